@@ -14,8 +14,12 @@ import (
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/server"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/configs"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/db"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/encryption"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/health"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/jwt"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/redis"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/tracing"
 	"github.com/gofiber/fiber/v2"
 	"go.uber.org/fx"
 )
@@ -36,6 +40,10 @@ func main() {
 		db.Module,
 		configs.Module,
 		redis.Module,
+		tracing.Module,
+		health.Module,
+		jwt.Module,
+		encryption.Module,
 		fx.Provide(
 			// http service
 			handler.NewAuthHandler,
@@ -44,6 +52,12 @@ func main() {
 				repository.NewAuthRepositoryWithRedis,
 				fx.As(new(ports.IAuthRepository)),
 			),
+			repository.NewBotRepository,
+			repository.NewSessionDenyList,
+			repository.NewTwoFactorRepository,
+			repository.NewMFAChallengeRepository,
+			repository.NewOAuthClientRepository,
+			repository.NewOAuthCodeRepository,
 			usecase.NewAuthUseCase,
 			server.NewServer,
 
@@ -63,12 +77,16 @@ func main() {
 			config *configs.Config,
 			srv *server.Server, // Inject the Fiber server
 			grpcSrv *grpc.GRPCServer, // Inject the gRPC server
+			checker *health.Checker, // Inject the health checker
 		) {
 			// Set up the Fiber server
 			srv.SetupAuthServer(lc)
 
 			// Set up the gRPC server
 			grpcSrv.SetupGRPCServer(lc)
+
+			// Set up the health checks
+			checker.SetupChecker(lc)
 		}),
 	)
 	app.Run()
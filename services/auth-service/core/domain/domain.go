@@ -2,15 +2,38 @@ package domain
 
 import "time"
 
+// Auth is a session and its tokens. Refreshing a session rotates its refresh token:
+// ID is the ID of the current refresh token and FamilyID, the session ID carried by
+// the access tokens, links all refresh tokens of the session. ReplacedBy is set on
+// a refresh token once it was rotated. IsCurrent marks the session of the caller
+// when sessions are listed. A login of a user with two-factor authentication first
+// gets an MFAToken, which is exchanged with the MFACode for the tokens. The sessions
+// of OAuth clients carry the client ID and the granted scope in their Claims.
 type Auth struct {
 	ID                    string
+	FamilyID              string
 	AccessToken           string
 	AccessTokenExpiresAt  time.Time
 	RefreshToken          string
 	RefreshTokenExpiresAt time.Time
 	RefreshTokenIsRevoked bool
+	ReplacedBy            string
 	User                  User
 	Claims                Claims
+	Device                Device
+	IsCurrent             bool
+	MFAToken              string
+	MFATokenExpiresAt     time.Time
+	MFACode               string
+}
+
+// Device is where a session is used from: the user agent and IP address of its last
+// login or refresh, when the session started and when it was last refreshed.
+type Device struct {
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
 }
 
 type User struct {
@@ -22,6 +45,8 @@ type User struct {
 }
 
 type Claims struct {
+	// Type is jwt.TokenTypeRefresh for refresh tokens, all other tokens are access tokens
+	Type      string
 	ID        uint
 	Username  string
 	Email     string
@@ -30,4 +55,101 @@ type Claims struct {
 	SessionID string
 	IssuedAt  time.Time
 	ExpiresAt time.Time
+	// ClientID and Scope are only set on the tokens of OAuth clients, which may only
+	// act within the space-separated scopes of Scope
+	ClientID string
+	Scope    string
+}
+
+// TwoFactor is the TOTP second factor of a user. URI, the otpauth URI of the secret, is
+// only set right after enrolling and RecoveryCodes right after enabling; only the hashes
+// of the recovery codes are stored. Code is a TOTP or recovery code sent by the user.
+type TwoFactor struct {
+	UserID        uint
+	Secret        string
+	URI           string
+	Enabled       bool
+	EnabledAt     time.Time
+	Code          string
+	RecoveryCodes []string
+}
+
+// RecoveryCode is a stored recovery code, which replaces a TOTP code once.
+type RecoveryCode struct {
+	ID     int
+	UserID uint
+	Hash   string
+}
+
+// Bot is a non-human account that logs in with client credentials.
+// ClientSecret is only set right after the bot was created.
+type Bot struct {
+	ID           int
+	Name         string
+	OwnerID      uint
+	ClientID     string
+	ClientSecret string
+	SecretHash   string
+	IsRevoked    bool
+	CreatedAt    time.Time
+}
+
+// OAuthClient is a third-party app that signs users in with OAuth 2.0 and acts on their
+// behalf within its Scopes. Confidential clients authenticate with their ClientSecret,
+// which is only set right after the client was created; public clients have no secret.
+type OAuthClient struct {
+	ID           int
+	Name         string
+	OwnerID      uint
+	ClientID     string
+	ClientSecret string
+	SecretHash   string
+	RedirectURIs []string
+	Scopes       []string
+	Confidential bool
+	IsRevoked    bool
+	CreatedAt    time.Time
+}
+
+// OAuthAuthorization is a request of a client to act on behalf of User, and the
+// authorization code issued for it. Approved is set when the user consents to Scopes.
+// The code is bound to the PKCE CodeChallenge, which the CodeVerifier sent with the code must match.
+type OAuthAuthorization struct {
+	ResponseType        string
+	Code                string
+	ClientID            string
+	RedirectURI         string
+	Scopes              []string
+	State               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Approved            bool
+	User                User
+	ExpiresAt           time.Time
+}
+
+// OAuthConsent records the scopes a user granted to a client.
+type OAuthConsent struct {
+	UserID     uint
+	ClientID   string
+	ClientName string
+	Scopes     []string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// OAuthTokenRequest is a request to the token or the revocation endpoint of a client,
+// authenticated with ClientSecret unless the client is public. Device is where the
+// request comes from, the device of the session the tokens start.
+type OAuthTokenRequest struct {
+	GrantType    string
+	ClientID     string
+	ClientSecret string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	RefreshToken string
+	Scopes       []string
+	Token        string
+	Device       Device
 }
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
 )
@@ -10,6 +11,66 @@ type IAuthRepository interface {
 	CreateToken(ctx context.Context, auth domain.Auth) (domain.Auth, error)
 	GetTokenByID(ctx context.Context, auth domain.Auth) (domain.Auth, error)
 	GetTokenByRefreshToken(ctx context.Context, auth domain.Auth) (domain.Auth, error)
+	RotateToken(ctx context.Context, current domain.Auth, next domain.Auth) (domain.Auth, error)
 	DeleteToken(ctx context.Context, auth domain.Auth) error
 	RevokeToken(ctx context.Context, auth domain.Auth) error
+	IsSessionActive(ctx context.Context, auth domain.Auth) (bool, error)
+	GetSessionsByUserID(ctx context.Context, auth domain.Auth) ([]domain.Auth, error)
+	GetSessionsByClientID(ctx context.Context, auth domain.Auth) ([]domain.Auth, error)
+}
+
+// ISessionDenyList holds the sessions that were ended and the bots and OAuth clients that
+// were revoked, until their last access token expired.
+type ISessionDenyList interface {
+	DenySession(ctx context.Context, auth domain.Auth, ttl time.Duration) error
+	IsSessionDenied(ctx context.Context, auth domain.Auth) (bool, error)
+	DenyBot(ctx context.Context, bot domain.Bot, ttl time.Duration) error
+	IsBotDenied(ctx context.Context, bot domain.Bot) (bool, error)
+	DenyClient(ctx context.Context, client domain.OAuthClient, ttl time.Duration) error
+	IsClientDenied(ctx context.Context, client domain.OAuthClient) (bool, error)
+}
+
+type IBotRepository interface {
+	CreateBot(ctx context.Context, bot domain.Bot) (domain.Bot, error)
+	GetBots(ctx context.Context) ([]domain.Bot, error)
+	GetBotByClientID(ctx context.Context, bot domain.Bot) (domain.Bot, error)
+	RevokeBot(ctx context.Context, bot domain.Bot) error
+}
+
+type ITwoFactorRepository interface {
+	CreateTwoFactor(ctx context.Context, twoFactor domain.TwoFactor) (domain.TwoFactor, error)
+	GetTwoFactor(ctx context.Context, twoFactor domain.TwoFactor) (domain.TwoFactor, error)
+	EnableTwoFactor(ctx context.Context, twoFactor domain.TwoFactor, codes []domain.RecoveryCode) error
+	DeleteTwoFactor(ctx context.Context, twoFactor domain.TwoFactor) error
+	GetRecoveryCodes(ctx context.Context, twoFactor domain.TwoFactor) ([]domain.RecoveryCode, error)
+	UseRecoveryCode(ctx context.Context, code domain.RecoveryCode) error
+	UseTimeStep(ctx context.Context, twoFactor domain.TwoFactor, step int64) error
+}
+
+// IMFAChallengeRepository holds the logins waiting for their second factor and counts the
+// codes tried by every user.
+type IMFAChallengeRepository interface {
+	CreateChallenge(ctx context.Context, auth domain.Auth) error
+	GetChallenge(ctx context.Context, auth domain.Auth) (domain.Auth, error)
+	DeleteChallenge(ctx context.Context, auth domain.Auth) error
+	CountAttempt(ctx context.Context, auth domain.Auth, window time.Duration) (int64, error)
+	ResetAttempts(ctx context.Context, auth domain.Auth) error
+}
+
+// IOAuthClientRepository holds the registered OAuth clients and the consents users gave them.
+type IOAuthClientRepository interface {
+	CreateClient(ctx context.Context, client domain.OAuthClient) (domain.OAuthClient, error)
+	GetClients(ctx context.Context) ([]domain.OAuthClient, error)
+	GetClientByClientID(ctx context.Context, client domain.OAuthClient) (domain.OAuthClient, error)
+	RevokeClient(ctx context.Context, client domain.OAuthClient) (domain.OAuthClient, error)
+	SaveConsent(ctx context.Context, consent domain.OAuthConsent) (domain.OAuthConsent, error)
+	GetConsent(ctx context.Context, consent domain.OAuthConsent) (domain.OAuthConsent, error)
+	GetConsentsByUserID(ctx context.Context, consent domain.OAuthConsent) ([]domain.OAuthConsent, error)
+	DeleteConsent(ctx context.Context, consent domain.OAuthConsent) error
+}
+
+// IOAuthCodeRepository holds the authorization codes until they expire or are exchanged, once.
+type IOAuthCodeRepository interface {
+	CreateCode(ctx context.Context, authorization domain.OAuthAuthorization) error
+	ConsumeCode(ctx context.Context, authorization domain.OAuthAuthorization) (domain.OAuthAuthorization, error)
 }
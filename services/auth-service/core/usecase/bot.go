@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/authctx"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/hash"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RoleBot is the role carried by the access tokens of bots.
+const RoleBot = "bot"
+
+// CreateBot registers a bot and returns its credentials. The client secret is not stored and can't be shown again.
+func (a *AuthUseCase) CreateBot(ctx context.Context, bot domain.Bot) (domain.Bot, error) {
+	admin, err := a.verifyAdmin(ctx)
+	if err != nil {
+		return domain.Bot{}, err
+	}
+
+	if bot.Name == "" {
+		return domain.Bot{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("bot name is required"))
+	}
+
+	clientID, err := randomHex(12)
+	if err != nil {
+		return domain.Bot{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	clientSecret, err := randomHex(32)
+	if err != nil {
+		return domain.Bot{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	secretHash, err := hash.HashedPassword(clientSecret, a.logger)
+	if err != nil {
+		return domain.Bot{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	bot.OwnerID = admin.User.ID
+	bot.ClientID = "bot_" + clientID
+	bot.SecretHash = secretHash
+
+	createdBot, err := a.botRepository.CreateBot(ctx, bot)
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in creating bot", zap.Error(err))
+		return domain.Bot{}, err
+	}
+	createdBot.ClientSecret = clientSecret
+
+	return createdBot, nil
+}
+
+func (a *AuthUseCase) GetBots(ctx context.Context) ([]domain.Bot, error) {
+	if _, err := a.verifyAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	bots, err := a.botRepository.GetBots(ctx)
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting bots", zap.Error(err))
+		return nil, err
+	}
+
+	return bots, nil
+}
+
+// RevokeBot disables the credentials of a bot and ends the access tokens it was issued.
+// Revoking a revoked bot again denies its tokens again, should the deny list have failed.
+func (a *AuthUseCase) RevokeBot(ctx context.Context, bot domain.Bot) error {
+	if _, err := a.verifyAdmin(ctx); err != nil {
+		return err
+	}
+
+	if err := a.botRepository.RevokeBot(ctx, bot); err != nil {
+		a.logger.Ctx(ctx).Error("error in revoking bot", zap.Error(err))
+		return err
+	}
+
+	// Bot tokens carry no session in the token store, the deny list is all that ends them
+	a.sessions.set(botSessionKey(uint(bot.ID)), false)
+	if err := a.denyList.DenyBot(ctx, bot, a.config.JWT.AccessTokenDuration); err != nil {
+		a.logger.Ctx(ctx).Error("error in denying bot", zap.Int("bot_id", bot.ID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// BotToken exchanges the client credentials of a bot for an access token.
+func (a *AuthUseCase) BotToken(ctx context.Context, bot domain.Bot) (domain.Auth, error) {
+	foundBot, err := a.botRepository.GetBotByClientID(ctx, bot)
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting bot by client id", zap.Error(err))
+		return domain.Auth{}, errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("invalid client credentials"))
+	}
+
+	if foundBot.IsRevoked {
+		return domain.Auth{}, errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("bot is revoked"))
+	}
+
+	ok, err := hash.ComparePassword(foundBot.SecretHash, bot.ClientSecret, a.logger)
+	if err != nil || !ok {
+		return domain.Auth{}, errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("invalid client credentials"))
+	}
+
+	auth := domain.Auth{
+		Claims: domain.Claims{
+			ID:        uint(foundBot.ID),
+			Username:  foundBot.Name,
+			Role:      RoleBot,
+			Duration:  a.config.JWT.AccessTokenDuration,
+			SessionID: uuid.New().String(),
+		},
+	}
+
+	auth, err = a.CreateToken(ctx, auth)
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in creating bot token", zap.Error(err))
+		return domain.Auth{}, err
+	}
+
+	return auth, nil
+}
+
+// verifyAdmin verifies the token from the context and makes sure it belongs to an admin.
+func (a *AuthUseCase) verifyAdmin(ctx context.Context) (domain.Auth, error) {
+	contextToken, ok := authctx.Token(ctx)
+	if !ok {
+		err := fmt.Errorf("error in getting token from context")
+		a.logger.Ctx(ctx).Error(err.Error())
+		return domain.Auth{}, errors.NewError(errors.ErrorBadRequest, err)
+	}
+
+	auth, err := a.VerifyToken(ctx, domain.Auth{AccessToken: contextToken})
+	if err != nil {
+		return domain.Auth{}, err
+	}
+
+	if auth.User.Role != "admin" {
+		return domain.Auth{}, errors.NewError(errors.ErrorForbidden, fmt.Errorf("user does not have admin permission"))
+	}
+	if auth.Claims.ClientID != "" {
+		return domain.Auth{}, errors.NewError(errors.ErrorForbidden, fmt.Errorf("OAuth clients can't act as admin"))
+	}
+
+	return auth, nil
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,516 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/hash"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RoleClient is the role carried by the access tokens OAuth clients get for themselves
+// with the client credentials grant.
+const RoleClient = "client"
+
+// The OAuth grant types and the only supported PKCE method.
+const (
+	GrantAuthorizationCode = "authorization_code"
+	GrantClientCredentials = "client_credentials"
+	GrantRefreshToken      = "refresh_token"
+	codeChallengeS256      = "S256"
+)
+
+// oauthScopes are the scopes OAuth clients may be granted. user-management enforces the
+// users scopes and chat-service the chat scopes.
+var oauthScopes = []string{"users:read", "users:write", "chat:read", "chat:write"}
+
+// CreateOAuthClient registers an OAuth client and returns its credentials. Clients without
+// redirect URIs only use the client credentials grant. Public clients get no secret, the
+// others get one that is not stored and can't be shown again.
+func (a *AuthUseCase) CreateOAuthClient(ctx context.Context, client domain.OAuthClient) (domain.OAuthClient, error) {
+	admin, err := a.verifyAdmin(ctx)
+	if err != nil {
+		return domain.OAuthClient{}, err
+	}
+
+	if client.Name == "" {
+		return domain.OAuthClient{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("client name is required"))
+	}
+	for _, redirectURI := range client.RedirectURIs {
+		if err := validateRedirectURI(redirectURI); err != nil {
+			return domain.OAuthClient{}, errors.NewError(errors.ErrorBadRequest, err)
+		}
+	}
+	if len(client.Scopes) == 0 {
+		return domain.OAuthClient{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("client scopes are required"))
+	}
+	for _, scope := range client.Scopes {
+		if !slices.Contains(oauthScopes, scope) {
+			return domain.OAuthClient{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("unknown scope %q", scope))
+		}
+	}
+	if !client.Confidential && len(client.RedirectURIs) == 0 {
+		return domain.OAuthClient{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("public clients need a redirect URI"))
+	}
+
+	clientID, err := randomHex(12)
+	if err != nil {
+		return domain.OAuthClient{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	client.OwnerID = admin.User.ID
+	client.ClientID = "oauth_" + clientID
+	client.SecretHash = ""
+
+	var clientSecret string
+	if client.Confidential {
+		clientSecret, err = randomHex(32)
+		if err != nil {
+			return domain.OAuthClient{}, errors.NewError(errors.ErrorInternal, err)
+		}
+		client.SecretHash, err = hash.HashedPassword(clientSecret, a.logger)
+		if err != nil {
+			return domain.OAuthClient{}, errors.NewError(errors.ErrorInternal, err)
+		}
+	}
+
+	createdClient, err := a.oauthClients.CreateClient(ctx, client)
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in creating OAuth client", zap.Error(err))
+		return domain.OAuthClient{}, err
+	}
+	createdClient.ClientSecret = clientSecret
+
+	return createdClient, nil
+}
+
+func (a *AuthUseCase) GetOAuthClients(ctx context.Context) ([]domain.OAuthClient, error) {
+	if _, err := a.verifyAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	clients, err := a.oauthClients.GetClients(ctx)
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting OAuth clients", zap.Error(err))
+		return nil, err
+	}
+
+	return clients, nil
+}
+
+// RevokeOAuthClient disables an OAuth client, which can no longer be authorized nor get or
+// refresh tokens. Every session the client holds for users ends, and the access tokens
+// issued to it, for users or for itself, are denied until the last of them expired.
+func (a *AuthUseCase) RevokeOAuthClient(ctx context.Context, client domain.OAuthClient) error {
+	if _, err := a.verifyAdmin(ctx); err != nil {
+		return err
+	}
+
+	client, err := a.oauthClients.RevokeClient(ctx, client)
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in revoking OAuth client", zap.Error(err))
+		return err
+	}
+
+	if err := a.denyList.DenyClient(ctx, client, a.config.JWT.AccessTokenDuration); err != nil {
+		a.logger.Ctx(ctx).Error("error in denying OAuth client", zap.String("client_id", client.ClientID), zap.Error(err))
+		return err
+	}
+
+	sessions, err := a.authRepository.GetSessionsByClientID(ctx, domain.Auth{Claims: domain.Claims{ClientID: client.ClientID}})
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting sessions", zap.Error(err))
+		return err
+	}
+	for _, session := range sessions {
+		if err := a.endSessionTokens(ctx, session); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AuthorizeOAuth lets the caller authorize an OAuth client to act on their behalf and
+// returns the authorization code to redirect back with. Scopes the caller has not consented
+// to yet are only granted when the request approves them.
+func (a *AuthUseCase) AuthorizeOAuth(ctx context.Context, authorization domain.OAuthAuthorization) (domain.OAuthAuthorization, error) {
+	caller, err := a.verifyCaller(ctx)
+	if err != nil {
+		return domain.OAuthAuthorization{}, err
+	}
+
+	client, err := a.oauthClients.GetClientByClientID(ctx, domain.OAuthClient{ClientID: authorization.ClientID})
+	if errors.IsSvcError(err, errors.ErrorNotFound) {
+		return domain.OAuthAuthorization{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("unknown client"))
+	}
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting OAuth client", zap.Error(err))
+		return domain.OAuthAuthorization{}, err
+	}
+	if client.IsRevoked {
+		return domain.OAuthAuthorization{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("client is revoked"))
+	}
+	if !slices.Contains(client.RedirectURIs, authorization.RedirectURI) {
+		return domain.OAuthAuthorization{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("redirect_uri is not registered for the client"))
+	}
+
+	if authorization.ResponseType != "code" {
+		return domain.OAuthAuthorization{}, errors.NewOAuthError(errors.ErrorBadRequest, errors.OAuthUnsupportedResponseType, fmt.Errorf("response_type must be code"))
+	}
+	if authorization.CodeChallenge == "" {
+		return domain.OAuthAuthorization{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("code_challenge is required"))
+	}
+	if authorization.CodeChallengeMethod != codeChallengeS256 {
+		return domain.OAuthAuthorization{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("code_challenge_method must be S256"))
+	}
+	scopes, err := resolveScopes(client, authorization.Scopes)
+	if err != nil {
+		return domain.OAuthAuthorization{}, err
+	}
+
+	// the caller consents once per client, and again for every scope it asks for later
+	consent, err := a.oauthClients.GetConsent(ctx, domain.OAuthConsent{UserID: caller.User.ID, ClientID: client.ClientID})
+	if err != nil && !errors.IsSvcError(err, errors.ErrorNotFound) {
+		a.logger.Ctx(ctx).Error("error in getting OAuth consent", zap.Error(err))
+		return domain.OAuthAuthorization{}, err
+	}
+	if !containsAll(consent.Scopes, scopes) {
+		if !authorization.Approved {
+			return domain.OAuthAuthorization{}, errors.NewOAuthError(errors.ErrorForbidden, errors.OAuthConsentRequired, fmt.Errorf("the user has not approved the requested scopes"))
+		}
+		consent.UserID = caller.User.ID
+		consent.ClientID = client.ClientID
+		consent.Scopes = union(consent.Scopes, scopes)
+		if _, err := a.oauthClients.SaveConsent(ctx, consent); err != nil {
+			a.logger.Ctx(ctx).Error("error in saving OAuth consent", zap.Error(err))
+			return domain.OAuthAuthorization{}, err
+		}
+	}
+
+	code, err := randomHex(32)
+	if err != nil {
+		return domain.OAuthAuthorization{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	authorization.Code = code
+	authorization.ClientID = client.ClientID
+	authorization.Scopes = scopes
+	authorization.User = caller.User
+	authorization.ExpiresAt = time.Now().Add(a.config.OAuth.CodeTTL)
+	if err := a.oauthCodes.CreateCode(ctx, authorization); err != nil {
+		a.logger.Ctx(ctx).Error("error in creating authorization code", zap.Error(err))
+		return domain.OAuthAuthorization{}, err
+	}
+
+	return authorization, nil
+}
+
+// OAuthToken is the token endpoint: it authenticates the client and issues tokens for the
+// authorization code, client credentials or refresh token grant of req.
+func (a *AuthUseCase) OAuthToken(ctx context.Context, req domain.OAuthTokenRequest) (domain.Auth, error) {
+	switch req.GrantType {
+	case GrantAuthorizationCode, GrantClientCredentials, GrantRefreshToken:
+	default:
+		return domain.Auth{}, errors.NewOAuthError(errors.ErrorBadRequest, errors.OAuthUnsupportedGrantType, fmt.Errorf("unsupported grant_type %q", req.GrantType))
+	}
+
+	client, err := a.authenticateOAuthClient(ctx, req)
+	if err != nil {
+		return domain.Auth{}, err
+	}
+
+	switch req.GrantType {
+	case GrantAuthorizationCode:
+		return a.exchangeOAuthCode(ctx, client, req)
+	case GrantClientCredentials:
+		return a.oauthClientToken(ctx, client, req)
+	default:
+		return a.refreshOAuthToken(ctx, client, req)
+	}
+}
+
+// exchangeOAuthCode starts a session of the user who authorized the client, after checking
+// the code was issued to the client for the same redirect URI and the PKCE verifier.
+func (a *AuthUseCase) exchangeOAuthCode(ctx context.Context, client domain.OAuthClient, req domain.OAuthTokenRequest) (domain.Auth, error) {
+	if req.Code == "" || req.CodeVerifier == "" {
+		return domain.Auth{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("code and code_verifier are required"))
+	}
+
+	authorization, err := a.oauthCodes.ConsumeCode(ctx, domain.OAuthAuthorization{Code: req.Code})
+	if errors.IsSvcError(err, errors.ErrorNotFound) {
+		return domain.Auth{}, errors.NewOAuthError(errors.ErrorBadRequest, errors.OAuthInvalidGrant, fmt.Errorf("authorization code is invalid or expired"))
+	}
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in consuming authorization code", zap.Error(err))
+		return domain.Auth{}, err
+	}
+	if authorization.ClientID != client.ClientID {
+		return domain.Auth{}, errors.NewOAuthError(errors.ErrorBadRequest, errors.OAuthInvalidGrant, fmt.Errorf("authorization code was issued to another client"))
+	}
+	if authorization.RedirectURI != req.RedirectURI {
+		return domain.Auth{}, errors.NewOAuthError(errors.ErrorBadRequest, errors.OAuthInvalidGrant, fmt.Errorf("redirect_uri does not match the authorization request"))
+	}
+	if !verifyCodeChallenge(authorization.CodeChallenge, req.CodeVerifier) {
+		return domain.Auth{}, errors.NewOAuthError(errors.ErrorBadRequest, errors.OAuthInvalidGrant, fmt.Errorf("code_verifier does not match the code_challenge"))
+	}
+
+	auth, err := a.startSession(ctx, domain.Auth{
+		User: authorization.User,
+		Claims: domain.Claims{
+			ClientID: client.ClientID,
+			Scope:    strings.Join(authorization.Scopes, " "),
+		},
+		Device: req.Device,
+	})
+	if err != nil {
+		return domain.Auth{}, err
+	}
+
+	return auth, nil
+}
+
+// oauthClientToken issues an access token for the client itself. Its session is never
+// stored, so it is only ended by a revocation, and it has no refresh token.
+func (a *AuthUseCase) oauthClientToken(ctx context.Context, client domain.OAuthClient, req domain.OAuthTokenRequest) (domain.Auth, error) {
+	if !client.Confidential {
+		return domain.Auth{}, errors.NewOAuthError(errors.ErrorBadRequest, errors.OAuthUnauthorizedClient, fmt.Errorf("public clients can't use the client credentials grant"))
+	}
+
+	scopes, err := resolveScopes(client, req.Scopes)
+	if err != nil {
+		return domain.Auth{}, err
+	}
+
+	auth := domain.Auth{
+		Claims: domain.Claims{
+			Username:  client.Name,
+			Role:      RoleClient,
+			Duration:  a.config.JWT.AccessTokenDuration,
+			SessionID: uuid.New().String(),
+			ClientID:  client.ClientID,
+			Scope:     strings.Join(scopes, " "),
+		},
+	}
+
+	auth, err = a.CreateToken(ctx, auth)
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in creating OAuth client token", zap.Error(err))
+		return domain.Auth{}, err
+	}
+
+	return auth, nil
+}
+
+// refreshOAuthToken rotates a refresh token of the client like RefreshToken does for
+// first-party sessions. The scope of the session does not change.
+func (a *AuthUseCase) refreshOAuthToken(ctx context.Context, client domain.OAuthClient, req domain.OAuthTokenRequest) (domain.Auth, error) {
+	if req.RefreshToken == "" {
+		return domain.Auth{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("refresh_token is required"))
+	}
+
+	current, err := a.authRepository.GetTokenByRefreshToken(ctx, domain.Auth{RefreshToken: req.RefreshToken})
+	if errors.IsSvcError(err, errors.ErrorNotFound) {
+		return domain.Auth{}, errors.NewOAuthError(errors.ErrorBadRequest, errors.OAuthInvalidGrant, fmt.Errorf("refresh token is invalid"))
+	}
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting token from database", zap.Error(err))
+		return domain.Auth{}, err
+	}
+	if current.Claims.ClientID != client.ClientID {
+		return domain.Auth{}, errors.NewOAuthError(errors.ErrorBadRequest, errors.OAuthInvalidGrant, fmt.Errorf("refresh token was issued to another client"))
+	}
+
+	auth, err := a.refreshSession(ctx, current, req.Device)
+	if errors.IsSvcError(err, errors.ErrorUnauthorized) {
+		return domain.Auth{}, errors.NewOAuthError(errors.ErrorBadRequest, errors.OAuthInvalidGrant, err)
+	}
+	if err != nil {
+		return domain.Auth{}, err
+	}
+
+	return auth, nil
+}
+
+// RevokeOAuthToken revokes an access or refresh token of the client (RFC 7009). Revoking
+// either token of a user session ends the whole session. Tokens that are invalid already
+// are not an error.
+func (a *AuthUseCase) RevokeOAuthToken(ctx context.Context, req domain.OAuthTokenRequest) error {
+	client, err := a.authenticateOAuthClient(ctx, req)
+	if err != nil {
+		return err
+	}
+	if req.Token == "" {
+		return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("token is required"))
+	}
+
+	// a refresh token is found in the token store
+	session, err := a.authRepository.GetTokenByRefreshToken(ctx, domain.Auth{RefreshToken: req.Token})
+	if err == nil {
+		if session.Claims.ClientID != client.ClientID {
+			return errors.NewOAuthError(errors.ErrorForbidden, errors.OAuthUnauthorizedClient, fmt.Errorf("token was issued to another client"))
+		}
+		return a.endSessionTokens(ctx, session)
+	}
+	if !errors.IsSvcError(err, errors.ErrorNotFound) {
+		a.logger.Ctx(ctx).Error("error in getting token from database", zap.Error(err))
+		return err
+	}
+
+	// otherwise it may be an access token
+	claims, err := a.keys.VerifyToken(req.Token)
+	if err != nil {
+		return nil
+	}
+	if claims.ClientID != client.ClientID {
+		return errors.NewOAuthError(errors.ErrorForbidden, errors.OAuthUnauthorizedClient, fmt.Errorf("token was issued to another client"))
+	}
+	session = domain.Auth{
+		FamilyID: claims.SessionID,
+		User:     domain.User{ID: claims.ID},
+	}
+	if claims.Role == RoleClient {
+		a.endSession(ctx, session)
+		return nil
+	}
+	if err := a.endSessionTokens(ctx, session); err != nil && !errors.IsSvcError(err, errors.ErrorNotFound) {
+		return err
+	}
+	return nil
+}
+
+// GetOAuthConsents returns the OAuth clients the caller has authorized and their scopes.
+func (a *AuthUseCase) GetOAuthConsents(ctx context.Context) ([]domain.OAuthConsent, error) {
+	caller, err := a.verifyCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	consents, err := a.oauthClients.GetConsentsByUserID(ctx, domain.OAuthConsent{UserID: caller.User.ID})
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting OAuth consents", zap.Error(err))
+		return nil, err
+	}
+	for i := range consents {
+		client, err := a.oauthClients.GetClientByClientID(ctx, domain.OAuthClient{ClientID: consents[i].ClientID})
+		if err != nil {
+			a.logger.Ctx(ctx).Error("error in getting OAuth client", zap.Error(err))
+			return nil, err
+		}
+		consents[i].ClientName = client.Name
+	}
+	return consents, nil
+}
+
+// RevokeOAuthConsent withdraws the consent of the caller to an OAuth client and ends every
+// session the client holds for the caller.
+func (a *AuthUseCase) RevokeOAuthConsent(ctx context.Context, consent domain.OAuthConsent) error {
+	caller, err := a.verifyCaller(ctx)
+	if err != nil {
+		return err
+	}
+
+	consent.UserID = caller.User.ID
+	if err := a.oauthClients.DeleteConsent(ctx, consent); err != nil {
+		a.logger.Ctx(ctx).Error("error in deleting OAuth consent", zap.Error(err))
+		return err
+	}
+
+	sessions, err := a.authRepository.GetSessionsByUserID(ctx, caller)
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting sessions", zap.Error(err))
+		return err
+	}
+	for _, session := range sessions {
+		if session.Claims.ClientID != consent.ClientID {
+			continue
+		}
+		if err := a.endSessionTokens(ctx, session); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// authenticateOAuthClient finds the client of req and checks its secret, which public
+// clients don't have.
+func (a *AuthUseCase) authenticateOAuthClient(ctx context.Context, req domain.OAuthTokenRequest) (domain.OAuthClient, error) {
+	client, err := a.oauthClients.GetClientByClientID(ctx, domain.OAuthClient{ClientID: req.ClientID})
+	if errors.IsSvcError(err, errors.ErrorNotFound) {
+		return domain.OAuthClient{}, errors.NewOAuthError(errors.ErrorUnauthorized, errors.OAuthInvalidClient, fmt.Errorf("invalid client credentials"))
+	}
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting OAuth client", zap.Error(err))
+		return domain.OAuthClient{}, err
+	}
+
+	if client.IsRevoked {
+		return domain.OAuthClient{}, errors.NewOAuthError(errors.ErrorUnauthorized, errors.OAuthInvalidClient, fmt.Errorf("client is revoked"))
+	}
+	if client.Confidential && !hash.MatchesHash(client.SecretHash, req.ClientSecret) {
+		return domain.OAuthClient{}, errors.NewOAuthError(errors.ErrorUnauthorized, errors.OAuthInvalidClient, fmt.Errorf("invalid client credentials"))
+	}
+
+	return client, nil
+}
+
+// resolveScopes checks that the client may be granted the requested scopes, which default
+// to all of its scopes.
+func resolveScopes(client domain.OAuthClient, requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return client.Scopes, nil
+	}
+	for _, scope := range requested {
+		if !slices.Contains(client.Scopes, scope) {
+			return nil, errors.NewOAuthError(errors.ErrorBadRequest, errors.OAuthInvalidScope, fmt.Errorf("scope %q is not allowed for the client", scope))
+		}
+	}
+	return union(nil, requested), nil
+}
+
+// validateRedirectURI makes sure a redirect URI is absolute and has no fragment (RFC 6749,
+// section 3.1.2).
+func validateRedirectURI(redirectURI string) error {
+	u, err := url.Parse(redirectURI)
+	if err != nil || !u.IsAbs() || u.Host == "" {
+		return fmt.Errorf("redirect URI %q must be an absolute URL", redirectURI)
+	}
+	if u.Fragment != "" {
+		return fmt.Errorf("redirect URI %q must not have a fragment", redirectURI)
+	}
+	return nil
+}
+
+// verifyCodeChallenge checks the PKCE code verifier against the S256 code challenge.
+func verifyCodeChallenge(challenge, verifier string) bool {
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+func containsAll(scopes, required []string) bool {
+	for _, scope := range required {
+		if !slices.Contains(scopes, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+// union returns the scopes of a followed by those of b that are not in a.
+func union(a, b []string) []string {
+	scopes := slices.Clone(a)
+	for _, scope := range b {
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
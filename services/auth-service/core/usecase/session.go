@@ -0,0 +1,283 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/authctx"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"go.uber.org/zap"
+)
+
+// sessionCache remembers for a short time whether sessions are active, so that verifying
+// the access tokens of a busy session does not reach Redis every time.
+type sessionCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]sessionEntry
+}
+
+type sessionEntry struct {
+	active    bool
+	expiresAt time.Time
+}
+
+func newSessionCache(ttl time.Duration) *sessionCache {
+	return &sessionCache{
+		ttl:     ttl,
+		entries: make(map[string]sessionEntry),
+	}
+}
+
+func (c *sessionCache) get(sessionID string) (active bool, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[sessionID]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return false, false
+	}
+	return entry.active, true
+}
+
+func (c *sessionCache) set(sessionID string, active bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	c.entries[sessionID] = sessionEntry{active: active, expiresAt: now.Add(c.ttl)}
+
+	// Drop the expired entries once the cache grew, so it holds the recent sessions only
+	if len(c.entries) > 10000 {
+		for id, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, id)
+			}
+		}
+	}
+}
+
+// checkSession makes sure the session of an access token was not ended by a logout or a
+// revocation. It asks the local cache first, then isSessionActive, and remembers the answer
+// for the cache TTL.
+func (a *AuthUseCase) checkSession(ctx context.Context, auth domain.Auth) error {
+	key := sessionKey(auth)
+	active, ok := a.sessions.get(key)
+	if !ok {
+		var err error
+		active, err = a.isSessionActive(ctx, auth)
+		if err != nil {
+			return err
+		}
+		a.sessions.set(key, active)
+	}
+
+	if !active {
+		return errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("session has ended"))
+	}
+	return nil
+}
+
+// isSessionActive asks the Redis deny list and then the token store whether the session of
+// an access token is active. Bot tokens carry no session and are denied all together when
+// the bot is revoked, like the tokens of an OAuth client when the client is; the tokens
+// OAuth clients get for themselves are only ever on the deny list.
+func (a *AuthUseCase) isSessionActive(ctx context.Context, auth domain.Auth) (bool, error) {
+	if auth.User.Role == RoleBot {
+		denied, err := a.denyList.IsBotDenied(ctx, domain.Bot{ID: int(auth.User.ID)})
+		return !denied, err
+	}
+	if auth.Claims.ClientID != "" {
+		denied, err := a.denyList.IsClientDenied(ctx, domain.OAuthClient{ClientID: auth.Claims.ClientID})
+		if err != nil || denied {
+			return !denied, err
+		}
+	}
+
+	denied, err := a.denyList.IsSessionDenied(ctx, auth)
+	if err != nil || denied || auth.User.Role == RoleClient {
+		return !denied, err
+	}
+
+	active, err := a.authRepository.IsSessionActive(ctx, auth)
+	if err != nil {
+		return false, err
+	}
+	if !active {
+		a.denySession(ctx, auth)
+	}
+	return active, nil
+}
+
+// sessionKey is the key of the session of auth in the session cache. The tokens of a bot
+// share one key, so that revoking the bot ends all of them.
+func sessionKey(auth domain.Auth) string {
+	if auth.User.Role == RoleBot {
+		return botSessionKey(auth.User.ID)
+	}
+	return auth.FamilyID
+}
+
+func botSessionKey(botID uint) string {
+	return fmt.Sprintf("bot:%d", botID)
+}
+
+// endSession stops the access tokens of the session of auth from being accepted, after its
+// refresh tokens were deleted or revoked.
+func (a *AuthUseCase) endSession(ctx context.Context, auth domain.Auth) {
+	a.sessions.set(sessionKey(auth), false)
+	a.denySession(ctx, auth)
+}
+
+// denySession adds the session to the deny list until its last access token expired. A
+// session missing from the list is still found ended in the token store, so a failure is
+// only logged.
+func (a *AuthUseCase) denySession(ctx context.Context, auth domain.Auth) {
+	if err := a.denyList.DenySession(ctx, auth, a.config.JWT.AccessTokenDuration); err != nil {
+		a.logger.Ctx(ctx).Error("error in denying session", zap.String("family_id", auth.FamilyID), zap.Error(err))
+	}
+}
+
+// GetSessions returns the active sessions of the caller, marking the one its token belongs to.
+func (a *AuthUseCase) GetSessions(ctx context.Context) ([]domain.Auth, error) {
+	caller, err := a.verifyCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := a.authRepository.GetSessionsByUserID(ctx, caller)
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting sessions", zap.Error(err))
+		return nil, err
+	}
+	for i := range sessions {
+		sessions[i].IsCurrent = sessions[i].FamilyID == caller.FamilyID
+	}
+	return sessions, nil
+}
+
+// RevokeSession ends one session of the caller, the session of auth.FamilyID.
+func (a *AuthUseCase) RevokeSession(ctx context.Context, auth domain.Auth) error {
+	caller, err := a.verifyCaller(ctx)
+	if err != nil {
+		return err
+	}
+
+	auth.User.ID = caller.User.ID
+	return a.revokeSession(ctx, auth)
+}
+
+// RevokeOtherSessions ends every session of the caller except the one its token belongs to.
+func (a *AuthUseCase) RevokeOtherSessions(ctx context.Context) error {
+	caller, err := a.verifyCaller(ctx)
+	if err != nil {
+		return err
+	}
+
+	return a.revokeSessions(ctx, caller, caller.FamilyID)
+}
+
+// GetUserSessions returns the active sessions of the user auth.User.ID to an admin.
+func (a *AuthUseCase) GetUserSessions(ctx context.Context, auth domain.Auth) ([]domain.Auth, error) {
+	if _, err := a.verifyAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	sessions, err := a.authRepository.GetSessionsByUserID(ctx, auth)
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting sessions", zap.Error(err))
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// RevokeUserSession ends the session auth.FamilyID of the user auth.User.ID for an admin.
+func (a *AuthUseCase) RevokeUserSession(ctx context.Context, auth domain.Auth) error {
+	if _, err := a.verifyAdmin(ctx); err != nil {
+		return err
+	}
+
+	return a.revokeSession(ctx, auth)
+}
+
+// RevokeUserSessions ends every session of the user auth.User.ID for an admin.
+func (a *AuthUseCase) RevokeUserSessions(ctx context.Context, auth domain.Auth) error {
+	if _, err := a.verifyAdmin(ctx); err != nil {
+		return err
+	}
+
+	return a.revokeSessions(ctx, auth, "")
+}
+
+// revokeSession ends the session auth.FamilyID, which must be an active session of auth.User.ID.
+func (a *AuthUseCase) revokeSession(ctx context.Context, auth domain.Auth) error {
+	sessions, err := a.authRepository.GetSessionsByUserID(ctx, auth)
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting sessions", zap.Error(err))
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.FamilyID == auth.FamilyID {
+			return a.endSessionTokens(ctx, session)
+		}
+	}
+	return errors.NewError(errors.ErrorNotFound, fmt.Errorf("session not found"))
+}
+
+// revokeSessions ends every active session of auth.User.ID except the session exceptID.
+func (a *AuthUseCase) revokeSessions(ctx context.Context, auth domain.Auth, exceptID string) error {
+	sessions, err := a.authRepository.GetSessionsByUserID(ctx, auth)
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting sessions", zap.Error(err))
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.FamilyID == exceptID {
+			continue
+		}
+		if err := a.endSessionTokens(ctx, session); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// endSessionTokens revokes the refresh tokens of a session and ends it.
+func (a *AuthUseCase) endSessionTokens(ctx context.Context, auth domain.Auth) error {
+	if err := a.authRepository.RevokeToken(ctx, auth); err != nil {
+		a.logger.Ctx(ctx).Error("error in revoking session", zap.String("family_id", auth.FamilyID), zap.Error(err))
+		return err
+	}
+	a.endSession(ctx, auth)
+	return nil
+}
+
+// verifyCaller verifies the token from the context and returns its session. Bots, which
+// are numbered apart from users, have no sessions.
+func (a *AuthUseCase) verifyCaller(ctx context.Context) (domain.Auth, error) {
+	contextToken, ok := authctx.Token(ctx)
+	if !ok {
+		err := fmt.Errorf("error in getting token from context")
+		a.logger.Ctx(ctx).Error(err.Error())
+		return domain.Auth{}, errors.NewError(errors.ErrorBadRequest, err)
+	}
+
+	auth, err := a.VerifyToken(ctx, domain.Auth{AccessToken: contextToken})
+	if err != nil {
+		return domain.Auth{}, err
+	}
+
+	if auth.User.Role == RoleBot {
+		return domain.Auth{}, errors.NewError(errors.ErrorForbidden, fmt.Errorf("bots have no sessions"))
+	}
+	if auth.Claims.ClientID != "" {
+		return domain.Auth{}, errors.NewError(errors.ErrorForbidden, fmt.Errorf("OAuth clients can't manage the account"))
+	}
+
+	return auth, nil
+}
@@ -0,0 +1,299 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/hash"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"go.uber.org/zap"
+)
+
+// EnrollTwoFactor generates a TOTP secret for the caller and returns it with its otpauth
+// URI. Logins only ask for a code once the secret was confirmed with EnableTwoFactor.
+func (a *AuthUseCase) EnrollTwoFactor(ctx context.Context) (domain.TwoFactor, error) {
+	caller, err := a.verifyCaller(ctx)
+	if err != nil {
+		return domain.TwoFactor{}, err
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      a.config.MFA.Issuer,
+		AccountName: caller.User.Username,
+	})
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in generating TOTP secret", zap.Error(err))
+		return domain.TwoFactor{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	twoFactor, err := a.twoFactors.CreateTwoFactor(ctx, domain.TwoFactor{
+		UserID: caller.User.ID,
+		Secret: key.Secret(),
+	})
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in enrolling two-factor", zap.Error(err))
+		return domain.TwoFactor{}, err
+	}
+	twoFactor.URI = key.URL()
+	return twoFactor, nil
+}
+
+// EnableTwoFactor turns two-factor authentication on once twoFactor.Code confirms the
+// enrolled secret, and returns the recovery codes. They are shown this one time only.
+func (a *AuthUseCase) EnableTwoFactor(ctx context.Context, twoFactor domain.TwoFactor) (domain.TwoFactor, error) {
+	caller, err := a.verifyCaller(ctx)
+	if err != nil {
+		return domain.TwoFactor{}, err
+	}
+
+	stored, err := a.twoFactors.GetTwoFactor(ctx, domain.TwoFactor{UserID: caller.User.ID})
+	if errors.IsSvcError(err, errors.ErrorNotFound) {
+		return domain.TwoFactor{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("two-factor authentication must be enrolled first"))
+	}
+	if err != nil {
+		return domain.TwoFactor{}, err
+	}
+	if stored.Enabled {
+		return domain.TwoFactor{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("two-factor authentication is already enabled"))
+	}
+
+	// only a TOTP code proves the authenticator app got the secret
+	if err := a.countAttempt(ctx, caller.User); err != nil {
+		return domain.TwoFactor{}, err
+	}
+	step, ok := validateTOTP(strings.TrimSpace(twoFactor.Code), stored.Secret, time.Now())
+	if !ok {
+		return domain.TwoFactor{}, errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("invalid two-factor code"))
+	}
+	if err := a.useTimeStep(ctx, stored, step); err != nil {
+		return domain.TwoFactor{}, err
+	}
+	a.resetAttempts(ctx, caller.User)
+
+	recoveryCodes := make([]string, a.config.MFA.RecoveryCodes)
+	codes := make([]domain.RecoveryCode, a.config.MFA.RecoveryCodes)
+	for i := range recoveryCodes {
+		first, err := randomHex(4)
+		if err != nil {
+			return domain.TwoFactor{}, errors.NewError(errors.ErrorInternal, err)
+		}
+		second, err := randomHex(4)
+		if err != nil {
+			return domain.TwoFactor{}, errors.NewError(errors.ErrorInternal, err)
+		}
+		recoveryCodes[i] = first + "-" + second
+
+		codeHash, err := hash.HashedPassword(recoveryCodes[i], a.logger)
+		if err != nil {
+			return domain.TwoFactor{}, errors.NewError(errors.ErrorInternal, err)
+		}
+		codes[i] = domain.RecoveryCode{UserID: caller.User.ID, Hash: codeHash}
+	}
+
+	if err := a.twoFactors.EnableTwoFactor(ctx, stored, codes); err != nil {
+		a.logger.Ctx(ctx).Error("error in enabling two-factor", zap.Error(err))
+		return domain.TwoFactor{}, err
+	}
+
+	stored.Enabled = true
+	stored.EnabledAt = time.Now()
+	stored.RecoveryCodes = recoveryCodes
+	return stored, nil
+}
+
+// DisableTwoFactor turns two-factor authentication off for the caller, who proves having
+// the second factor with a TOTP or recovery code.
+func (a *AuthUseCase) DisableTwoFactor(ctx context.Context, twoFactor domain.TwoFactor) error {
+	caller, err := a.verifyCaller(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored, err := a.twoFactors.GetTwoFactor(ctx, domain.TwoFactor{UserID: caller.User.ID})
+	if err != nil {
+		return err
+	}
+	if !stored.Enabled {
+		return errors.NewError(errors.ErrorNotFound, fmt.Errorf("two-factor authentication is not enabled"))
+	}
+
+	if err := a.verifyTwoFactorCode(ctx, caller.User, stored, twoFactor.Code); err != nil {
+		return err
+	}
+
+	if err := a.twoFactors.DeleteTwoFactor(ctx, stored); err != nil {
+		a.logger.Ctx(ctx).Error("error in disabling two-factor", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// LoginTwoFactor completes a login that was challenged for the second factor: it answers
+// the challenge auth.MFAToken with auth.MFACode, a TOTP or recovery code, and starts the session.
+func (a *AuthUseCase) LoginTwoFactor(ctx context.Context, auth domain.Auth) (domain.Auth, error) {
+	challenge, err := a.challenges.GetChallenge(ctx, auth)
+	if errors.IsSvcError(err, errors.ErrorNotFound) {
+		return domain.Auth{}, errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("MFA token is invalid or expired"))
+	}
+	if err != nil {
+		return domain.Auth{}, err
+	}
+	logger.SetUserID(ctx, strconv.FormatUint(uint64(challenge.User.ID), 10))
+
+	stored, err := a.twoFactors.GetTwoFactor(ctx, domain.TwoFactor{UserID: challenge.User.ID})
+	if err != nil && !errors.IsSvcError(err, errors.ErrorNotFound) {
+		return domain.Auth{}, err
+	}
+	// two-factor authentication may have been disabled since the password was checked
+	if stored.Enabled {
+		if err := a.verifyTwoFactorCode(ctx, challenge.User, stored, auth.MFACode); err != nil {
+			return domain.Auth{}, err
+		}
+	}
+
+	// a challenge is answered once, by the first of two racing requests
+	if err := a.challenges.DeleteChallenge(ctx, challenge); err != nil {
+		if errors.IsSvcError(err, errors.ErrorNotFound) {
+			return domain.Auth{}, errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("MFA token is invalid or expired"))
+		}
+		return domain.Auth{}, err
+	}
+
+	challenge.Device = auth.Device
+	return a.startSession(ctx, challenge)
+}
+
+// isTwoFactorEnabled reports whether the logins of user ask for a second factor.
+func (a *AuthUseCase) isTwoFactorEnabled(ctx context.Context, user domain.User) (bool, error) {
+	twoFactor, err := a.twoFactors.GetTwoFactor(ctx, domain.TwoFactor{UserID: user.ID})
+	if errors.IsSvcError(err, errors.ErrorNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting two-factor", zap.Error(err))
+		return false, err
+	}
+	return twoFactor.Enabled, nil
+}
+
+// createMFAChallenge returns the short-lived MFA token a login of user continues with.
+func (a *AuthUseCase) createMFAChallenge(ctx context.Context, user domain.User) (domain.Auth, error) {
+	token, err := randomHex(32)
+	if err != nil {
+		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	auth := domain.Auth{
+		MFAToken:          token,
+		MFATokenExpiresAt: time.Now().Add(a.config.MFA.ChallengeTTL),
+		User: domain.User{
+			ID:       user.ID,
+			Username: user.Username,
+			Email:    user.Email,
+			Role:     user.Role,
+		},
+	}
+	if err := a.challenges.CreateChallenge(ctx, auth); err != nil {
+		a.logger.Ctx(ctx).Error("error in creating MFA challenge", zap.Error(err))
+		return domain.Auth{}, err
+	}
+	return auth, nil
+}
+
+// verifyTwoFactorCode checks a TOTP code, which is accepted once, or else a recovery code
+// which is then used up.
+// Every user may only try a few codes within the attempt window.
+func (a *AuthUseCase) verifyTwoFactorCode(ctx context.Context, user domain.User, twoFactor domain.TwoFactor, code string) error {
+	if err := a.countAttempt(ctx, user); err != nil {
+		return err
+	}
+
+	code = strings.ToLower(strings.TrimSpace(code))
+	if step, ok := validateTOTP(code, twoFactor.Secret, time.Now()); ok {
+		if err := a.useTimeStep(ctx, twoFactor, step); err != nil {
+			return err
+		}
+		a.resetAttempts(ctx, user)
+		return nil
+	}
+
+	recoveryCodes, err := a.twoFactors.GetRecoveryCodes(ctx, twoFactor)
+	if err != nil {
+		return err
+	}
+	for _, recoveryCode := range recoveryCodes {
+		if !hash.MatchesHash(recoveryCode.Hash, code) {
+			continue
+		}
+		if err := a.twoFactors.UseRecoveryCode(ctx, recoveryCode); err != nil {
+			if errors.IsSvcError(err, errors.ErrorConflict) {
+				break
+			}
+			return err
+		}
+		a.logger.Ctx(ctx).Info("recovery code used", zap.Uint("user_id", user.ID), zap.Int("recovery_codes_left", len(recoveryCodes)-1))
+		a.resetAttempts(ctx, user)
+		return nil
+	}
+
+	return errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("invalid two-factor code"))
+}
+
+// useTimeStep records the time step of an accepted TOTP code of twoFactor. A code of that
+// or an earlier step is invalid from then on, even within its validity window.
+func (a *AuthUseCase) useTimeStep(ctx context.Context, twoFactor domain.TwoFactor, step int64) error {
+	if err := a.twoFactors.UseTimeStep(ctx, twoFactor, step); err != nil {
+		if errors.IsSvcError(err, errors.ErrorConflict) {
+			return errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("invalid two-factor code"))
+		}
+		return err
+	}
+	return nil
+}
+
+// validateTOTP reports whether code is the TOTP code of secret for the time step of now or
+// one step either side of it, like totp.Validate, and returns the step it matched. Storing
+// the step lets a code be rejected once it or a later one was accepted.
+func validateTOTP(code string, secret string, now time.Time) (int64, bool) {
+	const period = 30
+	opts := totp.ValidateOpts{
+		Period:    period,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	}
+	current := now.Unix() / period
+	for step := current - 1; step <= current+1; step++ {
+		if ok, err := totp.ValidateCustom(code, secret, time.Unix(step*period, 0).UTC(), opts); err == nil && ok {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// countAttempt counts a code tried by user and fails once the user tried too many.
+func (a *AuthUseCase) countAttempt(ctx context.Context, user domain.User) error {
+	attempts, err := a.challenges.CountAttempt(ctx, domain.Auth{User: user}, a.config.MFA.AttemptWindow)
+	if err != nil {
+		return err
+	}
+	if attempts > a.config.MFA.MaxAttempts {
+		a.logger.Ctx(ctx).Warn("too many two-factor attempts", zap.Uint("user_id", user.ID))
+		return errors.NewError(errors.ErrorTooManyRequests, fmt.Errorf("too many two-factor attempts, try again later"))
+	}
+	return nil
+}
+
+// resetAttempts forgets the codes tried by user after a code was accepted. A failure is
+// only logged, the attempts then expire with the window.
+func (a *AuthUseCase) resetAttempts(ctx context.Context, user domain.User) {
+	if err := a.challenges.ResetAttempts(ctx, domain.Auth{User: user}); err != nil {
+		a.logger.Ctx(ctx).Error("error in resetting two-factor attempts", zap.Error(err))
+	}
+}
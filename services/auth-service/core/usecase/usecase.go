@@ -3,29 +3,51 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/ports"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/grpc/service/user"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/authctx"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/configs"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/hash"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/jwt"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type AuthUseCase struct {
 	authRepository ports.IAuthRepository
+	botRepository  ports.IBotRepository
+	denyList       ports.ISessionDenyList
+	twoFactors     ports.ITwoFactorRepository
+	challenges     ports.IMFAChallengeRepository
+	oauthClients   ports.IOAuthClientRepository
+	oauthCodes     ports.IOAuthCodeRepository
+	sessions       *sessionCache
 	userService    *user.UsersService
+	keys           *jwt.KeySet
 	logger         *logger.Logger
 	config         *configs.Config
 }
 
-func NewAuthUseCase(authRepository ports.IAuthRepository, userService *user.UsersService, logger *logger.Logger, config *configs.Config) *AuthUseCase {
+func NewAuthUseCase(authRepository ports.IAuthRepository, botRepository ports.IBotRepository, denyList ports.ISessionDenyList, twoFactors ports.ITwoFactorRepository, challenges ports.IMFAChallengeRepository, oauthClients ports.IOAuthClientRepository, oauthCodes ports.IOAuthCodeRepository, userService *user.UsersService, keys *jwt.KeySet, logger *logger.Logger, config *configs.Config) *AuthUseCase {
 	return &AuthUseCase{
 		authRepository: authRepository,
+		botRepository:  botRepository,
+		denyList:       denyList,
+		twoFactors:     twoFactors,
+		challenges:     challenges,
+		oauthClients:   oauthClients,
+		oauthCodes:     oauthCodes,
+		sessions:       newSessionCache(config.Session.CacheTTL),
 		userService:    userService,
+		keys:           keys,
 		logger:         logger,
 		config:         config,
 	}
@@ -34,7 +56,7 @@ func NewAuthUseCase(authRepository ports.IAuthRepository, userService *user.User
 func (a *AuthUseCase) Login(ctx context.Context, auth domain.Auth) (domain.Auth, error) {
 	foundAuth, err := a.userService.GetUserByUsername(ctx, auth)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("error in getting user by username: %v", err))
+		a.logger.Ctx(ctx).Error("error in getting user by username", zap.Error(err))
 		return domain.Auth{}, err
 	}
 
@@ -46,40 +68,67 @@ func (a *AuthUseCase) Login(ctx context.Context, auth domain.Auth) (domain.Auth,
 	if !ok {
 		return domain.Auth{}, errors.NewError(errors.ErrorUnauthorized, err)
 	}
+	logger.SetUserID(ctx, strconv.FormatUint(uint64(foundAuth.User.ID), 10))
 
+	// with two-factor authentication the login waits for the second factor
+	enabled, err := a.isTwoFactorEnabled(ctx, foundAuth.User)
+	if err != nil {
+		return domain.Auth{}, err
+	}
+	if enabled {
+		return a.createMFAChallenge(ctx, foundAuth.User)
+	}
+
+	auth.User = foundAuth.User
+	return a.startSession(ctx, auth)
+}
+
+// startSession creates the tokens of a new session of auth.User on auth.Device. The
+// session of an OAuth client keeps the client ID and scope of auth.Claims.
+func (a *AuthUseCase) startSession(ctx context.Context, auth domain.Auth) (domain.Auth, error) {
 	// create access token
 	auth.Claims = domain.Claims{
-		ID:       foundAuth.User.ID,
-		Username: foundAuth.User.Username,
-		Email:    foundAuth.User.Email,
-		Role:     foundAuth.User.Role,
+		ID:       auth.User.ID,
+		Username: auth.User.Username,
+		Email:    auth.User.Email,
+		Role:     auth.User.Role,
 		Duration: a.config.JWT.AccessTokenDuration,
+		ClientID: auth.Claims.ClientID,
+		Scope:    auth.Claims.Scope,
 	}
 
 	sessionID := uuid.New().String()
 	auth.ID = sessionID
 	auth.Claims.SessionID = sessionID
 
-	auth, err = a.CreateToken(ctx, auth)
+	// the session starts on the device logging in
+	device := auth.Device
+	device.CreatedAt = time.Now()
+	device.LastUsedAt = device.CreatedAt
+
+	auth, err := a.CreateToken(ctx, auth)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("error in creating access token: %v", err))
+		a.logger.Ctx(ctx).Error("error in creating access token", zap.Error(err))
 		return domain.Auth{}, err
 	}
 
 	// create refresh token
+	auth.Claims.Type = jwt.TokenTypeRefresh
 	auth.Claims.Duration = a.config.JWT.RefreshTokenDuration
 	refreshToken, err := a.CreateToken(ctx, auth)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("error in creating refresh token: %v", err))
+		a.logger.Ctx(ctx).Error("error in creating refresh token", zap.Error(err))
 		return domain.Auth{}, err
 	}
 
-	// save refresh token
+	// save refresh token as the first of its family
+	auth.FamilyID = sessionID
+	auth.Device = device
 	auth.RefreshToken = refreshToken.AccessToken
 	auth.RefreshTokenExpiresAt = refreshToken.AccessTokenExpiresAt
 	auth, err = a.authRepository.CreateToken(ctx, auth)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("error in saving refresh token: %v", err))
+		a.logger.Ctx(ctx).Error("error in saving refresh token", zap.Error(err))
 		return domain.Auth{}, err
 	}
 
@@ -88,10 +137,10 @@ func (a *AuthUseCase) Login(ctx context.Context, auth domain.Auth) (domain.Auth,
 
 func (a *AuthUseCase) Logout(ctx context.Context, auth domain.Auth) error {
 	// get token from context
-	contextToken, ok := ctx.Value("token").(string)
+	contextToken, ok := authctx.Token(ctx)
 	if !ok {
 		err := fmt.Errorf("error in getting token from context")
-		a.logger.Error(err.Error())
+		a.logger.Ctx(ctx).Error(err.Error())
 		return errors.NewError(errors.ErrorBadRequest, err)
 	}
 
@@ -99,51 +148,143 @@ func (a *AuthUseCase) Logout(ctx context.Context, auth domain.Auth) error {
 	auth.AccessToken = contextToken
 	auth, err := a.VerifyToken(ctx, auth)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("error in verifying token: %v", err))
+		a.logger.Ctx(ctx).Error("error in verifying token", zap.Error(err))
 		return err
 	}
 
 	// delete token by finding it via RefreshToken in the database
 	err = a.authRepository.DeleteToken(ctx, auth)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("error in deleting token: %v", err))
+		a.logger.Ctx(ctx).Error("error in deleting token", zap.Error(err))
 		return err
 	}
+	a.endSession(ctx, auth)
 
 	return nil
 }
 
 func (a *AuthUseCase) RefreshToken(ctx context.Context, auth domain.Auth) (domain.Auth, error) {
 	// get refresh token from database
-	auth, err := a.authRepository.GetTokenByRefreshToken(ctx, auth)
+	current, err := a.authRepository.GetTokenByRefreshToken(ctx, auth)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("error in getting token from database: %v", err))
+		a.logger.Ctx(ctx).Error("error in getting token from database", zap.Error(err))
 		return domain.Auth{}, err
 	}
+	logger.SetUserID(ctx, strconv.FormatUint(uint64(current.User.ID), 10))
 
-	// check refresh token is expired
-	if auth.RefreshTokenIsRevoked {
+	// the refresh tokens of OAuth clients are refreshed with the client credentials
+	if current.Claims.ClientID != "" {
+		err := fmt.Errorf("refresh token belongs to an OAuth client")
+		a.logger.Ctx(ctx).Error(err.Error())
+		return domain.Auth{}, errors.NewError(errors.ErrorUnauthorized, err)
+	}
+
+	return a.refreshSession(ctx, current, auth.Device)
+}
+
+// refreshSession rotates the refresh token current, after checking that it may still be
+// used, and creates a new access token. The session is now used from device.
+func (a *AuthUseCase) refreshSession(ctx context.Context, current domain.Auth, device domain.Device) (domain.Auth, error) {
+	// check refresh token is revoked or expired
+	if current.RefreshTokenIsRevoked {
 		err := fmt.Errorf("refresh token is revoked")
-		a.logger.Error(err.Error())
+		a.logger.Ctx(ctx).Error(err.Error())
+		return domain.Auth{}, errors.NewError(errors.ErrorUnauthorized, err)
+	}
+	if time.Now().After(current.RefreshTokenExpiresAt) {
+		err := fmt.Errorf("refresh token is expired")
+		a.logger.Ctx(ctx).Error(err.Error())
 		return domain.Auth{}, errors.NewError(errors.ErrorUnauthorized, err)
 	}
 
+	// a refresh token that was already rotated is only presented again when it leaked
+	if current.ReplacedBy != "" {
+		return domain.Auth{}, a.revokeReusedToken(ctx, current)
+	}
+
+	// the session keeps its start, its device is the one refreshing it
+	device.CreatedAt = current.Device.CreatedAt
+	device.LastUsedAt = time.Now()
+
+	// the token row only keeps the user ID, the other claims are those the user has now
+	found, err := a.userService.GetUserByID(ctx, current)
+	if status.Code(err) == codes.NotFound {
+		return domain.Auth{}, errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("user of the refresh token no longer exists"))
+	}
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in getting user by id", zap.Error(err))
+		return domain.Auth{}, err
+	}
+	current.User = found.User
+	current.Claims.Username = found.User.Username
+	current.Claims.Email = found.User.Email
+	current.Claims.Role = found.User.Role
+
 	// create access token
-	auth.Claims.Duration = a.config.JWT.AccessTokenDuration
-	auth, err = a.CreateToken(ctx, auth)
+	current.Claims.Duration = a.config.JWT.AccessTokenDuration
+	auth, err := a.CreateToken(ctx, current)
+	if err != nil {
+		return domain.Auth{}, err
+	}
+
+	// create the refresh token replacing the presented one; its JWT ID is the token ID,
+	// which keeps the tokens of a family distinct
+	next := current
+	next.ID = uuid.New().String()
+	next.Device = device
+	next.Claims.SessionID = next.ID
+	next.Claims.Type = jwt.TokenTypeRefresh
+	next.Claims.Duration = a.config.JWT.RefreshTokenDuration
+	refreshToken, err := a.CreateToken(ctx, next)
+	if err != nil {
+		a.logger.Ctx(ctx).Error("error in creating refresh token", zap.Error(err))
+		return domain.Auth{}, err
+	}
+	next.RefreshToken = refreshToken.AccessToken
+	next.RefreshTokenExpiresAt = refreshToken.AccessTokenExpiresAt
+	next.Claims.SessionID = current.FamilyID
+
+	// rotate refresh token; a conflict means the token was used by a concurrent refresh
+	_, err = a.authRepository.RotateToken(ctx, current, next)
+	if errors.IsSvcError(err, errors.ErrorConflict) {
+		return domain.Auth{}, a.revokeReusedToken(ctx, current)
+	}
 	if err != nil {
+		a.logger.Ctx(ctx).Error("error in rotating refresh token", zap.Error(err))
 		return domain.Auth{}, err
 	}
 
+	auth.RefreshToken = next.RefreshToken
+	auth.RefreshTokenExpiresAt = next.RefreshTokenExpiresAt
 	return auth, nil
 }
 
+// revokeReusedToken revokes every token of the family of a refresh token that was
+// presented after it had been rotated, ending all sessions from that login, and logs
+// the event for review.
+func (a *AuthUseCase) revokeReusedToken(ctx context.Context, auth domain.Auth) error {
+	a.logger.Ctx(ctx).Warn("refresh token reuse detected, revoking its family",
+		zap.String("event", "refresh_token_reuse"),
+		zap.String("family_id", auth.FamilyID),
+		zap.String("token_id", auth.ID),
+		zap.String("replaced_by", auth.ReplacedBy),
+		zap.Uint("user_id", auth.User.ID),
+	)
+
+	if err := a.authRepository.RevokeToken(ctx, auth); err != nil {
+		a.logger.Ctx(ctx).Error("error in revoking token family", zap.Error(err))
+		return err
+	}
+	a.endSession(ctx, auth)
+	return errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("refresh token was already used"))
+}
+
 func (a *AuthUseCase) RevokeToken(ctx context.Context, auth domain.Auth) error {
 	// get token from context
-	contextToken, ok := ctx.Value("token").(string)
+	contextToken, ok := authctx.Token(ctx)
 	if !ok {
 		err := fmt.Errorf("error in getting token from context")
-		a.logger.Error(err.Error())
+		a.logger.Ctx(ctx).Error(err.Error())
 		return errors.NewError(errors.ErrorBadRequest, err)
 	}
 
@@ -151,37 +292,38 @@ func (a *AuthUseCase) RevokeToken(ctx context.Context, auth domain.Auth) error {
 	auth.AccessToken = contextToken
 	accessTokenClaims, err := a.VerifyToken(ctx, auth)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("error in verifying token: %v", err))
+		a.logger.Ctx(ctx).Error("error in verifying token", zap.Error(err))
 		return err
 	}
 
 	// get refresh token from database
 	auth, err = a.authRepository.GetTokenByRefreshToken(ctx, auth)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("error in getting token from database: %v", err))
+		a.logger.Ctx(ctx).Error("error in getting token from database", zap.Error(err))
 		return err
 	}
 
 	// compare access token and refresh token are for the same person
 	if accessTokenClaims.Claims.ID != auth.Claims.ID {
 		err := fmt.Errorf("access token and refresh token are not for the same person")
-		a.logger.Error(err.Error())
+		a.logger.Ctx(ctx).Error(err.Error())
 		return errors.NewError(errors.ErrorForbidden, err)
 	}
 
 	// check refresh token is expired
 	if auth.RefreshTokenIsRevoked {
 		err := fmt.Errorf("refresh token is revoked")
-		a.logger.Error(err.Error())
+		a.logger.Ctx(ctx).Error(err.Error())
 		return errors.NewError(errors.ErrorUnauthorized, err)
 	}
 
 	// revoke access token
 	err = a.authRepository.RevokeToken(ctx, auth)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("error in revoking token: %v", err))
+		a.logger.Ctx(ctx).Error("error in revoking token", zap.Error(err))
 		return err
 	}
+	a.endSession(ctx, auth)
 
 	return nil
 }
@@ -189,7 +331,7 @@ func (a *AuthUseCase) RevokeToken(ctx context.Context, auth domain.Auth) error {
 func (a *AuthUseCase) HashPassword(ctx context.Context, auth domain.Auth) (domain.Auth, error) {
 	hashedPassword, err := hash.HashedPassword(auth.User.Password, a.logger)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("error in hashing password: %v", err))
+		a.logger.Ctx(ctx).Error("error in hashing password", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
 	}
 	auth.User.Password = hashedPassword
@@ -197,23 +339,29 @@ func (a *AuthUseCase) HashPassword(ctx context.Context, auth domain.Auth) (domai
 }
 
 func (a *AuthUseCase) CreateToken(ctx context.Context, auth domain.Auth) (domain.Auth, error) {
-	secretKey := a.config.JWT.SecretKey
+	tokenType := jwt.TokenTypeAccess
+	if auth.Claims.Type != "" {
+		tokenType = auth.Claims.Type
+	}
 	userClaims := jwt.UserClaims{
+		Type:      tokenType,
 		ID:        auth.Claims.ID,
 		SessionID: auth.Claims.SessionID,
 		Username:  auth.Claims.Username,
 		Email:     auth.Claims.Email,
 		Role:      auth.Claims.Role,
 		Duration:  auth.Claims.Duration,
+		ClientID:  auth.Claims.ClientID,
+		Scope:     auth.Claims.Scope,
 	}
 	claims, err := jwt.NewUserClaims(userClaims)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("error in creating token claims: %v", err))
+		a.logger.Ctx(ctx).Error("error in creating token claims", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
 	}
-	accessToken, err := jwt.CreateToken(secretKey, claims)
+	accessToken, err := a.keys.CreateToken(claims)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("error in creating token claims: %v", err))
+		a.logger.Ctx(ctx).Error("error in creating token claims", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
 	}
 	auth = domain.Auth{
@@ -235,20 +383,23 @@ func (a *AuthUseCase) CreateToken(ctx context.Context, auth domain.Auth) (domain
 			SessionID: claims.RegisteredClaims.ID,
 			IssuedAt:  claims.RegisteredClaims.IssuedAt.Time,
 			ExpiresAt: claims.RegisteredClaims.ExpiresAt.Time,
+			ClientID:  claims.ClientID,
+			Scope:     claims.Scope,
 		},
 	}
 	return auth, nil
 }
 
 func (a *AuthUseCase) VerifyToken(ctx context.Context, auth domain.Auth) (domain.Auth, error) {
-	secretKey := a.config.JWT.SecretKey
-	claims, err := jwt.VerifyToken(auth.AccessToken, secretKey)
+	claims, err := a.keys.VerifyToken(auth.AccessToken)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("error in verifying token: %v", err))
+		a.logger.Ctx(ctx).Error("error in verifying token", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorUnauthorized, err)
 	}
+	logger.SetUserID(ctx, strconv.FormatUint(uint64(claims.ID), 10))
 	auth = domain.Auth{
 		ID:                   claims.SessionID,
+		FamilyID:             claims.SessionID,
 		AccessToken:          auth.AccessToken,
 		AccessTokenExpiresAt: claims.RegisteredClaims.ExpiresAt.Time,
 		User: domain.User{
@@ -266,7 +417,20 @@ func (a *AuthUseCase) VerifyToken(ctx context.Context, auth domain.Auth) (domain
 			SessionID: claims.RegisteredClaims.ID,
 			IssuedAt:  claims.RegisteredClaims.IssuedAt.Time,
 			ExpiresAt: claims.RegisteredClaims.ExpiresAt.Time,
+			ClientID:  claims.ClientID,
+			Scope:     claims.Scope,
 		},
 	}
+
+	// the signature alone does not tell whether the session was ended since
+	if err := a.checkSession(ctx, auth); err != nil {
+		a.logger.Ctx(ctx).Error("error in checking session", zap.Error(err))
+		return domain.Auth{}, err
+	}
 	return auth, nil
 }
+
+// JWKS returns the public keys the tokens are verified with.
+func (a *AuthUseCase) JWKS(ctx context.Context) jwt.JWKS {
+	return a.keys.JWKS()
+}
@@ -31,5 +31,7 @@ func MapDomainAuthToProtoVerifyTokenRes(res domain.Auth) *auth.VerifyTokenRes {
 		Username: res.User.Username,
 		Email:    res.User.Email,
 		Role:     res.User.Role,
+		ClientId: res.Claims.ClientID,
+		Scope:    res.Claims.Scope,
 	}
 }
@@ -7,10 +7,15 @@ import (
 
 	grpchandler "github.com/Ali-Gorgani/chat-room-project/services/auth-service/grpc/grpc-handler"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/grpc/pkg/auth"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/middleware"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/health"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/metrics"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type GRPCServer struct {
@@ -19,9 +24,14 @@ type GRPCServer struct {
 	config *configs.Config
 }
 
-func NewGRPCServer(grpcHandler *grpchandler.AuthHandler, logger *logger.Logger, config *configs.Config) *GRPCServer {
-	srv := grpc.NewServer()
+func NewGRPCServer(grpcHandler *grpchandler.AuthHandler, checker *health.Checker, logger *logger.Logger, config *configs.Config) *GRPCServer {
+	srv := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor(), middleware.RequestIDUnaryInterceptor(logger)),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor(), middleware.RequestIDStreamInterceptor(logger)),
+	)
 	auth.RegisterAuthServiceServer(srv, grpcHandler)
+	grpc_health_v1.RegisterHealthServer(srv, checker.Server())
 
 	return &GRPCServer{
 		server: srv,
@@ -20,6 +20,8 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	UsersService_GetUserByUsername_FullMethodName = "/user.UsersService/GetUserByUsername"
+	UsersService_GetUserByEmail_FullMethodName    = "/user.UsersService/GetUserByEmail"
+	UsersService_GetUserByID_FullMethodName       = "/user.UsersService/GetUserByID"
 )
 
 // UsersServiceClient is the client API for UsersService service.
@@ -27,6 +29,8 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UsersServiceClient interface {
 	GetUserByUsername(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*UserRes, error)
+	GetUserByEmail(ctx context.Context, in *GetUserByEmailReq, opts ...grpc.CallOption) (*UserRes, error)
+	GetUserByID(ctx context.Context, in *GetUserByIDReq, opts ...grpc.CallOption) (*UserRes, error)
 }
 
 type usersServiceClient struct {
@@ -47,11 +51,33 @@ func (c *usersServiceClient) GetUserByUsername(ctx context.Context, in *GetUserR
 	return out, nil
 }
 
+func (c *usersServiceClient) GetUserByEmail(ctx context.Context, in *GetUserByEmailReq, opts ...grpc.CallOption) (*UserRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserRes)
+	err := c.cc.Invoke(ctx, UsersService_GetUserByEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) GetUserByID(ctx context.Context, in *GetUserByIDReq, opts ...grpc.CallOption) (*UserRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserRes)
+	err := c.cc.Invoke(ctx, UsersService_GetUserByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsersServiceServer is the server API for UsersService service.
 // All implementations must embed UnimplementedUsersServiceServer
 // for forward compatibility.
 type UsersServiceServer interface {
 	GetUserByUsername(context.Context, *GetUserReq) (*UserRes, error)
+	GetUserByEmail(context.Context, *GetUserByEmailReq) (*UserRes, error)
+	GetUserByID(context.Context, *GetUserByIDReq) (*UserRes, error)
 	mustEmbedUnimplementedUsersServiceServer()
 }
 
@@ -65,6 +91,12 @@ type UnimplementedUsersServiceServer struct{}
 func (UnimplementedUsersServiceServer) GetUserByUsername(context.Context, *GetUserReq) (*UserRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserByUsername not implemented")
 }
+func (UnimplementedUsersServiceServer) GetUserByEmail(context.Context, *GetUserByEmailReq) (*UserRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByEmail not implemented")
+}
+func (UnimplementedUsersServiceServer) GetUserByID(context.Context, *GetUserByIDReq) (*UserRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByID not implemented")
+}
 func (UnimplementedUsersServiceServer) mustEmbedUnimplementedUsersServiceServer() {}
 func (UnimplementedUsersServiceServer) testEmbeddedByValue()                      {}
 
@@ -104,6 +136,42 @@ func _UsersService_GetUserByUsername_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersService_GetUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByEmailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetUserByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_GetUserByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetUserByEmail(ctx, req.(*GetUserByEmailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_GetUserByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetUserByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_GetUserByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetUserByID(ctx, req.(*GetUserByIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UsersService_ServiceDesc is the grpc.ServiceDesc for UsersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +183,14 @@ var UsersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserByUsername",
 			Handler:    _UsersService_GetUserByUsername_Handler,
 		},
+		{
+			MethodName: "GetUserByEmail",
+			Handler:    _UsersService_GetUserByEmail_Handler,
+		},
+		{
+			MethodName: "GetUserByID",
+			Handler:    _UsersService_GetUserByID_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
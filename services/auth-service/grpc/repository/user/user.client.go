@@ -7,20 +7,28 @@ import (
 	"time"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/grpc/pkg/user"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/middleware"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/configs"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/metrics"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // Client interface for UserService
 type IClient interface {
 	GetUserByUsername(ctx context.Context, req GetUserReq) (UserRes, error)
+	GetUserByID(ctx context.Context, req GetUserByIDReq) (UserRes, error)
+	CheckHealth(ctx context.Context) error
 }
 
 // Client struct for managing connection
 type Client struct {
 	c      user.UsersServiceClient // gRPC client
+	health grpc_health_v1.HealthClient
 	logger *logger.Logger
 }
 
@@ -31,7 +39,11 @@ func NewClient(logger *logger.Logger, config *configs.Config) (IClient, error) {
 	defer cancel()
 
 	// UserService connection
-	conn, err := grpc.DialContext(ctx, fmt.Sprintf("%s:%d", config.GRPC.UserHost, config.GRPC.UserPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.DialContext(ctx, fmt.Sprintf("%s:%d", config.GRPC.UserHost, config.GRPC.UserPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(metrics.UnaryClientInterceptor(), middleware.RequestIDClientInterceptor()),
+	)
 	if err != nil {
 		log.Fatalf("failed to connect to UserService: %v", err)
 	}
@@ -39,14 +51,36 @@ func NewClient(logger *logger.Logger, config *configs.Config) (IClient, error) {
 
 	return &Client{
 		c:      client,
+		health: grpc_health_v1.NewHealthClient(conn),
 		logger: logger,
 	}, nil
 }
 
+// CheckHealth reports an error unless UsersService is serving.
+func (c *Client) CheckHealth(ctx context.Context) error {
+	res, err := c.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if res.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("UsersService is %s", res.GetStatus())
+	}
+	return nil
+}
+
 func (c *Client) GetUserByUsername(ctx context.Context, req GetUserReq) (UserRes, error) {
 	res, err := c.c.GetUserByUsername(ctx, MapDtoGetUserReqToPbGetUserReq(req))
 	if err != nil {
-		c.logger.Error(fmt.Sprintf("failed to call GetUserByUsername: %v", err))
+		c.logger.Ctx(ctx).Error("failed to call GetUserByUsername", zap.Error(err))
+		return UserRes{}, err
+	}
+	return MapPbGetUserResToDtoGetUserRes(res), nil
+}
+
+func (c *Client) GetUserByID(ctx context.Context, req GetUserByIDReq) (UserRes, error) {
+	res, err := c.c.GetUserByID(ctx, MapDtoGetUserByIDReqToPbGetUserByIDReq(req))
+	if err != nil {
+		c.logger.Ctx(ctx).Error("failed to call GetUserByID", zap.Error(err))
 		return UserRes{}, err
 	}
 	return MapPbGetUserResToDtoGetUserRes(res), nil
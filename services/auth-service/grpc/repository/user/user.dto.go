@@ -4,6 +4,10 @@ type GetUserReq struct {
 	Username string
 }
 
+type GetUserByIDReq struct {
+	ID uint
+}
+
 type UserRes struct {
 	ID       uint
 	Username string
@@ -8,6 +8,12 @@ func MapDtoGetUserReqToPbGetUserReq(req GetUserReq) *user.GetUserReq {
 	}
 }
 
+func MapDtoGetUserByIDReqToPbGetUserByIDReq(req GetUserByIDReq) *user.GetUserByIDReq {
+	return &user.GetUserByIDReq{
+		Id: int32(req.ID),
+	}
+}
+
 func MapPbGetUserResToDtoGetUserRes(res *user.UserRes) UserRes {
 	return UserRes{
 		ID:       uint(res.Id),
@@ -11,6 +11,12 @@ func MapDomainUserToDtoGetUserReq(req domain.Auth) user.GetUserReq {
 	}
 }
 
+func MapDomainUserToDtoGetUserByIDReq(req domain.Auth) user.GetUserByIDReq {
+	return user.GetUserByIDReq{
+		ID: req.User.ID,
+	}
+}
+
 func MapDtoUserResToDomainUser(res user.UserRes) domain.Auth {
 	return domain.Auth{
 		User: domain.User{
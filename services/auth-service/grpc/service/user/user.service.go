@@ -25,3 +25,12 @@ func (s *UsersService) GetUserByUsername(ctx context.Context, req domain.Auth) (
 	}
 	return MapDtoUserResToDomainUser(dtoRes), nil
 }
+
+func (s *UsersService) GetUserByID(ctx context.Context, req domain.Auth) (domain.Auth, error) {
+	dtoReq := MapDomainUserToDtoGetUserByIDReq(req)
+	dtoRes, err := s.c.GetUserByID(ctx, dtoReq)
+	if err != nil {
+		return domain.Auth{}, err
+	}
+	return MapDtoUserResToDomainUser(dtoRes), nil
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreateBot godoc
+// @Summary Create a bot
+// @Description Register a bot and issue its client credentials. The client secret is only returned once.
+// @Tags bot
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param createBotRequest body CreateBotRequest true "Create bot request body"
+// @Success 201 {object} CreateBotResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /create-bot [post]
+func (h *AuthHandler) CreateBot(ctx *fiber.Ctx) error {
+	var createBotRequest CreateBotRequest
+	if err := ctx.BodyParser(&createBotRequest); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	bot, err := h.usecase.CreateBot(ctx.UserContext(), CreateBotRequestToDomainBot(createBotRequest))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainBotToCreateBotResponse(bot)
+
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
+
+// GetBots godoc
+// @Summary Get bots
+// @Description Retrieve all registered bots
+// @Tags bot
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} BotResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /get-bots [get]
+func (h *AuthHandler) GetBots(ctx *fiber.Ctx) error {
+	bots, err := h.usecase.GetBots(ctx.UserContext())
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainBotsToBotResponses(bots)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// RevokeBot godoc
+// @Summary Revoke a bot
+// @Description Revoke the client credentials of a bot so it can't get new access tokens, and end the access tokens it already has
+// @Tags bot
+// @Security BearerAuth
+// @Param botId path int true "Bot ID"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /revoke-bot/{botId} [post]
+func (h *AuthHandler) RevokeBot(ctx *fiber.Ctx) error {
+	botID, err := strconv.Atoi(ctx.Params("botId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	err = h.usecase.RevokeBot(ctx.UserContext(), domain.Bot{ID: botID})
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	return ctx.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+// BotToken godoc
+// @Summary Get a bot access token
+// @Description Exchange the client credentials of a bot for an access token
+// @Tags bot
+// @Accept json
+// @Produce json
+// @Param botTokenRequest body BotTokenRequest true "Bot token request body"
+// @Success 200 {object} BotTokenResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /bot-token [post]
+func (h *AuthHandler) BotToken(ctx *fiber.Ctx) error {
+	var botTokenRequest BotTokenRequest
+	if err := ctx.BodyParser(&botTokenRequest); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	auth, err := h.usecase.BotToken(ctx.UserContext(), BotTokenRequestToDomainBot(botTokenRequest))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainAuthToBotTokenResponse(auth)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
@@ -20,6 +22,11 @@ type LoginResponse struct {
 	RefreshToken          string       `json:"refresh_token"`
 	RefreshTokenExpiresAt time.Time    `json:"refresh_token_expires_at"`
 	User                  UserResponse `json:"user"`
+	// MFARequired is set instead of the tokens when the user has two-factor authentication
+	// enabled; the login continues at /login-2fa with MFAToken.
+	MFARequired       bool       `json:"mfa_required,omitempty"`
+	MFAToken          string     `json:"mfa_token,omitempty"`
+	MFATokenExpiresAt *time.Time `json:"mfa_token_expires_at,omitempty"`
 }
 
 type LoginRequest struct {
@@ -31,9 +38,12 @@ type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+// RefreshTokenResponse carries the rotated refresh token, which replaces the one sent.
 type RefreshTokenResponse struct {
-	AccessToken          string    `json:"access_token"`
-	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
 }
 
 type RevokeTokenRequest struct {
@@ -50,6 +60,17 @@ func LoginRequestToDomainAuth(req LoginRequest) domain.Auth {
 }
 
 func DomainAuthToLoginResponse(auth domain.Auth) LoginResponse {
+	if auth.MFAToken != "" {
+		return LoginResponse{
+			User: UserResponse{
+				ID:       auth.User.ID,
+				Username: auth.User.Username,
+			},
+			MFARequired:       true,
+			MFAToken:          auth.MFAToken,
+			MFATokenExpiresAt: &auth.MFATokenExpiresAt,
+		}
+	}
 	return LoginResponse{
 		ID:                    auth.ID,
 		AccessToken:           auth.AccessToken,
@@ -73,8 +94,10 @@ func RefreshTokenRequestToDomainAuth(req RefreshTokenRequest) domain.Auth {
 
 func DomainAuthToRefreshTokenResponse(auth domain.Auth) RefreshTokenResponse {
 	return RefreshTokenResponse{
-		AccessToken:          auth.AccessToken,
-		AccessTokenExpiresAt: auth.AccessTokenExpiresAt,
+		AccessToken:           auth.AccessToken,
+		AccessTokenExpiresAt:  auth.AccessTokenExpiresAt,
+		RefreshToken:          auth.RefreshToken,
+		RefreshTokenExpiresAt: auth.RefreshTokenExpiresAt,
 	}
 }
 
@@ -83,3 +106,351 @@ func RevokeTokenRequestToDomainAuth(req RevokeTokenRequest) domain.Auth {
 		RefreshToken: req.RefreshToken,
 	}
 }
+
+// SessionResponse is an active session; ID is the session ID carried by its access tokens
+// and Current marks the session of the caller.
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	UserID     uint      `json:"user_id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+	ClientID   string    `json:"client_id,omitempty"`
+}
+
+func DomainAuthToSessionResponse(auth domain.Auth) SessionResponse {
+	return SessionResponse{
+		ID:         auth.FamilyID,
+		UserID:     auth.User.ID,
+		UserAgent:  auth.Device.UserAgent,
+		IPAddress:  auth.Device.IPAddress,
+		CreatedAt:  auth.Device.CreatedAt,
+		LastUsedAt: auth.Device.LastUsedAt,
+		ExpiresAt:  auth.RefreshTokenExpiresAt,
+		Current:    auth.IsCurrent,
+		ClientID:   auth.Claims.ClientID,
+	}
+}
+
+func DomainAuthsToSessionResponses(auths []domain.Auth) []SessionResponse {
+	res := []SessionResponse{}
+	for _, auth := range auths {
+		res = append(res, DomainAuthToSessionResponse(auth))
+	}
+	return res
+}
+
+type EnrollTwoFactorResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code"`
+}
+
+// EnableTwoFactorResponse carries the recovery codes; each logs in once in place of a TOTP code.
+type EnableTwoFactorResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type LoginTwoFactorRequest struct {
+	MFAToken string `json:"mfa_token"`
+	Code     string `json:"code"`
+}
+
+func DomainTwoFactorToEnrollTwoFactorResponse(twoFactor domain.TwoFactor) EnrollTwoFactorResponse {
+	return EnrollTwoFactorResponse{
+		Secret: twoFactor.Secret,
+		URI:    twoFactor.URI,
+	}
+}
+
+func TwoFactorCodeRequestToDomainTwoFactor(req TwoFactorCodeRequest) domain.TwoFactor {
+	return domain.TwoFactor{
+		Code: req.Code,
+	}
+}
+
+func DomainTwoFactorToEnableTwoFactorResponse(twoFactor domain.TwoFactor) EnableTwoFactorResponse {
+	return EnableTwoFactorResponse{
+		RecoveryCodes: twoFactor.RecoveryCodes,
+	}
+}
+
+func LoginTwoFactorRequestToDomainAuth(req LoginTwoFactorRequest) domain.Auth {
+	return domain.Auth{
+		MFAToken: req.MFAToken,
+		MFACode:  req.Code,
+	}
+}
+
+type CreateBotRequest struct {
+	Name string `json:"name"`
+}
+
+type BotResponse struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	OwnerID   uint      `json:"owner_id"`
+	ClientID  string    `json:"client_id"`
+	IsRevoked bool      `json:"is_revoked"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CreateBotResponse struct {
+	BotResponse
+	ClientSecret string `json:"client_secret"`
+}
+
+type BotTokenRequest struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+type BotTokenResponse struct {
+	AccessToken          string    `json:"access_token"`
+	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+}
+
+func CreateBotRequestToDomainBot(req CreateBotRequest) domain.Bot {
+	return domain.Bot{
+		Name: req.Name,
+	}
+}
+
+func DomainBotToBotResponse(bot domain.Bot) BotResponse {
+	return BotResponse{
+		ID:        bot.ID,
+		Name:      bot.Name,
+		OwnerID:   bot.OwnerID,
+		ClientID:  bot.ClientID,
+		IsRevoked: bot.IsRevoked,
+		CreatedAt: bot.CreatedAt,
+	}
+}
+
+func DomainBotToCreateBotResponse(bot domain.Bot) CreateBotResponse {
+	return CreateBotResponse{
+		BotResponse:  DomainBotToBotResponse(bot),
+		ClientSecret: bot.ClientSecret,
+	}
+}
+
+func DomainBotsToBotResponses(bots []domain.Bot) []BotResponse {
+	var res []BotResponse
+	for _, bot := range bots {
+		res = append(res, DomainBotToBotResponse(bot))
+	}
+	return res
+}
+
+func BotTokenRequestToDomainBot(req BotTokenRequest) domain.Bot {
+	return domain.Bot{
+		ClientID:     req.ClientID,
+		ClientSecret: req.ClientSecret,
+	}
+}
+
+func DomainAuthToBotTokenResponse(auth domain.Auth) BotTokenResponse {
+	return BotTokenResponse{
+		AccessToken:          auth.AccessToken,
+		AccessTokenExpiresAt: auth.AccessTokenExpiresAt,
+	}
+}
+
+type CreateOAuthClientRequest struct {
+	Name         string   `json:"name"`
+	RedirectURIs []string `json:"redirect_uris"`
+	Scopes       []string `json:"scopes"`
+	Confidential bool     `json:"confidential"`
+}
+
+type OAuthClientResponse struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	OwnerID      uint      `json:"owner_id"`
+	ClientID     string    `json:"client_id"`
+	RedirectURIs []string  `json:"redirect_uris"`
+	Scopes       []string  `json:"scopes"`
+	Confidential bool      `json:"confidential"`
+	IsRevoked    bool      `json:"is_revoked"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// CreateOAuthClientResponse carries the client secret of confidential clients, which is only returned once.
+type CreateOAuthClientResponse struct {
+	OAuthClientResponse
+	ClientSecret string `json:"client_secret,omitempty"`
+}
+
+// AuthorizeOAuthRequest is an authorization request (RFC 6749, section 4.1.1) with the
+// PKCE code challenge. Approved carries the consent of the user to the requested scopes.
+type AuthorizeOAuthRequest struct {
+	ResponseType        string `json:"response_type"`
+	ClientID            string `json:"client_id"`
+	RedirectURI         string `json:"redirect_uri"`
+	Scope               string `json:"scope"`
+	State               string `json:"state"`
+	CodeChallenge       string `json:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method"`
+	Approved            bool   `json:"approved"`
+}
+
+// AuthorizeOAuthResponse tells where to redirect the user agent; RedirectURI already carries the code and state.
+type AuthorizeOAuthResponse struct {
+	RedirectURI string `json:"redirect_uri"`
+	Code        string `json:"code"`
+	State       string `json:"state,omitempty"`
+}
+
+// OAuthTokenRequest is a form-encoded token request. The client credentials may also be
+// sent with HTTP Basic authentication.
+type OAuthTokenRequest struct {
+	GrantType    string `form:"grant_type"`
+	ClientID     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+	Code         string `form:"code"`
+	RedirectURI  string `form:"redirect_uri"`
+	CodeVerifier string `form:"code_verifier"`
+	RefreshToken string `form:"refresh_token"`
+	Scope        string `form:"scope"`
+}
+
+type OAuthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope"`
+}
+
+// OAuthRevokeRequest is a form-encoded revocation request (RFC 7009).
+type OAuthRevokeRequest struct {
+	Token        string `form:"token"`
+	ClientID     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+}
+
+type OAuthConsentResponse struct {
+	ClientID   string    `json:"client_id"`
+	ClientName string    `json:"client_name"`
+	Scopes     []string  `json:"scopes"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func CreateOAuthClientRequestToDomainOAuthClient(req CreateOAuthClientRequest) domain.OAuthClient {
+	return domain.OAuthClient{
+		Name:         req.Name,
+		RedirectURIs: req.RedirectURIs,
+		Scopes:       req.Scopes,
+		Confidential: req.Confidential,
+	}
+}
+
+func DomainOAuthClientToOAuthClientResponse(client domain.OAuthClient) OAuthClientResponse {
+	return OAuthClientResponse{
+		ID:           client.ID,
+		Name:         client.Name,
+		OwnerID:      client.OwnerID,
+		ClientID:     client.ClientID,
+		RedirectURIs: client.RedirectURIs,
+		Scopes:       client.Scopes,
+		Confidential: client.Confidential,
+		IsRevoked:    client.IsRevoked,
+		CreatedAt:    client.CreatedAt,
+	}
+}
+
+func DomainOAuthClientToCreateOAuthClientResponse(client domain.OAuthClient) CreateOAuthClientResponse {
+	return CreateOAuthClientResponse{
+		OAuthClientResponse: DomainOAuthClientToOAuthClientResponse(client),
+		ClientSecret:        client.ClientSecret,
+	}
+}
+
+func DomainOAuthClientsToOAuthClientResponses(clients []domain.OAuthClient) []OAuthClientResponse {
+	res := []OAuthClientResponse{}
+	for _, client := range clients {
+		res = append(res, DomainOAuthClientToOAuthClientResponse(client))
+	}
+	return res
+}
+
+func AuthorizeOAuthRequestToDomainOAuthAuthorization(req AuthorizeOAuthRequest) domain.OAuthAuthorization {
+	return domain.OAuthAuthorization{
+		ResponseType:        req.ResponseType,
+		ClientID:            req.ClientID,
+		RedirectURI:         req.RedirectURI,
+		Scopes:              strings.Fields(req.Scope),
+		State:               req.State,
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+		Approved:            req.Approved,
+	}
+}
+
+func DomainOAuthAuthorizationToAuthorizeOAuthResponse(authorization domain.OAuthAuthorization) AuthorizeOAuthResponse {
+	redirectURI, _ := url.Parse(authorization.RedirectURI)
+	query := redirectURI.Query()
+	query.Set("code", authorization.Code)
+	if authorization.State != "" {
+		query.Set("state", authorization.State)
+	}
+	redirectURI.RawQuery = query.Encode()
+
+	return AuthorizeOAuthResponse{
+		RedirectURI: redirectURI.String(),
+		Code:        authorization.Code,
+		State:       authorization.State,
+	}
+}
+
+func OAuthTokenRequestToDomainOAuthTokenRequest(req OAuthTokenRequest) domain.OAuthTokenRequest {
+	return domain.OAuthTokenRequest{
+		GrantType:    req.GrantType,
+		ClientID:     req.ClientID,
+		ClientSecret: req.ClientSecret,
+		Code:         req.Code,
+		RedirectURI:  req.RedirectURI,
+		CodeVerifier: req.CodeVerifier,
+		RefreshToken: req.RefreshToken,
+		Scopes:       strings.Fields(req.Scope),
+	}
+}
+
+func DomainAuthToOAuthTokenResponse(auth domain.Auth) OAuthTokenResponse {
+	return OAuthTokenResponse{
+		AccessToken:  auth.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(time.Until(auth.AccessTokenExpiresAt).Seconds()),
+		RefreshToken: auth.RefreshToken,
+		Scope:        auth.Claims.Scope,
+	}
+}
+
+func OAuthRevokeRequestToDomainOAuthTokenRequest(req OAuthRevokeRequest) domain.OAuthTokenRequest {
+	return domain.OAuthTokenRequest{
+		ClientID:     req.ClientID,
+		ClientSecret: req.ClientSecret,
+		Token:        req.Token,
+	}
+}
+
+func DomainOAuthConsentsToOAuthConsentResponses(consents []domain.OAuthConsent) []OAuthConsentResponse {
+	res := []OAuthConsentResponse{}
+	for _, consent := range consents {
+		res = append(res, OAuthConsentResponse{
+			ClientID:   consent.ClientID,
+			ClientName: consent.ClientName,
+			Scopes:     consent.Scopes,
+			CreatedAt:  consent.CreatedAt,
+			UpdatedAt:  consent.UpdatedAt,
+		})
+	}
+	return res
+}
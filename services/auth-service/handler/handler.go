@@ -4,6 +4,7 @@ import (
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/usecase"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/jwt"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -19,7 +20,7 @@ func NewAuthHandler(usecase *usecase.AuthUseCase) *AuthHandler {
 
 // Login godoc
 // @Summary Login user
-// @Description Authenticate and return tokens
+// @Description Authenticate and return tokens, or an MFA token to continue at /login-2fa when two-factor authentication is enabled
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -38,7 +39,10 @@ func (h *AuthHandler) Login(ctx *fiber.Ctx) error {
 		return ctx.Status(apiErr.Status).JSON(apiErr)
 	}
 
-	loginResponse, err := h.usecase.Login(ctx.Context(), LoginRequestToDomainAuth(loginRequest))
+	auth := LoginRequestToDomainAuth(loginRequest)
+	auth.Device = requestDevice(ctx)
+
+	loginResponse, err := h.usecase.Login(ctx.UserContext(), auth)
 	if err != nil {
 		apiErr := errors.FromError(err)
 		return ctx.Status(apiErr.Status).JSON(apiErr)
@@ -61,7 +65,7 @@ func (h *AuthHandler) Login(ctx *fiber.Ctx) error {
 // @Failure 500 {object} map[string]interface{}
 // @Router /logout [post]
 func (h *AuthHandler) Logout(ctx *fiber.Ctx) error {
-	err := h.usecase.Logout(ctx.Context(), domain.Auth{})
+	err := h.usecase.Logout(ctx.UserContext(), domain.Auth{})
 	if err != nil {
 		apiErr := errors.FromError(err)
 		return ctx.Status(apiErr.Status).JSON(apiErr)
@@ -72,7 +76,7 @@ func (h *AuthHandler) Logout(ctx *fiber.Ctx) error {
 
 // RefreshToken godoc
 // @Summary Refresh access token
-// @Description Refresh the access token using a valid refresh token
+// @Description Refresh the access token using a valid refresh token. The refresh token is rotated: the response carries a new one and the one sent can no longer be used. Sending a rotated refresh token again revokes every token of its login.
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -90,7 +94,10 @@ func (h *AuthHandler) RefreshToken(ctx *fiber.Ctx) error {
 		return ctx.Status(apiErr.Status).JSON(apiErr)
 	}
 
-	refreshTokenResponse, err := h.usecase.RefreshToken(ctx.Context(), RefreshTokenRequestToDomainAuth(refreshTokenRequest))
+	auth := RefreshTokenRequestToDomainAuth(refreshTokenRequest)
+	auth.Device = requestDevice(ctx)
+
+	refreshTokenResponse, err := h.usecase.RefreshToken(ctx.UserContext(), auth)
 	if err != nil {
 		apiErr := errors.FromError(err)
 		return ctx.Status(apiErr.Status).JSON(apiErr)
@@ -102,7 +109,7 @@ func (h *AuthHandler) RefreshToken(ctx *fiber.Ctx) error {
 
 // RevokeToken godoc
 // @Summary Revoke a refresh token
-// @Description Revoke a refresh token to invalidate future access tokens
+// @Description Revoke a refresh token and every token rotated from the same login to invalidate future access tokens
 // @Tags auth
 // @Security BearerAuth
 // @Accept json
@@ -122,7 +129,7 @@ func (h *AuthHandler) RevokeToken(ctx *fiber.Ctx) error {
 		return ctx.Status(apiErr.Status).JSON(apiErr)
 	}
 
-	err := h.usecase.RevokeToken(ctx.Context(), RevokeTokenRequestToDomainAuth(revokeTokenRequest))
+	err := h.usecase.RevokeToken(ctx.UserContext(), RevokeTokenRequestToDomainAuth(revokeTokenRequest))
 	if err != nil {
 		apiErr := errors.FromError(err)
 		return ctx.Status(apiErr.Status).JSON(apiErr)
@@ -130,3 +137,18 @@ func (h *AuthHandler) RevokeToken(ctx *fiber.Ctx) error {
 
 	return ctx.Status(fiber.StatusNoContent).JSON(nil)
 }
+
+// JWKS godoc
+// @Summary Get the token signing keys
+// @Description Get the public keys access tokens are verified with, as a JSON Web Key Set, including the keys that become active later
+// @Tags auth
+// @Produce json
+// @Success 200 {object} jwt.JWKS
+// @Router /.well-known/jwks.json [get]
+func (h *AuthHandler) JWKS(ctx *fiber.Ctx) error {
+	// Verifiers refetch the set when they see an unknown key ID, so it can be cached
+	ctx.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	var res jwt.JWKS = h.usecase.JWKS(ctx.UserContext())
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
@@ -0,0 +1,286 @@
+package handler
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreateOAuthClient godoc
+// @Summary Register an OAuth client
+// @Description Register an OAuth 2.0 client with its redirect URIs and the scopes it may be granted. Confidential clients get a client secret, which is only returned once.
+// @Tags oauth
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param createOAuthClientRequest body CreateOAuthClientRequest true "Create OAuth client request body"
+// @Success 201 {object} CreateOAuthClientResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /create-oauth-client [post]
+func (h *AuthHandler) CreateOAuthClient(ctx *fiber.Ctx) error {
+	var createOAuthClientRequest CreateOAuthClientRequest
+	if err := ctx.BodyParser(&createOAuthClientRequest); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	client, err := h.usecase.CreateOAuthClient(ctx.UserContext(), CreateOAuthClientRequestToDomainOAuthClient(createOAuthClientRequest))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainOAuthClientToCreateOAuthClientResponse(client)
+
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
+
+// GetOAuthClients godoc
+// @Summary Get OAuth clients
+// @Description Retrieve all registered OAuth clients
+// @Tags oauth
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} OAuthClientResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /get-oauth-clients [get]
+func (h *AuthHandler) GetOAuthClients(ctx *fiber.Ctx) error {
+	clients, err := h.usecase.GetOAuthClients(ctx.UserContext())
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainOAuthClientsToOAuthClientResponses(clients)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// RevokeOAuthClient godoc
+// @Summary Revoke an OAuth client
+// @Description Revoke an OAuth client so it can't be authorized, get tokens or refresh them. The sessions it holds end and the access tokens issued to it stop being accepted.
+// @Tags oauth
+// @Security BearerAuth
+// @Param oauthClientId path int true "OAuth client ID"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /revoke-oauth-client/{oauthClientId} [post]
+func (h *AuthHandler) RevokeOAuthClient(ctx *fiber.Ctx) error {
+	clientID, err := strconv.Atoi(ctx.Params("oauthClientId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	err = h.usecase.RevokeOAuthClient(ctx.UserContext(), domain.OAuthClient{ID: clientID})
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	return ctx.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+// AuthorizeOAuth godoc
+// @Summary Authorize an OAuth client
+// @Description Authorize an OAuth client to act on behalf of the caller with the authorization code flow and PKCE (S256). Without an earlier consent to the scopes, the request must be approved by the user, otherwise it fails with consent_required. The response tells where to redirect the user agent with the authorization code.
+// @Tags oauth
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param authorizeOAuthRequest body AuthorizeOAuthRequest true "Authorization request body"
+// @Success 200 {object} AuthorizeOAuthResponse
+// @Failure 400 {object} errors.OAuthError
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} errors.OAuthError
+// @Failure 500 {object} errors.OAuthError
+// @Router /oauth/authorize [post]
+func (h *AuthHandler) AuthorizeOAuth(ctx *fiber.Ctx) error {
+	var authorizeOAuthRequest AuthorizeOAuthRequest
+	if err := ctx.BodyParser(&authorizeOAuthRequest); err != nil {
+		oauthErr := errors.OAuthFromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(oauthErr.Status).JSON(oauthErr)
+	}
+
+	authorization, err := h.usecase.AuthorizeOAuth(ctx.UserContext(), AuthorizeOAuthRequestToDomainOAuthAuthorization(authorizeOAuthRequest))
+	if err != nil {
+		oauthErr := errors.OAuthFromError(err)
+		return ctx.Status(oauthErr.Status).JSON(oauthErr)
+	}
+	res := DomainOAuthAuthorizationToAuthorizeOAuthResponse(authorization)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// OAuthToken godoc
+// @Summary Get OAuth tokens
+// @Description The OAuth 2.0 token endpoint, for the authorization_code, client_credentials and refresh_token grants. The client authenticates with HTTP Basic or with client_id and client_secret in the form; public clients send their client_id only.
+// @Tags oauth
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param grant_type formData string true "authorization_code, client_credentials or refresh_token"
+// @Param client_id formData string false "Client ID, unless sent with HTTP Basic"
+// @Param client_secret formData string false "Client secret, unless sent with HTTP Basic"
+// @Param code formData string false "Authorization code"
+// @Param redirect_uri formData string false "Redirect URI of the authorization request"
+// @Param code_verifier formData string false "PKCE code verifier"
+// @Param refresh_token formData string false "Refresh token"
+// @Param scope formData string false "Space-separated scopes for the client_credentials grant"
+// @Success 200 {object} OAuthTokenResponse
+// @Failure 400 {object} errors.OAuthError
+// @Failure 401 {object} errors.OAuthError
+// @Failure 500 {object} errors.OAuthError
+// @Router /oauth/token [post]
+func (h *AuthHandler) OAuthToken(ctx *fiber.Ctx) error {
+	var oauthTokenRequest OAuthTokenRequest
+	if err := ctx.BodyParser(&oauthTokenRequest); err != nil {
+		return oauthError(ctx, errors.NewError(errors.ErrorBadRequest, err))
+	}
+
+	req := OAuthTokenRequestToDomainOAuthTokenRequest(oauthTokenRequest)
+	if err := clientCredentials(ctx, &req); err != nil {
+		return oauthError(ctx, err)
+	}
+	req.Device = requestDevice(ctx)
+
+	auth, err := h.usecase.OAuthToken(ctx.UserContext(), req)
+	if err != nil {
+		return oauthError(ctx, err)
+	}
+	res := DomainAuthToOAuthTokenResponse(auth)
+
+	ctx.Set(fiber.HeaderCacheControl, "no-store")
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// RevokeOAuthToken godoc
+// @Summary Revoke an OAuth token
+// @Description Revoke an access or refresh token of the client (RFC 7009). Revoking either token of a user session ends the whole session. Unknown or expired tokens are not an error.
+// @Tags oauth
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param token formData string true "Access or refresh token"
+// @Param client_id formData string false "Client ID, unless sent with HTTP Basic"
+// @Param client_secret formData string false "Client secret, unless sent with HTTP Basic"
+// @Success 200
+// @Failure 400 {object} errors.OAuthError
+// @Failure 401 {object} errors.OAuthError
+// @Failure 403 {object} errors.OAuthError
+// @Failure 500 {object} errors.OAuthError
+// @Router /oauth/revoke [post]
+func (h *AuthHandler) RevokeOAuthToken(ctx *fiber.Ctx) error {
+	var oauthRevokeRequest OAuthRevokeRequest
+	if err := ctx.BodyParser(&oauthRevokeRequest); err != nil {
+		return oauthError(ctx, errors.NewError(errors.ErrorBadRequest, err))
+	}
+
+	req := OAuthRevokeRequestToDomainOAuthTokenRequest(oauthRevokeRequest)
+	if err := clientCredentials(ctx, &req); err != nil {
+		return oauthError(ctx, err)
+	}
+
+	if err := h.usecase.RevokeOAuthToken(ctx.UserContext(), req); err != nil {
+		return oauthError(ctx, err)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+// GetOAuthConsents godoc
+// @Summary Get my OAuth consents
+// @Description Retrieve the OAuth clients the caller has authorized and the scopes they were granted
+// @Tags oauth
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} OAuthConsentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /get-oauth-consents [get]
+func (h *AuthHandler) GetOAuthConsents(ctx *fiber.Ctx) error {
+	consents, err := h.usecase.GetOAuthConsents(ctx.UserContext())
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainOAuthConsentsToOAuthConsentResponses(consents)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// RevokeOAuthConsent godoc
+// @Summary Revoke my consent to an OAuth client
+// @Description Withdraw the consent of the caller to an OAuth client and end every session the client holds for the caller
+// @Tags oauth
+// @Security BearerAuth
+// @Param clientId path string true "OAuth client ID"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /revoke-oauth-consent/{clientId} [post]
+func (h *AuthHandler) RevokeOAuthConsent(ctx *fiber.Ctx) error {
+	err := h.usecase.RevokeOAuthConsent(ctx.UserContext(), domain.OAuthConsent{ClientID: ctx.Params("clientId")})
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	return ctx.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+// clientCredentials takes the client credentials of req from the HTTP Basic authorization
+// header when it is sent, which RFC 6749 (section 2.3.1) prefers over the form.
+func clientCredentials(ctx *fiber.Ctx, req *domain.OAuthTokenRequest) error {
+	header := ctx.Get(fiber.HeaderAuthorization)
+	encoded, ok := strings.CutPrefix(header, "Basic ")
+	if !ok {
+		return nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return errors.NewOAuthError(errors.ErrorUnauthorized, errors.OAuthInvalidClient, err)
+	}
+	clientID, clientSecret, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return errors.NewOAuthError(errors.ErrorUnauthorized, errors.OAuthInvalidClient, errors.New("malformed basic credentials"))
+	}
+
+	// the credentials are form-encoded before they are joined
+	if req.ClientID, err = url.QueryUnescape(clientID); err != nil {
+		return errors.NewOAuthError(errors.ErrorUnauthorized, errors.OAuthInvalidClient, err)
+	}
+	if req.ClientSecret, err = url.QueryUnescape(clientSecret); err != nil {
+		return errors.NewOAuthError(errors.ErrorUnauthorized, errors.OAuthInvalidClient, err)
+	}
+	return nil
+}
+
+// oauthError writes the error response of the token and revocation endpoints, asking the
+// client to authenticate again when its credentials were refused.
+func oauthError(ctx *fiber.Ctx, err error) error {
+	oauthErr := errors.OAuthFromError(err)
+	if oauthErr.Status == fiber.StatusUnauthorized {
+		ctx.Set(fiber.HeaderWWWAuthenticate, `Basic realm="oauth"`)
+	}
+	ctx.Set(fiber.HeaderCacheControl, "no-store")
+	return ctx.Status(oauthErr.Status).JSON(oauthErr)
+}
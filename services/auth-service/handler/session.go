@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetSessions godoc
+// @Summary Get my sessions
+// @Description Retrieve the active sessions of the caller with the device they are used from, the most recently used first
+// @Tags session
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} SessionResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /get-sessions [get]
+func (h *AuthHandler) GetSessions(ctx *fiber.Ctx) error {
+	sessions, err := h.usecase.GetSessions(ctx.UserContext())
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainAuthsToSessionResponses(sessions)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// RevokeSession godoc
+// @Summary Revoke one of my sessions
+// @Description Log out one session of the caller; its access tokens stop working within seconds
+// @Tags session
+// @Security BearerAuth
+// @Param sessionId path string true "Session ID"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /revoke-session/{sessionId} [post]
+func (h *AuthHandler) RevokeSession(ctx *fiber.Ctx) error {
+	err := h.usecase.RevokeSession(ctx.UserContext(), domain.Auth{FamilyID: ctx.Params("sessionId")})
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	return ctx.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+// RevokeOtherSessions godoc
+// @Summary Log out everywhere else
+// @Description Log out every session of the caller except the one of the access token sent
+// @Tags session
+// @Security BearerAuth
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /revoke-other-sessions [post]
+func (h *AuthHandler) RevokeOtherSessions(ctx *fiber.Ctx) error {
+	err := h.usecase.RevokeOtherSessions(ctx.UserContext())
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	return ctx.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+// GetUserSessions godoc
+// @Summary Get the sessions of a user
+// @Description Retrieve the active sessions of any user, for admins
+// @Tags session
+// @Security BearerAuth
+// @Produce json
+// @Param userId path int true "User ID"
+// @Success 200 {array} SessionResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /get-user-sessions/{userId} [get]
+func (h *AuthHandler) GetUserSessions(ctx *fiber.Ctx) error {
+	userID, err := strconv.ParseUint(ctx.Params("userId"), 10, 0)
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	sessions, err := h.usecase.GetUserSessions(ctx.UserContext(), domain.Auth{User: domain.User{ID: uint(userID)}})
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainAuthsToSessionResponses(sessions)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// RevokeUserSession godoc
+// @Summary Revoke a session of a user
+// @Description Log out one session of any user, for admins
+// @Tags session
+// @Security BearerAuth
+// @Param userId path int true "User ID"
+// @Param sessionId path string true "Session ID"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /revoke-user-session/{userId}/{sessionId} [post]
+func (h *AuthHandler) RevokeUserSession(ctx *fiber.Ctx) error {
+	userID, err := strconv.ParseUint(ctx.Params("userId"), 10, 0)
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	err = h.usecase.RevokeUserSession(ctx.UserContext(), domain.Auth{
+		FamilyID: ctx.Params("sessionId"),
+		User:     domain.User{ID: uint(userID)},
+	})
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	return ctx.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+// RevokeUserSessions godoc
+// @Summary Log a user out everywhere
+// @Description Log out every session of any user, for admins
+// @Tags session
+// @Security BearerAuth
+// @Param userId path int true "User ID"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /revoke-user-sessions/{userId} [post]
+func (h *AuthHandler) RevokeUserSessions(ctx *fiber.Ctx) error {
+	userID, err := strconv.ParseUint(ctx.Params("userId"), 10, 0)
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	err = h.usecase.RevokeUserSessions(ctx.UserContext(), domain.Auth{User: domain.User{ID: uint(userID)}})
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	return ctx.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+// requestDevice returns the device a request comes from.
+func requestDevice(ctx *fiber.Ctx) domain.Device {
+	return domain.Device{
+		UserAgent: ctx.Get(fiber.HeaderUserAgent),
+		IPAddress: ctx.IP(),
+	}
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// EnrollTwoFactor godoc
+// @Summary Enroll in two-factor authentication
+// @Description Generate a TOTP secret and its otpauth URI for an authenticator app. Two-factor authentication is only enabled once a code confirms the secret at /enable-2fa.
+// @Tags 2fa
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} EnrollTwoFactorResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /enroll-2fa [post]
+func (h *AuthHandler) EnrollTwoFactor(ctx *fiber.Ctx) error {
+	twoFactor, err := h.usecase.EnrollTwoFactor(ctx.UserContext())
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainTwoFactorToEnrollTwoFactorResponse(twoFactor)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// EnableTwoFactor godoc
+// @Summary Enable two-factor authentication
+// @Description Confirm the enrolled secret with a TOTP code and enable two-factor authentication. The recovery codes are only returned once.
+// @Tags 2fa
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param twoFactorCodeRequest body TwoFactorCodeRequest true "TOTP code"
+// @Success 200 {object} EnableTwoFactorResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 429 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /enable-2fa [post]
+func (h *AuthHandler) EnableTwoFactor(ctx *fiber.Ctx) error {
+	var twoFactorCodeRequest TwoFactorCodeRequest
+	if err := ctx.BodyParser(&twoFactorCodeRequest); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	twoFactor, err := h.usecase.EnableTwoFactor(ctx.UserContext(), TwoFactorCodeRequestToDomainTwoFactor(twoFactorCodeRequest))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainTwoFactorToEnableTwoFactorResponse(twoFactor)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// DisableTwoFactor godoc
+// @Summary Disable two-factor authentication
+// @Description Disable two-factor authentication with a TOTP or recovery code; the remaining recovery codes are deleted
+// @Tags 2fa
+// @Security BearerAuth
+// @Accept json
+// @Param twoFactorCodeRequest body TwoFactorCodeRequest true "TOTP or recovery code"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 429 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /disable-2fa [post]
+func (h *AuthHandler) DisableTwoFactor(ctx *fiber.Ctx) error {
+	var twoFactorCodeRequest TwoFactorCodeRequest
+	if err := ctx.BodyParser(&twoFactorCodeRequest); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	err := h.usecase.DisableTwoFactor(ctx.UserContext(), TwoFactorCodeRequestToDomainTwoFactor(twoFactorCodeRequest))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	return ctx.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+// LoginTwoFactor godoc
+// @Summary Complete a two-factor login
+// @Description Answer the MFA token returned by /login with a TOTP or recovery code and return tokens
+// @Tags 2fa
+// @Accept json
+// @Produce json
+// @Param loginTwoFactorRequest body LoginTwoFactorRequest true "Login 2FA request body"
+// @Success 200 {object} LoginResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 429 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /login-2fa [post]
+func (h *AuthHandler) LoginTwoFactor(ctx *fiber.Ctx) error {
+	var loginTwoFactorRequest LoginTwoFactorRequest
+	if err := ctx.BodyParser(&loginTwoFactorRequest); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	auth := LoginTwoFactorRequestToDomainAuth(loginTwoFactorRequest)
+	auth.Device = requestDevice(ctx)
+
+	loginResponse, err := h.usecase.LoginTwoFactor(ctx.UserContext(), auth)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainAuthToLoginResponse(loginResponse)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"strings"
 
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/authctx"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
 	"github.com/gofiber/fiber/v2"
 )
@@ -17,7 +18,7 @@ func AuthMiddleware() fiber.Handler {
 		}
 
 		// Pass the token to the context
-		ctx.Locals("token", token)
+		ctx.SetUserContext(authctx.WithToken(ctx.UserContext(), token))
 
 		// Proceed to the next handler
 		return ctx.Next()
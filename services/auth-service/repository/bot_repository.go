@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/ports"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent"
+	entBot "github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/bot"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
+	"go.uber.org/zap"
+)
+
+type BotRepository struct {
+	client *ent.Client
+	logger *logger.Logger
+}
+
+func NewBotRepository(client *ent.Client, logger *logger.Logger) ports.IBotRepository {
+	return &BotRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+// CreateBot is a method to create a bot
+func (r *BotRepository) CreateBot(ctx context.Context, bot domain.Bot) (domain.Bot, error) {
+	createdBot, err := r.client.Bot.
+		Create().
+		SetName(bot.Name).
+		SetOwnerID(bot.OwnerID).
+		SetClientID(bot.ClientID).
+		SetSecretHash(bot.SecretHash).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		r.logger.Ctx(ctx).Warn("failed to create bot", zap.Error(err))
+		return domain.Bot{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("bot already exists"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to create bot", zap.Error(err))
+		return domain.Bot{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entBotToDomainBot(createdBot), nil
+}
+
+// GetBots is a method to get all bots
+func (r *BotRepository) GetBots(ctx context.Context) ([]domain.Bot, error) {
+	bots, err := r.client.Bot.
+		Query().
+		Order(ent.Asc(entBot.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to retrieve bots", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	var res []domain.Bot
+	for _, bot := range bots {
+		res = append(res, entBotToDomainBot(bot))
+	}
+	return res, nil
+}
+
+// GetBotByClientID is a method to get a bot by its client id
+func (r *BotRepository) GetBotByClientID(ctx context.Context, bot domain.Bot) (domain.Bot, error) {
+	foundBot, err := r.client.Bot.
+		Query().
+		Where(entBot.ClientIDEQ(bot.ClientID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("bot not found", zap.Error(err))
+		return domain.Bot{}, errors.NewError(errors.ErrorNotFound, err)
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to retrieve bot", zap.Error(err))
+		return domain.Bot{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entBotToDomainBot(foundBot), nil
+}
+
+// RevokeBot is a method to revoke the credentials of a bot
+func (r *BotRepository) RevokeBot(ctx context.Context, bot domain.Bot) error {
+	err := r.client.Bot.
+		UpdateOneID(bot.ID).
+		SetIsRevoked(true).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("bot not found", zap.Error(err))
+		return errors.NewError(errors.ErrorNotFound, err)
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to revoke bot", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+func entBotToDomainBot(bot *ent.Bot) domain.Bot {
+	return domain.Bot{
+		ID:         bot.ID,
+		Name:       bot.Name,
+		OwnerID:    bot.OwnerID,
+		ClientID:   bot.ClientID,
+		SecretHash: bot.SecretHash,
+		IsRevoked:  bot.IsRevoked,
+		CreatedAt:  bot.CreatedAt,
+	}
+}
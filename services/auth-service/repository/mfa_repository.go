@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/ports"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// Every login waiting for its second factor is stored in a mfaChallenge:<token> hash
+// with the user it logs in, until the challenge expires. The codes tried by a user are
+// counted in mfaAttempts:<user id>, which expires at the end of the attempt window.
+
+type MFAChallengeRepository struct {
+	client *redis.Client
+	logger *logger.Logger
+}
+
+func NewMFAChallengeRepository(client *redis.Client, logger *logger.Logger) ports.IMFAChallengeRepository {
+	return &MFAChallengeRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+// CreateChallenge is a method to store a challenge until auth.MFATokenExpiresAt
+func (r *MFAChallengeRepository) CreateChallenge(ctx context.Context, auth domain.Auth) error {
+	key := challengeKey(auth.MFAToken)
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, key, map[string]interface{}{
+		"user_id":  auth.User.ID,
+		"username": auth.User.Username,
+		"email":    auth.User.Email,
+		"role":     auth.User.Role,
+	})
+	pipe.ExpireAt(ctx, key, auth.MFATokenExpiresAt)
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("failed to store MFA challenge in Redis", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+// GetChallenge is a method to get the user of the challenge auth.MFAToken
+func (r *MFAChallengeRepository) GetChallenge(ctx context.Context, auth domain.Auth) (domain.Auth, error) {
+	challenge, err := r.client.HGetAll(ctx, challengeKey(auth.MFAToken)).Result()
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to get MFA challenge from Redis", zap.Error(err))
+		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	if len(challenge) == 0 {
+		return domain.Auth{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("MFA challenge not found in Redis"))
+	}
+
+	userID, err := strconv.ParseUint(challenge["user_id"], 10, 0)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to parse MFA challenge", zap.Error(err))
+		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	auth.User = domain.User{
+		ID:       uint(userID),
+		Username: challenge["username"],
+		Email:    challenge["email"],
+		Role:     challenge["role"],
+	}
+	return auth, nil
+}
+
+// DeleteChallenge is a method to delete a challenge, which fails with not found when it
+// was already deleted so that a challenge is only ever answered once
+func (r *MFAChallengeRepository) DeleteChallenge(ctx context.Context, auth domain.Auth) error {
+	deleted, err := r.client.Del(ctx, challengeKey(auth.MFAToken)).Result()
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to delete MFA challenge from Redis", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if deleted == 0 {
+		return errors.NewError(errors.ErrorNotFound, fmt.Errorf("MFA challenge not found in Redis"))
+	}
+	return nil
+}
+
+// CountAttempt is a method to count a code tried by auth.User.ID and return the number of
+// codes tried within the window
+func (r *MFAChallengeRepository) CountAttempt(ctx context.Context, auth domain.Auth, window time.Duration) (int64, error) {
+	key := attemptsKey(auth.User.ID)
+	pipe := r.client.TxPipeline()
+	count := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("failed to count MFA attempt in Redis", zap.Error(err))
+		return 0, errors.NewError(errors.ErrorInternal, err)
+	}
+	return count.Val(), nil
+}
+
+// ResetAttempts is a method to forget the codes tried by auth.User.ID
+func (r *MFAChallengeRepository) ResetAttempts(ctx context.Context, auth domain.Auth) error {
+	if err := r.client.Del(ctx, attemptsKey(auth.User.ID)).Err(); err != nil {
+		r.logger.Ctx(ctx).Error("failed to reset MFA attempts in Redis", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+func challengeKey(token string) string {
+	return fmt.Sprintf("mfaChallenge:%s", token)
+}
+
+func attemptsKey(userID uint) string {
+	return fmt.Sprintf("mfaAttempts:%d", userID)
+}
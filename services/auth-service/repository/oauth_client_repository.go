@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/ports"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent"
+	entOAuthClient "github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/oauthclient"
+	entOAuthConsent "github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/oauthconsent"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
+	"go.uber.org/zap"
+)
+
+type OAuthClientRepository struct {
+	client *ent.Client
+	logger *logger.Logger
+}
+
+func NewOAuthClientRepository(client *ent.Client, logger *logger.Logger) ports.IOAuthClientRepository {
+	return &OAuthClientRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+// CreateClient is a method to register an OAuth client
+func (r *OAuthClientRepository) CreateClient(ctx context.Context, client domain.OAuthClient) (domain.OAuthClient, error) {
+	createdClient, err := r.client.OAuthClient.
+		Create().
+		SetName(client.Name).
+		SetOwnerID(client.OwnerID).
+		SetClientID(client.ClientID).
+		SetSecretHash(client.SecretHash).
+		SetRedirectUris(client.RedirectURIs).
+		SetScopes(client.Scopes).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		r.logger.Ctx(ctx).Warn("failed to create OAuth client", zap.Error(err))
+		return domain.OAuthClient{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("OAuth client already exists"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to create OAuth client", zap.Error(err))
+		return domain.OAuthClient{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entOAuthClientToDomainOAuthClient(createdClient), nil
+}
+
+// GetClients is a method to get all OAuth clients
+func (r *OAuthClientRepository) GetClients(ctx context.Context) ([]domain.OAuthClient, error) {
+	clients, err := r.client.OAuthClient.
+		Query().
+		Order(ent.Asc(entOAuthClient.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to retrieve OAuth clients", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	var res []domain.OAuthClient
+	for _, client := range clients {
+		res = append(res, entOAuthClientToDomainOAuthClient(client))
+	}
+	return res, nil
+}
+
+// GetClientByClientID is a method to get an OAuth client by its client id
+func (r *OAuthClientRepository) GetClientByClientID(ctx context.Context, client domain.OAuthClient) (domain.OAuthClient, error) {
+	foundClient, err := r.client.OAuthClient.
+		Query().
+		Where(entOAuthClient.ClientIDEQ(client.ClientID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("OAuth client not found", zap.Error(err))
+		return domain.OAuthClient{}, errors.NewError(errors.ErrorNotFound, err)
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to retrieve OAuth client", zap.Error(err))
+		return domain.OAuthClient{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entOAuthClientToDomainOAuthClient(foundClient), nil
+}
+
+// RevokeClient is a method to revoke an OAuth client, which returns the revoked client
+func (r *OAuthClientRepository) RevokeClient(ctx context.Context, client domain.OAuthClient) (domain.OAuthClient, error) {
+	revokedClient, err := r.client.OAuthClient.
+		UpdateOneID(client.ID).
+		SetIsRevoked(true).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("OAuth client not found", zap.Error(err))
+		return domain.OAuthClient{}, errors.NewError(errors.ErrorNotFound, err)
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to revoke OAuth client", zap.Error(err))
+		return domain.OAuthClient{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entOAuthClientToDomainOAuthClient(revokedClient), nil
+}
+
+// SaveConsent is a method to record the scopes a user granted to a client, replacing the
+// ones granted before
+func (r *OAuthClientRepository) SaveConsent(ctx context.Context, consent domain.OAuthConsent) (domain.OAuthConsent, error) {
+	// a consent created concurrently makes the create fail, the update then finds it
+	for attempt := 0; attempt < 2; attempt++ {
+		updated, err := r.client.OAuthConsent.
+			Update().
+			Where(
+				entOAuthConsent.UserIDEQ(consent.UserID),
+				entOAuthConsent.ClientIDEQ(consent.ClientID),
+			).
+			SetScopes(consent.Scopes).
+			Save(ctx)
+		if err != nil {
+			r.logger.Ctx(ctx).Error("failed to update OAuth consent", zap.Error(err))
+			return domain.OAuthConsent{}, errors.NewError(errors.ErrorInternal, err)
+		}
+		if updated > 0 {
+			return r.GetConsent(ctx, consent)
+		}
+
+		createdConsent, err := r.client.OAuthConsent.
+			Create().
+			SetUserID(consent.UserID).
+			SetClientID(consent.ClientID).
+			SetScopes(consent.Scopes).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			continue
+		}
+		if err != nil {
+			r.logger.Ctx(ctx).Error("failed to create OAuth consent", zap.Error(err))
+			return domain.OAuthConsent{}, errors.NewError(errors.ErrorInternal, err)
+		}
+		return entOAuthConsentToDomainOAuthConsent(createdConsent), nil
+	}
+	return domain.OAuthConsent{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("OAuth consent was changed concurrently"))
+}
+
+// GetConsent is a method to get the consent of a user to a client
+func (r *OAuthClientRepository) GetConsent(ctx context.Context, consent domain.OAuthConsent) (domain.OAuthConsent, error) {
+	foundConsent, err := r.client.OAuthConsent.
+		Query().
+		Where(
+			entOAuthConsent.UserIDEQ(consent.UserID),
+			entOAuthConsent.ClientIDEQ(consent.ClientID),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return domain.OAuthConsent{}, errors.NewError(errors.ErrorNotFound, err)
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to retrieve OAuth consent", zap.Error(err))
+		return domain.OAuthConsent{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entOAuthConsentToDomainOAuthConsent(foundConsent), nil
+}
+
+// GetConsentsByUserID is a method to get the consents of a user, the most recent first
+func (r *OAuthClientRepository) GetConsentsByUserID(ctx context.Context, consent domain.OAuthConsent) ([]domain.OAuthConsent, error) {
+	consents, err := r.client.OAuthConsent.
+		Query().
+		Where(entOAuthConsent.UserIDEQ(consent.UserID)).
+		Order(ent.Desc(entOAuthConsent.FieldUpdatedAt)).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to retrieve OAuth consents", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	res := []domain.OAuthConsent{}
+	for _, consent := range consents {
+		res = append(res, entOAuthConsentToDomainOAuthConsent(consent))
+	}
+	return res, nil
+}
+
+// DeleteConsent is a method to delete the consent of a user to a client
+func (r *OAuthClientRepository) DeleteConsent(ctx context.Context, consent domain.OAuthConsent) error {
+	deleted, err := r.client.OAuthConsent.
+		Delete().
+		Where(
+			entOAuthConsent.UserIDEQ(consent.UserID),
+			entOAuthConsent.ClientIDEQ(consent.ClientID),
+		).
+		Exec(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to delete OAuth consent", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if deleted == 0 {
+		return errors.NewError(errors.ErrorNotFound, fmt.Errorf("OAuth consent not found"))
+	}
+	return nil
+}
+
+func entOAuthClientToDomainOAuthClient(client *ent.OAuthClient) domain.OAuthClient {
+	return domain.OAuthClient{
+		ID:           client.ID,
+		Name:         client.Name,
+		OwnerID:      client.OwnerID,
+		ClientID:     client.ClientID,
+		SecretHash:   client.SecretHash,
+		RedirectURIs: client.RedirectUris,
+		Scopes:       client.Scopes,
+		Confidential: client.SecretHash != "",
+		IsRevoked:    client.IsRevoked,
+		CreatedAt:    client.CreatedAt,
+	}
+}
+
+func entOAuthConsentToDomainOAuthConsent(consent *ent.OAuthConsent) domain.OAuthConsent {
+	return domain.OAuthConsent{
+		UserID:    consent.UserID,
+		ClientID:  consent.ClientID,
+		Scopes:    consent.Scopes,
+		CreatedAt: consent.CreatedAt,
+		UpdatedAt: consent.UpdatedAt,
+	}
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/ports"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// Every authorization code is stored in an oauthCode:<code> hash with the request it was
+// issued for, until it expires or is exchanged.
+
+type OAuthCodeRepository struct {
+	client *redis.Client
+	logger *logger.Logger
+}
+
+func NewOAuthCodeRepository(client *redis.Client, logger *logger.Logger) ports.IOAuthCodeRepository {
+	return &OAuthCodeRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+// CreateCode is a method to store an authorization code until authorization.ExpiresAt
+func (r *OAuthCodeRepository) CreateCode(ctx context.Context, authorization domain.OAuthAuthorization) error {
+	key := oauthCodeKey(authorization.Code)
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, key, map[string]interface{}{
+		"client_id":             authorization.ClientID,
+		"redirect_uri":          authorization.RedirectURI,
+		"scope":                 strings.Join(authorization.Scopes, " "),
+		"code_challenge":        authorization.CodeChallenge,
+		"code_challenge_method": authorization.CodeChallengeMethod,
+		"user_id":               authorization.User.ID,
+		"username":              authorization.User.Username,
+		"email":                 authorization.User.Email,
+		"role":                  authorization.User.Role,
+	})
+	pipe.ExpireAt(ctx, key, authorization.ExpiresAt)
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("failed to store authorization code in Redis", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+// ConsumeCode is a method to get and delete an authorization code at once, so that a code
+// is only ever exchanged once
+func (r *OAuthCodeRepository) ConsumeCode(ctx context.Context, authorization domain.OAuthAuthorization) (domain.OAuthAuthorization, error) {
+	key := oauthCodeKey(authorization.Code)
+	pipe := r.client.TxPipeline()
+	get := pipe.HGetAll(ctx, key)
+	pipe.Del(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("failed to consume authorization code in Redis", zap.Error(err))
+		return domain.OAuthAuthorization{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	data := get.Val()
+	if len(data) == 0 {
+		return domain.OAuthAuthorization{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("authorization code not found in Redis"))
+	}
+
+	userID, err := strconv.ParseUint(data["user_id"], 10, 0)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to parse authorization code", zap.Error(err))
+		return domain.OAuthAuthorization{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return domain.OAuthAuthorization{
+		Code:                authorization.Code,
+		ClientID:            data["client_id"],
+		RedirectURI:         data["redirect_uri"],
+		Scopes:              strings.Fields(data["scope"]),
+		CodeChallenge:       data["code_challenge"],
+		CodeChallengeMethod: data["code_challenge_method"],
+		User: domain.User{
+			ID:       uint(userID),
+			Username: data["username"],
+			Email:    data["email"],
+			Role:     data["role"],
+		},
+	}, nil
+}
+
+func oauthCodeKey(code string) string {
+	return fmt.Sprintf("oauthCode:%s", code)
+}
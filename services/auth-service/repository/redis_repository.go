@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -11,8 +12,26 @@ import (
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
 	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
 )
 
+// Every refresh token is stored in a sessionID:<token id> hash, found through its
+// refreshToken:<token> key. The IDs of the tokens rotated from the same login are
+// kept in the family:<family id> set, the family IDs of a user in the
+// userSessions:<user id> set, and those of an OAuth client in the
+// clientSessions:<client id> set.
+
+// revokeScript marks the given tokens as revoked, skipping the ones that already
+// expired so that they are not stored again without expiry.
+var revokeScript = redis.NewScript(`
+for _, key in ipairs(KEYS) do
+	if redis.call("EXISTS", key) == 1 then
+		redis.call("HSET", key, "is_revoked", "1")
+	end
+end
+return 0
+`)
+
 type AuthRepositoryWithRedis struct {
 	client *redis.Client
 	logger *logger.Logger
@@ -27,30 +46,14 @@ func NewAuthRepositoryWithRedis(client *redis.Client, logger *logger.Logger) por
 
 // StoreToken is a method to store a token in Redis with auto-expiration
 func (r *AuthRepositoryWithRedis) CreateToken(ctx context.Context, auth domain.Auth) (domain.Auth, error) {
-	key := fmt.Sprintf("sessionID:%s", auth.Claims.SessionID)
-	refreshTokenKey := fmt.Sprintf("refreshToken:%s", auth.RefreshToken) // Mapping key
-
 	// Use a pipeline to execute multiple commands in a single round-trip
 	pipe := r.client.TxPipeline()
-
-	// Set the token information as fields in the Redis hash
-	pipe.HSet(ctx, key, map[string]interface{}{
-		"refresh_token": auth.RefreshToken,
-		"user_id":       auth.User.ID,
-		"expires_at":    auth.RefreshTokenExpiresAt,
-		"is_revoked":    auth.RefreshTokenIsRevoked,
-	})
-
-	// Store the mapping from refresh token to session ID
-	pipe.Set(ctx, refreshTokenKey, auth.Claims.SessionID, time.Until(auth.RefreshTokenExpiresAt))
-
-	// Set the key to expire after 1 day
-	pipe.Expire(ctx, key, auth.Claims.Duration)
+	storeToken(ctx, pipe, auth)
 
 	// Execute the pipeline
 	_, err := pipe.Exec(ctx)
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to execute pipeline for storing token: %v", err))
+		r.logger.Ctx(ctx).Error("failed to execute pipeline for storing token", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
 	}
 
@@ -59,85 +62,50 @@ func (r *AuthRepositoryWithRedis) CreateToken(ctx context.Context, auth domain.A
 
 // GetToken is a method to retrieve a token by id from Redis
 func (r *AuthRepositoryWithRedis) GetTokenByID(ctx context.Context, auth domain.Auth) (domain.Auth, error) {
-	key := fmt.Sprintf("sessionID:%s", auth.ID)
-
 	// Retrieve the fields for the token from the Redis hash
-	sessionData, err := r.client.HGetAll(ctx, key).Result()
+	sessionData, err := r.client.HGetAll(ctx, tokenKey(auth.ID)).Result()
 	if err == redis.Nil {
-		r.logger.Warn(fmt.Sprintf("token not found in Redis: %v", err))
+		r.logger.Ctx(ctx).Warn("token not found in Redis", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorNotFound, err)
 	}
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to retrieve token from Redis: %v", err))
+		r.logger.Ctx(ctx).Error("failed to retrieve token from Redis", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
 	}
 
 	if len(sessionData) == 0 {
-		r.logger.Warn(fmt.Sprintf("token not found in Redis"))
+		r.logger.Ctx(ctx).Warn("token not found in Redis")
 		return domain.Auth{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("token not found in Redis"))
 	}
 
-	// Parse the session data
-	refreshToken := sessionData["refresh_token"]
-
-	userID, err := strconv.Atoi(sessionData["user_id"])
-	if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to parse user_id: %v", err))
-		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
-	}
-
-	expiresAt, err := time.Parse(time.RFC3339, sessionData["expires_at"])
-	if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to parse expires_at: %v", err))
-		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
-	}
-
-	isRevoked, err := strconv.ParseBool(sessionData["is_revoked"])
+	foundAuth, err := parseToken(auth.ID, sessionData)
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to parse is_revoked: %v", err))
+		r.logger.Ctx(ctx).Error("failed to parse token", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
 	}
-
-	foundAuth := domain.Auth{
-		ID:                    auth.ID,
-		RefreshToken:          refreshToken,
-		RefreshTokenExpiresAt: expiresAt,
-		RefreshTokenIsRevoked: isRevoked,
-		User: domain.User{
-			ID: uint(userID),
-		},
-		Claims: domain.Claims{
-			ID:        uint(userID),
-			SessionID: auth.ID,
-			IssuedAt:  time.Now(),
-			ExpiresAt: expiresAt,
-		},
-	}
 	return foundAuth, nil
 }
 
 // GetToken is a method to retrieve a token by refresh token from Redis
 func (r *AuthRepositoryWithRedis) GetTokenByRefreshToken(ctx context.Context, auth domain.Auth) (domain.Auth, error) {
-	refreshTokenKey := fmt.Sprintf("refreshToken:%s", auth.RefreshToken)
-
-	// Step 1: Retrieve the sessionID using the refresh token
-	sessionID, err := r.client.Get(ctx, refreshTokenKey).Result()
+	// Step 1: Retrieve the token ID using the refresh token
+	sessionID, err := r.client.Get(ctx, refreshTokenKey(auth.RefreshToken)).Result()
 	if err == redis.Nil {
-		r.logger.Warn(fmt.Sprintf("refresh token not found in Redis: %v", err))
+		r.logger.Ctx(ctx).Warn("refresh token not found in Redis", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorNotFound, err)
 	} else if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to get sessionID by refresh token: %v", err))
+		r.logger.Ctx(ctx).Error("failed to get sessionID by refresh token", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
 	}
 
 	if sessionID == "" {
-		r.logger.Warn(fmt.Sprintf("sessionID not found in Redis"))
+		r.logger.Ctx(ctx).Warn("sessionID not found in Redis")
 		return domain.Auth{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("sessionID not found in Redis"))
 	}
 
 	auth.ID = sessionID
 
-	// Step 2: Retrieve the token information using the sessionID
+	// Step 2: Retrieve the token information using the token ID
 	auth, err = r.GetTokenByID(ctx, auth)
 	if err != nil {
 		return domain.Auth{}, err
@@ -146,53 +114,349 @@ func (r *AuthRepositoryWithRedis) GetTokenByRefreshToken(ctx context.Context, au
 	return auth, nil
 }
 
-// DeleteToken is a method to delete a token from Redis
-func (r *AuthRepositoryWithRedis) DeleteToken(ctx context.Context, auth domain.Auth) error {
-	auth, err := r.GetTokenByID(ctx, auth)
-	if err != nil {
+// RotateToken replaces the current refresh token of a family with the next one. It fails
+// with a conflict when the current token was already rotated or revoked, or when it
+// changes while being rotated, which catches two refreshes racing with the same token.
+func (r *AuthRepositoryWithRedis) RotateToken(ctx context.Context, current domain.Auth, next domain.Auth) (domain.Auth, error) {
+	key := tokenKey(current.ID)
+	err := r.client.Watch(ctx, func(tx *redis.Tx) error {
+		sessionData, err := tx.HGetAll(ctx, key).Result()
+		if err != nil {
+			return errors.NewError(errors.ErrorInternal, err)
+		}
+		if len(sessionData) == 0 {
+			return errors.NewError(errors.ErrorNotFound, fmt.Errorf("token not found in Redis"))
+		}
+		token, err := parseToken(current.ID, sessionData)
+		if err != nil {
+			return errors.NewError(errors.ErrorInternal, err)
+		}
+		if token.ReplacedBy != "" || token.RefreshTokenIsRevoked {
+			return errors.NewError(errors.ErrorConflict, fmt.Errorf("token was already rotated"))
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(ctx, key, "replaced_by", next.ID)
+			storeToken(ctx, pipe, next)
+			return nil
+		})
 		return err
+	}, key)
+	if err == redis.TxFailedErr {
+		return domain.Auth{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("token was rotated concurrently"))
+	}
+	if err != nil {
+		if _, ok := err.(errors.Error); ok {
+			return domain.Auth{}, err
+		}
+		r.logger.Ctx(ctx).Error("failed to rotate token in Redis", zap.Error(err))
+		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
 	}
 
-	// Use a pipeline to execute multiple commands in a single round-trip
-	pipe := r.client.TxPipeline()
+	return next, nil
+}
 
-	// Step 1: delete the refresh token mapping to sessionID
-	refreshTokenKey := fmt.Sprintf("refreshToken:%s", auth.RefreshToken)
-	pipe.Del(ctx, refreshTokenKey)
+// DeleteToken is a method to delete the tokens of a family from Redis
+func (r *AuthRepositoryWithRedis) DeleteToken(ctx context.Context, auth domain.Auth) error {
+	ids, err := r.familyTokenIDs(ctx, auth)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to get the tokens of the family", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
 
-	// Step 2: delete the sessionID hash
-	key := fmt.Sprintf("sessionID:%s", auth.ID)
-	pipe.Del(ctx, key)
+	// Step 1: look up the refresh tokens to delete their mappings to the token IDs
+	pipe := r.client.Pipeline()
+	refreshTokens := make([]*redis.StringCmd, len(ids))
+	for i, id := range ids {
+		refreshTokens[i] = pipe.HGet(ctx, tokenKey(id), "refresh_token")
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		r.logger.Ctx(ctx).Error("failed to execute pipeline for getting tokens", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
 
-	// Execute the pipeline
-	_, err = pipe.Exec(ctx)
-	if err != nil {
-		if err == redis.Nil {
-			// Handle specific cases where keys are missing
-			r.logger.Warn(fmt.Sprintf("key not found for deletion in Redis: %v", err))
-			return errors.NewError(errors.ErrorNotFound, err)
+	// Step 2: delete the token hashes, their mappings and the family
+	keys := []string{familyKey(auth.FamilyID)}
+	for i, id := range ids {
+		keys = append(keys, tokenKey(id))
+		if refreshToken := refreshTokens[i].Val(); refreshToken != "" {
+			keys = append(keys, refreshTokenKey(refreshToken))
 		}
-		// General error handling for failed pipeline execution
-		r.logger.Error(fmt.Sprintf("failed to execute pipeline for deleting token: %v", err))
+	}
+	deleted, err := r.client.Del(ctx, keys...).Result()
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to delete tokens from Redis", zap.Error(err))
 		return errors.NewError(errors.ErrorInternal, err)
 	}
+	if deleted == 0 {
+		r.logger.Ctx(ctx).Warn("key not found for deletion in Redis")
+		return errors.NewError(errors.ErrorNotFound, fmt.Errorf("token not found in Redis"))
+	}
 
 	return nil
 }
 
-// RevokeToken is a method to revoke a token in Redis
+// RevokeToken is a method to revoke the tokens of a family in Redis
 func (r *AuthRepositoryWithRedis) RevokeToken(ctx context.Context, auth domain.Auth) error {
-	key := fmt.Sprintf("sessionID:%s", auth.Claims.SessionID)
-
-	// Set is_revoked to true for the given token
-	err := r.client.HSet(ctx, key, "is_revoked", true).Err()
+	ids, err := r.familyTokenIDs(ctx, auth)
 	if err != nil {
-		if err == redis.Nil {
-			r.logger.Warn(fmt.Sprintf("token not found for revocation in Redis: %v", err))
-			return errors.NewError(errors.ErrorNotFound, err)
-		}
-		r.logger.Error(fmt.Sprintf("failed to revoke token in Redis: %v", err))
+		r.logger.Ctx(ctx).Error("failed to get the tokens of the family", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = tokenKey(id)
+	}
+	if err := revokeScript.Run(ctx, r.client, keys).Err(); err != nil && err != redis.Nil {
+		r.logger.Ctx(ctx).Error("failed to revoke token in Redis", zap.Error(err))
 		return errors.NewError(errors.ErrorInternal, err)
 	}
 	return nil
 }
+
+// IsSessionActive reports whether the family of auth still has a token that is not revoked;
+// expired tokens are already gone from Redis
+func (r *AuthRepositoryWithRedis) IsSessionActive(ctx context.Context, auth domain.Auth) (bool, error) {
+	ids, err := r.familyTokenIDs(ctx, auth)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to get the tokens of the family", zap.Error(err))
+		return false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	pipe := r.client.Pipeline()
+	revoked := make([]*redis.StringCmd, len(ids))
+	for i, id := range ids {
+		revoked[i] = pipe.HGet(ctx, tokenKey(id), "is_revoked")
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		r.logger.Ctx(ctx).Error("failed to execute pipeline for checking tokens", zap.Error(err))
+		return false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	for _, cmd := range revoked {
+		if isRevoked, err := strconv.ParseBool(cmd.Val()); err == nil && !isRevoked {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetSessionsByUserID returns the current token of every active session of the user, the
+// most recently used first. Sessions that ended are dropped from the index of the user.
+func (r *AuthRepositoryWithRedis) GetSessionsByUserID(ctx context.Context, auth domain.Auth) ([]domain.Auth, error) {
+	return r.getSessions(ctx, userSessionsKey(auth.User.ID))
+}
+
+// GetSessionsByClientID returns the current token of every active session the OAuth client
+// auth.Claims.ClientID holds. Sessions that ended are dropped from the index of the client.
+func (r *AuthRepositoryWithRedis) GetSessionsByClientID(ctx context.Context, auth domain.Auth) ([]domain.Auth, error) {
+	return r.getSessions(ctx, clientSessionsKey(auth.Claims.ClientID))
+}
+
+// getSessions returns the current token of every active session in the index at key, the
+// most recently used first, and drops the sessions that ended from the index.
+func (r *AuthRepositoryWithRedis) getSessions(ctx context.Context, key string) ([]domain.Auth, error) {
+	familyIDs, err := r.client.SMembers(ctx, key).Result()
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to get the sessions", zap.String("index", key), zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+	if len(familyIDs) == 0 {
+		return []domain.Auth{}, nil
+	}
+
+	// Step 1: get the tokens of every family
+	pipe := r.client.Pipeline()
+	families := make([]*redis.StringSliceCmd, len(familyIDs))
+	for i, familyID := range familyIDs {
+		families[i] = pipe.SMembers(ctx, familyKey(familyID))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("failed to execute pipeline for getting families", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	// Step 2: get the tokens and keep the one of each family that is neither rotated nor revoked
+	pipe = r.client.Pipeline()
+	tokens := make([][]*redis.StringStringMapCmd, len(familyIDs))
+	for i, family := range families {
+		for _, id := range family.Val() {
+			tokens[i] = append(tokens[i], pipe.HGetAll(ctx, tokenKey(id)))
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("failed to execute pipeline for getting tokens", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	sessions := []domain.Auth{}
+	var ended []interface{}
+	for i, family := range families {
+		found := false
+		for j, id := range family.Val() {
+			sessionData := tokens[i][j].Val()
+			if len(sessionData) == 0 {
+				continue
+			}
+			token, err := parseToken(id, sessionData)
+			if err != nil {
+				r.logger.Ctx(ctx).Error("failed to parse token", zap.Error(err))
+				return nil, errors.NewError(errors.ErrorInternal, err)
+			}
+			if token.ReplacedBy == "" && !token.RefreshTokenIsRevoked {
+				sessions = append(sessions, token)
+				found = true
+				break
+			}
+		}
+		if !found {
+			ended = append(ended, familyIDs[i])
+		}
+	}
+
+	if len(ended) > 0 {
+		if err := r.client.SRem(ctx, key, ended...).Err(); err != nil {
+			r.logger.Ctx(ctx).Warn("failed to drop ended sessions", zap.String("index", key), zap.Error(err))
+		}
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Device.LastUsedAt.After(sessions[j].Device.LastUsedAt)
+	})
+	return sessions, nil
+}
+
+// familyTokenIDs returns the IDs of the tokens of the family of auth. A token stored
+// before tokens were rotated has no family set and is a family of its own.
+func (r *AuthRepositoryWithRedis) familyTokenIDs(ctx context.Context, auth domain.Auth) ([]string, error) {
+	ids, err := r.client.SMembers(ctx, familyKey(auth.FamilyID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		ids = []string{auth.FamilyID}
+	}
+	return ids, nil
+}
+
+// storeToken queues the commands storing a refresh token in its family; all of its
+// keys expire with the token, the family with its newest token.
+func storeToken(ctx context.Context, pipe redis.Pipeliner, auth domain.Auth) {
+	key := tokenKey(auth.ID)
+
+	// Set the token information as fields in the Redis hash
+	pipe.HSet(ctx, key, map[string]interface{}{
+		"family_id":     auth.FamilyID,
+		"refresh_token": auth.RefreshToken,
+		"user_id":       auth.User.ID,
+		"expires_at":    auth.RefreshTokenExpiresAt,
+		"is_revoked":    auth.RefreshTokenIsRevoked,
+		"user_agent":    auth.Device.UserAgent,
+		"ip_address":    auth.Device.IPAddress,
+		"created_at":    auth.Device.CreatedAt,
+		"last_used_at":  auth.Device.LastUsedAt,
+		"client_id":     auth.Claims.ClientID,
+		"scope":         auth.Claims.Scope,
+	})
+	pipe.ExpireAt(ctx, key, auth.RefreshTokenExpiresAt)
+
+	// Store the mapping from refresh token to token ID
+	pipe.Set(ctx, refreshTokenKey(auth.RefreshToken), auth.ID, time.Until(auth.RefreshTokenExpiresAt))
+
+	// Add the token to its family
+	pipe.SAdd(ctx, familyKey(auth.FamilyID), auth.ID)
+	pipe.ExpireAt(ctx, familyKey(auth.FamilyID), auth.RefreshTokenExpiresAt)
+
+	// Add the family to the sessions of the user
+	pipe.SAdd(ctx, userSessionsKey(auth.User.ID), auth.FamilyID)
+	pipe.ExpireAt(ctx, userSessionsKey(auth.User.ID), auth.RefreshTokenExpiresAt)
+
+	// Add the family to the sessions of the OAuth client it was granted to
+	if auth.Claims.ClientID != "" {
+		pipe.SAdd(ctx, clientSessionsKey(auth.Claims.ClientID), auth.FamilyID)
+		pipe.ExpireAt(ctx, clientSessionsKey(auth.Claims.ClientID), auth.RefreshTokenExpiresAt)
+	}
+}
+
+func parseToken(id string, sessionData map[string]string) (domain.Auth, error) {
+	userID, err := strconv.Atoi(sessionData["user_id"])
+	if err != nil {
+		return domain.Auth{}, fmt.Errorf("failed to parse user_id: %w", err)
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, sessionData["expires_at"])
+	if err != nil {
+		return domain.Auth{}, fmt.Errorf("failed to parse expires_at: %w", err)
+	}
+
+	isRevoked, err := strconv.ParseBool(sessionData["is_revoked"])
+	if err != nil {
+		return domain.Auth{}, fmt.Errorf("failed to parse is_revoked: %w", err)
+	}
+
+	// Tokens stored before tokens were rotated are a family of their own
+	familyID := sessionData["family_id"]
+	if familyID == "" {
+		familyID = id
+	}
+
+	// Tokens stored before devices were recorded have no session times
+	var createdAt, lastUsedAt time.Time
+	if sessionData["created_at"] != "" {
+		if createdAt, err = time.Parse(time.RFC3339, sessionData["created_at"]); err != nil {
+			return domain.Auth{}, fmt.Errorf("failed to parse created_at: %w", err)
+		}
+	}
+	if sessionData["last_used_at"] != "" {
+		if lastUsedAt, err = time.Parse(time.RFC3339, sessionData["last_used_at"]); err != nil {
+			return domain.Auth{}, fmt.Errorf("failed to parse last_used_at: %w", err)
+		}
+	}
+
+	return domain.Auth{
+		ID:                    id,
+		FamilyID:              familyID,
+		RefreshToken:          sessionData["refresh_token"],
+		RefreshTokenExpiresAt: expiresAt,
+		RefreshTokenIsRevoked: isRevoked,
+		ReplacedBy:            sessionData["replaced_by"],
+		User: domain.User{
+			ID: uint(userID),
+		},
+		Claims: domain.Claims{
+			ID:        uint(userID),
+			SessionID: familyID,
+			IssuedAt:  time.Now(),
+			ExpiresAt: expiresAt,
+			ClientID:  sessionData["client_id"],
+			Scope:     sessionData["scope"],
+		},
+		Device: domain.Device{
+			UserAgent:  sessionData["user_agent"],
+			IPAddress:  sessionData["ip_address"],
+			CreatedAt:  createdAt,
+			LastUsedAt: lastUsedAt,
+		},
+	}, nil
+}
+
+func tokenKey(id string) string {
+	return fmt.Sprintf("sessionID:%s", id)
+}
+
+func refreshTokenKey(refreshToken string) string {
+	return fmt.Sprintf("refreshToken:%s", refreshToken)
+}
+
+func familyKey(familyID string) string {
+	return fmt.Sprintf("family:%s", familyID)
+}
+
+func userSessionsKey(userID uint) string {
+	return fmt.Sprintf("userSessions:%d", userID)
+}
+
+func clientSessionsKey(clientID string) string {
+	return fmt.Sprintf("clientSessions:%s", clientID)
+}
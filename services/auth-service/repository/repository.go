@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/ports"
@@ -11,6 +12,7 @@ import (
 	entAuth "github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/auth"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
+	"go.uber.org/zap"
 )
 
 type AuthRepository struct {
@@ -30,16 +32,23 @@ func (r *AuthRepository) CreateToken(ctx context.Context, auth domain.Auth) (dom
 	_, err := r.client.Auth.
 		Create().
 		SetID(auth.ID).
+		SetFamilyID(auth.FamilyID).
 		SetUserID(auth.User.ID).
 		SetRefreshToken(auth.RefreshToken).
 		SetExpiresAt(auth.RefreshTokenExpiresAt).
+		SetUserAgent(auth.Device.UserAgent).
+		SetIPAddress(auth.Device.IPAddress).
+		SetSessionCreatedAt(auth.Device.CreatedAt).
+		SetLastUsedAt(auth.Device.LastUsedAt).
+		SetClientID(auth.Claims.ClientID).
+		SetScope(auth.Claims.Scope).
 		Save(ctx)
 	if err != nil {
 		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-			r.logger.Error(fmt.Sprintf("failed to create token: %v", err))
+			r.logger.Ctx(ctx).Error("failed to create token", zap.Error(err))
 			return domain.Auth{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("token already exists"))
 		}
-		r.logger.Error(fmt.Sprintf("failed to create token: %v", err))
+		r.logger.Ctx(ctx).Error("failed to create token", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
 	}
 	return auth, nil
@@ -52,29 +61,14 @@ func (r *AuthRepository) GetTokenByID(ctx context.Context, auth domain.Auth) (do
 		Where(entAuth.IDEQ(auth.ID)).
 		Only(ctx)
 	if ent.IsNotFound(err) {
-		r.logger.Warn(fmt.Sprintf("token not found: %v", err))
+		r.logger.Ctx(ctx).Warn("token not found", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorNotFound, err)
 	}
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to retrieve token: %v", err))
+		r.logger.Ctx(ctx).Error("failed to retrieve token", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
 	}
-	auth = domain.Auth{
-		ID:                    token.ID,
-		RefreshToken:          token.RefreshToken,
-		RefreshTokenExpiresAt: token.ExpiresAt,
-		RefreshTokenIsRevoked: token.IsRevoked,
-		User: domain.User{
-			ID: token.UserID,
-		},
-		Claims: domain.Claims{
-			ID:        token.UserID,
-			SessionID: token.ID,
-			IssuedAt:  token.CreatedAt,
-			ExpiresAt: token.ExpiresAt,
-		},
-	}
-	return auth, nil
+	return mapToken(token), nil
 }
 
 // GetToken is a method to get a token by refresh token
@@ -84,60 +78,200 @@ func (r *AuthRepository) GetTokenByRefreshToken(ctx context.Context, auth domain
 		Where(entAuth.RefreshTokenEQ(auth.RefreshToken)).
 		Only(ctx)
 	if ent.IsNotFound(err) {
-		r.logger.Warn(fmt.Sprintf("token not found: %v", err))
+		r.logger.Ctx(ctx).Warn("token not found", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorNotFound, err)
 	}
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to retrieve token: %v", err))
+		r.logger.Ctx(ctx).Error("failed to retrieve token", zap.Error(err))
 		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
 	}
-	auth = domain.Auth{
-		ID:                    token.ID,
-		RefreshToken:          token.RefreshToken,
-		RefreshTokenExpiresAt: token.ExpiresAt,
-		RefreshTokenIsRevoked: token.IsRevoked,
-		User: domain.User{
-			ID: token.UserID,
-		},
-		Claims: domain.Claims{
-			ID:        token.UserID,
-			SessionID: token.ID,
-			IssuedAt:  token.CreatedAt,
-			ExpiresAt: token.ExpiresAt,
-		},
+	return mapToken(token), nil
+}
+
+// RotateToken replaces the current refresh token of a family with the next one. It fails
+// with a conflict when the current token was already rotated or revoked, which also
+// catches two refreshes racing with the same token.
+func (r *AuthRepository) RotateToken(ctx context.Context, current domain.Auth, next domain.Auth) (domain.Auth, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to start transaction", zap.Error(err))
+		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
 	}
-	return auth, nil
+
+	n, err := tx.Auth.
+		Update().
+		Where(
+			entAuth.IDEQ(current.ID),
+			entAuth.ReplacedByIsNil(),
+			entAuth.IsRevokedEQ(false),
+		).
+		SetReplacedBy(next.ID).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.logger.Ctx(ctx).Error("failed to rotate token", zap.Error(err))
+		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	if n == 0 {
+		_ = tx.Rollback()
+		return domain.Auth{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("token was already rotated"))
+	}
+
+	_, err = tx.Auth.
+		Create().
+		SetID(next.ID).
+		SetFamilyID(next.FamilyID).
+		SetUserID(next.User.ID).
+		SetRefreshToken(next.RefreshToken).
+		SetExpiresAt(next.RefreshTokenExpiresAt).
+		SetUserAgent(next.Device.UserAgent).
+		SetIPAddress(next.Device.IPAddress).
+		SetSessionCreatedAt(next.Device.CreatedAt).
+		SetLastUsedAt(next.Device.LastUsedAt).
+		SetClientID(next.Claims.ClientID).
+		SetScope(next.Claims.Scope).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.logger.Ctx(ctx).Error("failed to create rotated token", zap.Error(err))
+		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Ctx(ctx).Error("failed to commit token rotation", zap.Error(err))
+		return domain.Auth{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return next, nil
 }
 
-// DeleteToken is a method to delete a token
+// DeleteToken is a method to delete the tokens of a family
 func (r *AuthRepository) DeleteToken(ctx context.Context, auth domain.Auth) error {
-	err := r.client.Auth.
-		DeleteOneID(auth.ID).
+	n, err := r.client.Auth.
+		Delete().
+		Where(entAuth.FamilyIDEQ(auth.FamilyID)).
 		Exec(ctx)
-	if ent.IsNotFound(err) {
-		r.logger.Warn(fmt.Sprintf("token not found for deletion: %v", err))
-		return errors.NewError(errors.ErrorNotFound, err)
-	}
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to delete token: %v", err))
+		r.logger.Ctx(ctx).Error("failed to delete token", zap.Error(err))
 		return errors.NewError(errors.ErrorInternal, err)
 	}
+	if n == 0 {
+		r.logger.Ctx(ctx).Warn("token not found for deletion")
+		return errors.NewError(errors.ErrorNotFound, fmt.Errorf("token not found"))
+	}
 	return nil
 }
 
-// RevokedToken is a method to revoke a token
+// RevokeToken is a method to revoke the tokens of a family
 func (r *AuthRepository) RevokeToken(ctx context.Context, auth domain.Auth) error {
-	_, err := r.client.Auth.
-		UpdateOneID(auth.ID).
+	n, err := r.client.Auth.
+		Update().
+		Where(entAuth.FamilyIDEQ(auth.FamilyID)).
 		SetIsRevoked(true).
 		Save(ctx)
-	if ent.IsNotFound(err) {
-		r.logger.Warn(fmt.Sprintf("token not found for revocation: %v", err))
-		return errors.NewError(errors.ErrorNotFound, err)
-	}
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to revoke token: %v", err))
+		r.logger.Ctx(ctx).Error("failed to revoke token", zap.Error(err))
 		return errors.NewError(errors.ErrorInternal, err)
 	}
+	if n == 0 {
+		r.logger.Ctx(ctx).Warn("token not found for revocation")
+		return errors.NewError(errors.ErrorNotFound, fmt.Errorf("token not found"))
+	}
 	return nil
 }
+
+// IsSessionActive reports whether the family of auth still has a token that is neither revoked nor expired
+func (r *AuthRepository) IsSessionActive(ctx context.Context, auth domain.Auth) (bool, error) {
+	active, err := r.client.Auth.
+		Query().
+		Where(
+			entAuth.FamilyIDEQ(auth.FamilyID),
+			entAuth.IsRevokedEQ(false),
+			entAuth.ExpiresAtGT(time.Now()),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to check session", zap.Error(err))
+		return false, errors.NewError(errors.ErrorInternal, err)
+	}
+	return active, nil
+}
+
+// GetSessionsByUserID returns the current token of every active session of the user, the
+// most recently used first
+func (r *AuthRepository) GetSessionsByUserID(ctx context.Context, auth domain.Auth) ([]domain.Auth, error) {
+	tokens, err := r.client.Auth.
+		Query().
+		Where(
+			entAuth.UserIDEQ(auth.User.ID),
+			entAuth.ReplacedByIsNil(),
+			entAuth.IsRevokedEQ(false),
+			entAuth.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(entAuth.FieldLastUsedAt)).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to get sessions", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	sessions := make([]domain.Auth, len(tokens))
+	for i, token := range tokens {
+		sessions[i] = mapToken(token)
+	}
+	return sessions, nil
+}
+
+// GetSessionsByClientID returns the current token of every active session the OAuth client
+// auth.Claims.ClientID holds
+func (r *AuthRepository) GetSessionsByClientID(ctx context.Context, auth domain.Auth) ([]domain.Auth, error) {
+	tokens, err := r.client.Auth.
+		Query().
+		Where(
+			entAuth.ClientIDEQ(auth.Claims.ClientID),
+			entAuth.ReplacedByIsNil(),
+			entAuth.IsRevokedEQ(false),
+			entAuth.ExpiresAtGT(time.Now()),
+		).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to get sessions of the client", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	sessions := make([]domain.Auth, len(tokens))
+	for i, token := range tokens {
+		sessions[i] = mapToken(token)
+	}
+	return sessions, nil
+}
+
+func mapToken(token *ent.Auth) domain.Auth {
+	auth := domain.Auth{
+		ID:                    token.ID,
+		FamilyID:              token.FamilyID,
+		RefreshToken:          token.RefreshToken,
+		RefreshTokenExpiresAt: token.ExpiresAt,
+		RefreshTokenIsRevoked: token.IsRevoked,
+		User: domain.User{
+			ID: token.UserID,
+		},
+		Claims: domain.Claims{
+			ID:        token.UserID,
+			SessionID: token.FamilyID,
+			IssuedAt:  token.CreatedAt,
+			ExpiresAt: token.ExpiresAt,
+			ClientID:  token.ClientID,
+			Scope:     token.Scope,
+		},
+		Device: domain.Device{
+			UserAgent:  token.UserAgent,
+			IPAddress:  token.IPAddress,
+			CreatedAt:  token.SessionCreatedAt,
+			LastUsedAt: token.LastUsedAt,
+		},
+	}
+	if token.ReplacedBy != nil {
+		auth.ReplacedBy = *token.ReplacedBy
+	}
+	return auth
+}
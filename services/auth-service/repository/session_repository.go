@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/ports"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// Every ended session is kept in a revokedSession:<family id> key, every revoked bot in a
+// revokedBot:<bot id> key and every revoked OAuth client in a revokedClient:<client id>
+// key, which expire once the access tokens issued before have.
+
+type SessionDenyList struct {
+	client *redis.Client
+	logger *logger.Logger
+}
+
+func NewSessionDenyList(client *redis.Client, logger *logger.Logger) ports.ISessionDenyList {
+	return &SessionDenyList{
+		client: client,
+		logger: logger,
+	}
+}
+
+// DenySession adds the family of auth to the deny list for ttl
+func (r *SessionDenyList) DenySession(ctx context.Context, auth domain.Auth, ttl time.Duration) error {
+	if err := r.client.Set(ctx, deniedSessionKey(auth.FamilyID), 1, ttl).Err(); err != nil {
+		r.logger.Ctx(ctx).Error("failed to deny session in Redis", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+// IsSessionDenied reports whether the family of auth is on the deny list
+func (r *SessionDenyList) IsSessionDenied(ctx context.Context, auth domain.Auth) (bool, error) {
+	n, err := r.client.Exists(ctx, deniedSessionKey(auth.FamilyID)).Result()
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to check session in Redis", zap.Error(err))
+		return false, errors.NewError(errors.ErrorInternal, err)
+	}
+	return n > 0, nil
+}
+
+// DenyBot adds the bot to the deny list for ttl
+func (r *SessionDenyList) DenyBot(ctx context.Context, bot domain.Bot, ttl time.Duration) error {
+	if err := r.client.Set(ctx, deniedBotKey(bot.ID), 1, ttl).Err(); err != nil {
+		r.logger.Ctx(ctx).Error("failed to deny bot in Redis", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+// IsBotDenied reports whether the bot is on the deny list
+func (r *SessionDenyList) IsBotDenied(ctx context.Context, bot domain.Bot) (bool, error) {
+	n, err := r.client.Exists(ctx, deniedBotKey(bot.ID)).Result()
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to check bot in Redis", zap.Error(err))
+		return false, errors.NewError(errors.ErrorInternal, err)
+	}
+	return n > 0, nil
+}
+
+// DenyClient adds the OAuth client to the deny list for ttl
+func (r *SessionDenyList) DenyClient(ctx context.Context, client domain.OAuthClient, ttl time.Duration) error {
+	if err := r.client.Set(ctx, deniedClientKey(client.ClientID), 1, ttl).Err(); err != nil {
+		r.logger.Ctx(ctx).Error("failed to deny OAuth client in Redis", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+// IsClientDenied reports whether the OAuth client is on the deny list
+func (r *SessionDenyList) IsClientDenied(ctx context.Context, client domain.OAuthClient) (bool, error) {
+	n, err := r.client.Exists(ctx, deniedClientKey(client.ClientID)).Result()
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to check OAuth client in Redis", zap.Error(err))
+		return false, errors.NewError(errors.ErrorInternal, err)
+	}
+	return n > 0, nil
+}
+
+func deniedSessionKey(familyID string) string {
+	return fmt.Sprintf("revokedSession:%s", familyID)
+}
+
+func deniedBotKey(botID int) string {
+	return fmt.Sprintf("revokedBot:%d", botID)
+}
+
+func deniedClientKey(clientID string) string {
+	return fmt.Sprintf("revokedClient:%s", clientID)
+}
@@ -0,0 +1,286 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/core/ports"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/encryption"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent"
+	entRecoveryCode "github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/recoverycode"
+	entTwoFactor "github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/twofactor"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
+	"go.uber.org/zap"
+)
+
+// TwoFactorRepository stores the TOTP secrets encrypted with cipher, and hands them out decrypted.
+type TwoFactorRepository struct {
+	client *ent.Client
+	cipher *encryption.Cipher
+	logger *logger.Logger
+}
+
+func NewTwoFactorRepository(client *ent.Client, cipher *encryption.Cipher, logger *logger.Logger) ports.ITwoFactorRepository {
+	return &TwoFactorRepository{
+		client: client,
+		cipher: cipher,
+		logger: logger,
+	}
+}
+
+// CreateTwoFactor is a method to enroll a secret, replacing the one of an enrollment that was never enabled
+func (r *TwoFactorRepository) CreateTwoFactor(ctx context.Context, twoFactor domain.TwoFactor) (domain.TwoFactor, error) {
+	secret, err := r.cipher.Encrypt(twoFactor.Secret, secretAdditionalData(twoFactor.UserID))
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to encrypt two-factor secret", zap.Error(err))
+		return domain.TwoFactor{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to start transaction", zap.Error(err))
+		return domain.TwoFactor{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	_, err = tx.TwoFactor.
+		Delete().
+		Where(
+			entTwoFactor.UserIDEQ(twoFactor.UserID),
+			entTwoFactor.EnabledEQ(false),
+		).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.logger.Ctx(ctx).Error("failed to delete pending two-factor", zap.Error(err))
+		return domain.TwoFactor{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	created, err := tx.TwoFactor.
+		Create().
+		SetUserID(twoFactor.UserID).
+		SetEncryptedSecret(secret).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		_ = tx.Rollback()
+		return domain.TwoFactor{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("two-factor authentication is already enabled"))
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		r.logger.Ctx(ctx).Error("failed to create two-factor", zap.Error(err))
+		return domain.TwoFactor{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Ctx(ctx).Error("failed to commit two-factor enrollment", zap.Error(err))
+		return domain.TwoFactor{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	res := entTwoFactorToDomainTwoFactor(created)
+	res.Secret = twoFactor.Secret
+	return res, nil
+}
+
+// GetTwoFactor is a method to get the two-factor of a user
+func (r *TwoFactorRepository) GetTwoFactor(ctx context.Context, twoFactor domain.TwoFactor) (domain.TwoFactor, error) {
+	found, err := r.client.TwoFactor.
+		Query().
+		Where(entTwoFactor.UserIDEQ(twoFactor.UserID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return domain.TwoFactor{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("two-factor authentication is not enrolled"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to get two-factor", zap.Error(err))
+		return domain.TwoFactor{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	res := entTwoFactorToDomainTwoFactor(found)
+	res.Secret, err = r.cipher.Decrypt(found.EncryptedSecret, secretAdditionalData(found.UserID))
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to decrypt two-factor secret", zap.Uint("user_id", found.UserID), zap.Error(err))
+		return domain.TwoFactor{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return res, nil
+}
+
+// UseTimeStep is a method to record the time step of an accepted TOTP code, which fails with a
+// conflict when a code of that or a later step was already accepted
+func (r *TwoFactorRepository) UseTimeStep(ctx context.Context, twoFactor domain.TwoFactor, step int64) error {
+	n, err := r.client.TwoFactor.
+		Update().
+		Where(
+			entTwoFactor.UserIDEQ(twoFactor.UserID),
+			entTwoFactor.LastStepLT(step),
+		).
+		SetLastStep(step).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to record two-factor time step", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if n == 0 {
+		return errors.NewError(errors.ErrorConflict, fmt.Errorf("two-factor code was already used"))
+	}
+	return nil
+}
+
+// EnableTwoFactor is a method to enable the enrolled two-factor of a user and replace its recovery codes
+func (r *TwoFactorRepository) EnableTwoFactor(ctx context.Context, twoFactor domain.TwoFactor, codes []domain.RecoveryCode) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to start transaction", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+
+	n, err := tx.TwoFactor.
+		Update().
+		Where(
+			entTwoFactor.UserIDEQ(twoFactor.UserID),
+			entTwoFactor.EnabledEQ(false),
+		).
+		SetEnabled(true).
+		SetEnabledAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.logger.Ctx(ctx).Error("failed to enable two-factor", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if n == 0 {
+		_ = tx.Rollback()
+		return errors.NewError(errors.ErrorConflict, fmt.Errorf("two-factor authentication is already enabled"))
+	}
+
+	_, err = tx.RecoveryCode.
+		Delete().
+		Where(entRecoveryCode.UserIDEQ(twoFactor.UserID)).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.logger.Ctx(ctx).Error("failed to delete recovery codes", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+
+	builders := make([]*ent.RecoveryCodeCreate, len(codes))
+	for i, code := range codes {
+		builders[i] = tx.RecoveryCode.
+			Create().
+			SetUserID(twoFactor.UserID).
+			SetCodeHash(code.Hash)
+	}
+	if _, err := tx.RecoveryCode.CreateBulk(builders...).Save(ctx); err != nil {
+		_ = tx.Rollback()
+		r.logger.Ctx(ctx).Error("failed to create recovery codes", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Ctx(ctx).Error("failed to commit two-factor", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+// DeleteTwoFactor is a method to delete the two-factor of a user with its recovery codes
+func (r *TwoFactorRepository) DeleteTwoFactor(ctx context.Context, twoFactor domain.TwoFactor) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to start transaction", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+
+	n, err := tx.TwoFactor.
+		Delete().
+		Where(entTwoFactor.UserIDEQ(twoFactor.UserID)).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.logger.Ctx(ctx).Error("failed to delete two-factor", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if n == 0 {
+		_ = tx.Rollback()
+		return errors.NewError(errors.ErrorNotFound, fmt.Errorf("two-factor authentication is not enrolled"))
+	}
+
+	_, err = tx.RecoveryCode.
+		Delete().
+		Where(entRecoveryCode.UserIDEQ(twoFactor.UserID)).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.logger.Ctx(ctx).Error("failed to delete recovery codes", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Ctx(ctx).Error("failed to commit two-factor deletion", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+// GetRecoveryCodes is a method to get the unused recovery codes of a user
+func (r *TwoFactorRepository) GetRecoveryCodes(ctx context.Context, twoFactor domain.TwoFactor) ([]domain.RecoveryCode, error) {
+	codes, err := r.client.RecoveryCode.
+		Query().
+		Where(
+			entRecoveryCode.UserIDEQ(twoFactor.UserID),
+			entRecoveryCode.UsedAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to get recovery codes", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	res := make([]domain.RecoveryCode, len(codes))
+	for i, code := range codes {
+		res[i] = domain.RecoveryCode{
+			ID:     code.ID,
+			UserID: code.UserID,
+			Hash:   code.CodeHash,
+		}
+	}
+	return res, nil
+}
+
+// UseRecoveryCode is a method to mark a recovery code as used, which fails with a conflict when it already was
+func (r *TwoFactorRepository) UseRecoveryCode(ctx context.Context, code domain.RecoveryCode) error {
+	n, err := r.client.RecoveryCode.
+		Update().
+		Where(
+			entRecoveryCode.IDEQ(code.ID),
+			entRecoveryCode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to use recovery code", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if n == 0 {
+		return errors.NewError(errors.ErrorConflict, fmt.Errorf("recovery code was already used"))
+	}
+	return nil
+}
+
+func entTwoFactorToDomainTwoFactor(twoFactor *ent.TwoFactor) domain.TwoFactor {
+	res := domain.TwoFactor{
+		UserID:  twoFactor.UserID,
+		Enabled: twoFactor.Enabled,
+	}
+	if twoFactor.EnabledAt != nil {
+		res.EnabledAt = *twoFactor.EnabledAt
+	}
+	return res
+}
+
+// secretAdditionalData binds an encrypted secret to its user, so it can't be copied to
+// the row of another user.
+func secretAdditionalData(userID uint) []byte {
+	return []byte(strconv.FormatUint(uint64(userID), 10))
+}
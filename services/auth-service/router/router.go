@@ -3,32 +3,88 @@ package router
 import (
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/handler"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/middleware"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/health"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/metrics"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/tracing"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/swagger"
 )
 
-func SetupAuthRouter(handler *handler.AuthHandler) *fiber.App {
+func SetupAuthRouter(handler *handler.AuthHandler, logger *logger.Logger, checker *health.Checker) *fiber.App {
 	app := fiber.New()
 
+	// Health checks come before the middlewares, so probes are not counted, traced or logged
+	app.Get("/healthz", checker.Liveness)
+	app.Get("/readyz", checker.Readiness)
+
+	// Count and time every request
+	app.Use(metrics.Middleware())
+
+	// Trace every request and pass the span on in the user context
+	app.Use(tracing.Middleware())
+
+	// Accept or assign a request ID and log the request with it
+	app.Use(middleware.RequestIDMiddleware(logger))
+
 	// Configure CORS
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:     "http://localhost:3000,http://localhost:3001,https://localhost:3002", // Comma-separated origins as a single string
 		AllowCredentials: true,                                                                 // Allow cookies and credentials
 		AllowMethods:     "GET,POST,PUT,DELETE",                                                // Specify allowed HTTP methods
-		AllowHeaders:     "Content-Type,Authorization",                                         // Specify allowed headers
+		AllowHeaders:     "Content-Type,Authorization,X-Request-ID",                            // Specify allowed headers
+		ExposeHeaders:    "X-Request-ID",                                                       // Let browsers read the request ID
 	}))
 
+	// Prometheus metrics
+	app.Get("/metrics", metrics.Handler())
+
 	// Define Swagger route first to keep it unaffected by AuthMiddleware.
 	app.Get("/swagger/*", swagger.HandlerDefault)
 
 	// Public routes
 	app.Post("/refresh-token", handler.RefreshToken)
 	app.Post("/login", handler.Login)
+	app.Post("/login-2fa", handler.LoginTwoFactor)
+	app.Post("/bot-token", handler.BotToken)
+	app.Post("/oauth/token", handler.OAuthToken)
+	app.Post("/oauth/revoke", handler.RevokeOAuthToken)
+	app.Get("/.well-known/jwks.json", handler.JWKS)
 
 	// Routes protected by AuthMiddleware
 	app.Post("/logout", middleware.AuthMiddleware(), handler.Logout)
 	app.Post("/revoke-token", middleware.AuthMiddleware(), handler.RevokeToken)
 
+	// Bot routes protected by AuthMiddleware
+	app.Post("/create-bot", middleware.AuthMiddleware(), handler.CreateBot)
+	app.Get("/get-bots", middleware.AuthMiddleware(), handler.GetBots)
+	app.Post("/revoke-bot/:botId", middleware.AuthMiddleware(), handler.RevokeBot)
+
+	// OAuth client routes protected by AuthMiddleware
+	app.Post("/create-oauth-client", middleware.AuthMiddleware(), handler.CreateOAuthClient)
+	app.Get("/get-oauth-clients", middleware.AuthMiddleware(), handler.GetOAuthClients)
+	app.Post("/revoke-oauth-client/:oauthClientId", middleware.AuthMiddleware(), handler.RevokeOAuthClient)
+
+	// OAuth authorization and consent routes protected by AuthMiddleware
+	app.Post("/oauth/authorize", middleware.AuthMiddleware(), handler.AuthorizeOAuth)
+	app.Get("/get-oauth-consents", middleware.AuthMiddleware(), handler.GetOAuthConsents)
+	app.Post("/revoke-oauth-consent/:clientId", middleware.AuthMiddleware(), handler.RevokeOAuthConsent)
+
+	// Session routes protected by AuthMiddleware
+	app.Get("/get-sessions", middleware.AuthMiddleware(), handler.GetSessions)
+	app.Post("/revoke-session/:sessionId", middleware.AuthMiddleware(), handler.RevokeSession)
+	app.Post("/revoke-other-sessions", middleware.AuthMiddleware(), handler.RevokeOtherSessions)
+
+	// Two-factor authentication routes protected by AuthMiddleware
+	app.Post("/enroll-2fa", middleware.AuthMiddleware(), handler.EnrollTwoFactor)
+	app.Post("/enable-2fa", middleware.AuthMiddleware(), handler.EnableTwoFactor)
+	app.Post("/disable-2fa", middleware.AuthMiddleware(), handler.DisableTwoFactor)
+
+	// Admin session routes protected by AuthMiddleware
+	app.Get("/get-user-sessions/:userId", middleware.AuthMiddleware(), handler.GetUserSessions)
+	app.Post("/revoke-user-session/:userId/:sessionId", middleware.AuthMiddleware(), handler.RevokeUserSession)
+	app.Post("/revoke-user-sessions/:userId", middleware.AuthMiddleware(), handler.RevokeUserSessions)
+
 	return app
 }
@@ -0,0 +1,18 @@
+// Package authctx carries the bearer token of a request from the middlewares that
+// read it to the usecases that verify it.
+package authctx
+
+import "context"
+
+type tokenKey struct{}
+
+// WithToken returns a context carrying the bearer token of the request.
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+// Token returns the bearer token of the request, if it has one.
+func Token(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(tokenKey{}).(string)
+	return token, ok
+}
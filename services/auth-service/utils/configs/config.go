@@ -17,11 +17,17 @@ var Module = fx.Options(
 // Config holds the application wide configurations.
 // The values are read by viper from the config file or environment variables.
 type Config struct {
-	Server ServerConfig `mapstructure:"server"`
-	GRPC   GRPCConfig   `mapstructure:"grpc"`
-	PSQL   PSQLConfig   `mapstructure:"postgres"`
-	JWT    JWTConfig    `mapstructure:"jwt"`
-	Redis  Redis        `mapstructure:"redis"`
+	Server  ServerConfig  `mapstructure:"server"`
+	GRPC    GRPCConfig    `mapstructure:"grpc"`
+	PSQL    PSQLConfig    `mapstructure:"postgres"`
+	JWT     JWTConfig     `mapstructure:"jwt"`
+	Redis   Redis         `mapstructure:"redis"`
+	Tracing TracingConfig `mapstructure:"tracing"`
+	Log     LogConfig     `mapstructure:"log"`
+	Health  HealthConfig  `mapstructure:"health"`
+	Session SessionConfig `mapstructure:"session"`
+	MFA     MFAConfig     `mapstructure:"mfa"`
+	OAuth   OAuthConfig   `mapstructure:"oauth"`
 }
 
 type ServerConfig struct {
@@ -46,9 +52,21 @@ type PSQLConfig struct {
 }
 
 type JWTConfig struct {
-	SecretKey            string        `mapstructure:"secret_key"`
-	AccessTokenDuration  time.Duration `mapstructure:"access_token_duration"`
-	RefreshTokenDuration time.Duration `mapstructure:"refresh_token_duration"`
+	// SecretKey signs HS256 tokens when no keys are configured
+	SecretKey            string         `mapstructure:"secret_key"`
+	AccessTokenDuration  time.Duration  `mapstructure:"access_token_duration"`
+	RefreshTokenDuration time.Duration  `mapstructure:"refresh_token_duration"`
+	Keys                 []JWTKeyConfig `mapstructure:"keys"`
+	// RotationOverlap is how long the tokens of a key are still accepted after the next key became active
+	RotationOverlap time.Duration `mapstructure:"rotation_overlap"`
+}
+
+// JWTKeyConfig is an RSA or Ed25519 signing key, which signs the tokens from ActiveFrom
+// (RFC 3339, empty for always) until the next key becomes active.
+type JWTKeyConfig struct {
+	ID             string `mapstructure:"id"`
+	PrivateKeyFile string `mapstructure:"private_key_file"`
+	ActiveFrom     string `mapstructure:"active_from"`
 }
 
 type Redis struct {
@@ -57,6 +75,53 @@ type Redis struct {
 	DB       int    `mapstructure:"db"`
 }
 
+// TracingConfig selects where spans are exported: none, stdout or an OTLP gRPC collector.
+type TracingConfig struct {
+	Exporter    string  `mapstructure:"exporter"`
+	Endpoint    string  `mapstructure:"endpoint"`
+	Insecure    bool    `mapstructure:"insecure"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+	ServiceName string  `mapstructure:"service_name"`
+}
+
+// LogConfig holds the minimum level of the JSON logs: debug, info, warn or error.
+type LogConfig struct {
+	Level string `mapstructure:"level"`
+}
+
+// HealthConfig sets how often the dependencies are checked to update the gRPC health
+// status and how long a single dependency check may take.
+type HealthConfig struct {
+	Interval time.Duration `mapstructure:"interval"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+}
+
+// SessionConfig sets how long an instance remembers whether a session is active, which
+// is how long a logout or a revocation may take to stop its access tokens being accepted.
+type SessionConfig struct {
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+}
+
+// MFAConfig sets up two-factor authentication: the issuer shown by authenticator apps, how
+// long a login may wait for its second factor, how many recovery codes are issued, and how
+// many codes a user may try within the attempt window. The TOTP secrets are encrypted with
+// the key in the encryption key file.
+type MFAConfig struct {
+	Issuer        string        `mapstructure:"issuer"`
+	ChallengeTTL  time.Duration `mapstructure:"challenge_ttl"`
+	RecoveryCodes int           `mapstructure:"recovery_codes"`
+	MaxAttempts   int64         `mapstructure:"max_attempts"`
+	AttemptWindow time.Duration `mapstructure:"attempt_window"`
+	// EncryptionKeyFile holds 32 random bytes encoded as base64
+	EncryptionKeyFile string `mapstructure:"encryption_key_file"`
+}
+
+// OAuthConfig sets up the OAuth 2.0 authorization server: how long an authorization code
+// may wait to be exchanged for tokens.
+type OAuthConfig struct {
+	CodeTTL time.Duration `mapstructure:"code_ttl"`
+}
+
 // NewConfig creates a new Config instance.
 func NewConfig() *Config {
 	return &Config{}
@@ -109,6 +174,31 @@ func LoadConfig(path string, logger *logger.Logger) (*Config, error) {
 		return nil, err
 	}
 
+	if err := validateTracingConfig(config.Tracing); err != nil {
+		return nil, err
+	}
+
+	// Apply the log level, which also validates it
+	if err := logger.SetLevel(config.Log.Level); err != nil {
+		return nil, fmt.Errorf("invalid log level: %w", err)
+	}
+
+	if err := validateHealthConfig(config.Health); err != nil {
+		return nil, err
+	}
+
+	if err := validateSessionConfig(config.Session); err != nil {
+		return nil, err
+	}
+
+	if err := validateMFAConfig(config.MFA); err != nil {
+		return nil, err
+	}
+
+	if err := validateOAuthConfig(config.OAuth); err != nil {
+		return nil, err
+	}
+
 	return &config, nil
 }
 
@@ -131,10 +221,32 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("jwt.secret_key", "abcd1234abcd1234abcd1234")
 	v.SetDefault("jwt.access_token_duration", "15m")
 	v.SetDefault("jwt.refresh_token_duration", "24h")
+	v.SetDefault("jwt.rotation_overlap", "1h")
 
 	v.SetDefault("redis.addr", "localhost:6379")
 	v.SetDefault("redis.password", "")
 	v.SetDefault("redis.db", 0)
+
+	v.SetDefault("tracing.exporter", "none")
+	v.SetDefault("tracing.endpoint", "localhost:4317")
+	v.SetDefault("tracing.insecure", true)
+	v.SetDefault("tracing.sample_ratio", 1)
+	v.SetDefault("tracing.service_name", "auth-service")
+
+	v.SetDefault("log.level", "info")
+
+	v.SetDefault("health.interval", "10s")
+	v.SetDefault("health.timeout", "2s")
+
+	v.SetDefault("session.cache_ttl", "5s")
+
+	v.SetDefault("mfa.issuer", "Chat Room")
+	v.SetDefault("mfa.challenge_ttl", "5m")
+	v.SetDefault("mfa.recovery_codes", 10)
+	v.SetDefault("mfa.max_attempts", 5)
+	v.SetDefault("mfa.attempt_window", "15m")
+
+	v.SetDefault("oauth.code_ttl", "1m")
 }
 
 // validateServerConfig ensures that essential server config values are present.
@@ -184,8 +296,8 @@ func validatePSQLConfig(psqlConfig PSQLConfig) error {
 
 // validateJWTConfig ensures that essential JWT config values are present.
 func validateJWTConfig(jwtConfig JWTConfig) error {
-	if jwtConfig.SecretKey == "" {
-		return fmt.Errorf("jwt secret key is required")
+	if jwtConfig.SecretKey == "" && len(jwtConfig.Keys) == 0 {
+		return fmt.Errorf("jwt secret key or keys are required")
 	}
 	if jwtConfig.AccessTokenDuration == 0 {
 		return fmt.Errorf("jwt access token duration is required")
@@ -193,6 +305,27 @@ func validateJWTConfig(jwtConfig JWTConfig) error {
 	if jwtConfig.RefreshTokenDuration == 0 {
 		return fmt.Errorf("jwt refresh token duration is required")
 	}
+	if jwtConfig.RotationOverlap < jwtConfig.AccessTokenDuration {
+		return fmt.Errorf("jwt rotation overlap must be at least the access token duration")
+	}
+	ids := make(map[string]bool, len(jwtConfig.Keys))
+	for _, key := range jwtConfig.Keys {
+		if key.ID == "" {
+			return fmt.Errorf("jwt key id is required")
+		}
+		if ids[key.ID] {
+			return fmt.Errorf("jwt key id %s is duplicated", key.ID)
+		}
+		ids[key.ID] = true
+		if key.PrivateKeyFile == "" {
+			return fmt.Errorf("jwt key %s private key file is required", key.ID)
+		}
+		if key.ActiveFrom != "" {
+			if _, err := time.Parse(time.RFC3339, key.ActiveFrom); err != nil {
+				return fmt.Errorf("jwt key %s active_from must be an RFC 3339 time: %w", key.ID, err)
+			}
+		}
+	}
 	return nil
 }
 
@@ -204,6 +337,76 @@ func validateRedisConfig(redisConfig Redis) error {
 	return nil
 }
 
+// validateTracingConfig ensures that the exporter is known and the sample ratio is a fraction.
+func validateTracingConfig(tracingConfig TracingConfig) error {
+	switch tracingConfig.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if tracingConfig.Endpoint == "" {
+			return fmt.Errorf("tracing endpoint is required for the otlp exporter")
+		}
+	default:
+		return fmt.Errorf("tracing exporter must be none, stdout or otlp")
+	}
+	if tracingConfig.SampleRatio < 0 || tracingConfig.SampleRatio > 1 {
+		return fmt.Errorf("tracing sample ratio must be between 0 and 1")
+	}
+	if tracingConfig.ServiceName == "" {
+		return fmt.Errorf("tracing service name is required")
+	}
+	return nil
+}
+
+// validateHealthConfig ensures that the checks are repeated and time out.
+func validateHealthConfig(healthConfig HealthConfig) error {
+	if healthConfig.Interval <= 0 {
+		return fmt.Errorf("health check interval is required")
+	}
+	if healthConfig.Timeout <= 0 {
+		return fmt.Errorf("health check timeout is required")
+	}
+	return nil
+}
+
+// validateSessionConfig ensures that the session cache entries expire.
+func validateSessionConfig(sessionConfig SessionConfig) error {
+	if sessionConfig.CacheTTL <= 0 {
+		return fmt.Errorf("session cache ttl is required")
+	}
+	return nil
+}
+
+// validateMFAConfig ensures that challenges expire, code attempts are limited and secrets are encrypted.
+func validateMFAConfig(mfaConfig MFAConfig) error {
+	if mfaConfig.Issuer == "" {
+		return fmt.Errorf("mfa issuer is required")
+	}
+	if mfaConfig.ChallengeTTL <= 0 {
+		return fmt.Errorf("mfa challenge ttl is required")
+	}
+	if mfaConfig.RecoveryCodes <= 0 {
+		return fmt.Errorf("mfa recovery codes must be positive")
+	}
+	if mfaConfig.MaxAttempts <= 0 {
+		return fmt.Errorf("mfa max attempts must be positive")
+	}
+	if mfaConfig.EncryptionKeyFile == "" {
+		return fmt.Errorf("mfa encryption key file is required")
+	}
+	if mfaConfig.AttemptWindow <= 0 {
+		return fmt.Errorf("mfa attempt window is required")
+	}
+	return nil
+}
+
+// validateOAuthConfig ensures that authorization codes expire.
+func validateOAuthConfig(oauthConfig OAuthConfig) error {
+	if oauthConfig.CodeTTL <= 0 {
+		return fmt.Errorf("oauth code ttl is required")
+	}
+	return nil
+}
+
 // ProvideConfig is an fx provider that loads the configuration.
 func ProvideConfig(logger *logger.Logger) (*Config, error) {
 	return LoadConfig(".", logger)
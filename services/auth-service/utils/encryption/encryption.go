@@ -0,0 +1,85 @@
+// Package encryption encrypts the secrets the auth service has to store readable, such as
+// the TOTP secrets, with AES-256-GCM and a key that is kept out of the database.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/configs"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewCipher),
+)
+
+// prefix names the format of the values sealed by a Cipher.
+const prefix = "v1:"
+
+// Cipher seals and opens values with the key read from mfa.encryption_key_file.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher reads the key file, which holds 32 random bytes encoded as base64.
+func NewCipher(config *configs.Config) (*Cipher, error) {
+	data, err := os.ReadFile(config.MFA.EncryptionKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read encryption key: %w", err)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%s holds no base64 key: %w", config.MFA.EncryptionKeyFile, err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("%s holds a %d byte key, expected 32", config.MFA.EncryptionKeyFile, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Encrypt seals plaintext under a random nonce. The value only opens with the same
+// additionalData, such as the ID of the row it is stored in, so it can't be moved to
+// another row.
+func (c *Cipher) Encrypt(plaintext string, additionalData []byte) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), additionalData)
+	return prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a value sealed by Encrypt with the same additionalData.
+func (c *Cipher) Decrypt(ciphertext string, additionalData []byte) (string, error) {
+	if !strings.HasPrefix(ciphertext, prefix) {
+		return "", fmt.Errorf("value is not encrypted")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(ciphertext, prefix))
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < c.aead.NonceSize() {
+		return "", fmt.Errorf("encrypted value is too short")
+	}
+	nonce, sealed := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, sealed, additionalData)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
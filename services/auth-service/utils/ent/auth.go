@@ -17,16 +17,32 @@ type Auth struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// FamilyID holds the value of the "family_id" field.
+	FamilyID string `json:"family_id,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID uint `json:"user_id,omitempty"`
 	// RefreshToken holds the value of the "refresh_token" field.
 	RefreshToken string `json:"refresh_token,omitempty"`
+	// ReplacedBy holds the value of the "replaced_by" field.
+	ReplacedBy *string `json:"replaced_by,omitempty"`
 	// IsRevoked holds the value of the "is_revoked" field.
 	IsRevoked bool `json:"is_revoked,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// ExpiresAt holds the value of the "expires_at" field.
-	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// IPAddress holds the value of the "ip_address" field.
+	IPAddress string `json:"ip_address,omitempty"`
+	// SessionCreatedAt holds the value of the "session_created_at" field.
+	SessionCreatedAt time.Time `json:"session_created_at,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt time.Time `json:"last_used_at,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// Scope holds the value of the "scope" field.
+	Scope        string `json:"scope,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -39,9 +55,9 @@ func (*Auth) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case auth.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case auth.FieldID, auth.FieldRefreshToken:
+		case auth.FieldID, auth.FieldFamilyID, auth.FieldRefreshToken, auth.FieldReplacedBy, auth.FieldUserAgent, auth.FieldIPAddress, auth.FieldClientID, auth.FieldScope:
 			values[i] = new(sql.NullString)
-		case auth.FieldCreatedAt, auth.FieldExpiresAt:
+		case auth.FieldCreatedAt, auth.FieldExpiresAt, auth.FieldSessionCreatedAt, auth.FieldLastUsedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -64,6 +80,12 @@ func (a *Auth) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.ID = value.String
 			}
+		case auth.FieldFamilyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field family_id", values[i])
+			} else if value.Valid {
+				a.FamilyID = value.String
+			}
 		case auth.FieldUserID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field user_id", values[i])
@@ -76,6 +98,13 @@ func (a *Auth) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.RefreshToken = value.String
 			}
+		case auth.FieldReplacedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field replaced_by", values[i])
+			} else if value.Valid {
+				a.ReplacedBy = new(string)
+				*a.ReplacedBy = value.String
+			}
 		case auth.FieldIsRevoked:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_revoked", values[i])
@@ -94,6 +123,42 @@ func (a *Auth) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.ExpiresAt = value.Time
 			}
+		case auth.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				a.UserAgent = value.String
+			}
+		case auth.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				a.IPAddress = value.String
+			}
+		case auth.FieldSessionCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field session_created_at", values[i])
+			} else if value.Valid {
+				a.SessionCreatedAt = value.Time
+			}
+		case auth.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				a.LastUsedAt = value.Time
+			}
+		case auth.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				a.ClientID = value.String
+			}
+		case auth.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				a.Scope = value.String
+			}
 		default:
 			a.selectValues.Set(columns[i], values[i])
 		}
@@ -130,12 +195,20 @@ func (a *Auth) String() string {
 	var builder strings.Builder
 	builder.WriteString("Auth(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("family_id=")
+	builder.WriteString(a.FamilyID)
+	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", a.UserID))
 	builder.WriteString(", ")
 	builder.WriteString("refresh_token=")
 	builder.WriteString(a.RefreshToken)
 	builder.WriteString(", ")
+	if v := a.ReplacedBy; v != nil {
+		builder.WriteString("replaced_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("is_revoked=")
 	builder.WriteString(fmt.Sprintf("%v", a.IsRevoked))
 	builder.WriteString(", ")
@@ -144,6 +217,24 @@ func (a *Auth) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("expires_at=")
 	builder.WriteString(a.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(a.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("ip_address=")
+	builder.WriteString(a.IPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("session_created_at=")
+	builder.WriteString(a.SessionCreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_used_at=")
+	builder.WriteString(a.LastUsedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(a.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(a.Scope)
 	builder.WriteByte(')')
 	return builder.String()
 }
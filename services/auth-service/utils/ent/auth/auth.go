@@ -13,16 +13,32 @@ const (
 	Label = "auth"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldFamilyID holds the string denoting the family_id field in the database.
+	FieldFamilyID = "family_id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
 	// FieldRefreshToken holds the string denoting the refresh_token field in the database.
 	FieldRefreshToken = "refresh_token"
+	// FieldReplacedBy holds the string denoting the replaced_by field in the database.
+	FieldReplacedBy = "replaced_by"
 	// FieldIsRevoked holds the string denoting the is_revoked field in the database.
 	FieldIsRevoked = "is_revoked"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldExpiresAt holds the string denoting the expires_at field in the database.
 	FieldExpiresAt = "expires_at"
+	// FieldUserAgent holds the string denoting the user_agent field in the database.
+	FieldUserAgent = "user_agent"
+	// FieldIPAddress holds the string denoting the ip_address field in the database.
+	FieldIPAddress = "ip_address"
+	// FieldSessionCreatedAt holds the string denoting the session_created_at field in the database.
+	FieldSessionCreatedAt = "session_created_at"
+	// FieldLastUsedAt holds the string denoting the last_used_at field in the database.
+	FieldLastUsedAt = "last_used_at"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
 	// Table holds the table name of the auth in the database.
 	Table = "auths"
 )
@@ -30,11 +46,19 @@ const (
 // Columns holds all SQL columns for auth fields.
 var Columns = []string{
 	FieldID,
+	FieldFamilyID,
 	FieldUserID,
 	FieldRefreshToken,
+	FieldReplacedBy,
 	FieldIsRevoked,
 	FieldCreatedAt,
 	FieldExpiresAt,
+	FieldUserAgent,
+	FieldIPAddress,
+	FieldSessionCreatedAt,
+	FieldLastUsedAt,
+	FieldClientID,
+	FieldScope,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -48,12 +72,26 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// FamilyIDValidator is a validator for the "family_id" field. It is called by the builders before save.
+	FamilyIDValidator func(string) error
 	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
 	UserIDValidator func(uint) error
 	// DefaultIsRevoked holds the default value on creation for the "is_revoked" field.
 	DefaultIsRevoked bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultUserAgent holds the default value on creation for the "user_agent" field.
+	DefaultUserAgent string
+	// DefaultIPAddress holds the default value on creation for the "ip_address" field.
+	DefaultIPAddress string
+	// DefaultSessionCreatedAt holds the default value on creation for the "session_created_at" field.
+	DefaultSessionCreatedAt func() time.Time
+	// DefaultLastUsedAt holds the default value on creation for the "last_used_at" field.
+	DefaultLastUsedAt func() time.Time
+	// DefaultClientID holds the default value on creation for the "client_id" field.
+	DefaultClientID string
+	// DefaultScope holds the default value on creation for the "scope" field.
+	DefaultScope string
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -66,6 +104,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByFamilyID orders the results by the family_id field.
+func ByFamilyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFamilyID, opts...).ToFunc()
+}
+
 // ByUserID orders the results by the user_id field.
 func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
@@ -76,6 +119,11 @@ func ByRefreshToken(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRefreshToken, opts...).ToFunc()
 }
 
+// ByReplacedBy orders the results by the replaced_by field.
+func ByReplacedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplacedBy, opts...).ToFunc()
+}
+
 // ByIsRevoked orders the results by the is_revoked field.
 func ByIsRevoked(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsRevoked, opts...).ToFunc()
@@ -90,3 +138,33 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
 }
+
+// ByUserAgent orders the results by the user_agent field.
+func ByUserAgent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAgent, opts...).ToFunc()
+}
+
+// ByIPAddress orders the results by the ip_address field.
+func ByIPAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIPAddress, opts...).ToFunc()
+}
+
+// BySessionCreatedAt orders the results by the session_created_at field.
+func BySessionCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionCreatedAt, opts...).ToFunc()
+}
+
+// ByLastUsedAt orders the results by the last_used_at field.
+func ByLastUsedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUsedAt, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByScope orders the results by the scope field.
+func ByScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScope, opts...).ToFunc()
+}
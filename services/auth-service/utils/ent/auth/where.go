@@ -64,6 +64,11 @@ func IDContainsFold(id string) predicate.Auth {
 	return predicate.Auth(sql.FieldContainsFold(FieldID, id))
 }
 
+// FamilyID applies equality check predicate on the "family_id" field. It's identical to FamilyIDEQ.
+func FamilyID(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldFamilyID, v))
+}
+
 // UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
 func UserID(v uint) predicate.Auth {
 	return predicate.Auth(sql.FieldEQ(FieldUserID, v))
@@ -74,6 +79,11 @@ func RefreshToken(v string) predicate.Auth {
 	return predicate.Auth(sql.FieldEQ(FieldRefreshToken, v))
 }
 
+// ReplacedBy applies equality check predicate on the "replaced_by" field. It's identical to ReplacedByEQ.
+func ReplacedBy(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldReplacedBy, v))
+}
+
 // IsRevoked applies equality check predicate on the "is_revoked" field. It's identical to IsRevokedEQ.
 func IsRevoked(v bool) predicate.Auth {
 	return predicate.Auth(sql.FieldEQ(FieldIsRevoked, v))
@@ -89,6 +99,101 @@ func ExpiresAt(v time.Time) predicate.Auth {
 	return predicate.Auth(sql.FieldEQ(FieldExpiresAt, v))
 }
 
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// IPAddress applies equality check predicate on the "ip_address" field. It's identical to IPAddressEQ.
+func IPAddress(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// SessionCreatedAt applies equality check predicate on the "session_created_at" field. It's identical to SessionCreatedAtEQ.
+func SessionCreatedAt(v time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldSessionCreatedAt, v))
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldClientID, v))
+}
+
+// Scope applies equality check predicate on the "scope" field. It's identical to ScopeEQ.
+func Scope(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldScope, v))
+}
+
+// FamilyIDEQ applies the EQ predicate on the "family_id" field.
+func FamilyIDEQ(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldFamilyID, v))
+}
+
+// FamilyIDNEQ applies the NEQ predicate on the "family_id" field.
+func FamilyIDNEQ(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldNEQ(FieldFamilyID, v))
+}
+
+// FamilyIDIn applies the In predicate on the "family_id" field.
+func FamilyIDIn(vs ...string) predicate.Auth {
+	return predicate.Auth(sql.FieldIn(FieldFamilyID, vs...))
+}
+
+// FamilyIDNotIn applies the NotIn predicate on the "family_id" field.
+func FamilyIDNotIn(vs ...string) predicate.Auth {
+	return predicate.Auth(sql.FieldNotIn(FieldFamilyID, vs...))
+}
+
+// FamilyIDGT applies the GT predicate on the "family_id" field.
+func FamilyIDGT(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldGT(FieldFamilyID, v))
+}
+
+// FamilyIDGTE applies the GTE predicate on the "family_id" field.
+func FamilyIDGTE(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldGTE(FieldFamilyID, v))
+}
+
+// FamilyIDLT applies the LT predicate on the "family_id" field.
+func FamilyIDLT(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldLT(FieldFamilyID, v))
+}
+
+// FamilyIDLTE applies the LTE predicate on the "family_id" field.
+func FamilyIDLTE(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldLTE(FieldFamilyID, v))
+}
+
+// FamilyIDContains applies the Contains predicate on the "family_id" field.
+func FamilyIDContains(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldContains(FieldFamilyID, v))
+}
+
+// FamilyIDHasPrefix applies the HasPrefix predicate on the "family_id" field.
+func FamilyIDHasPrefix(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldHasPrefix(FieldFamilyID, v))
+}
+
+// FamilyIDHasSuffix applies the HasSuffix predicate on the "family_id" field.
+func FamilyIDHasSuffix(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldHasSuffix(FieldFamilyID, v))
+}
+
+// FamilyIDEqualFold applies the EqualFold predicate on the "family_id" field.
+func FamilyIDEqualFold(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEqualFold(FieldFamilyID, v))
+}
+
+// FamilyIDContainsFold applies the ContainsFold predicate on the "family_id" field.
+func FamilyIDContainsFold(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldContainsFold(FieldFamilyID, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v uint) predicate.Auth {
 	return predicate.Auth(sql.FieldEQ(FieldUserID, v))
@@ -194,6 +299,81 @@ func RefreshTokenContainsFold(v string) predicate.Auth {
 	return predicate.Auth(sql.FieldContainsFold(FieldRefreshToken, v))
 }
 
+// ReplacedByEQ applies the EQ predicate on the "replaced_by" field.
+func ReplacedByEQ(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldReplacedBy, v))
+}
+
+// ReplacedByNEQ applies the NEQ predicate on the "replaced_by" field.
+func ReplacedByNEQ(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldNEQ(FieldReplacedBy, v))
+}
+
+// ReplacedByIn applies the In predicate on the "replaced_by" field.
+func ReplacedByIn(vs ...string) predicate.Auth {
+	return predicate.Auth(sql.FieldIn(FieldReplacedBy, vs...))
+}
+
+// ReplacedByNotIn applies the NotIn predicate on the "replaced_by" field.
+func ReplacedByNotIn(vs ...string) predicate.Auth {
+	return predicate.Auth(sql.FieldNotIn(FieldReplacedBy, vs...))
+}
+
+// ReplacedByGT applies the GT predicate on the "replaced_by" field.
+func ReplacedByGT(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldGT(FieldReplacedBy, v))
+}
+
+// ReplacedByGTE applies the GTE predicate on the "replaced_by" field.
+func ReplacedByGTE(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldGTE(FieldReplacedBy, v))
+}
+
+// ReplacedByLT applies the LT predicate on the "replaced_by" field.
+func ReplacedByLT(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldLT(FieldReplacedBy, v))
+}
+
+// ReplacedByLTE applies the LTE predicate on the "replaced_by" field.
+func ReplacedByLTE(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldLTE(FieldReplacedBy, v))
+}
+
+// ReplacedByContains applies the Contains predicate on the "replaced_by" field.
+func ReplacedByContains(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldContains(FieldReplacedBy, v))
+}
+
+// ReplacedByHasPrefix applies the HasPrefix predicate on the "replaced_by" field.
+func ReplacedByHasPrefix(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldHasPrefix(FieldReplacedBy, v))
+}
+
+// ReplacedByHasSuffix applies the HasSuffix predicate on the "replaced_by" field.
+func ReplacedByHasSuffix(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldHasSuffix(FieldReplacedBy, v))
+}
+
+// ReplacedByIsNil applies the IsNil predicate on the "replaced_by" field.
+func ReplacedByIsNil() predicate.Auth {
+	return predicate.Auth(sql.FieldIsNull(FieldReplacedBy))
+}
+
+// ReplacedByNotNil applies the NotNil predicate on the "replaced_by" field.
+func ReplacedByNotNil() predicate.Auth {
+	return predicate.Auth(sql.FieldNotNull(FieldReplacedBy))
+}
+
+// ReplacedByEqualFold applies the EqualFold predicate on the "replaced_by" field.
+func ReplacedByEqualFold(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEqualFold(FieldReplacedBy, v))
+}
+
+// ReplacedByContainsFold applies the ContainsFold predicate on the "replaced_by" field.
+func ReplacedByContainsFold(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldContainsFold(FieldReplacedBy, v))
+}
+
 // IsRevokedEQ applies the EQ predicate on the "is_revoked" field.
 func IsRevokedEQ(v bool) predicate.Auth {
 	return predicate.Auth(sql.FieldEQ(FieldIsRevoked, v))
@@ -284,6 +464,346 @@ func ExpiresAtLTE(v time.Time) predicate.Auth {
 	return predicate.Auth(sql.FieldLTE(FieldExpiresAt, v))
 }
 
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.Auth {
+	return predicate.Auth(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.Auth {
+	return predicate.Auth(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// IPAddressEQ applies the EQ predicate on the "ip_address" field.
+func IPAddressEQ(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// IPAddressNEQ applies the NEQ predicate on the "ip_address" field.
+func IPAddressNEQ(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldNEQ(FieldIPAddress, v))
+}
+
+// IPAddressIn applies the In predicate on the "ip_address" field.
+func IPAddressIn(vs ...string) predicate.Auth {
+	return predicate.Auth(sql.FieldIn(FieldIPAddress, vs...))
+}
+
+// IPAddressNotIn applies the NotIn predicate on the "ip_address" field.
+func IPAddressNotIn(vs ...string) predicate.Auth {
+	return predicate.Auth(sql.FieldNotIn(FieldIPAddress, vs...))
+}
+
+// IPAddressGT applies the GT predicate on the "ip_address" field.
+func IPAddressGT(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldGT(FieldIPAddress, v))
+}
+
+// IPAddressGTE applies the GTE predicate on the "ip_address" field.
+func IPAddressGTE(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldGTE(FieldIPAddress, v))
+}
+
+// IPAddressLT applies the LT predicate on the "ip_address" field.
+func IPAddressLT(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldLT(FieldIPAddress, v))
+}
+
+// IPAddressLTE applies the LTE predicate on the "ip_address" field.
+func IPAddressLTE(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldLTE(FieldIPAddress, v))
+}
+
+// IPAddressContains applies the Contains predicate on the "ip_address" field.
+func IPAddressContains(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldContains(FieldIPAddress, v))
+}
+
+// IPAddressHasPrefix applies the HasPrefix predicate on the "ip_address" field.
+func IPAddressHasPrefix(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldHasPrefix(FieldIPAddress, v))
+}
+
+// IPAddressHasSuffix applies the HasSuffix predicate on the "ip_address" field.
+func IPAddressHasSuffix(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldHasSuffix(FieldIPAddress, v))
+}
+
+// IPAddressEqualFold applies the EqualFold predicate on the "ip_address" field.
+func IPAddressEqualFold(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEqualFold(FieldIPAddress, v))
+}
+
+// IPAddressContainsFold applies the ContainsFold predicate on the "ip_address" field.
+func IPAddressContainsFold(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldContainsFold(FieldIPAddress, v))
+}
+
+// SessionCreatedAtEQ applies the EQ predicate on the "session_created_at" field.
+func SessionCreatedAtEQ(v time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldSessionCreatedAt, v))
+}
+
+// SessionCreatedAtNEQ applies the NEQ predicate on the "session_created_at" field.
+func SessionCreatedAtNEQ(v time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldNEQ(FieldSessionCreatedAt, v))
+}
+
+// SessionCreatedAtIn applies the In predicate on the "session_created_at" field.
+func SessionCreatedAtIn(vs ...time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldIn(FieldSessionCreatedAt, vs...))
+}
+
+// SessionCreatedAtNotIn applies the NotIn predicate on the "session_created_at" field.
+func SessionCreatedAtNotIn(vs ...time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldNotIn(FieldSessionCreatedAt, vs...))
+}
+
+// SessionCreatedAtGT applies the GT predicate on the "session_created_at" field.
+func SessionCreatedAtGT(v time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldGT(FieldSessionCreatedAt, v))
+}
+
+// SessionCreatedAtGTE applies the GTE predicate on the "session_created_at" field.
+func SessionCreatedAtGTE(v time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldGTE(FieldSessionCreatedAt, v))
+}
+
+// SessionCreatedAtLT applies the LT predicate on the "session_created_at" field.
+func SessionCreatedAtLT(v time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldLT(FieldSessionCreatedAt, v))
+}
+
+// SessionCreatedAtLTE applies the LTE predicate on the "session_created_at" field.
+func SessionCreatedAtLTE(v time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldLTE(FieldSessionCreatedAt, v))
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldNEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldNotIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldGT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldGTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldLT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.Auth {
+	return predicate.Auth(sql.FieldLTE(FieldLastUsedAt, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.Auth {
+	return predicate.Auth(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.Auth {
+	return predicate.Auth(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...string) predicate.Auth {
+	return predicate.Auth(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...string) predicate.Auth {
+	return predicate.Auth(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// ScopeGT applies the GT predicate on the "scope" field.
+func ScopeGT(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldGT(FieldScope, v))
+}
+
+// ScopeGTE applies the GTE predicate on the "scope" field.
+func ScopeGTE(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldGTE(FieldScope, v))
+}
+
+// ScopeLT applies the LT predicate on the "scope" field.
+func ScopeLT(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldLT(FieldScope, v))
+}
+
+// ScopeLTE applies the LTE predicate on the "scope" field.
+func ScopeLTE(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldLTE(FieldScope, v))
+}
+
+// ScopeContains applies the Contains predicate on the "scope" field.
+func ScopeContains(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldContains(FieldScope, v))
+}
+
+// ScopeHasPrefix applies the HasPrefix predicate on the "scope" field.
+func ScopeHasPrefix(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldHasPrefix(FieldScope, v))
+}
+
+// ScopeHasSuffix applies the HasSuffix predicate on the "scope" field.
+func ScopeHasSuffix(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldHasSuffix(FieldScope, v))
+}
+
+// ScopeEqualFold applies the EqualFold predicate on the "scope" field.
+func ScopeEqualFold(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldEqualFold(FieldScope, v))
+}
+
+// ScopeContainsFold applies the ContainsFold predicate on the "scope" field.
+func ScopeContainsFold(v string) predicate.Auth {
+	return predicate.Auth(sql.FieldContainsFold(FieldScope, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Auth) predicate.Auth {
 	return predicate.Auth(sql.AndPredicates(predicates...))
@@ -20,6 +20,12 @@ type AuthCreate struct {
 	hooks    []Hook
 }
 
+// SetFamilyID sets the "family_id" field.
+func (ac *AuthCreate) SetFamilyID(s string) *AuthCreate {
+	ac.mutation.SetFamilyID(s)
+	return ac
+}
+
 // SetUserID sets the "user_id" field.
 func (ac *AuthCreate) SetUserID(u uint) *AuthCreate {
 	ac.mutation.SetUserID(u)
@@ -32,6 +38,20 @@ func (ac *AuthCreate) SetRefreshToken(s string) *AuthCreate {
 	return ac
 }
 
+// SetReplacedBy sets the "replaced_by" field.
+func (ac *AuthCreate) SetReplacedBy(s string) *AuthCreate {
+	ac.mutation.SetReplacedBy(s)
+	return ac
+}
+
+// SetNillableReplacedBy sets the "replaced_by" field if the given value is not nil.
+func (ac *AuthCreate) SetNillableReplacedBy(s *string) *AuthCreate {
+	if s != nil {
+		ac.SetReplacedBy(*s)
+	}
+	return ac
+}
+
 // SetIsRevoked sets the "is_revoked" field.
 func (ac *AuthCreate) SetIsRevoked(b bool) *AuthCreate {
 	ac.mutation.SetIsRevoked(b)
@@ -66,6 +86,90 @@ func (ac *AuthCreate) SetExpiresAt(t time.Time) *AuthCreate {
 	return ac
 }
 
+// SetUserAgent sets the "user_agent" field.
+func (ac *AuthCreate) SetUserAgent(s string) *AuthCreate {
+	ac.mutation.SetUserAgent(s)
+	return ac
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (ac *AuthCreate) SetNillableUserAgent(s *string) *AuthCreate {
+	if s != nil {
+		ac.SetUserAgent(*s)
+	}
+	return ac
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (ac *AuthCreate) SetIPAddress(s string) *AuthCreate {
+	ac.mutation.SetIPAddress(s)
+	return ac
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (ac *AuthCreate) SetNillableIPAddress(s *string) *AuthCreate {
+	if s != nil {
+		ac.SetIPAddress(*s)
+	}
+	return ac
+}
+
+// SetSessionCreatedAt sets the "session_created_at" field.
+func (ac *AuthCreate) SetSessionCreatedAt(t time.Time) *AuthCreate {
+	ac.mutation.SetSessionCreatedAt(t)
+	return ac
+}
+
+// SetNillableSessionCreatedAt sets the "session_created_at" field if the given value is not nil.
+func (ac *AuthCreate) SetNillableSessionCreatedAt(t *time.Time) *AuthCreate {
+	if t != nil {
+		ac.SetSessionCreatedAt(*t)
+	}
+	return ac
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (ac *AuthCreate) SetLastUsedAt(t time.Time) *AuthCreate {
+	ac.mutation.SetLastUsedAt(t)
+	return ac
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (ac *AuthCreate) SetNillableLastUsedAt(t *time.Time) *AuthCreate {
+	if t != nil {
+		ac.SetLastUsedAt(*t)
+	}
+	return ac
+}
+
+// SetClientID sets the "client_id" field.
+func (ac *AuthCreate) SetClientID(s string) *AuthCreate {
+	ac.mutation.SetClientID(s)
+	return ac
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (ac *AuthCreate) SetNillableClientID(s *string) *AuthCreate {
+	if s != nil {
+		ac.SetClientID(*s)
+	}
+	return ac
+}
+
+// SetScope sets the "scope" field.
+func (ac *AuthCreate) SetScope(s string) *AuthCreate {
+	ac.mutation.SetScope(s)
+	return ac
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (ac *AuthCreate) SetNillableScope(s *string) *AuthCreate {
+	if s != nil {
+		ac.SetScope(*s)
+	}
+	return ac
+}
+
 // SetID sets the "id" field.
 func (ac *AuthCreate) SetID(s string) *AuthCreate {
 	ac.mutation.SetID(s)
@@ -115,10 +219,42 @@ func (ac *AuthCreate) defaults() {
 		v := auth.DefaultCreatedAt()
 		ac.mutation.SetCreatedAt(v)
 	}
+	if _, ok := ac.mutation.UserAgent(); !ok {
+		v := auth.DefaultUserAgent
+		ac.mutation.SetUserAgent(v)
+	}
+	if _, ok := ac.mutation.IPAddress(); !ok {
+		v := auth.DefaultIPAddress
+		ac.mutation.SetIPAddress(v)
+	}
+	if _, ok := ac.mutation.SessionCreatedAt(); !ok {
+		v := auth.DefaultSessionCreatedAt()
+		ac.mutation.SetSessionCreatedAt(v)
+	}
+	if _, ok := ac.mutation.LastUsedAt(); !ok {
+		v := auth.DefaultLastUsedAt()
+		ac.mutation.SetLastUsedAt(v)
+	}
+	if _, ok := ac.mutation.ClientID(); !ok {
+		v := auth.DefaultClientID
+		ac.mutation.SetClientID(v)
+	}
+	if _, ok := ac.mutation.Scope(); !ok {
+		v := auth.DefaultScope
+		ac.mutation.SetScope(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (ac *AuthCreate) check() error {
+	if _, ok := ac.mutation.FamilyID(); !ok {
+		return &ValidationError{Name: "family_id", err: errors.New(`ent: missing required field "Auth.family_id"`)}
+	}
+	if v, ok := ac.mutation.FamilyID(); ok {
+		if err := auth.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "Auth.family_id": %w`, err)}
+		}
+	}
 	if _, ok := ac.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Auth.user_id"`)}
 	}
@@ -139,6 +275,24 @@ func (ac *AuthCreate) check() error {
 	if _, ok := ac.mutation.ExpiresAt(); !ok {
 		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Auth.expires_at"`)}
 	}
+	if _, ok := ac.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "Auth.user_agent"`)}
+	}
+	if _, ok := ac.mutation.IPAddress(); !ok {
+		return &ValidationError{Name: "ip_address", err: errors.New(`ent: missing required field "Auth.ip_address"`)}
+	}
+	if _, ok := ac.mutation.SessionCreatedAt(); !ok {
+		return &ValidationError{Name: "session_created_at", err: errors.New(`ent: missing required field "Auth.session_created_at"`)}
+	}
+	if _, ok := ac.mutation.LastUsedAt(); !ok {
+		return &ValidationError{Name: "last_used_at", err: errors.New(`ent: missing required field "Auth.last_used_at"`)}
+	}
+	if _, ok := ac.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "Auth.client_id"`)}
+	}
+	if _, ok := ac.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "Auth.scope"`)}
+	}
 	if v, ok := ac.mutation.ID(); ok {
 		if err := auth.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Auth.id": %w`, err)}
@@ -179,6 +333,10 @@ func (ac *AuthCreate) createSpec() (*Auth, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := ac.mutation.FamilyID(); ok {
+		_spec.SetField(auth.FieldFamilyID, field.TypeString, value)
+		_node.FamilyID = value
+	}
 	if value, ok := ac.mutation.UserID(); ok {
 		_spec.SetField(auth.FieldUserID, field.TypeUint, value)
 		_node.UserID = value
@@ -187,6 +345,10 @@ func (ac *AuthCreate) createSpec() (*Auth, *sqlgraph.CreateSpec) {
 		_spec.SetField(auth.FieldRefreshToken, field.TypeString, value)
 		_node.RefreshToken = value
 	}
+	if value, ok := ac.mutation.ReplacedBy(); ok {
+		_spec.SetField(auth.FieldReplacedBy, field.TypeString, value)
+		_node.ReplacedBy = &value
+	}
 	if value, ok := ac.mutation.IsRevoked(); ok {
 		_spec.SetField(auth.FieldIsRevoked, field.TypeBool, value)
 		_node.IsRevoked = value
@@ -199,6 +361,30 @@ func (ac *AuthCreate) createSpec() (*Auth, *sqlgraph.CreateSpec) {
 		_spec.SetField(auth.FieldExpiresAt, field.TypeTime, value)
 		_node.ExpiresAt = value
 	}
+	if value, ok := ac.mutation.UserAgent(); ok {
+		_spec.SetField(auth.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := ac.mutation.IPAddress(); ok {
+		_spec.SetField(auth.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := ac.mutation.SessionCreatedAt(); ok {
+		_spec.SetField(auth.FieldSessionCreatedAt, field.TypeTime, value)
+		_node.SessionCreatedAt = value
+	}
+	if value, ok := ac.mutation.LastUsedAt(); ok {
+		_spec.SetField(auth.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = value
+	}
+	if value, ok := ac.mutation.ClientID(); ok {
+		_spec.SetField(auth.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := ac.mutation.Scope(); ok {
+		_spec.SetField(auth.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
 	return _node, _spec
 }
 
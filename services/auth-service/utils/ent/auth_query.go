@@ -262,12 +262,12 @@ func (aq *AuthQuery) Clone() *AuthQuery {
 // Example:
 //
 //	var v []struct {
-//		UserID uint `json:"user_id,omitempty"`
+//		FamilyID string `json:"family_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Auth.Query().
-//		GroupBy(auth.FieldUserID).
+//		GroupBy(auth.FieldFamilyID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (aq *AuthQuery) GroupBy(field string, fields ...string) *AuthGroupBy {
@@ -285,11 +285,11 @@ func (aq *AuthQuery) GroupBy(field string, fields ...string) *AuthGroupBy {
 // Example:
 //
 //	var v []struct {
-//		UserID uint `json:"user_id,omitempty"`
+//		FamilyID string `json:"family_id,omitempty"`
 //	}
 //
 //	client.Auth.Query().
-//		Select(auth.FieldUserID).
+//		Select(auth.FieldFamilyID).
 //		Scan(ctx, &v)
 func (aq *AuthQuery) Select(fields ...string) *AuthSelect {
 	aq.ctx.Fields = append(aq.ctx.Fields, fields...)
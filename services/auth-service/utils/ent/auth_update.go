@@ -28,6 +28,20 @@ func (au *AuthUpdate) Where(ps ...predicate.Auth) *AuthUpdate {
 	return au
 }
 
+// SetFamilyID sets the "family_id" field.
+func (au *AuthUpdate) SetFamilyID(s string) *AuthUpdate {
+	au.mutation.SetFamilyID(s)
+	return au
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (au *AuthUpdate) SetNillableFamilyID(s *string) *AuthUpdate {
+	if s != nil {
+		au.SetFamilyID(*s)
+	}
+	return au
+}
+
 // SetUserID sets the "user_id" field.
 func (au *AuthUpdate) SetUserID(u uint) *AuthUpdate {
 	au.mutation.ResetUserID()
@@ -63,6 +77,26 @@ func (au *AuthUpdate) SetNillableRefreshToken(s *string) *AuthUpdate {
 	return au
 }
 
+// SetReplacedBy sets the "replaced_by" field.
+func (au *AuthUpdate) SetReplacedBy(s string) *AuthUpdate {
+	au.mutation.SetReplacedBy(s)
+	return au
+}
+
+// SetNillableReplacedBy sets the "replaced_by" field if the given value is not nil.
+func (au *AuthUpdate) SetNillableReplacedBy(s *string) *AuthUpdate {
+	if s != nil {
+		au.SetReplacedBy(*s)
+	}
+	return au
+}
+
+// ClearReplacedBy clears the value of the "replaced_by" field.
+func (au *AuthUpdate) ClearReplacedBy() *AuthUpdate {
+	au.mutation.ClearReplacedBy()
+	return au
+}
+
 // SetIsRevoked sets the "is_revoked" field.
 func (au *AuthUpdate) SetIsRevoked(b bool) *AuthUpdate {
 	au.mutation.SetIsRevoked(b)
@@ -105,6 +139,90 @@ func (au *AuthUpdate) SetNillableExpiresAt(t *time.Time) *AuthUpdate {
 	return au
 }
 
+// SetUserAgent sets the "user_agent" field.
+func (au *AuthUpdate) SetUserAgent(s string) *AuthUpdate {
+	au.mutation.SetUserAgent(s)
+	return au
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (au *AuthUpdate) SetNillableUserAgent(s *string) *AuthUpdate {
+	if s != nil {
+		au.SetUserAgent(*s)
+	}
+	return au
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (au *AuthUpdate) SetIPAddress(s string) *AuthUpdate {
+	au.mutation.SetIPAddress(s)
+	return au
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (au *AuthUpdate) SetNillableIPAddress(s *string) *AuthUpdate {
+	if s != nil {
+		au.SetIPAddress(*s)
+	}
+	return au
+}
+
+// SetSessionCreatedAt sets the "session_created_at" field.
+func (au *AuthUpdate) SetSessionCreatedAt(t time.Time) *AuthUpdate {
+	au.mutation.SetSessionCreatedAt(t)
+	return au
+}
+
+// SetNillableSessionCreatedAt sets the "session_created_at" field if the given value is not nil.
+func (au *AuthUpdate) SetNillableSessionCreatedAt(t *time.Time) *AuthUpdate {
+	if t != nil {
+		au.SetSessionCreatedAt(*t)
+	}
+	return au
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (au *AuthUpdate) SetLastUsedAt(t time.Time) *AuthUpdate {
+	au.mutation.SetLastUsedAt(t)
+	return au
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (au *AuthUpdate) SetNillableLastUsedAt(t *time.Time) *AuthUpdate {
+	if t != nil {
+		au.SetLastUsedAt(*t)
+	}
+	return au
+}
+
+// SetClientID sets the "client_id" field.
+func (au *AuthUpdate) SetClientID(s string) *AuthUpdate {
+	au.mutation.SetClientID(s)
+	return au
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (au *AuthUpdate) SetNillableClientID(s *string) *AuthUpdate {
+	if s != nil {
+		au.SetClientID(*s)
+	}
+	return au
+}
+
+// SetScope sets the "scope" field.
+func (au *AuthUpdate) SetScope(s string) *AuthUpdate {
+	au.mutation.SetScope(s)
+	return au
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (au *AuthUpdate) SetNillableScope(s *string) *AuthUpdate {
+	if s != nil {
+		au.SetScope(*s)
+	}
+	return au
+}
+
 // Mutation returns the AuthMutation object of the builder.
 func (au *AuthUpdate) Mutation() *AuthMutation {
 	return au.mutation
@@ -139,6 +257,11 @@ func (au *AuthUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (au *AuthUpdate) check() error {
+	if v, ok := au.mutation.FamilyID(); ok {
+		if err := auth.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "Auth.family_id": %w`, err)}
+		}
+	}
 	if v, ok := au.mutation.UserID(); ok {
 		if err := auth.UserIDValidator(v); err != nil {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Auth.user_id": %w`, err)}
@@ -159,6 +282,9 @@ func (au *AuthUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := au.mutation.FamilyID(); ok {
+		_spec.SetField(auth.FieldFamilyID, field.TypeString, value)
+	}
 	if value, ok := au.mutation.UserID(); ok {
 		_spec.SetField(auth.FieldUserID, field.TypeUint, value)
 	}
@@ -168,6 +294,12 @@ func (au *AuthUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.RefreshToken(); ok {
 		_spec.SetField(auth.FieldRefreshToken, field.TypeString, value)
 	}
+	if value, ok := au.mutation.ReplacedBy(); ok {
+		_spec.SetField(auth.FieldReplacedBy, field.TypeString, value)
+	}
+	if au.mutation.ReplacedByCleared() {
+		_spec.ClearField(auth.FieldReplacedBy, field.TypeString)
+	}
 	if value, ok := au.mutation.IsRevoked(); ok {
 		_spec.SetField(auth.FieldIsRevoked, field.TypeBool, value)
 	}
@@ -177,6 +309,24 @@ func (au *AuthUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.ExpiresAt(); ok {
 		_spec.SetField(auth.FieldExpiresAt, field.TypeTime, value)
 	}
+	if value, ok := au.mutation.UserAgent(); ok {
+		_spec.SetField(auth.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := au.mutation.IPAddress(); ok {
+		_spec.SetField(auth.FieldIPAddress, field.TypeString, value)
+	}
+	if value, ok := au.mutation.SessionCreatedAt(); ok {
+		_spec.SetField(auth.FieldSessionCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := au.mutation.LastUsedAt(); ok {
+		_spec.SetField(auth.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if value, ok := au.mutation.ClientID(); ok {
+		_spec.SetField(auth.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Scope(); ok {
+		_spec.SetField(auth.FieldScope, field.TypeString, value)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{auth.Label}
@@ -197,6 +347,20 @@ type AuthUpdateOne struct {
 	mutation *AuthMutation
 }
 
+// SetFamilyID sets the "family_id" field.
+func (auo *AuthUpdateOne) SetFamilyID(s string) *AuthUpdateOne {
+	auo.mutation.SetFamilyID(s)
+	return auo
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (auo *AuthUpdateOne) SetNillableFamilyID(s *string) *AuthUpdateOne {
+	if s != nil {
+		auo.SetFamilyID(*s)
+	}
+	return auo
+}
+
 // SetUserID sets the "user_id" field.
 func (auo *AuthUpdateOne) SetUserID(u uint) *AuthUpdateOne {
 	auo.mutation.ResetUserID()
@@ -232,6 +396,26 @@ func (auo *AuthUpdateOne) SetNillableRefreshToken(s *string) *AuthUpdateOne {
 	return auo
 }
 
+// SetReplacedBy sets the "replaced_by" field.
+func (auo *AuthUpdateOne) SetReplacedBy(s string) *AuthUpdateOne {
+	auo.mutation.SetReplacedBy(s)
+	return auo
+}
+
+// SetNillableReplacedBy sets the "replaced_by" field if the given value is not nil.
+func (auo *AuthUpdateOne) SetNillableReplacedBy(s *string) *AuthUpdateOne {
+	if s != nil {
+		auo.SetReplacedBy(*s)
+	}
+	return auo
+}
+
+// ClearReplacedBy clears the value of the "replaced_by" field.
+func (auo *AuthUpdateOne) ClearReplacedBy() *AuthUpdateOne {
+	auo.mutation.ClearReplacedBy()
+	return auo
+}
+
 // SetIsRevoked sets the "is_revoked" field.
 func (auo *AuthUpdateOne) SetIsRevoked(b bool) *AuthUpdateOne {
 	auo.mutation.SetIsRevoked(b)
@@ -274,6 +458,90 @@ func (auo *AuthUpdateOne) SetNillableExpiresAt(t *time.Time) *AuthUpdateOne {
 	return auo
 }
 
+// SetUserAgent sets the "user_agent" field.
+func (auo *AuthUpdateOne) SetUserAgent(s string) *AuthUpdateOne {
+	auo.mutation.SetUserAgent(s)
+	return auo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (auo *AuthUpdateOne) SetNillableUserAgent(s *string) *AuthUpdateOne {
+	if s != nil {
+		auo.SetUserAgent(*s)
+	}
+	return auo
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (auo *AuthUpdateOne) SetIPAddress(s string) *AuthUpdateOne {
+	auo.mutation.SetIPAddress(s)
+	return auo
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (auo *AuthUpdateOne) SetNillableIPAddress(s *string) *AuthUpdateOne {
+	if s != nil {
+		auo.SetIPAddress(*s)
+	}
+	return auo
+}
+
+// SetSessionCreatedAt sets the "session_created_at" field.
+func (auo *AuthUpdateOne) SetSessionCreatedAt(t time.Time) *AuthUpdateOne {
+	auo.mutation.SetSessionCreatedAt(t)
+	return auo
+}
+
+// SetNillableSessionCreatedAt sets the "session_created_at" field if the given value is not nil.
+func (auo *AuthUpdateOne) SetNillableSessionCreatedAt(t *time.Time) *AuthUpdateOne {
+	if t != nil {
+		auo.SetSessionCreatedAt(*t)
+	}
+	return auo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (auo *AuthUpdateOne) SetLastUsedAt(t time.Time) *AuthUpdateOne {
+	auo.mutation.SetLastUsedAt(t)
+	return auo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (auo *AuthUpdateOne) SetNillableLastUsedAt(t *time.Time) *AuthUpdateOne {
+	if t != nil {
+		auo.SetLastUsedAt(*t)
+	}
+	return auo
+}
+
+// SetClientID sets the "client_id" field.
+func (auo *AuthUpdateOne) SetClientID(s string) *AuthUpdateOne {
+	auo.mutation.SetClientID(s)
+	return auo
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (auo *AuthUpdateOne) SetNillableClientID(s *string) *AuthUpdateOne {
+	if s != nil {
+		auo.SetClientID(*s)
+	}
+	return auo
+}
+
+// SetScope sets the "scope" field.
+func (auo *AuthUpdateOne) SetScope(s string) *AuthUpdateOne {
+	auo.mutation.SetScope(s)
+	return auo
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (auo *AuthUpdateOne) SetNillableScope(s *string) *AuthUpdateOne {
+	if s != nil {
+		auo.SetScope(*s)
+	}
+	return auo
+}
+
 // Mutation returns the AuthMutation object of the builder.
 func (auo *AuthUpdateOne) Mutation() *AuthMutation {
 	return auo.mutation
@@ -321,6 +589,11 @@ func (auo *AuthUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (auo *AuthUpdateOne) check() error {
+	if v, ok := auo.mutation.FamilyID(); ok {
+		if err := auth.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "Auth.family_id": %w`, err)}
+		}
+	}
 	if v, ok := auo.mutation.UserID(); ok {
 		if err := auth.UserIDValidator(v); err != nil {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Auth.user_id": %w`, err)}
@@ -358,6 +631,9 @@ func (auo *AuthUpdateOne) sqlSave(ctx context.Context) (_node *Auth, err error)
 			}
 		}
 	}
+	if value, ok := auo.mutation.FamilyID(); ok {
+		_spec.SetField(auth.FieldFamilyID, field.TypeString, value)
+	}
 	if value, ok := auo.mutation.UserID(); ok {
 		_spec.SetField(auth.FieldUserID, field.TypeUint, value)
 	}
@@ -367,6 +643,12 @@ func (auo *AuthUpdateOne) sqlSave(ctx context.Context) (_node *Auth, err error)
 	if value, ok := auo.mutation.RefreshToken(); ok {
 		_spec.SetField(auth.FieldRefreshToken, field.TypeString, value)
 	}
+	if value, ok := auo.mutation.ReplacedBy(); ok {
+		_spec.SetField(auth.FieldReplacedBy, field.TypeString, value)
+	}
+	if auo.mutation.ReplacedByCleared() {
+		_spec.ClearField(auth.FieldReplacedBy, field.TypeString)
+	}
 	if value, ok := auo.mutation.IsRevoked(); ok {
 		_spec.SetField(auth.FieldIsRevoked, field.TypeBool, value)
 	}
@@ -376,6 +658,24 @@ func (auo *AuthUpdateOne) sqlSave(ctx context.Context) (_node *Auth, err error)
 	if value, ok := auo.mutation.ExpiresAt(); ok {
 		_spec.SetField(auth.FieldExpiresAt, field.TypeTime, value)
 	}
+	if value, ok := auo.mutation.UserAgent(); ok {
+		_spec.SetField(auth.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.IPAddress(); ok {
+		_spec.SetField(auth.FieldIPAddress, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.SessionCreatedAt(); ok {
+		_spec.SetField(auth.FieldSessionCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := auo.mutation.LastUsedAt(); ok {
+		_spec.SetField(auth.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if value, ok := auo.mutation.ClientID(); ok {
+		_spec.SetField(auth.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Scope(); ok {
+		_spec.SetField(auth.FieldScope, field.TypeString, value)
+	}
 	_node = &Auth{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
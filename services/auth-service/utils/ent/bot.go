@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/bot"
+)
+
+// Bot is the model entity for the Bot schema.
+type Bot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID uint `json:"owner_id,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// SecretHash holds the value of the "secret_hash" field.
+	SecretHash string `json:"-"`
+	// IsRevoked holds the value of the "is_revoked" field.
+	IsRevoked bool `json:"is_revoked,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Bot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bot.FieldIsRevoked:
+			values[i] = new(sql.NullBool)
+		case bot.FieldID, bot.FieldOwnerID:
+			values[i] = new(sql.NullInt64)
+		case bot.FieldName, bot.FieldClientID, bot.FieldSecretHash:
+			values[i] = new(sql.NullString)
+		case bot.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Bot fields.
+func (b *Bot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			b.ID = int(value.Int64)
+		case bot.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				b.Name = value.String
+			}
+		case bot.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				b.OwnerID = uint(value.Int64)
+			}
+		case bot.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				b.ClientID = value.String
+			}
+		case bot.FieldSecretHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_hash", values[i])
+			} else if value.Valid {
+				b.SecretHash = value.String
+			}
+		case bot.FieldIsRevoked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_revoked", values[i])
+			} else if value.Valid {
+				b.IsRevoked = value.Bool
+			}
+		case bot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				b.CreatedAt = value.Time
+			}
+		default:
+			b.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Bot.
+// This includes values selected through modifiers, order, etc.
+func (b *Bot) Value(name string) (ent.Value, error) {
+	return b.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Bot.
+// Note that you need to call Bot.Unwrap() before calling this method if this Bot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Bot) Update() *BotUpdateOne {
+	return NewBotClient(b.config).UpdateOne(b)
+}
+
+// Unwrap unwraps the Bot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Bot) Unwrap() *Bot {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Bot is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Bot) String() string {
+	var builder strings.Builder
+	builder.WriteString("Bot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("name=")
+	builder.WriteString(b.Name)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", b.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(b.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("secret_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("is_revoked=")
+	builder.WriteString(fmt.Sprintf("%v", b.IsRevoked))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Bots is a parsable slice of Bot.
+type Bots []*Bot
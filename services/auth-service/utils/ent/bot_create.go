@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/bot"
+)
+
+// BotCreate is the builder for creating a Bot entity.
+type BotCreate struct {
+	config
+	mutation *BotMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (bc *BotCreate) SetName(s string) *BotCreate {
+	bc.mutation.SetName(s)
+	return bc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (bc *BotCreate) SetOwnerID(u uint) *BotCreate {
+	bc.mutation.SetOwnerID(u)
+	return bc
+}
+
+// SetClientID sets the "client_id" field.
+func (bc *BotCreate) SetClientID(s string) *BotCreate {
+	bc.mutation.SetClientID(s)
+	return bc
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (bc *BotCreate) SetSecretHash(s string) *BotCreate {
+	bc.mutation.SetSecretHash(s)
+	return bc
+}
+
+// SetIsRevoked sets the "is_revoked" field.
+func (bc *BotCreate) SetIsRevoked(b bool) *BotCreate {
+	bc.mutation.SetIsRevoked(b)
+	return bc
+}
+
+// SetNillableIsRevoked sets the "is_revoked" field if the given value is not nil.
+func (bc *BotCreate) SetNillableIsRevoked(b *bool) *BotCreate {
+	if b != nil {
+		bc.SetIsRevoked(*b)
+	}
+	return bc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bc *BotCreate) SetCreatedAt(t time.Time) *BotCreate {
+	bc.mutation.SetCreatedAt(t)
+	return bc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bc *BotCreate) SetNillableCreatedAt(t *time.Time) *BotCreate {
+	if t != nil {
+		bc.SetCreatedAt(*t)
+	}
+	return bc
+}
+
+// Mutation returns the BotMutation object of the builder.
+func (bc *BotCreate) Mutation() *BotMutation {
+	return bc.mutation
+}
+
+// Save creates the Bot in the database.
+func (bc *BotCreate) Save(ctx context.Context) (*Bot, error) {
+	bc.defaults()
+	return withHooks(ctx, bc.sqlSave, bc.mutation, bc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BotCreate) SaveX(ctx context.Context) *Bot {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BotCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BotCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BotCreate) defaults() {
+	if _, ok := bc.mutation.IsRevoked(); !ok {
+		v := bot.DefaultIsRevoked
+		bc.mutation.SetIsRevoked(v)
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		v := bot.DefaultCreatedAt()
+		bc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BotCreate) check() error {
+	if _, ok := bc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Bot.name"`)}
+	}
+	if v, ok := bc.mutation.Name(); ok {
+		if err := bot.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Bot.name": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Bot.owner_id"`)}
+	}
+	if v, ok := bc.mutation.OwnerID(); ok {
+		if err := bot.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`ent: validator failed for field "Bot.owner_id": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "Bot.client_id"`)}
+	}
+	if v, ok := bc.mutation.ClientID(); ok {
+		if err := bot.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "Bot.client_id": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.SecretHash(); !ok {
+		return &ValidationError{Name: "secret_hash", err: errors.New(`ent: missing required field "Bot.secret_hash"`)}
+	}
+	if v, ok := bc.mutation.SecretHash(); ok {
+		if err := bot.SecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "secret_hash", err: fmt.Errorf(`ent: validator failed for field "Bot.secret_hash": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.IsRevoked(); !ok {
+		return &ValidationError{Name: "is_revoked", err: errors.New(`ent: missing required field "Bot.is_revoked"`)}
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Bot.created_at"`)}
+	}
+	return nil
+}
+
+func (bc *BotCreate) sqlSave(ctx context.Context) (*Bot, error) {
+	if err := bc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	bc.mutation.id = &_node.ID
+	bc.mutation.done = true
+	return _node, nil
+}
+
+func (bc *BotCreate) createSpec() (*Bot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Bot{config: bc.config}
+		_spec = sqlgraph.NewCreateSpec(bot.Table, sqlgraph.NewFieldSpec(bot.FieldID, field.TypeInt))
+	)
+	if value, ok := bc.mutation.Name(); ok {
+		_spec.SetField(bot.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := bc.mutation.OwnerID(); ok {
+		_spec.SetField(bot.FieldOwnerID, field.TypeUint, value)
+		_node.OwnerID = value
+	}
+	if value, ok := bc.mutation.ClientID(); ok {
+		_spec.SetField(bot.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := bc.mutation.SecretHash(); ok {
+		_spec.SetField(bot.FieldSecretHash, field.TypeString, value)
+		_node.SecretHash = value
+	}
+	if value, ok := bc.mutation.IsRevoked(); ok {
+		_spec.SetField(bot.FieldIsRevoked, field.TypeBool, value)
+		_node.IsRevoked = value
+	}
+	if value, ok := bc.mutation.CreatedAt(); ok {
+		_spec.SetField(bot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// BotCreateBulk is the builder for creating many Bot entities in bulk.
+type BotCreateBulk struct {
+	config
+	err      error
+	builders []*BotCreate
+}
+
+// Save creates the Bot entities in the database.
+func (bcb *BotCreateBulk) Save(ctx context.Context) ([]*Bot, error) {
+	if bcb.err != nil {
+		return nil, bcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Bot, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BotCreateBulk) SaveX(ctx context.Context) []*Bot {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BotCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BotCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/bot"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/predicate"
+)
+
+// BotUpdate is the builder for updating Bot entities.
+type BotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BotMutation
+}
+
+// Where appends a list predicates to the BotUpdate builder.
+func (bu *BotUpdate) Where(ps ...predicate.Bot) *BotUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetName sets the "name" field.
+func (bu *BotUpdate) SetName(s string) *BotUpdate {
+	bu.mutation.SetName(s)
+	return bu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (bu *BotUpdate) SetNillableName(s *string) *BotUpdate {
+	if s != nil {
+		bu.SetName(*s)
+	}
+	return bu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (bu *BotUpdate) SetOwnerID(u uint) *BotUpdate {
+	bu.mutation.ResetOwnerID()
+	bu.mutation.SetOwnerID(u)
+	return bu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (bu *BotUpdate) SetNillableOwnerID(u *uint) *BotUpdate {
+	if u != nil {
+		bu.SetOwnerID(*u)
+	}
+	return bu
+}
+
+// AddOwnerID adds u to the "owner_id" field.
+func (bu *BotUpdate) AddOwnerID(u int) *BotUpdate {
+	bu.mutation.AddOwnerID(u)
+	return bu
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (bu *BotUpdate) SetSecretHash(s string) *BotUpdate {
+	bu.mutation.SetSecretHash(s)
+	return bu
+}
+
+// SetNillableSecretHash sets the "secret_hash" field if the given value is not nil.
+func (bu *BotUpdate) SetNillableSecretHash(s *string) *BotUpdate {
+	if s != nil {
+		bu.SetSecretHash(*s)
+	}
+	return bu
+}
+
+// SetIsRevoked sets the "is_revoked" field.
+func (bu *BotUpdate) SetIsRevoked(b bool) *BotUpdate {
+	bu.mutation.SetIsRevoked(b)
+	return bu
+}
+
+// SetNillableIsRevoked sets the "is_revoked" field if the given value is not nil.
+func (bu *BotUpdate) SetNillableIsRevoked(b *bool) *BotUpdate {
+	if b != nil {
+		bu.SetIsRevoked(*b)
+	}
+	return bu
+}
+
+// Mutation returns the BotMutation object of the builder.
+func (bu *BotUpdate) Mutation() *BotMutation {
+	return bu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BotUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BotUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BotUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bu *BotUpdate) check() error {
+	if v, ok := bu.mutation.Name(); ok {
+		if err := bot.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Bot.name": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.OwnerID(); ok {
+		if err := bot.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`ent: validator failed for field "Bot.owner_id": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.SecretHash(); ok {
+		if err := bot.SecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "secret_hash", err: fmt.Errorf(`ent: validator failed for field "Bot.secret_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bu *BotUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bot.Table, bot.Columns, sqlgraph.NewFieldSpec(bot.FieldID, field.TypeInt))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.Name(); ok {
+		_spec.SetField(bot.FieldName, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.OwnerID(); ok {
+		_spec.SetField(bot.FieldOwnerID, field.TypeUint, value)
+	}
+	if value, ok := bu.mutation.AddedOwnerID(); ok {
+		_spec.AddField(bot.FieldOwnerID, field.TypeUint, value)
+	}
+	if value, ok := bu.mutation.SecretHash(); ok {
+		_spec.SetField(bot.FieldSecretHash, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.IsRevoked(); ok {
+		_spec.SetField(bot.FieldIsRevoked, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BotUpdateOne is the builder for updating a single Bot entity.
+type BotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BotMutation
+}
+
+// SetName sets the "name" field.
+func (buo *BotUpdateOne) SetName(s string) *BotUpdateOne {
+	buo.mutation.SetName(s)
+	return buo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (buo *BotUpdateOne) SetNillableName(s *string) *BotUpdateOne {
+	if s != nil {
+		buo.SetName(*s)
+	}
+	return buo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (buo *BotUpdateOne) SetOwnerID(u uint) *BotUpdateOne {
+	buo.mutation.ResetOwnerID()
+	buo.mutation.SetOwnerID(u)
+	return buo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (buo *BotUpdateOne) SetNillableOwnerID(u *uint) *BotUpdateOne {
+	if u != nil {
+		buo.SetOwnerID(*u)
+	}
+	return buo
+}
+
+// AddOwnerID adds u to the "owner_id" field.
+func (buo *BotUpdateOne) AddOwnerID(u int) *BotUpdateOne {
+	buo.mutation.AddOwnerID(u)
+	return buo
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (buo *BotUpdateOne) SetSecretHash(s string) *BotUpdateOne {
+	buo.mutation.SetSecretHash(s)
+	return buo
+}
+
+// SetNillableSecretHash sets the "secret_hash" field if the given value is not nil.
+func (buo *BotUpdateOne) SetNillableSecretHash(s *string) *BotUpdateOne {
+	if s != nil {
+		buo.SetSecretHash(*s)
+	}
+	return buo
+}
+
+// SetIsRevoked sets the "is_revoked" field.
+func (buo *BotUpdateOne) SetIsRevoked(b bool) *BotUpdateOne {
+	buo.mutation.SetIsRevoked(b)
+	return buo
+}
+
+// SetNillableIsRevoked sets the "is_revoked" field if the given value is not nil.
+func (buo *BotUpdateOne) SetNillableIsRevoked(b *bool) *BotUpdateOne {
+	if b != nil {
+		buo.SetIsRevoked(*b)
+	}
+	return buo
+}
+
+// Mutation returns the BotMutation object of the builder.
+func (buo *BotUpdateOne) Mutation() *BotMutation {
+	return buo.mutation
+}
+
+// Where appends a list predicates to the BotUpdate builder.
+func (buo *BotUpdateOne) Where(ps ...predicate.Bot) *BotUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BotUpdateOne) Select(field string, fields ...string) *BotUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Bot entity.
+func (buo *BotUpdateOne) Save(ctx context.Context) (*Bot, error) {
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BotUpdateOne) SaveX(ctx context.Context) *Bot {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BotUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BotUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (buo *BotUpdateOne) check() error {
+	if v, ok := buo.mutation.Name(); ok {
+		if err := bot.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Bot.name": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.OwnerID(); ok {
+		if err := bot.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`ent: validator failed for field "Bot.owner_id": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.SecretHash(); ok {
+		if err := bot.SecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "secret_hash", err: fmt.Errorf(`ent: validator failed for field "Bot.secret_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (buo *BotUpdateOne) sqlSave(ctx context.Context) (_node *Bot, err error) {
+	if err := buo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bot.Table, bot.Columns, sqlgraph.NewFieldSpec(bot.FieldID, field.TypeInt))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Bot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bot.FieldID)
+		for _, f := range fields {
+			if !bot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.Name(); ok {
+		_spec.SetField(bot.FieldName, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.OwnerID(); ok {
+		_spec.SetField(bot.FieldOwnerID, field.TypeUint, value)
+	}
+	if value, ok := buo.mutation.AddedOwnerID(); ok {
+		_spec.AddField(bot.FieldOwnerID, field.TypeUint, value)
+	}
+	if value, ok := buo.mutation.SecretHash(); ok {
+		_spec.SetField(bot.FieldSecretHash, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.IsRevoked(); ok {
+		_spec.SetField(bot.FieldIsRevoked, field.TypeBool, value)
+	}
+	_node = &Bot{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
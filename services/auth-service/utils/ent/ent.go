@@ -13,6 +13,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/auth"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/bot"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/oauthclient"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/oauthconsent"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/recoverycode"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/twofactor"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +78,12 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			auth.Table: auth.ValidColumn,
+			auth.Table:         auth.ValidColumn,
+			bot.Table:          bot.ValidColumn,
+			oauthclient.Table:  oauthclient.ValidColumn,
+			oauthconsent.Table: oauthconsent.ValidColumn,
+			recoverycode.Table: recoverycode.ValidColumn,
+			twofactor.Table:    twofactor.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
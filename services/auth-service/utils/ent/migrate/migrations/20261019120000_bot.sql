@@ -0,0 +1,6 @@
+-- Create "bots" table
+CREATE TABLE "bots" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "owner_id" bigint NOT NULL, "client_id" character varying NOT NULL, "secret_hash" character varying NOT NULL, "is_revoked" boolean NOT NULL DEFAULT false, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "bots_client_id_key" to table: "bots"
+CREATE UNIQUE INDEX "bots_client_id_key" ON "bots" ("client_id");
+-- Create index "bots_name_key" to table: "bots"
+CREATE UNIQUE INDEX "bots_name_key" ON "bots" ("name");
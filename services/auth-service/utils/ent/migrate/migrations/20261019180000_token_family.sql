@@ -0,0 +1,8 @@
+-- Modify "auths" table
+ALTER TABLE "auths" ADD COLUMN "family_id" character varying NULL, ADD COLUMN "replaced_by" character varying NULL;
+-- Backfill "family_id": every token issued before rotation is a family of its own
+UPDATE "auths" SET "family_id" = "id";
+-- Modify "auths" table
+ALTER TABLE "auths" ALTER COLUMN "family_id" SET NOT NULL;
+-- Create index "auth_family_id" to table: "auths"
+CREATE INDEX "auth_family_id" ON "auths" ("family_id");
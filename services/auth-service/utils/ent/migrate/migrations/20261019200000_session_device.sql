@@ -0,0 +1,8 @@
+-- Modify "auths" table
+ALTER TABLE "auths" ADD COLUMN "user_agent" character varying NOT NULL DEFAULT '', ADD COLUMN "ip_address" character varying NOT NULL DEFAULT '', ADD COLUMN "session_created_at" timestamptz NULL, ADD COLUMN "last_used_at" timestamptz NULL;
+-- Backfill the session times of the tokens issued before they were recorded
+UPDATE "auths" SET "session_created_at" = "created_at", "last_used_at" = "created_at";
+-- Modify "auths" table
+ALTER TABLE "auths" ALTER COLUMN "session_created_at" SET NOT NULL, ALTER COLUMN "last_used_at" SET NOT NULL;
+-- Create index "auth_user_id" to table: "auths"
+CREATE INDEX "auth_user_id" ON "auths" ("user_id");
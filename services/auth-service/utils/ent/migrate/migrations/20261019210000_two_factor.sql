@@ -0,0 +1,8 @@
+-- Create "recovery_codes" table
+CREATE TABLE "recovery_codes" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "user_id" bigint NOT NULL, "code_hash" character varying NOT NULL, "used_at" timestamptz NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "recoverycode_user_id" to table: "recovery_codes"
+CREATE INDEX "recoverycode_user_id" ON "recovery_codes" ("user_id");
+-- Create "two_factors" table
+CREATE TABLE "two_factors" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "user_id" bigint NOT NULL, "encrypted_secret" character varying NOT NULL, "enabled" boolean NOT NULL DEFAULT false, "created_at" timestamptz NOT NULL, "enabled_at" timestamptz NULL, "last_step" bigint NOT NULL DEFAULT 0, PRIMARY KEY ("id"));
+-- Create index "two_factors_user_id_key" to table: "two_factors"
+CREATE UNIQUE INDEX "two_factors_user_id_key" ON "two_factors" ("user_id");
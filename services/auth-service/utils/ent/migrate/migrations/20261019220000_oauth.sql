@@ -0,0 +1,12 @@
+-- Modify "auths" table
+ALTER TABLE "auths" ADD COLUMN "client_id" character varying NOT NULL DEFAULT '', ADD COLUMN "scope" character varying NOT NULL DEFAULT '';
+-- Create "oauth_clients" table
+CREATE TABLE "oauth_clients" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "owner_id" bigint NOT NULL, "client_id" character varying NOT NULL, "secret_hash" character varying NOT NULL DEFAULT '', "redirect_uris" jsonb NOT NULL, "scopes" jsonb NOT NULL, "is_revoked" boolean NOT NULL DEFAULT false, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "oauth_clients_client_id_key" to table: "oauth_clients"
+CREATE UNIQUE INDEX "oauth_clients_client_id_key" ON "oauth_clients" ("client_id");
+-- Create index "oauth_clients_name_key" to table: "oauth_clients"
+CREATE UNIQUE INDEX "oauth_clients_name_key" ON "oauth_clients" ("name");
+-- Create "oauth_consents" table
+CREATE TABLE "oauth_consents" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "user_id" bigint NOT NULL, "client_id" character varying NOT NULL, "scopes" jsonb NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "oauthconsent_user_id_client_id" to table: "oauth_consents"
+CREATE UNIQUE INDEX "oauthconsent_user_id_client_id" ON "oauth_consents" ("user_id", "client_id");
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/oauthclient"
+)
+
+// OAuthClient is the model entity for the OAuthClient schema.
+type OAuthClient struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID uint `json:"owner_id,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// SecretHash holds the value of the "secret_hash" field.
+	SecretHash string `json:"-"`
+	// RedirectUris holds the value of the "redirect_uris" field.
+	RedirectUris []string `json:"redirect_uris,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// IsRevoked holds the value of the "is_revoked" field.
+	IsRevoked bool `json:"is_revoked,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OAuthClient) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthclient.FieldRedirectUris, oauthclient.FieldScopes:
+			values[i] = new([]byte)
+		case oauthclient.FieldIsRevoked:
+			values[i] = new(sql.NullBool)
+		case oauthclient.FieldID, oauthclient.FieldOwnerID:
+			values[i] = new(sql.NullInt64)
+		case oauthclient.FieldName, oauthclient.FieldClientID, oauthclient.FieldSecretHash:
+			values[i] = new(sql.NullString)
+		case oauthclient.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OAuthClient fields.
+func (oc *OAuthClient) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthclient.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			oc.ID = int(value.Int64)
+		case oauthclient.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				oc.Name = value.String
+			}
+		case oauthclient.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				oc.OwnerID = uint(value.Int64)
+			}
+		case oauthclient.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				oc.ClientID = value.String
+			}
+		case oauthclient.FieldSecretHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_hash", values[i])
+			} else if value.Valid {
+				oc.SecretHash = value.String
+			}
+		case oauthclient.FieldRedirectUris:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uris", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oc.RedirectUris); err != nil {
+					return fmt.Errorf("unmarshal field redirect_uris: %w", err)
+				}
+			}
+		case oauthclient.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oc.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case oauthclient.FieldIsRevoked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_revoked", values[i])
+			} else if value.Valid {
+				oc.IsRevoked = value.Bool
+			}
+		case oauthclient.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				oc.CreatedAt = value.Time
+			}
+		default:
+			oc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OAuthClient.
+// This includes values selected through modifiers, order, etc.
+func (oc *OAuthClient) Value(name string) (ent.Value, error) {
+	return oc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OAuthClient.
+// Note that you need to call OAuthClient.Unwrap() before calling this method if this OAuthClient
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oc *OAuthClient) Update() *OAuthClientUpdateOne {
+	return NewOAuthClientClient(oc.config).UpdateOne(oc)
+}
+
+// Unwrap unwraps the OAuthClient entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oc *OAuthClient) Unwrap() *OAuthClient {
+	_tx, ok := oc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OAuthClient is not a transactional entity")
+	}
+	oc.config.driver = _tx.drv
+	return oc
+}
+
+// String implements the fmt.Stringer.
+func (oc *OAuthClient) String() string {
+	var builder strings.Builder
+	builder.WriteString("OAuthClient(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oc.ID))
+	builder.WriteString("name=")
+	builder.WriteString(oc.Name)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", oc.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(oc.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("secret_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("redirect_uris=")
+	builder.WriteString(fmt.Sprintf("%v", oc.RedirectUris))
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", oc.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("is_revoked=")
+	builder.WriteString(fmt.Sprintf("%v", oc.IsRevoked))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(oc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OAuthClients is a parsable slice of OAuthClient.
+type OAuthClients []*OAuthClient
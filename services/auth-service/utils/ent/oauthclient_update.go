@@ -0,0 +1,448 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/oauthclient"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/predicate"
+)
+
+// OAuthClientUpdate is the builder for updating OAuthClient entities.
+type OAuthClientUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OAuthClientMutation
+}
+
+// Where appends a list predicates to the OAuthClientUpdate builder.
+func (ocu *OAuthClientUpdate) Where(ps ...predicate.OAuthClient) *OAuthClientUpdate {
+	ocu.mutation.Where(ps...)
+	return ocu
+}
+
+// SetName sets the "name" field.
+func (ocu *OAuthClientUpdate) SetName(s string) *OAuthClientUpdate {
+	ocu.mutation.SetName(s)
+	return ocu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ocu *OAuthClientUpdate) SetNillableName(s *string) *OAuthClientUpdate {
+	if s != nil {
+		ocu.SetName(*s)
+	}
+	return ocu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (ocu *OAuthClientUpdate) SetOwnerID(u uint) *OAuthClientUpdate {
+	ocu.mutation.ResetOwnerID()
+	ocu.mutation.SetOwnerID(u)
+	return ocu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (ocu *OAuthClientUpdate) SetNillableOwnerID(u *uint) *OAuthClientUpdate {
+	if u != nil {
+		ocu.SetOwnerID(*u)
+	}
+	return ocu
+}
+
+// AddOwnerID adds u to the "owner_id" field.
+func (ocu *OAuthClientUpdate) AddOwnerID(u int) *OAuthClientUpdate {
+	ocu.mutation.AddOwnerID(u)
+	return ocu
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (ocu *OAuthClientUpdate) SetSecretHash(s string) *OAuthClientUpdate {
+	ocu.mutation.SetSecretHash(s)
+	return ocu
+}
+
+// SetNillableSecretHash sets the "secret_hash" field if the given value is not nil.
+func (ocu *OAuthClientUpdate) SetNillableSecretHash(s *string) *OAuthClientUpdate {
+	if s != nil {
+		ocu.SetSecretHash(*s)
+	}
+	return ocu
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (ocu *OAuthClientUpdate) SetRedirectUris(s []string) *OAuthClientUpdate {
+	ocu.mutation.SetRedirectUris(s)
+	return ocu
+}
+
+// AppendRedirectUris appends s to the "redirect_uris" field.
+func (ocu *OAuthClientUpdate) AppendRedirectUris(s []string) *OAuthClientUpdate {
+	ocu.mutation.AppendRedirectUris(s)
+	return ocu
+}
+
+// SetScopes sets the "scopes" field.
+func (ocu *OAuthClientUpdate) SetScopes(s []string) *OAuthClientUpdate {
+	ocu.mutation.SetScopes(s)
+	return ocu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (ocu *OAuthClientUpdate) AppendScopes(s []string) *OAuthClientUpdate {
+	ocu.mutation.AppendScopes(s)
+	return ocu
+}
+
+// SetIsRevoked sets the "is_revoked" field.
+func (ocu *OAuthClientUpdate) SetIsRevoked(b bool) *OAuthClientUpdate {
+	ocu.mutation.SetIsRevoked(b)
+	return ocu
+}
+
+// SetNillableIsRevoked sets the "is_revoked" field if the given value is not nil.
+func (ocu *OAuthClientUpdate) SetNillableIsRevoked(b *bool) *OAuthClientUpdate {
+	if b != nil {
+		ocu.SetIsRevoked(*b)
+	}
+	return ocu
+}
+
+// Mutation returns the OAuthClientMutation object of the builder.
+func (ocu *OAuthClientUpdate) Mutation() *OAuthClientMutation {
+	return ocu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ocu *OAuthClientUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ocu.sqlSave, ocu.mutation, ocu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocu *OAuthClientUpdate) SaveX(ctx context.Context) int {
+	affected, err := ocu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ocu *OAuthClientUpdate) Exec(ctx context.Context) error {
+	_, err := ocu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocu *OAuthClientUpdate) ExecX(ctx context.Context) {
+	if err := ocu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocu *OAuthClientUpdate) check() error {
+	if v, ok := ocu.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.name": %w`, err)}
+		}
+	}
+	if v, ok := ocu.mutation.OwnerID(); ok {
+		if err := oauthclient.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.owner_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ocu *OAuthClientUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ocu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt))
+	if ps := ocu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocu.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := ocu.mutation.OwnerID(); ok {
+		_spec.SetField(oauthclient.FieldOwnerID, field.TypeUint, value)
+	}
+	if value, ok := ocu.mutation.AddedOwnerID(); ok {
+		_spec.AddField(oauthclient.FieldOwnerID, field.TypeUint, value)
+	}
+	if value, ok := ocu.mutation.SecretHash(); ok {
+		_spec.SetField(oauthclient.FieldSecretHash, field.TypeString, value)
+	}
+	if value, ok := ocu.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := ocu.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldRedirectUris, value)
+		})
+	}
+	if value, ok := ocu.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := ocu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldScopes, value)
+		})
+	}
+	if value, ok := ocu.mutation.IsRevoked(); ok {
+		_spec.SetField(oauthclient.FieldIsRevoked, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ocu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ocu.mutation.done = true
+	return n, nil
+}
+
+// OAuthClientUpdateOne is the builder for updating a single OAuthClient entity.
+type OAuthClientUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OAuthClientMutation
+}
+
+// SetName sets the "name" field.
+func (ocuo *OAuthClientUpdateOne) SetName(s string) *OAuthClientUpdateOne {
+	ocuo.mutation.SetName(s)
+	return ocuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ocuo *OAuthClientUpdateOne) SetNillableName(s *string) *OAuthClientUpdateOne {
+	if s != nil {
+		ocuo.SetName(*s)
+	}
+	return ocuo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (ocuo *OAuthClientUpdateOne) SetOwnerID(u uint) *OAuthClientUpdateOne {
+	ocuo.mutation.ResetOwnerID()
+	ocuo.mutation.SetOwnerID(u)
+	return ocuo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (ocuo *OAuthClientUpdateOne) SetNillableOwnerID(u *uint) *OAuthClientUpdateOne {
+	if u != nil {
+		ocuo.SetOwnerID(*u)
+	}
+	return ocuo
+}
+
+// AddOwnerID adds u to the "owner_id" field.
+func (ocuo *OAuthClientUpdateOne) AddOwnerID(u int) *OAuthClientUpdateOne {
+	ocuo.mutation.AddOwnerID(u)
+	return ocuo
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (ocuo *OAuthClientUpdateOne) SetSecretHash(s string) *OAuthClientUpdateOne {
+	ocuo.mutation.SetSecretHash(s)
+	return ocuo
+}
+
+// SetNillableSecretHash sets the "secret_hash" field if the given value is not nil.
+func (ocuo *OAuthClientUpdateOne) SetNillableSecretHash(s *string) *OAuthClientUpdateOne {
+	if s != nil {
+		ocuo.SetSecretHash(*s)
+	}
+	return ocuo
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (ocuo *OAuthClientUpdateOne) SetRedirectUris(s []string) *OAuthClientUpdateOne {
+	ocuo.mutation.SetRedirectUris(s)
+	return ocuo
+}
+
+// AppendRedirectUris appends s to the "redirect_uris" field.
+func (ocuo *OAuthClientUpdateOne) AppendRedirectUris(s []string) *OAuthClientUpdateOne {
+	ocuo.mutation.AppendRedirectUris(s)
+	return ocuo
+}
+
+// SetScopes sets the "scopes" field.
+func (ocuo *OAuthClientUpdateOne) SetScopes(s []string) *OAuthClientUpdateOne {
+	ocuo.mutation.SetScopes(s)
+	return ocuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (ocuo *OAuthClientUpdateOne) AppendScopes(s []string) *OAuthClientUpdateOne {
+	ocuo.mutation.AppendScopes(s)
+	return ocuo
+}
+
+// SetIsRevoked sets the "is_revoked" field.
+func (ocuo *OAuthClientUpdateOne) SetIsRevoked(b bool) *OAuthClientUpdateOne {
+	ocuo.mutation.SetIsRevoked(b)
+	return ocuo
+}
+
+// SetNillableIsRevoked sets the "is_revoked" field if the given value is not nil.
+func (ocuo *OAuthClientUpdateOne) SetNillableIsRevoked(b *bool) *OAuthClientUpdateOne {
+	if b != nil {
+		ocuo.SetIsRevoked(*b)
+	}
+	return ocuo
+}
+
+// Mutation returns the OAuthClientMutation object of the builder.
+func (ocuo *OAuthClientUpdateOne) Mutation() *OAuthClientMutation {
+	return ocuo.mutation
+}
+
+// Where appends a list predicates to the OAuthClientUpdate builder.
+func (ocuo *OAuthClientUpdateOne) Where(ps ...predicate.OAuthClient) *OAuthClientUpdateOne {
+	ocuo.mutation.Where(ps...)
+	return ocuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ocuo *OAuthClientUpdateOne) Select(field string, fields ...string) *OAuthClientUpdateOne {
+	ocuo.fields = append([]string{field}, fields...)
+	return ocuo
+}
+
+// Save executes the query and returns the updated OAuthClient entity.
+func (ocuo *OAuthClientUpdateOne) Save(ctx context.Context) (*OAuthClient, error) {
+	return withHooks(ctx, ocuo.sqlSave, ocuo.mutation, ocuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocuo *OAuthClientUpdateOne) SaveX(ctx context.Context) *OAuthClient {
+	node, err := ocuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ocuo *OAuthClientUpdateOne) Exec(ctx context.Context) error {
+	_, err := ocuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocuo *OAuthClientUpdateOne) ExecX(ctx context.Context) {
+	if err := ocuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocuo *OAuthClientUpdateOne) check() error {
+	if v, ok := ocuo.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.name": %w`, err)}
+		}
+	}
+	if v, ok := ocuo.mutation.OwnerID(); ok {
+		if err := oauthclient.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.owner_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ocuo *OAuthClientUpdateOne) sqlSave(ctx context.Context) (_node *OAuthClient, err error) {
+	if err := ocuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt))
+	id, ok := ocuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OAuthClient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ocuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthclient.FieldID)
+		for _, f := range fields {
+			if !oauthclient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthclient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ocuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocuo.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := ocuo.mutation.OwnerID(); ok {
+		_spec.SetField(oauthclient.FieldOwnerID, field.TypeUint, value)
+	}
+	if value, ok := ocuo.mutation.AddedOwnerID(); ok {
+		_spec.AddField(oauthclient.FieldOwnerID, field.TypeUint, value)
+	}
+	if value, ok := ocuo.mutation.SecretHash(); ok {
+		_spec.SetField(oauthclient.FieldSecretHash, field.TypeString, value)
+	}
+	if value, ok := ocuo.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := ocuo.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldRedirectUris, value)
+		})
+	}
+	if value, ok := ocuo.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := ocuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldScopes, value)
+		})
+	}
+	if value, ok := ocuo.mutation.IsRevoked(); ok {
+		_spec.SetField(oauthclient.FieldIsRevoked, field.TypeBool, value)
+	}
+	_node = &OAuthClient{config: ocuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ocuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ocuo.mutation.done = true
+	return _node, nil
+}
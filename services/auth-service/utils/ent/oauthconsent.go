@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/oauthconsent"
+)
+
+// OAuthConsent is the model entity for the OAuthConsent schema.
+type OAuthConsent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint `json:"user_id,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OAuthConsent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthconsent.FieldScopes:
+			values[i] = new([]byte)
+		case oauthconsent.FieldID, oauthconsent.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case oauthconsent.FieldClientID:
+			values[i] = new(sql.NullString)
+		case oauthconsent.FieldCreatedAt, oauthconsent.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OAuthConsent fields.
+func (oc *OAuthConsent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthconsent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			oc.ID = int(value.Int64)
+		case oauthconsent.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				oc.UserID = uint(value.Int64)
+			}
+		case oauthconsent.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				oc.ClientID = value.String
+			}
+		case oauthconsent.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oc.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case oauthconsent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				oc.CreatedAt = value.Time
+			}
+		case oauthconsent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				oc.UpdatedAt = value.Time
+			}
+		default:
+			oc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OAuthConsent.
+// This includes values selected through modifiers, order, etc.
+func (oc *OAuthConsent) Value(name string) (ent.Value, error) {
+	return oc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OAuthConsent.
+// Note that you need to call OAuthConsent.Unwrap() before calling this method if this OAuthConsent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oc *OAuthConsent) Update() *OAuthConsentUpdateOne {
+	return NewOAuthConsentClient(oc.config).UpdateOne(oc)
+}
+
+// Unwrap unwraps the OAuthConsent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oc *OAuthConsent) Unwrap() *OAuthConsent {
+	_tx, ok := oc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OAuthConsent is not a transactional entity")
+	}
+	oc.config.driver = _tx.drv
+	return oc
+}
+
+// String implements the fmt.Stringer.
+func (oc *OAuthConsent) String() string {
+	var builder strings.Builder
+	builder.WriteString("OAuthConsent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oc.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", oc.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(oc.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", oc.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(oc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(oc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OAuthConsents is a parsable slice of OAuthConsent.
+type OAuthConsents []*OAuthConsent
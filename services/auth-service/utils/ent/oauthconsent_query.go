@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/oauthconsent"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/predicate"
+)
+
+// OAuthConsentQuery is the builder for querying OAuthConsent entities.
+type OAuthConsentQuery struct {
+	config
+	ctx        *QueryContext
+	order      []oauthconsent.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OAuthConsent
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OAuthConsentQuery builder.
+func (ocq *OAuthConsentQuery) Where(ps ...predicate.OAuthConsent) *OAuthConsentQuery {
+	ocq.predicates = append(ocq.predicates, ps...)
+	return ocq
+}
+
+// Limit the number of records to be returned by this query.
+func (ocq *OAuthConsentQuery) Limit(limit int) *OAuthConsentQuery {
+	ocq.ctx.Limit = &limit
+	return ocq
+}
+
+// Offset to start from.
+func (ocq *OAuthConsentQuery) Offset(offset int) *OAuthConsentQuery {
+	ocq.ctx.Offset = &offset
+	return ocq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ocq *OAuthConsentQuery) Unique(unique bool) *OAuthConsentQuery {
+	ocq.ctx.Unique = &unique
+	return ocq
+}
+
+// Order specifies how the records should be ordered.
+func (ocq *OAuthConsentQuery) Order(o ...oauthconsent.OrderOption) *OAuthConsentQuery {
+	ocq.order = append(ocq.order, o...)
+	return ocq
+}
+
+// First returns the first OAuthConsent entity from the query.
+// Returns a *NotFoundError when no OAuthConsent was found.
+func (ocq *OAuthConsentQuery) First(ctx context.Context) (*OAuthConsent, error) {
+	nodes, err := ocq.Limit(1).All(setContextOp(ctx, ocq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{oauthconsent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) FirstX(ctx context.Context) *OAuthConsent {
+	node, err := ocq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OAuthConsent ID from the query.
+// Returns a *NotFoundError when no OAuthConsent ID was found.
+func (ocq *OAuthConsentQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ocq.Limit(1).IDs(setContextOp(ctx, ocq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{oauthconsent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) FirstIDX(ctx context.Context) int {
+	id, err := ocq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OAuthConsent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OAuthConsent entity is found.
+// Returns a *NotFoundError when no OAuthConsent entities are found.
+func (ocq *OAuthConsentQuery) Only(ctx context.Context) (*OAuthConsent, error) {
+	nodes, err := ocq.Limit(2).All(setContextOp(ctx, ocq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{oauthconsent.Label}
+	default:
+		return nil, &NotSingularError{oauthconsent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) OnlyX(ctx context.Context) *OAuthConsent {
+	node, err := ocq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OAuthConsent ID in the query.
+// Returns a *NotSingularError when more than one OAuthConsent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ocq *OAuthConsentQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ocq.Limit(2).IDs(setContextOp(ctx, ocq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{oauthconsent.Label}
+	default:
+		err = &NotSingularError{oauthconsent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ocq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OAuthConsents.
+func (ocq *OAuthConsentQuery) All(ctx context.Context) ([]*OAuthConsent, error) {
+	ctx = setContextOp(ctx, ocq.ctx, ent.OpQueryAll)
+	if err := ocq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OAuthConsent, *OAuthConsentQuery]()
+	return withInterceptors[[]*OAuthConsent](ctx, ocq, qr, ocq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) AllX(ctx context.Context) []*OAuthConsent {
+	nodes, err := ocq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OAuthConsent IDs.
+func (ocq *OAuthConsentQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ocq.ctx.Unique == nil && ocq.path != nil {
+		ocq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ocq.ctx, ent.OpQueryIDs)
+	if err = ocq.Select(oauthconsent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) IDsX(ctx context.Context) []int {
+	ids, err := ocq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ocq *OAuthConsentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ocq.ctx, ent.OpQueryCount)
+	if err := ocq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ocq, querierCount[*OAuthConsentQuery](), ocq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) CountX(ctx context.Context) int {
+	count, err := ocq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ocq *OAuthConsentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ocq.ctx, ent.OpQueryExist)
+	switch _, err := ocq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) ExistX(ctx context.Context) bool {
+	exist, err := ocq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OAuthConsentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ocq *OAuthConsentQuery) Clone() *OAuthConsentQuery {
+	if ocq == nil {
+		return nil
+	}
+	return &OAuthConsentQuery{
+		config:     ocq.config,
+		ctx:        ocq.ctx.Clone(),
+		order:      append([]oauthconsent.OrderOption{}, ocq.order...),
+		inters:     append([]Interceptor{}, ocq.inters...),
+		predicates: append([]predicate.OAuthConsent{}, ocq.predicates...),
+		// clone intermediate query.
+		sql:  ocq.sql.Clone(),
+		path: ocq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uint `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OAuthConsent.Query().
+//		GroupBy(oauthconsent.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ocq *OAuthConsentQuery) GroupBy(field string, fields ...string) *OAuthConsentGroupBy {
+	ocq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OAuthConsentGroupBy{build: ocq}
+	grbuild.flds = &ocq.ctx.Fields
+	grbuild.label = oauthconsent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uint `json:"user_id,omitempty"`
+//	}
+//
+//	client.OAuthConsent.Query().
+//		Select(oauthconsent.FieldUserID).
+//		Scan(ctx, &v)
+func (ocq *OAuthConsentQuery) Select(fields ...string) *OAuthConsentSelect {
+	ocq.ctx.Fields = append(ocq.ctx.Fields, fields...)
+	sbuild := &OAuthConsentSelect{OAuthConsentQuery: ocq}
+	sbuild.label = oauthconsent.Label
+	sbuild.flds, sbuild.scan = &ocq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OAuthConsentSelect configured with the given aggregations.
+func (ocq *OAuthConsentQuery) Aggregate(fns ...AggregateFunc) *OAuthConsentSelect {
+	return ocq.Select().Aggregate(fns...)
+}
+
+func (ocq *OAuthConsentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ocq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ocq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ocq.ctx.Fields {
+		if !oauthconsent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ocq.path != nil {
+		prev, err := ocq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ocq.sql = prev
+	}
+	return nil
+}
+
+func (ocq *OAuthConsentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OAuthConsent, error) {
+	var (
+		nodes = []*OAuthConsent{}
+		_spec = ocq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OAuthConsent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OAuthConsent{config: ocq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ocq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ocq *OAuthConsentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ocq.querySpec()
+	_spec.Node.Columns = ocq.ctx.Fields
+	if len(ocq.ctx.Fields) > 0 {
+		_spec.Unique = ocq.ctx.Unique != nil && *ocq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ocq.driver, _spec)
+}
+
+func (ocq *OAuthConsentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(oauthconsent.Table, oauthconsent.Columns, sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt))
+	_spec.From = ocq.sql
+	if unique := ocq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ocq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ocq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthconsent.FieldID)
+		for i := range fields {
+			if fields[i] != oauthconsent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ocq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ocq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ocq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ocq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ocq *OAuthConsentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ocq.driver.Dialect())
+	t1 := builder.Table(oauthconsent.Table)
+	columns := ocq.ctx.Fields
+	if len(columns) == 0 {
+		columns = oauthconsent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ocq.sql != nil {
+		selector = ocq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ocq.ctx.Unique != nil && *ocq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ocq.predicates {
+		p(selector)
+	}
+	for _, p := range ocq.order {
+		p(selector)
+	}
+	if offset := ocq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ocq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OAuthConsentGroupBy is the group-by builder for OAuthConsent entities.
+type OAuthConsentGroupBy struct {
+	selector
+	build *OAuthConsentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ocgb *OAuthConsentGroupBy) Aggregate(fns ...AggregateFunc) *OAuthConsentGroupBy {
+	ocgb.fns = append(ocgb.fns, fns...)
+	return ocgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ocgb *OAuthConsentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ocgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ocgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OAuthConsentQuery, *OAuthConsentGroupBy](ctx, ocgb.build, ocgb, ocgb.build.inters, v)
+}
+
+func (ocgb *OAuthConsentGroupBy) sqlScan(ctx context.Context, root *OAuthConsentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ocgb.fns))
+	for _, fn := range ocgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ocgb.flds)+len(ocgb.fns))
+		for _, f := range *ocgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ocgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ocgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OAuthConsentSelect is the builder for selecting fields of OAuthConsent entities.
+type OAuthConsentSelect struct {
+	*OAuthConsentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ocs *OAuthConsentSelect) Aggregate(fns ...AggregateFunc) *OAuthConsentSelect {
+	ocs.fns = append(ocs.fns, fns...)
+	return ocs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ocs *OAuthConsentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ocs.ctx, ent.OpQuerySelect)
+	if err := ocs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OAuthConsentQuery, *OAuthConsentSelect](ctx, ocs.OAuthConsentQuery, ocs, ocs.inters, v)
+}
+
+func (ocs *OAuthConsentSelect) sqlScan(ctx context.Context, root *OAuthConsentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ocs.fns))
+	for _, fn := range ocs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ocs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ocs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
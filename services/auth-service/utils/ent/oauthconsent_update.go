@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/oauthconsent"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/predicate"
+)
+
+// OAuthConsentUpdate is the builder for updating OAuthConsent entities.
+type OAuthConsentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OAuthConsentMutation
+}
+
+// Where appends a list predicates to the OAuthConsentUpdate builder.
+func (ocu *OAuthConsentUpdate) Where(ps ...predicate.OAuthConsent) *OAuthConsentUpdate {
+	ocu.mutation.Where(ps...)
+	return ocu
+}
+
+// SetUserID sets the "user_id" field.
+func (ocu *OAuthConsentUpdate) SetUserID(u uint) *OAuthConsentUpdate {
+	ocu.mutation.ResetUserID()
+	ocu.mutation.SetUserID(u)
+	return ocu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ocu *OAuthConsentUpdate) SetNillableUserID(u *uint) *OAuthConsentUpdate {
+	if u != nil {
+		ocu.SetUserID(*u)
+	}
+	return ocu
+}
+
+// AddUserID adds u to the "user_id" field.
+func (ocu *OAuthConsentUpdate) AddUserID(u int) *OAuthConsentUpdate {
+	ocu.mutation.AddUserID(u)
+	return ocu
+}
+
+// SetClientID sets the "client_id" field.
+func (ocu *OAuthConsentUpdate) SetClientID(s string) *OAuthConsentUpdate {
+	ocu.mutation.SetClientID(s)
+	return ocu
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (ocu *OAuthConsentUpdate) SetNillableClientID(s *string) *OAuthConsentUpdate {
+	if s != nil {
+		ocu.SetClientID(*s)
+	}
+	return ocu
+}
+
+// SetScopes sets the "scopes" field.
+func (ocu *OAuthConsentUpdate) SetScopes(s []string) *OAuthConsentUpdate {
+	ocu.mutation.SetScopes(s)
+	return ocu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (ocu *OAuthConsentUpdate) AppendScopes(s []string) *OAuthConsentUpdate {
+	ocu.mutation.AppendScopes(s)
+	return ocu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ocu *OAuthConsentUpdate) SetUpdatedAt(t time.Time) *OAuthConsentUpdate {
+	ocu.mutation.SetUpdatedAt(t)
+	return ocu
+}
+
+// Mutation returns the OAuthConsentMutation object of the builder.
+func (ocu *OAuthConsentUpdate) Mutation() *OAuthConsentMutation {
+	return ocu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ocu *OAuthConsentUpdate) Save(ctx context.Context) (int, error) {
+	ocu.defaults()
+	return withHooks(ctx, ocu.sqlSave, ocu.mutation, ocu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocu *OAuthConsentUpdate) SaveX(ctx context.Context) int {
+	affected, err := ocu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ocu *OAuthConsentUpdate) Exec(ctx context.Context) error {
+	_, err := ocu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocu *OAuthConsentUpdate) ExecX(ctx context.Context) {
+	if err := ocu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocu *OAuthConsentUpdate) defaults() {
+	if _, ok := ocu.mutation.UpdatedAt(); !ok {
+		v := oauthconsent.UpdateDefaultUpdatedAt()
+		ocu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocu *OAuthConsentUpdate) check() error {
+	if v, ok := ocu.mutation.UserID(); ok {
+		if err := oauthconsent.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OAuthConsent.user_id": %w`, err)}
+		}
+	}
+	if v, ok := ocu.mutation.ClientID(); ok {
+		if err := oauthconsent.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OAuthConsent.client_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ocu *OAuthConsentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ocu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthconsent.Table, oauthconsent.Columns, sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt))
+	if ps := ocu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocu.mutation.UserID(); ok {
+		_spec.SetField(oauthconsent.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := ocu.mutation.AddedUserID(); ok {
+		_spec.AddField(oauthconsent.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := ocu.mutation.ClientID(); ok {
+		_spec.SetField(oauthconsent.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := ocu.mutation.Scopes(); ok {
+		_spec.SetField(oauthconsent.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := ocu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthconsent.FieldScopes, value)
+		})
+	}
+	if value, ok := ocu.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthconsent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ocu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthconsent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ocu.mutation.done = true
+	return n, nil
+}
+
+// OAuthConsentUpdateOne is the builder for updating a single OAuthConsent entity.
+type OAuthConsentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OAuthConsentMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (ocuo *OAuthConsentUpdateOne) SetUserID(u uint) *OAuthConsentUpdateOne {
+	ocuo.mutation.ResetUserID()
+	ocuo.mutation.SetUserID(u)
+	return ocuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ocuo *OAuthConsentUpdateOne) SetNillableUserID(u *uint) *OAuthConsentUpdateOne {
+	if u != nil {
+		ocuo.SetUserID(*u)
+	}
+	return ocuo
+}
+
+// AddUserID adds u to the "user_id" field.
+func (ocuo *OAuthConsentUpdateOne) AddUserID(u int) *OAuthConsentUpdateOne {
+	ocuo.mutation.AddUserID(u)
+	return ocuo
+}
+
+// SetClientID sets the "client_id" field.
+func (ocuo *OAuthConsentUpdateOne) SetClientID(s string) *OAuthConsentUpdateOne {
+	ocuo.mutation.SetClientID(s)
+	return ocuo
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (ocuo *OAuthConsentUpdateOne) SetNillableClientID(s *string) *OAuthConsentUpdateOne {
+	if s != nil {
+		ocuo.SetClientID(*s)
+	}
+	return ocuo
+}
+
+// SetScopes sets the "scopes" field.
+func (ocuo *OAuthConsentUpdateOne) SetScopes(s []string) *OAuthConsentUpdateOne {
+	ocuo.mutation.SetScopes(s)
+	return ocuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (ocuo *OAuthConsentUpdateOne) AppendScopes(s []string) *OAuthConsentUpdateOne {
+	ocuo.mutation.AppendScopes(s)
+	return ocuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ocuo *OAuthConsentUpdateOne) SetUpdatedAt(t time.Time) *OAuthConsentUpdateOne {
+	ocuo.mutation.SetUpdatedAt(t)
+	return ocuo
+}
+
+// Mutation returns the OAuthConsentMutation object of the builder.
+func (ocuo *OAuthConsentUpdateOne) Mutation() *OAuthConsentMutation {
+	return ocuo.mutation
+}
+
+// Where appends a list predicates to the OAuthConsentUpdate builder.
+func (ocuo *OAuthConsentUpdateOne) Where(ps ...predicate.OAuthConsent) *OAuthConsentUpdateOne {
+	ocuo.mutation.Where(ps...)
+	return ocuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ocuo *OAuthConsentUpdateOne) Select(field string, fields ...string) *OAuthConsentUpdateOne {
+	ocuo.fields = append([]string{field}, fields...)
+	return ocuo
+}
+
+// Save executes the query and returns the updated OAuthConsent entity.
+func (ocuo *OAuthConsentUpdateOne) Save(ctx context.Context) (*OAuthConsent, error) {
+	ocuo.defaults()
+	return withHooks(ctx, ocuo.sqlSave, ocuo.mutation, ocuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocuo *OAuthConsentUpdateOne) SaveX(ctx context.Context) *OAuthConsent {
+	node, err := ocuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ocuo *OAuthConsentUpdateOne) Exec(ctx context.Context) error {
+	_, err := ocuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocuo *OAuthConsentUpdateOne) ExecX(ctx context.Context) {
+	if err := ocuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocuo *OAuthConsentUpdateOne) defaults() {
+	if _, ok := ocuo.mutation.UpdatedAt(); !ok {
+		v := oauthconsent.UpdateDefaultUpdatedAt()
+		ocuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocuo *OAuthConsentUpdateOne) check() error {
+	if v, ok := ocuo.mutation.UserID(); ok {
+		if err := oauthconsent.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OAuthConsent.user_id": %w`, err)}
+		}
+	}
+	if v, ok := ocuo.mutation.ClientID(); ok {
+		if err := oauthconsent.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OAuthConsent.client_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ocuo *OAuthConsentUpdateOne) sqlSave(ctx context.Context) (_node *OAuthConsent, err error) {
+	if err := ocuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthconsent.Table, oauthconsent.Columns, sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt))
+	id, ok := ocuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OAuthConsent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ocuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthconsent.FieldID)
+		for _, f := range fields {
+			if !oauthconsent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthconsent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ocuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocuo.mutation.UserID(); ok {
+		_spec.SetField(oauthconsent.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := ocuo.mutation.AddedUserID(); ok {
+		_spec.AddField(oauthconsent.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := ocuo.mutation.ClientID(); ok {
+		_spec.SetField(oauthconsent.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := ocuo.mutation.Scopes(); ok {
+		_spec.SetField(oauthconsent.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := ocuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthconsent.FieldScopes, value)
+		})
+	}
+	if value, ok := ocuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthconsent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &OAuthConsent{config: ocuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ocuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthconsent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ocuo.mutation.done = true
+	return _node, nil
+}
@@ -8,3 +8,18 @@ import (
 
 // Auth is the predicate function for auth builders.
 type Auth func(*sql.Selector)
+
+// Bot is the predicate function for bot builders.
+type Bot func(*sql.Selector)
+
+// OAuthClient is the predicate function for oauthclient builders.
+type OAuthClient func(*sql.Selector)
+
+// OAuthConsent is the predicate function for oauthconsent builders.
+type OAuthConsent func(*sql.Selector)
+
+// RecoveryCode is the predicate function for recoverycode builders.
+type RecoveryCode func(*sql.Selector)
+
+// TwoFactor is the predicate function for twofactor builders.
+type TwoFactor func(*sql.Selector)
@@ -6,7 +6,12 @@ import (
 	"time"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/auth"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/bot"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/oauthclient"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/oauthconsent"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/recoverycode"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/schema"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/twofactor"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -15,20 +20,156 @@ import (
 func init() {
 	authFields := schema.Auth{}.Fields()
 	_ = authFields
+	// authDescFamilyID is the schema descriptor for family_id field.
+	authDescFamilyID := authFields[1].Descriptor()
+	// auth.FamilyIDValidator is a validator for the "family_id" field. It is called by the builders before save.
+	auth.FamilyIDValidator = authDescFamilyID.Validators[0].(func(string) error)
 	// authDescUserID is the schema descriptor for user_id field.
-	authDescUserID := authFields[1].Descriptor()
+	authDescUserID := authFields[2].Descriptor()
 	// auth.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
 	auth.UserIDValidator = authDescUserID.Validators[0].(func(uint) error)
 	// authDescIsRevoked is the schema descriptor for is_revoked field.
-	authDescIsRevoked := authFields[3].Descriptor()
+	authDescIsRevoked := authFields[5].Descriptor()
 	// auth.DefaultIsRevoked holds the default value on creation for the is_revoked field.
 	auth.DefaultIsRevoked = authDescIsRevoked.Default.(bool)
 	// authDescCreatedAt is the schema descriptor for created_at field.
-	authDescCreatedAt := authFields[4].Descriptor()
+	authDescCreatedAt := authFields[6].Descriptor()
 	// auth.DefaultCreatedAt holds the default value on creation for the created_at field.
 	auth.DefaultCreatedAt = authDescCreatedAt.Default.(func() time.Time)
+	// authDescUserAgent is the schema descriptor for user_agent field.
+	authDescUserAgent := authFields[8].Descriptor()
+	// auth.DefaultUserAgent holds the default value on creation for the user_agent field.
+	auth.DefaultUserAgent = authDescUserAgent.Default.(string)
+	// authDescIPAddress is the schema descriptor for ip_address field.
+	authDescIPAddress := authFields[9].Descriptor()
+	// auth.DefaultIPAddress holds the default value on creation for the ip_address field.
+	auth.DefaultIPAddress = authDescIPAddress.Default.(string)
+	// authDescSessionCreatedAt is the schema descriptor for session_created_at field.
+	authDescSessionCreatedAt := authFields[10].Descriptor()
+	// auth.DefaultSessionCreatedAt holds the default value on creation for the session_created_at field.
+	auth.DefaultSessionCreatedAt = authDescSessionCreatedAt.Default.(func() time.Time)
+	// authDescLastUsedAt is the schema descriptor for last_used_at field.
+	authDescLastUsedAt := authFields[11].Descriptor()
+	// auth.DefaultLastUsedAt holds the default value on creation for the last_used_at field.
+	auth.DefaultLastUsedAt = authDescLastUsedAt.Default.(func() time.Time)
+	// authDescClientID is the schema descriptor for client_id field.
+	authDescClientID := authFields[12].Descriptor()
+	// auth.DefaultClientID holds the default value on creation for the client_id field.
+	auth.DefaultClientID = authDescClientID.Default.(string)
+	// authDescScope is the schema descriptor for scope field.
+	authDescScope := authFields[13].Descriptor()
+	// auth.DefaultScope holds the default value on creation for the scope field.
+	auth.DefaultScope = authDescScope.Default.(string)
 	// authDescID is the schema descriptor for id field.
 	authDescID := authFields[0].Descriptor()
 	// auth.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	auth.IDValidator = authDescID.Validators[0].(func(string) error)
+	botFields := schema.Bot{}.Fields()
+	_ = botFields
+	// botDescName is the schema descriptor for name field.
+	botDescName := botFields[0].Descriptor()
+	// bot.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bot.NameValidator = botDescName.Validators[0].(func(string) error)
+	// botDescOwnerID is the schema descriptor for owner_id field.
+	botDescOwnerID := botFields[1].Descriptor()
+	// bot.OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	bot.OwnerIDValidator = botDescOwnerID.Validators[0].(func(uint) error)
+	// botDescClientID is the schema descriptor for client_id field.
+	botDescClientID := botFields[2].Descriptor()
+	// bot.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	bot.ClientIDValidator = botDescClientID.Validators[0].(func(string) error)
+	// botDescSecretHash is the schema descriptor for secret_hash field.
+	botDescSecretHash := botFields[3].Descriptor()
+	// bot.SecretHashValidator is a validator for the "secret_hash" field. It is called by the builders before save.
+	bot.SecretHashValidator = botDescSecretHash.Validators[0].(func(string) error)
+	// botDescIsRevoked is the schema descriptor for is_revoked field.
+	botDescIsRevoked := botFields[4].Descriptor()
+	// bot.DefaultIsRevoked holds the default value on creation for the is_revoked field.
+	bot.DefaultIsRevoked = botDescIsRevoked.Default.(bool)
+	// botDescCreatedAt is the schema descriptor for created_at field.
+	botDescCreatedAt := botFields[5].Descriptor()
+	// bot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bot.DefaultCreatedAt = botDescCreatedAt.Default.(func() time.Time)
+	oauthclientFields := schema.OAuthClient{}.Fields()
+	_ = oauthclientFields
+	// oauthclientDescName is the schema descriptor for name field.
+	oauthclientDescName := oauthclientFields[0].Descriptor()
+	// oauthclient.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	oauthclient.NameValidator = oauthclientDescName.Validators[0].(func(string) error)
+	// oauthclientDescOwnerID is the schema descriptor for owner_id field.
+	oauthclientDescOwnerID := oauthclientFields[1].Descriptor()
+	// oauthclient.OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	oauthclient.OwnerIDValidator = oauthclientDescOwnerID.Validators[0].(func(uint) error)
+	// oauthclientDescClientID is the schema descriptor for client_id field.
+	oauthclientDescClientID := oauthclientFields[2].Descriptor()
+	// oauthclient.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauthclient.ClientIDValidator = oauthclientDescClientID.Validators[0].(func(string) error)
+	// oauthclientDescSecretHash is the schema descriptor for secret_hash field.
+	oauthclientDescSecretHash := oauthclientFields[3].Descriptor()
+	// oauthclient.DefaultSecretHash holds the default value on creation for the secret_hash field.
+	oauthclient.DefaultSecretHash = oauthclientDescSecretHash.Default.(string)
+	// oauthclientDescIsRevoked is the schema descriptor for is_revoked field.
+	oauthclientDescIsRevoked := oauthclientFields[6].Descriptor()
+	// oauthclient.DefaultIsRevoked holds the default value on creation for the is_revoked field.
+	oauthclient.DefaultIsRevoked = oauthclientDescIsRevoked.Default.(bool)
+	// oauthclientDescCreatedAt is the schema descriptor for created_at field.
+	oauthclientDescCreatedAt := oauthclientFields[7].Descriptor()
+	// oauthclient.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthclient.DefaultCreatedAt = oauthclientDescCreatedAt.Default.(func() time.Time)
+	oauthconsentFields := schema.OAuthConsent{}.Fields()
+	_ = oauthconsentFields
+	// oauthconsentDescUserID is the schema descriptor for user_id field.
+	oauthconsentDescUserID := oauthconsentFields[0].Descriptor()
+	// oauthconsent.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	oauthconsent.UserIDValidator = oauthconsentDescUserID.Validators[0].(func(uint) error)
+	// oauthconsentDescClientID is the schema descriptor for client_id field.
+	oauthconsentDescClientID := oauthconsentFields[1].Descriptor()
+	// oauthconsent.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauthconsent.ClientIDValidator = oauthconsentDescClientID.Validators[0].(func(string) error)
+	// oauthconsentDescCreatedAt is the schema descriptor for created_at field.
+	oauthconsentDescCreatedAt := oauthconsentFields[3].Descriptor()
+	// oauthconsent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthconsent.DefaultCreatedAt = oauthconsentDescCreatedAt.Default.(func() time.Time)
+	// oauthconsentDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthconsentDescUpdatedAt := oauthconsentFields[4].Descriptor()
+	// oauthconsent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthconsent.DefaultUpdatedAt = oauthconsentDescUpdatedAt.Default.(func() time.Time)
+	// oauthconsent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthconsent.UpdateDefaultUpdatedAt = oauthconsentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescUserID is the schema descriptor for user_id field.
+	recoverycodeDescUserID := recoverycodeFields[0].Descriptor()
+	// recoverycode.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	recoverycode.UserIDValidator = recoverycodeDescUserID.Validators[0].(func(uint) error)
+	// recoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	recoverycodeDescCodeHash := recoverycodeFields[1].Descriptor()
+	// recoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	recoverycode.CodeHashValidator = recoverycodeDescCodeHash.Validators[0].(func(string) error)
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeFields[3].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	twofactorFields := schema.TwoFactor{}.Fields()
+	_ = twofactorFields
+	// twofactorDescUserID is the schema descriptor for user_id field.
+	twofactorDescUserID := twofactorFields[0].Descriptor()
+	// twofactor.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	twofactor.UserIDValidator = twofactorDescUserID.Validators[0].(func(uint) error)
+	// twofactorDescEncryptedSecret is the schema descriptor for encrypted_secret field.
+	twofactorDescEncryptedSecret := twofactorFields[1].Descriptor()
+	// twofactor.EncryptedSecretValidator is a validator for the "encrypted_secret" field. It is called by the builders before save.
+	twofactor.EncryptedSecretValidator = twofactorDescEncryptedSecret.Validators[0].(func(string) error)
+	// twofactorDescEnabled is the schema descriptor for enabled field.
+	twofactorDescEnabled := twofactorFields[2].Descriptor()
+	// twofactor.DefaultEnabled holds the default value on creation for the enabled field.
+	twofactor.DefaultEnabled = twofactorDescEnabled.Default.(bool)
+	// twofactorDescCreatedAt is the schema descriptor for created_at field.
+	twofactorDescCreatedAt := twofactorFields[3].Descriptor()
+	// twofactor.DefaultCreatedAt holds the default value on creation for the created_at field.
+	twofactor.DefaultCreatedAt = twofactorDescCreatedAt.Default.(func() time.Time)
+	// twofactorDescLastStep is the schema descriptor for last_step field.
+	twofactorDescLastStep := twofactorFields[5].Descriptor()
+	// twofactor.DefaultLastStep holds the default value on creation for the last_step field.
+	twofactor.DefaultLastStep = twofactorDescLastStep.Default.(int64)
 }
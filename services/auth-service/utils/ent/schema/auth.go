@@ -5,9 +5,14 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Auth holds the schema definition for the Auth entity.
+// Every refresh of a session rotates its refresh token: the new token is created in
+// the same family, the family ID of the login, and the old one is replaced_by it.
+// The new token keeps session_created_at and records the device that refreshed it.
+// The tokens of an OAuth client carry its client_id and the scope the user granted.
 type Auth struct {
 	ent.Schema
 }
@@ -18,14 +23,39 @@ func (Auth) Fields() []ent.Field {
 		field.String("id").
 			NotEmpty().
 			Unique(),
+		field.String("family_id").
+			NotEmpty(),
 		field.Uint("user_id").
 			Positive(),
 		field.String("refresh_token"),
+		field.String("replaced_by").
+			Optional().
+			Nillable(),
 		field.Bool("is_revoked").
 			Default(false),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("expires_at"),
+		field.String("user_agent").
+			Default(""),
+		field.String("ip_address").
+			Default(""),
+		field.Time("session_created_at").
+			Default(time.Now),
+		field.Time("last_used_at").
+			Default(time.Now),
+		field.String("client_id").
+			Default(""),
+		field.String("scope").
+			Default(""),
+	}
+}
+
+// Indexes of the Auth.
+func (Auth) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("family_id"),
+		index.Fields("user_id"),
 	}
 }
 
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Bot holds the schema definition for the Bot entity.
+// Bots authenticate with a client id and secret instead of a user password.
+type Bot struct {
+	ent.Schema
+}
+
+// Fields of the Bot.
+func (Bot) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique(),
+		field.Uint("owner_id").
+			Positive(),
+		field.String("client_id").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("secret_hash").
+			NotEmpty().
+			Sensitive(),
+		field.Bool("is_revoked").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Bot.
+func (Bot) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthClient holds the schema definition for the OAuthClient entity.
+// A third-party app signs users in with the authorization code flow and may only ask for
+// its scopes and redirect to its redirect URIs. Public clients have no secret and prove
+// their authorization codes with PKCE alone.
+type OAuthClient struct {
+	ent.Schema
+}
+
+// Fields of the OAuthClient.
+func (OAuthClient) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique(),
+		field.Uint("owner_id").
+			Positive(),
+		field.String("client_id").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("secret_hash").
+			Default("").
+			Sensitive(),
+		field.Strings("redirect_uris"),
+		field.Strings("scopes"),
+		field.Bool("is_revoked").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Annotations of the OAuthClient.
+func (OAuthClient) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "oauth_clients"},
+	}
+}
+
+// Edges of the OAuthClient.
+func (OAuthClient) Edges() []ent.Edge {
+	return nil
+}
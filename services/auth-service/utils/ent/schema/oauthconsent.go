@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OAuthConsent holds the schema definition for the OAuthConsent entity.
+// It records the scopes a user granted to an OAuth client, so the user is only asked
+// again when the client wants more.
+type OAuthConsent struct {
+	ent.Schema
+}
+
+// Fields of the OAuthConsent.
+func (OAuthConsent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("user_id").
+			Positive(),
+		field.String("client_id").
+			NotEmpty(),
+		field.Strings("scopes"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the OAuthConsent.
+func (OAuthConsent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "client_id").
+			Unique(),
+	}
+}
+
+// Annotations of the OAuthConsent.
+func (OAuthConsent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "oauth_consents"},
+	}
+}
+
+// Edges of the OAuthConsent.
+func (OAuthConsent) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RecoveryCode holds the schema definition for the RecoveryCode entity.
+// Recovery codes replace a TOTP code once each when the user lost their device.
+type RecoveryCode struct {
+	ent.Schema
+}
+
+// Fields of the RecoveryCode.
+func (RecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("user_id").
+			Positive(),
+		field.String("code_hash").
+			NotEmpty().
+			Sensitive(),
+		field.Time("used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the RecoveryCode.
+func (RecoveryCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+// Edges of the RecoveryCode.
+func (RecoveryCode) Edges() []ent.Edge {
+	return nil
+}
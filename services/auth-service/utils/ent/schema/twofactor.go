@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// TwoFactor holds the schema definition for the TwoFactor entity.
+// A user enrolls a TOTP secret, which only protects the logins once a first code
+// confirmed it and the second factor is enabled. The secret is only stored encrypted,
+// and last_step is the time step of the last accepted code, so no code is accepted twice.
+type TwoFactor struct {
+	ent.Schema
+}
+
+// Fields of the TwoFactor.
+func (TwoFactor) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("user_id").
+			Positive().
+			Unique(),
+		field.String("encrypted_secret").
+			NotEmpty().
+			Sensitive(),
+		field.Bool("enabled").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("enabled_at").
+			Optional().
+			Nillable(),
+		field.Int64("last_step").
+			Default(0),
+	}
+}
+
+// Edges of the TwoFactor.
+func (TwoFactor) Edges() []ent.Edge {
+	return nil
+}
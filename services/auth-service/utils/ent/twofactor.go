@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/twofactor"
+)
+
+// TwoFactor is the model entity for the TwoFactor schema.
+type TwoFactor struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint `json:"user_id,omitempty"`
+	// EncryptedSecret holds the value of the "encrypted_secret" field.
+	EncryptedSecret string `json:"-"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// EnabledAt holds the value of the "enabled_at" field.
+	EnabledAt *time.Time `json:"enabled_at,omitempty"`
+	// LastStep holds the value of the "last_step" field.
+	LastStep     int64 `json:"last_step,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TwoFactor) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case twofactor.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case twofactor.FieldID, twofactor.FieldUserID, twofactor.FieldLastStep:
+			values[i] = new(sql.NullInt64)
+		case twofactor.FieldEncryptedSecret:
+			values[i] = new(sql.NullString)
+		case twofactor.FieldCreatedAt, twofactor.FieldEnabledAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TwoFactor fields.
+func (tf *TwoFactor) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case twofactor.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tf.ID = int(value.Int64)
+		case twofactor.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				tf.UserID = uint(value.Int64)
+			}
+		case twofactor.FieldEncryptedSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field encrypted_secret", values[i])
+			} else if value.Valid {
+				tf.EncryptedSecret = value.String
+			}
+		case twofactor.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				tf.Enabled = value.Bool
+			}
+		case twofactor.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tf.CreatedAt = value.Time
+			}
+		case twofactor.FieldEnabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled_at", values[i])
+			} else if value.Valid {
+				tf.EnabledAt = new(time.Time)
+				*tf.EnabledAt = value.Time
+			}
+		case twofactor.FieldLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_step", values[i])
+			} else if value.Valid {
+				tf.LastStep = value.Int64
+			}
+		default:
+			tf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TwoFactor.
+// This includes values selected through modifiers, order, etc.
+func (tf *TwoFactor) Value(name string) (ent.Value, error) {
+	return tf.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TwoFactor.
+// Note that you need to call TwoFactor.Unwrap() before calling this method if this TwoFactor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tf *TwoFactor) Update() *TwoFactorUpdateOne {
+	return NewTwoFactorClient(tf.config).UpdateOne(tf)
+}
+
+// Unwrap unwraps the TwoFactor entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tf *TwoFactor) Unwrap() *TwoFactor {
+	_tx, ok := tf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TwoFactor is not a transactional entity")
+	}
+	tf.config.driver = _tx.drv
+	return tf
+}
+
+// String implements the fmt.Stringer.
+func (tf *TwoFactor) String() string {
+	var builder strings.Builder
+	builder.WriteString("TwoFactor(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tf.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", tf.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("encrypted_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", tf.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tf.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := tf.EnabledAt; v != nil {
+		builder.WriteString("enabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_step=")
+	builder.WriteString(fmt.Sprintf("%v", tf.LastStep))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TwoFactors is a parsable slice of TwoFactor.
+type TwoFactors []*TwoFactor
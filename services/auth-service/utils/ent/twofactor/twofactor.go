@@ -0,0 +1,102 @@
+// Code generated by ent, DO NOT EDIT.
+
+package twofactor
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the twofactor type in the database.
+	Label = "two_factor"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldEncryptedSecret holds the string denoting the encrypted_secret field in the database.
+	FieldEncryptedSecret = "encrypted_secret"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldEnabledAt holds the string denoting the enabled_at field in the database.
+	FieldEnabledAt = "enabled_at"
+	// FieldLastStep holds the string denoting the last_step field in the database.
+	FieldLastStep = "last_step"
+	// Table holds the table name of the twofactor in the database.
+	Table = "two_factors"
+)
+
+// Columns holds all SQL columns for twofactor fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldEncryptedSecret,
+	FieldEnabled,
+	FieldCreatedAt,
+	FieldEnabledAt,
+	FieldLastStep,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(uint) error
+	// EncryptedSecretValidator is a validator for the "encrypted_secret" field. It is called by the builders before save.
+	EncryptedSecretValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultLastStep holds the default value on creation for the "last_step" field.
+	DefaultLastStep int64
+)
+
+// OrderOption defines the ordering options for the TwoFactor queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByEncryptedSecret orders the results by the encrypted_secret field.
+func ByEncryptedSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEncryptedSecret, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByEnabledAt orders the results by the enabled_at field.
+func ByEnabledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabledAt, opts...).ToFunc()
+}
+
+// ByLastStep orders the results by the last_step field.
+func ByLastStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastStep, opts...).ToFunc()
+}
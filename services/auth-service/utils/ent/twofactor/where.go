@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package twofactor
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEQ(FieldUserID, v))
+}
+
+// EncryptedSecret applies equality check predicate on the "encrypted_secret" field. It's identical to EncryptedSecretEQ.
+func EncryptedSecret(v string) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEQ(FieldEncryptedSecret, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EnabledAt applies equality check predicate on the "enabled_at" field. It's identical to EnabledAtEQ.
+func EnabledAt(v time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEQ(FieldEnabledAt, v))
+}
+
+// LastStep applies equality check predicate on the "last_step" field. It's identical to LastStepEQ.
+func LastStep(v int64) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEQ(FieldLastStep, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uint) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uint) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uint) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uint) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldLTE(FieldUserID, v))
+}
+
+// EncryptedSecretEQ applies the EQ predicate on the "encrypted_secret" field.
+func EncryptedSecretEQ(v string) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEQ(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretNEQ applies the NEQ predicate on the "encrypted_secret" field.
+func EncryptedSecretNEQ(v string) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldNEQ(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretIn applies the In predicate on the "encrypted_secret" field.
+func EncryptedSecretIn(vs ...string) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldIn(FieldEncryptedSecret, vs...))
+}
+
+// EncryptedSecretNotIn applies the NotIn predicate on the "encrypted_secret" field.
+func EncryptedSecretNotIn(vs ...string) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldNotIn(FieldEncryptedSecret, vs...))
+}
+
+// EncryptedSecretGT applies the GT predicate on the "encrypted_secret" field.
+func EncryptedSecretGT(v string) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldGT(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretGTE applies the GTE predicate on the "encrypted_secret" field.
+func EncryptedSecretGTE(v string) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldGTE(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretLT applies the LT predicate on the "encrypted_secret" field.
+func EncryptedSecretLT(v string) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldLT(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretLTE applies the LTE predicate on the "encrypted_secret" field.
+func EncryptedSecretLTE(v string) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldLTE(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretContains applies the Contains predicate on the "encrypted_secret" field.
+func EncryptedSecretContains(v string) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldContains(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretHasPrefix applies the HasPrefix predicate on the "encrypted_secret" field.
+func EncryptedSecretHasPrefix(v string) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldHasPrefix(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretHasSuffix applies the HasSuffix predicate on the "encrypted_secret" field.
+func EncryptedSecretHasSuffix(v string) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldHasSuffix(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretEqualFold applies the EqualFold predicate on the "encrypted_secret" field.
+func EncryptedSecretEqualFold(v string) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEqualFold(FieldEncryptedSecret, v))
+}
+
+// EncryptedSecretContainsFold applies the ContainsFold predicate on the "encrypted_secret" field.
+func EncryptedSecretContainsFold(v string) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldContainsFold(FieldEncryptedSecret, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// EnabledAtEQ applies the EQ predicate on the "enabled_at" field.
+func EnabledAtEQ(v time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEQ(FieldEnabledAt, v))
+}
+
+// EnabledAtNEQ applies the NEQ predicate on the "enabled_at" field.
+func EnabledAtNEQ(v time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldNEQ(FieldEnabledAt, v))
+}
+
+// EnabledAtIn applies the In predicate on the "enabled_at" field.
+func EnabledAtIn(vs ...time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldIn(FieldEnabledAt, vs...))
+}
+
+// EnabledAtNotIn applies the NotIn predicate on the "enabled_at" field.
+func EnabledAtNotIn(vs ...time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldNotIn(FieldEnabledAt, vs...))
+}
+
+// EnabledAtGT applies the GT predicate on the "enabled_at" field.
+func EnabledAtGT(v time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldGT(FieldEnabledAt, v))
+}
+
+// EnabledAtGTE applies the GTE predicate on the "enabled_at" field.
+func EnabledAtGTE(v time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldGTE(FieldEnabledAt, v))
+}
+
+// EnabledAtLT applies the LT predicate on the "enabled_at" field.
+func EnabledAtLT(v time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldLT(FieldEnabledAt, v))
+}
+
+// EnabledAtLTE applies the LTE predicate on the "enabled_at" field.
+func EnabledAtLTE(v time.Time) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldLTE(FieldEnabledAt, v))
+}
+
+// EnabledAtIsNil applies the IsNil predicate on the "enabled_at" field.
+func EnabledAtIsNil() predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldIsNull(FieldEnabledAt))
+}
+
+// EnabledAtNotNil applies the NotNil predicate on the "enabled_at" field.
+func EnabledAtNotNil() predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldNotNull(FieldEnabledAt))
+}
+
+// LastStepEQ applies the EQ predicate on the "last_step" field.
+func LastStepEQ(v int64) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldEQ(FieldLastStep, v))
+}
+
+// LastStepNEQ applies the NEQ predicate on the "last_step" field.
+func LastStepNEQ(v int64) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldNEQ(FieldLastStep, v))
+}
+
+// LastStepIn applies the In predicate on the "last_step" field.
+func LastStepIn(vs ...int64) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldIn(FieldLastStep, vs...))
+}
+
+// LastStepNotIn applies the NotIn predicate on the "last_step" field.
+func LastStepNotIn(vs ...int64) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldNotIn(FieldLastStep, vs...))
+}
+
+// LastStepGT applies the GT predicate on the "last_step" field.
+func LastStepGT(v int64) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldGT(FieldLastStep, v))
+}
+
+// LastStepGTE applies the GTE predicate on the "last_step" field.
+func LastStepGTE(v int64) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldGTE(FieldLastStep, v))
+}
+
+// LastStepLT applies the LT predicate on the "last_step" field.
+func LastStepLT(v int64) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldLT(FieldLastStep, v))
+}
+
+// LastStepLTE applies the LTE predicate on the "last_step" field.
+func LastStepLTE(v int64) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.FieldLTE(FieldLastStep, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TwoFactor) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TwoFactor) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TwoFactor) predicate.TwoFactor {
+	return predicate.TwoFactor(sql.NotPredicates(p))
+}
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/twofactor"
+)
+
+// TwoFactorCreate is the builder for creating a TwoFactor entity.
+type TwoFactorCreate struct {
+	config
+	mutation *TwoFactorMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (tfc *TwoFactorCreate) SetUserID(u uint) *TwoFactorCreate {
+	tfc.mutation.SetUserID(u)
+	return tfc
+}
+
+// SetEncryptedSecret sets the "encrypted_secret" field.
+func (tfc *TwoFactorCreate) SetEncryptedSecret(s string) *TwoFactorCreate {
+	tfc.mutation.SetEncryptedSecret(s)
+	return tfc
+}
+
+// SetEnabled sets the "enabled" field.
+func (tfc *TwoFactorCreate) SetEnabled(b bool) *TwoFactorCreate {
+	tfc.mutation.SetEnabled(b)
+	return tfc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (tfc *TwoFactorCreate) SetNillableEnabled(b *bool) *TwoFactorCreate {
+	if b != nil {
+		tfc.SetEnabled(*b)
+	}
+	return tfc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tfc *TwoFactorCreate) SetCreatedAt(t time.Time) *TwoFactorCreate {
+	tfc.mutation.SetCreatedAt(t)
+	return tfc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tfc *TwoFactorCreate) SetNillableCreatedAt(t *time.Time) *TwoFactorCreate {
+	if t != nil {
+		tfc.SetCreatedAt(*t)
+	}
+	return tfc
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (tfc *TwoFactorCreate) SetEnabledAt(t time.Time) *TwoFactorCreate {
+	tfc.mutation.SetEnabledAt(t)
+	return tfc
+}
+
+// SetNillableEnabledAt sets the "enabled_at" field if the given value is not nil.
+func (tfc *TwoFactorCreate) SetNillableEnabledAt(t *time.Time) *TwoFactorCreate {
+	if t != nil {
+		tfc.SetEnabledAt(*t)
+	}
+	return tfc
+}
+
+// SetLastStep sets the "last_step" field.
+func (tfc *TwoFactorCreate) SetLastStep(i int64) *TwoFactorCreate {
+	tfc.mutation.SetLastStep(i)
+	return tfc
+}
+
+// SetNillableLastStep sets the "last_step" field if the given value is not nil.
+func (tfc *TwoFactorCreate) SetNillableLastStep(i *int64) *TwoFactorCreate {
+	if i != nil {
+		tfc.SetLastStep(*i)
+	}
+	return tfc
+}
+
+// Mutation returns the TwoFactorMutation object of the builder.
+func (tfc *TwoFactorCreate) Mutation() *TwoFactorMutation {
+	return tfc.mutation
+}
+
+// Save creates the TwoFactor in the database.
+func (tfc *TwoFactorCreate) Save(ctx context.Context) (*TwoFactor, error) {
+	tfc.defaults()
+	return withHooks(ctx, tfc.sqlSave, tfc.mutation, tfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tfc *TwoFactorCreate) SaveX(ctx context.Context) *TwoFactor {
+	v, err := tfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tfc *TwoFactorCreate) Exec(ctx context.Context) error {
+	_, err := tfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tfc *TwoFactorCreate) ExecX(ctx context.Context) {
+	if err := tfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tfc *TwoFactorCreate) defaults() {
+	if _, ok := tfc.mutation.Enabled(); !ok {
+		v := twofactor.DefaultEnabled
+		tfc.mutation.SetEnabled(v)
+	}
+	if _, ok := tfc.mutation.CreatedAt(); !ok {
+		v := twofactor.DefaultCreatedAt()
+		tfc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tfc.mutation.LastStep(); !ok {
+		v := twofactor.DefaultLastStep
+		tfc.mutation.SetLastStep(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tfc *TwoFactorCreate) check() error {
+	if _, ok := tfc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TwoFactor.user_id"`)}
+	}
+	if v, ok := tfc.mutation.UserID(); ok {
+		if err := twofactor.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "TwoFactor.user_id": %w`, err)}
+		}
+	}
+	if _, ok := tfc.mutation.EncryptedSecret(); !ok {
+		return &ValidationError{Name: "encrypted_secret", err: errors.New(`ent: missing required field "TwoFactor.encrypted_secret"`)}
+	}
+	if v, ok := tfc.mutation.EncryptedSecret(); ok {
+		if err := twofactor.EncryptedSecretValidator(v); err != nil {
+			return &ValidationError{Name: "encrypted_secret", err: fmt.Errorf(`ent: validator failed for field "TwoFactor.encrypted_secret": %w`, err)}
+		}
+	}
+	if _, ok := tfc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "TwoFactor.enabled"`)}
+	}
+	if _, ok := tfc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TwoFactor.created_at"`)}
+	}
+	if _, ok := tfc.mutation.LastStep(); !ok {
+		return &ValidationError{Name: "last_step", err: errors.New(`ent: missing required field "TwoFactor.last_step"`)}
+	}
+	return nil
+}
+
+func (tfc *TwoFactorCreate) sqlSave(ctx context.Context) (*TwoFactor, error) {
+	if err := tfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tfc.mutation.id = &_node.ID
+	tfc.mutation.done = true
+	return _node, nil
+}
+
+func (tfc *TwoFactorCreate) createSpec() (*TwoFactor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TwoFactor{config: tfc.config}
+		_spec = sqlgraph.NewCreateSpec(twofactor.Table, sqlgraph.NewFieldSpec(twofactor.FieldID, field.TypeInt))
+	)
+	if value, ok := tfc.mutation.UserID(); ok {
+		_spec.SetField(twofactor.FieldUserID, field.TypeUint, value)
+		_node.UserID = value
+	}
+	if value, ok := tfc.mutation.EncryptedSecret(); ok {
+		_spec.SetField(twofactor.FieldEncryptedSecret, field.TypeString, value)
+		_node.EncryptedSecret = value
+	}
+	if value, ok := tfc.mutation.Enabled(); ok {
+		_spec.SetField(twofactor.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := tfc.mutation.CreatedAt(); ok {
+		_spec.SetField(twofactor.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tfc.mutation.EnabledAt(); ok {
+		_spec.SetField(twofactor.FieldEnabledAt, field.TypeTime, value)
+		_node.EnabledAt = &value
+	}
+	if value, ok := tfc.mutation.LastStep(); ok {
+		_spec.SetField(twofactor.FieldLastStep, field.TypeInt64, value)
+		_node.LastStep = value
+	}
+	return _node, _spec
+}
+
+// TwoFactorCreateBulk is the builder for creating many TwoFactor entities in bulk.
+type TwoFactorCreateBulk struct {
+	config
+	err      error
+	builders []*TwoFactorCreate
+}
+
+// Save creates the TwoFactor entities in the database.
+func (tfcb *TwoFactorCreateBulk) Save(ctx context.Context) ([]*TwoFactor, error) {
+	if tfcb.err != nil {
+		return nil, tfcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tfcb.builders))
+	nodes := make([]*TwoFactor, len(tfcb.builders))
+	mutators := make([]Mutator, len(tfcb.builders))
+	for i := range tfcb.builders {
+		func(i int, root context.Context) {
+			builder := tfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TwoFactorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tfcb *TwoFactorCreateBulk) SaveX(ctx context.Context) []*TwoFactor {
+	v, err := tfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tfcb *TwoFactorCreateBulk) Exec(ctx context.Context) error {
+	_, err := tfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tfcb *TwoFactorCreateBulk) ExecX(ctx context.Context) {
+	if err := tfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/twofactor"
+)
+
+// TwoFactorQuery is the builder for querying TwoFactor entities.
+type TwoFactorQuery struct {
+	config
+	ctx        *QueryContext
+	order      []twofactor.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TwoFactor
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TwoFactorQuery builder.
+func (tfq *TwoFactorQuery) Where(ps ...predicate.TwoFactor) *TwoFactorQuery {
+	tfq.predicates = append(tfq.predicates, ps...)
+	return tfq
+}
+
+// Limit the number of records to be returned by this query.
+func (tfq *TwoFactorQuery) Limit(limit int) *TwoFactorQuery {
+	tfq.ctx.Limit = &limit
+	return tfq
+}
+
+// Offset to start from.
+func (tfq *TwoFactorQuery) Offset(offset int) *TwoFactorQuery {
+	tfq.ctx.Offset = &offset
+	return tfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tfq *TwoFactorQuery) Unique(unique bool) *TwoFactorQuery {
+	tfq.ctx.Unique = &unique
+	return tfq
+}
+
+// Order specifies how the records should be ordered.
+func (tfq *TwoFactorQuery) Order(o ...twofactor.OrderOption) *TwoFactorQuery {
+	tfq.order = append(tfq.order, o...)
+	return tfq
+}
+
+// First returns the first TwoFactor entity from the query.
+// Returns a *NotFoundError when no TwoFactor was found.
+func (tfq *TwoFactorQuery) First(ctx context.Context) (*TwoFactor, error) {
+	nodes, err := tfq.Limit(1).All(setContextOp(ctx, tfq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{twofactor.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tfq *TwoFactorQuery) FirstX(ctx context.Context) *TwoFactor {
+	node, err := tfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TwoFactor ID from the query.
+// Returns a *NotFoundError when no TwoFactor ID was found.
+func (tfq *TwoFactorQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tfq.Limit(1).IDs(setContextOp(ctx, tfq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{twofactor.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tfq *TwoFactorQuery) FirstIDX(ctx context.Context) int {
+	id, err := tfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TwoFactor entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TwoFactor entity is found.
+// Returns a *NotFoundError when no TwoFactor entities are found.
+func (tfq *TwoFactorQuery) Only(ctx context.Context) (*TwoFactor, error) {
+	nodes, err := tfq.Limit(2).All(setContextOp(ctx, tfq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{twofactor.Label}
+	default:
+		return nil, &NotSingularError{twofactor.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tfq *TwoFactorQuery) OnlyX(ctx context.Context) *TwoFactor {
+	node, err := tfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TwoFactor ID in the query.
+// Returns a *NotSingularError when more than one TwoFactor ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tfq *TwoFactorQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tfq.Limit(2).IDs(setContextOp(ctx, tfq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{twofactor.Label}
+	default:
+		err = &NotSingularError{twofactor.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tfq *TwoFactorQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TwoFactors.
+func (tfq *TwoFactorQuery) All(ctx context.Context) ([]*TwoFactor, error) {
+	ctx = setContextOp(ctx, tfq.ctx, ent.OpQueryAll)
+	if err := tfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TwoFactor, *TwoFactorQuery]()
+	return withInterceptors[[]*TwoFactor](ctx, tfq, qr, tfq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tfq *TwoFactorQuery) AllX(ctx context.Context) []*TwoFactor {
+	nodes, err := tfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TwoFactor IDs.
+func (tfq *TwoFactorQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tfq.ctx.Unique == nil && tfq.path != nil {
+		tfq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tfq.ctx, ent.OpQueryIDs)
+	if err = tfq.Select(twofactor.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tfq *TwoFactorQuery) IDsX(ctx context.Context) []int {
+	ids, err := tfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tfq *TwoFactorQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tfq.ctx, ent.OpQueryCount)
+	if err := tfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tfq, querierCount[*TwoFactorQuery](), tfq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tfq *TwoFactorQuery) CountX(ctx context.Context) int {
+	count, err := tfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tfq *TwoFactorQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tfq.ctx, ent.OpQueryExist)
+	switch _, err := tfq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tfq *TwoFactorQuery) ExistX(ctx context.Context) bool {
+	exist, err := tfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TwoFactorQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tfq *TwoFactorQuery) Clone() *TwoFactorQuery {
+	if tfq == nil {
+		return nil
+	}
+	return &TwoFactorQuery{
+		config:     tfq.config,
+		ctx:        tfq.ctx.Clone(),
+		order:      append([]twofactor.OrderOption{}, tfq.order...),
+		inters:     append([]Interceptor{}, tfq.inters...),
+		predicates: append([]predicate.TwoFactor{}, tfq.predicates...),
+		// clone intermediate query.
+		sql:  tfq.sql.Clone(),
+		path: tfq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uint `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TwoFactor.Query().
+//		GroupBy(twofactor.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tfq *TwoFactorQuery) GroupBy(field string, fields ...string) *TwoFactorGroupBy {
+	tfq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TwoFactorGroupBy{build: tfq}
+	grbuild.flds = &tfq.ctx.Fields
+	grbuild.label = twofactor.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uint `json:"user_id,omitempty"`
+//	}
+//
+//	client.TwoFactor.Query().
+//		Select(twofactor.FieldUserID).
+//		Scan(ctx, &v)
+func (tfq *TwoFactorQuery) Select(fields ...string) *TwoFactorSelect {
+	tfq.ctx.Fields = append(tfq.ctx.Fields, fields...)
+	sbuild := &TwoFactorSelect{TwoFactorQuery: tfq}
+	sbuild.label = twofactor.Label
+	sbuild.flds, sbuild.scan = &tfq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TwoFactorSelect configured with the given aggregations.
+func (tfq *TwoFactorQuery) Aggregate(fns ...AggregateFunc) *TwoFactorSelect {
+	return tfq.Select().Aggregate(fns...)
+}
+
+func (tfq *TwoFactorQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tfq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tfq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tfq.ctx.Fields {
+		if !twofactor.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tfq.path != nil {
+		prev, err := tfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tfq.sql = prev
+	}
+	return nil
+}
+
+func (tfq *TwoFactorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TwoFactor, error) {
+	var (
+		nodes = []*TwoFactor{}
+		_spec = tfq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TwoFactor).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TwoFactor{config: tfq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (tfq *TwoFactorQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tfq.querySpec()
+	_spec.Node.Columns = tfq.ctx.Fields
+	if len(tfq.ctx.Fields) > 0 {
+		_spec.Unique = tfq.ctx.Unique != nil && *tfq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tfq.driver, _spec)
+}
+
+func (tfq *TwoFactorQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(twofactor.Table, twofactor.Columns, sqlgraph.NewFieldSpec(twofactor.FieldID, field.TypeInt))
+	_spec.From = tfq.sql
+	if unique := tfq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tfq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tfq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, twofactor.FieldID)
+		for i := range fields {
+			if fields[i] != twofactor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tfq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tfq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tfq *TwoFactorQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tfq.driver.Dialect())
+	t1 := builder.Table(twofactor.Table)
+	columns := tfq.ctx.Fields
+	if len(columns) == 0 {
+		columns = twofactor.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tfq.sql != nil {
+		selector = tfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tfq.ctx.Unique != nil && *tfq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tfq.predicates {
+		p(selector)
+	}
+	for _, p := range tfq.order {
+		p(selector)
+	}
+	if offset := tfq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tfq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TwoFactorGroupBy is the group-by builder for TwoFactor entities.
+type TwoFactorGroupBy struct {
+	selector
+	build *TwoFactorQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tfgb *TwoFactorGroupBy) Aggregate(fns ...AggregateFunc) *TwoFactorGroupBy {
+	tfgb.fns = append(tfgb.fns, fns...)
+	return tfgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tfgb *TwoFactorGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tfgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tfgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TwoFactorQuery, *TwoFactorGroupBy](ctx, tfgb.build, tfgb, tfgb.build.inters, v)
+}
+
+func (tfgb *TwoFactorGroupBy) sqlScan(ctx context.Context, root *TwoFactorQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tfgb.fns))
+	for _, fn := range tfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tfgb.flds)+len(tfgb.fns))
+		for _, f := range *tfgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tfgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tfgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TwoFactorSelect is the builder for selecting fields of TwoFactor entities.
+type TwoFactorSelect struct {
+	*TwoFactorQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tfs *TwoFactorSelect) Aggregate(fns ...AggregateFunc) *TwoFactorSelect {
+	tfs.fns = append(tfs.fns, fns...)
+	return tfs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tfs *TwoFactorSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tfs.ctx, ent.OpQuerySelect)
+	if err := tfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TwoFactorQuery, *TwoFactorSelect](ctx, tfs.TwoFactorQuery, tfs, tfs.inters, v)
+}
+
+func (tfs *TwoFactorSelect) sqlScan(ctx context.Context, root *TwoFactorQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tfs.fns))
+	for _, fn := range tfs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tfs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
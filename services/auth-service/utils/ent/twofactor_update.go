@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent/twofactor"
+)
+
+// TwoFactorUpdate is the builder for updating TwoFactor entities.
+type TwoFactorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TwoFactorMutation
+}
+
+// Where appends a list predicates to the TwoFactorUpdate builder.
+func (tfu *TwoFactorUpdate) Where(ps ...predicate.TwoFactor) *TwoFactorUpdate {
+	tfu.mutation.Where(ps...)
+	return tfu
+}
+
+// SetUserID sets the "user_id" field.
+func (tfu *TwoFactorUpdate) SetUserID(u uint) *TwoFactorUpdate {
+	tfu.mutation.ResetUserID()
+	tfu.mutation.SetUserID(u)
+	return tfu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tfu *TwoFactorUpdate) SetNillableUserID(u *uint) *TwoFactorUpdate {
+	if u != nil {
+		tfu.SetUserID(*u)
+	}
+	return tfu
+}
+
+// AddUserID adds u to the "user_id" field.
+func (tfu *TwoFactorUpdate) AddUserID(u int) *TwoFactorUpdate {
+	tfu.mutation.AddUserID(u)
+	return tfu
+}
+
+// SetEncryptedSecret sets the "encrypted_secret" field.
+func (tfu *TwoFactorUpdate) SetEncryptedSecret(s string) *TwoFactorUpdate {
+	tfu.mutation.SetEncryptedSecret(s)
+	return tfu
+}
+
+// SetNillableEncryptedSecret sets the "encrypted_secret" field if the given value is not nil.
+func (tfu *TwoFactorUpdate) SetNillableEncryptedSecret(s *string) *TwoFactorUpdate {
+	if s != nil {
+		tfu.SetEncryptedSecret(*s)
+	}
+	return tfu
+}
+
+// SetEnabled sets the "enabled" field.
+func (tfu *TwoFactorUpdate) SetEnabled(b bool) *TwoFactorUpdate {
+	tfu.mutation.SetEnabled(b)
+	return tfu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (tfu *TwoFactorUpdate) SetNillableEnabled(b *bool) *TwoFactorUpdate {
+	if b != nil {
+		tfu.SetEnabled(*b)
+	}
+	return tfu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tfu *TwoFactorUpdate) SetCreatedAt(t time.Time) *TwoFactorUpdate {
+	tfu.mutation.SetCreatedAt(t)
+	return tfu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tfu *TwoFactorUpdate) SetNillableCreatedAt(t *time.Time) *TwoFactorUpdate {
+	if t != nil {
+		tfu.SetCreatedAt(*t)
+	}
+	return tfu
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (tfu *TwoFactorUpdate) SetEnabledAt(t time.Time) *TwoFactorUpdate {
+	tfu.mutation.SetEnabledAt(t)
+	return tfu
+}
+
+// SetNillableEnabledAt sets the "enabled_at" field if the given value is not nil.
+func (tfu *TwoFactorUpdate) SetNillableEnabledAt(t *time.Time) *TwoFactorUpdate {
+	if t != nil {
+		tfu.SetEnabledAt(*t)
+	}
+	return tfu
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (tfu *TwoFactorUpdate) ClearEnabledAt() *TwoFactorUpdate {
+	tfu.mutation.ClearEnabledAt()
+	return tfu
+}
+
+// SetLastStep sets the "last_step" field.
+func (tfu *TwoFactorUpdate) SetLastStep(i int64) *TwoFactorUpdate {
+	tfu.mutation.ResetLastStep()
+	tfu.mutation.SetLastStep(i)
+	return tfu
+}
+
+// SetNillableLastStep sets the "last_step" field if the given value is not nil.
+func (tfu *TwoFactorUpdate) SetNillableLastStep(i *int64) *TwoFactorUpdate {
+	if i != nil {
+		tfu.SetLastStep(*i)
+	}
+	return tfu
+}
+
+// AddLastStep adds i to the "last_step" field.
+func (tfu *TwoFactorUpdate) AddLastStep(i int64) *TwoFactorUpdate {
+	tfu.mutation.AddLastStep(i)
+	return tfu
+}
+
+// Mutation returns the TwoFactorMutation object of the builder.
+func (tfu *TwoFactorUpdate) Mutation() *TwoFactorMutation {
+	return tfu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tfu *TwoFactorUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tfu.sqlSave, tfu.mutation, tfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tfu *TwoFactorUpdate) SaveX(ctx context.Context) int {
+	affected, err := tfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tfu *TwoFactorUpdate) Exec(ctx context.Context) error {
+	_, err := tfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tfu *TwoFactorUpdate) ExecX(ctx context.Context) {
+	if err := tfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tfu *TwoFactorUpdate) check() error {
+	if v, ok := tfu.mutation.UserID(); ok {
+		if err := twofactor.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "TwoFactor.user_id": %w`, err)}
+		}
+	}
+	if v, ok := tfu.mutation.EncryptedSecret(); ok {
+		if err := twofactor.EncryptedSecretValidator(v); err != nil {
+			return &ValidationError{Name: "encrypted_secret", err: fmt.Errorf(`ent: validator failed for field "TwoFactor.encrypted_secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tfu *TwoFactorUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tfu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(twofactor.Table, twofactor.Columns, sqlgraph.NewFieldSpec(twofactor.FieldID, field.TypeInt))
+	if ps := tfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tfu.mutation.UserID(); ok {
+		_spec.SetField(twofactor.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := tfu.mutation.AddedUserID(); ok {
+		_spec.AddField(twofactor.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := tfu.mutation.EncryptedSecret(); ok {
+		_spec.SetField(twofactor.FieldEncryptedSecret, field.TypeString, value)
+	}
+	if value, ok := tfu.mutation.Enabled(); ok {
+		_spec.SetField(twofactor.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := tfu.mutation.CreatedAt(); ok {
+		_spec.SetField(twofactor.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tfu.mutation.EnabledAt(); ok {
+		_spec.SetField(twofactor.FieldEnabledAt, field.TypeTime, value)
+	}
+	if tfu.mutation.EnabledAtCleared() {
+		_spec.ClearField(twofactor.FieldEnabledAt, field.TypeTime)
+	}
+	if value, ok := tfu.mutation.LastStep(); ok {
+		_spec.SetField(twofactor.FieldLastStep, field.TypeInt64, value)
+	}
+	if value, ok := tfu.mutation.AddedLastStep(); ok {
+		_spec.AddField(twofactor.FieldLastStep, field.TypeInt64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{twofactor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tfu.mutation.done = true
+	return n, nil
+}
+
+// TwoFactorUpdateOne is the builder for updating a single TwoFactor entity.
+type TwoFactorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TwoFactorMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (tfuo *TwoFactorUpdateOne) SetUserID(u uint) *TwoFactorUpdateOne {
+	tfuo.mutation.ResetUserID()
+	tfuo.mutation.SetUserID(u)
+	return tfuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tfuo *TwoFactorUpdateOne) SetNillableUserID(u *uint) *TwoFactorUpdateOne {
+	if u != nil {
+		tfuo.SetUserID(*u)
+	}
+	return tfuo
+}
+
+// AddUserID adds u to the "user_id" field.
+func (tfuo *TwoFactorUpdateOne) AddUserID(u int) *TwoFactorUpdateOne {
+	tfuo.mutation.AddUserID(u)
+	return tfuo
+}
+
+// SetEncryptedSecret sets the "encrypted_secret" field.
+func (tfuo *TwoFactorUpdateOne) SetEncryptedSecret(s string) *TwoFactorUpdateOne {
+	tfuo.mutation.SetEncryptedSecret(s)
+	return tfuo
+}
+
+// SetNillableEncryptedSecret sets the "encrypted_secret" field if the given value is not nil.
+func (tfuo *TwoFactorUpdateOne) SetNillableEncryptedSecret(s *string) *TwoFactorUpdateOne {
+	if s != nil {
+		tfuo.SetEncryptedSecret(*s)
+	}
+	return tfuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (tfuo *TwoFactorUpdateOne) SetEnabled(b bool) *TwoFactorUpdateOne {
+	tfuo.mutation.SetEnabled(b)
+	return tfuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (tfuo *TwoFactorUpdateOne) SetNillableEnabled(b *bool) *TwoFactorUpdateOne {
+	if b != nil {
+		tfuo.SetEnabled(*b)
+	}
+	return tfuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tfuo *TwoFactorUpdateOne) SetCreatedAt(t time.Time) *TwoFactorUpdateOne {
+	tfuo.mutation.SetCreatedAt(t)
+	return tfuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tfuo *TwoFactorUpdateOne) SetNillableCreatedAt(t *time.Time) *TwoFactorUpdateOne {
+	if t != nil {
+		tfuo.SetCreatedAt(*t)
+	}
+	return tfuo
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (tfuo *TwoFactorUpdateOne) SetEnabledAt(t time.Time) *TwoFactorUpdateOne {
+	tfuo.mutation.SetEnabledAt(t)
+	return tfuo
+}
+
+// SetNillableEnabledAt sets the "enabled_at" field if the given value is not nil.
+func (tfuo *TwoFactorUpdateOne) SetNillableEnabledAt(t *time.Time) *TwoFactorUpdateOne {
+	if t != nil {
+		tfuo.SetEnabledAt(*t)
+	}
+	return tfuo
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (tfuo *TwoFactorUpdateOne) ClearEnabledAt() *TwoFactorUpdateOne {
+	tfuo.mutation.ClearEnabledAt()
+	return tfuo
+}
+
+// SetLastStep sets the "last_step" field.
+func (tfuo *TwoFactorUpdateOne) SetLastStep(i int64) *TwoFactorUpdateOne {
+	tfuo.mutation.ResetLastStep()
+	tfuo.mutation.SetLastStep(i)
+	return tfuo
+}
+
+// SetNillableLastStep sets the "last_step" field if the given value is not nil.
+func (tfuo *TwoFactorUpdateOne) SetNillableLastStep(i *int64) *TwoFactorUpdateOne {
+	if i != nil {
+		tfuo.SetLastStep(*i)
+	}
+	return tfuo
+}
+
+// AddLastStep adds i to the "last_step" field.
+func (tfuo *TwoFactorUpdateOne) AddLastStep(i int64) *TwoFactorUpdateOne {
+	tfuo.mutation.AddLastStep(i)
+	return tfuo
+}
+
+// Mutation returns the TwoFactorMutation object of the builder.
+func (tfuo *TwoFactorUpdateOne) Mutation() *TwoFactorMutation {
+	return tfuo.mutation
+}
+
+// Where appends a list predicates to the TwoFactorUpdate builder.
+func (tfuo *TwoFactorUpdateOne) Where(ps ...predicate.TwoFactor) *TwoFactorUpdateOne {
+	tfuo.mutation.Where(ps...)
+	return tfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tfuo *TwoFactorUpdateOne) Select(field string, fields ...string) *TwoFactorUpdateOne {
+	tfuo.fields = append([]string{field}, fields...)
+	return tfuo
+}
+
+// Save executes the query and returns the updated TwoFactor entity.
+func (tfuo *TwoFactorUpdateOne) Save(ctx context.Context) (*TwoFactor, error) {
+	return withHooks(ctx, tfuo.sqlSave, tfuo.mutation, tfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tfuo *TwoFactorUpdateOne) SaveX(ctx context.Context) *TwoFactor {
+	node, err := tfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tfuo *TwoFactorUpdateOne) Exec(ctx context.Context) error {
+	_, err := tfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tfuo *TwoFactorUpdateOne) ExecX(ctx context.Context) {
+	if err := tfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tfuo *TwoFactorUpdateOne) check() error {
+	if v, ok := tfuo.mutation.UserID(); ok {
+		if err := twofactor.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "TwoFactor.user_id": %w`, err)}
+		}
+	}
+	if v, ok := tfuo.mutation.EncryptedSecret(); ok {
+		if err := twofactor.EncryptedSecretValidator(v); err != nil {
+			return &ValidationError{Name: "encrypted_secret", err: fmt.Errorf(`ent: validator failed for field "TwoFactor.encrypted_secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tfuo *TwoFactorUpdateOne) sqlSave(ctx context.Context) (_node *TwoFactor, err error) {
+	if err := tfuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(twofactor.Table, twofactor.Columns, sqlgraph.NewFieldSpec(twofactor.FieldID, field.TypeInt))
+	id, ok := tfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TwoFactor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, twofactor.FieldID)
+		for _, f := range fields {
+			if !twofactor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != twofactor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tfuo.mutation.UserID(); ok {
+		_spec.SetField(twofactor.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := tfuo.mutation.AddedUserID(); ok {
+		_spec.AddField(twofactor.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := tfuo.mutation.EncryptedSecret(); ok {
+		_spec.SetField(twofactor.FieldEncryptedSecret, field.TypeString, value)
+	}
+	if value, ok := tfuo.mutation.Enabled(); ok {
+		_spec.SetField(twofactor.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := tfuo.mutation.CreatedAt(); ok {
+		_spec.SetField(twofactor.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tfuo.mutation.EnabledAt(); ok {
+		_spec.SetField(twofactor.FieldEnabledAt, field.TypeTime, value)
+	}
+	if tfuo.mutation.EnabledAtCleared() {
+		_spec.ClearField(twofactor.FieldEnabledAt, field.TypeTime)
+	}
+	if value, ok := tfuo.mutation.LastStep(); ok {
+		_spec.SetField(twofactor.FieldLastStep, field.TypeInt64, value)
+	}
+	if value, ok := tfuo.mutation.AddedLastStep(); ok {
+		_spec.AddField(twofactor.FieldLastStep, field.TypeInt64, value)
+	}
+	_node = &TwoFactor{config: tfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{twofactor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tfuo.mutation.done = true
+	return _node, nil
+}
@@ -3,12 +3,13 @@ package errors
 import "errors"
 
 var (
-	ErrorBadRequest   = New("Bad Request")
-	ErrorUnauthorized = New("Unauthorized")
-	ErrorNotFound     = New("Not Found")
-	ErrorInternal     = New("Internal Server Error")
-	ErrorConflict     = New("Conflict")
-	ErrorForbidden    = New("Forbidden")
+	ErrorBadRequest      = New("Bad Request")
+	ErrorUnauthorized    = New("Unauthorized")
+	ErrorNotFound        = New("Not Found")
+	ErrorInternal        = New("Internal Server Error")
+	ErrorConflict        = New("Conflict")
+	ErrorForbidden       = New("Forbidden")
+	ErrorTooManyRequests = New("Too Many Requests")
 )
 
 type Error struct {
@@ -38,3 +39,9 @@ func (e Error) Error() string {
 func New(err string) error {
 	return errors.New(err)
 }
+
+// IsSvcError reports whether err is an Error of the given service error kind.
+func IsSvcError(err error, svcError error) bool {
+	var e Error
+	return errors.As(err, &e) && e.svcError == svcError
+}
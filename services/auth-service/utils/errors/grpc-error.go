@@ -37,6 +37,9 @@ func GRPCFromError(err error) GRPCError {
 		case ErrorForbidden:
 			grpcError.Code = codes.PermissionDenied
 			grpcError.Status = http.StatusForbidden
+		case ErrorTooManyRequests:
+			grpcError.Code = codes.ResourceExhausted
+			grpcError.Status = http.StatusTooManyRequests
 		case ErrorNotFound:
 			grpcError.Code = codes.NotFound
 			grpcError.Status = http.StatusNotFound
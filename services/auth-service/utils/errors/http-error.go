@@ -24,6 +24,8 @@ func HTTPFromError(err error) APIError {
 			apiError.Status = http.StatusUnauthorized
 		case ErrorForbidden:
 			apiError.Status = http.StatusForbidden
+		case ErrorTooManyRequests:
+			apiError.Status = http.StatusTooManyRequests
 		case ErrorNotFound:
 			apiError.Status = http.StatusNotFound
 		case ErrorConflict:
@@ -0,0 +1,64 @@
+package errors
+
+import (
+	"errors"
+	"net/http"
+)
+
+// The error codes of the OAuth 2.0 endpoints (RFC 6749, section 5.2, and RFC 7009).
+const (
+	OAuthInvalidRequest          = "invalid_request"
+	OAuthInvalidClient           = "invalid_client"
+	OAuthInvalidGrant            = "invalid_grant"
+	OAuthInvalidScope            = "invalid_scope"
+	OAuthUnauthorizedClient      = "unauthorized_client"
+	OAuthUnsupportedGrantType    = "unsupported_grant_type"
+	OAuthUnsupportedResponseType = "unsupported_response_type"
+	OAuthConsentRequired         = "consent_required"
+	OAuthServerError             = "server_error"
+)
+
+// OAuthError is the error response of the OAuth 2.0 endpoints.
+type OAuthError struct {
+	Status      int    `json:"-"`
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+// oauthCodeError carries the OAuth error code an application error is reported with.
+type oauthCodeError struct {
+	code string
+	err  error
+}
+
+func (e oauthCodeError) Error() string {
+	return e.err.Error()
+}
+
+// NewOAuthError is NewError for the errors the OAuth endpoints report with code.
+func NewOAuthError(svcError error, code string, appError error) Error {
+	return NewError(svcError, oauthCodeError{code: code, err: appError})
+}
+
+// OAuthFromError maps an error to the error response of the OAuth endpoints. Errors without
+// an OAuth error code are reported as invalid_request, or server_error when internal.
+func OAuthFromError(err error) OAuthError {
+	httpError := HTTPFromError(err)
+	oauthError := OAuthError{
+		Status:      httpError.Status,
+		Code:        OAuthInvalidRequest,
+		Description: httpError.Message,
+	}
+
+	var svcError Error
+	var codeError oauthCodeError
+	switch {
+	case errors.As(err, &svcError) && errors.As(svcError.AppError(), &codeError):
+		oauthError.Code = codeError.code
+	case httpError.Status == 0 || httpError.Status == http.StatusInternalServerError:
+		oauthError.Status = http.StatusInternalServerError
+		oauthError.Code = OAuthServerError
+		oauthError.Description = ""
+	}
+	return oauthError
+}
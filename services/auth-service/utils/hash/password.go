@@ -26,3 +26,8 @@ func ComparePassword(hashedPassword, password string, logger *logger.Logger) (bo
 	}
 	return true, nil
 }
+
+// MatchesHash reports whether value matches the hash without logging a mismatch, to look a value up among several hashes.
+func MatchesHash(hashed, value string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hashed), []byte(value)) == nil
+}
@@ -0,0 +1,193 @@
+// Package health serves the liveness and readiness endpoints of the Fiber app and the
+// grpc.health.v1 Health service of the gRPC server.
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/grpc/repository/user"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/ent"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
+	"github.com/go-redis/redis/v8"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewChecker),
+)
+
+// Check reports an error when a dependency of the service cannot be used.
+type Check struct {
+	Name string
+	// Local checks cover the dependencies of this process only. They alone decide the
+	// gRPC health status, which the readiness checks of the other services call, so
+	// two services that call each other never wait on one another to become ready.
+	Local bool
+	Run   func(ctx context.Context) error
+}
+
+// Response is the body of the readiness endpoint, with "ok" or the error of every check.
+type Response struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Checker runs the checks behind the readiness endpoint and keeps the gRPC health status
+// up to date with the local ones.
+type Checker struct {
+	checks  []Check
+	server  *health.Server
+	serving bool
+	logger  *logger.Logger
+	config  *configs.Config
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewChecker checks Postgres through the Ent client, Redis, and UsersService through
+// its gRPC health service.
+func NewChecker(client *ent.Client, redisClient *redis.Client, userClient user.IClient, logger *logger.Logger, config *configs.Config) *Checker {
+	server := health.NewServer()
+	// Not serving until the first checks have passed
+	server.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	return &Checker{
+		checks: []Check{
+			{Name: "postgres", Local: true, Run: func(ctx context.Context) error {
+				_, err := client.ExecContext(ctx, "SELECT 1")
+				return err
+			}},
+			{Name: "redis", Local: true, Run: func(ctx context.Context) error {
+				return redisClient.Ping(ctx).Err()
+			}},
+			{Name: "user-service", Run: userClient.CheckHealth},
+		},
+		server: server,
+		logger: logger,
+		config: config,
+		done:   make(chan struct{}),
+	}
+}
+
+// Server returns the grpc.health.v1 Health service to register on the gRPC server.
+func (c *Checker) Server() grpc_health_v1.HealthServer {
+	return c.server
+}
+
+// Liveness answers as long as the process can serve requests. It does not check the
+// dependencies, so an outage of one of them does not get the service restarted.
+func (c *Checker) Liveness(ctx *fiber.Ctx) error {
+	return ctx.JSON(Response{Status: "ok"})
+}
+
+// Readiness runs every check and answers 503 Service Unavailable when one of them fails.
+func (c *Checker) Readiness(ctx *fiber.Ctx) error {
+	results, ok := c.run(ctx.UserContext(), false)
+	if !ok {
+		return ctx.Status(fiber.StatusServiceUnavailable).JSON(Response{Status: "unavailable", Checks: results})
+	}
+	return ctx.JSON(Response{Status: "ok", Checks: results})
+}
+
+func (c *Checker) SetupChecker(lc fx.Lifecycle) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			c.logger.Info(fmt.Sprintf("Starting health checks (interval: %s)", c.config.Health.Interval))
+
+			runCtx, cancel := context.WithCancel(context.Background())
+			c.cancel = cancel
+			go c.watch(runCtx)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			c.logger.Info("Stopping health checks")
+			c.cancel()
+
+			select {
+			case <-c.done:
+			case <-ctx.Done():
+			}
+			// Tell the clients watching the status that the server is going away
+			c.server.Shutdown()
+			return nil
+		},
+	})
+}
+
+// watch updates the gRPC health status with the local checks until ctx is canceled.
+func (c *Checker) watch(ctx context.Context) {
+	defer close(c.done)
+
+	ticker := time.NewTicker(c.config.Health.Interval)
+	defer ticker.Stop()
+
+	for {
+		c.update(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Checker) update(ctx context.Context) {
+	_, ok := c.run(ctx, true)
+	if ctx.Err() != nil || ok == c.serving {
+		return
+	}
+	c.serving = ok
+
+	if ok {
+		c.logger.Info("Dependencies are up, serving")
+		c.server.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	} else {
+		c.logger.Warn("A dependency is down, not serving")
+		c.server.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+// run runs the checks concurrently, each within the configured timeout, and reports
+// whether all of them passed.
+func (c *Checker) run(ctx context.Context, localOnly bool) (map[string]string, bool) {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]string, len(c.checks))
+		ok      = true
+	)
+	for _, check := range c.checks {
+		if localOnly && !check.Local {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, c.config.Health.Timeout)
+			defer cancel()
+			err := check.Run(checkCtx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				c.logger.Ctx(ctx).Warn("health check failed", zap.String("check", check.Name), zap.Error(err))
+				results[check.Name] = err.Error()
+				ok = false
+				return
+			}
+			results[check.Name] = "ok"
+		}()
+	}
+	wg.Wait()
+	return results, ok
+}
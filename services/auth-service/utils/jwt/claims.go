@@ -6,24 +6,39 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// The types of the tokens, in their typ claim. Only access tokens are accepted as bearer
+// tokens; refresh tokens are only looked up in the token store.
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
 type UserClaims struct {
+	// Type is TokenTypeAccess or TokenTypeRefresh
+	Type      string `json:"typ"`
 	ID        uint
 	SessionID string
 	Username  string
 	Email     string
 	Role      string
 	Duration  time.Duration
+	// ClientID and Scope are only set on the tokens of OAuth clients
+	ClientID string `json:",omitempty"`
+	Scope    string `json:",omitempty"`
 	jwt.RegisteredClaims
 }
 
 func NewUserClaims(claim UserClaims) (UserClaims, error) {
 	return UserClaims{
+		Type:      claim.Type,
 		ID:        claim.ID,
 		SessionID: claim.SessionID,
 		Username:  claim.Username,
 		Email:     claim.Email,
 		Role:      claim.Role,
 		Duration:  claim.Duration,
+		ClientID:  claim.ClientID,
+		Scope:     claim.Scope,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        claim.SessionID,
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -2,19 +2,31 @@ package jwt
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
-// CreateToken creates a new JWT token.
-func CreateToken(secretKey string, claim UserClaims) (string, error) {
+// CreateToken creates a new JWT token, signed with the key that is active now.
+func (k *KeySet) CreateToken(claim UserClaims) (string, error) {
 	claims, err := NewUserClaims(claim)
 	if err != nil {
 		return "", err
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenStr, err := token.SignedString([]byte(secretKey))
+	// Without keys the tokens are signed with the shared secret
+	if len(k.keys) == 0 {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString(k.secret)
+	}
+
+	key, err := k.signingKey(time.Now())
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.id
+	tokenStr, err := token.SignedString(key.private)
 	if err != nil {
 		return "", err
 	}
@@ -22,14 +34,26 @@ func CreateToken(secretKey string, claim UserClaims) (string, error) {
 	return tokenStr, nil
 }
 
-// VerifyToken verifies the JWT token.
-func VerifyToken(tokenStr, secretKey string) (UserClaims, error) {
+// VerifyToken verifies the JWT token with the key named by its kid header, which must
+// not have been retired. Only access tokens are accepted.
+func (k *KeySet) VerifyToken(tokenStr string) (UserClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenStr, &UserClaims{}, func(token *jwt.Token) (interface{}, error) {
-		_, ok := token.Method.(*jwt.SigningMethodHMAC)
+		if len(k.keys) == 0 {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+			return k.secret, nil
+		}
+
+		kid, _ := token.Header["kid"].(string)
+		key, ok := k.verificationKey(kid, time.Now())
 		if !ok {
+			return nil, fmt.Errorf("unknown or retired signing key %q", kid)
+		}
+		if token.Method != key.method {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return []byte(secretKey), nil
+		return key.public, nil
 	})
 	if err != nil {
 		return UserClaims{}, fmt.Errorf("error parsing token: %w", err)
@@ -39,6 +63,9 @@ func VerifyToken(tokenStr, secretKey string) (UserClaims, error) {
 	if !ok {
 		return UserClaims{}, fmt.Errorf("invalid token claims")
 	}
+	if claims.Type != TokenTypeAccess {
+		return UserClaims{}, fmt.Errorf("token is not an access token")
+	}
 
 	return *claims, nil
 }
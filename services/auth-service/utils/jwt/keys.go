@@ -0,0 +1,189 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewKeySet),
+)
+
+// KeySet holds the keys tokens are signed and verified with. Every key signs the tokens
+// from the time it becomes active until the next key does, and the tokens it signed
+// are accepted for the rotation overlap after that. The public keys are published
+// before they become active, so verifiers caching them know a key before its first token.
+type KeySet struct {
+	keys    []signingKey // ordered by activeFrom
+	overlap time.Duration
+	// secret signs HS256 tokens when no keys are configured
+	secret []byte
+}
+
+type signingKey struct {
+	id         string
+	activeFrom time.Time
+	method     jwt.SigningMethod
+	private    crypto.Signer
+	public     crypto.PublicKey
+}
+
+// NewKeySet loads the signing keys from their files. RSA keys sign RS256 tokens and
+// Ed25519 keys EdDSA tokens.
+func NewKeySet(config *configs.Config, logger *logger.Logger) (*KeySet, error) {
+	keySet := &KeySet{
+		overlap: config.JWT.RotationOverlap,
+		secret:  []byte(config.JWT.SecretKey),
+	}
+	if len(config.JWT.Keys) == 0 {
+		logger.Warn("No JWT keys are configured, signing HS256 tokens with the secret key")
+		return keySet, nil
+	}
+
+	for _, keyConfig := range config.JWT.Keys {
+		key, err := loadKey(keyConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load JWT key %s: %w", keyConfig.ID, err)
+		}
+		keySet.keys = append(keySet.keys, key)
+	}
+	sort.Slice(keySet.keys, func(i, j int) bool {
+		return keySet.keys[i].activeFrom.Before(keySet.keys[j].activeFrom)
+	})
+
+	key, err := keySet.signingKey(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	logger.Info(fmt.Sprintf("Signing %s tokens with JWT key %s", key.method.Alg(), key.id))
+	return keySet, nil
+}
+
+// signingKey returns the key that is active at t.
+func (k *KeySet) signingKey(t time.Time) (signingKey, error) {
+	for i := len(k.keys) - 1; i >= 0; i-- {
+		if !k.keys[i].activeFrom.After(t) {
+			return k.keys[i], nil
+		}
+	}
+	return signingKey{}, fmt.Errorf("no JWT key is active yet")
+}
+
+// verificationKey returns the key named kid if it has become active and was not retired at t.
+func (k *KeySet) verificationKey(kid string, t time.Time) (signingKey, bool) {
+	for i, key := range k.keys {
+		if key.id == kid {
+			return key, !key.activeFrom.After(t) && !k.retired(i, t)
+		}
+	}
+	return signingKey{}, false
+}
+
+// retired reports whether the key at index i was replaced for longer than the rotation overlap at t.
+func (k *KeySet) retired(i int, t time.Time) bool {
+	return i+1 < len(k.keys) && !k.keys[i+1].activeFrom.Add(k.overlap).After(t)
+}
+
+// JWK is a public key in the JSON Web Key format of RFC 7517.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA keys
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Ed25519 keys
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public keys that are not retired, including the ones that become active later.
+func (k *KeySet) JWKS() JWKS {
+	jwks := JWKS{Keys: []JWK{}}
+	t := time.Now()
+	for i, key := range k.keys {
+		if k.retired(i, t) {
+			continue
+		}
+
+		jwk := JWK{Kid: key.id, Use: "sig", Alg: key.method.Alg()}
+		switch public := key.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+	return jwks
+}
+
+func loadKey(keyConfig configs.JWTKeyConfig) (signingKey, error) {
+	key := signingKey{id: keyConfig.ID}
+	if keyConfig.ActiveFrom != "" {
+		activeFrom, err := time.Parse(time.RFC3339, keyConfig.ActiveFrom)
+		if err != nil {
+			return signingKey{}, fmt.Errorf("invalid active_from: %w", err)
+		}
+		key.activeFrom = activeFrom
+	}
+
+	data, err := os.ReadFile(keyConfig.PrivateKeyFile)
+	if err != nil {
+		return signingKey{}, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return signingKey{}, fmt.Errorf("%s holds no PEM data", keyConfig.PrivateKeyFile)
+	}
+
+	var private any
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return signingKey{}, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return signingKey{}, err
+	}
+
+	switch private := private.(type) {
+	case *rsa.PrivateKey:
+		key.method = jwt.SigningMethodRS256
+		key.private = private
+	case ed25519.PrivateKey:
+		key.method = jwt.SigningMethodEdDSA
+		key.private = private
+	default:
+		return signingKey{}, fmt.Errorf("unsupported key type %T, use an RSA or Ed25519 key", private)
+	}
+	key.public = key.private.Public()
+	return key, nil
+}
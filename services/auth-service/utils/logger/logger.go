@@ -1,6 +1,9 @@
 package logger
 
 import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -12,14 +15,55 @@ var Module = fx.Options(
 
 type Logger struct {
 	*zap.Logger
+	level zap.AtomicLevel
 }
 
+// NewLogger builds a JSON logger at the info level until the configuration sets the level.
 func NewLogger() (*Logger, error) {
-	config := zap.NewDevelopmentConfig()
-	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	config := zap.NewProductionConfig()
+	config.Sampling = nil // every line of a request is needed to follow it
+	config.EncoderConfig.TimeKey = "time"
+	config.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
 	logger, err := config.Build()
 	if err != nil {
 		return nil, err
 	}
-	return &Logger{logger}, nil
-}
\ No newline at end of file
+	return &Logger{Logger: logger, level: config.Level}, nil
+}
+
+// SetLevel changes the minimum level of the logger and of the loggers derived from it.
+func (l *Logger) SetLevel(level string) error {
+	parsed, err := zapcore.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	l.level.SetLevel(parsed)
+	return nil
+}
+
+// Ctx returns a logger that adds the request ID, user ID and route of the request
+// ctx belongs to, and the trace and span IDs when it is traced, to every line.
+func (l *Logger) Ctx(ctx context.Context) *Logger {
+	var fields []zap.Field
+	if r, ok := ctx.Value(requestKey{}).(*request); ok {
+		r.mu.Lock()
+		fields = append(fields, zap.String("request_id", r.id))
+		if r.userID != "" {
+			fields = append(fields, zap.String("user_id", r.userID))
+		}
+		if route := r.routeLocked(); route != "" {
+			fields = append(fields, zap.String("route", route))
+		}
+		r.mu.Unlock()
+	}
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		fields = append(fields,
+			zap.String("trace_id", span.TraceID().String()),
+			zap.String("span_id", span.SpanID().String()),
+		)
+	}
+	if len(fields) == 0 {
+		return l
+	}
+	return &Logger{Logger: l.With(fields...), level: l.level}
+}
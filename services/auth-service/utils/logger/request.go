@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"context"
+	"sync"
+)
+
+type requestKey struct{}
+
+// request holds the fields of a request that are added to its log lines. The user
+// is only known once the token has been verified, so the fields are set in place.
+type request struct {
+	mu     sync.Mutex
+	id     string
+	userID string
+	route  string
+	// resolve returns the route while it is still being matched; see WithRequest.
+	resolve func() string
+}
+
+// WithRequest returns a context carrying the request ID and route of a request.
+// When the route is not known yet, resolve is called to get it while the request is
+// handled and FinishRequest must be called before resolve becomes invalid.
+func WithRequest(ctx context.Context, id, route string, resolve func() string) context.Context {
+	return context.WithValue(ctx, requestKey{}, &request{id: id, route: route, resolve: resolve})
+}
+
+// FinishRequest fixes the route of the request, so that goroutines that outlive
+// the request no longer call the resolver passed to WithRequest.
+func FinishRequest(ctx context.Context) {
+	if r, ok := ctx.Value(requestKey{}).(*request); ok {
+		r.mu.Lock()
+		r.route = r.routeLocked()
+		r.resolve = nil
+		r.mu.Unlock()
+	}
+}
+
+// SetUserID records the user a request was made by.
+func SetUserID(ctx context.Context, userID string) {
+	if r, ok := ctx.Value(requestKey{}).(*request); ok {
+		r.mu.Lock()
+		r.userID = userID
+		r.mu.Unlock()
+	}
+}
+
+// RequestID returns the ID of the request ctx belongs to, or an empty string.
+func RequestID(ctx context.Context) string {
+	if r, ok := ctx.Value(requestKey{}).(*request); ok {
+		return r.id
+	}
+	return ""
+}
+
+func (r *request) routeLocked() string {
+	if r.resolve != nil {
+		return r.resolve()
+	}
+	return r.route
+}
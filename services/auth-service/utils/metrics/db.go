@@ -0,0 +1,135 @@
+package metrics
+
+import (
+	"context"
+	stdsql "database/sql"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	dbDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_query_duration_seconds",
+		Help:    "Time taken by database statements, by operation (select, insert, update, delete, begin, commit, rollback or other).",
+		Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation"})
+
+	dbErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "db_query_errors_total",
+		Help: "Database statements that failed, by operation.",
+	}, []string{"operation"})
+)
+
+// InstrumentDriver times the statements and transactions that go through the
+// ent driver and exports the connection pool statistics of the database.
+func InstrumentDriver(drv *sql.Driver, dbName string) dialect.Driver {
+	registerCollector(collectors.NewDBStatsCollector(drv.DB(), dbName))
+	return &driver{Driver: drv, sql: drv}
+}
+
+type driver struct {
+	dialect.Driver
+	sql *sql.Driver
+}
+
+func (d *driver) Exec(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := d.Driver.Exec(ctx, query, args, v)
+	observe(operation(query), start, err)
+	return err
+}
+
+func (d *driver) Query(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := d.Driver.Query(ctx, query, args, v)
+	observe(operation(query), start, err)
+	return err
+}
+
+// ExecContext is used by the ent client for raw statements, such as the health check.
+func (d *driver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	start := time.Now()
+	res, err := d.sql.ExecContext(ctx, query, args...)
+	observe(operation(query), start, err)
+	return res, err
+}
+
+func (d *driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+// BeginTx is used by ent for transactions with options.
+func (d *driver) BeginTx(ctx context.Context, opts *stdsql.TxOptions) (dialect.Tx, error) {
+	start := time.Now()
+	t, err := d.sql.BeginTx(ctx, opts)
+	observe("begin", start, err)
+	if err != nil {
+		return nil, err
+	}
+	return &tx{Tx: t}, nil
+}
+
+type tx struct {
+	dialect.Tx
+}
+
+func (t *tx) Exec(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := t.Tx.Exec(ctx, query, args, v)
+	observe(operation(query), start, err)
+	return err
+}
+
+func (t *tx) Query(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := t.Tx.Query(ctx, query, args, v)
+	observe(operation(query), start, err)
+	return err
+}
+
+func (t *tx) Commit() error {
+	start := time.Now()
+	err := t.Tx.Commit()
+	observe("commit", start, err)
+	return err
+}
+
+func (t *tx) Rollback() error {
+	start := time.Now()
+	err := t.Tx.Rollback()
+	observe("rollback", start, err)
+	return err
+}
+
+func observe(op string, start time.Time, err error) {
+	dbDuration.WithLabelValues(op).Observe(time.Since(start).Seconds())
+	if err != nil {
+		dbErrors.WithLabelValues(op).Inc()
+	}
+}
+
+// operation returns the kind of statement from its first keyword.
+func operation(query string) string {
+	keyword, _, _ := strings.Cut(strings.TrimSpace(query), " ")
+	switch keyword = strings.ToLower(keyword); keyword {
+	case "select", "insert", "update", "delete":
+		return keyword
+	}
+	return "other"
+}
+
+// registerCollector registers c unless an identical collector already is, which
+// happens when a constructor runs more than once in the same process.
+func registerCollector(c prometheus.Collector) {
+	if err := prometheus.Register(c); err != nil {
+		if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+			panic(err)
+		}
+	}
+}
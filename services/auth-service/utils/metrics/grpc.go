@@ -0,0 +1,81 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcServerHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "RPCs completed by the server, by service, method and status code.",
+	}, []string{"grpc_service", "grpc_method", "grpc_code"})
+
+	grpcServerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Time taken by the server to complete RPCs, by service and method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"grpc_service", "grpc_method"})
+
+	grpcClientHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_handled_total",
+		Help: "RPCs completed by the clients, by service, method and status code.",
+	}, []string{"grpc_service", "grpc_method", "grpc_code"})
+
+	grpcClientDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_client_handling_seconds",
+		Help:    "Time taken by the clients to complete RPCs, by service and method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"grpc_service", "grpc_method"})
+)
+
+// UnaryServerInterceptor records the count and latency of unary RPCs.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		res, err := handler(ctx, req)
+		observeRPC(grpcServerHandled, grpcServerDuration, info.FullMethod, start, err)
+		return res, err
+	}
+}
+
+// StreamServerInterceptor records the count and duration of streaming RPCs.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, stream)
+		observeRPC(grpcServerHandled, grpcServerDuration, info.FullMethod, start, err)
+		return err
+	}
+}
+
+// UnaryClientInterceptor records the count and latency of the unary RPCs a client makes.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		observeRPC(grpcClientHandled, grpcClientDuration, method, start, err)
+		return err
+	}
+}
+
+func observeRPC(handled *prometheus.CounterVec, duration *prometheus.HistogramVec, fullMethod string, start time.Time, err error) {
+	service, method := splitMethod(fullMethod)
+	handled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	duration.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+}
+
+// splitMethod splits "/package.Service/Method" into its service and method.
+func splitMethod(fullMethod string) (string, string) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return "unknown", "unknown"
+	}
+	return service, method
+}
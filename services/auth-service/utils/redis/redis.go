@@ -6,6 +6,8 @@ import (
 
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/configs"
 	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/metrics"
+	"github.com/Ali-Gorgani/chat-room-project/services/auth-service/utils/tracing"
 	"github.com/go-redis/redis/v8"
 	"go.uber.org/fx"
 )
@@ -33,6 +35,8 @@ func NewRedisClient(lc fx.Lifecycle, config *configs.Config, logger *logger.Logg
 		Password: cfg.Password,
 		DB:       cfg.DB,
 	})
+	client.AddHook(metrics.RedisHook{})
+	client.AddHook(tracing.RedisHook{})
 
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
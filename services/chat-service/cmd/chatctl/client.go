@@ -0,0 +1,310 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// refreshBefore is how long before it expires an access token is refreshed.
+const refreshBefore = 30 * time.Second
+
+var errNotLoggedIn = errors.New("not logged in, run chatctl login")
+
+// credentials are the tokens of the logged in user, stored in the user config directory.
+type credentials struct {
+	UserID                string    `json:"user_id"`
+	Username              string    `json:"username"`
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
+
+type client struct {
+	authURL   string
+	chatURL   string
+	tlsConfig *tls.Config
+	http      *http.Client
+	path      string
+	creds     *credentials
+}
+
+func newClient(authURL, chatURL string, insecure bool) (*client, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: insecure}
+	c := &client{
+		authURL:   authURL,
+		chatURL:   chatURL,
+		tlsConfig: tlsConfig,
+		http: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		path: filepath.Join(dir, "chatctl", "credentials.json"),
+	}
+
+	data, err := os.ReadFile(c.path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		var creds credentials
+		if err := json.Unmarshal(data, &creds); err != nil {
+			return nil, fmt.Errorf("reading %s: %w", c.path, err)
+		}
+		c.creds = &creds
+	}
+
+	return c, nil
+}
+
+// login exchanges a username and a password for tokens and stores them.
+func (c *client) login(ctx context.Context, username, password string) error {
+	var res struct {
+		AccessToken           string    `json:"access_token"`
+		AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+		RefreshToken          string    `json:"refresh_token"`
+		RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+		User                  struct {
+			ID       uint   `json:"id"`
+			Username string `json:"username"`
+		} `json:"user"`
+	}
+	body := map[string]string{"username": username, "password": password}
+	if err := c.request(ctx, http.MethodPost, c.authURL+"/login", "", body, &res); err != nil {
+		return err
+	}
+
+	return c.save(&credentials{
+		UserID:                strconv.FormatUint(uint64(res.User.ID), 10),
+		Username:              res.User.Username,
+		AccessToken:           res.AccessToken,
+		AccessTokenExpiresAt:  res.AccessTokenExpiresAt,
+		RefreshToken:          res.RefreshToken,
+		RefreshTokenExpiresAt: res.RefreshTokenExpiresAt,
+	})
+}
+
+// logout revokes the tokens on a best effort basis and forgets them.
+func (c *client) logout(ctx context.Context) error {
+	if c.creds == nil {
+		return errNotLoggedIn
+	}
+
+	if token, err := c.token(ctx); err == nil {
+		if err := c.request(ctx, http.MethodPost, c.authURL+"/logout", token, nil, nil); err != nil {
+			fmt.Fprintf(os.Stderr, "chatctl: logging out: %v\n", err)
+		}
+	}
+
+	c.creds = nil
+	if err := os.Remove(c.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// token returns an access token, refreshing it first when it is about to expire.
+func (c *client) token(ctx context.Context) (string, error) {
+	if c.creds == nil {
+		return "", errNotLoggedIn
+	}
+	if time.Until(c.creds.AccessTokenExpiresAt) < refreshBefore {
+		if err := c.refresh(ctx); err != nil {
+			return "", err
+		}
+	}
+	return c.creds.AccessToken, nil
+}
+
+func (c *client) refresh(ctx context.Context) error {
+	if c.creds == nil {
+		return errNotLoggedIn
+	}
+	if !c.creds.RefreshTokenExpiresAt.IsZero() && time.Now().After(c.creds.RefreshTokenExpiresAt) {
+		return fmt.Errorf("session expired, run chatctl login")
+	}
+
+	var res struct {
+		AccessToken           string    `json:"access_token"`
+		AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+		RefreshToken          string    `json:"refresh_token"`
+		RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+	}
+	body := map[string]string{"refresh_token": c.creds.RefreshToken}
+	if err := c.request(ctx, http.MethodPost, c.authURL+"/refresh-token", "", body, &res); err != nil {
+		return fmt.Errorf("refreshing token: %w", err)
+	}
+
+	creds := *c.creds
+	creds.AccessToken = res.AccessToken
+	creds.AccessTokenExpiresAt = res.AccessTokenExpiresAt
+	// The refresh token is rotated on every refresh and the old one is no longer valid
+	creds.RefreshToken = res.RefreshToken
+	creds.RefreshTokenExpiresAt = res.RefreshTokenExpiresAt
+	return c.save(&creds)
+}
+
+func (c *client) save(creds *credentials) error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(creds, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(c.path, data, 0o600); err != nil {
+		return err
+	}
+	c.creds = creds
+	return nil
+}
+
+// call sends an authenticated request to chat-service. A request rejected as
+// unauthorized is retried once with a refreshed token.
+func (c *client) call(ctx context.Context, method, path string, body, out any) error {
+	token, err := c.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = c.request(ctx, method, c.chatURL+path, token, body, out)
+	var apiErr *apiError
+	if errors.As(err, &apiErr) && apiErr.status == http.StatusUnauthorized {
+		if err := c.refresh(ctx); err != nil {
+			return err
+		}
+		return c.request(ctx, method, c.chatURL+path, c.creds.AccessToken, body, out)
+	}
+	return err
+}
+
+// apiError is an error response of one of the services.
+type apiError struct {
+	status  int
+	message string
+}
+
+func (e *apiError) Error() string {
+	if e.message == "" {
+		return http.StatusText(e.status)
+	}
+	return e.message
+}
+
+func (c *client) request(ctx context.Context, method, url, token string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode >= http.StatusBadRequest {
+		var errRes struct {
+			Message string `json:"message"`
+		}
+		json.Unmarshal(data, &errRes)
+		return &apiError{status: res.StatusCode, message: errRes.Message}
+	}
+
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+type room struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Members        int        `json:"members"`
+	Online         int        `json:"online"`
+	LastActivityAt *time.Time `json:"lastActivityAt"`
+}
+
+type message struct {
+	ID        int       `json:"id"`
+	Username  string    `json:"username"`
+	Content   string    `json:"content"`
+	Bot       bool      `json:"bot"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type member struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+}
+
+func (c *client) rooms(ctx context.Context, query, sort string, page, pageSize int) ([]room, int, error) {
+	values := url.Values{}
+	values.Set("q", query)
+	values.Set("sort", sort)
+	values.Set("page", strconv.Itoa(page))
+	values.Set("pageSize", strconv.Itoa(pageSize))
+
+	var res struct {
+		Rooms []room `json:"rooms"`
+		Total int    `json:"total"`
+	}
+	if err := c.call(ctx, http.MethodGet, "/ws/get-rooms?"+values.Encode(), nil, &res); err != nil {
+		return nil, 0, err
+	}
+	return res.Rooms, res.Total, nil
+}
+
+func (c *client) createRoom(ctx context.Context, name string) (room, error) {
+	var res room
+	err := c.call(ctx, http.MethodPost, "/ws/create-room", map[string]string{"name": name}, &res)
+	return res, err
+}
+
+func (c *client) history(ctx context.Context, roomID string, limit int) ([]message, error) {
+	var res []message
+	path := fmt.Sprintf("/ws/get-history/%s?limit=%d", url.PathEscape(roomID), limit)
+	err := c.call(ctx, http.MethodGet, path, nil, &res)
+	return res, err
+}
+
+func (c *client) members(ctx context.Context, roomID string) ([]member, error) {
+	var res []member
+	err := c.call(ctx, http.MethodGet, "/ws/get-clients/"+url.PathEscape(roomID), nil, &res)
+	return res, err
+}
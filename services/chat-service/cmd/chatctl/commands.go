@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"golang.org/x/term"
+)
+
+func runLogin(ctx context.Context, c *client, args []string) error {
+	fs := flag.NewFlagSet("login", flag.ExitOnError)
+	username := fs.String("u", "", "username")
+	fs.Parse(args)
+
+	stdin := bufio.NewReader(os.Stdin)
+	if *username == "" {
+		fmt.Fprint(os.Stderr, "Username: ")
+		line, err := stdin.ReadString('\n')
+		if err != nil && line == "" {
+			return err
+		}
+		*username = strings.TrimSpace(line)
+	}
+
+	password, err := readPassword(stdin)
+	if err != nil {
+		return err
+	}
+
+	if err := c.login(ctx, *username, password); err != nil {
+		return err
+	}
+	fmt.Printf("Logged in as %s\n", c.creds.Username)
+	return nil
+}
+
+// readPassword prompts for a password without echoing it, or reads a line when
+// stdin is not a terminal, so that scripts can pipe the password in.
+func readPassword(stdin *bufio.Reader) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		line, err := stdin.ReadString('\n')
+		if err != nil && line == "" {
+			return "", err
+		}
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+
+	fmt.Fprint(os.Stderr, "Password: ")
+	password, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	return string(password), err
+}
+
+func runLogout(ctx context.Context, c *client, args []string) error {
+	return c.logout(ctx)
+}
+
+func runRooms(ctx context.Context, c *client, args []string) error {
+	fs := flag.NewFlagSet("rooms", flag.ExitOnError)
+	query := fs.String("q", "", "search room names")
+	sort := fs.String("sort", "activity", "activity, members, name or newest")
+	page := fs.Int("page", 1, "page number")
+	pageSize := fs.Int("n", 20, "rooms per page")
+	fs.Parse(args)
+
+	rooms, total, err := c.rooms(ctx, *query, *sort, *page, *pageSize)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tMEMBERS\tONLINE\tLAST ACTIVITY")
+	for _, room := range rooms {
+		lastActivity := "-"
+		if room.LastActivityAt != nil {
+			lastActivity = room.LastActivityAt.Local().Format(time.DateTime)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\n", room.ID, room.Name, room.Members, room.Online, lastActivity)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if shown := (*page-1)*(*pageSize) + len(rooms); shown < total {
+		fmt.Printf("%d of %d rooms, next page: -page %d\n", shown, total, *page+1)
+	}
+	return nil
+}
+
+func runCreateRoom(ctx context.Context, c *client, args []string) error {
+	name := strings.TrimSpace(strings.Join(args, " "))
+	if name == "" {
+		return errors.New("usage: chatctl create-room <name>")
+	}
+
+	room, err := c.createRoom(ctx, name)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Created room %s (%s)\n", room.ID, room.Name)
+	return nil
+}
+
+func runHistory(ctx context.Context, c *client, args []string) error {
+	fs := flag.NewFlagSet("history", flag.ExitOnError)
+	limit := fs.Int("n", 50, "number of messages")
+	follow := fs.Bool("f", false, "keep printing new messages")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return errors.New("usage: chatctl history [-n count] [-f] <room id>")
+	}
+	roomID := fs.Arg(0)
+
+	messages, err := c.history(ctx, roomID, *limit)
+	if err != nil {
+		return err
+	}
+	for _, message := range messages {
+		fmt.Println(formatMessage(message.CreatedAt, message.Username, message.Content, message.Bot))
+	}
+	if !*follow {
+		return nil
+	}
+
+	conn, err := c.dial(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	defer conn.close()
+
+	for event := range conn.events {
+		fmt.Println(formatEvent(event))
+	}
+	return conn.err
+}
+
+func runSend(ctx context.Context, c *client, args []string) error {
+	if len(args) < 2 {
+		return errors.New("usage: chatctl send <room id> <message>|-")
+	}
+	roomID := args[0]
+
+	content := strings.Join(args[1:], " ")
+	if content == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		content = string(data)
+	}
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return errors.New("message is empty")
+	}
+
+	conn, err := c.dial(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	defer conn.close()
+
+	if err := conn.send(content); err != nil {
+		return err
+	}
+
+	// The service broadcasts a message after storing it, so its echo confirms delivery
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case event, ok := <-conn.events:
+			if !ok {
+				if conn.err != nil {
+					return conn.err
+				}
+				return errors.New("connection closed before the message was delivered")
+			}
+			if event.Type == "" && event.Username == c.creds.Username && event.Content == content {
+				return nil
+			}
+		case <-timeout:
+			return errors.New("timed out waiting for the message to be delivered")
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Command chatctl is a terminal client for the chat services. It logs in through
+// auth-service, keeps the tokens in the user config directory and refreshes them
+// when they expire.
+//
+//	chatctl login -u alice
+//	chatctl rooms -q general
+//	chatctl create-room general
+//	chatctl history -n 20 -f 1
+//	chatctl join 1
+//	echo "deploy finished" | chatctl send 1 -
+//	chatctl logout
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+)
+
+const usage = `usage: chatctl [flags] <command> [arguments]
+
+commands:
+  login        log in and store the tokens
+  logout       log out and forget the tokens
+  rooms        list rooms
+  create-room  create a room
+  history      print the latest messages of a room, -f to follow it
+  join         chat in a room interactively
+  send         send a message to a room
+
+flags:
+`
+
+func main() {
+	authURL := flag.String("auth", envOr("CHATCTL_AUTH_URL", "http://localhost:3001"), "auth-service URL")
+	chatURL := flag.String("chat", envOr("CHATCTL_CHAT_URL", "https://localhost:3002"), "chat-service URL")
+	insecure := flag.Bool("insecure", os.Getenv("CHATCTL_INSECURE") != "", "skip TLS certificate verification")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	c, err := newClient(strings.TrimRight(*authURL, "/"), strings.TrimRight(*chatURL, "/"), *insecure)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "chatctl: %v\n", err)
+		os.Exit(1)
+	}
+
+	commands := map[string]func(context.Context, *client, []string) error{
+		"login":       runLogin,
+		"logout":      runLogout,
+		"rooms":       runRooms,
+		"create-room": runCreateRoom,
+		"history":     runHistory,
+		"join":        runJoin,
+		"send":        runSend,
+	}
+
+	command, ok := commands[flag.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "chatctl: unknown command %q\n\n", flag.Arg(0))
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := command(ctx, c, flag.Args()[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "chatctl: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
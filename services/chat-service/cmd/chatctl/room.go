@@ -0,0 +1,281 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+	fasthttpws "github.com/fasthttp/websocket"
+	"golang.org/x/term"
+)
+
+// roomEvent is a message or an event broadcast to a room.
+type roomEvent struct {
+	Content  string   `json:"content"`
+	Username string   `json:"username"`
+	Bot      bool     `json:"bot"`
+	Type     string   `json:"type"`
+	Poll     *ws.Poll `json:"poll"`
+}
+
+// roomConn is a WebSocket connection to a room. Events are closed when the
+// connection ends, after which err tells why.
+type roomConn struct {
+	conn   *fasthttpws.Conn
+	events chan roomEvent
+	err    error
+
+	mu        sync.Mutex
+	closeOnce sync.Once
+}
+
+// dial joins a room over the chat WebSocket with JSON frames.
+func (c *client) dial(ctx context.Context, roomID string) (*roomConn, error) {
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(c.chatURL)
+	if err != nil {
+		return nil, err
+	}
+	origin := u.String()
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+	u.Path = "/ws/join-room/" + url.PathEscape(roomID)
+
+	dialer := fasthttpws.Dialer{
+		HandshakeTimeout: 10 * time.Second,
+		TLSClientConfig:  c.tlsConfig,
+		Subprotocols:     []string{ws.SubprotocolJSON},
+	}
+	header := http.Header{
+		"Origin":        {origin},
+		"Authorization": {"Bearer " + token},
+	}
+	conn, res, err := dialer.DialContext(ctx, u.String(), header)
+	if err != nil {
+		if res != nil {
+			return nil, fmt.Errorf("joining room %s: %s", roomID, res.Status)
+		}
+		return nil, fmt.Errorf("joining room %s: %w", roomID, err)
+	}
+
+	rc := &roomConn{conn: conn, events: make(chan roomEvent, 16)}
+	go rc.read()
+	go func() {
+		<-ctx.Done()
+		rc.close()
+	}()
+	return rc, nil
+}
+
+func (rc *roomConn) read() {
+	defer close(rc.events)
+	for {
+		_, data, err := rc.conn.ReadMessage()
+		if err != nil {
+			if !fasthttpws.IsCloseError(err, fasthttpws.CloseNormalClosure, fasthttpws.CloseGoingAway) && !errors.Is(err, net.ErrClosed) {
+				rc.err = err
+			}
+			return
+		}
+
+		var event roomEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			continue
+		}
+		rc.events <- event
+	}
+}
+
+func (rc *roomConn) send(content string) error {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	data, err := json.Marshal(map[string]string{"content": content})
+	if err != nil {
+		return err
+	}
+	return rc.conn.WriteMessage(fasthttpws.TextMessage, data)
+}
+
+func (rc *roomConn) close() {
+	rc.closeOnce.Do(func() {
+		rc.mu.Lock()
+		rc.conn.WriteControl(fasthttpws.CloseMessage,
+			fasthttpws.FormatCloseMessage(fasthttpws.CloseNormalClosure, ""), time.Now().Add(time.Second))
+		rc.mu.Unlock()
+		rc.conn.Close()
+	})
+}
+
+func runJoin(ctx context.Context, c *client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: chatctl join <room id>")
+	}
+	roomID := args[0]
+
+	messages, err := c.history(ctx, roomID, 20)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.dial(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	defer conn.close()
+
+	screen := &screen{tty: term.IsTerminal(int(os.Stdout.Fd()))}
+	for _, message := range messages {
+		screen.print(formatMessage(message.CreatedAt, message.Username, message.Content, message.Bot))
+	}
+	screen.print(fmt.Sprintf("-- joined room %s as %s, /help for commands --", roomID, c.creds.Username))
+
+	showMembers := func() {
+		members, err := c.members(ctx, roomID)
+		if err != nil {
+			screen.print("-- members: " + err.Error() + " --")
+			return
+		}
+		names := make([]string, 0, len(members))
+		for _, member := range members {
+			names = append(names, member.Username)
+		}
+		screen.print(fmt.Sprintf("-- online (%d): %s --", len(names), strings.Join(names, ", ")))
+	}
+	showMembers()
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+
+	screen.prompt()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event, ok := <-conn.events:
+			if !ok {
+				screen.print("-- disconnected --")
+				return conn.err
+			}
+			screen.print(formatEvent(event))
+			if isPresenceEvent(event) {
+				showMembers()
+			}
+
+		case line, ok := <-lines:
+			if !ok {
+				return nil
+			}
+			screen.clearInput()
+
+			switch line = strings.TrimSpace(line); line {
+			case "":
+			case "/quit", "/exit":
+				return nil
+			case "/members":
+				showMembers()
+			case "/help":
+				screen.print("-- /members shows who is online, /quit leaves the room --")
+			default:
+				if err := conn.send(line); err != nil {
+					return err
+				}
+			}
+			screen.prompt()
+		}
+	}
+}
+
+// screen prints room events above the input line of a terminal.
+type screen struct {
+	tty bool
+}
+
+func (s *screen) print(line string) {
+	if s.tty {
+		// Clear the prompt, print the line and put the prompt back under it
+		fmt.Print("\r\033[K" + line + "\n> ")
+		return
+	}
+	fmt.Println(line)
+}
+
+func (s *screen) prompt() {
+	if s.tty {
+		fmt.Print("\r\033[K> ")
+	}
+}
+
+// clearInput removes the line the user just typed; the service echoes sent messages.
+func (s *screen) clearInput() {
+	if s.tty {
+		fmt.Print("\033[1A\r\033[K")
+	}
+}
+
+func formatEvent(event roomEvent) string {
+	switch {
+	case event.Type == ws.MessageTypePoll && event.Poll != nil:
+		options := make([]string, 0, len(event.Poll.Options))
+		for _, option := range event.Poll.Options {
+			options = append(options, fmt.Sprintf("%s: %d", option.Text, option.Votes))
+		}
+		state := "open"
+		if event.Poll.Closed {
+			state = "closed"
+		}
+		return fmt.Sprintf("* poll %d (%s) %s [%s]", event.Poll.ID, state, event.Poll.Question, strings.Join(options, ", "))
+	case event.Type != "" || isPresenceEvent(event):
+		return "* " + parseContent(event.Content)
+	}
+	return formatMessage(time.Now(), event.Username, event.Content, event.Bot)
+}
+
+// isPresenceEvent reports whether the event tells the room that someone joined or left.
+func isPresenceEvent(event roomEvent) bool {
+	return event.Type == "" && event.Content == event.Username+" has joined the room" ||
+		event.Type == "" && event.Content == event.Username+" has left the room"
+}
+
+func formatMessage(at time.Time, username, content string, bot bool) string {
+	if bot {
+		username += " [bot]"
+	}
+	return fmt.Sprintf("%s %s: %s", at.Local().Format("15:04"), username, parseContent(content))
+}
+
+// parseContent returns the text of a message. The web client sends the text
+// wrapped in a JSON object, other clients send it as is.
+func parseContent(content string) string {
+	var wrapped struct {
+		Content *string `json:"content"`
+	}
+	if strings.HasPrefix(content, "{") && json.Unmarshal([]byte(content), &wrapped) == nil && wrapped.Content != nil {
+		return *wrapped.Content
+	}
+	return content
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+	fasthttpws "github.com/fasthttp/websocket"
+)
+
+// payloadPrefix marks the messages sent by chatload among the other messages of a room.
+const payloadPrefix = "chatload "
+
+// payload is the content of a load message. Sender and Seq identify the message,
+// SentAt measures its latency; all the connections run on the same clock.
+type payload struct {
+	Run     string `json:"r"`
+	Sender  int    `json:"u"`
+	Seq     int    `json:"s"`
+	SentAt  int64  `json:"t"`
+	Padding string `json:"p,omitempty"`
+}
+
+// loadConn is the WebSocket connection of a synthetic user. Only its sender writes
+// sent and sendErrors, and only its reader the rest, until close returns.
+type loadConn struct {
+	conn   *fasthttpws.Conn
+	runID  string
+	roomID string
+
+	sent       int
+	sendErrors int
+
+	closing   atomic.Bool
+	done      chan struct{}
+	dropped   error // why the connection ended before it was closed
+	senders   map[int]*senderStats
+	latencies []time.Duration
+}
+
+// senderStats is what a connection received from one sender.
+type senderStats struct {
+	seen       map[int]bool
+	maxSeq     int
+	duplicates int
+	outOfOrder int
+}
+
+func (l *loader) dial(ctx context.Context, u *user) (*loadConn, error) {
+	target, err := url.Parse(l.cfg.ChatURL)
+	if err != nil {
+		return nil, err
+	}
+	origin := target.String()
+	if target.Scheme == "https" {
+		target.Scheme = "wss"
+	} else {
+		target.Scheme = "ws"
+	}
+	target.Path = "/ws/join-room/" + url.PathEscape(u.roomID)
+
+	dialer := fasthttpws.Dialer{
+		HandshakeTimeout: 10 * time.Second,
+		TLSClientConfig:  l.tlsConfig,
+		Subprotocols:     []string{ws.SubprotocolJSON},
+	}
+	header := http.Header{
+		"Origin":        {origin},
+		"Authorization": {"Bearer " + u.token},
+	}
+	conn, res, err := dialer.DialContext(ctx, target.String(), header)
+	if err != nil {
+		if res != nil {
+			return nil, &statusError{status: res.StatusCode}
+		}
+		return nil, err
+	}
+
+	c := &loadConn{
+		conn:    conn,
+		runID:   l.runID,
+		roomID:  u.roomID,
+		done:    make(chan struct{}),
+		senders: make(map[int]*senderStats),
+	}
+	go c.read()
+	return c, nil
+}
+
+func (c *loadConn) send(runID string, sender, seq, size int) {
+	content, err := json.Marshal(payload{
+		Run:     runID,
+		Sender:  sender,
+		Seq:     seq,
+		SentAt:  time.Now().UnixNano(),
+		Padding: strings.Repeat("x", size),
+	})
+	if err != nil {
+		c.sendErrors++
+		return
+	}
+
+	frame, err := json.Marshal(map[string]string{"content": payloadPrefix + string(content)})
+	if err != nil {
+		c.sendErrors++
+		return
+	}
+	if err := c.conn.WriteMessage(fasthttpws.TextMessage, frame); err != nil {
+		c.sendErrors++
+		return
+	}
+	c.sent++
+}
+
+func (c *loadConn) read() {
+	defer close(c.done)
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			if !c.closing.Load() {
+				c.dropped = err
+			}
+			return
+		}
+		received := time.Now()
+
+		var msg struct {
+			Content string `json:"content"`
+			Type    string `json:"type"`
+		}
+		if err := json.Unmarshal(data, &msg); err != nil || msg.Type != "" || !strings.HasPrefix(msg.Content, payloadPrefix) {
+			continue
+		}
+		var p payload
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(msg.Content, payloadPrefix)), &p); err != nil || p.Run != c.runID {
+			continue
+		}
+
+		stats, ok := c.senders[p.Sender]
+		if !ok {
+			stats = &senderStats{seen: make(map[int]bool)}
+			c.senders[p.Sender] = stats
+		}
+		if stats.seen[p.Seq] {
+			stats.duplicates++
+			continue
+		}
+		stats.seen[p.Seq] = true
+		if p.Seq < stats.maxSeq {
+			stats.outOfOrder++
+		} else {
+			stats.maxSeq = p.Seq
+		}
+		c.latencies = append(c.latencies, received.Sub(time.Unix(0, p.SentAt)))
+	}
+}
+
+// close closes the connection and waits for its reader to finish.
+func (c *loadConn) close() {
+	if c.closing.Swap(true) {
+		<-c.done
+		return
+	}
+	c.conn.WriteControl(fasthttpws.CloseMessage,
+		fasthttpws.FormatCloseMessage(fasthttpws.CloseNormalClosure, ""), time.Now().Add(time.Second))
+	c.conn.Close()
+	<-c.done
+}
+
+// failureReason groups errors into the categories counted in the report.
+func failureReason(err error) string {
+	var statusErr *statusError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &statusErr):
+		return fmt.Sprintf("http %d", statusErr.status)
+	case errors.Is(err, fasthttpws.ErrBadHandshake):
+		return "bad handshake"
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return "canceled"
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return "timeout"
+	case fasthttpws.IsUnexpectedCloseError(err):
+		return "closed by server"
+	case errors.As(err, &netErr):
+		return "network"
+	default:
+		return "other"
+	}
+}
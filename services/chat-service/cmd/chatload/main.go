@@ -0,0 +1,131 @@
+// Command chatload measures how the chat hub holds up under load. It signs up and
+// logs in synthetic users, spreads their WebSocket connections over a set of rooms,
+// sends messages at a fixed rate per user and reports delivery latency percentiles,
+// dropped, duplicated and out-of-order messages and connection failures as JSON.
+//
+//	chatload -insecure -users 500 -rooms 20 -rate 0.5 -duration 1m -out report.json
+//
+// It refuses to run against anything but a local stack unless -allow-remote is set.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+)
+
+type config struct {
+	AuthURL     string        `json:"authUrl"`
+	UsersURL    string        `json:"usersUrl"`
+	ChatURL     string        `json:"chatUrl"`
+	Users       int           `json:"users"`
+	Rooms       int           `json:"rooms"`
+	Rate        float64       `json:"rate"` // messages per second per user
+	Duration    time.Duration `json:"duration"`
+	Ramp        time.Duration `json:"ramp"`
+	Drain       time.Duration `json:"drain"`
+	Size        int           `json:"size"`
+	Prefix      string        `json:"prefix"`
+	Password    string        `json:"-"`
+	Concurrency int           `json:"concurrency"`
+	Insecure    bool          `json:"insecure"`
+}
+
+func main() {
+	var cfg config
+	flag.StringVar(&cfg.AuthURL, "auth", "http://localhost:3001", "auth-service URL")
+	flag.StringVar(&cfg.UsersURL, "user-management", "http://localhost:3000", "user-management URL")
+	flag.StringVar(&cfg.ChatURL, "chat", "https://localhost:3002", "chat-service URL")
+	flag.IntVar(&cfg.Users, "users", 100, "number of synthetic users, one connection each")
+	flag.IntVar(&cfg.Rooms, "rooms", 10, "number of rooms the connections are spread over")
+	flag.Float64Var(&cfg.Rate, "rate", 1, "messages per second sent by every user")
+	flag.DurationVar(&cfg.Duration, "duration", 30*time.Second, "how long to send messages")
+	flag.DurationVar(&cfg.Ramp, "ramp", 10*time.Second, "how long to spread opening the connections over")
+	flag.DurationVar(&cfg.Drain, "drain", 5*time.Second, "how long to wait for deliveries after the last message is sent")
+	flag.IntVar(&cfg.Size, "size", 64, "bytes of padding added to every message")
+	flag.StringVar(&cfg.Prefix, "prefix", "load", "prefix of the synthetic usernames and room names")
+	flag.StringVar(&cfg.Password, "password", "load-test-password", "password of the synthetic users")
+	flag.IntVar(&cfg.Concurrency, "concurrency", 16, "parallel sign ups and logins")
+	flag.BoolVar(&cfg.Insecure, "insecure", false, "skip TLS certificate verification")
+	allowRemote := flag.Bool("allow-remote", false, "allow targets other than the local machine")
+	out := flag.String("out", "chatload-report.json", "path of the JSON report, - for stdout")
+	flag.Parse()
+
+	if err := validate(cfg, *allowRemote); err != nil {
+		fmt.Fprintf(os.Stderr, "chatload: %v\n", err)
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	rep, err := run(ctx, cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "chatload: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := writeReport(*out, rep); err != nil {
+		fmt.Fprintf(os.Stderr, "chatload: writing report: %v\n", err)
+		os.Exit(1)
+	}
+	rep.print(os.Stderr)
+}
+
+func validate(cfg config, allowRemote bool) error {
+	switch {
+	case cfg.Users <= 0:
+		return fmt.Errorf("-users must be positive")
+	case cfg.Rooms <= 0 || cfg.Rooms > cfg.Users:
+		return fmt.Errorf("-rooms must be between 1 and -users")
+	case cfg.Rate <= 0:
+		return fmt.Errorf("-rate must be positive")
+	case cfg.Duration <= 0:
+		return fmt.Errorf("-duration must be positive")
+	case cfg.Concurrency <= 0:
+		return fmt.Errorf("-concurrency must be positive")
+	}
+
+	if allowRemote {
+		return nil
+	}
+	for _, raw := range []string{cfg.AuthURL, cfg.UsersURL, cfg.ChatURL} {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return err
+		}
+		if !isLocal(u.Hostname()) {
+			return fmt.Errorf("%s is not a local address, pass -allow-remote to load it anyway", raw)
+		}
+	}
+	return nil
+}
+
+func isLocal(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func writeReport(path string, rep *report) error {
+	data, err := json.MarshalIndent(rep, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if path == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
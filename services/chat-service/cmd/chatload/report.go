@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"time"
+)
+
+// report is the machine-readable result of a run. Latencies are in milliseconds.
+type report struct {
+	StartedAt   time.Time        `json:"startedAt"`
+	Config      reportConfig     `json:"config"`
+	Logins      countReport      `json:"logins"`
+	Connections connectionReport `json:"connections"`
+	Messages    messageReport    `json:"messages"`
+	Latency     latencyReport    `json:"latencyMs"`
+
+	connectTimes []time.Duration
+}
+
+type reportConfig struct {
+	AuthURL  string  `json:"authUrl"`
+	UsersURL string  `json:"usersUrl"`
+	ChatURL  string  `json:"chatUrl"`
+	Users    int     `json:"users"`
+	Rooms    int     `json:"rooms"`
+	Rate     float64 `json:"ratePerUser"`
+	Duration string  `json:"duration"`
+	Ramp     string  `json:"ramp"`
+	Drain    string  `json:"drain"`
+	Size     int     `json:"size"`
+}
+
+type countReport struct {
+	Attempted int            `json:"attempted"`
+	Failed    int            `json:"failed"`
+	Failures  map[string]int `json:"failures"`
+}
+
+type connectionReport struct {
+	countReport
+	Established int            `json:"established"`
+	Dropped     int            `json:"dropped"` // ended by the server or the network during the run
+	DropReasons map[string]int `json:"dropReasons"`
+	ConnectMs   latencyReport  `json:"connectMs"`
+}
+
+type messageReport struct {
+	Sent          int     `json:"sent"`
+	SendErrors    int     `json:"sendErrors"`
+	Expected      int     `json:"expected"` // every message is expected once by every connection of its room
+	Delivered     int     `json:"delivered"`
+	Dropped       int     `json:"dropped"`
+	Duplicates    int     `json:"duplicates"`
+	OutOfOrder    int     `json:"outOfOrder"`
+	SendSeconds   float64 `json:"sendSeconds"`
+	SentPerSec    float64 `json:"sentPerSecond"`
+	DeliveredRate float64 `json:"deliveredPerSecond"`
+}
+
+type latencyReport struct {
+	Count int     `json:"count"`
+	Min   float64 `json:"min"`
+	Mean  float64 `json:"mean"`
+	P50   float64 `json:"p50"`
+	P90   float64 `json:"p90"`
+	P95   float64 `json:"p95"`
+	P99   float64 `json:"p99"`
+	P999  float64 `json:"p999"`
+	Max   float64 `json:"max"`
+}
+
+func newReport(cfg config) *report {
+	return &report{
+		StartedAt: time.Now(),
+		Config: reportConfig{
+			AuthURL:  cfg.AuthURL,
+			UsersURL: cfg.UsersURL,
+			ChatURL:  cfg.ChatURL,
+			Users:    cfg.Users,
+			Rooms:    cfg.Rooms,
+			Rate:     cfg.Rate,
+			Duration: cfg.Duration.String(),
+			Ramp:     cfg.Ramp.String(),
+			Drain:    cfg.Drain.String(),
+			Size:     cfg.Size,
+		},
+		Logins: countReport{Failures: make(map[string]int)},
+		Connections: connectionReport{
+			countReport: countReport{Failures: make(map[string]int)},
+			DropReasons: make(map[string]int),
+		},
+	}
+}
+
+// collect adds up what the connections sent and received once they are closed.
+func (r *report) collect(users []*user, sendTime time.Duration) {
+	r.Connections.Established = len(users)
+	r.Connections.ConnectMs = summarize(r.connectTimes)
+
+	members := make(map[string][]*user)
+	for _, u := range users {
+		members[u.roomID] = append(members[u.roomID], u)
+	}
+
+	var latencies []time.Duration
+	for _, u := range users {
+		c := u.conn
+		r.Messages.Sent += c.sent
+		r.Messages.SendErrors += c.sendErrors
+		if c.dropped != nil {
+			r.Connections.Dropped++
+			r.Connections.DropReasons[failureReason(c.dropped)]++
+		}
+
+		for _, sender := range members[u.roomID] {
+			r.Messages.Expected += sender.conn.sent
+			stats, ok := c.senders[sender.index]
+			if !ok {
+				continue
+			}
+			r.Messages.Delivered += len(stats.seen)
+			r.Messages.Duplicates += stats.duplicates
+			r.Messages.OutOfOrder += stats.outOfOrder
+		}
+		latencies = append(latencies, c.latencies...)
+	}
+	r.Messages.Dropped = r.Messages.Expected - r.Messages.Delivered
+	r.Messages.SendSeconds = sendTime.Seconds()
+	if sendTime > 0 {
+		r.Messages.SentPerSec = float64(r.Messages.Sent) / sendTime.Seconds()
+		r.Messages.DeliveredRate = float64(r.Messages.Delivered) / sendTime.Seconds()
+	}
+	r.Latency = summarize(latencies)
+}
+
+func summarize(durations []time.Duration) latencyReport {
+	if len(durations) == 0 {
+		return latencyReport{}
+	}
+	slices.Sort(durations)
+
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+	percentile := func(p float64) float64 {
+		i := int(math.Ceil(p*float64(len(durations)))) - 1
+		return ms(durations[max(i, 0)])
+	}
+	return latencyReport{
+		Count: len(durations),
+		Min:   ms(durations[0]),
+		Mean:  ms(total / time.Duration(len(durations))),
+		P50:   percentile(0.50),
+		P90:   percentile(0.90),
+		P95:   percentile(0.95),
+		P99:   percentile(0.99),
+		P999:  percentile(0.999),
+		Max:   ms(durations[len(durations)-1]),
+	}
+}
+
+func ms(d time.Duration) float64 {
+	return math.Round(float64(d)/float64(time.Microsecond)) / 1000
+}
+
+func (r *report) print(w io.Writer) {
+	fmt.Fprintf(w, "logins:      %d/%d\n", r.Logins.Attempted-r.Logins.Failed, r.Logins.Attempted)
+	fmt.Fprintf(w, "connections: %d/%d established, %d dropped\n", r.Connections.Established, r.Connections.Attempted, r.Connections.Dropped)
+	fmt.Fprintf(w, "messages:    %d sent (%.1f/s), %d/%d delivered, %d dropped, %d duplicates, %d out of order\n",
+		r.Messages.Sent, r.Messages.SentPerSec, r.Messages.Delivered, r.Messages.Expected,
+		r.Messages.Dropped, r.Messages.Duplicates, r.Messages.OutOfOrder)
+	fmt.Fprintf(w, "latency ms:  p50 %.1f  p90 %.1f  p95 %.1f  p99 %.1f  max %.1f\n",
+		r.Latency.P50, r.Latency.P90, r.Latency.P95, r.Latency.P99, r.Latency.Max)
+}
@@ -0,0 +1,337 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// user is a synthetic user with its connection to the room it was assigned to.
+type user struct {
+	index    int
+	username string
+	id       string
+	token    string
+	roomID   string
+	conn     *loadConn
+}
+
+type loader struct {
+	cfg       config
+	runID     string
+	tlsConfig *tls.Config
+	http      *http.Client
+	rep       *report
+}
+
+// run signs up and logs in the users, connects them, sends messages for the configured
+// duration and collects what every connection received.
+func run(ctx context.Context, cfg config) (*report, error) {
+	runID := make([]byte, 4)
+	if _, err := rand.Read(runID); err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: cfg.Insecure}
+	l := &loader{
+		cfg:       cfg,
+		runID:     hex.EncodeToString(runID),
+		tlsConfig: tlsConfig,
+		http: &http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &http.Transport{
+				TLSClientConfig:     tlsConfig,
+				MaxIdleConnsPerHost: cfg.Concurrency,
+			},
+		},
+		rep: newReport(cfg),
+	}
+
+	logf("logging in %d users", cfg.Users)
+	users := l.login(ctx)
+	if len(users) == 0 {
+		return nil, fmt.Errorf("no user could log in: %v", l.rep.Logins.Failures)
+	}
+
+	logf("preparing %d rooms", cfg.Rooms)
+	roomIDs, err := l.prepareRooms(ctx, users[0].token)
+	if err != nil {
+		return nil, err
+	}
+	for i, u := range users {
+		u.roomID = roomIDs[i%len(roomIDs)]
+	}
+
+	logf("opening %d connections over %s", len(users), cfg.Ramp)
+	connected := l.connect(ctx, users)
+	if len(connected) == 0 {
+		return nil, fmt.Errorf("no connection could be opened: %v", l.rep.Connections.Failures)
+	}
+
+	// Let the join announcements settle before measuring
+	sleep(ctx, time.Second)
+
+	logf("sending for %s", cfg.Duration)
+	started := time.Now()
+	l.send(ctx, connected)
+	sent := time.Since(started)
+
+	logf("draining for %s", cfg.Drain)
+	sleep(ctx, cfg.Drain)
+	for _, u := range connected {
+		u.conn.close()
+	}
+
+	l.rep.collect(connected, sent)
+	return l.rep, nil
+}
+
+// login signs up the users that do not exist yet and logs all of them in.
+func (l *loader) login(ctx context.Context) []*user {
+	var (
+		mu    sync.Mutex
+		users []*user
+		wg    sync.WaitGroup
+	)
+	sem := make(chan struct{}, l.cfg.Concurrency)
+	for i := 0; i < l.cfg.Users; i++ {
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			u, err := l.loginUser(ctx, i)
+			mu.Lock()
+			defer mu.Unlock()
+			l.rep.Logins.Attempted++
+			if err != nil {
+				l.rep.Logins.Failed++
+				l.rep.Logins.Failures[failureReason(err)]++
+				return
+			}
+			users = append(users, u)
+		}(i)
+	}
+	wg.Wait()
+	return users
+}
+
+func (l *loader) loginUser(ctx context.Context, i int) (*user, error) {
+	username := fmt.Sprintf("%s-user-%05d", l.cfg.Prefix, i)
+
+	// Signing up an existing user fails, which is fine: it only has to exist
+	signup := map[string]string{
+		"username": username,
+		"password": l.cfg.Password,
+		"email":    username + "@load.test",
+	}
+	l.request(ctx, http.MethodPost, l.cfg.UsersURL+"/users", "", signup, nil)
+
+	var res struct {
+		AccessToken string `json:"access_token"`
+		User        struct {
+			ID       uint   `json:"id"`
+			Username string `json:"username"`
+		} `json:"user"`
+	}
+	login := map[string]string{"username": username, "password": l.cfg.Password}
+	if err := l.request(ctx, http.MethodPost, l.cfg.AuthURL+"/login", "", login, &res); err != nil {
+		return nil, err
+	}
+
+	return &user{
+		index:    i,
+		username: res.User.Username,
+		id:       strconv.FormatUint(uint64(res.User.ID), 10),
+		token:    res.AccessToken,
+	}, nil
+}
+
+// prepareRooms finds the rooms of earlier runs by name and creates the missing ones.
+func (l *loader) prepareRooms(ctx context.Context, token string) ([]string, error) {
+	roomIDs := make([]string, 0, l.cfg.Rooms)
+	for i := 0; i < l.cfg.Rooms; i++ {
+		name := fmt.Sprintf("%s-room-%03d", l.cfg.Prefix, i)
+
+		var page struct {
+			Rooms []struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"rooms"`
+		}
+		query := url.Values{"q": {name}, "sort": {"name"}, "pageSize": {"100"}}
+		if err := l.request(ctx, http.MethodGet, l.cfg.ChatURL+"/ws/get-rooms?"+query.Encode(), token, nil, &page); err != nil {
+			return nil, fmt.Errorf("finding room %s: %w", name, err)
+		}
+
+		roomID := ""
+		for _, room := range page.Rooms {
+			if room.Name == name {
+				roomID = room.ID
+				break
+			}
+		}
+		if roomID == "" {
+			var room struct {
+				ID string `json:"id"`
+			}
+			if err := l.request(ctx, http.MethodPost, l.cfg.ChatURL+"/ws/create-room", token, map[string]string{"name": name}, &room); err != nil {
+				return nil, fmt.Errorf("creating room %s: %w", name, err)
+			}
+			roomID = room.ID
+		}
+		roomIDs = append(roomIDs, roomID)
+	}
+	return roomIDs, nil
+}
+
+// connect opens the connections of the users spread evenly over the ramp and
+// returns the users that are connected.
+func (l *loader) connect(ctx context.Context, users []*user) []*user {
+	var (
+		mu        sync.Mutex
+		connected []*user
+		wg        sync.WaitGroup
+	)
+	interval := l.cfg.Ramp / time.Duration(len(users))
+	for i, u := range users {
+		if i > 0 && !sleep(ctx, interval) {
+			break
+		}
+
+		wg.Add(1)
+		go func(u *user) {
+			defer wg.Done()
+
+			start := time.Now()
+			conn, err := l.dial(ctx, u)
+			mu.Lock()
+			defer mu.Unlock()
+			l.rep.Connections.Attempted++
+			if err != nil {
+				l.rep.Connections.Failed++
+				l.rep.Connections.Failures[failureReason(err)]++
+				return
+			}
+			l.rep.connectTimes = append(l.rep.connectTimes, time.Since(start))
+			u.conn = conn
+			connected = append(connected, u)
+		}(u)
+	}
+	wg.Wait()
+	return connected
+}
+
+// send makes every connected user send messages at the configured rate until the duration is over.
+func (l *loader) send(ctx context.Context, users []*user) {
+	ctx, cancel := context.WithTimeout(ctx, l.cfg.Duration)
+	defer cancel()
+
+	interval := time.Duration(float64(time.Second) / l.cfg.Rate)
+	var wg sync.WaitGroup
+	for i, u := range users {
+		wg.Add(1)
+		go func(u *user, offset time.Duration) {
+			defer wg.Done()
+
+			// Stagger the users so that they do not all send at the same instant
+			if !sleep(ctx, offset) {
+				return
+			}
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for seq := 1; ; seq++ {
+				u.conn.send(l.runID, u.index, seq, l.cfg.Size)
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}(u, interval*time.Duration(i)/time.Duration(len(users)))
+	}
+	wg.Wait()
+}
+
+func (l *loader) request(ctx context.Context, method, url, token string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	res, err := l.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode >= http.StatusBadRequest {
+		return &statusError{status: res.StatusCode}
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+type statusError struct {
+	status int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("%d %s", e.status, http.StatusText(e.status))
+}
+
+// sleep waits for d and reports false when ctx is done first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func logf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "%s "+format+"\n", append([]any{time.Now().Format(time.TimeOnly)}, args...)...)
+}
@@ -0,0 +1,133 @@
+// Command import imports chat history from a Slack workspace export or a
+// generic JSON export into chat-service, using the service configuration.
+//
+//	import -format slack -file export.zip
+//	import -resume 42
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/ports"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/usecase"
+	grpcAuthRepository "github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/repository/auth"
+	grpcUserRepository "github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/repository/user"
+	grpcAuthService "github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/service/auth"
+	grpcUserService "github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/service/user"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/repository"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/db"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/jwks"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/views"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+	"go.uber.org/fx"
+)
+
+func main() {
+	format := flag.String("format", "", "export format: slack or json")
+	file := flag.String("file", "", "path to the export file")
+	resume := flag.Int("resume", 0, "id of a pending or failed import to resume")
+	flag.Parse()
+
+	if *resume == 0 && (*format == "" || *file == "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var chatUseCase *usecase.ChatUseCase
+	app := fx.New(
+		fx.NopLogger,
+		logger.Module,
+		db.Module,
+		configs.Module,
+		views.Module,
+		jwks.Module,
+		fx.Provide(
+			fx.Annotate(
+				repository.NewChatRepository,
+				fx.As(new(ports.IChatRepository)),
+			),
+			usecase.NewChatUseCase,
+			ws.NewHub,
+
+			// gRPC service
+			fx.Annotate(
+				grpcAuthRepository.NewClient,
+				fx.As(new(grpcAuthRepository.IClient)),
+			),
+			grpcAuthService.NewAuthService,
+			fx.Annotate(
+				grpcUserRepository.NewClient,
+				fx.As(new(grpcUserRepository.IClient)),
+			),
+			grpcUserService.NewUsersService,
+		),
+		fx.Populate(&chatUseCase),
+	)
+
+	ctx := context.Background()
+	if err := app.Start(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to start: %v\n", err)
+		os.Exit(1)
+	}
+	defer app.Stop(ctx)
+
+	imp, err := run(ctx, chatUseCase, domain.ImportFormat(*format), *file, *resume)
+	if imp.ID != 0 {
+		printReport(imp)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import failed: %v\n", err)
+		if imp.ID != 0 {
+			fmt.Fprintf(os.Stderr, "resume it with: import -resume %d\n", imp.ID)
+		}
+		app.Stop(ctx)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, chatUseCase *usecase.ChatUseCase, format domain.ImportFormat, path string, resume int) (domain.Import, error) {
+	if resume != 0 {
+		return chatUseCase.RunImport(ctx, domain.Import{ID: resume})
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return domain.Import{}, err
+	}
+	defer file.Close()
+
+	imp, err := chatUseCase.CreateImport(ctx, domain.Import{Format: format, UserID: "cli"}, file)
+	if err != nil {
+		return domain.Import{}, err
+	}
+
+	return chatUseCase.RunImport(ctx, imp)
+}
+
+func printReport(imp domain.Import) {
+	fmt.Printf("Import %d (%s): %s\n", imp.ID, imp.Format, imp.Status)
+	fmt.Printf("  rooms created:     %d\n", imp.RoomsCreated)
+	fmt.Printf("  rooms completed:   %d\n", len(imp.CompletedRooms))
+	fmt.Printf("  messages imported: %d\n", imp.MessagesImported)
+	fmt.Printf("  already imported:  %d\n", imp.MessagesSkipped)
+
+	if len(imp.UnmappedUsers) == 0 {
+		fmt.Println("All authors were mapped to users.")
+		return
+	}
+
+	fmt.Printf("\n%d unmapped users:\n", len(imp.UnmappedUsers))
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tNAME\tEMAIL\tMESSAGES")
+	for _, user := range imp.UnmappedUsers {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", user.Key, user.Name, user.Email, user.Messages)
+	}
+	w.Flush()
+}
@@ -4,18 +4,28 @@ import (
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/ports"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/usecase"
 	_ "github.com/Ali-Gorgani/chat-room-project/services/chat-service/docs" // Import Swagger docs
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc"
+	grpcHandler "github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/grpc-handler"
 	grpcAuthRepository "github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/repository/auth"
+	grpcUserRepository "github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/repository/user"
 	grpcAuthService "github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/service/auth"
+	grpcUserService "github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/service/user"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/handler"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/jobs"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/repository"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/router"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/server"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/db"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/health"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/jwks"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/redis"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/tracing"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/views"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
 	"github.com/gofiber/fiber/v2"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/fx"
 )
 
@@ -35,6 +45,10 @@ func main() {
 		db.Module,
 		configs.Module,
 		redis.Module,
+		views.Module,
+		jwks.Module,
+		tracing.Module,
+		health.Module,
 		fx.Provide(
 			// http service
 			handler.NewChatHandler,
@@ -47,12 +61,28 @@ func main() {
 			server.NewServer,
 			ws.NewHub,
 
+			// background jobs
+			jobs.NewRetentionJob,
+			jobs.NewExportJob,
+			jobs.NewBotDeliveryJob,
+			jobs.NewScheduledMessageJob,
+			jobs.NewPollCloseJob,
+
+			// gRPC server
+			grpcHandler.NewChatHandler,
+			grpc.NewGRPCServer,
+
 			// gRPC service
 			fx.Annotate(
 				grpcAuthRepository.NewClient,
 				fx.As(new(grpcAuthRepository.IClient)),
 			),
 			grpcAuthService.NewAuthService,
+			fx.Annotate(
+				grpcUserRepository.NewClient,
+				fx.As(new(grpcUserRepository.IClient)),
+			),
+			grpcUserService.NewUsersService,
 		),
 		fx.Invoke(func(
 			lc fx.Lifecycle,
@@ -60,12 +90,41 @@ func main() {
 			logger *logger.Logger,
 			config *configs.Config,
 			srv *server.Server, // Inject the Fiber server
+			grpcSrv *grpc.GRPCServer, // Inject the gRPC server
+			checker *health.Checker, // Inject the health checker
 			ws *ws.Hub, // Inject the ws hub
+			retentionJob *jobs.RetentionJob, // Inject the retention job
+			exportJob *jobs.ExportJob, // Inject the export job
+			botDeliveryJob *jobs.BotDeliveryJob, // Inject the bot delivery job
+			scheduledMessageJob *jobs.ScheduledMessageJob, // Inject the scheduled message job
+			pollCloseJob *jobs.PollCloseJob, // Inject the poll close job
 		) {
 			// Set up the Fiber server
 			srv.SetupChatServer(lc)
 
-			// Start ws hub
+			// Set up the gRPC server
+			grpcSrv.SetupGRPCServer(lc)
+
+			// Set up the health checks
+			checker.SetupChecker(lc)
+
+			// Set up the retention job
+			retentionJob.SetupRetentionJob(lc)
+
+			// Set up the export job
+			exportJob.SetupExportJob(lc)
+
+			// Set up the bot delivery job
+			botDeliveryJob.SetupBotDeliveryJob(lc)
+
+			// Set up the scheduled message job
+			scheduledMessageJob.SetupScheduledMessageJob(lc)
+
+			// Set up the poll close job
+			pollCloseJob.SetupPollCloseJob(lc)
+
+			// Export the hub gauges and start the hub
+			prometheus.MustRegister(ws)
 			go ws.Run()
 		}),
 	)
@@ -1,6 +1,13 @@
 package domain
 
-import "github.com/gofiber/websocket/v2"
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofiber/websocket/v2"
+)
 
 // User represents a user in the chat
 type User struct {
@@ -8,6 +15,40 @@ type User struct {
 	Username string
 	Email    string
 	Role     Role
+	// ClientID and Scopes are set when an OAuth client acts on behalf of the user, or
+	// on its own behalf with the client role
+	ClientID string
+	Scopes   []string
+}
+
+// RoleBot is the role of the accounts that bots authenticate with.
+const RoleBot = "bot"
+
+// RoleClient is the role of the tokens OAuth clients get for themselves.
+const RoleClient = "client"
+
+// The scopes OAuth clients need for GET requests and for everything else, which
+// includes connecting to rooms.
+const (
+	ScopeChatRead  = "chat:read"
+	ScopeChatWrite = "chat:write"
+)
+
+// IsBot reports whether the user is a bot.
+func (u User) IsBot() bool {
+	return u.Role.Name == RoleBot
+}
+
+// IsAdmin reports whether the user acts as an admin. OAuth clients never do, not even
+// on behalf of an admin.
+func (u User) IsAdmin() bool {
+	return u.Role.Name == "admin" && u.ClientID == ""
+}
+
+// HasScope reports whether the token of the user allows scope. Only the tokens of OAuth
+// clients are limited to their scopes.
+func (u User) HasScope(scope string) bool {
+	return u.ClientID == "" || slices.Contains(u.Scopes, scope)
 }
 
 type Role struct {
@@ -20,15 +61,117 @@ type Auth struct {
 }
 
 type Room struct {
-	ID   string
-	Name string
+	ID        string
+	Name      string
+	Retention Retention
+	OwnerID   string
+	ImportKey string
+}
+
+// RoomSort is the order of the room directory.
+type RoomSort string
+
+const (
+	RoomSortActivity RoomSort = "activity"
+	RoomSortMembers  RoomSort = "members"
+	RoomSortName     RoomSort = "name"
+	RoomSortNewest   RoomSort = "newest"
+)
+
+// RoomFilter selects a page of the room directory. Query matches room names.
+type RoomFilter struct {
+	Query  string
+	Sort   RoomSort
+	Offset int
+	Limit  int
+}
+
+// RoomSummary is a room as listed in the room directory.
+// Online is the number of users connected to the hub of this instance.
+type RoomSummary struct {
+	Room           Room
+	Members        int
+	Online         int
+	LastActivityAt *time.Time
+}
+
+// RoomPage is a page of the room directory. Total counts all the rooms matching the filter.
+type RoomPage struct {
+	Rooms  []RoomSummary
+	Total  int
+	Offset int
+	Limit  int
+}
+
+// Retention holds the retention policy of a room.
+// A nil value falls back to the global default, zero keeps messages forever.
+type Retention struct {
+	MaxAge      *time.Duration
+	MaxMessages *int
 }
 
 type Message struct {
-	ID       int
-	RoomID   string
-	Username string
-	Content  string
+	ID          int
+	RoomID      string
+	Username    string
+	Content     string
+	Bot         bool
+	Attachments []Attachment
+	CreatedAt   time.Time
+	ArchivedAt  *time.Time
+	ImportKey   string
+}
+
+// Attachment is a link attached to a message.
+type Attachment struct {
+	Title string
+	URL   string
+	Text  string
+}
+
+type PurgeReason string
+
+const (
+	PurgeReasonMaxAge      PurgeReason = "max_age"
+	PurgeReasonMaxMessages PurgeReason = "max_messages"
+)
+
+type PurgeMode string
+
+const (
+	PurgeModeDelete  PurgeMode = "delete"
+	PurgeModeArchive PurgeMode = "archive"
+)
+
+// Purge records the messages removed from a room by a single retention batch.
+type Purge struct {
+	ID           int
+	RoomID       string
+	Reason       PurgeReason
+	Mode         PurgeMode
+	DryRun       bool
+	MessageCount int
+	MessageIDs   []int
+	CreatedAt    time.Time
+}
+
+// PurgeFilter selects the messages of a room that are eligible for a purge.
+// Messages are returned in ascending ID order starting after AfterID.
+type PurgeFilter struct {
+	RoomID        string
+	CreatedBefore time.Time
+	MaxID         int
+	AfterID       int
+	Limit         int
+}
+
+// PurgeReport summarizes a retention run.
+type PurgeReport struct {
+	DryRun     bool
+	Mode       PurgeMode
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Purges     []Purge
 }
 
 type Chat struct {
@@ -37,3 +180,372 @@ type Chat struct {
 	User    User
 	Conn    *websocket.Conn
 }
+
+// Member is a user that has joined a room at least once.
+type Member struct {
+	RoomID   string
+	UserID   string
+	Username string
+	JoinedAt time.Time
+}
+
+// MessageFilter selects the messages of a room within an optional time range.
+// Messages are returned in ascending ID order starting after AfterID.
+type MessageFilter struct {
+	RoomID   string
+	From     *time.Time
+	To       *time.Time
+	AfterID  int
+	BeforeID int
+	Limit    int
+}
+
+type ExportFormat string
+
+const (
+	ExportFormatJSONL ExportFormat = "jsonl"
+	ExportFormatCSV   ExportFormat = "csv"
+	ExportFormatHTML  ExportFormat = "html"
+)
+
+type ExportStatus string
+
+const (
+	ExportStatusPending   ExportStatus = "pending"
+	ExportStatusRunning   ExportStatus = "running"
+	ExportStatusCompleted ExportStatus = "completed"
+	ExportStatusFailed    ExportStatus = "failed"
+)
+
+// Export is a transcript export of the messages of a room.
+type Export struct {
+	ID           int
+	RoomID       string
+	UserID       string
+	Format       ExportFormat
+	From         *time.Time
+	To           *time.Time
+	Status       ExportStatus
+	Error        string
+	MessageCount int
+	CreatedAt    time.Time
+	CompletedAt  *time.Time
+	// LeaseUntil is set while a worker runs the export, which it must renew until then
+	LeaseUntil *time.Time
+}
+
+// ExportChunk is a part of the transcript of an asynchronous export; the chunks of an
+// export are numbered from 0.
+type ExportChunk struct {
+	ExportID int
+	Seq      int
+	Data     []byte
+}
+
+type ImportFormat string
+
+const (
+	ImportFormatSlack ImportFormat = "slack"
+	ImportFormatJSON  ImportFormat = "json"
+)
+
+type ImportStatus string
+
+const (
+	ImportStatusPending   ImportStatus = "pending"
+	ImportStatusRunning   ImportStatus = "running"
+	ImportStatusCompleted ImportStatus = "completed"
+	ImportStatusFailed    ImportStatus = "failed"
+)
+
+// Import is an import of chat history exported from another chat system.
+// CompletedRooms holds the import keys of the rooms that were fully imported.
+type Import struct {
+	ID               int
+	Format           ImportFormat
+	FilePath         string
+	UserID           string
+	Status           ImportStatus
+	RoomsCreated     int
+	MessagesImported int
+	MessagesSkipped  int
+	CompletedRooms   []string
+	UnmappedUsers    []UnmappedUser
+	Error            string
+	CreatedAt        time.Time
+	CompletedAt      *time.Time
+}
+
+// UnmappedUser is an author of imported messages without a matching user.
+type UnmappedUser struct {
+	Key      string
+	Name     string
+	Email    string
+	Messages int
+}
+
+type BotEventType string
+
+const (
+	BotEventMessage BotEventType = "message"
+	BotEventJoin    BotEventType = "join"
+	BotEventMention BotEventType = "mention"
+)
+
+// BotSubscription registers the callback that receives the events of a room for a bot.
+type BotSubscription struct {
+	ID          int
+	BotID       string
+	BotName     string
+	RoomID      string
+	CallbackURL string
+	Secret      string
+	Events      []BotEventType
+	CreatedAt   time.Time
+}
+
+// Wants reports whether the subscription asked for the event type.
+func (s BotSubscription) Wants(event BotEventType) bool {
+	for _, e := range s.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// BotEvent is a room event as delivered to bot callbacks.
+type BotEvent struct {
+	Type      BotEventType
+	RoomID    string
+	User      User
+	Message   *Message
+	CreatedAt time.Time
+}
+
+type BotDeliveryStatus string
+
+const (
+	BotDeliveryPending   BotDeliveryStatus = "pending"
+	BotDeliveryDelivered BotDeliveryStatus = "delivered"
+	BotDeliveryFailed    BotDeliveryStatus = "failed"
+)
+
+// BotDelivery is a single attempt series of delivering an event to a subscription.
+type BotDelivery struct {
+	ID             int
+	SubscriptionID int
+	Event          BotEventType
+	Payload        string
+	Status         BotDeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      string
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// Webhook is an incoming webhook that posts messages into a room.
+// Token is only set when the webhook is created; afterwards only its hash is known.
+type Webhook struct {
+	ID         int
+	RoomID     string
+	Name       string
+	CreatedBy  string
+	Token      string
+	TokenHash  string
+	IsRevoked  bool
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+}
+
+type ScheduledMessageStatus string
+
+const (
+	ScheduledMessagePending  ScheduledMessageStatus = "pending"
+	ScheduledMessageSent     ScheduledMessageStatus = "sent"
+	ScheduledMessageCanceled ScheduledMessageStatus = "canceled"
+)
+
+// ScheduledMessage is a message written now and posted to its room at SendAt.
+// MessageID is set once the message has been posted.
+type ScheduledMessage struct {
+	ID        int
+	RoomID    string
+	UserID    string
+	Username  string
+	Content   string
+	SendAt    time.Time
+	TimeZone  string
+	Status    ScheduledMessageStatus
+	MessageID *int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	SentAt    *time.Time
+}
+
+// ScheduledMessageFilter selects the scheduled messages of a user. Empty fields match everything.
+type ScheduledMessageFilter struct {
+	UserID string
+	RoomID string
+	Status ScheduledMessageStatus
+}
+
+// PinnedMessage is a message pinned in its room by a moderator.
+type PinnedMessage struct {
+	ID        int
+	RoomID    string
+	MessageID int
+	PinnedBy  string
+	PinnedAt  time.Time
+	Message   Message
+}
+
+// SavedMessage is a private bookmark of a user on a message of any room.
+type SavedMessage struct {
+	ID        int
+	UserID    string
+	MessageID int
+	SavedAt   time.Time
+	Message   Message
+}
+
+// Poll is a question posted to a room as a message. Votes holds one entry per chosen
+// option; a poll without ClosesAt stays open until it is closed by hand.
+type Poll struct {
+	ID               int
+	RoomID           string
+	MessageID        int
+	Question         string
+	Options          []string
+	Multiple         bool
+	Anonymous        bool
+	CreatedBy        string
+	CreatedByName    string
+	ClosesAt         *time.Time
+	ClosedAt         *time.Time
+	SummaryMessageID *int
+	CreatedAt        time.Time
+	Votes            []PollVote
+}
+
+// PollVote is the vote of a user for one option of a poll.
+type PollVote struct {
+	PollID   int
+	UserID   string
+	Username string
+	Option   int
+}
+
+// PollTally is the result of a poll option. Voters is left empty for anonymous polls.
+type PollTally struct {
+	Option string
+	Votes  int
+	Voters []string
+}
+
+// IsOpen reports whether the poll still accepts votes at now.
+func (p Poll) IsOpen(now time.Time) bool {
+	return p.ClosedAt == nil && (p.ClosesAt == nil || now.Before(*p.ClosesAt))
+}
+
+// Tally counts the votes of every option and the number of distinct voters.
+func (p Poll) Tally() ([]PollTally, int) {
+	tallies := make([]PollTally, len(p.Options))
+	for i, option := range p.Options {
+		tallies[i].Option = option
+	}
+
+	voters := make(map[string]bool)
+	for _, vote := range p.Votes {
+		if vote.Option < 0 || vote.Option >= len(tallies) {
+			continue
+		}
+		tallies[vote.Option].Votes++
+		if !p.Anonymous {
+			tallies[vote.Option].Voters = append(tallies[vote.Option].Voters, vote.Username)
+		}
+		voters[vote.UserID] = true
+	}
+
+	return tallies, len(voters)
+}
+
+// Summary returns the results of the poll as the text of a chat message.
+func (p Poll) Summary() string {
+	tallies, voters := p.Tally()
+
+	results := make([]string, 0, len(tallies))
+	for _, tally := range tallies {
+		results = append(results, fmt.Sprintf("%s: %d", tally.Option, tally.Votes))
+	}
+	return fmt.Sprintf("Poll closed: %s (%s; %d voters)", p.Question, strings.Join(results, ", "), voters)
+}
+
+// Call is a voice or video call of a room. The media flows between the participants;
+// the service only relays their signaling and keeps track of who is in the call.
+type Call struct {
+	ID            int
+	RoomID        string
+	StartedBy     string
+	StartedByName string
+	StartedAt     time.Time
+	EndedAt       *time.Time
+	Participants  []CallParticipant
+}
+
+// CallParticipant is one stay of a user in a call. LeftAt is nil while they are in it.
+type CallParticipant struct {
+	ID       int
+	CallID   int
+	UserID   string
+	Username string
+	JoinedAt time.Time
+	LeftAt   *time.Time
+}
+
+type CallFilter struct {
+	RoomID string
+	Active bool // only the calls that have not ended
+	Limit  int
+}
+
+// InCall reports whether the user is currently a participant of the call.
+func (c Call) InCall(userID string) bool {
+	for _, participant := range c.Active() {
+		if participant.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// Active returns the participants that are currently in the call.
+func (c Call) Active() []CallParticipant {
+	var active []CallParticipant
+	for _, participant := range c.Participants {
+		if participant.LeftAt == nil {
+			active = append(active, participant)
+		}
+	}
+	return active
+}
+
+// CallSignalKind is the kind of a WebRTC signaling message.
+type CallSignalKind string
+
+const (
+	CallSignalOffer     CallSignalKind = "offer"
+	CallSignalAnswer    CallSignalKind = "answer"
+	CallSignalCandidate CallSignalKind = "candidate"
+)
+
+// CallSignal is an SDP offer or answer or an ICE candidate sent by a participant of a call to another one.
+// The service relays SDP and Candidate as they are.
+type CallSignal struct {
+	CallID    int
+	Kind      CallSignalKind
+	To        string
+	SDP       string
+	Candidate string
+}
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
 )
@@ -9,6 +10,96 @@ import (
 type IChatRepository interface {
 	AddRoom(ctx context.Context, chat domain.Chat) (domain.Chat, error)
 	GetRooms(ctx context.Context) ([]domain.Chat, error)
+	SearchRooms(ctx context.Context, filter domain.RoomFilter) ([]domain.RoomSummary, int, error)
+	GetRoomByID(ctx context.Context, chat domain.Chat) (domain.Chat, error)
+	UpdateRoom(ctx context.Context, chat domain.Chat) (domain.Chat, error)
+	DeleteRoom(ctx context.Context, chat domain.Chat) error
 	AddMessage(ctx context.Context, message domain.Chat) (domain.Chat, error)
 	GetMessagesByRoomID(ctx context.Context, chat domain.Chat) ([]domain.Chat, error)
+
+	// Retention
+	UpdateRoomRetention(ctx context.Context, chat domain.Chat) (domain.Chat, error)
+	GetMessageOverflowID(ctx context.Context, roomID string, keep int) (int, error)
+	GetPurgeCandidates(ctx context.Context, filter domain.PurgeFilter) ([]domain.Message, error)
+	DeleteMessages(ctx context.Context, ids []int) (int, error)
+	ArchiveMessages(ctx context.Context, ids []int) (int, error)
+	AddPurge(ctx context.Context, purge domain.Purge) (domain.Purge, error)
+	GetPurges(ctx context.Context, roomID string) ([]domain.Purge, error)
+
+	// Members
+	AddRoomMember(ctx context.Context, chat domain.Chat) error
+	IsRoomMember(ctx context.Context, chat domain.Chat) (bool, error)
+
+	// Export
+	CountMessages(ctx context.Context, filter domain.MessageFilter) (int, error)
+	GetMessages(ctx context.Context, filter domain.MessageFilter) ([]domain.Message, error)
+	GetLatestMessages(ctx context.Context, filter domain.MessageFilter) ([]domain.Message, error)
+	AddExport(ctx context.Context, export domain.Export) (domain.Export, error)
+	GetExportByID(ctx context.Context, id int) (domain.Export, error)
+	GetDueExportIDs(ctx context.Context, limit int) ([]int, error)
+	ClaimExport(ctx context.Context, id int, leaseUntil time.Time) (bool, error)
+	RenewExportLease(ctx context.Context, export domain.Export, leaseUntil time.Time) (bool, error)
+	AddExportChunk(ctx context.Context, chunk domain.ExportChunk) error
+	GetExportChunk(ctx context.Context, exportID int, seq int) (domain.ExportChunk, error)
+	UpdateExport(ctx context.Context, export domain.Export) (domain.Export, error)
+
+	// Import
+	AddImportedRoom(ctx context.Context, chat domain.Chat) (domain.Chat, bool, error)
+	AddImportedMessages(ctx context.Context, messages []domain.Message) (int, error)
+	AddImport(ctx context.Context, imp domain.Import) (domain.Import, error)
+	GetImportByID(ctx context.Context, id int) (domain.Import, error)
+	ClaimImport(ctx context.Context, id int) (bool, error)
+	UpdateImport(ctx context.Context, imp domain.Import) (domain.Import, error)
+
+	// Bots
+	AddBotSubscription(ctx context.Context, subscription domain.BotSubscription) (domain.BotSubscription, error)
+	GetBotSubscriptionByID(ctx context.Context, id int) (domain.BotSubscription, error)
+	GetBotSubscriptionsByBotID(ctx context.Context, botID string) ([]domain.BotSubscription, error)
+	GetBotSubscriptionsByRoomID(ctx context.Context, roomID string) ([]domain.BotSubscription, error)
+	DeleteBotSubscription(ctx context.Context, id int) error
+	AddBotDeliveries(ctx context.Context, deliveries []domain.BotDelivery) error
+	GetDueBotDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.BotDelivery, error)
+	ClaimBotDelivery(ctx context.Context, delivery domain.BotDelivery, leaseUntil time.Time) (bool, error)
+	UpdateBotDelivery(ctx context.Context, delivery domain.BotDelivery) error
+
+	// Webhooks
+	AddWebhook(ctx context.Context, webhook domain.Webhook) (domain.Webhook, error)
+	GetWebhookByID(ctx context.Context, id int) (domain.Webhook, error)
+	GetWebhookByTokenHash(ctx context.Context, tokenHash string) (domain.Webhook, error)
+	GetWebhooksByRoomID(ctx context.Context, roomID string) ([]domain.Webhook, error)
+	RevokeWebhook(ctx context.Context, id int) (domain.Webhook, error)
+	TouchWebhook(ctx context.Context, id int, usedAt time.Time) error
+
+	// Scheduled messages
+	AddScheduledMessage(ctx context.Context, scheduledMessage domain.ScheduledMessage) (domain.ScheduledMessage, error)
+	GetScheduledMessageByID(ctx context.Context, id int) (domain.ScheduledMessage, error)
+	GetScheduledMessages(ctx context.Context, filter domain.ScheduledMessageFilter) ([]domain.ScheduledMessage, error)
+	UpdateScheduledMessage(ctx context.Context, scheduledMessage domain.ScheduledMessage) (domain.ScheduledMessage, error)
+	CancelScheduledMessage(ctx context.Context, id int) (domain.ScheduledMessage, error)
+	GetDueScheduledMessages(ctx context.Context, now time.Time, limit int) ([]domain.ScheduledMessage, error)
+	SendScheduledMessage(ctx context.Context, scheduledMessage domain.ScheduledMessage, sentAt time.Time) (domain.Message, bool, error)
+
+	// Pinned and saved messages
+	GetMessageByID(ctx context.Context, id int) (domain.Message, error)
+	AddPinnedMessage(ctx context.Context, pin domain.PinnedMessage) (domain.PinnedMessage, error)
+	DeletePinnedMessage(ctx context.Context, messageID int) error
+	GetPinnedMessages(ctx context.Context, roomID string) ([]domain.PinnedMessage, error)
+	AddSavedMessage(ctx context.Context, saved domain.SavedMessage) (domain.SavedMessage, error)
+	DeleteSavedMessage(ctx context.Context, userID string, messageID int) error
+	GetSavedMessages(ctx context.Context, userID, roomID string) ([]domain.SavedMessage, error)
+
+	// Polls
+	AddPoll(ctx context.Context, poll domain.Poll) (domain.Poll, error)
+	GetPollByID(ctx context.Context, id int) (domain.Poll, error)
+	SetPollVotes(ctx context.Context, pollID int, user domain.User, options []int, now time.Time) error
+	GetDuePolls(ctx context.Context, now time.Time, limit int) ([]domain.Poll, error)
+	ClosePoll(ctx context.Context, id int, closedAt time.Time) (domain.Poll, domain.Message, bool, error)
+
+	// Calls
+	AddCall(ctx context.Context, call domain.Call) (domain.Call, error)
+	GetCallByID(ctx context.Context, id int) (domain.Call, error)
+	GetCalls(ctx context.Context, filter domain.CallFilter) ([]domain.Call, error)
+	AddCallParticipant(ctx context.Context, callID int, user domain.User, now time.Time) (domain.Call, bool, error)
+	RemoveCallParticipant(ctx context.Context, callID int, userID string, now time.Time) (domain.Call, bool, bool, error)
+	EndCall(ctx context.Context, callID int, now time.Time) (domain.Call, bool, error)
 }
@@ -0,0 +1,390 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	mrand "math/rand"
+	"strings"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/callback"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+	"go.uber.org/zap"
+)
+
+// SubscribeBot registers a callback for the events of a room and makes the bot a member of it.
+// The returned subscription carries the signing secret, which is not shown again.
+func (uc *ChatUseCase) SubscribeBot(ctx context.Context, subscription domain.BotSubscription) (domain.BotSubscription, error) {
+	bot, err := uc.verifyBot(ctx)
+	if err != nil {
+		return domain.BotSubscription{}, err
+	}
+
+	if err := uc.validateCallbackURL(subscription.CallbackURL); err != nil {
+		return domain.BotSubscription{}, err
+	}
+
+	if len(subscription.Events) == 0 {
+		subscription.Events = []domain.BotEventType{domain.BotEventMessage}
+	}
+	for _, event := range subscription.Events {
+		switch event {
+		case domain.BotEventMessage, domain.BotEventJoin, domain.BotEventMention:
+		default:
+			return domain.BotSubscription{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("unsupported event %q", event))
+		}
+	}
+
+	room, err := uc.chatRepository.GetRoomByID(ctx, domain.Chat{Room: domain.Room{ID: subscription.RoomID}})
+	if err != nil {
+		return domain.BotSubscription{}, err
+	}
+
+	secret, err := randomSecret()
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error generating bot secret", zap.Error(err))
+		return domain.BotSubscription{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	subscription.BotID = bot.ID
+	subscription.BotName = bot.Username
+	subscription.RoomID = room.Room.ID
+	subscription.Secret = secret
+
+	createdSubscription, err := uc.chatRepository.AddBotSubscription(ctx, subscription)
+	if err != nil {
+		return domain.BotSubscription{}, err
+	}
+
+	if err := uc.chatRepository.AddRoomMember(ctx, domain.Chat{Room: room.Room, User: bot}); err != nil {
+		uc.logger.Ctx(ctx).Error("error adding bot to room", zap.Error(err))
+	}
+
+	return createdSubscription, nil
+}
+
+// GetBotSubscriptions returns the subscriptions of the calling bot without their secrets.
+func (uc *ChatUseCase) GetBotSubscriptions(ctx context.Context) ([]domain.BotSubscription, error) {
+	bot, err := uc.verifyBot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := uc.chatRepository.GetBotSubscriptionsByBotID(ctx, bot.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range subscriptions {
+		subscriptions[i].Secret = ""
+	}
+	return subscriptions, nil
+}
+
+func (uc *ChatUseCase) UnsubscribeBot(ctx context.Context, id int) error {
+	bot, err := uc.verifyBot(ctx)
+	if err != nil {
+		return err
+	}
+
+	subscription, err := uc.chatRepository.GetBotSubscriptionByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if subscription.BotID != bot.ID {
+		return errors.NewError(errors.ErrorNotFound, fmt.Errorf("bot subscription not found"))
+	}
+
+	return uc.chatRepository.DeleteBotSubscription(ctx, id)
+}
+
+// SendBotMessage posts a message as the calling bot to a room it is a member of.
+func (uc *ChatUseCase) SendBotMessage(ctx context.Context, chat domain.Chat) (domain.Chat, error) {
+	bot, err := uc.verifyBot(ctx)
+	if err != nil {
+		return domain.Chat{}, err
+	}
+
+	if strings.TrimSpace(chat.Message.Content) == "" {
+		return domain.Chat{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("message content is required"))
+	}
+
+	if err := uc.verifyMember(ctx, chat.Room.ID, bot); err != nil {
+		return domain.Chat{}, err
+	}
+
+	createdMessage, err := uc.chatRepository.AddMessage(ctx, domain.Chat{
+		Message: domain.Message{
+			RoomID:   chat.Room.ID,
+			Username: bot.Username,
+			Content:  chat.Message.Content,
+			Bot:      true,
+		},
+	})
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error saving bot message", zap.Error(err))
+		return domain.Chat{}, err
+	}
+
+	uc.hub.Broadcast <- &ws.Message{
+		Content:  createdMessage.Message.Content,
+		RoomID:   createdMessage.Message.RoomID,
+		Username: createdMessage.Message.Username,
+		Bot:      true,
+	}
+
+	uc.publishMessageEvents(ctx, bot, createdMessage.Message)
+
+	return createdMessage, nil
+}
+
+// publishMessageEvents queues the message and mention events of a saved message.
+func (uc *ChatUseCase) publishMessageEvents(ctx context.Context, user domain.User, message domain.Message) {
+	event := domain.BotEvent{
+		Type:      domain.BotEventMessage,
+		RoomID:    message.RoomID,
+		User:      user,
+		Message:   &message,
+		CreatedAt: message.CreatedAt,
+	}
+	uc.publishBotEvent(ctx, event)
+
+	if strings.Contains(message.Content, "@") {
+		event.Type = domain.BotEventMention
+		uc.publishBotEvent(ctx, event)
+	}
+}
+
+// publishBotEvent queues the delivery of an event to every subscription of the room that wants it.
+// Bots never receive their own events, and mentions only go to the mentioned bot.
+// Failures are logged and never block the chat.
+func (uc *ChatUseCase) publishBotEvent(ctx context.Context, event domain.BotEvent) {
+	subscriptions, err := uc.chatRepository.GetBotSubscriptionsByRoomID(ctx, event.RoomID)
+	if err != nil || len(subscriptions) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(newBotEventPayload(event))
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error encoding bot event", zap.Error(err))
+		return
+	}
+
+	var deliveries []domain.BotDelivery
+	for _, subscription := range subscriptions {
+		if !subscription.Wants(event.Type) || subscription.BotID == event.User.ID {
+			continue
+		}
+		if event.Type == domain.BotEventMention && !mentions(event.Message.Content, subscription.BotName) {
+			continue
+		}
+
+		deliveries = append(deliveries, domain.BotDelivery{
+			SubscriptionID: subscription.ID,
+			Event:          event.Type,
+			Payload:        string(payload),
+		})
+	}
+
+	if err := uc.chatRepository.AddBotDeliveries(ctx, deliveries); err != nil {
+		uc.logger.Ctx(ctx).Error("error queueing bot deliveries", zap.Error(err))
+	}
+}
+
+// DeliverBotEvents sends the due bot deliveries. Each delivery is leased before it is sent
+// so that replicas running the job concurrently never send it twice at the same time.
+func (uc *ChatUseCase) DeliverBotEvents(ctx context.Context) error {
+	deliveries, err := uc.chatRepository.GetDueBotDeliveries(ctx, time.Now(), uc.config.Bots.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	subscriptions := make(map[int]domain.BotSubscription)
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		leaseUntil := time.Now().Add(2 * uc.config.Bots.Timeout)
+		claimed, err := uc.chatRepository.ClaimBotDelivery(ctx, delivery, leaseUntil)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			subscription, err = uc.chatRepository.GetBotSubscriptionByID(ctx, delivery.SubscriptionID)
+			if err != nil {
+				if errors.IsSvcError(err, errors.ErrorNotFound) {
+					delivery.Status = domain.BotDeliveryFailed
+					delivery.LastError = "subscription was removed"
+					uc.updateBotDelivery(ctx, delivery)
+					continue
+				}
+				return err
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+
+		uc.deliverBotEvent(ctx, subscription, delivery)
+	}
+
+	return nil
+}
+
+func (uc *ChatUseCase) deliverBotEvent(ctx context.Context, subscription domain.BotSubscription, delivery domain.BotDelivery) {
+	err := uc.callbackSender.Send(ctx, callback.Request{
+		URL:        subscription.CallbackURL,
+		Secret:     subscription.Secret,
+		Event:      string(delivery.Event),
+		DeliveryID: delivery.ID,
+		Body:       []byte(delivery.Payload),
+	})
+
+	now := time.Now()
+	delivery.Attempts++
+	if err == nil {
+		delivery.Status = domain.BotDeliveryDelivered
+		delivery.LastError = ""
+		delivery.NextAttemptAt = now
+		delivery.DeliveredAt = &now
+		uc.updateBotDelivery(ctx, delivery)
+		return
+	}
+
+	uc.logger.Ctx(ctx).Warn("bot delivery failed",
+		zap.Int("delivery_id", delivery.ID),
+		zap.String("callback_url", subscription.CallbackURL),
+		zap.Int("attempt", delivery.Attempts),
+		zap.Error(err),
+	)
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= uc.config.Bots.MaxAttempts {
+		delivery.Status = domain.BotDeliveryFailed
+		delivery.NextAttemptAt = now
+	} else {
+		delivery.NextAttemptAt = now.Add(uc.botBackoff(delivery.Attempts))
+	}
+	uc.updateBotDelivery(ctx, delivery)
+}
+
+func (uc *ChatUseCase) updateBotDelivery(ctx context.Context, delivery domain.BotDelivery) {
+	if err := uc.chatRepository.UpdateBotDelivery(ctx, delivery); err != nil {
+		uc.logger.Ctx(ctx).Error("error updating bot delivery", zap.Int("delivery_id", delivery.ID), zap.Error(err))
+	}
+}
+
+// botBackoff returns the delay before the next attempt: the initial backoff doubled
+// for every failed attempt, capped at the max backoff, with up to 20% jitter.
+func (uc *ChatUseCase) botBackoff(attempts int) time.Duration {
+	backoff := uc.config.Bots.InitialBackoff
+	for i := 1; i < attempts && backoff < uc.config.Bots.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > uc.config.Bots.MaxBackoff {
+		backoff = uc.config.Bots.MaxBackoff
+	}
+	return backoff + time.Duration(mrand.Int63n(int64(backoff)/5+1))
+}
+
+// verifyBot verifies the token from the context and makes sure it belongs to a bot.
+func (uc *ChatUseCase) verifyBot(ctx context.Context) (domain.User, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if !user.IsBot() {
+		return domain.User{}, errors.NewError(errors.ErrorForbidden, fmt.Errorf("only bots can use this endpoint"))
+	}
+
+	return user, nil
+}
+
+type botEventPayload struct {
+	Type      domain.BotEventType `json:"type"`
+	RoomID    string              `json:"roomId"`
+	User      botEventUser        `json:"user"`
+	Message   *botEventMessage    `json:"message,omitempty"`
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+type botEventUser struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	Bot      bool   `json:"bot"`
+}
+
+type botEventMessage struct {
+	ID        int       `json:"id"`
+	Username  string    `json:"username"`
+	Content   string    `json:"content"`
+	Bot       bool      `json:"bot"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func newBotEventPayload(event domain.BotEvent) botEventPayload {
+	payload := botEventPayload{
+		Type:   event.Type,
+		RoomID: event.RoomID,
+		User: botEventUser{
+			ID:       event.User.ID,
+			Username: event.User.Username,
+			Bot:      event.User.IsBot(),
+		},
+		CreatedAt: event.CreatedAt,
+	}
+	if event.Message != nil {
+		payload.Message = &botEventMessage{
+			ID:        event.Message.ID,
+			Username:  event.Message.Username,
+			Content:   event.Message.Content,
+			Bot:       event.Message.Bot,
+			CreatedAt: event.Message.CreatedAt,
+		}
+	}
+	return payload
+}
+
+// mentions reports whether content mentions name as "@name".
+func mentions(content, name string) bool {
+	mention := "@" + name
+	for {
+		i := strings.Index(content, mention)
+		if i < 0 {
+			return false
+		}
+		end := i + len(mention)
+		if end == len(content) || !isNameChar(content[end]) {
+			return true
+		}
+		content = content[end:]
+	}
+}
+
+func isNameChar(c byte) bool {
+	return c == '_' || c == '-' || c == '.' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func (uc *ChatUseCase) validateCallbackURL(callbackURL string) error {
+	if err := uc.callbackSender.ValidateURL(callbackURL); err != nil {
+		return errors.NewError(errors.ErrorBadRequest, err)
+	}
+	return nil
+}
+
+func randomSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
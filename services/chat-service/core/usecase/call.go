@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+	"go.uber.org/zap"
+)
+
+// CallAction starts, joins, leaves or ends a call of a room on behalf of the caller.
+// The call is started in roomID; for the other actions id is the call, which must
+// belong to roomID when roomID is set.
+func (uc *ChatUseCase) CallAction(ctx context.Context, roomID, action string, id int) (domain.Call, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.Call{}, err
+	}
+
+	return uc.callAction(ctx, user, roomID, action, id)
+}
+
+func (uc *ChatUseCase) callAction(ctx context.Context, user domain.User, roomID, action string, id int) (domain.Call, error) {
+	if action == ws.CallActionStart {
+		return uc.startCall(ctx, user, roomID)
+	}
+
+	call, err := uc.chatRepository.GetCallByID(ctx, id)
+	if err != nil {
+		return domain.Call{}, err
+	}
+	if roomID != "" && call.RoomID != roomID {
+		return domain.Call{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("call is not in this room"))
+	}
+
+	switch action {
+	case ws.CallActionJoin:
+		return uc.joinCall(ctx, user, call)
+	case ws.CallActionLeave:
+		return uc.leaveCall(ctx, user, call)
+	case ws.CallActionEnd:
+		return uc.endCall(ctx, user, call)
+	default:
+		return domain.Call{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("invalid call action %q", action))
+	}
+}
+
+// EndCall ends a call for everyone in it. Only the user who started the call and the
+// moderators of its room can end it.
+func (uc *ChatUseCase) EndCall(ctx context.Context, id int) (domain.Call, error) {
+	return uc.CallAction(ctx, "", ws.CallActionEnd, id)
+}
+
+// GetCalls returns the latest calls of a room to its members and moderators.
+func (uc *ChatUseCase) GetCalls(ctx context.Context, filter domain.CallFilter) ([]domain.Call, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.verifyReader(ctx, filter.RoomID, user); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = defaultHistoryLimit
+	case filter.Limit > maxHistoryLimit:
+		filter.Limit = maxHistoryLimit
+	}
+
+	return uc.chatRepository.GetCalls(ctx, filter)
+}
+
+// GetCall returns a call with everyone who has been in it.
+func (uc *ChatUseCase) GetCall(ctx context.Context, id int) (domain.Call, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.Call{}, err
+	}
+
+	call, err := uc.chatRepository.GetCallByID(ctx, id)
+	if err != nil {
+		return domain.Call{}, err
+	}
+
+	if err := uc.verifyReader(ctx, call.RoomID, user); err != nil {
+		return domain.Call{}, err
+	}
+
+	return call, nil
+}
+
+// RelaySignal sends an SDP offer or answer or an ICE candidate from the caller to
+// another participant of the same call.
+func (uc *ChatUseCase) RelaySignal(ctx context.Context, roomID string, signal domain.CallSignal) error {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	return uc.relaySignal(ctx, user, roomID, signal)
+}
+
+// relaySignal only delivers the signal to the connections of the recipient; the media
+// then flows between the peers and never through the service.
+func (uc *ChatUseCase) relaySignal(ctx context.Context, user domain.User, roomID string, signal domain.CallSignal) error {
+	switch signal.Kind {
+	case domain.CallSignalOffer, domain.CallSignalAnswer:
+		if signal.SDP == "" {
+			return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("sdp is required"))
+		}
+	case domain.CallSignalCandidate:
+		if signal.Candidate == "" {
+			return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("candidate is required"))
+		}
+	default:
+		return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("invalid signal kind %q", signal.Kind))
+	}
+	if signal.To == "" || signal.To == user.ID {
+		return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("signal needs another participant to send it to"))
+	}
+
+	call, err := uc.chatRepository.GetCallByID(ctx, signal.CallID)
+	if err != nil {
+		return err
+	}
+	if roomID != "" && call.RoomID != roomID {
+		return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("call is not in this room"))
+	}
+	if call.EndedAt != nil {
+		return errors.NewError(errors.ErrorConflict, fmt.Errorf("call has ended"))
+	}
+	if !call.InCall(user.ID) {
+		return errors.NewError(errors.ErrorForbidden, fmt.Errorf("user is not in the call"))
+	}
+	if !call.InCall(signal.To) {
+		return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("recipient is not in the call"))
+	}
+
+	uc.hub.Broadcast <- &ws.Message{
+		RoomID:   call.RoomID,
+		Username: user.Username,
+		Type:     ws.MessageTypeSignal,
+		Signal: &ws.Signal{
+			CallID:    call.ID,
+			Kind:      string(signal.Kind),
+			From:      user.ID,
+			FromName:  user.Username,
+			To:        signal.To,
+			SDP:       signal.SDP,
+			Candidate: signal.Candidate,
+		},
+		To: signal.To,
+	}
+
+	return nil
+}
+
+// startCall starts a call in a room the user may read, with the user in it.
+func (uc *ChatUseCase) startCall(ctx context.Context, user domain.User, roomID string) (domain.Call, error) {
+	if err := uc.verifyReader(ctx, roomID, user); err != nil {
+		return domain.Call{}, err
+	}
+
+	call, err := uc.chatRepository.AddCall(ctx, domain.Call{
+		RoomID:        roomID,
+		StartedBy:     user.ID,
+		StartedByName: user.Username,
+	})
+	if err != nil {
+		return domain.Call{}, err
+	}
+
+	uc.broadcastCall(call, ws.CallEventStarted, user)
+
+	return call, nil
+}
+
+func (uc *ChatUseCase) joinCall(ctx context.Context, user domain.User, call domain.Call) (domain.Call, error) {
+	if err := uc.verifyReader(ctx, call.RoomID, user); err != nil {
+		return domain.Call{}, err
+	}
+
+	call, joined, err := uc.chatRepository.AddCallParticipant(ctx, call.ID, user, time.Now())
+	if err != nil {
+		return domain.Call{}, err
+	}
+	if joined {
+		uc.broadcastCall(call, ws.CallEventJoined, user)
+	}
+
+	return call, nil
+}
+
+// leaveCall takes the user out of a call, which ends when the last participant leaves.
+func (uc *ChatUseCase) leaveCall(ctx context.Context, user domain.User, call domain.Call) (domain.Call, error) {
+	call, left, ended, err := uc.chatRepository.RemoveCallParticipant(ctx, call.ID, user.ID, time.Now())
+	if err != nil {
+		return domain.Call{}, err
+	}
+	if !left {
+		return domain.Call{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("user is not in the call"))
+	}
+
+	uc.broadcastCall(call, ws.CallEventLeft, user)
+	if ended {
+		uc.broadcastCall(call, ws.CallEventEnded, user)
+	}
+
+	return call, nil
+}
+
+func (uc *ChatUseCase) endCall(ctx context.Context, user domain.User, call domain.Call) (domain.Call, error) {
+	if call.StartedBy != user.ID && !user.IsAdmin() {
+		room, err := uc.chatRepository.GetRoomByID(ctx, domain.Chat{Room: domain.Room{ID: call.RoomID}})
+		if err != nil {
+			return domain.Call{}, err
+		}
+		if room.Room.OwnerID != user.ID {
+			return domain.Call{}, errors.NewError(errors.ErrorForbidden, fmt.Errorf("only the user who started the call or a moderator can end it"))
+		}
+	}
+
+	call, ended, err := uc.chatRepository.EndCall(ctx, call.ID, time.Now())
+	if err != nil {
+		return domain.Call{}, err
+	}
+	if !ended {
+		return domain.Call{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("call has ended"))
+	}
+
+	uc.broadcastCall(call, ws.CallEventEnded, user)
+
+	return call, nil
+}
+
+// leaveCallOnDisconnect takes a user out of the call of a room once they have no
+// connection to the room left, so that a closed tab does not keep them in the call.
+func (uc *ChatUseCase) leaveCallOnDisconnect(ctx context.Context, user domain.User, roomID string) {
+	if uc.hub.IsConnected(roomID, user.ID) {
+		return
+	}
+
+	calls, err := uc.chatRepository.GetCalls(ctx, domain.CallFilter{RoomID: roomID, Active: true, Limit: 1})
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error getting calls", zap.Error(err))
+		return
+	}
+
+	for _, call := range calls {
+		if !call.InCall(user.ID) {
+			continue
+		}
+		if _, err := uc.leaveCall(ctx, user, call); err != nil {
+			uc.logger.Ctx(ctx).Error("error leaving call", zap.Error(err))
+		}
+	}
+}
+
+// broadcastCall tells the room about a call event with the participants currently in the call.
+func (uc *ChatUseCase) broadcastCall(call domain.Call, event string, by domain.User) {
+	active := call.Active()
+	participants := make([]ws.CallParticipant, 0, len(active))
+	for _, participant := range active {
+		participants = append(participants, ws.CallParticipant{
+			ID:       participant.UserID,
+			Username: participant.Username,
+		})
+	}
+
+	var content string
+	switch event {
+	case ws.CallEventStarted:
+		content = by.Username + " started a call"
+	case ws.CallEventJoined:
+		content = by.Username + " joined the call"
+	case ws.CallEventLeft:
+		content = by.Username + " left the call"
+	case ws.CallEventEnded:
+		content = "The call has ended"
+	}
+
+	startedAt := call.StartedAt
+	uc.hub.Broadcast <- &ws.Message{
+		Content:  content,
+		RoomID:   call.RoomID,
+		Username: by.Username,
+		Type:     ws.MessageTypeCall,
+		Call: &ws.Call{
+			ID:           call.ID,
+			Action:       event,
+			By:           by.Username,
+			StartedBy:    call.StartedByName,
+			Participants: participants,
+			StartedAt:    &startedAt,
+			EndedAt:      call.EndedAt,
+		},
+	}
+}
@@ -0,0 +1,441 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/ports"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+	"go.uber.org/zap"
+)
+
+const testRoomID = "room-1"
+
+var (
+	alice = domain.User{ID: "user-alice", Username: "alice"}
+	bob   = domain.User{ID: "user-bob", Username: "bob"}
+	carol = domain.User{ID: "user-carol", Username: "carol"}
+)
+
+// callRepository keeps the members and calls of the test room in memory.
+// The methods the call flows don't use are left to the nil embedded interface.
+type callRepository struct {
+	ports.IChatRepository
+
+	mu      sync.Mutex
+	members map[string]bool
+	calls   map[int]*domain.Call
+	nextID  int
+}
+
+func newCallRepository(members ...domain.User) *callRepository {
+	r := &callRepository{members: make(map[string]bool), calls: make(map[int]*domain.Call)}
+	for _, member := range members {
+		r.members[member.ID] = true
+	}
+	return r
+}
+
+func (r *callRepository) GetRoomByID(ctx context.Context, chat domain.Chat) (domain.Chat, error) {
+	if chat.Room.ID != testRoomID {
+		return domain.Chat{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("room not found"))
+	}
+	return domain.Chat{Room: domain.Room{ID: testRoomID, Name: "test"}}, nil
+}
+
+func (r *callRepository) IsRoomMember(ctx context.Context, chat domain.Chat) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return chat.Room.ID == testRoomID && r.members[chat.User.ID], nil
+}
+
+func (r *callRepository) AddCall(ctx context.Context, call domain.Call) (domain.Call, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.calls {
+		if existing.RoomID == call.RoomID && existing.EndedAt == nil {
+			return domain.Call{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("room already has a call"))
+		}
+	}
+
+	r.nextID++
+	now := time.Now()
+	call.ID = r.nextID
+	call.StartedAt = now
+	call.Participants = []domain.CallParticipant{{CallID: call.ID, UserID: call.StartedBy, Username: call.StartedByName, JoinedAt: now}}
+	r.calls[call.ID] = &call
+	return copyCall(call), nil
+}
+
+func (r *callRepository) GetCallByID(ctx context.Context, id int) (domain.Call, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	call, ok := r.calls[id]
+	if !ok {
+		return domain.Call{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("call not found"))
+	}
+	return copyCall(*call), nil
+}
+
+func (r *callRepository) GetCalls(ctx context.Context, filter domain.CallFilter) ([]domain.Call, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []domain.Call
+	for _, call := range r.calls {
+		if call.RoomID == filter.RoomID && (!filter.Active || call.EndedAt == nil) {
+			res = append(res, copyCall(*call))
+		}
+	}
+	return res, nil
+}
+
+func (r *callRepository) AddCallParticipant(ctx context.Context, callID int, user domain.User, now time.Time) (domain.Call, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	call, err := r.openCall(callID)
+	if err != nil {
+		return domain.Call{}, false, err
+	}
+	if call.InCall(user.ID) {
+		return copyCall(*call), false, nil
+	}
+	call.Participants = append(call.Participants, domain.CallParticipant{CallID: callID, UserID: user.ID, Username: user.Username, JoinedAt: now})
+	return copyCall(*call), true, nil
+}
+
+func (r *callRepository) RemoveCallParticipant(ctx context.Context, callID int, userID string, now time.Time) (domain.Call, bool, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	call, err := r.openCall(callID)
+	if err != nil {
+		return domain.Call{}, false, false, err
+	}
+
+	left := false
+	for i := range call.Participants {
+		if call.Participants[i].UserID == userID && call.Participants[i].LeftAt == nil {
+			call.Participants[i].LeftAt = &now
+			left = true
+		}
+	}
+	ended := len(call.Active()) == 0
+	if ended {
+		call.EndedAt = &now
+	}
+	return copyCall(*call), left, ended, nil
+}
+
+func (r *callRepository) EndCall(ctx context.Context, callID int, now time.Time) (domain.Call, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	call, err := r.openCall(callID)
+	if err != nil {
+		return domain.Call{}, false, err
+	}
+	for i := range call.Participants {
+		if call.Participants[i].LeftAt == nil {
+			call.Participants[i].LeftAt = &now
+		}
+	}
+	call.EndedAt = &now
+	return copyCall(*call), true, nil
+}
+
+// openCall returns a call that has not ended; r.mu must be held.
+func (r *callRepository) openCall(callID int) (*domain.Call, error) {
+	call, ok := r.calls[callID]
+	if !ok {
+		return nil, errors.NewError(errors.ErrorNotFound, fmt.Errorf("call not found"))
+	}
+	if call.EndedAt != nil {
+		return nil, errors.NewError(errors.ErrorConflict, fmt.Errorf("call has ended"))
+	}
+	return call, nil
+}
+
+func copyCall(call domain.Call) domain.Call {
+	call.Participants = append([]domain.CallParticipant(nil), call.Participants...)
+	return call
+}
+
+// peer is a simulated connection of a user to the test room. It is attached to the
+// hub like the sessions of the HTTP transports and sends its frames the way the
+// WebSocket read loop does.
+type peer struct {
+	t      *testing.T
+	uc     *ChatUseCase
+	user   domain.User
+	client *ws.Client
+}
+
+func newCallUseCase(t *testing.T, repository ports.IChatRepository) *ChatUseCase {
+	t.Helper()
+	hub := ws.NewHub()
+	go hub.Run()
+	return NewChatUseCase(repository, nil, nil, &logger.Logger{Logger: zap.NewNop()}, &configs.Config{}, hub, nil)
+}
+
+func connect(t *testing.T, uc *ChatUseCase, user domain.User) *peer {
+	t.Helper()
+	client := &ws.Client{
+		Message:   make(chan *ws.Message, 64),
+		ID:        user.ID,
+		RoomID:    testRoomID,
+		Username:  user.Username,
+		Transport: ws.TransportWebSocket,
+	}
+	uc.hub.Register <- client
+	return &peer{t: t, uc: uc, user: user, client: client}
+}
+
+func (p *peer) send(msg *ws.Message) error {
+	msg.RoomID = p.client.RoomID
+	msg.Username = p.client.Username
+	return p.uc.handleClientMessage(context.Background(), p.user, p.client.RoomID, msg)
+}
+
+func (p *peer) call(action string, id int) error {
+	return p.send(&ws.Message{Type: ws.MessageTypeCall, Call: &ws.Call{Action: action, ID: id}})
+}
+
+func (p *peer) signal(signal ws.Signal) error {
+	return p.send(&ws.Message{Type: ws.MessageTypeSignal, Signal: &signal})
+}
+
+// expect waits for the next message of the peer that matches, skipping the others.
+func (p *peer) expect(match func(*ws.Message) bool) *ws.Message {
+	p.t.Helper()
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case msg, ok := <-p.client.Message:
+			if !ok {
+				p.t.Fatalf("%s was disconnected", p.user.Username)
+			}
+			if match(msg) {
+				return msg
+			}
+		case <-timeout:
+			p.t.Fatalf("%s got no matching message", p.user.Username)
+			return nil
+		}
+	}
+}
+
+// expectNone makes sure no message that matches reaches the peer for a while.
+func (p *peer) expectNone(match func(*ws.Message) bool) {
+	p.t.Helper()
+	timeout := time.After(100 * time.Millisecond)
+	for {
+		select {
+		case msg, ok := <-p.client.Message:
+			if ok && match(msg) {
+				p.t.Fatalf("%s got an unexpected %s message", p.user.Username, msg.Type)
+			}
+		case <-timeout:
+			return
+		}
+	}
+}
+
+// disconnect closes the connection the way the WebSocket read loop ends: the client is
+// unregistered, the hub closes its channel and the user leaves the call once they have
+// no connection left.
+func (p *peer) disconnect() {
+	p.uc.hub.Unregister <- p.client
+	for range p.client.Message {
+	}
+	p.uc.leaveCallOnDisconnect(context.Background(), p.user, p.client.RoomID)
+}
+
+func callEvent(event string, by domain.User) func(*ws.Message) bool {
+	return func(msg *ws.Message) bool {
+		return msg.Type == ws.MessageTypeCall && msg.Call.Action == event && msg.Call.By == by.Username
+	}
+}
+
+func isSignal(msg *ws.Message) bool {
+	return msg.Type == ws.MessageTypeSignal
+}
+
+func participants(msg *ws.Message) []string {
+	var res []string
+	for _, participant := range msg.Call.Participants {
+		res = append(res, participant.Username)
+	}
+	return res
+}
+
+func startCall(t *testing.T, p *peer) int {
+	t.Helper()
+	if err := p.call(ws.CallActionStart, 0); err != nil {
+		t.Fatalf("starting call: %v", err)
+	}
+	return p.expect(callEvent(ws.CallEventStarted, p.user)).Call.ID
+}
+
+func TestCallJoin(t *testing.T) {
+	uc := newCallUseCase(t, newCallRepository(alice, bob))
+	a, b, c := connect(t, uc, alice), connect(t, uc, bob), connect(t, uc, carol)
+
+	id := startCall(t, a)
+	b.expect(callEvent(ws.CallEventStarted, alice))
+
+	if err := b.call(ws.CallActionJoin, id); err != nil {
+		t.Fatalf("joining call: %v", err)
+	}
+	joined := a.expect(callEvent(ws.CallEventJoined, bob))
+	if got := fmt.Sprint(participants(joined)); got != "[alice bob]" {
+		t.Errorf("participants = %s, want [alice bob]", got)
+	}
+
+	// Joining again is a no-op and sends no event
+	if err := b.call(ws.CallActionJoin, id); err != nil {
+		t.Fatalf("joining call again: %v", err)
+	}
+	a.expectNone(callEvent(ws.CallEventJoined, bob))
+
+	// Carol is connected to the room but has never been a member of it
+	if err := c.call(ws.CallActionJoin, id); !errors.IsSvcError(err, errors.ErrorForbidden) {
+		t.Errorf("joining as a non-member: err = %v, want forbidden", err)
+	}
+}
+
+func TestFramesNeedWriteScope(t *testing.T) {
+	uc := newCallUseCase(t, newCallRepository(alice, bob))
+	a := connect(t, uc, alice)
+	reader := bob
+	reader.ClientID = "client-1"
+	reader.Scopes = []string{domain.ScopeChatRead}
+	b := connect(t, uc, reader)
+
+	id := startCall(t, a)
+
+	if err := b.call(ws.CallActionJoin, id); !errors.IsSvcError(err, errors.ErrorForbidden) {
+		t.Errorf("joining with chat:read: err = %v, want forbidden", err)
+	}
+	if err := b.signal(ws.Signal{CallID: id, Kind: string(domain.CallSignalOffer), To: alice.ID, SDP: "v=0"}); !errors.IsSvcError(err, errors.ErrorForbidden) {
+		t.Errorf("signaling with chat:read: err = %v, want forbidden", err)
+	}
+	if err := b.send(&ws.Message{Content: "hello"}); !errors.IsSvcError(err, errors.ErrorForbidden) {
+		t.Errorf("chatting with chat:read: err = %v, want forbidden", err)
+	}
+}
+
+func TestCallSignal(t *testing.T) {
+	uc := newCallUseCase(t, newCallRepository(alice, bob, carol))
+	a, b, c := connect(t, uc, alice), connect(t, uc, bob), connect(t, uc, carol)
+
+	id := startCall(t, a)
+	if err := b.call(ws.CallActionJoin, id); err != nil {
+		t.Fatalf("joining call: %v", err)
+	}
+
+	if err := a.signal(ws.Signal{CallID: id, Kind: string(domain.CallSignalOffer), To: bob.ID, SDP: "v=0"}); err != nil {
+		t.Fatalf("sending offer: %v", err)
+	}
+	offer := b.expect(isSignal).Signal
+	if offer.From != alice.ID || offer.FromName != alice.Username || offer.SDP != "v=0" {
+		t.Errorf("offer = %+v, want the SDP from alice", offer)
+	}
+
+	if err := b.signal(ws.Signal{CallID: id, Kind: string(domain.CallSignalCandidate), To: alice.ID, Candidate: "candidate:1"}); err != nil {
+		t.Fatalf("sending candidate: %v", err)
+	}
+	if candidate := a.expect(isSignal).Signal; candidate.From != bob.ID || candidate.Candidate != "candidate:1" {
+		t.Errorf("candidate = %+v, want the candidate from bob", candidate)
+	}
+
+	// Signals only reach their recipient
+	c.expectNone(isSignal)
+
+	// Carol is a member of the room but not in the call
+	if err := c.signal(ws.Signal{CallID: id, Kind: string(domain.CallSignalOffer), To: alice.ID, SDP: "v=0"}); !errors.IsSvcError(err, errors.ErrorForbidden) {
+		t.Errorf("signaling from outside the call: err = %v, want forbidden", err)
+	}
+	if err := a.signal(ws.Signal{CallID: id, Kind: string(domain.CallSignalOffer), To: carol.ID, SDP: "v=0"}); !errors.IsSvcError(err, errors.ErrorBadRequest) {
+		t.Errorf("signaling someone outside the call: err = %v, want bad request", err)
+	}
+	if err := a.signal(ws.Signal{CallID: id, Kind: string(domain.CallSignalOffer), To: bob.ID}); !errors.IsSvcError(err, errors.ErrorBadRequest) {
+		t.Errorf("sending an offer without SDP: err = %v, want bad request", err)
+	}
+}
+
+func TestCallLeave(t *testing.T) {
+	repository := newCallRepository(alice, bob)
+	uc := newCallUseCase(t, repository)
+	a, b := connect(t, uc, alice), connect(t, uc, bob)
+
+	id := startCall(t, a)
+	if err := b.call(ws.CallActionJoin, id); err != nil {
+		t.Fatalf("joining call: %v", err)
+	}
+
+	if err := b.call(ws.CallActionLeave, id); err != nil {
+		t.Fatalf("leaving call: %v", err)
+	}
+	left := a.expect(callEvent(ws.CallEventLeft, bob))
+	if got := fmt.Sprint(participants(left)); got != "[alice]" {
+		t.Errorf("participants = %s, want [alice]", got)
+	}
+	if err := b.call(ws.CallActionLeave, id); !errors.IsSvcError(err, errors.ErrorConflict) {
+		t.Errorf("leaving twice: err = %v, want conflict", err)
+	}
+
+	// The call ends with its last participant
+	if err := a.call(ws.CallActionLeave, id); err != nil {
+		t.Fatalf("leaving call: %v", err)
+	}
+	b.expect(callEvent(ws.CallEventLeft, alice))
+	b.expect(callEvent(ws.CallEventEnded, alice))
+
+	call, err := repository.GetCallByID(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if call.EndedAt == nil {
+		t.Error("call has not ended")
+	}
+	if err := b.call(ws.CallActionJoin, id); !errors.IsSvcError(err, errors.ErrorConflict) {
+		t.Errorf("joining an ended call: err = %v, want conflict", err)
+	}
+}
+
+func TestCallDisconnectCleanup(t *testing.T) {
+	repository := newCallRepository(alice, bob)
+	uc := newCallUseCase(t, repository)
+	a := connect(t, uc, alice)
+	b1, b2 := connect(t, uc, bob), connect(t, uc, bob)
+
+	id := startCall(t, a)
+	if err := b1.call(ws.CallActionJoin, id); err != nil {
+		t.Fatalf("joining call: %v", err)
+	}
+	a.expect(callEvent(ws.CallEventJoined, bob))
+
+	// Bob stays in the call while they have another connection to the room
+	b1.disconnect()
+	a.expectNone(callEvent(ws.CallEventLeft, bob))
+
+	b2.disconnect()
+	left := a.expect(callEvent(ws.CallEventLeft, bob))
+	if got := fmt.Sprint(participants(left)); got != "[alice]" {
+		t.Errorf("participants = %s, want [alice]", got)
+	}
+
+	a.disconnect()
+	call, err := repository.GetCallByID(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if call.EndedAt == nil || len(call.Active()) != 0 {
+		t.Errorf("call = %+v, want it ended with nobody in it", call)
+	}
+}
@@ -0,0 +1,302 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/transcript"
+	"go.uber.org/zap"
+)
+
+// ExportRoom prepares a transcript export of a room for one of its readers.
+// Exports with more messages than the sync limit are queued for the export job
+// and returned as pending; smaller ones are returned unsaved so that the caller
+// can stream them right away with WriteTranscript.
+func (uc *ChatUseCase) ExportRoom(ctx context.Context, export domain.Export) (domain.Export, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.Export{}, err
+	}
+
+	if err := uc.verifyReader(ctx, export.RoomID, user); err != nil {
+		return domain.Export{}, err
+	}
+
+	switch export.Format {
+	case domain.ExportFormatJSONL, domain.ExportFormatCSV, domain.ExportFormatHTML:
+	default:
+		return domain.Export{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("unsupported export format: %s", export.Format))
+	}
+
+	if export.From != nil && export.To != nil && !export.From.Before(*export.To) {
+		return domain.Export{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("export range start must be before its end"))
+	}
+
+	count, err := uc.chatRepository.CountMessages(ctx, domain.MessageFilter{
+		RoomID: export.RoomID,
+		From:   export.From,
+		To:     export.To,
+	})
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error counting messages", zap.Error(err))
+		return domain.Export{}, err
+	}
+
+	export.UserID = user.ID
+	export.MessageCount = count
+
+	if count <= uc.config.Export.SyncLimit {
+		export.Status = domain.ExportStatusRunning
+		return export, nil
+	}
+
+	createdExport, err := uc.chatRepository.AddExport(ctx, export)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error creating export", zap.Error(err))
+		return domain.Export{}, err
+	}
+
+	return createdExport, nil
+}
+
+// GetExport returns an export requested by the current user.
+func (uc *ChatUseCase) GetExport(ctx context.Context, export domain.Export) (domain.Export, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.Export{}, err
+	}
+
+	foundExport, err := uc.chatRepository.GetExportByID(ctx, export.ID)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error getting export", zap.Error(err))
+		return domain.Export{}, err
+	}
+
+	if foundExport.UserID != user.ID {
+		return domain.Export{}, errors.NewError(errors.ErrorForbidden, fmt.Errorf("export belongs to another user"))
+	}
+
+	return foundExport, nil
+}
+
+// WriteTranscript writes the messages selected by the export to w in batches and returns how many were written.
+func (uc *ChatUseCase) WriteTranscript(ctx context.Context, export domain.Export, w io.Writer) (int, error) {
+	room, err := uc.chatRepository.GetRoomByID(ctx, domain.Chat{Room: domain.Room{ID: export.RoomID}})
+	if err != nil {
+		return 0, err
+	}
+
+	writer, err := transcript.NewWriter(transcript.Format(export.Format), w, uc.views, transcript.Header{
+		RoomID:      room.Room.ID,
+		RoomName:    room.Room.Name,
+		From:        export.From,
+		To:          export.To,
+		GeneratedAt: time.Now(),
+	})
+	if err != nil {
+		return 0, errors.NewError(errors.ErrorBadRequest, err)
+	}
+
+	filter := domain.MessageFilter{
+		RoomID: export.RoomID,
+		From:   export.From,
+		To:     export.To,
+		Limit:  uc.config.Export.BatchSize,
+	}
+
+	written := 0
+	for {
+		messages, err := uc.chatRepository.GetMessages(ctx, filter)
+		if err != nil {
+			writer.Close()
+			return written, err
+		}
+
+		for _, message := range messages {
+			err := writer.WriteMessage(transcript.Message{
+				ID:        message.ID,
+				RoomID:    message.RoomID,
+				Username:  message.Username,
+				Content:   message.Content,
+				CreatedAt: message.CreatedAt,
+			})
+			if err != nil {
+				writer.Close()
+				return written, errors.NewError(errors.ErrorInternal, err)
+			}
+			written++
+		}
+
+		if len(messages) < filter.Limit {
+			break
+		}
+		filter.AfterID = messages[len(messages)-1].ID
+	}
+
+	if err := writer.Close(); err != nil {
+		return written, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	return written, nil
+}
+
+// exportChunkSize is the size of the chunks the transcripts of asynchronous exports are
+// stored in.
+const exportChunkSize = 1 << 20
+
+// RunPendingExports claims the due exports and stores the transcript of each of them.
+func (uc *ChatUseCase) RunPendingExports(ctx context.Context) error {
+	ids, err := uc.chatRepository.GetDueExportIDs(ctx, 10)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error getting due exports", zap.Error(err))
+		return err
+	}
+
+	for _, id := range ids {
+		claimed, err := uc.chatRepository.ClaimExport(ctx, id, uc.exportLeaseUntil())
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+
+		export, err := uc.chatRepository.GetExportByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		uc.runExport(ctx, export)
+	}
+
+	return nil
+}
+
+// exportLeaseUntil returns when a lease taken now ends. It is kept to the microsecond
+// precision of the database, so that the lease can be matched when it is renewed.
+func (uc *ChatUseCase) exportLeaseUntil() time.Time {
+	return time.Now().Add(uc.config.Export.Lease).Truncate(time.Microsecond)
+}
+
+func (uc *ChatUseCase) runExport(ctx context.Context, export domain.Export) {
+	w := &exportWriter{ctx: ctx, uc: uc, export: &export}
+	count, err := uc.WriteTranscript(ctx, export, w)
+	if err == nil {
+		err = w.flush()
+	}
+	if errors.IsSvcError(err, errors.ErrorConflict) {
+		uc.logger.Ctx(ctx).Warn("export was claimed by another worker", zap.Int("export_id", export.ID), zap.Error(err))
+		return
+	}
+
+	now := time.Now()
+	export.CompletedAt = &now
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error running export", zap.Int("export_id", export.ID), zap.String("room_id", export.RoomID), zap.Error(err))
+		export.Status = domain.ExportStatusFailed
+		export.Error = err.Error()
+	} else {
+		uc.logger.Ctx(ctx).Info("export finished", zap.Int("export_id", export.ID), zap.String("room_id", export.RoomID), zap.Int("messages", count))
+		export.Status = domain.ExportStatusCompleted
+		export.MessageCount = count
+	}
+
+	if _, err := uc.chatRepository.UpdateExport(ctx, export); err != nil {
+		uc.logger.Ctx(ctx).Error("error updating export", zap.Int("export_id", export.ID), zap.Error(err))
+	}
+}
+
+// exportWriter stores what is written to it as the chunks of a leased export. The lease
+// is renewed with every chunk; once another worker claimed the export, writing fails
+// with a conflict.
+type exportWriter struct {
+	ctx    context.Context
+	uc     *ChatUseCase
+	export *domain.Export
+	buf    []byte
+	seq    int
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(exportChunkSize-len(w.buf), len(p))
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		written += n
+
+		if len(w.buf) == exportChunkSize {
+			if err := w.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// flush stores the buffered data as the next chunk. The first chunk is stored even when
+// it is empty.
+func (w *exportWriter) flush() error {
+	if len(w.buf) == 0 && w.seq > 0 {
+		return nil
+	}
+
+	leaseUntil := w.uc.exportLeaseUntil()
+	renewed, err := w.uc.chatRepository.RenewExportLease(w.ctx, *w.export, leaseUntil)
+	if err != nil {
+		return err
+	}
+	if !renewed {
+		return errors.NewError(errors.ErrorConflict, fmt.Errorf("lease of the export expired"))
+	}
+	w.export.LeaseUntil = &leaseUntil
+
+	err = w.uc.chatRepository.AddExportChunk(w.ctx, domain.ExportChunk{
+		ExportID: w.export.ID,
+		Seq:      w.seq,
+		Data:     w.buf,
+	})
+	if err != nil {
+		return err
+	}
+	w.seq++
+	w.buf = w.buf[:0]
+	return nil
+}
+
+// WriteExport writes the stored transcript of a completed export to w.
+func (uc *ChatUseCase) WriteExport(ctx context.Context, export domain.Export, w io.Writer) error {
+	for seq := 0; ; seq++ {
+		chunk, err := uc.chatRepository.GetExportChunk(ctx, export.ID, seq)
+		if errors.IsSvcError(err, errors.ErrorNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(chunk.Data); err != nil {
+			return err
+		}
+	}
+}
+
+// verifyMember makes sure the user has joined the room before. Members are only
+// recorded from authenticated joins and bot subscriptions.
+func (uc *ChatUseCase) verifyMember(ctx context.Context, roomID string, user domain.User) error {
+	isMember, err := uc.chatRepository.IsRoomMember(ctx, domain.Chat{
+		Room: domain.Room{ID: roomID},
+		User: user,
+	})
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error checking room member", zap.Error(err))
+		return err
+	}
+	if !isMember {
+		return errors.NewError(errors.ErrorForbidden, fmt.Errorf("user is not a member of the room"))
+	}
+	return nil
+}
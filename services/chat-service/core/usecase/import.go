@@ -0,0 +1,393 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/importer"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+	"go.uber.org/zap"
+)
+
+// ImportHistory stores an uploaded export file and imports it in the background.
+func (uc *ChatUseCase) ImportHistory(ctx context.Context, imp domain.Import, file io.Reader) (domain.Import, error) {
+	user, err := uc.verifyAdmin(ctx)
+	if err != nil {
+		return domain.Import{}, err
+	}
+
+	imp.UserID = user.ID
+	createdImport, err := uc.CreateImport(ctx, imp, file)
+	if err != nil {
+		return domain.Import{}, err
+	}
+
+	return uc.startImport(ctx, createdImport.ID)
+}
+
+// ResumeImport continues a pending or failed import in the background.
+func (uc *ChatUseCase) ResumeImport(ctx context.Context, imp domain.Import) (domain.Import, error) {
+	if _, err := uc.verifyAdmin(ctx); err != nil {
+		return domain.Import{}, err
+	}
+
+	return uc.startImport(ctx, imp.ID)
+}
+
+// GetImport returns the progress of an import and, once it is done, its report.
+func (uc *ChatUseCase) GetImport(ctx context.Context, imp domain.Import) (domain.Import, error) {
+	if _, err := uc.verifyAdmin(ctx); err != nil {
+		return domain.Import{}, err
+	}
+
+	foundImport, err := uc.chatRepository.GetImportByID(ctx, imp.ID)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error getting import", zap.Error(err))
+		return domain.Import{}, err
+	}
+
+	return foundImport, nil
+}
+
+// CreateImport copies the export file into the import directory and records a pending import for it.
+func (uc *ChatUseCase) CreateImport(ctx context.Context, imp domain.Import, file io.Reader) (domain.Import, error) {
+	var extension string
+	switch imp.Format {
+	case domain.ImportFormatSlack:
+		extension = "zip"
+	case domain.ImportFormatJSON:
+		extension = "json"
+	default:
+		return domain.Import{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("unsupported import format: %s", imp.Format))
+	}
+
+	if err := os.MkdirAll(uc.config.Import.Dir, 0o755); err != nil {
+		uc.logger.Ctx(ctx).Error("error creating import directory", zap.Error(err))
+		return domain.Import{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	dst, err := os.CreateTemp(uc.config.Import.Dir, fmt.Sprintf("import-*.%s", extension))
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error creating import file", zap.Error(err))
+		return domain.Import{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	imp.FilePath = dst.Name()
+
+	_, err = io.Copy(dst, file)
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(imp.FilePath)
+		uc.logger.Ctx(ctx).Error("error writing import file", zap.Error(err))
+		return domain.Import{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	// Reject unreadable files right away instead of failing in the background
+	archive, err := importer.Open(importer.Format(imp.Format), imp.FilePath)
+	if err != nil {
+		os.Remove(imp.FilePath)
+		return domain.Import{}, errors.NewError(errors.ErrorBadRequest, err)
+	}
+	archive.Close()
+
+	createdImport, err := uc.chatRepository.AddImport(ctx, imp)
+	if err != nil {
+		os.Remove(imp.FilePath)
+		uc.logger.Ctx(ctx).Error("error creating import", zap.Error(err))
+		return domain.Import{}, err
+	}
+
+	return createdImport, nil
+}
+
+// RunImport claims an import and runs it until it completes or fails.
+func (uc *ChatUseCase) RunImport(ctx context.Context, imp domain.Import) (domain.Import, error) {
+	claimedImport, err := uc.claimImport(ctx, imp.ID)
+	if err != nil {
+		return domain.Import{}, err
+	}
+
+	return uc.runImport(ctx, claimedImport)
+}
+
+func (uc *ChatUseCase) startImport(ctx context.Context, id int) (domain.Import, error) {
+	claimedImport, err := uc.claimImport(ctx, id)
+	if err != nil {
+		return domain.Import{}, err
+	}
+
+	go func() {
+		if _, err := uc.runImport(context.Background(), claimedImport); err != nil {
+			uc.logger.Ctx(ctx).Error("error running import", zap.Int("import_id", claimedImport.ID), zap.Error(err))
+		}
+	}()
+
+	return claimedImport, nil
+}
+
+func (uc *ChatUseCase) claimImport(ctx context.Context, id int) (domain.Import, error) {
+	claimed, err := uc.chatRepository.ClaimImport(ctx, id)
+	if err != nil {
+		return domain.Import{}, err
+	}
+	if !claimed {
+		if _, err := uc.chatRepository.GetImportByID(ctx, id); err != nil {
+			return domain.Import{}, err
+		}
+		return domain.Import{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("import is already running or completed"))
+	}
+
+	return uc.chatRepository.GetImportByID(ctx, id)
+}
+
+// runImport imports the rooms of the archive one by one. Progress is saved after
+// every room, so a resumed import skips the rooms that were already completed;
+// messages of a partially imported room are recognized by their import keys.
+func (uc *ChatUseCase) runImport(ctx context.Context, imp domain.Import) (domain.Import, error) {
+	uc.logger.Ctx(ctx).Info("starting import", zap.Int("import_id", imp.ID), zap.String("format", string(imp.Format)), zap.String("file", imp.FilePath))
+
+	archive, err := importer.Open(importer.Format(imp.Format), imp.FilePath)
+	if err != nil {
+		return uc.failImport(imp, err)
+	}
+	defer archive.Close()
+
+	completed := make(map[string]bool, len(imp.CompletedRooms))
+	for _, key := range imp.CompletedRooms {
+		completed[key] = true
+	}
+
+	unmapped := make(map[string]*domain.UnmappedUser, len(imp.UnmappedUsers))
+	for _, user := range imp.UnmappedUsers {
+		user := user
+		unmapped[user.Key] = &user
+	}
+
+	resolver := &authorResolver{
+		uc:        uc,
+		usernames: make(map[string]string),
+	}
+
+	for _, room := range archive.Rooms() {
+		if completed[room.Key] {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return uc.failImport(imp, err)
+		}
+
+		// A failed room is imported again on resume, so only the created room is kept from its progress
+		checkpoint := imp
+		roomUnmapped, err := uc.importRoom(ctx, &imp, archive, room, resolver)
+		if err != nil {
+			checkpoint.RoomsCreated = imp.RoomsCreated
+			return uc.failImport(checkpoint, err)
+		}
+
+		// Unmapped authors are only counted once their room is complete, so that
+		// resuming a partially imported room does not count its messages twice
+		for key, user := range roomUnmapped {
+			if existing, ok := unmapped[key]; ok {
+				existing.Messages += user.Messages
+				continue
+			}
+			unmapped[key] = user
+		}
+		imp.UnmappedUsers = sortUnmappedUsers(unmapped)
+		imp.CompletedRooms = append(imp.CompletedRooms, room.Key)
+
+		if _, err := uc.chatRepository.UpdateImport(ctx, imp); err != nil {
+			return uc.failImport(imp, err)
+		}
+	}
+
+	now := time.Now()
+	imp.Status = domain.ImportStatusCompleted
+	imp.CompletedAt = &now
+	imp.Error = ""
+	updatedImport, err := uc.chatRepository.UpdateImport(ctx, imp)
+	if err != nil {
+		return domain.Import{}, err
+	}
+
+	uc.logger.Ctx(ctx).Info("import finished",
+		zap.Int("import_id", imp.ID),
+		zap.Int("rooms_created", imp.RoomsCreated),
+		zap.Int("messages_imported", imp.MessagesImported),
+		zap.Int("messages_skipped", imp.MessagesSkipped),
+		zap.Int("unmapped_users", len(imp.UnmappedUsers)),
+	)
+
+	return updatedImport, nil
+}
+
+func (uc *ChatUseCase) importRoom(ctx context.Context, imp *domain.Import, archive importer.Archive, room importer.Room, resolver *authorResolver) (map[string]*domain.UnmappedUser, error) {
+	chat, created, err := uc.chatRepository.AddImportedRoom(ctx, domain.Chat{
+		Room: domain.Room{
+			Name:      room.Name,
+			ImportKey: fmt.Sprintf("%s:%s", imp.Format, room.Key),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		imp.RoomsCreated++
+	}
+
+	uc.hub.Lock()
+	if _, ok := uc.hub.Rooms[chat.Room.ID]; !ok {
+		uc.hub.Rooms[chat.Room.ID] = &ws.Room{
+			ID:      chat.Room.ID,
+			Name:    chat.Room.Name,
+			Clients: make(map[string][]*ws.Client),
+		}
+	}
+	uc.hub.Unlock()
+
+	messages, err := archive.Messages(room)
+	if err != nil {
+		return nil, err
+	}
+
+	unmapped := make(map[string]*domain.UnmappedUser)
+	batch := make([]domain.Message, 0, uc.config.Import.BatchSize)
+	flush := func() error {
+		inserted, err := uc.chatRepository.AddImportedMessages(ctx, batch)
+		if err != nil {
+			return err
+		}
+		imp.MessagesImported += inserted
+		imp.MessagesSkipped += len(batch) - inserted
+		batch = batch[:0]
+		return nil
+	}
+
+	for _, message := range messages {
+		if message.Text == "" {
+			imp.MessagesSkipped++
+			continue
+		}
+
+		username, mapped, err := resolver.resolve(ctx, message.Author)
+		if err != nil {
+			return nil, err
+		}
+		if !mapped {
+			key := message.Author.Key()
+			if _, ok := unmapped[key]; !ok {
+				unmapped[key] = &domain.UnmappedUser{
+					Key:   key,
+					Name:  username,
+					Email: message.Author.Email,
+				}
+			}
+			unmapped[key].Messages++
+		}
+
+		batch = append(batch, domain.Message{
+			RoomID:    chat.Room.ID,
+			Username:  username,
+			Content:   message.Text,
+			CreatedAt: message.CreatedAt,
+			ImportKey: fmt.Sprintf("%s:%s:%s", imp.Format, room.Key, message.Key),
+		})
+		if len(batch) == uc.config.Import.BatchSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Ctx(ctx).Info("imported room", zap.Int("import_id", imp.ID), zap.String("room", room.Name), zap.Int("messages", len(messages)))
+
+	return unmapped, nil
+}
+
+func (uc *ChatUseCase) failImport(imp domain.Import, cause error) (domain.Import, error) {
+	uc.logger.Error("import failed", zap.Int("import_id", imp.ID), zap.Error(cause))
+
+	// The import context may be cancelled already, the failure still has to be saved
+	imp.Status = domain.ImportStatusFailed
+	imp.Error = cause.Error()
+	if _, err := uc.chatRepository.UpdateImport(context.Background(), imp); err != nil {
+		uc.logger.Error("error updating import", zap.Int("import_id", imp.ID), zap.Error(err))
+	}
+
+	return imp, cause
+}
+
+func sortUnmappedUsers(unmapped map[string]*domain.UnmappedUser) []domain.UnmappedUser {
+	users := make([]domain.UnmappedUser, 0, len(unmapped))
+	for _, user := range unmapped {
+		users = append(users, *user)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		if users[i].Messages != users[j].Messages {
+			return users[i].Messages > users[j].Messages
+		}
+		return users[i].Key < users[j].Key
+	})
+	return users
+}
+
+// importedAuthorPrefix is put in front of the names of authors that aren't mapped to a user.
+const importedAuthorPrefix = "imported:"
+
+// authorResolver maps export authors to user-management users, first by email
+// and then by username, and remembers the result for the rest of the import.
+type authorResolver struct {
+	uc        *ChatUseCase
+	usernames map[string]string
+}
+
+// resolve returns the username to store for the author and whether it belongs to a known user.
+// Unknown authors keep the name they had in the export behind importedAuthorPrefix, so
+// their messages can't be mistaken for those of a user with the same name.
+func (r *authorResolver) resolve(ctx context.Context, author importer.Author) (string, bool, error) {
+	key := author.Key()
+	if username, ok := r.usernames[key]; ok {
+		return r.fallback(author, username)
+	}
+
+	username := ""
+	if author.Email != "" {
+		user, err := r.uc.usersService.GetUserByEmail(ctx, domain.User{Email: author.Email})
+		if err == nil {
+			username = user.Username
+		} else if !errors.IsSvcError(err, errors.ErrorNotFound) {
+			return "", false, err
+		}
+	}
+	if username == "" && author.Username != "" {
+		user, err := r.uc.usersService.GetUserByUsername(ctx, domain.User{Username: author.Username})
+		if err == nil {
+			username = user.Username
+		} else if !errors.IsSvcError(err, errors.ErrorNotFound) {
+			return "", false, err
+		}
+	}
+
+	r.usernames[key] = username
+	return r.fallback(author, username)
+}
+
+func (r *authorResolver) fallback(author importer.Author, username string) (string, bool, error) {
+	if username != "" {
+		return username, true, nil
+	}
+	if name := author.DisplayName(); name != "" {
+		return importedAuthorPrefix + name, false, nil
+	}
+	return importedAuthorPrefix + "unknown", false, nil
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+)
+
+// PinMessage pins a message in its room and tells the room about it.
+// Only moderators of the room, its owner and the admins, can pin messages.
+func (uc *ChatUseCase) PinMessage(ctx context.Context, messageID int) (domain.PinnedMessage, error) {
+	message, err := uc.chatRepository.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return domain.PinnedMessage{}, err
+	}
+
+	user, err := uc.verifyRoomOwner(ctx, message.RoomID)
+	if err != nil {
+		return domain.PinnedMessage{}, err
+	}
+
+	pin, err := uc.chatRepository.AddPinnedMessage(ctx, domain.PinnedMessage{
+		RoomID:    message.RoomID,
+		MessageID: message.ID,
+		PinnedBy:  user.ID,
+		Message:   message,
+	})
+	if err != nil {
+		return domain.PinnedMessage{}, err
+	}
+
+	uc.hub.Broadcast <- &ws.Message{
+		Content:  user.Username + " pinned a message",
+		RoomID:   message.RoomID,
+		Username: user.Username,
+		Type:     ws.MessageTypePin,
+		Pin: &ws.Pin{
+			MessageID: message.ID,
+			Content:   message.Content,
+			Author:    message.Username,
+			By:        user.Username,
+		},
+	}
+
+	return pin, nil
+}
+
+// UnpinMessage removes the pin of a message and tells the room about it.
+func (uc *ChatUseCase) UnpinMessage(ctx context.Context, messageID int) error {
+	message, err := uc.chatRepository.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return err
+	}
+
+	user, err := uc.verifyRoomOwner(ctx, message.RoomID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.chatRepository.DeletePinnedMessage(ctx, message.ID); err != nil {
+		return err
+	}
+
+	uc.hub.Broadcast <- &ws.Message{
+		Content:  user.Username + " unpinned a message",
+		RoomID:   message.RoomID,
+		Username: user.Username,
+		Type:     ws.MessageTypeUnpin,
+		Pin: &ws.Pin{
+			MessageID: message.ID,
+			By:        user.Username,
+		},
+	}
+
+	return nil
+}
+
+// GetPinnedMessages returns the pinned messages of a room to its members and moderators.
+func (uc *ChatUseCase) GetPinnedMessages(ctx context.Context, roomID string) ([]domain.PinnedMessage, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.verifyReader(ctx, roomID, user); err != nil {
+		return nil, err
+	}
+
+	return uc.chatRepository.GetPinnedMessages(ctx, roomID)
+}
+
+// verifyReader makes sure the user may read a room: its members, its owner and the admins.
+func (uc *ChatUseCase) verifyReader(ctx context.Context, roomID string, user domain.User) error {
+	room, err := uc.chatRepository.GetRoomByID(ctx, domain.Chat{Room: domain.Room{ID: roomID}})
+	if err != nil {
+		return err
+	}
+
+	if room.Room.OwnerID == user.ID || user.IsAdmin() {
+		return nil
+	}
+	return uc.verifyMember(ctx, roomID, user)
+}
+
+// SaveMessage adds a message of a room the caller is a member of to their saved messages.
+func (uc *ChatUseCase) SaveMessage(ctx context.Context, messageID int) (domain.SavedMessage, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.SavedMessage{}, err
+	}
+
+	message, err := uc.chatRepository.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return domain.SavedMessage{}, err
+	}
+
+	if err := uc.verifyMember(ctx, message.RoomID, user); err != nil {
+		return domain.SavedMessage{}, err
+	}
+
+	return uc.chatRepository.AddSavedMessage(ctx, domain.SavedMessage{
+		UserID:    user.ID,
+		MessageID: message.ID,
+		Message:   message,
+	})
+}
+
+// UnsaveMessage removes a message from the saved messages of the caller.
+func (uc *ChatUseCase) UnsaveMessage(ctx context.Context, messageID int) error {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	return uc.chatRepository.DeleteSavedMessage(ctx, user.ID, messageID)
+}
+
+// GetSavedMessages returns the saved messages of the caller, optionally only those of one room.
+func (uc *ChatUseCase) GetSavedMessages(ctx context.Context, roomID string) ([]domain.SavedMessage, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.chatRepository.GetSavedMessages(ctx, user.ID, roomID)
+}
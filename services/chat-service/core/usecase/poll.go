@@ -0,0 +1,252 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+)
+
+// CreatePoll posts a poll to a room the caller is a member of.
+func (uc *ChatUseCase) CreatePoll(ctx context.Context, poll domain.Poll) (domain.Poll, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.Poll{}, err
+	}
+
+	if err := uc.verifyMember(ctx, poll.RoomID, user); err != nil {
+		return domain.Poll{}, err
+	}
+
+	if err := uc.validatePoll(&poll); err != nil {
+		return domain.Poll{}, err
+	}
+
+	poll.CreatedBy = user.ID
+	poll.CreatedByName = user.Username
+
+	createdPoll, err := uc.chatRepository.AddPoll(ctx, poll)
+	if err != nil {
+		return domain.Poll{}, err
+	}
+
+	uc.broadcastPoll(createdPoll, createdPoll.Question)
+
+	uc.publishMessageEvents(ctx, user, domain.Message{
+		ID:        createdPoll.MessageID,
+		RoomID:    createdPoll.RoomID,
+		Username:  createdPoll.CreatedByName,
+		Content:   createdPoll.Question,
+		CreatedAt: createdPoll.CreatedAt,
+	})
+
+	return createdPoll, nil
+}
+
+// GetPoll returns a poll with its votes to the readers of its room.
+func (uc *ChatUseCase) GetPoll(ctx context.Context, id int) (domain.Poll, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.Poll{}, err
+	}
+
+	poll, err := uc.chatRepository.GetPollByID(ctx, id)
+	if err != nil {
+		return domain.Poll{}, err
+	}
+
+	if err := uc.verifyReader(ctx, poll.RoomID, user); err != nil {
+		return domain.Poll{}, err
+	}
+
+	return poll, nil
+}
+
+// VotePoll replaces the vote of the caller on a poll; no options retracts it.
+func (uc *ChatUseCase) VotePoll(ctx context.Context, id int, options []int) (domain.Poll, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.Poll{}, err
+	}
+
+	return uc.castVote(ctx, user, id, options)
+}
+
+// castVote stores the vote of a member of the room of the poll and broadcasts the new tallies.
+func (uc *ChatUseCase) castVote(ctx context.Context, user domain.User, id int, options []int) (domain.Poll, error) {
+	poll, err := uc.chatRepository.GetPollByID(ctx, id)
+	if err != nil {
+		return domain.Poll{}, err
+	}
+
+	if err := uc.verifyMember(ctx, poll.RoomID, user); err != nil {
+		return domain.Poll{}, err
+	}
+
+	if !poll.IsOpen(time.Now()) {
+		return domain.Poll{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("poll is closed"))
+	}
+
+	seen := make(map[int]bool)
+	var chosen []int
+	for _, option := range options {
+		if option < 0 || option >= len(poll.Options) {
+			return domain.Poll{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("option %d does not exist", option))
+		}
+		if !seen[option] {
+			seen[option] = true
+			chosen = append(chosen, option)
+		}
+	}
+	if !poll.Multiple && len(chosen) > 1 {
+		return domain.Poll{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("poll allows a single choice"))
+	}
+
+	if err := uc.chatRepository.SetPollVotes(ctx, poll.ID, user, chosen, time.Now()); err != nil {
+		return domain.Poll{}, err
+	}
+
+	poll, err = uc.chatRepository.GetPollByID(ctx, id)
+	if err != nil {
+		return domain.Poll{}, err
+	}
+
+	uc.broadcastPoll(poll, "")
+
+	return poll, nil
+}
+
+// ClosePoll closes a poll and posts its results. Only the author of the poll and the
+// moderators of its room can close it.
+func (uc *ChatUseCase) ClosePoll(ctx context.Context, id int) (domain.Poll, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.Poll{}, err
+	}
+
+	poll, err := uc.chatRepository.GetPollByID(ctx, id)
+	if err != nil {
+		return domain.Poll{}, err
+	}
+
+	if poll.CreatedBy != user.ID {
+		if _, err := uc.verifyRoomOwner(ctx, poll.RoomID); err != nil {
+			return domain.Poll{}, err
+		}
+	}
+
+	closedPoll, closed, err := uc.closePoll(ctx, id)
+	if err != nil {
+		return domain.Poll{}, err
+	}
+	if !closed {
+		return domain.Poll{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("poll is already closed"))
+	}
+
+	return closedPoll, nil
+}
+
+// CloseDuePolls closes the polls whose closing time has come.
+func (uc *ChatUseCase) CloseDuePolls(ctx context.Context) error {
+	polls, err := uc.chatRepository.GetDuePolls(ctx, time.Now(), uc.config.Polls.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, poll := range polls {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if _, _, err := uc.closePoll(ctx, poll.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// closePoll closes a poll and broadcasts its results. It reports false when the poll
+// was already closed, by a user or by another replica.
+func (uc *ChatUseCase) closePoll(ctx context.Context, id int) (domain.Poll, bool, error) {
+	poll, summary, closed, err := uc.chatRepository.ClosePoll(ctx, id, time.Now())
+	if err != nil || !closed {
+		return domain.Poll{}, false, err
+	}
+
+	uc.hub.Broadcast <- &ws.Message{
+		Content:  summary.Content,
+		RoomID:   summary.RoomID,
+		Username: summary.Username,
+	}
+	uc.broadcastPoll(poll, "")
+
+	uc.publishMessageEvents(ctx, domain.User{ID: poll.CreatedBy, Username: poll.CreatedByName}, summary)
+
+	return poll, true, nil
+}
+
+// broadcastPoll sends the current tallies of a poll to its room. Content is only set
+// when the poll is posted, so that clients unaware of polls show the question once.
+func (uc *ChatUseCase) broadcastPoll(poll domain.Poll, content string) {
+	tallies, voters := poll.Tally()
+	options := make([]ws.PollOption, 0, len(tallies))
+	for _, tally := range tallies {
+		options = append(options, ws.PollOption{
+			Text:   tally.Option,
+			Votes:  tally.Votes,
+			Voters: tally.Voters,
+		})
+	}
+
+	uc.hub.Broadcast <- &ws.Message{
+		Content:  content,
+		RoomID:   poll.RoomID,
+		Username: poll.CreatedByName,
+		Type:     ws.MessageTypePoll,
+		Poll: &ws.Poll{
+			ID:        poll.ID,
+			MessageID: poll.MessageID,
+			Question:  poll.Question,
+			Options:   options,
+			Multiple:  poll.Multiple,
+			Anonymous: poll.Anonymous,
+			ClosesAt:  poll.ClosesAt,
+			Closed:    !poll.IsOpen(time.Now()),
+			Voters:    voters,
+		},
+	}
+}
+
+func (uc *ChatUseCase) validatePoll(poll *domain.Poll) error {
+	poll.Question = strings.TrimSpace(poll.Question)
+	if poll.Question == "" {
+		return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("poll question is required"))
+	}
+
+	if len(poll.Options) < 2 || len(poll.Options) > uc.config.Polls.MaxOptions {
+		return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("poll must have between 2 and %d options", uc.config.Polls.MaxOptions))
+	}
+	seen := make(map[string]bool)
+	for i, option := range poll.Options {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("poll options must not be empty"))
+		}
+		if seen[option] {
+			return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("poll option %q is repeated", option))
+		}
+		seen[option] = true
+		poll.Options[i] = option
+	}
+
+	if poll.ClosesAt != nil && !poll.ClosesAt.After(time.Now()) {
+		return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("poll closing time must be in the future"))
+	}
+
+	return nil
+}
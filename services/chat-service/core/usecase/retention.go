@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"go.uber.org/zap"
+)
+
+func (uc *ChatUseCase) UpdateRoomRetention(ctx context.Context, chat domain.Chat) (domain.Chat, error) {
+	if _, err := uc.verifyAdmin(ctx); err != nil {
+		return domain.Chat{}, err
+	}
+
+	retention := chat.Room.Retention
+	if retention.MaxAge != nil && *retention.MaxAge < 0 {
+		return domain.Chat{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("retention max age must not be negative"))
+	}
+	if retention.MaxMessages != nil && *retention.MaxMessages < 0 {
+		return domain.Chat{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("retention max messages must not be negative"))
+	}
+
+	updatedRoom, err := uc.chatRepository.UpdateRoomRetention(ctx, chat)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error updating room retention", zap.Error(err))
+		return domain.Chat{}, err
+	}
+
+	return updatedRoom, nil
+}
+
+func (uc *ChatUseCase) PurgeMessages(ctx context.Context, dryRun bool) (domain.PurgeReport, error) {
+	if _, err := uc.verifyAdmin(ctx); err != nil {
+		return domain.PurgeReport{}, err
+	}
+
+	return uc.ApplyRetention(ctx, dryRun || uc.config.Retention.DryRun)
+}
+
+func (uc *ChatUseCase) GetPurges(ctx context.Context, chat domain.Chat) ([]domain.Purge, error) {
+	if _, err := uc.verifyAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	purges, err := uc.chatRepository.GetPurges(ctx, chat.Room.ID)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error getting purges", zap.Error(err))
+		return nil, err
+	}
+
+	return purges, nil
+}
+
+// ApplyRetention removes the messages of every room that are past the room's
+// retention policy, or the global default when the room has none.
+// In dry-run mode the purges are recorded but no message is touched.
+func (uc *ChatUseCase) ApplyRetention(ctx context.Context, dryRun bool) (domain.PurgeReport, error) {
+	uc.retentionMu.Lock()
+	defer uc.retentionMu.Unlock()
+
+	report := domain.PurgeReport{
+		DryRun:    dryRun,
+		Mode:      domain.PurgeMode(uc.config.Retention.Mode),
+		StartedAt: time.Now(),
+	}
+
+	rooms, err := uc.chatRepository.GetRooms(ctx)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error getting rooms", zap.Error(err))
+		return domain.PurgeReport{}, err
+	}
+
+	for _, room := range rooms {
+		maxAge, maxMessages := uc.effectiveRetention(room.Room.Retention)
+
+		if maxAge > 0 {
+			filter := domain.PurgeFilter{
+				RoomID:        room.Room.ID,
+				CreatedBefore: report.StartedAt.Add(-maxAge),
+			}
+			purges, err := uc.purgeRoom(ctx, filter, domain.PurgeReasonMaxAge, report.Mode, dryRun)
+			report.Purges = append(report.Purges, purges...)
+			if err != nil {
+				return report, err
+			}
+		}
+
+		if maxMessages > 0 {
+			overflowID, err := uc.chatRepository.GetMessageOverflowID(ctx, room.Room.ID, maxMessages)
+			if err != nil {
+				uc.logger.Ctx(ctx).Error("error getting message overflow", zap.Error(err))
+				return report, err
+			}
+			if overflowID == 0 {
+				continue
+			}
+
+			filter := domain.PurgeFilter{
+				RoomID: room.Room.ID,
+				MaxID:  overflowID,
+			}
+			purges, err := uc.purgeRoom(ctx, filter, domain.PurgeReasonMaxMessages, report.Mode, dryRun)
+			report.Purges = append(report.Purges, purges...)
+			if err != nil {
+				return report, err
+			}
+		}
+	}
+
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+// effectiveRetention resolves the retention of a room against the global default.
+func (uc *ChatUseCase) effectiveRetention(retention domain.Retention) (time.Duration, int) {
+	maxAge := uc.config.Retention.MaxAge
+	if retention.MaxAge != nil {
+		maxAge = *retention.MaxAge
+	}
+
+	maxMessages := uc.config.Retention.MaxMessages
+	if retention.MaxMessages != nil {
+		maxMessages = *retention.MaxMessages
+	}
+
+	return maxAge, maxMessages
+}
+
+// purgeRoom walks the messages matching the filter in batches, removes them and records one purge per batch.
+func (uc *ChatUseCase) purgeRoom(ctx context.Context, filter domain.PurgeFilter, reason domain.PurgeReason, mode domain.PurgeMode, dryRun bool) ([]domain.Purge, error) {
+	var purges []domain.Purge
+
+	filter.Limit = uc.config.Retention.BatchSize
+	for {
+		messages, err := uc.chatRepository.GetPurgeCandidates(ctx, filter)
+		if err != nil {
+			uc.logger.Ctx(ctx).Error("error getting purge candidates", zap.Error(err))
+			return purges, err
+		}
+		if len(messages) == 0 {
+			return purges, nil
+		}
+
+		ids := make([]int, 0, len(messages))
+		for _, message := range messages {
+			ids = append(ids, message.ID)
+		}
+
+		count := len(ids)
+		if !dryRun {
+			switch mode {
+			case domain.PurgeModeArchive:
+				count, err = uc.chatRepository.ArchiveMessages(ctx, ids)
+			default:
+				count, err = uc.chatRepository.DeleteMessages(ctx, ids)
+			}
+			if err != nil {
+				uc.logger.Ctx(ctx).Error("error purging messages", zap.Error(err))
+				return purges, err
+			}
+		}
+
+		purge, err := uc.chatRepository.AddPurge(ctx, domain.Purge{
+			RoomID:       filter.RoomID,
+			Reason:       reason,
+			Mode:         mode,
+			DryRun:       dryRun,
+			MessageCount: count,
+			MessageIDs:   ids,
+		})
+		if err != nil {
+			uc.logger.Ctx(ctx).Error("error recording purge", zap.Error(err))
+			return purges, err
+		}
+		purges = append(purges, purge)
+
+		uc.logger.Ctx(ctx).Info("purged messages",
+			zap.Int("messages", count),
+			zap.String("room_id", filter.RoomID),
+			zap.String("reason", string(reason)),
+			zap.String("mode", string(mode)),
+			zap.Bool("dry_run", dryRun),
+		)
+
+		if len(messages) < filter.Limit {
+			return purges, nil
+		}
+		filter.AfterID = ids[len(ids)-1]
+	}
+}
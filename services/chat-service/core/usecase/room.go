@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+)
+
+const (
+	defaultHistoryLimit = 50
+	maxHistoryLimit     = 200
+	defaultRoomPageSize = 20
+	maxRoomPageSize     = 100
+)
+
+// Authenticate verifies the token from the context and returns its user.
+func (uc *ChatUseCase) Authenticate(ctx context.Context) (domain.User, error) {
+	return uc.verifyUser(ctx)
+}
+
+func (uc *ChatUseCase) GetRoom(ctx context.Context, chat domain.Chat) (domain.Chat, error) {
+	if _, err := uc.verifyUser(ctx); err != nil {
+		return domain.Chat{}, err
+	}
+
+	return uc.chatRepository.GetRoomByID(ctx, chat)
+}
+
+// UpdateRoom renames a room. Only the owner of the room or an admin can rename it.
+func (uc *ChatUseCase) UpdateRoom(ctx context.Context, chat domain.Chat) (domain.Chat, error) {
+	if _, err := uc.verifyRoomOwner(ctx, chat.Room.ID); err != nil {
+		return domain.Chat{}, err
+	}
+
+	chat.Room.Name = strings.TrimSpace(chat.Room.Name)
+	if chat.Room.Name == "" {
+		return domain.Chat{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("room name is required"))
+	}
+
+	updatedRoom, err := uc.chatRepository.UpdateRoom(ctx, chat)
+	if err != nil {
+		return domain.Chat{}, err
+	}
+
+	uc.hub.Lock()
+	if room, ok := uc.hub.Rooms[updatedRoom.Room.ID]; ok {
+		room.Name = updatedRoom.Room.Name
+	}
+	uc.hub.Unlock()
+
+	return updatedRoom, nil
+}
+
+// DeleteRoom deletes a room and its messages. Only the owner of the room or an admin can delete it.
+// Connected clients are told about it and stay connected until they leave.
+func (uc *ChatUseCase) DeleteRoom(ctx context.Context, chat domain.Chat) error {
+	if _, err := uc.verifyRoomOwner(ctx, chat.Room.ID); err != nil {
+		return err
+	}
+
+	if err := uc.chatRepository.DeleteRoom(ctx, chat); err != nil {
+		return err
+	}
+
+	uc.hub.Broadcast <- &ws.Message{
+		Content:  "this room has been deleted",
+		RoomID:   chat.Room.ID,
+		Username: "system",
+	}
+
+	return nil
+}
+
+// GetHistory returns the newest messages of a room before filter.BeforeID, oldest first.
+func (uc *ChatUseCase) GetHistory(ctx context.Context, filter domain.MessageFilter) ([]domain.Message, error) {
+	if _, err := uc.verifyUser(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.chatRepository.GetRoomByID(ctx, domain.Chat{Room: domain.Room{ID: filter.RoomID}}); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = defaultHistoryLimit
+	case filter.Limit > maxHistoryLimit:
+		filter.Limit = maxHistoryLimit
+	}
+
+	return uc.chatRepository.GetLatestMessages(ctx, domain.MessageFilter{
+		RoomID:   filter.RoomID,
+		BeforeID: filter.BeforeID,
+		Limit:    filter.Limit,
+	})
+}
+
+// ConnectRoom joins a room for an authenticated user over gRPC. Like the HTTP transports,
+// the returned session is attached to the hub.
+func (uc *ChatUseCase) ConnectRoom(ctx context.Context, user domain.User, roomID string) (*ws.Session, error) {
+	room, err := uc.chatRepository.GetRoomByID(ctx, domain.Chat{Room: domain.Room{ID: roomID}})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.OpenSession(ctx, domain.Chat{Room: room.Room, User: user}, ws.TransportGRPC)
+}
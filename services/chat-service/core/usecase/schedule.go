@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+)
+
+// ScheduleMessage saves a message that is posted to its room by the scheduler at SendAt.
+// When a time zone is given, the wall clock of SendAt is read in that zone.
+func (uc *ChatUseCase) ScheduleMessage(ctx context.Context, scheduledMessage domain.ScheduledMessage) (domain.ScheduledMessage, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.ScheduledMessage{}, err
+	}
+
+	if err := uc.verifyMember(ctx, scheduledMessage.RoomID, user); err != nil {
+		return domain.ScheduledMessage{}, err
+	}
+
+	if err := uc.validateScheduledMessage(&scheduledMessage); err != nil {
+		return domain.ScheduledMessage{}, err
+	}
+
+	scheduledMessage.UserID = user.ID
+	scheduledMessage.Username = user.Username
+
+	return uc.chatRepository.AddScheduledMessage(ctx, scheduledMessage)
+}
+
+// GetScheduledMessages returns the scheduled messages of the caller, optionally of one room or status.
+func (uc *ChatUseCase) GetScheduledMessages(ctx context.Context, filter domain.ScheduledMessageFilter) ([]domain.ScheduledMessage, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch filter.Status {
+	case "", domain.ScheduledMessagePending, domain.ScheduledMessageSent, domain.ScheduledMessageCanceled:
+	default:
+		return nil, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("unsupported status %q", filter.Status))
+	}
+
+	filter.UserID = user.ID
+	return uc.chatRepository.GetScheduledMessages(ctx, filter)
+}
+
+// UpdateScheduledMessage changes the content and the send time of a pending scheduled message of the caller.
+func (uc *ChatUseCase) UpdateScheduledMessage(ctx context.Context, scheduledMessage domain.ScheduledMessage) (domain.ScheduledMessage, error) {
+	existing, err := uc.getOwnScheduledMessage(ctx, scheduledMessage.ID)
+	if err != nil {
+		return domain.ScheduledMessage{}, err
+	}
+	if existing.Status != domain.ScheduledMessagePending {
+		return domain.ScheduledMessage{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("scheduled message was already %s", existing.Status))
+	}
+
+	if err := uc.validateScheduledMessage(&scheduledMessage); err != nil {
+		return domain.ScheduledMessage{}, err
+	}
+
+	return uc.chatRepository.UpdateScheduledMessage(ctx, scheduledMessage)
+}
+
+// CancelScheduledMessage cancels a pending scheduled message of the caller.
+func (uc *ChatUseCase) CancelScheduledMessage(ctx context.Context, id int) (domain.ScheduledMessage, error) {
+	existing, err := uc.getOwnScheduledMessage(ctx, id)
+	if err != nil {
+		return domain.ScheduledMessage{}, err
+	}
+	if existing.Status != domain.ScheduledMessagePending {
+		return domain.ScheduledMessage{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("scheduled message was already %s", existing.Status))
+	}
+
+	return uc.chatRepository.CancelScheduledMessage(ctx, id)
+}
+
+// SendDueScheduledMessages posts the scheduled messages whose send time has come.
+// A message is persisted in the same transaction that marks it sent, so when several
+// replicas run the scheduler each message is posted by exactly one of them. Messages
+// of authors who are no longer members of the room are canceled, not posted.
+func (uc *ChatUseCase) SendDueScheduledMessages(ctx context.Context) error {
+	scheduledMessages, err := uc.chatRepository.GetDueScheduledMessages(ctx, time.Now(), uc.config.Scheduler.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, scheduledMessage := range scheduledMessages {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		message, sent, err := uc.chatRepository.SendScheduledMessage(ctx, scheduledMessage, time.Now())
+		if err != nil {
+			return err
+		}
+		if !sent {
+			continue
+		}
+
+		uc.hub.Broadcast <- &ws.Message{
+			Content:  message.Content,
+			RoomID:   message.RoomID,
+			Username: message.Username,
+		}
+
+		uc.publishMessageEvents(ctx, domain.User{ID: scheduledMessage.UserID, Username: scheduledMessage.Username}, message)
+	}
+
+	return nil
+}
+
+// getOwnScheduledMessage returns a scheduled message of the caller. Messages of other
+// users are reported as not found.
+func (uc *ChatUseCase) getOwnScheduledMessage(ctx context.Context, id int) (domain.ScheduledMessage, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.ScheduledMessage{}, err
+	}
+
+	scheduledMessage, err := uc.chatRepository.GetScheduledMessageByID(ctx, id)
+	if err != nil {
+		return domain.ScheduledMessage{}, err
+	}
+	if scheduledMessage.UserID != user.ID {
+		return domain.ScheduledMessage{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("scheduled message not found"))
+	}
+
+	return scheduledMessage, nil
+}
+
+// validateScheduledMessage checks the content and resolves the send time in the time zone
+// of the message, which must be in the future and at most the max delay ahead.
+func (uc *ChatUseCase) validateScheduledMessage(scheduledMessage *domain.ScheduledMessage) error {
+	if strings.TrimSpace(scheduledMessage.Content) == "" {
+		return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("message content is required"))
+	}
+
+	if scheduledMessage.TimeZone == "" {
+		scheduledMessage.TimeZone = "UTC"
+	} else {
+		location, err := time.LoadLocation(scheduledMessage.TimeZone)
+		if err != nil {
+			return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("unknown time zone %q", scheduledMessage.TimeZone))
+		}
+		sendAt := scheduledMessage.SendAt
+		scheduledMessage.SendAt = time.Date(sendAt.Year(), sendAt.Month(), sendAt.Day(), sendAt.Hour(), sendAt.Minute(), sendAt.Second(), sendAt.Nanosecond(), location)
+	}
+
+	now := time.Now()
+	if !scheduledMessage.SendAt.After(now) {
+		return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("send time must be in the future"))
+	}
+	if scheduledMessage.SendAt.After(now.Add(uc.config.Scheduler.MaxDelay)) {
+		return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("send time must be within %s", uc.config.Scheduler.MaxDelay))
+	}
+
+	return nil
+}
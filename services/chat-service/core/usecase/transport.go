@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/authctx"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OpenSession joins a room over an HTTP transport (Server-Sent Events or long-polling).
+// The session is attached to the hub like a WebSocket client, so it shows up in the
+// room's clients and receives the same messages.
+func (uc *ChatUseCase) OpenSession(ctx context.Context, chat domain.Chat, transport string) (*ws.Session, error) {
+	if chat.Room.ID == "" || chat.User.ID == "" || chat.User.Username == "" {
+		return nil, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("room id, user id and username are required"))
+	}
+
+	// Remember the user as a member of the room
+	if err := uc.chatRepository.AddRoomMember(ctx, chat); err != nil {
+		uc.logger.Ctx(ctx).Error("error adding room member", zap.Error(err))
+	}
+
+	session, err := uc.sessions.Open(&ws.Client{
+		Message:   make(chan *ws.Message, 10),
+		ID:        chat.User.ID,
+		RoomID:    chat.Room.ID,
+		Username:  chat.User.Username,
+		Transport: transport,
+	})
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error opening session", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	// The session outlives the request that opened it, but not the session of its token
+	go uc.WatchSession(context.WithoutCancel(ctx), session.Done(), func() {
+		uc.CloseSession(session.ID)
+	})
+
+	uc.publishBotEvent(ctx, domain.BotEvent{
+		Type:      domain.BotEventJoin,
+		RoomID:    chat.Room.ID,
+		User:      chat.User,
+		CreatedAt: time.Now(),
+	})
+
+	return session, nil
+}
+
+// WatchSession verifies the token in ctx again every auth.session_cache_ttl while the
+// stream it opened is open, until done is closed or ctx is done. Once the session of the
+// token has ended or the token expired, end is called to close the stream. When the auth
+// service can't be asked the stream stays open.
+func (uc *ChatUseCase) WatchSession(ctx context.Context, done <-chan struct{}, end func()) {
+	token, ok := authctx.Token(ctx)
+	if !ok {
+		return
+	}
+
+	ticker := time.NewTicker(uc.config.Auth.SessionCacheTTL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		_, err := uc.authService.VerifyToken(ctx, domain.Auth{AccessToken: token})
+		if errors.IsSvcError(err, errors.ErrorUnauthorized) || status.Code(err) == codes.Unauthenticated {
+			uc.logger.Ctx(ctx).Info("closing stream of an ended session", zap.Error(err))
+			end()
+			return
+		}
+		if err != nil {
+			uc.logger.Ctx(ctx).Warn("error in checking the session of a stream", zap.Error(err))
+		}
+	}
+}
+
+func (uc *ChatUseCase) GetSession(sessionID string) (*ws.Session, error) {
+	session, ok := uc.sessions.Get(sessionID)
+	if !ok {
+		return nil, errors.NewError(errors.ErrorNotFound, fmt.Errorf("session not found or expired"))
+	}
+	return session, nil
+}
+
+// getUserSession verifies the token from the context and returns the session sessionID
+// when it was opened by the same user. The sessions of other users are not found.
+func (uc *ChatUseCase) getUserSession(ctx context.Context, sessionID string) (domain.User, *ws.Session, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.User{}, nil, err
+	}
+
+	session, err := uc.GetSession(sessionID)
+	if err != nil {
+		return domain.User{}, nil, err
+	}
+	if session.Client.ID != user.ID {
+		return domain.User{}, nil, errors.NewError(errors.ErrorNotFound, fmt.Errorf("session not found or expired"))
+	}
+	return user, session, nil
+}
+
+// PollSession waits up to the poll timeout for the messages of a long-polling session of
+// the user of the token after cursor.
+func (uc *ChatUseCase) PollSession(ctx context.Context, sessionID string, cursor int64) ([]ws.Event, int64, error) {
+	_, session, err := uc.getUserSession(ctx, sessionID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	pollCtx, cancel := context.WithTimeout(ctx, uc.config.Transports.PollTimeout)
+	defer cancel()
+
+	events, closed := session.Next(pollCtx, cursor)
+	if closed && len(events) == 0 {
+		return nil, 0, errors.NewError(errors.ErrorNotFound, fmt.Errorf("session not found or expired"))
+	}
+
+	if len(events) > 0 {
+		cursor = events[len(events)-1].Seq
+	}
+	return events, cursor, nil
+}
+
+// SendSessionMessage saves and broadcasts a message the user of the token sends to the room
+// of their session. OAuth clients need chat:write for it, whatever the scope of the route.
+func (uc *ChatUseCase) SendSessionMessage(ctx context.Context, sessionID string, content string) error {
+	user, session, err := uc.getUserSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if err := verifyScope(user, domain.ScopeChatWrite); err != nil {
+		return err
+	}
+	session.Touch()
+
+	if strings.TrimSpace(content) == "" {
+		return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("message content is required"))
+	}
+
+	msg := &ws.Message{
+		Content:  content,
+		RoomID:   session.Client.RoomID,
+		Username: session.Client.Username,
+	}
+	if err := uc.saveMessage(ctx, user, msg); err != nil {
+		return err
+	}
+
+	uc.hub.Broadcast <- msg
+	return nil
+}
+
+// LeaveSession closes a session of the user of the token.
+func (uc *ChatUseCase) LeaveSession(ctx context.Context, sessionID string) error {
+	_, session, err := uc.getUserSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	uc.CloseSession(session.ID)
+	return nil
+}
+
+func (uc *ChatUseCase) CloseSession(sessionID string) {
+	uc.sessions.Close(sessionID)
+}
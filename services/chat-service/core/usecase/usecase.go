@@ -3,39 +3,65 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/ports"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/service/auth"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/service/user"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/authctx"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/callback"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
+	"go.uber.org/zap"
 )
 
 type ChatUseCase struct {
 	chatRepository ports.IChatRepository
-	// authService    *auth.AuthService
-	logger *logger.Logger
-	config *configs.Config
-	hub    *ws.Hub
+	authService    *auth.AuthService
+	usersService   *user.UsersService
+	logger         *logger.Logger
+	config         *configs.Config
+	hub            *ws.Hub
+	views          fiber.Views
+	callbackSender *callback.Sender
+	sessions       *ws.Sessions
+	retentionMu    sync.Mutex
 }
 
-func NewChatUseCase(chatRepository ports.IChatRepository, authService *auth.AuthService, logger *logger.Logger, config *configs.Config, hub *ws.Hub) *ChatUseCase {
+func NewChatUseCase(chatRepository ports.IChatRepository, authService *auth.AuthService, usersService *user.UsersService, logger *logger.Logger, config *configs.Config, hub *ws.Hub, views fiber.Views) *ChatUseCase {
 	return &ChatUseCase{
 		chatRepository: chatRepository,
+		authService:    authService,
+		usersService:   usersService,
 		logger:         logger,
 		config:         config,
 		hub:            hub,
+		views:          views,
+		callbackSender: callback.NewSender(config.Bots.Timeout, config.Bots.AllowPrivateCallbacks),
+		sessions:       ws.NewSessions(hub, config.Transports.SessionTTL, config.Transports.BufferSize),
 	}
 }
 
 func (uc *ChatUseCase) CreateRoom(ctx context.Context, chat domain.Chat) (domain.Chat, error) {
+	// Rooms created by an authenticated user are owned by them
+	if _, ok := authctx.Token(ctx); ok {
+		user, err := uc.verifyUser(ctx)
+		if err != nil {
+			return domain.Chat{}, err
+		}
+		chat.Room.OwnerID = user.ID
+	}
 
 	createdRoom, err := uc.chatRepository.AddRoom(ctx, chat)
 	if err != nil {
-		uc.logger.Error(fmt.Sprintf("error creating room: %v", err))
+		uc.logger.Ctx(ctx).Error("error creating room", zap.Error(err))
 		return domain.Chat{}, err
 	}
 
@@ -51,25 +77,43 @@ func (uc *ChatUseCase) CreateRoom(ctx context.Context, chat domain.Chat) (domain
 	return createdRoom, nil
 }
 
+// JoinRoom connects a user to a room over a WebSocket. The user must come from
+// Authenticate: the frames of the connection are acted on as that user.
 func (uc *ChatUseCase) JoinRoom(ctx context.Context, chat domain.Chat) error {
+	// Remember the user as a member of the room
+	if err := uc.chatRepository.AddRoomMember(ctx, chat); err != nil {
+		uc.logger.Ctx(ctx).Error("error adding room member", zap.Error(err))
+	}
+
 	client := &ws.Client{
-		Conn:     chat.Conn,
-		Message:  make(chan *ws.Message, 10),
-		ID:       chat.User.ID,
-		RoomID:   chat.Room.ID,
-		Username: chat.User.Username,
+		Conn:      chat.Conn,
+		Message:   make(chan *ws.Message, 10),
+		ID:        chat.User.ID,
+		RoomID:    chat.Room.ID,
+		Username:  chat.User.Username,
+		Transport: ws.TransportWebSocket,
+		Encoding:  ws.EncodingForSubprotocol(chat.Conn.Subprotocol()),
 	}
 
 	// Register the client
 	uc.hub.Register <- client
 
+	uc.publishBotEvent(ctx, domain.BotEvent{
+		Type:      domain.BotEventJoin,
+		RoomID:    chat.Room.ID,
+		User:      chat.User,
+		CreatedAt: time.Now(),
+	})
+
 	// Handle message reading and writing
 	var wg sync.WaitGroup
 	wg.Add(2)
 
 	go func() {
 		defer wg.Done()
-		client.ReadMessage(uc.hub)
+		client.ReadMessage(uc.hub, func(msg *ws.Message) error {
+			return uc.handleClientMessage(ctx, chat.User, chat.Room.ID, msg)
+		})
 	}()
 
 	go func() {
@@ -77,38 +121,122 @@ func (uc *ChatUseCase) JoinRoom(ctx context.Context, chat domain.Chat) error {
 		client.WriteMessage()
 	}()
 
+	// The connection is closed once the session of its token has ended
+	closed := make(chan struct{})
+	go uc.WatchSession(ctx, closed, func() {
+		chat.Conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "session has ended"), time.Now().Add(time.Second))
+		chat.Conn.Close()
+	})
+
 	wg.Wait()
+	close(closed)
+
+	uc.leaveCallOnDisconnect(ctx, chat.User, chat.Room.ID)
 	return nil
 }
 
-func (uc *ChatUseCase) GetRooms(ctx context.Context) ([]domain.Chat, error) {
-	rooms, err := uc.chatRepository.GetRooms(ctx)
+// handleClientMessage acts on a frame read from the connection of a user to a room.
+// Joining a room only needs chat:read, while every frame is acted on as the user the
+// connection was authenticated as, so it needs a token that allows writing.
+func (uc *ChatUseCase) handleClientMessage(ctx context.Context, user domain.User, roomID string, msg *ws.Message) error {
+	if err := verifyScope(user, domain.ScopeChatWrite); err != nil {
+		return err
+	}
+
+	switch msg.Type {
+	case ws.MessageTypeVote:
+		_, err := uc.castVote(ctx, user, msg.Vote.PollID, msg.Vote.Options)
+		return err
+	case ws.MessageTypeCall:
+		_, err := uc.callAction(ctx, user, roomID, msg.Call.Action, msg.Call.ID)
+		return err
+	case ws.MessageTypeSignal:
+		return uc.relaySignal(ctx, user, roomID, domain.CallSignal{
+			CallID:    msg.Signal.CallID,
+			Kind:      domain.CallSignalKind(msg.Signal.Kind),
+			To:        msg.Signal.To,
+			SDP:       msg.Signal.SDP,
+			Candidate: msg.Signal.Candidate,
+		})
+	}
+	return uc.saveMessage(ctx, user, msg)
+}
+
+// saveMessage persists a chat message read from a client before it is broadcast
+// and queues the events for the bots of the room.
+func (uc *ChatUseCase) saveMessage(ctx context.Context, user domain.User, msg *ws.Message) error {
+	createdMessage, err := uc.chatRepository.AddMessage(ctx, domain.Chat{
+		Message: domain.Message{
+			RoomID:   msg.RoomID,
+			Username: msg.Username,
+			Content:  msg.Content,
+		},
+	})
 	if err != nil {
-		uc.logger.Error(fmt.Sprintf("error getting rooms: %v", err))
-		return nil, err
+		uc.logger.Ctx(ctx).Error("error saving message", zap.Error(err))
+		return err
 	}
 
-	uc.hub.Lock()
+	uc.publishMessageEvents(ctx, user, createdMessage.Message)
+	return nil
+}
+
+// GetRooms returns a page of the room directory with the number of users online in each room.
+func (uc *ChatUseCase) GetRooms(ctx context.Context, filter domain.RoomFilter) (domain.RoomPage, error) {
+	filter.Query = strings.TrimSpace(filter.Query)
+	switch filter.Sort {
+	case "":
+		filter.Sort = domain.RoomSortActivity
+	case domain.RoomSortActivity, domain.RoomSortMembers, domain.RoomSortName, domain.RoomSortNewest:
+	default:
+		return domain.RoomPage{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("invalid sort %q", filter.Sort))
+	}
+	if filter.Offset < 0 {
+		return domain.RoomPage{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("offset must not be negative"))
+	}
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = defaultRoomPageSize
+	case filter.Limit > maxRoomPageSize:
+		filter.Limit = maxRoomPageSize
+	}
+
+	rooms, total, err := uc.chatRepository.SearchRooms(ctx, filter)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error getting rooms", zap.Error(err))
+		return domain.RoomPage{}, err
+	}
+
+	roomIDs := make([]string, 0, len(rooms))
 	for _, room := range rooms {
-		uc.hub.Rooms[room.Room.ID] = &ws.Room{
-			ID:      room.Room.ID,
-			Name:    room.Room.Name,
-			Clients: uc.hub.Rooms[room.Room.ID].Clients,
-		}
+		roomIDs = append(roomIDs, room.Room.ID)
+	}
+	online := uc.hub.OnlineCounts(roomIDs)
+	for i := range rooms {
+		rooms[i].Online = online[rooms[i].Room.ID]
 	}
-	uc.hub.Unlock()
 
-	return rooms, nil
+	return domain.RoomPage{
+		Rooms:  rooms,
+		Total:  total,
+		Offset: filter.Offset,
+		Limit:  filter.Limit,
+	}, nil
 }
 
 func (uc *ChatUseCase) GetClients(ctx context.Context, chat domain.Chat) ([]domain.Chat, error) {
-	uc.hub.Lock()
-	defer uc.hub.Unlock()
-
+	uc.hub.RLock()
 	room, ok := uc.hub.Rooms[chat.Room.ID]
 	if !ok {
-		return nil, errors.NewError(errors.ErrorNotFound, fmt.Errorf("room not found"))
+		uc.hub.RUnlock()
+		// Nobody has joined the room on this instance yet
+		if _, err := uc.chatRepository.GetRoomByID(ctx, chat); err != nil {
+			return nil, err
+		}
+		return []domain.Chat{}, nil
 	}
+	defer uc.hub.RUnlock()
 
 	var clients []domain.Chat
 	for _, clientList := range room.Clients {
@@ -124,3 +252,59 @@ func (uc *ChatUseCase) GetClients(ctx context.Context, chat domain.Chat) ([]doma
 
 	return clients, nil
 }
+
+// verifyAdmin verifies the token from the context and makes sure it belongs to an admin.
+func (uc *ChatUseCase) verifyAdmin(ctx context.Context) (domain.User, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if user.ClientID != "" {
+		return domain.User{}, errors.NewError(errors.ErrorForbidden, fmt.Errorf("OAuth clients can't act as admin"))
+	}
+	if !user.IsAdmin() {
+		return domain.User{}, errors.NewError(errors.ErrorForbidden, fmt.Errorf("user does not have admin permission"))
+	}
+
+	return user, nil
+}
+
+// verifyUser verifies the token from the context with the auth service and makes sure
+// that an OAuth client was granted the scope of the request.
+func (uc *ChatUseCase) verifyUser(ctx context.Context) (domain.User, error) {
+	// get token from context
+	contextToken, ok := authctx.Token(ctx)
+	if !ok {
+		err := fmt.Errorf("error in getting token from context")
+		uc.logger.Ctx(ctx).Error(err.Error())
+		return domain.User{}, errors.NewError(errors.ErrorBadRequest, err)
+	}
+
+	// verify token with auth service and get user claims
+	user, err := uc.authService.VerifyToken(ctx, domain.Auth{AccessToken: contextToken})
+	if err != nil {
+		uc.logger.Ctx(ctx).Error(err.Error())
+		return domain.User{}, err
+	}
+	logger.SetUserID(ctx, user.ID)
+
+	// OAuth clients need the scope the route declares, chat:write when it declares none
+	scope, ok := authctx.Scope(ctx)
+	if !ok {
+		scope = domain.ScopeChatWrite
+	}
+	if err := verifyScope(user, scope); err != nil {
+		return domain.User{}, err
+	}
+
+	return user, nil
+}
+
+// verifyScope makes sure the token the user was verified with allows scope.
+func verifyScope(user domain.User, scope string) error {
+	if !user.HasScope(scope) {
+		return errors.NewError(errors.ErrorForbidden, fmt.Errorf("token does not have the %s scope", scope))
+	}
+	return nil
+}
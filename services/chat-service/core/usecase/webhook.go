@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+	"go.uber.org/zap"
+)
+
+// webhookTokenPrefix marks incoming webhook tokens so that leaked ones are easy to spot.
+const webhookTokenPrefix = "whk_"
+
+// maxWebhookUsernameLength bounds the username a webhook payload may post as.
+const maxWebhookUsernameLength = 64
+
+// CreateWebhook creates an incoming webhook for a room owned by the caller.
+// The returned webhook carries the token, which is not shown again.
+func (uc *ChatUseCase) CreateWebhook(ctx context.Context, webhook domain.Webhook) (domain.Webhook, error) {
+	user, err := uc.verifyRoomOwner(ctx, webhook.RoomID)
+	if err != nil {
+		return domain.Webhook{}, err
+	}
+
+	webhook.Name = strings.TrimSpace(webhook.Name)
+	if webhook.Name == "" {
+		return domain.Webhook{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("webhook name is required"))
+	}
+	if utf8.RuneCountInString(webhook.Name) > maxWebhookUsernameLength {
+		return domain.Webhook{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("webhook name must be at most %d characters", maxWebhookUsernameLength))
+	}
+
+	secret, err := randomSecret()
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error generating webhook token", zap.Error(err))
+		return domain.Webhook{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	token := webhookTokenPrefix + secret
+
+	webhook.CreatedBy = user.ID
+	webhook.TokenHash = HashWebhookToken(token)
+
+	createdWebhook, err := uc.chatRepository.AddWebhook(ctx, webhook)
+	if err != nil {
+		return domain.Webhook{}, err
+	}
+	createdWebhook.Token = token
+
+	return createdWebhook, nil
+}
+
+func (uc *ChatUseCase) GetWebhooks(ctx context.Context, roomID string) ([]domain.Webhook, error) {
+	if _, err := uc.verifyRoomOwner(ctx, roomID); err != nil {
+		return nil, err
+	}
+
+	return uc.chatRepository.GetWebhooksByRoomID(ctx, roomID)
+}
+
+func (uc *ChatUseCase) RevokeWebhook(ctx context.Context, id int) (domain.Webhook, error) {
+	webhook, err := uc.chatRepository.GetWebhookByID(ctx, id)
+	if err != nil {
+		return domain.Webhook{}, err
+	}
+
+	if _, err := uc.verifyRoomOwner(ctx, webhook.RoomID); err != nil {
+		return domain.Webhook{}, err
+	}
+
+	return uc.chatRepository.RevokeWebhook(ctx, id)
+}
+
+// PostWebhookMessage saves a message posted through an incoming webhook and broadcasts it
+// to the room like any other message. The username defaults to the name of the webhook.
+func (uc *ChatUseCase) PostWebhookMessage(ctx context.Context, token string, chat domain.Chat) (domain.Chat, error) {
+	webhook, err := uc.chatRepository.GetWebhookByTokenHash(ctx, HashWebhookToken(token))
+	if err != nil {
+		if errors.IsSvcError(err, errors.ErrorNotFound) {
+			return domain.Chat{}, errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("invalid webhook token"))
+		}
+		return domain.Chat{}, err
+	}
+	if webhook.IsRevoked {
+		return domain.Chat{}, errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("webhook has been revoked"))
+	}
+
+	message, err := uc.validateWebhookMessage(chat.Message)
+	if err != nil {
+		return domain.Chat{}, err
+	}
+	message.RoomID = webhook.RoomID
+	if message.Username == "" {
+		message.Username = webhook.Name
+	}
+
+	createdMessage, err := uc.chatRepository.AddMessage(ctx, domain.Chat{Message: message})
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("error saving webhook message", zap.Error(err))
+		return domain.Chat{}, err
+	}
+
+	attachments := make([]ws.Attachment, 0, len(createdMessage.Message.Attachments))
+	for _, attachment := range createdMessage.Message.Attachments {
+		attachments = append(attachments, ws.Attachment{
+			Title: attachment.Title,
+			URL:   attachment.URL,
+			Text:  attachment.Text,
+		})
+	}
+	uc.hub.Broadcast <- &ws.Message{
+		Content:     createdMessage.Message.Content,
+		RoomID:      createdMessage.Message.RoomID,
+		Username:    createdMessage.Message.Username,
+		Attachments: attachments,
+	}
+
+	if err := uc.chatRepository.TouchWebhook(ctx, webhook.ID, time.Now()); err != nil {
+		uc.logger.Ctx(ctx).Error("error updating webhook last use", zap.Error(err))
+	}
+
+	uc.publishMessageEvents(ctx, domain.User{
+		ID:       fmt.Sprintf("webhook-%d", webhook.ID),
+		Username: createdMessage.Message.Username,
+	}, createdMessage.Message)
+
+	return createdMessage, nil
+}
+
+// validateWebhookMessage checks a webhook payload against the configured limits.
+// A message may consist of attachments only, in which case the first one becomes its text.
+func (uc *ChatUseCase) validateWebhookMessage(message domain.Message) (domain.Message, error) {
+	message.Content = strings.TrimSpace(message.Content)
+	message.Username = strings.TrimSpace(message.Username)
+
+	if message.Content == "" && len(message.Attachments) == 0 {
+		return domain.Message{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("text or attachments are required"))
+	}
+	if utf8.RuneCountInString(message.Content) > uc.config.Webhooks.MaxTextLength {
+		return domain.Message{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("text must be at most %d characters", uc.config.Webhooks.MaxTextLength))
+	}
+	if utf8.RuneCountInString(message.Username) > maxWebhookUsernameLength {
+		return domain.Message{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("username must be at most %d characters", maxWebhookUsernameLength))
+	}
+	if len(message.Attachments) > uc.config.Webhooks.MaxAttachments {
+		return domain.Message{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("at most %d attachments are allowed", uc.config.Webhooks.MaxAttachments))
+	}
+
+	for i, attachment := range message.Attachments {
+		if attachment.Title == "" && attachment.URL == "" && attachment.Text == "" {
+			return domain.Message{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("attachment %d is empty", i))
+		}
+		if attachment.URL != "" {
+			u, err := url.Parse(attachment.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return domain.Message{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("attachment %d url must be an absolute http or https url", i))
+			}
+		}
+		if utf8.RuneCountInString(attachment.Title)+utf8.RuneCountInString(attachment.Text) > uc.config.Webhooks.MaxTextLength {
+			return domain.Message{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("attachment %d must be at most %d characters", i, uc.config.Webhooks.MaxTextLength))
+		}
+	}
+
+	if message.Content == "" {
+		first := message.Attachments[0]
+		switch {
+		case first.Title != "":
+			message.Content = first.Title
+		case first.URL != "":
+			message.Content = first.URL
+		default:
+			message.Content = first.Text
+		}
+	}
+
+	return message, nil
+}
+
+// verifyRoomOwner verifies the token from the context and makes sure it belongs to the
+// owner of the room or to an admin.
+func (uc *ChatUseCase) verifyRoomOwner(ctx context.Context, roomID string) (domain.User, error) {
+	user, err := uc.verifyUser(ctx)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	room, err := uc.chatRepository.GetRoomByID(ctx, domain.Chat{Room: domain.Room{ID: roomID}})
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if room.Room.OwnerID != user.ID && !user.IsAdmin() {
+		return domain.User{}, errors.NewError(errors.ErrorForbidden, fmt.Errorf("user is not the owner of the room"))
+	}
+
+	return user, nil
+}
+
+// HashWebhookToken returns the hash a webhook token is stored and looked up by.
+func HashWebhookToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
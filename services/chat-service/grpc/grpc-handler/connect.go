@@ -0,0 +1,220 @@
+package grpchandler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/pkg/chat"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/authctx"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Connect exchanges room events over a bidirectional stream. Every joined room is a
+// session on the hub, so gRPC clients see the same messages as WebSocket clients.
+// Failed client events are answered with an Error event and leave the stream open.
+// Joining needs chat:read like the stream, sending, voting and calls need chat:write.
+// The stream is ended with Unauthenticated once the session of its token has ended.
+func (h *ChatHandler) Connect(stream chat.ChatService_ConnectServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	user, err := h.chatUseCase.Authenticate(ctx)
+	if err != nil {
+		grpcErr := errors.GRPCFromError(err)
+		return status.Error(grpcErr.Code, grpcErr.Message)
+	}
+	writeCtx := authctx.WithScope(ctx, domain.ScopeChatWrite)
+
+	// stream.Send is not safe for concurrent use, so all events go through one writer
+	out := make(chan *chat.ServerEvent, 16)
+	sendErr := make(chan error, 1)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-out:
+				if err := stream.Send(event); err != nil {
+					sendErr <- err
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	send := func(event *chat.ServerEvent) {
+		select {
+		case out <- event:
+		case <-ctx.Done():
+		}
+	}
+	sendError := func(err error) {
+		grpcErr := errors.GRPCFromError(err)
+		send(&chat.ServerEvent{Event: &chat.ServerEvent_Error{Error: &chat.Error{
+			Status:  int32(grpcErr.Status),
+			Message: grpcErr.Message,
+		}}})
+	}
+
+	var wg sync.WaitGroup
+	sessions := make(map[string]*ws.Session)
+	defer func() {
+		for _, session := range sessions {
+			h.chatUseCase.CloseSession(session.ID)
+		}
+		cancel()
+		wg.Wait()
+	}()
+
+	// The stream ends once the session of its token has ended; the events are received
+	// aside so that waiting for one does not keep it open
+	ended := make(chan struct{})
+	go h.chatUseCase.WatchSession(ctx, nil, func() { close(ended) })
+
+	type received struct {
+		event *chat.ClientEvent
+		err   error
+	}
+	incoming := make(chan received)
+	go func() {
+		for {
+			in, err := stream.Recv()
+			select {
+			case incoming <- received{event: in, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		var in *chat.ClientEvent
+		select {
+		case <-ended:
+			return status.Error(codes.Unauthenticated, "session has ended")
+		case r := <-incoming:
+			if r.err == io.EOF {
+				return nil
+			}
+			if r.err != nil {
+				select {
+				case err := <-sendErr:
+					return err
+				default:
+					return r.err
+				}
+			}
+			in = r.event
+		}
+
+		switch event := in.GetEvent().(type) {
+		case *chat.ClientEvent_Join:
+			roomID := event.Join.GetRoomId()
+			if _, ok := sessions[roomID]; ok {
+				send(&chat.ServerEvent{Event: &chat.ServerEvent_Joined{Joined: &chat.Joined{RoomId: roomID}}})
+				continue
+			}
+
+			session, err := h.chatUseCase.ConnectRoom(ctx, user, roomID)
+			if err != nil {
+				sendError(err)
+				continue
+			}
+			sessions[roomID] = session
+			send(&chat.ServerEvent{Event: &chat.ServerEvent_Joined{Joined: &chat.Joined{RoomId: roomID}}})
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				h.forward(ctx, session, send)
+			}()
+
+		case *chat.ClientEvent_Leave:
+			roomID := event.Leave.GetRoomId()
+			session, ok := sessions[roomID]
+			if !ok {
+				sendError(errors.NewError(errors.ErrorBadRequest, fmt.Errorf("not joined to room %s", roomID)))
+				continue
+			}
+			delete(sessions, roomID)
+			h.chatUseCase.CloseSession(session.ID)
+
+		case *chat.ClientEvent_Send:
+			roomID := event.Send.GetRoomId()
+			session, ok := sessions[roomID]
+			if !ok {
+				sendError(errors.NewError(errors.ErrorBadRequest, fmt.Errorf("not joined to room %s", roomID)))
+				continue
+			}
+			if err := h.chatUseCase.SendSessionMessage(ctx, session.ID, event.Send.GetContent()); err != nil {
+				sendError(err)
+			}
+
+		case *chat.ClientEvent_Vote:
+			options := make([]int, 0, len(event.Vote.GetOptions()))
+			for _, option := range event.Vote.GetOptions() {
+				options = append(options, int(option))
+			}
+			if _, err := h.chatUseCase.VotePoll(writeCtx, int(event.Vote.GetPollId()), options); err != nil {
+				sendError(err)
+			}
+
+		case *chat.ClientEvent_Call:
+			roomID := event.Call.GetRoomId()
+			if _, ok := sessions[roomID]; !ok {
+				sendError(errors.NewError(errors.ErrorBadRequest, fmt.Errorf("not joined to room %s", roomID)))
+				continue
+			}
+			if _, err := h.chatUseCase.CallAction(writeCtx, roomID, event.Call.GetAction(), int(event.Call.GetCallId())); err != nil {
+				sendError(err)
+			}
+
+		case *chat.ClientEvent_Signal:
+			roomID := event.Signal.GetRoomId()
+			if _, ok := sessions[roomID]; !ok {
+				sendError(errors.NewError(errors.ErrorBadRequest, fmt.Errorf("not joined to room %s", roomID)))
+				continue
+			}
+			if err := h.chatUseCase.RelaySignal(writeCtx, roomID, MapProtoSignalToDomainCallSignal(event.Signal)); err != nil {
+				sendError(err)
+			}
+
+		default:
+			sendError(errors.NewError(errors.ErrorBadRequest, fmt.Errorf("unknown event")))
+		}
+	}
+}
+
+// forward sends the messages of a session to the stream until the session is closed.
+// It wakes up every heartbeat to keep the session from expiring.
+func (h *ChatHandler) forward(ctx context.Context, session *ws.Session, send func(*chat.ServerEvent)) {
+	var cursor int64
+	for {
+		nextCtx, cancel := context.WithTimeout(ctx, h.config.Transports.Heartbeat)
+		events, closed := session.Next(nextCtx, cursor)
+		cancel()
+
+		for _, event := range events {
+			send(MapWSMessageToProtoServerEvent(event.Message))
+			cursor = event.Seq
+		}
+
+		if closed {
+			send(&chat.ServerEvent{Event: &chat.ServerEvent_Left{Left: &chat.Left{RoomId: session.Client.RoomID}}})
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package grpchandler
+
+import (
+	"context"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/usecase"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/pkg/chat"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"google.golang.org/grpc/status"
+)
+
+type ChatHandler struct {
+	chat.UnimplementedChatServiceServer
+	chatUseCase *usecase.ChatUseCase
+	config      *configs.Config
+}
+
+func NewChatHandler(chatUseCase *usecase.ChatUseCase, config *configs.Config) *ChatHandler {
+	return &ChatHandler{
+		chatUseCase: chatUseCase,
+		config:      config,
+	}
+}
+
+func (h *ChatHandler) CreateRoom(ctx context.Context, req *chat.CreateRoomReq) (*chat.Room, error) {
+	res, err := h.chatUseCase.CreateRoom(ctx, MapProtoCreateRoomReqToDomainChat(req))
+	if err != nil {
+		grpcErr := errors.GRPCFromError(err)
+		return &chat.Room{}, status.Error(grpcErr.Code, grpcErr.Message)
+	}
+	return MapDomainChatToProtoRoom(res), nil
+}
+
+func (h *ChatHandler) GetRoom(ctx context.Context, req *chat.GetRoomReq) (*chat.Room, error) {
+	res, err := h.chatUseCase.GetRoom(ctx, MapProtoGetRoomReqToDomainChat(req))
+	if err != nil {
+		grpcErr := errors.GRPCFromError(err)
+		return &chat.Room{}, status.Error(grpcErr.Code, grpcErr.Message)
+	}
+	return MapDomainChatToProtoRoom(res), nil
+}
+
+func (h *ChatHandler) GetRooms(ctx context.Context, req *chat.GetRoomsReq) (*chat.GetRoomsRes, error) {
+	if _, err := h.chatUseCase.Authenticate(ctx); err != nil {
+		grpcErr := errors.GRPCFromError(err)
+		return &chat.GetRoomsRes{}, status.Error(grpcErr.Code, grpcErr.Message)
+	}
+
+	res, err := h.chatUseCase.GetRooms(ctx, MapProtoGetRoomsReqToDomainRoomFilter(req))
+	if err != nil {
+		grpcErr := errors.GRPCFromError(err)
+		return &chat.GetRoomsRes{}, status.Error(grpcErr.Code, grpcErr.Message)
+	}
+	return MapDomainRoomPageToProtoGetRoomsRes(res), nil
+}
+
+func (h *ChatHandler) UpdateRoom(ctx context.Context, req *chat.UpdateRoomReq) (*chat.Room, error) {
+	res, err := h.chatUseCase.UpdateRoom(ctx, MapProtoUpdateRoomReqToDomainChat(req))
+	if err != nil {
+		grpcErr := errors.GRPCFromError(err)
+		return &chat.Room{}, status.Error(grpcErr.Code, grpcErr.Message)
+	}
+	return MapDomainChatToProtoRoom(res), nil
+}
+
+func (h *ChatHandler) DeleteRoom(ctx context.Context, req *chat.DeleteRoomReq) (*chat.DeleteRoomRes, error) {
+	if err := h.chatUseCase.DeleteRoom(ctx, MapProtoDeleteRoomReqToDomainChat(req)); err != nil {
+		grpcErr := errors.GRPCFromError(err)
+		return &chat.DeleteRoomRes{}, status.Error(grpcErr.Code, grpcErr.Message)
+	}
+	return &chat.DeleteRoomRes{}, nil
+}
+
+func (h *ChatHandler) GetHistory(ctx context.Context, req *chat.GetHistoryReq) (*chat.GetHistoryRes, error) {
+	res, err := h.chatUseCase.GetHistory(ctx, MapProtoGetHistoryReqToDomainMessageFilter(req))
+	if err != nil {
+		grpcErr := errors.GRPCFromError(err)
+		return &chat.GetHistoryRes{}, status.Error(grpcErr.Code, grpcErr.Message)
+	}
+	return MapDomainMessagesToProtoGetHistoryRes(res), nil
+}
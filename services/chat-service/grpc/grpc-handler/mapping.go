@@ -0,0 +1,125 @@
+package grpchandler
+
+import (
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/pkg/chat"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MapProtoCreateRoomReqToDomainChat(req *chat.CreateRoomReq) domain.Chat {
+	return domain.Chat{
+		Room: domain.Room{
+			Name: req.GetName(),
+		},
+	}
+}
+
+func MapProtoGetRoomReqToDomainChat(req *chat.GetRoomReq) domain.Chat {
+	return domain.Chat{
+		Room: domain.Room{
+			ID: req.GetRoomId(),
+		},
+	}
+}
+
+func MapProtoUpdateRoomReqToDomainChat(req *chat.UpdateRoomReq) domain.Chat {
+	return domain.Chat{
+		Room: domain.Room{
+			ID:   req.GetRoomId(),
+			Name: req.GetName(),
+		},
+	}
+}
+
+func MapProtoDeleteRoomReqToDomainChat(req *chat.DeleteRoomReq) domain.Chat {
+	return domain.Chat{
+		Room: domain.Room{
+			ID: req.GetRoomId(),
+		},
+	}
+}
+
+func MapProtoGetHistoryReqToDomainMessageFilter(req *chat.GetHistoryReq) domain.MessageFilter {
+	return domain.MessageFilter{
+		RoomID:   req.GetRoomId(),
+		BeforeID: int(req.GetBeforeId()),
+		Limit:    int(req.GetLimit()),
+	}
+}
+
+func MapDomainChatToProtoRoom(res domain.Chat) *chat.Room {
+	return &chat.Room{
+		Id:      res.Room.ID,
+		Name:    res.Room.Name,
+		OwnerId: res.Room.OwnerID,
+	}
+}
+
+func MapProtoGetRoomsReqToDomainRoomFilter(req *chat.GetRoomsReq) domain.RoomFilter {
+	return domain.RoomFilter{
+		Query:  req.GetQuery(),
+		Sort:   domain.RoomSort(req.GetSort()),
+		Offset: int(req.GetOffset()),
+		Limit:  int(req.GetLimit()),
+	}
+}
+
+func MapDomainRoomPageToProtoGetRoomsRes(res domain.RoomPage) *chat.GetRoomsRes {
+	rooms := make([]*chat.Room, 0, len(res.Rooms))
+	for _, summary := range res.Rooms {
+		room := MapDomainChatToProtoRoom(domain.Chat{Room: summary.Room})
+		room.Members = int32(summary.Members)
+		room.Online = int32(summary.Online)
+		if summary.LastActivityAt != nil {
+			room.LastActivityAt = timestamppb.New(*summary.LastActivityAt)
+		}
+		rooms = append(rooms, room)
+	}
+	return &chat.GetRoomsRes{
+		Rooms: rooms,
+		Total: int32(res.Total),
+	}
+}
+
+func MapDomainMessagesToProtoGetHistoryRes(res []domain.Message) *chat.GetHistoryRes {
+	messages := make([]*chat.Message, 0, len(res))
+	for _, message := range res {
+		attachments := make([]*chat.Attachment, 0, len(message.Attachments))
+		for _, attachment := range message.Attachments {
+			attachments = append(attachments, &chat.Attachment{
+				Title: attachment.Title,
+				Url:   attachment.URL,
+				Text:  attachment.Text,
+			})
+		}
+
+		messages = append(messages, &chat.Message{
+			Id:          int32(message.ID),
+			RoomId:      message.RoomID,
+			Username:    message.Username,
+			Content:     message.Content,
+			Bot:         message.Bot,
+			Attachments: attachments,
+			CreatedAt:   timestamppb.New(message.CreatedAt),
+		})
+	}
+	return &chat.GetHistoryRes{
+		Messages: messages,
+	}
+}
+
+// MapWSMessageToProtoServerEvent maps a message broadcast by the hub to a Connect event.
+func MapWSMessageToProtoServerEvent(message *ws.Message) *chat.ServerEvent {
+	return message.ProtoEvent()
+}
+
+func MapProtoSignalToDomainCallSignal(req *chat.Signal) domain.CallSignal {
+	return domain.CallSignal{
+		CallID:    int(req.GetCallId()),
+		Kind:      domain.CallSignalKind(req.GetKind()),
+		To:        req.GetTo(),
+		SDP:       req.GetSdp(),
+		Candidate: req.GetCandidate(),
+	}
+}
@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	grpchandler "github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/grpc-handler"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/pkg/chat"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/middleware"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/health"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/metrics"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type GRPCServer struct {
+	server *grpc.Server
+	logger *logger.Logger
+	config *configs.Config
+}
+
+func NewGRPCServer(grpcHandler *grpchandler.ChatHandler, checker *health.Checker, logger *logger.Logger, config *configs.Config) *GRPCServer {
+	srv := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor(), middleware.RequestIDUnaryInterceptor(logger), middleware.AuthUnaryInterceptor()),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor(), middleware.RequestIDStreamInterceptor(logger), middleware.AuthStreamInterceptor()),
+	)
+	chat.RegisterChatServiceServer(srv, grpcHandler)
+	grpc_health_v1.RegisterHealthServer(srv, checker.Server())
+
+	return &GRPCServer{
+		server: srv,
+		logger: logger,
+		config: config,
+	}
+}
+
+func (srv *GRPCServer) SetupGRPCServer(lc fx.Lifecycle) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			srv.logger.Info("Starting gRPC server")
+
+			// Start the gRPC server in a separate goroutine
+			go func() {
+				listener, err := net.Listen("tcp", fmt.Sprintf(":%d", srv.config.GRPC.ChatPort))
+				if err != nil {
+					srv.logger.Fatal(fmt.Sprintf("Failed to listen on port %d: %v", srv.config.GRPC.ChatPort, err))
+				}
+				if err := srv.server.Serve(listener); err != nil {
+					srv.logger.Fatal(fmt.Sprintf("Failed to serve gRPC: %v", err))
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			srv.logger.Info("Shutting down gRPC server")
+			srv.server.GracefulStop()
+			return nil
+		},
+	})
+}
@@ -5,27 +5,38 @@ import (
 	"fmt"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/pkg/auth"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/middleware"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/metrics"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // Client interface for AuthService
 type IClient interface {
 	VerifyToken(ctx context.Context, req VerifyTokenReq) (VerifyTokenRes, error)
+	CheckHealth(ctx context.Context) error
 }
 
 // Client struct for managing connection
 type Client struct {
 	c      auth.AuthServiceClient // gRPC client
+	health grpc_health_v1.HealthClient
 	logger *logger.Logger
 }
 
 // NewClient creates a new gRPC client for AuthService
 func NewClient(logger *logger.Logger, config *configs.Config) (IClient, error) {
 	// Establish gRPC connection with the server
-	conn, err := grpc.NewClient(fmt.Sprintf("%s:%d", config.GRPC.AuthHost, config.GRPC.AuthPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(fmt.Sprintf("%s:%d", config.GRPC.AuthHost, config.GRPC.AuthPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(metrics.UnaryClientInterceptor(), middleware.RequestIDClientInterceptor()),
+	)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to establish connection with AuthService: %v", err))
 		return nil, err
@@ -34,14 +45,27 @@ func NewClient(logger *logger.Logger, config *configs.Config) (IClient, error) {
 
 	return &Client{
 		c:      client,
+		health: grpc_health_v1.NewHealthClient(conn),
 		logger: logger,
 	}, nil
 }
 
+// CheckHealth reports an error unless AuthService is serving.
+func (c *Client) CheckHealth(ctx context.Context) error {
+	res, err := c.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if res.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("AuthService is %s", res.GetStatus())
+	}
+	return nil
+}
+
 func (c *Client) HashPassword(ctx context.Context, req HashPasswordReq) (HashPasswordRes, error) {
 	res, err := c.c.HashPassword(ctx, MapDtoHashPasswordReqToPbHashPasswordReq(req))
 	if err != nil {
-		c.logger.Error(fmt.Sprintf("failed to call HashPassword: %v", err))
+		c.logger.Ctx(ctx).Error("failed to call HashPassword", zap.Error(err))
 		return HashPasswordRes{}, err
 	}
 	return MapPbHashPasswordResToDtoHashPasswordRes(res), nil
@@ -50,7 +74,7 @@ func (c *Client) HashPassword(ctx context.Context, req HashPasswordReq) (HashPas
 func (c *Client) VerifyToken(ctx context.Context, req VerifyTokenReq) (VerifyTokenRes, error) {
 	res, err := c.c.VerifyToken(ctx, MapDtoVerifyTokenReqToPbVerifyTokenReq(req))
 	if err != nil {
-		c.logger.Error(fmt.Sprintf("failed to call VerifyToken: %v", err))
+		c.logger.Ctx(ctx).Error("failed to call VerifyToken", zap.Error(err))
 		return VerifyTokenRes{}, err
 	}
 	return MapPbVerifyTokenResToDtoVerifyTokenRes(res), nil
@@ -17,4 +17,6 @@ type VerifyTokenRes struct {
 	Username string
 	Email    string
 	Role     string
+	ClientID string
+	Scope    string
 }
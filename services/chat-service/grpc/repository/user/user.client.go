@@ -0,0 +1,82 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/pkg/user"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/middleware"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/metrics"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Client interface for UsersService
+type IClient interface {
+	GetUserByUsername(ctx context.Context, req GetUserReq) (UserRes, error)
+	GetUserByEmail(ctx context.Context, req GetUserByEmailReq) (UserRes, error)
+	CheckHealth(ctx context.Context) error
+}
+
+// Client struct for managing connection
+type Client struct {
+	c      user.UsersServiceClient // gRPC client
+	health grpc_health_v1.HealthClient
+	logger *logger.Logger
+}
+
+// NewClient creates a new gRPC client for UsersService
+func NewClient(logger *logger.Logger, config *configs.Config) (IClient, error) {
+	// Establish gRPC connection with the server
+	conn, err := grpc.NewClient(fmt.Sprintf("%s:%d", config.GRPC.UserHost, config.GRPC.UserPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(metrics.UnaryClientInterceptor(), middleware.RequestIDClientInterceptor()),
+	)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to establish connection with UsersService: %v", err))
+		return nil, err
+	}
+	client := user.NewUsersServiceClient(conn)
+
+	return &Client{
+		c:      client,
+		health: grpc_health_v1.NewHealthClient(conn),
+		logger: logger,
+	}, nil
+}
+
+// CheckHealth reports an error unless UsersService is serving.
+func (c *Client) CheckHealth(ctx context.Context) error {
+	res, err := c.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if res.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("UsersService is %s", res.GetStatus())
+	}
+	return nil
+}
+
+func (c *Client) GetUserByUsername(ctx context.Context, req GetUserReq) (UserRes, error) {
+	res, err := c.c.GetUserByUsername(ctx, MapDtoGetUserReqToPbGetUserReq(req))
+	if err != nil {
+		c.logger.Ctx(ctx).Error("failed to call GetUserByUsername", zap.Error(err))
+		return UserRes{}, err
+	}
+	return MapPbUserResToDtoUserRes(res), nil
+}
+
+func (c *Client) GetUserByEmail(ctx context.Context, req GetUserByEmailReq) (UserRes, error) {
+	res, err := c.c.GetUserByEmail(ctx, MapDtoGetUserByEmailReqToPbGetUserByEmailReq(req))
+	if err != nil {
+		c.logger.Ctx(ctx).Error("failed to call GetUserByEmail", zap.Error(err))
+		return UserRes{}, err
+	}
+	return MapPbUserResToDtoUserRes(res), nil
+}
@@ -0,0 +1,21 @@
+package user
+
+type GetUserReq struct {
+	Username string
+}
+
+type GetUserByEmailReq struct {
+	Email string
+}
+
+type UserRes struct {
+	ID       int
+	Username string
+	Email    string
+	Role     Role
+}
+
+type Role struct {
+	Name        string
+	Premissions []string
+}
@@ -0,0 +1,27 @@
+package user
+
+import "github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/pkg/user"
+
+func MapDtoGetUserReqToPbGetUserReq(req GetUserReq) *user.GetUserReq {
+	return &user.GetUserReq{
+		Username: req.Username,
+	}
+}
+
+func MapDtoGetUserByEmailReqToPbGetUserByEmailReq(req GetUserByEmailReq) *user.GetUserByEmailReq {
+	return &user.GetUserByEmailReq{
+		Email: req.Email,
+	}
+}
+
+func MapPbUserResToDtoUserRes(res *user.UserRes) UserRes {
+	return UserRes{
+		ID:       int(res.Id),
+		Username: res.Username,
+		Email:    res.Email,
+		Role: Role{
+			Name:        res.GetRole().GetName(),
+			Premissions: res.GetRole().GetPremissions(),
+		},
+	}
+}
@@ -3,7 +3,9 @@ package auth
 import (
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/repository/auth"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/jwks"
 	"strconv"
+	"strings"
 )
 
 func MapDomainVerifyTokenReqToDtoVerifyTokenReq(req domain.Auth) auth.VerifyTokenReq {
@@ -13,12 +15,34 @@ func MapDomainVerifyTokenReqToDtoVerifyTokenReq(req domain.Auth) auth.VerifyToke
 }
 
 func MapDtoVerifyTokenResToDomainVerifyTokenRes(res auth.VerifyTokenRes) domain.User {
+	// Bots are numbered apart from users and OAuth clients are not users, keep their ids from colliding
+	id := strconv.Itoa(res.ID)
+	switch res.Role {
+	case domain.RoleBot:
+		id = "bot-" + id
+	case domain.RoleClient:
+		id = "client-" + res.ClientID
+	}
+
 	return domain.User{
-		ID:       strconv.Itoa(res.ID),
+		ID:       id,
 		Username: res.Username,
 		Email:    res.Email,
 		Role: domain.Role{
 			Name: res.Role,
 		},
+		ClientID: res.ClientID,
+		Scopes:   strings.Fields(res.Scope),
 	}
 }
+
+func MapJwksClaimsToDomainVerifyTokenRes(claims jwks.Claims) domain.User {
+	return MapDtoVerifyTokenResToDomainVerifyTokenRes(auth.VerifyTokenRes{
+		ID:       int(claims.ID),
+		Username: claims.Username,
+		Email:    claims.Email,
+		Role:     claims.Role,
+		ClientID: claims.ClientID,
+		Scope:    claims.Scope,
+	})
+}
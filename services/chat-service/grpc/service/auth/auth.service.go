@@ -2,22 +2,67 @@ package auth
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/repository/auth"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/jwks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type AuthService struct {
-	c auth.IClient
+	c        auth.IClient
+	verifier *jwks.Verifier
+	sessions *sessionCache
+	config   *configs.Config
 }
 
-func NewAuthService(c auth.IClient) *AuthService {
+func NewAuthService(c auth.IClient, verifier *jwks.Verifier, config *configs.Config) *AuthService {
 	return &AuthService{
-		c: c,
+		c:        c,
+		verifier: verifier,
+		sessions: newSessionCache(config.Auth.SessionCacheTTL),
+		config:   config,
 	}
 }
 
+// checkSession makes sure the session of a locally verified token was not ended, asking the
+// auth service once per session and cache TTL. Which tokens have a session to end, bots and
+// OAuth clients included, is up to the auth service, so every token is asked about.
+func (s *AuthService) checkSession(ctx context.Context, req domain.Auth, claims jwks.Claims) error {
+	active, ok := s.sessions.get(claims.SessionID)
+	if !ok {
+		_, err := s.c.VerifyToken(ctx, MapDomainVerifyTokenReqToDtoVerifyTokenReq(req))
+		if err != nil && status.Code(err) != codes.Unauthenticated {
+			return err
+		}
+		active = err == nil
+		s.sessions.set(claims.SessionID, active)
+	}
+
+	if !active {
+		return errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("session has ended"))
+	}
+	return nil
+}
+
+// VerifyToken verifies the token locally with the published signing keys, or with the
+// auth service when the verification is configured as remote.
 func (s *AuthService) VerifyToken(ctx context.Context, req domain.Auth) (domain.User, error) {
+	if s.config.Auth.Verification == "local" {
+		claims, err := s.verifier.Verify(ctx, req.AccessToken)
+		if err != nil {
+			return domain.User{}, errors.NewError(errors.ErrorUnauthorized, err)
+		}
+		if err := s.checkSession(ctx, req, claims); err != nil {
+			return domain.User{}, err
+		}
+		return MapJwksClaimsToDomainVerifyTokenRes(claims), nil
+	}
+
 	dtoReq := MapDomainVerifyTokenReqToDtoVerifyTokenReq(req)
 	dtoRes, err := s.c.VerifyToken(ctx, dtoReq)
 	if err != nil {
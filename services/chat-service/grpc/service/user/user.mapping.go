@@ -0,0 +1,31 @@
+package user
+
+import (
+	"strconv"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/repository/user"
+)
+
+func MapDomainUserToDtoGetUserReq(req domain.User) user.GetUserReq {
+	return user.GetUserReq{
+		Username: req.Username,
+	}
+}
+
+func MapDomainUserToDtoGetUserByEmailReq(req domain.User) user.GetUserByEmailReq {
+	return user.GetUserByEmailReq{
+		Email: req.Email,
+	}
+}
+
+func MapDtoUserResToDomainUser(res user.UserRes) domain.User {
+	return domain.User{
+		ID:       strconv.Itoa(res.ID),
+		Username: res.Username,
+		Email:    res.Email,
+		Role: domain.Role{
+			Name: res.Role.Name,
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/repository/user"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UsersService struct {
+	c user.IClient
+}
+
+func NewUsersService(c user.IClient) *UsersService {
+	return &UsersService{
+		c: c,
+	}
+}
+
+func (s *UsersService) GetUserByUsername(ctx context.Context, req domain.User) (domain.User, error) {
+	dtoReq := MapDomainUserToDtoGetUserReq(req)
+	dtoRes, err := s.c.GetUserByUsername(ctx, dtoReq)
+	if err != nil {
+		return domain.User{}, mapError(err)
+	}
+	return MapDtoUserResToDomainUser(dtoRes), nil
+}
+
+func (s *UsersService) GetUserByEmail(ctx context.Context, req domain.User) (domain.User, error) {
+	dtoReq := MapDomainUserToDtoGetUserByEmailReq(req)
+	dtoRes, err := s.c.GetUserByEmail(ctx, dtoReq)
+	if err != nil {
+		return domain.User{}, mapError(err)
+	}
+	return MapDtoUserResToDomainUser(dtoRes), nil
+}
+
+// mapError keeps "not found" apart from transport failures so callers can tell them apart.
+func mapError(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return errors.NewError(errors.ErrorNotFound, err)
+	}
+	return errors.NewError(errors.ErrorInternal, err)
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// SubscribeBot godoc
+// @Summary Subscribe a bot to a room
+// @Description Register a callback URL that receives the events of a room. Deliveries are signed with the returned secret, which is only shown once. The URL must point to a public address, and redirects are not followed. Requires a bot token.
+// @Tags bots
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param SubscribeBotRequest body SubscribeBotRequest true "Subscribe Bot Request"
+// @Success 201 {object} BotSubscriptionRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/bot/subscribe [post]
+func (h *ChatHandler) SubscribeBot(ctx *fiber.Ctx) error {
+	var req SubscribeBotRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	subscription, err := h.usecase.SubscribeBot(ctx.UserContext(), SubscribeBotReqToDomainBotSubscription(req))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainBotSubscriptionToBotSubscriptionRes(subscription)
+
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
+
+// GetBotSubscriptions godoc
+// @Summary Get the subscriptions of a bot
+// @Description Retrieve the room subscriptions of the calling bot
+// @Tags bots
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} BotSubscriptionRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/bot/get-subscriptions [get]
+func (h *ChatHandler) GetBotSubscriptions(ctx *fiber.Ctx) error {
+	subscriptions, err := h.usecase.GetBotSubscriptions(ctx.UserContext())
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainBotSubscriptionsToBotSubscriptionsRes(subscriptions)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// UnsubscribeBot godoc
+// @Summary Unsubscribe a bot from a room
+// @Description Remove a subscription of the calling bot and drop its pending deliveries
+// @Tags bots
+// @Security BearerAuth
+// @Produce json
+// @Param subscriptionId path int true "Subscription ID"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/bot/unsubscribe/{subscriptionId} [delete]
+func (h *ChatHandler) UnsubscribeBot(ctx *fiber.Ctx) error {
+	subscriptionID, err := strconv.Atoi(ctx.Params("subscriptionId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	if err := h.usecase.UnsubscribeBot(ctx.UserContext(), subscriptionID); err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+// SendBotMessage godoc
+// @Summary Post a message as a bot
+// @Description Post a message to a room the calling bot is subscribed to. The message is saved and broadcast with the bot flag set.
+// @Tags bots
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param roomId path string true "Room ID"
+// @Param SendBotMessageRequest body SendBotMessageRequest true "Send Bot Message Request"
+// @Success 201 {object} MessageRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/bot/send-message/{roomId} [post]
+func (h *ChatHandler) SendBotMessage(ctx *fiber.Ctx) error {
+	var req SendBotMessageRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	message, err := h.usecase.SendBotMessage(ctx.UserContext(), SendBotMessageReqToDomainChat(ctx.Params("roomId"), req))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainChatToMessageRes(message)
+
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetCalls godoc
+// @Summary Get the calls of a room
+// @Description Retrieve the latest calls of a room, newest first, with everyone who has been in them. Calls are started, joined and left over the WebSocket with {"type":"call","call":{"action":"start"}} and {"type":"call","call":{"action":"join","id":1}}, and participants exchange SDP offers, answers and ICE candidates with {"type":"signal","signal":{"callId":1,"kind":"offer","to":"<user id>","sdp":"..."}}.
+// @Tags calls
+// @Security BearerAuth
+// @Produce json
+// @Param roomId path string true "Room ID"
+// @Param active query bool false "Only the call in progress"
+// @Param limit query int false "Maximum number of calls, at most 200"
+// @Success 200 {array} CallRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/get-calls/{roomId} [get]
+func (h *ChatHandler) GetCalls(ctx *fiber.Ctx) error {
+	var req GetCallsRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	calls, err := h.usecase.GetCalls(ctx.UserContext(), GetCallsReqToDomainCallFilter(ctx.Params("roomId"), req))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainCallsToGetCallsRes(calls)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// GetCall godoc
+// @Summary Get a call
+// @Description Retrieve a call with everyone who has been in it
+// @Tags calls
+// @Security BearerAuth
+// @Produce json
+// @Param callId path int true "Call ID"
+// @Success 200 {object} CallRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/get-call/{callId} [get]
+func (h *ChatHandler) GetCall(ctx *fiber.Ctx) error {
+	callID, err := strconv.Atoi(ctx.Params("callId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	call, err := h.usecase.GetCall(ctx.UserContext(), callID)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainCallToCallRes(call)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// EndCall godoc
+// @Summary End a call
+// @Description End a call for everyone in it and tell the room. Only the user who started the call and the moderators of its room can end it.
+// @Tags calls
+// @Security BearerAuth
+// @Produce json
+// @Param callId path int true "Call ID"
+// @Success 200 {object} CallRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/end-call/{callId} [post]
+func (h *ChatHandler) EndCall(ctx *fiber.Ctx) error {
+	callID, err := strconv.Atoi(ctx.Params("callId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	call, err := h.usecase.EndCall(ctx.UserContext(), callID)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainCallToCallRes(call)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
@@ -1,7 +1,10 @@
 package handler
 
 import (
+	"time"
+
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
 	"github.com/gofiber/websocket/v2"
 )
 
@@ -9,14 +12,38 @@ type CreateRoomRequest struct {
 	Name string `json:"name"`
 }
 
-type JoinRoomRequest struct {
-	UserID   string `json:"userId"`
-	Username string `json:"username"`
+type RoomRes struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	OwnerID string `json:"ownerId,omitempty"`
 }
 
-type RoomRes struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+type GetRoomsRequest struct {
+	Query    string `query:"q"`
+	Sort     string `query:"sort"`
+	Page     int    `query:"page"`
+	PageSize int    `query:"pageSize"`
+}
+
+type RoomSummaryRes struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	OwnerID        string     `json:"ownerId,omitempty"`
+	Members        int        `json:"members"`
+	Online         int        `json:"online"`
+	LastActivityAt *time.Time `json:"lastActivityAt,omitempty"`
+}
+
+type GetRoomsRes struct {
+	Rooms    []RoomSummaryRes `json:"rooms"`
+	Total    int              `json:"total"`
+	Page     int              `json:"page"`
+	PageSize int              `json:"pageSize"`
+}
+
+type GetHistoryRequest struct {
+	BeforeID int `query:"beforeId"`
+	Limit    int `query:"limit"`
 }
 
 type ClientRes struct {
@@ -34,30 +61,49 @@ func CreateRoomReqToDomainChat(req CreateRoomRequest) domain.Chat {
 
 func DomainChatToRoomRes(chat domain.Chat) RoomRes {
 	return RoomRes{
-		ID:   chat.Room.ID,
-		Name: chat.Room.Name,
+		ID:      chat.Room.ID,
+		Name:    chat.Room.Name,
+		OwnerID: chat.Room.OwnerID,
 	}
 }
 
-func JoinRoomReqToDomainChat(roomID string, req JoinRoomRequest, conn *websocket.Conn) domain.Chat {
+func JoinRoomReqToDomainChat(roomID string, user domain.User, conn *websocket.Conn) domain.Chat {
 	return domain.Chat{
 		Room: domain.Room{
 			ID: roomID,
 		},
-		User: domain.User{
-			ID:       req.UserID,
-			Username: req.Username,
-		},
+		User: user,
 		Conn: conn,
 	}
 }
 
-func DomainChatToGetRoomsRes(chat []domain.Chat) []RoomRes {
-	var res []RoomRes
-	for _, c := range chat {
-		res = append(res, RoomRes{
-			ID:   c.Room.ID,
-			Name: c.Room.Name,
+func GetRoomsReqToDomainRoomFilter(req GetRoomsRequest) domain.RoomFilter {
+	filter := domain.RoomFilter{
+		Query: req.Query,
+		Sort:  domain.RoomSort(req.Sort),
+		Limit: req.PageSize,
+	}
+	if req.Page > 1 && req.PageSize > 0 {
+		filter.Offset = (req.Page - 1) * req.PageSize
+	}
+	return filter
+}
+
+func DomainRoomPageToGetRoomsRes(page domain.RoomPage) GetRoomsRes {
+	res := GetRoomsRes{
+		Rooms:    make([]RoomSummaryRes, 0, len(page.Rooms)),
+		Total:    page.Total,
+		Page:     page.Offset/page.Limit + 1,
+		PageSize: page.Limit,
+	}
+	for _, room := range page.Rooms {
+		res.Rooms = append(res.Rooms, RoomSummaryRes{
+			ID:             room.Room.ID,
+			Name:           room.Room.Name,
+			OwnerID:        room.Room.OwnerID,
+			Members:        room.Members,
+			Online:         room.Online,
+			LastActivityAt: room.LastActivityAt,
 		})
 	}
 	return res
@@ -81,3 +127,770 @@ func DomainChatToGetClientsRes(chat []domain.Chat) []ClientRes {
 	}
 	return res
 }
+
+type UpdateRoomRetentionRequest struct {
+	// MaxAge is a Go duration such as "720h"; "0s" keeps messages forever and null falls back to the global default.
+	MaxAge *string `json:"maxAge"`
+	// MaxMessages is the number of newest messages to keep; 0 keeps all and null falls back to the global default.
+	MaxMessages *int `json:"maxMessages"`
+}
+
+type RoomRetentionRes struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	MaxAge      *string `json:"maxAge"`
+	MaxMessages *int    `json:"maxMessages"`
+}
+
+type PurgeMessagesRequest struct {
+	DryRun bool `json:"dryRun"`
+}
+
+type PurgeRes struct {
+	ID           int       `json:"id"`
+	RoomID       string    `json:"roomId"`
+	Reason       string    `json:"reason"`
+	Mode         string    `json:"mode"`
+	DryRun       bool      `json:"dryRun"`
+	MessageCount int       `json:"messageCount"`
+	MessageIDs   []int     `json:"messageIds"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+type PurgeReportRes struct {
+	DryRun     bool       `json:"dryRun"`
+	Mode       string     `json:"mode"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt time.Time  `json:"finishedAt"`
+	Purges     []PurgeRes `json:"purges"`
+}
+
+func UpdateRoomRetentionReqToDomainChat(roomID string, req UpdateRoomRetentionRequest) (domain.Chat, error) {
+	chat := domain.Chat{
+		Room: domain.Room{
+			ID: roomID,
+			Retention: domain.Retention{
+				MaxMessages: req.MaxMessages,
+			},
+		},
+	}
+
+	if req.MaxAge != nil {
+		maxAge, err := time.ParseDuration(*req.MaxAge)
+		if err != nil {
+			return domain.Chat{}, err
+		}
+		chat.Room.Retention.MaxAge = &maxAge
+	}
+
+	return chat, nil
+}
+
+func DomainChatToRoomRetentionRes(chat domain.Chat) RoomRetentionRes {
+	res := RoomRetentionRes{
+		ID:          chat.Room.ID,
+		Name:        chat.Room.Name,
+		MaxMessages: chat.Room.Retention.MaxMessages,
+	}
+
+	if chat.Room.Retention.MaxAge != nil {
+		maxAge := chat.Room.Retention.MaxAge.String()
+		res.MaxAge = &maxAge
+	}
+
+	return res
+}
+
+func GetPurgesReqToDomainChat(roomID string) domain.Chat {
+	return domain.Chat{
+		Room: domain.Room{
+			ID: roomID,
+		},
+	}
+}
+
+func DomainPurgeToPurgeRes(purge domain.Purge) PurgeRes {
+	return PurgeRes{
+		ID:           purge.ID,
+		RoomID:       purge.RoomID,
+		Reason:       string(purge.Reason),
+		Mode:         string(purge.Mode),
+		DryRun:       purge.DryRun,
+		MessageCount: purge.MessageCount,
+		MessageIDs:   purge.MessageIDs,
+		CreatedAt:    purge.CreatedAt,
+	}
+}
+
+func DomainPurgesToGetPurgesRes(purges []domain.Purge) []PurgeRes {
+	var res []PurgeRes
+	for _, purge := range purges {
+		res = append(res, DomainPurgeToPurgeRes(purge))
+	}
+	return res
+}
+
+func DomainPurgeReportToPurgeReportRes(report domain.PurgeReport) PurgeReportRes {
+	return PurgeReportRes{
+		DryRun:     report.DryRun,
+		Mode:       string(report.Mode),
+		StartedAt:  report.StartedAt,
+		FinishedAt: report.FinishedAt,
+		Purges:     DomainPurgesToGetPurgesRes(report.Purges),
+	}
+}
+
+type ExportRoomRequest struct {
+	// Format is one of "jsonl", "csv" or "html".
+	Format string `json:"format"`
+	// From and To are optional RFC 3339 timestamps limiting the exported messages.
+	From *time.Time `json:"from"`
+	To   *time.Time `json:"to"`
+}
+
+type ExportRes struct {
+	ID           int        `json:"id"`
+	RoomID       string     `json:"roomId"`
+	Format       string     `json:"format"`
+	From         *time.Time `json:"from"`
+	To           *time.Time `json:"to"`
+	Status       string     `json:"status"`
+	Error        string     `json:"error,omitempty"`
+	MessageCount int        `json:"messageCount"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	CompletedAt  *time.Time `json:"completedAt"`
+}
+
+func ExportRoomReqToDomainExport(roomID string, req ExportRoomRequest) domain.Export {
+	return domain.Export{
+		RoomID: roomID,
+		Format: domain.ExportFormat(req.Format),
+		From:   req.From,
+		To:     req.To,
+	}
+}
+
+func DomainExportToExportRes(export domain.Export) ExportRes {
+	return ExportRes{
+		ID:           export.ID,
+		RoomID:       export.RoomID,
+		Format:       string(export.Format),
+		From:         export.From,
+		To:           export.To,
+		Status:       string(export.Status),
+		Error:        export.Error,
+		MessageCount: export.MessageCount,
+		CreatedAt:    export.CreatedAt,
+		CompletedAt:  export.CompletedAt,
+	}
+}
+
+type ImportRes struct {
+	ID               int               `json:"id"`
+	Format           string            `json:"format"`
+	Status           string            `json:"status"`
+	RoomsCreated     int               `json:"roomsCreated"`
+	RoomsCompleted   int               `json:"roomsCompleted"`
+	MessagesImported int               `json:"messagesImported"`
+	MessagesSkipped  int               `json:"messagesSkipped"`
+	UnmappedUsers    []UnmappedUserRes `json:"unmappedUsers"`
+	Error            string            `json:"error,omitempty"`
+	CreatedAt        time.Time         `json:"createdAt"`
+	CompletedAt      *time.Time        `json:"completedAt"`
+}
+
+type UnmappedUserRes struct {
+	Key      string `json:"key"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Messages int    `json:"messages"`
+}
+
+func DomainImportToImportRes(imp domain.Import) ImportRes {
+	unmappedUsers := make([]UnmappedUserRes, 0, len(imp.UnmappedUsers))
+	for _, user := range imp.UnmappedUsers {
+		unmappedUsers = append(unmappedUsers, UnmappedUserRes{
+			Key:      user.Key,
+			Name:     user.Name,
+			Email:    user.Email,
+			Messages: user.Messages,
+		})
+	}
+
+	return ImportRes{
+		ID:               imp.ID,
+		Format:           string(imp.Format),
+		Status:           string(imp.Status),
+		RoomsCreated:     imp.RoomsCreated,
+		RoomsCompleted:   len(imp.CompletedRooms),
+		MessagesImported: imp.MessagesImported,
+		MessagesSkipped:  imp.MessagesSkipped,
+		UnmappedUsers:    unmappedUsers,
+		Error:            imp.Error,
+		CreatedAt:        imp.CreatedAt,
+		CompletedAt:      imp.CompletedAt,
+	}
+}
+
+type SubscribeBotRequest struct {
+	RoomID      string `json:"roomId"`
+	CallbackURL string `json:"callbackUrl"`
+	// Events is any of "message", "join" and "mention"; it defaults to "message".
+	Events []string `json:"events"`
+}
+
+type BotSubscriptionRes struct {
+	ID          int       `json:"id"`
+	RoomID      string    `json:"roomId"`
+	CallbackURL string    `json:"callbackUrl"`
+	Events      []string  `json:"events"`
+	Secret      string    `json:"secret,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type SendBotMessageRequest struct {
+	Content string `json:"content"`
+}
+
+type MessageRes struct {
+	ID          int             `json:"id"`
+	RoomID      string          `json:"roomId"`
+	Username    string          `json:"username"`
+	Content     string          `json:"content"`
+	Bot         bool            `json:"bot"`
+	Attachments []AttachmentRes `json:"attachments,omitempty"`
+	CreatedAt   time.Time       `json:"createdAt"`
+}
+
+type AttachmentRes struct {
+	Title string `json:"title,omitempty"`
+	URL   string `json:"url,omitempty"`
+	Text  string `json:"text,omitempty"`
+}
+
+func SubscribeBotReqToDomainBotSubscription(req SubscribeBotRequest) domain.BotSubscription {
+	events := make([]domain.BotEventType, 0, len(req.Events))
+	for _, event := range req.Events {
+		events = append(events, domain.BotEventType(event))
+	}
+
+	return domain.BotSubscription{
+		RoomID:      req.RoomID,
+		CallbackURL: req.CallbackURL,
+		Events:      events,
+	}
+}
+
+func DomainBotSubscriptionToBotSubscriptionRes(subscription domain.BotSubscription) BotSubscriptionRes {
+	events := make([]string, 0, len(subscription.Events))
+	for _, event := range subscription.Events {
+		events = append(events, string(event))
+	}
+
+	return BotSubscriptionRes{
+		ID:          subscription.ID,
+		RoomID:      subscription.RoomID,
+		CallbackURL: subscription.CallbackURL,
+		Events:      events,
+		Secret:      subscription.Secret,
+		CreatedAt:   subscription.CreatedAt,
+	}
+}
+
+func DomainBotSubscriptionsToBotSubscriptionsRes(subscriptions []domain.BotSubscription) []BotSubscriptionRes {
+	res := make([]BotSubscriptionRes, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		res = append(res, DomainBotSubscriptionToBotSubscriptionRes(subscription))
+	}
+	return res
+}
+
+func SendBotMessageReqToDomainChat(roomID string, req SendBotMessageRequest) domain.Chat {
+	return domain.Chat{
+		Room: domain.Room{
+			ID: roomID,
+		},
+		Message: domain.Message{
+			Content: req.Content,
+		},
+	}
+}
+
+func DomainChatToMessageRes(chat domain.Chat) MessageRes {
+	var attachments []AttachmentRes
+	for _, attachment := range chat.Message.Attachments {
+		attachments = append(attachments, AttachmentRes{
+			Title: attachment.Title,
+			URL:   attachment.URL,
+			Text:  attachment.Text,
+		})
+	}
+
+	return MessageRes{
+		ID:          chat.Message.ID,
+		RoomID:      chat.Message.RoomID,
+		Username:    chat.Message.Username,
+		Content:     chat.Message.Content,
+		Bot:         chat.Message.Bot,
+		Attachments: attachments,
+		CreatedAt:   chat.Message.CreatedAt,
+	}
+}
+
+func GetHistoryReqToDomainMessageFilter(roomID string, req GetHistoryRequest) domain.MessageFilter {
+	return domain.MessageFilter{
+		RoomID:   roomID,
+		BeforeID: req.BeforeID,
+		Limit:    req.Limit,
+	}
+}
+
+func DomainMessagesToGetHistoryRes(messages []domain.Message) []MessageRes {
+	res := make([]MessageRes, 0, len(messages))
+	for _, message := range messages {
+		res = append(res, DomainChatToMessageRes(domain.Chat{Message: message}))
+	}
+	return res
+}
+
+type CreateWebhookRequest struct {
+	// Name is the default username of the messages posted through the webhook.
+	Name string `json:"name"`
+}
+
+type WebhookRes struct {
+	ID        int        `json:"id"`
+	RoomID    string     `json:"roomId"`
+	Name      string     `json:"name"`
+	CreatedBy string     `json:"createdBy"`
+	IsRevoked bool       `json:"isRevoked"`
+	CreatedAt time.Time  `json:"createdAt"`
+	LastUsed  *time.Time `json:"lastUsedAt"`
+}
+
+type CreateWebhookRes struct {
+	WebhookRes
+	// Token is only returned once; URL is the path to post messages to.
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+type WebhookMessageRequest struct {
+	Text string `json:"text"`
+	// Username overrides the name of the webhook for this message.
+	Username    string                  `json:"username"`
+	Attachments []WebhookAttachmentBody `json:"attachments"`
+}
+
+type WebhookAttachmentBody struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+	Text  string `json:"text"`
+}
+
+func CreateWebhookReqToDomainWebhook(roomID string, req CreateWebhookRequest) domain.Webhook {
+	return domain.Webhook{
+		RoomID: roomID,
+		Name:   req.Name,
+	}
+}
+
+func DomainWebhookToWebhookRes(webhook domain.Webhook) WebhookRes {
+	return WebhookRes{
+		ID:        webhook.ID,
+		RoomID:    webhook.RoomID,
+		Name:      webhook.Name,
+		CreatedBy: webhook.CreatedBy,
+		IsRevoked: webhook.IsRevoked,
+		CreatedAt: webhook.CreatedAt,
+		LastUsed:  webhook.LastUsedAt,
+	}
+}
+
+func DomainWebhookToCreateWebhookRes(webhook domain.Webhook) CreateWebhookRes {
+	return CreateWebhookRes{
+		WebhookRes: DomainWebhookToWebhookRes(webhook),
+		Token:      webhook.Token,
+		URL:        "/ws/webhooks/" + webhook.Token,
+	}
+}
+
+func DomainWebhooksToGetWebhooksRes(webhooks []domain.Webhook) []WebhookRes {
+	res := make([]WebhookRes, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		res = append(res, DomainWebhookToWebhookRes(webhook))
+	}
+	return res
+}
+
+func WebhookMessageReqToDomainChat(req WebhookMessageRequest) domain.Chat {
+	attachments := make([]domain.Attachment, 0, len(req.Attachments))
+	for _, attachment := range req.Attachments {
+		attachments = append(attachments, domain.Attachment{
+			Title: attachment.Title,
+			URL:   attachment.URL,
+			Text:  attachment.Text,
+		})
+	}
+
+	return domain.Chat{
+		Message: domain.Message{
+			Username:    req.Username,
+			Content:     req.Text,
+			Attachments: attachments,
+		},
+	}
+}
+
+type ScheduleMessageRequest struct {
+	Content string    `json:"content"`
+	SendAt  time.Time `json:"sendAt"`
+	// TimeZone is an IANA time zone such as "Europe/Berlin". When set, the wall clock
+	// of sendAt is read in that zone and its offset is ignored.
+	TimeZone string `json:"timeZone"`
+}
+
+type ScheduledMessageRes struct {
+	ID        int        `json:"id"`
+	RoomID    string     `json:"roomId"`
+	UserID    string     `json:"userId"`
+	Username  string     `json:"username"`
+	Content   string     `json:"content"`
+	SendAt    time.Time  `json:"sendAt"`
+	TimeZone  string     `json:"timeZone"`
+	Status    string     `json:"status"`
+	MessageID *int       `json:"messageId"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	SentAt    *time.Time `json:"sentAt"`
+}
+
+type GetScheduledMessagesRequest struct {
+	RoomID string `query:"roomId"`
+	Status string `query:"status"`
+}
+
+func ScheduleMessageReqToDomainScheduledMessage(id int, roomID string, req ScheduleMessageRequest) domain.ScheduledMessage {
+	return domain.ScheduledMessage{
+		ID:       id,
+		RoomID:   roomID,
+		Content:  req.Content,
+		SendAt:   req.SendAt,
+		TimeZone: req.TimeZone,
+	}
+}
+
+func GetScheduledMessagesReqToDomainFilter(req GetScheduledMessagesRequest) domain.ScheduledMessageFilter {
+	return domain.ScheduledMessageFilter{
+		RoomID: req.RoomID,
+		Status: domain.ScheduledMessageStatus(req.Status),
+	}
+}
+
+func DomainScheduledMessageToScheduledMessageRes(scheduledMessage domain.ScheduledMessage) ScheduledMessageRes {
+	// Show the send time in the zone it was scheduled in
+	sendAt := scheduledMessage.SendAt
+	if location, err := time.LoadLocation(scheduledMessage.TimeZone); err == nil {
+		sendAt = sendAt.In(location)
+	}
+
+	return ScheduledMessageRes{
+		ID:        scheduledMessage.ID,
+		RoomID:    scheduledMessage.RoomID,
+		UserID:    scheduledMessage.UserID,
+		Username:  scheduledMessage.Username,
+		Content:   scheduledMessage.Content,
+		SendAt:    sendAt,
+		TimeZone:  scheduledMessage.TimeZone,
+		Status:    string(scheduledMessage.Status),
+		MessageID: scheduledMessage.MessageID,
+		CreatedAt: scheduledMessage.CreatedAt,
+		UpdatedAt: scheduledMessage.UpdatedAt,
+		SentAt:    scheduledMessage.SentAt,
+	}
+}
+
+func DomainScheduledMessagesToGetScheduledMessagesRes(scheduledMessages []domain.ScheduledMessage) []ScheduledMessageRes {
+	res := make([]ScheduledMessageRes, 0, len(scheduledMessages))
+	for _, scheduledMessage := range scheduledMessages {
+		res = append(res, DomainScheduledMessageToScheduledMessageRes(scheduledMessage))
+	}
+	return res
+}
+
+type PinnedMessageRes struct {
+	ID       int        `json:"id"`
+	RoomID   string     `json:"roomId"`
+	PinnedBy string     `json:"pinnedBy"`
+	PinnedAt time.Time  `json:"pinnedAt"`
+	Message  MessageRes `json:"message"`
+}
+
+type SavedMessageRes struct {
+	ID      int        `json:"id"`
+	SavedAt time.Time  `json:"savedAt"`
+	Message MessageRes `json:"message"`
+}
+
+type GetSavedMessagesRequest struct {
+	RoomID string `query:"roomId"`
+}
+
+func DomainPinnedMessageToPinnedMessageRes(pin domain.PinnedMessage) PinnedMessageRes {
+	return PinnedMessageRes{
+		ID:       pin.ID,
+		RoomID:   pin.RoomID,
+		PinnedBy: pin.PinnedBy,
+		PinnedAt: pin.PinnedAt,
+		Message:  DomainChatToMessageRes(domain.Chat{Message: pin.Message}),
+	}
+}
+
+func DomainPinnedMessagesToGetPinnedMessagesRes(pins []domain.PinnedMessage) []PinnedMessageRes {
+	res := make([]PinnedMessageRes, 0, len(pins))
+	for _, pin := range pins {
+		res = append(res, DomainPinnedMessageToPinnedMessageRes(pin))
+	}
+	return res
+}
+
+func DomainSavedMessageToSavedMessageRes(saved domain.SavedMessage) SavedMessageRes {
+	return SavedMessageRes{
+		ID:      saved.ID,
+		SavedAt: saved.SavedAt,
+		Message: DomainChatToMessageRes(domain.Chat{Message: saved.Message}),
+	}
+}
+
+func DomainSavedMessagesToGetSavedMessagesRes(saved []domain.SavedMessage) []SavedMessageRes {
+	res := make([]SavedMessageRes, 0, len(saved))
+	for _, s := range saved {
+		res = append(res, DomainSavedMessageToSavedMessageRes(s))
+	}
+	return res
+}
+
+type CreatePollRequest struct {
+	Question  string   `json:"question"`
+	Options   []string `json:"options"`
+	Multiple  bool     `json:"multiple"`
+	Anonymous bool     `json:"anonymous"`
+	// ClosesAt is optional; polls without it stay open until they are closed by hand.
+	ClosesAt *time.Time `json:"closesAt"`
+}
+
+type VotePollRequest struct {
+	// Options are the indexes of the chosen options; an empty list retracts the vote.
+	Options []int `json:"options"`
+}
+
+type RoomPollRes struct {
+	ID               int                 `json:"id"`
+	RoomID           string              `json:"roomId"`
+	MessageID        int                 `json:"messageId"`
+	Question         string              `json:"question"`
+	Options          []RoomPollOptionRes `json:"options"`
+	Multiple         bool                `json:"multiple"`
+	Anonymous        bool                `json:"anonymous"`
+	CreatedBy        string              `json:"createdBy"`
+	Voters           int                 `json:"voters"`
+	ClosesAt         *time.Time          `json:"closesAt"`
+	ClosedAt         *time.Time          `json:"closedAt"`
+	SummaryMessageID *int                `json:"summaryMessageId"`
+	CreatedAt        time.Time           `json:"createdAt"`
+}
+
+type RoomPollOptionRes struct {
+	Text   string   `json:"text"`
+	Votes  int      `json:"votes"`
+	Voters []string `json:"voters,omitempty"`
+}
+
+func CreatePollReqToDomainPoll(roomID string, req CreatePollRequest) domain.Poll {
+	return domain.Poll{
+		RoomID:    roomID,
+		Question:  req.Question,
+		Options:   req.Options,
+		Multiple:  req.Multiple,
+		Anonymous: req.Anonymous,
+		ClosesAt:  req.ClosesAt,
+	}
+}
+
+func DomainPollToRoomPollRes(poll domain.Poll) RoomPollRes {
+	tallies, voters := poll.Tally()
+	options := make([]RoomPollOptionRes, 0, len(tallies))
+	for _, tally := range tallies {
+		options = append(options, RoomPollOptionRes{
+			Text:   tally.Option,
+			Votes:  tally.Votes,
+			Voters: tally.Voters,
+		})
+	}
+
+	return RoomPollRes{
+		ID:               poll.ID,
+		RoomID:           poll.RoomID,
+		MessageID:        poll.MessageID,
+		Question:         poll.Question,
+		Options:          options,
+		Multiple:         poll.Multiple,
+		Anonymous:        poll.Anonymous,
+		CreatedBy:        poll.CreatedByName,
+		Voters:           voters,
+		ClosesAt:         poll.ClosesAt,
+		ClosedAt:         poll.ClosedAt,
+		SummaryMessageID: poll.SummaryMessageID,
+		CreatedAt:        poll.CreatedAt,
+	}
+}
+
+type SessionRes struct {
+	SessionID string `json:"sessionId"`
+	Transport string `json:"transport"`
+	Cursor    int64  `json:"cursor"`
+}
+
+type EventRes struct {
+	Seq         int64           `json:"seq"`
+	Content     string          `json:"content"`
+	RoomID      string          `json:"roomId"`
+	Username    string          `json:"username"`
+	Bot         bool            `json:"bot,omitempty"`
+	Attachments []AttachmentRes `json:"attachments,omitempty"`
+	Type        string          `json:"type,omitempty"`
+	Pin         *PinRes         `json:"pin,omitempty"`
+	Poll        *ws.Poll        `json:"poll,omitempty"`
+	Call        *ws.Call        `json:"call,omitempty"`
+	Signal      *ws.Signal      `json:"signal,omitempty"`
+}
+
+type PinRes struct {
+	MessageID int    `json:"messageId"`
+	Content   string `json:"content,omitempty"`
+	Author    string `json:"author,omitempty"`
+	By        string `json:"by"`
+}
+
+type PollRes struct {
+	Events []EventRes `json:"events"`
+	// Cursor is passed to the next poll to receive the following events.
+	Cursor int64 `json:"cursor"`
+}
+
+type SendMessageRequest struct {
+	Content string `json:"content"`
+}
+
+func DomainSessionToSessionRes(session *ws.Session) SessionRes {
+	return SessionRes{
+		SessionID: session.ID,
+		Transport: session.Client.Transport,
+		Cursor:    session.Cursor(),
+	}
+}
+
+func WSEventToEventRes(event ws.Event) EventRes {
+	var attachments []AttachmentRes
+	for _, attachment := range event.Message.Attachments {
+		attachments = append(attachments, AttachmentRes{
+			Title: attachment.Title,
+			URL:   attachment.URL,
+			Text:  attachment.Text,
+		})
+	}
+
+	var pin *PinRes
+	if event.Message.Pin != nil {
+		pin = &PinRes{
+			MessageID: event.Message.Pin.MessageID,
+			Content:   event.Message.Pin.Content,
+			Author:    event.Message.Pin.Author,
+			By:        event.Message.Pin.By,
+		}
+	}
+
+	return EventRes{
+		Seq:         event.Seq,
+		Content:     event.Message.Content,
+		RoomID:      event.Message.RoomID,
+		Username:    event.Message.Username,
+		Bot:         event.Message.Bot,
+		Attachments: attachments,
+		Type:        event.Message.Type,
+		Pin:         pin,
+		Poll:        event.Message.Poll,
+		Call:        event.Message.Call,
+		Signal:      event.Message.Signal,
+	}
+}
+
+func WSEventsToPollRes(events []ws.Event, cursor int64) PollRes {
+	res := PollRes{
+		Events: make([]EventRes, 0, len(events)),
+		Cursor: cursor,
+	}
+	for _, event := range events {
+		res.Events = append(res.Events, WSEventToEventRes(event))
+	}
+	return res
+}
+
+type GetCallsRequest struct {
+	Active bool `query:"active"`
+	Limit  int  `query:"limit"`
+}
+
+type CallRes struct {
+	ID            int                  `json:"id"`
+	RoomID        string               `json:"roomId"`
+	StartedBy     string               `json:"startedBy"`
+	StartedByName string               `json:"startedByName"`
+	StartedAt     time.Time            `json:"startedAt"`
+	EndedAt       *time.Time           `json:"endedAt,omitempty"`
+	Participants  []CallParticipantRes `json:"participants"`
+}
+
+type CallParticipantRes struct {
+	UserID   string     `json:"userId"`
+	Username string     `json:"username"`
+	JoinedAt time.Time  `json:"joinedAt"`
+	LeftAt   *time.Time `json:"leftAt,omitempty"`
+}
+
+func GetCallsReqToDomainCallFilter(roomID string, req GetCallsRequest) domain.CallFilter {
+	return domain.CallFilter{
+		RoomID: roomID,
+		Active: req.Active,
+		Limit:  req.Limit,
+	}
+}
+
+func DomainCallToCallRes(call domain.Call) CallRes {
+	res := CallRes{
+		ID:            call.ID,
+		RoomID:        call.RoomID,
+		StartedBy:     call.StartedBy,
+		StartedByName: call.StartedByName,
+		StartedAt:     call.StartedAt,
+		EndedAt:       call.EndedAt,
+		Participants:  make([]CallParticipantRes, 0, len(call.Participants)),
+	}
+	for _, participant := range call.Participants {
+		res.Participants = append(res.Participants, CallParticipantRes{
+			UserID:   participant.UserID,
+			Username: participant.Username,
+			JoinedAt: participant.JoinedAt,
+			LeftAt:   participant.LeftAt,
+		})
+	}
+	return res
+}
+
+func DomainCallsToGetCallsRes(calls []domain.Call) []CallRes {
+	res := make([]CallRes, 0, len(calls))
+	for _, call := range calls {
+		res = append(res, DomainCallToCallRes(call))
+	}
+	return res
+}
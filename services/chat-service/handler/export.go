@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/transcript"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// exportStatusTrailer tells the clients of a streamed export whether it completed.
+const exportStatusTrailer = "X-Export-Status"
+
+// ExportRoom godoc
+// @Summary Export the transcript of a room
+// @Description Export the messages of a room as JSON Lines, CSV or HTML. Small exports are streamed in the response, followed by an X-Export-Status trailer that is "completed" unless the transcript was cut short; exports above the sync limit are queued and returned with status 202.
+// @Tags export
+// @Security BearerAuth
+// @Accept json
+// @Produce json,plain,html
+// @Param roomId path string true "Room ID"
+// @Param ExportRoomRequest body ExportRoomRequest true "Export Room Request"
+// @Success 200 {file} file
+// @Success 202 {object} ExportRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/export-room/{roomId} [post]
+func (h *ChatHandler) ExportRoom(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomId")
+
+	var req ExportRoomRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	export, err := h.usecase.ExportRoom(ctx.UserContext(), ExportRoomReqToDomainExport(roomID, req))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	if export.Status == domain.ExportStatusPending {
+		return ctx.Status(fiber.StatusAccepted).JSON(DomainExportToExportRes(export))
+	}
+
+	format := transcript.Format(export.Format)
+	ctx.Attachment(fmt.Sprintf("room-%s.%s", roomID, format))
+	ctx.Set(fiber.HeaderContentType, format.ContentType())
+
+	// The body is streamed chunked, so the outcome is sent in a trailer after the last
+	// chunk: a transcript without "X-Export-Status: completed" was cut short.
+	userCtx := ctx.UserContext()
+	fctx := ctx.Context()
+	fctx.Response.Header.SetTrailer(exportStatusTrailer)
+	fctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		status := domain.ExportStatusCompleted
+		if _, err := h.usecase.WriteTranscript(userCtx, export, w); err != nil {
+			h.logger.Ctx(userCtx).Error("error streaming export", zap.String("room_id", export.RoomID), zap.Error(err))
+			status = domain.ExportStatusFailed
+		}
+		if err := w.Flush(); err != nil {
+			status = domain.ExportStatusFailed
+		}
+		fctx.Response.Header.Set(exportStatusTrailer, string(status))
+	})
+
+	return nil
+}
+
+// GetExport godoc
+// @Summary Get an export
+// @Description Retrieve the status of a queued transcript export
+// @Tags export
+// @Security BearerAuth
+// @Produce json
+// @Param exportId path int true "Export ID"
+// @Success 200 {object} ExportRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/get-export/{exportId} [get]
+func (h *ChatHandler) GetExport(ctx *fiber.Ctx) error {
+	exportID, err := strconv.Atoi(ctx.Params("exportId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	export, err := h.usecase.GetExport(ctx.UserContext(), domain.Export{ID: exportID})
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainExportToExportRes(export)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// DownloadExport godoc
+// @Summary Download an export
+// @Description Download the transcript of a completed export, from any replica. The body is streamed, followed by an X-Export-Status trailer that is "completed" unless the download was cut short.
+// @Tags export
+// @Security BearerAuth
+// @Produce json,plain,html
+// @Param exportId path int true "Export ID"
+// @Success 200 {file} file
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/download-export/{exportId} [get]
+func (h *ChatHandler) DownloadExport(ctx *fiber.Ctx) error {
+	exportID, err := strconv.Atoi(ctx.Params("exportId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	export, err := h.usecase.GetExport(ctx.UserContext(), domain.Export{ID: exportID})
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	if export.Status != domain.ExportStatusCompleted {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorConflict, fmt.Errorf("export is %s", export.Status)))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	format := transcript.Format(export.Format)
+	ctx.Attachment(fmt.Sprintf("room-%s.%s", export.RoomID, format))
+	ctx.Set(fiber.HeaderContentType, format.ContentType())
+
+	userCtx := ctx.UserContext()
+	fctx := ctx.Context()
+	fctx.Response.Header.SetTrailer(exportStatusTrailer)
+	fctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		status := domain.ExportStatusCompleted
+		if err := h.usecase.WriteExport(userCtx, export, w); err != nil {
+			h.logger.Ctx(userCtx).Error("error streaming export", zap.Int("export_id", export.ID), zap.Error(err))
+			status = domain.ExportStatusFailed
+		}
+		if err := w.Flush(); err != nil {
+			status = domain.ExportStatusFailed
+		}
+		fctx.Response.Header.Set(exportStatusTrailer, string(status))
+	})
+
+	return nil
+}
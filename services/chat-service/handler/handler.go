@@ -4,7 +4,10 @@ import (
 	"log"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/usecase"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ratelimit"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
 	"github.com/go-redis/redis/v8"
 	"github.com/gofiber/fiber/v2"
@@ -12,23 +15,38 @@ import (
 )
 
 type ChatHandler struct {
-	usecase *usecase.ChatUseCase
-	client  *redis.Client
-	hub     *ws.Hub
+	usecase        *usecase.ChatUseCase
+	client         *redis.Client
+	hub            *ws.Hub
+	config         *configs.Config
+	webhookLimiter *ratelimit.Limiter
+	wsConfig       websocket.Config
+	logger         *logger.Logger
 }
 
-func NewChatHandler(usecase *usecase.ChatUseCase, client *redis.Client, hub *ws.Hub) *ChatHandler {
+func NewChatHandler(usecase *usecase.ChatUseCase, client *redis.Client, hub *ws.Hub, config *configs.Config, logger *logger.Logger) *ChatHandler {
 	return &ChatHandler{
-		usecase: usecase,
-		client:  client,
-		hub:     hub,
+		usecase:        usecase,
+		client:         client,
+		hub:            hub,
+		config:         config,
+		logger:         logger,
+		webhookLimiter: ratelimit.NewLimiter(client, "chat:webhook"),
+		wsConfig: websocket.Config{
+			ReadBufferSize:    1024,
+			WriteBufferSize:   1024,
+			Origins:           []string{"https://localhost:3002"},
+			Subprotocols:      ws.Subprotocols,
+			EnableCompression: config.WebSocket.Compression,
+		},
 	}
 }
 
 // CreateRoom godoc
 // @Summary Create a new chat room
-// @Description Create a new chat room with the given ID and name
+// @Description Create a new chat room with the given ID and name. When a bearer token is sent, the caller becomes the owner of the room.
 // @Tags chat
+// @Security BearerAuth
 // @Accept json
 // @Produce json
 // @Param CreateRoomRequest body CreateRoomRequest true "Create Room Request"
@@ -41,7 +59,7 @@ func (h *ChatHandler) CreateRoom(ctx *fiber.Ctx) error {
 		return errors.NewError(errors.ErrorBadRequest, err)
 	}
 
-	createRoomRes, err := h.usecase.CreateRoom(ctx.Context(), CreateRoomReqToDomainChat(req))
+	createRoomRes, err := h.usecase.CreateRoom(ctx.UserContext(), CreateRoomReqToDomainChat(req))
 	if err != nil {
 		apiErr := errors.FromError(err)
 		return ctx.Status(apiErr.Status).JSON(apiErr)
@@ -51,12 +69,19 @@ func (h *ChatHandler) CreateRoom(ctx *fiber.Ctx) error {
 	return ctx.Status(fiber.StatusCreated).JSON(res)
 }
 
-var config = websocket.Config{
-	ReadBufferSize:  1024,
-	WriteBufferSize: 1024,
-	Origins:         []string{"https://localhost:3002"},
-}
-
+// JoinRoom godoc
+// @Summary Join a room over a WebSocket
+// @Description Upgrade to a WebSocket that sends and receives the messages of a room as the user of the token. Browsers, which can't set headers on a WebSocket, pass the token in the access_token query parameter.
+// @Tags chat
+// @Security BearerAuth
+// @Param roomId path string true "Room ID"
+// @Param access_token query string false "Access token, when it is not sent in the Authorization header"
+// @Success 101
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 426 {object} map[string]interface{}
+// @Router /ws/join-room/{roomId} [get]
 func (h *ChatHandler) JoinRoom(ctx *fiber.Ctx) error {
 	roomID := ctx.Params("roomId")
 	if roomID == "" {
@@ -64,36 +89,53 @@ func (h *ChatHandler) JoinRoom(ctx *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Room ID is required")
 	}
 
-	var req JoinRoomRequest
-	if err := ctx.QueryParser(&req); err != nil {
-		log.Println("Error parsing query parameters:", err)
-		return fiber.NewError(fiber.StatusBadRequest, "Invalid query parameters")
+	if !websocket.IsWebSocketUpgrade(ctx) {
+		return fiber.ErrUpgradeRequired
 	}
 
-	if websocket.IsWebSocketUpgrade(ctx) {
-		return websocket.New(func(conn *websocket.Conn) {
-			h.usecase.JoinRoom(ctx.Context(), JoinRoomReqToDomainChat(roomID, req, conn))
-		}, config)(ctx)
+	// The user joins as who the token says, before the connection is upgraded
+	userCtx := ctx.UserContext()
+	user, err := h.usecase.Authenticate(userCtx)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
 	}
 
-	return fiber.ErrUpgradeRequired
+	return websocket.New(func(conn *websocket.Conn) {
+		if h.config.WebSocket.Compression {
+			conn.SetCompressionLevel(h.config.WebSocket.CompressionLevel)
+		}
+		h.usecase.JoinRoom(userCtx, JoinRoomReqToDomainChat(roomID, user, conn))
+	}, h.wsConfig)(ctx)
 }
 
 // GetRooms godoc
-// @Summary Get all chat rooms
-// @Description Retrieve a list of all chat rooms
+// @Summary Browse the room directory
+// @Description Retrieve a page of chat rooms with their member counts, online users and last activity
 // @Tags chat
 // @Accept json
 // @Produce json
-// @Success 200 {array} RoomRes
+// @Param q query string false "Search room names"
+// @Param sort query string false "Sort order" Enums(activity, members, name, newest)
+// @Param page query int false "Page number, starting at 1"
+// @Param pageSize query int false "Rooms per page, at most 100"
+// @Success 200 {object} GetRoomsRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
 // @Router /ws/get-rooms [get]
 func (h *ChatHandler) GetRooms(ctx *fiber.Ctx) error {
-	rooms, err := h.usecase.GetRooms(ctx.Context())
+	var req GetRoomsRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	rooms, err := h.usecase.GetRooms(ctx.UserContext(), GetRoomsReqToDomainRoomFilter(req))
 	if err != nil {
 		apiErr := errors.FromError(err)
 		return ctx.Status(apiErr.Status).JSON(apiErr)
 	}
-	res := DomainChatToGetRoomsRes(rooms)
+	res := DomainRoomPageToGetRoomsRes(rooms)
 
 	return ctx.Status(fiber.StatusOK).JSON(res)
 }
@@ -111,7 +153,7 @@ func (h *ChatHandler) GetRooms(ctx *fiber.Ctx) error {
 func (h *ChatHandler) GetClients(ctx *fiber.Ctx) error {
 	roomID := ctx.Params("roomId")
 
-	getClientsRes, err := h.usecase.GetClients(ctx.Context(), GetClientsReqToDomainChat(roomID))
+	getClientsRes, err := h.usecase.GetClients(ctx.UserContext(), GetClientsReqToDomainChat(roomID))
 	if err != nil {
 		apiErr := errors.FromError(err)
 		return ctx.Status(apiErr.Status).JSON(apiErr)
@@ -120,3 +162,35 @@ func (h *ChatHandler) GetClients(ctx *fiber.Ctx) error {
 
 	return ctx.Status(fiber.StatusOK).JSON(res)
 }
+
+// GetHistory godoc
+// @Summary Get the history of a room
+// @Description Retrieve the latest messages of a room, oldest first. Pass the id of the oldest message received as beforeId to page back.
+// @Tags chat
+// @Security BearerAuth
+// @Produce json
+// @Param roomId path string true "Room ID"
+// @Param beforeId query int false "Only messages older than this message"
+// @Param limit query int false "Maximum number of messages, at most 200"
+// @Success 200 {array} MessageRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/get-history/{roomId} [get]
+func (h *ChatHandler) GetHistory(ctx *fiber.Ctx) error {
+	var req GetHistoryRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	messages, err := h.usecase.GetHistory(ctx.UserContext(), GetHistoryReqToDomainMessageFilter(ctx.Params("roomId"), req))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainMessagesToGetHistoryRes(messages)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ImportHistory godoc
+// @Summary Import chat history
+// @Description Upload a Slack workspace export (zip) or a generic JSON export and import it in the background. Authors are mapped to users by email, then by username; the others keep their export name prefixed with "imported:".
+// @Tags import
+// @Security BearerAuth
+// @Accept multipart/form-data
+// @Produce json
+// @Param format formData string true "Export format" Enums(slack, json)
+// @Param file formData file true "Export file"
+// @Success 202 {object} ImportRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/import-history [post]
+func (h *ChatHandler) ImportHistory(ctx *fiber.Ctx) error {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	defer file.Close()
+
+	imp := domain.Import{
+		Format: domain.ImportFormat(ctx.FormValue("format")),
+	}
+
+	createdImport, err := h.usecase.ImportHistory(ctx.UserContext(), imp, file)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainImportToImportRes(createdImport)
+
+	return ctx.Status(fiber.StatusAccepted).JSON(res)
+}
+
+// ResumeImport godoc
+// @Summary Resume an import
+// @Description Continue a pending or failed import. Rooms that were already imported are skipped.
+// @Tags import
+// @Security BearerAuth
+// @Produce json
+// @Param importId path int true "Import ID"
+// @Success 202 {object} ImportRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/resume-import/{importId} [post]
+func (h *ChatHandler) ResumeImport(ctx *fiber.Ctx) error {
+	importID, err := strconv.Atoi(ctx.Params("importId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	imp, err := h.usecase.ResumeImport(ctx.UserContext(), domain.Import{ID: importID})
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainImportToImportRes(imp)
+
+	return ctx.Status(fiber.StatusAccepted).JSON(res)
+}
+
+// GetImport godoc
+// @Summary Get an import
+// @Description Retrieve the progress of an import and its report of unmapped users
+// @Tags import
+// @Security BearerAuth
+// @Produce json
+// @Param importId path int true "Import ID"
+// @Success 200 {object} ImportRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/get-import/{importId} [get]
+func (h *ChatHandler) GetImport(ctx *fiber.Ctx) error {
+	importID, err := strconv.Atoi(ctx.Params("importId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	imp, err := h.usecase.GetImport(ctx.UserContext(), domain.Import{ID: importID})
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainImportToImportRes(imp)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
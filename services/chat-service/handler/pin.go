@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// PinMessage godoc
+// @Summary Pin a message
+// @Description Pin a message in its room. Only moderators of the room, its owner and the admins, can pin messages. The room receives a pin event.
+// @Tags pins
+// @Security BearerAuth
+// @Produce json
+// @Param messageId path int true "Message ID"
+// @Success 201 {object} PinnedMessageRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/pin-message/{messageId} [post]
+func (h *ChatHandler) PinMessage(ctx *fiber.Ctx) error {
+	messageID, err := strconv.Atoi(ctx.Params("messageId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	pin, err := h.usecase.PinMessage(ctx.UserContext(), messageID)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainPinnedMessageToPinnedMessageRes(pin)
+
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
+
+// UnpinMessage godoc
+// @Summary Unpin a message
+// @Description Remove the pin of a message. Only moderators of the room can unpin messages. The room receives an unpin event.
+// @Tags pins
+// @Security BearerAuth
+// @Produce json
+// @Param messageId path int true "Message ID"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/unpin-message/{messageId} [delete]
+func (h *ChatHandler) UnpinMessage(ctx *fiber.Ctx) error {
+	messageID, err := strconv.Atoi(ctx.Params("messageId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	if err := h.usecase.UnpinMessage(ctx.UserContext(), messageID); err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+// GetPinnedMessages godoc
+// @Summary Get the pinned messages of a room
+// @Description Retrieve the pinned messages of a room, most recent pin first
+// @Tags pins
+// @Security BearerAuth
+// @Produce json
+// @Param roomId path string true "Room ID"
+// @Success 200 {array} PinnedMessageRes
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/get-pinned-messages/{roomId} [get]
+func (h *ChatHandler) GetPinnedMessages(ctx *fiber.Ctx) error {
+	pins, err := h.usecase.GetPinnedMessages(ctx.UserContext(), ctx.Params("roomId"))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainPinnedMessagesToGetPinnedMessagesRes(pins)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// SaveMessage godoc
+// @Summary Save a message
+// @Description Add a message of a room you are a member of to your private saved messages
+// @Tags pins
+// @Security BearerAuth
+// @Produce json
+// @Param messageId path int true "Message ID"
+// @Success 201 {object} SavedMessageRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/save-message/{messageId} [post]
+func (h *ChatHandler) SaveMessage(ctx *fiber.Ctx) error {
+	messageID, err := strconv.Atoi(ctx.Params("messageId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	saved, err := h.usecase.SaveMessage(ctx.UserContext(), messageID)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainSavedMessageToSavedMessageRes(saved)
+
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
+
+// UnsaveMessage godoc
+// @Summary Remove a saved message
+// @Description Remove a message from your saved messages
+// @Tags pins
+// @Security BearerAuth
+// @Produce json
+// @Param messageId path int true "Message ID"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/unsave-message/{messageId} [delete]
+func (h *ChatHandler) UnsaveMessage(ctx *fiber.Ctx) error {
+	messageID, err := strconv.Atoi(ctx.Params("messageId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	if err := h.usecase.UnsaveMessage(ctx.UserContext(), messageID); err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+// GetSavedMessages godoc
+// @Summary Get your saved messages
+// @Description Retrieve your saved messages across rooms, most recently saved first
+// @Tags pins
+// @Security BearerAuth
+// @Produce json
+// @Param roomId query string false "Only the messages of this room"
+// @Success 200 {array} SavedMessageRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/get-saved-messages [get]
+func (h *ChatHandler) GetSavedMessages(ctx *fiber.Ctx) error {
+	var req GetSavedMessagesRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	saved, err := h.usecase.GetSavedMessages(ctx.UserContext(), req.RoomID)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainSavedMessagesToGetSavedMessagesRes(saved)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
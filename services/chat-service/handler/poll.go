@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreatePoll godoc
+// @Summary Create a poll
+// @Description Post a poll to a room you are a member of. Polls allow a single choice unless multiple is set, can hide who voted for what and can close automatically at closesAt.
+// @Tags polls
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param roomId path string true "Room ID"
+// @Param CreatePollRequest body CreatePollRequest true "Create Poll Request"
+// @Success 201 {object} RoomPollRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/create-poll/{roomId} [post]
+func (h *ChatHandler) CreatePoll(ctx *fiber.Ctx) error {
+	var req CreatePollRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	poll, err := h.usecase.CreatePoll(ctx.UserContext(), CreatePollReqToDomainPoll(ctx.Params("roomId"), req))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainPollToRoomPollRes(poll)
+
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
+
+// GetPoll godoc
+// @Summary Get a poll
+// @Description Retrieve a poll with its current tallies
+// @Tags polls
+// @Security BearerAuth
+// @Produce json
+// @Param pollId path int true "Poll ID"
+// @Success 200 {object} RoomPollRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/get-poll/{pollId} [get]
+func (h *ChatHandler) GetPoll(ctx *fiber.Ctx) error {
+	pollID, err := strconv.Atoi(ctx.Params("pollId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	poll, err := h.usecase.GetPoll(ctx.UserContext(), pollID)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainPollToRoomPollRes(poll)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// VotePoll godoc
+// @Summary Vote on a poll
+// @Description Replace your vote on an open poll. The new tallies are broadcast to the room. WebSocket clients can send {"type":"vote","vote":{"pollId":1,"options":[0]}} instead.
+// @Tags polls
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param pollId path int true "Poll ID"
+// @Param VotePollRequest body VotePollRequest true "Vote Poll Request"
+// @Success 200 {object} RoomPollRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/vote-poll/{pollId} [post]
+func (h *ChatHandler) VotePoll(ctx *fiber.Ctx) error {
+	pollID, err := strconv.Atoi(ctx.Params("pollId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	var req VotePollRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	poll, err := h.usecase.VotePoll(ctx.UserContext(), pollID, req.Options)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainPollToRoomPollRes(poll)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// ClosePoll godoc
+// @Summary Close a poll
+// @Description Close a poll and post its results to the room. Only the author of the poll and the moderators of the room can close it.
+// @Tags polls
+// @Security BearerAuth
+// @Produce json
+// @Param pollId path int true "Poll ID"
+// @Success 200 {object} RoomPollRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/close-poll/{pollId} [post]
+func (h *ChatHandler) ClosePoll(ctx *fiber.Ctx) error {
+	pollID, err := strconv.Atoi(ctx.Params("pollId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	poll, err := h.usecase.ClosePoll(ctx.UserContext(), pollID)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainPollToRoomPollRes(poll)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// UpdateRoomRetention godoc
+// @Summary Update the retention policy of a room
+// @Description Set the maximum message age and/or count kept for a room. Null values fall back to the global default.
+// @Tags retention
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param roomId path string true "Room ID"
+// @Param UpdateRoomRetentionRequest body UpdateRoomRetentionRequest true "Update Room Retention Request"
+// @Success 200 {object} RoomRetentionRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/update-room-retention/{roomId} [put]
+func (h *ChatHandler) UpdateRoomRetention(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomId")
+
+	var req UpdateRoomRetentionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	chat, err := UpdateRoomRetentionReqToDomainChat(roomID, req)
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	updatedRoom, err := h.usecase.UpdateRoomRetention(ctx.UserContext(), chat)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainChatToRoomRetentionRes(updatedRoom)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// PurgeMessages godoc
+// @Summary Run the retention purge now
+// @Description Purge the messages of every room that are past their retention policy. In dry-run mode the purge is only recorded.
+// @Tags retention
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param PurgeMessagesRequest body PurgeMessagesRequest true "Purge Messages Request"
+// @Success 200 {object} PurgeReportRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/purge-messages [post]
+func (h *ChatHandler) PurgeMessages(ctx *fiber.Ctx) error {
+	var req PurgeMessagesRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	report, err := h.usecase.PurgeMessages(ctx.UserContext(), req.DryRun)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainPurgeReportToPurgeReportRes(report)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// GetPurges godoc
+// @Summary Get recorded purges
+// @Description Retrieve the purge records, optionally filtered by room
+// @Tags retention
+// @Security BearerAuth
+// @Produce json
+// @Param roomId query string false "Room ID"
+// @Success 200 {array} PurgeRes
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/get-purges [get]
+func (h *ChatHandler) GetPurges(ctx *fiber.Ctx) error {
+	roomID := ctx.Query("roomId")
+
+	purges, err := h.usecase.GetPurges(ctx.UserContext(), GetPurgesReqToDomainChat(roomID))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainPurgesToGetPurgesRes(purges)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ScheduleMessage godoc
+// @Summary Schedule a message
+// @Description Write a message now and have it posted to the room at sendAt. When timeZone is set, the wall clock of sendAt is read in that zone.
+// @Tags schedule
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param roomId path string true "Room ID"
+// @Param ScheduleMessageRequest body ScheduleMessageRequest true "Schedule Message Request"
+// @Success 201 {object} ScheduledMessageRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/schedule-message/{roomId} [post]
+func (h *ChatHandler) ScheduleMessage(ctx *fiber.Ctx) error {
+	var req ScheduleMessageRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	scheduledMessage, err := h.usecase.ScheduleMessage(ctx.UserContext(), ScheduleMessageReqToDomainScheduledMessage(0, ctx.Params("roomId"), req))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainScheduledMessageToScheduledMessageRes(scheduledMessage)
+
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
+
+// GetScheduledMessages godoc
+// @Summary Get your scheduled messages
+// @Description Retrieve the scheduled messages of the caller, ordered by send time
+// @Tags schedule
+// @Security BearerAuth
+// @Produce json
+// @Param roomId query string false "Only the messages of this room"
+// @Param status query string false "Only the messages with this status" Enums(pending, sent, canceled)
+// @Success 200 {array} ScheduledMessageRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/get-scheduled-messages [get]
+func (h *ChatHandler) GetScheduledMessages(ctx *fiber.Ctx) error {
+	var req GetScheduledMessagesRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	scheduledMessages, err := h.usecase.GetScheduledMessages(ctx.UserContext(), GetScheduledMessagesReqToDomainFilter(req))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainScheduledMessagesToGetScheduledMessagesRes(scheduledMessages)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// UpdateScheduledMessage godoc
+// @Summary Edit a scheduled message
+// @Description Change the content and the send time of a scheduled message that has not been sent yet
+// @Tags schedule
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param scheduledMessageId path int true "Scheduled Message ID"
+// @Param ScheduleMessageRequest body ScheduleMessageRequest true "Schedule Message Request"
+// @Success 200 {object} ScheduledMessageRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/update-scheduled-message/{scheduledMessageId} [put]
+func (h *ChatHandler) UpdateScheduledMessage(ctx *fiber.Ctx) error {
+	scheduledMessageID, err := strconv.Atoi(ctx.Params("scheduledMessageId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	var req ScheduleMessageRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	scheduledMessage, err := h.usecase.UpdateScheduledMessage(ctx.UserContext(), ScheduleMessageReqToDomainScheduledMessage(scheduledMessageID, "", req))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainScheduledMessageToScheduledMessageRes(scheduledMessage)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// CancelScheduledMessage godoc
+// @Summary Cancel a scheduled message
+// @Description Cancel a scheduled message that has not been sent yet
+// @Tags schedule
+// @Security BearerAuth
+// @Produce json
+// @Param scheduledMessageId path int true "Scheduled Message ID"
+// @Success 200 {object} ScheduledMessageRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/cancel-scheduled-message/{scheduledMessageId} [delete]
+func (h *ChatHandler) CancelScheduledMessage(ctx *fiber.Ctx) error {
+	scheduledMessageID, err := strconv.Atoi(ctx.Params("scheduledMessageId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	scheduledMessage, err := h.usecase.CancelScheduledMessage(ctx.UserContext(), scheduledMessageID)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainScheduledMessageToScheduledMessageRes(scheduledMessage)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
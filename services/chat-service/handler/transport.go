@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ws"
+	"github.com/gofiber/fiber/v2"
+)
+
+// StreamRoom godoc
+// @Summary Join a room over Server-Sent Events
+// @Description Join a room without WebSockets as the user of the token. The first event is "session" with the session used to send messages; room messages follow as "message" events. Comment lines are sent as heartbeats. Browsers, which can't set headers on an EventSource, pass the token in the access_token query parameter.
+// @Tags transports
+// @Security BearerAuth
+// @Produce text/event-stream
+// @Param roomId path string true "Room ID"
+// @Param access_token query string false "Access token, when it is not sent in the Authorization header"
+// @Success 200 {string} string "event stream"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/stream-room/{roomId} [get]
+func (h *ChatHandler) StreamRoom(ctx *fiber.Ctx) error {
+	user, err := h.usecase.Authenticate(ctx.UserContext())
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	session, err := h.usecase.OpenSession(ctx.UserContext(), JoinRoomReqToDomainChat(ctx.Params("roomId"), user, nil), ws.TransportSSE)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no") // keep reverse proxies from buffering the stream
+
+	// The stream outlives the handler; a failed flush means the client has gone away
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer h.usecase.CloseSession(session.ID)
+
+		writeEvent(w, "session", "", DomainSessionToSessionRes(session))
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		var cursor int64
+		for {
+			nextCtx, cancel := context.WithTimeout(context.Background(), h.config.Transports.Heartbeat)
+			events, closed := session.Next(nextCtx, cursor)
+			cancel()
+
+			for _, event := range events {
+				writeEvent(w, "message", strconv.FormatInt(event.Seq, 10), WSEventToEventRes(event))
+				cursor = event.Seq
+			}
+			if len(events) == 0 && !closed {
+				fmt.Fprint(w, ": ping\n\n")
+			}
+
+			if err := w.Flush(); err != nil || closed {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+// writeEvent writes a Server-Sent Event with a JSON payload.
+func writeEvent(w *bufio.Writer, event string, id string, data any) {
+	payload, _ := json.Marshal(data)
+	if id != "" {
+		fmt.Fprintf(w, "id: %s\n", id)
+	}
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+}
+
+// PollRoom godoc
+// @Summary Join a room with long-polling
+// @Description Join a room without WebSockets as the user of the token and receive its messages by polling the returned session
+// @Tags transports
+// @Security BearerAuth
+// @Produce json
+// @Param roomId path string true "Room ID"
+// @Success 201 {object} SessionRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/poll-room/{roomId} [post]
+func (h *ChatHandler) PollRoom(ctx *fiber.Ctx) error {
+	user, err := h.usecase.Authenticate(ctx.UserContext())
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	session, err := h.usecase.OpenSession(ctx.UserContext(), JoinRoomReqToDomainChat(ctx.Params("roomId"), user, nil), ws.TransportLongPoll)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainSessionToSessionRes(session)
+
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
+
+// Poll godoc
+// @Summary Poll a long-polling session
+// @Description Wait for the messages after the cursor. The request returns as soon as there are messages, or with none once the poll timeout passes. Only the user who opened the session can poll it.
+// @Tags transports
+// @Security BearerAuth
+// @Produce json
+// @Param sessionId path string true "Session ID"
+// @Param cursor query int false "Cursor returned by the previous poll"
+// @Success 200 {object} PollRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /ws/poll/{sessionId} [get]
+func (h *ChatHandler) Poll(ctx *fiber.Ctx) error {
+	cursor, err := strconv.ParseInt(ctx.Query("cursor", "0"), 10, 64)
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	events, cursor, err := h.usecase.PollSession(ctx.UserContext(), ctx.Params("sessionId"), cursor)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := WSEventsToPollRes(events, cursor)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// SendMessage godoc
+// @Summary Send a message over HTTP
+// @Description Send a message to the room of a Server-Sent Events or long-polling session as the user who opened it
+// @Tags transports
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param sessionId path string true "Session ID"
+// @Param SendMessageRequest body SendMessageRequest true "Send Message Request"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/send-message/{sessionId} [post]
+func (h *ChatHandler) SendMessage(ctx *fiber.Ctx) error {
+	var req SendMessageRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	if err := h.usecase.SendSessionMessage(ctx.UserContext(), ctx.Params("sessionId"), req.Content); err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+// LeaveRoom godoc
+// @Summary Leave a room joined over HTTP
+// @Description Close a Server-Sent Events or long-polling session of the user of the token
+// @Tags transports
+// @Security BearerAuth
+// @Param sessionId path string true "Session ID"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /ws/leave-room/{sessionId} [delete]
+func (h *ChatHandler) LeaveRoom(ctx *fiber.Ctx) error {
+	if err := h.usecase.LeaveSession(ctx.UserContext(), ctx.Params("sessionId")); err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreateWebhook godoc
+// @Summary Create an incoming webhook
+// @Description Create an incoming webhook that posts messages into a room. Only the owner of the room or an admin can create one. The token is only returned once.
+// @Tags webhooks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param roomId path string true "Room ID"
+// @Param CreateWebhookRequest body CreateWebhookRequest true "Create Webhook Request"
+// @Success 201 {object} CreateWebhookRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/create-webhook/{roomId} [post]
+func (h *ChatHandler) CreateWebhook(ctx *fiber.Ctx) error {
+	var req CreateWebhookRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	webhook, err := h.usecase.CreateWebhook(ctx.UserContext(), CreateWebhookReqToDomainWebhook(ctx.Params("roomId"), req))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainWebhookToCreateWebhookRes(webhook)
+
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
+
+// GetWebhooks godoc
+// @Summary Get the webhooks of a room
+// @Description Retrieve the incoming webhooks of a room, including revoked ones
+// @Tags webhooks
+// @Security BearerAuth
+// @Produce json
+// @Param roomId path string true "Room ID"
+// @Success 200 {array} WebhookRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/get-webhooks/{roomId} [get]
+func (h *ChatHandler) GetWebhooks(ctx *fiber.Ctx) error {
+	webhooks, err := h.usecase.GetWebhooks(ctx.UserContext(), ctx.Params("roomId"))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainWebhooksToGetWebhooksRes(webhooks)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// RevokeWebhook godoc
+// @Summary Revoke an incoming webhook
+// @Description Revoke an incoming webhook so that its token no longer posts messages
+// @Tags webhooks
+// @Security BearerAuth
+// @Produce json
+// @Param webhookId path int true "Webhook ID"
+// @Success 200 {object} WebhookRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/revoke-webhook/{webhookId} [delete]
+func (h *ChatHandler) RevokeWebhook(ctx *fiber.Ctx) error {
+	webhookID, err := strconv.Atoi(ctx.Params("webhookId"))
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	webhook, err := h.usecase.RevokeWebhook(ctx.UserContext(), webhookID)
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainWebhookToWebhookRes(webhook)
+
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// PostWebhookMessage godoc
+// @Summary Post a message through an incoming webhook
+// @Description Post a message into the room of the webhook. The token in the path authenticates the request. Each token is rate limited.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param token path string true "Webhook token"
+// @Param WebhookMessageRequest body WebhookMessageRequest true "Webhook Message Request"
+// @Success 201 {object} MessageRes
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 429 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /ws/webhooks/{token} [post]
+func (h *ChatHandler) PostWebhookMessage(ctx *fiber.Ctx) error {
+	token := ctx.Params("token")
+
+	// Limit by token before looking it up, so guessing tokens is throttled as well
+	sum := sha256.Sum256([]byte(token))
+	limit, err := h.webhookLimiter.Allow(ctx.UserContext(), hex.EncodeToString(sum[:]), h.config.Webhooks.RateLimit, h.config.Webhooks.RateWindow)
+	if err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorInternal, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	ctx.Set("X-RateLimit-Limit", strconv.Itoa(limit.Limit))
+	ctx.Set("X-RateLimit-Remaining", strconv.Itoa(limit.Remaining))
+	if !limit.Allowed {
+		ctx.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(limit.RetryAfter.Seconds()))))
+		apiErr := errors.FromError(errors.NewError(errors.ErrorTooManyRequests, fmt.Errorf("webhook rate limit exceeded")))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	var req WebhookMessageRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		apiErr := errors.FromError(errors.NewError(errors.ErrorBadRequest, err))
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+
+	message, err := h.usecase.PostWebhookMessage(ctx.UserContext(), token, WebhookMessageReqToDomainChat(req))
+	if err != nil {
+		apiErr := errors.FromError(err)
+		return ctx.Status(apiErr.Status).JSON(apiErr)
+	}
+	res := DomainChatToMessageRes(message)
+
+	return ctx.Status(fiber.StatusCreated).JSON(res)
+}
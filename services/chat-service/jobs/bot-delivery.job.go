@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/usecase"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// BotDeliveryJob sends the queued bot events to their callbacks and retries the failed ones.
+type BotDeliveryJob struct {
+	usecase *usecase.ChatUseCase
+	logger  *logger.Logger
+	config  *configs.Config
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func NewBotDeliveryJob(usecase *usecase.ChatUseCase, logger *logger.Logger, config *configs.Config) *BotDeliveryJob {
+	return &BotDeliveryJob{
+		usecase: usecase,
+		logger:  logger,
+		config:  config,
+		done:    make(chan struct{}),
+	}
+}
+
+func (j *BotDeliveryJob) SetupBotDeliveryJob(lc fx.Lifecycle) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			j.logger.Info(fmt.Sprintf("Starting bot delivery job (poll interval: %s)", j.config.Bots.PollInterval))
+
+			runCtx, cancel := context.WithCancel(context.Background())
+			j.cancel = cancel
+			go j.run(runCtx)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			j.logger.Info("Stopping bot delivery job")
+			j.cancel()
+
+			select {
+			case <-j.done:
+			case <-ctx.Done():
+			}
+			return nil
+		},
+	})
+}
+
+func (j *BotDeliveryJob) run(ctx context.Context) {
+	defer close(j.done)
+
+	ticker := time.NewTicker(j.config.Bots.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.usecase.DeliverBotEvents(ctx); err != nil {
+				j.logger.Ctx(ctx).Error("error delivering bot events", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/usecase"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// ExportJob picks up the queued transcript exports and stores their transcripts.
+type ExportJob struct {
+	usecase *usecase.ChatUseCase
+	logger  *logger.Logger
+	config  *configs.Config
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func NewExportJob(usecase *usecase.ChatUseCase, logger *logger.Logger, config *configs.Config) *ExportJob {
+	return &ExportJob{
+		usecase: usecase,
+		logger:  logger,
+		config:  config,
+		done:    make(chan struct{}),
+	}
+}
+
+func (j *ExportJob) SetupExportJob(lc fx.Lifecycle) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			j.logger.Info(fmt.Sprintf("Starting export job (poll interval: %s, lease: %s)", j.config.Export.PollInterval, j.config.Export.Lease))
+
+			runCtx, cancel := context.WithCancel(context.Background())
+			j.cancel = cancel
+			go j.run(runCtx)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			j.logger.Info("Stopping export job")
+			j.cancel()
+
+			select {
+			case <-j.done:
+			case <-ctx.Done():
+			}
+			return nil
+		},
+	})
+}
+
+func (j *ExportJob) run(ctx context.Context) {
+	defer close(j.done)
+
+	ticker := time.NewTicker(j.config.Export.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.usecase.RunPendingExports(ctx); err != nil {
+				j.logger.Ctx(ctx).Error("error running exports", zap.Error(err))
+			}
+		}
+	}
+}
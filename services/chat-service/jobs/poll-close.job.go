@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/usecase"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// PollCloseJob closes the polls whose closing time has come and posts their results.
+type PollCloseJob struct {
+	usecase *usecase.ChatUseCase
+	logger  *logger.Logger
+	config  *configs.Config
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func NewPollCloseJob(usecase *usecase.ChatUseCase, logger *logger.Logger, config *configs.Config) *PollCloseJob {
+	return &PollCloseJob{
+		usecase: usecase,
+		logger:  logger,
+		config:  config,
+		done:    make(chan struct{}),
+	}
+}
+
+func (j *PollCloseJob) SetupPollCloseJob(lc fx.Lifecycle) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			j.logger.Info(fmt.Sprintf("Starting poll close job (close interval: %s)", j.config.Polls.CloseInterval))
+
+			runCtx, cancel := context.WithCancel(context.Background())
+			j.cancel = cancel
+			go j.run(runCtx)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			j.logger.Info("Stopping poll close job")
+			j.cancel()
+
+			select {
+			case <-j.done:
+			case <-ctx.Done():
+			}
+			return nil
+		},
+	})
+}
+
+func (j *PollCloseJob) run(ctx context.Context) {
+	defer close(j.done)
+
+	ticker := time.NewTicker(j.config.Polls.CloseInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.usecase.CloseDuePolls(ctx); err != nil {
+				j.logger.Ctx(ctx).Error("error closing polls", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/usecase"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// RetentionJob periodically purges the messages that are past their room's retention policy.
+type RetentionJob struct {
+	usecase *usecase.ChatUseCase
+	logger  *logger.Logger
+	config  *configs.Config
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func NewRetentionJob(usecase *usecase.ChatUseCase, logger *logger.Logger, config *configs.Config) *RetentionJob {
+	return &RetentionJob{
+		usecase: usecase,
+		logger:  logger,
+		config:  config,
+		done:    make(chan struct{}),
+	}
+}
+
+func (j *RetentionJob) SetupRetentionJob(lc fx.Lifecycle) {
+	if !j.config.Retention.Enabled {
+		j.logger.Info("Retention job is disabled")
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			j.logger.Info(fmt.Sprintf("Starting retention job (interval: %s, dry run: %t)", j.config.Retention.Interval, j.config.Retention.DryRun))
+
+			runCtx, cancel := context.WithCancel(context.Background())
+			j.cancel = cancel
+			go j.run(runCtx)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			j.logger.Info("Stopping retention job")
+			j.cancel()
+
+			select {
+			case <-j.done:
+			case <-ctx.Done():
+			}
+			return nil
+		},
+	})
+}
+
+func (j *RetentionJob) run(ctx context.Context) {
+	defer close(j.done)
+
+	ticker := time.NewTicker(j.config.Retention.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := j.usecase.ApplyRetention(ctx, j.config.Retention.DryRun)
+			if err != nil {
+				j.logger.Ctx(ctx).Error("error applying retention", zap.Error(err))
+				continue
+			}
+
+			removed := 0
+			for _, purge := range report.Purges {
+				removed += purge.MessageCount
+			}
+			j.logger.Ctx(ctx).Info("retention run finished",
+				zap.Duration("duration", report.FinishedAt.Sub(report.StartedAt)),
+				zap.Int("messages", removed),
+				zap.Int("batches", len(report.Purges)),
+				zap.Bool("dry_run", report.DryRun),
+			)
+		}
+	}
+}
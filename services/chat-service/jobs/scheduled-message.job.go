@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/usecase"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// ScheduledMessageJob posts the scheduled messages once they are due.
+type ScheduledMessageJob struct {
+	usecase *usecase.ChatUseCase
+	logger  *logger.Logger
+	config  *configs.Config
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func NewScheduledMessageJob(usecase *usecase.ChatUseCase, logger *logger.Logger, config *configs.Config) *ScheduledMessageJob {
+	return &ScheduledMessageJob{
+		usecase: usecase,
+		logger:  logger,
+		config:  config,
+		done:    make(chan struct{}),
+	}
+}
+
+func (j *ScheduledMessageJob) SetupScheduledMessageJob(lc fx.Lifecycle) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			j.logger.Info(fmt.Sprintf("Starting scheduled message job (poll interval: %s)", j.config.Scheduler.PollInterval))
+
+			runCtx, cancel := context.WithCancel(context.Background())
+			j.cancel = cancel
+			go j.run(runCtx)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			j.logger.Info("Stopping scheduled message job")
+			j.cancel()
+
+			select {
+			case <-j.done:
+			case <-ctx.Done():
+			}
+			return nil
+		},
+	})
+}
+
+func (j *ScheduledMessageJob) run(ctx context.Context) {
+	defer close(j.done)
+
+	ticker := time.NewTicker(j.config.Scheduler.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.usecase.SendDueScheduledMessages(ctx); err != nil {
+				j.logger.Ctx(ctx).Error("error sending scheduled messages", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package middleware
+
+import "github.com/gofiber/fiber/v2"
+
+// BodyLimitMiddleware rejects requests with a body larger than limit bytes.
+// The app streams request bodies instead of reading them up front, so this is
+// what bounds them: the default limit applies to every route, and the routes
+// that take uploads set their own before it. Streamed bodies without a length
+// can't be bounded up front and are refused.
+func BodyLimitMiddleware(limit int) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		length := ctx.Request().Header.ContentLength()
+		if length == -1 {
+			return fiber.ErrLengthRequired
+		}
+		if length > limit {
+			return fiber.ErrRequestEntityTooLarge
+		}
+		return ctx.Next()
+	}
+}
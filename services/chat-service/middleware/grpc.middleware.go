@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/pkg/chat"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/authctx"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// methodScopes are the scopes the tokens of OAuth clients need for the methods of the chat
+// service. Connect only needs chat:read to join rooms; what is sent over it needs chat:write.
+var methodScopes = map[string]string{
+	chat.ChatService_CreateRoom_FullMethodName: domain.ScopeChatWrite,
+	chat.ChatService_GetRoom_FullMethodName:    domain.ScopeChatRead,
+	chat.ChatService_GetRooms_FullMethodName:   domain.ScopeChatRead,
+	chat.ChatService_UpdateRoom_FullMethodName: domain.ScopeChatWrite,
+	chat.ChatService_DeleteRoom_FullMethodName: domain.ScopeChatWrite,
+	chat.ChatService_GetHistory_FullMethodName: domain.ScopeChatRead,
+	chat.ChatService_Connect_FullMethodName:    domain.ScopeChatRead,
+}
+
+// AuthUnaryInterceptor passes the bearer token from the "authorization" metadata and the
+// scope of the method to the context. The health service is called without a token.
+func AuthUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if isHealthMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, err := contextWithToken(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamInterceptor passes the bearer token from the "authorization" metadata and the
+// scope of the method to the stream context.
+func AuthStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isHealthMethod(info.FullMethod) {
+			return handler(srv, stream)
+		}
+		ctx, err := contextWithToken(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authServerStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func isHealthMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+grpc_health_v1.Health_ServiceDesc.ServiceName+"/")
+}
+
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// contextWithToken passes the token and the scope of fullMethod to the context. Methods
+// without a declared scope are left to the default of the usecases, chat:write.
+func contextWithToken(ctx context.Context, fullMethod string) (context.Context, error) {
+	token, err := VerifyClaimsFromMetadata(ctx)
+	if err != nil {
+		grpcErr := errors.GRPCFromError(err)
+		return nil, status.Error(grpcErr.Code, grpcErr.Message)
+	}
+
+	// Pass the token and the scope to the context
+	ctx = authctx.WithToken(ctx, token)
+	if scope, ok := methodScopes[fullMethod]; ok {
+		ctx = authctx.WithScope(ctx, scope)
+	}
+	return ctx, nil
+}
+
+// VerifyClaimsFromMetadata extracts the bearer token from the "authorization" metadata.
+func VerifyClaimsFromMetadata(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", errors.NewError(errors.ErrorUnauthorized, errors.New("authorization metadata is missing"))
+	}
+
+	fields := strings.Fields(values[0])
+	if len(fields) != 2 || strings.ToLower(fields[0]) != "bearer" {
+		return "", errors.NewError(errors.ErrorUnauthorized, errors.New("invalid authorization metadata"))
+	}
+
+	return fields[1], nil
+}
@@ -3,12 +3,14 @@ package middleware
 import (
 	"strings"
 
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/authctx"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
 	"github.com/gofiber/fiber/v2"
 )
 
 // AuthMiddleware is a middleware that verifies the token from the Authorization header.
-func AuthMiddleware() fiber.Handler {
+// scope is the scope the tokens of OAuth clients need for the route.
+func AuthMiddleware(scope string) fiber.Handler {
 	return func(ctx *fiber.Ctx) error {
 		token, err := VerifyClaimsFromAuthHeader(ctx)
 		if err != nil {
@@ -16,14 +18,39 @@ func AuthMiddleware() fiber.Handler {
 			return ctx.Status(apiErr.Status).JSON(apiErr)
 		}
 
-		// Pass the token to the context
-		ctx.Locals("token", token)
+		// Pass the token and the scope of the route to the context
+		ctx.SetUserContext(authctx.WithToken(ctx.UserContext(), token))
+		ctx.SetUserContext(authctx.WithScope(ctx.UserContext(), scope))
 
 		// Proceed to the next handler
 		return ctx.Next()
 	}
 }
 
+// OptionalAuthMiddleware passes the token from the Authorization header to the context
+// when one is present, and lets anonymous requests through.
+func OptionalAuthMiddleware(scope string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		if ctx.Get("Authorization") == "" {
+			return ctx.Next()
+		}
+
+		return AuthMiddleware(scope)(ctx)
+	}
+}
+
+// StreamAuthMiddleware is AuthMiddleware for the routes browsers open as a WebSocket or
+// an EventSource, which can't send headers. They may pass the token in the access_token
+// query parameter instead (RFC 6750, section 2.3).
+func StreamAuthMiddleware(scope string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		if token := ctx.Query("access_token"); token != "" && ctx.Get("Authorization") == "" {
+			ctx.Request().Header.Set("Authorization", "Bearer "+token)
+		}
+		return AuthMiddleware(scope)(ctx)
+	}
+}
+
 // VerifyClaimsFromAuthHeader verifies the token from the Authorization header.
 func VerifyClaimsFromAuthHeader(ctx *fiber.Ctx) (string, error) {
 	authHeader := ctx.Get("Authorization")
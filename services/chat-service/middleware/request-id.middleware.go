@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Header and gRPC metadata key a request ID travels in.
+const (
+	HeaderRequestID   = "X-Request-ID"
+	metadataRequestID = "x-request-id"
+)
+
+// RequestIDMiddleware accepts the X-Request-ID of the caller or generates one, returns it
+// in the response and puts it in the user context for the logger and the gRPC clients.
+// It logs every request once it is handled.
+func RequestIDMiddleware(log *logger.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		id := requestID(c.Get(HeaderRequestID))
+		c.Set(HeaderRequestID, id)
+
+		// The route is only matched after this middleware, so it is resolved when logging
+		ctx := logger.WithRequest(c.UserContext(), id, "", func() string {
+			return c.Method() + " " + c.Route().Path
+		})
+		c.SetUserContext(ctx)
+
+		err := c.Next()
+		logger.FinishRequest(ctx)
+
+		code := c.Response().StatusCode()
+		if err != nil {
+			code = fiber.StatusInternalServerError
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				code = fiberErr.Code
+			}
+		}
+		log.Ctx(ctx).Info("request handled",
+			zap.String("path", c.Path()),
+			zap.Int("status", code),
+			zap.Duration("duration", time.Since(start)),
+		)
+		return err
+	}
+}
+
+// RequestIDUnaryInterceptor accepts the request ID of the caller from the metadata or generates one.
+func RequestIDUnaryInterceptor(log *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		ctx = contextWithRequestID(ctx, info.FullMethod)
+		res, err := handler(ctx, req)
+		log.Ctx(ctx).Info("rpc handled", zap.String("code", status.Code(err).String()), zap.Duration("duration", time.Since(start)))
+		return res, err
+	}
+}
+
+// RequestIDStreamInterceptor accepts the request ID of the caller from the metadata or generates one.
+func RequestIDStreamInterceptor(log *logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx := contextWithRequestID(stream.Context(), info.FullMethod)
+		err := handler(srv, &authServerStream{ServerStream: stream, ctx: ctx})
+		log.Ctx(ctx).Info("rpc handled", zap.String("code", status.Code(err).String()), zap.Duration("duration", time.Since(start)))
+		return err
+	}
+}
+
+// RequestIDClientInterceptor passes the request ID of ctx on to the service called.
+func RequestIDClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if id := logger.RequestID(ctx); id != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, metadataRequestID, id)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func contextWithRequestID(ctx context.Context, method string) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var id string
+	if values := md.Get(metadataRequestID); len(values) > 0 {
+		id = values[0]
+	}
+	return logger.WithRequest(ctx, requestID(id), method, nil)
+}
+
+// requestID returns the ID of the caller when it is a sensible one, or a new ID.
+func requestID(id string) string {
+	if id != "" && len(id) <= 128 && isPrintable(id) {
+		return id
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+func isPrintable(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x21 || s[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent"
+	EntBotDelivery "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botdelivery"
+	EntBotSubscription "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botsubscription"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"go.uber.org/zap"
+)
+
+func (r *ChatRepository) AddBotSubscription(ctx context.Context, subscription domain.BotSubscription) (domain.BotSubscription, error) {
+	events := make([]string, 0, len(subscription.Events))
+	for _, event := range subscription.Events {
+		events = append(events, string(event))
+	}
+
+	createdSubscription, err := r.client.BotSubscription.Create().
+		SetBotID(subscription.BotID).
+		SetBotName(subscription.BotName).
+		SetRoomID(subscription.RoomID).
+		SetCallbackURL(subscription.CallbackURL).
+		SetSecret(subscription.Secret).
+		SetEvents(events).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error creating bot subscription", zap.Error(err))
+		return domain.BotSubscription{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entBotSubscriptionToDomainBotSubscription(createdSubscription), nil
+}
+
+func (r *ChatRepository) GetBotSubscriptionByID(ctx context.Context, id int) (domain.BotSubscription, error) {
+	subscription, err := r.client.BotSubscription.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("bot subscription not found", zap.Error(err))
+		return domain.BotSubscription{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("bot subscription not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting bot subscription", zap.Error(err))
+		return domain.BotSubscription{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entBotSubscriptionToDomainBotSubscription(subscription), nil
+}
+
+func (r *ChatRepository) GetBotSubscriptionsByBotID(ctx context.Context, botID string) ([]domain.BotSubscription, error) {
+	subscriptions, err := r.client.BotSubscription.Query().
+		Where(EntBotSubscription.BotIDEQ(botID)).
+		Order(ent.Asc(EntBotSubscription.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting bot subscriptions", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entBotSubscriptionsToDomainBotSubscriptions(subscriptions), nil
+}
+
+func (r *ChatRepository) GetBotSubscriptionsByRoomID(ctx context.Context, roomID string) ([]domain.BotSubscription, error) {
+	subscriptions, err := r.client.BotSubscription.Query().
+		Where(EntBotSubscription.RoomIDEQ(roomID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting room bot subscriptions", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entBotSubscriptionsToDomainBotSubscriptions(subscriptions), nil
+}
+
+// DeleteBotSubscription removes a subscription together with its pending deliveries.
+func (r *ChatRepository) DeleteBotSubscription(ctx context.Context, id int) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error starting transaction", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.BotDelivery.Delete().
+		Where(
+			EntBotDelivery.SubscriptionIDEQ(id),
+			EntBotDelivery.StatusEQ(EntBotDelivery.StatusPending),
+		).
+		Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("error deleting bot deliveries", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+
+	err = tx.BotSubscription.DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return errors.NewError(errors.ErrorNotFound, fmt.Errorf("bot subscription not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error deleting bot subscription", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+func (r *ChatRepository) AddBotDeliveries(ctx context.Context, deliveries []domain.BotDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.BotDeliveryCreate, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		builders = append(builders, r.client.BotDelivery.Create().
+			SetSubscriptionID(delivery.SubscriptionID).
+			SetEvent(string(delivery.Event)).
+			SetPayload(delivery.Payload))
+	}
+
+	if err := r.client.BotDelivery.CreateBulk(builders...).Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("error creating bot deliveries", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+// GetDueBotDeliveries returns the pending deliveries whose next attempt is due.
+func (r *ChatRepository) GetDueBotDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.BotDelivery, error) {
+	deliveries, err := r.client.BotDelivery.Query().
+		Where(
+			EntBotDelivery.StatusEQ(EntBotDelivery.StatusPending),
+			EntBotDelivery.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(EntBotDelivery.FieldNextAttemptAt), ent.Asc(EntBotDelivery.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting due bot deliveries", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	var res []domain.BotDelivery
+	for _, delivery := range deliveries {
+		res = append(res, entBotDeliveryToDomainBotDelivery(delivery))
+	}
+	return res, nil
+}
+
+// ClaimBotDelivery leases a due delivery until leaseUntil by moving its next attempt.
+// It reports false when another replica claimed the delivery first.
+func (r *ChatRepository) ClaimBotDelivery(ctx context.Context, delivery domain.BotDelivery, leaseUntil time.Time) (bool, error) {
+	updated, err := r.client.BotDelivery.Update().
+		Where(
+			EntBotDelivery.IDEQ(delivery.ID),
+			EntBotDelivery.StatusEQ(EntBotDelivery.StatusPending),
+			EntBotDelivery.NextAttemptAtEQ(delivery.NextAttemptAt),
+		).
+		SetNextAttemptAt(leaseUntil).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error claiming bot delivery", zap.Error(err))
+		return false, errors.NewError(errors.ErrorInternal, err)
+	}
+	return updated == 1, nil
+}
+
+func (r *ChatRepository) UpdateBotDelivery(ctx context.Context, delivery domain.BotDelivery) error {
+	err := r.client.BotDelivery.UpdateOneID(delivery.ID).
+		SetStatus(EntBotDelivery.Status(delivery.Status)).
+		SetAttempts(delivery.Attempts).
+		SetNextAttemptAt(delivery.NextAttemptAt).
+		SetLastError(delivery.LastError).
+		SetNillableDeliveredAt(delivery.DeliveredAt).
+		Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Error("error updating bot delivery", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+func entBotSubscriptionToDomainBotSubscription(subscription *ent.BotSubscription) domain.BotSubscription {
+	events := make([]domain.BotEventType, 0, len(subscription.Events))
+	for _, event := range subscription.Events {
+		events = append(events, domain.BotEventType(event))
+	}
+
+	return domain.BotSubscription{
+		ID:          subscription.ID,
+		BotID:       subscription.BotID,
+		BotName:     subscription.BotName,
+		RoomID:      subscription.RoomID,
+		CallbackURL: subscription.CallbackURL,
+		Secret:      subscription.Secret,
+		Events:      events,
+		CreatedAt:   subscription.CreatedAt,
+	}
+}
+
+func entBotSubscriptionsToDomainBotSubscriptions(subscriptions []*ent.BotSubscription) []domain.BotSubscription {
+	var res []domain.BotSubscription
+	for _, subscription := range subscriptions {
+		res = append(res, entBotSubscriptionToDomainBotSubscription(subscription))
+	}
+	return res
+}
+
+func entBotDeliveryToDomainBotDelivery(delivery *ent.BotDelivery) domain.BotDelivery {
+	return domain.BotDelivery{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		Event:          domain.BotEventType(delivery.Event),
+		Payload:        delivery.Payload,
+		Status:         domain.BotDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
@@ -0,0 +1,302 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent"
+	EntCallParticipant "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callparticipant"
+	EntCallSession "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callsession"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"go.uber.org/zap"
+)
+
+// AddCall starts a call in a room with the user who started it as its first participant.
+// It fails with a conflict when the room already has a call that has not ended.
+func (r *ChatRepository) AddCall(ctx context.Context, call domain.Call) (domain.Call, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error starting transaction", zap.Error(err))
+		return domain.Call{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	defer tx.Rollback()
+
+	createdCall, err := tx.CallSession.Create().
+		SetRoomID(call.RoomID).
+		SetStartedBy(call.StartedBy).
+		SetStartedByName(call.StartedByName).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return domain.Call{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("room already has a call"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error creating call", zap.Error(err))
+		return domain.Call{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	participant, err := tx.CallParticipant.Create().
+		SetCallID(createdCall.ID).
+		SetUserID(call.StartedBy).
+		SetUsername(call.StartedByName).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error creating call participant", zap.Error(err))
+		return domain.Call{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Call{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	createdCall.Edges.Participants = []*ent.CallParticipant{participant}
+	return entCallSessionToDomainCall(createdCall), nil
+}
+
+// GetCallByID returns a call with everyone who has been in it.
+func (r *ChatRepository) GetCallByID(ctx context.Context, id int) (domain.Call, error) {
+	return r.getCallByID(ctx, r.client.CallSession, id)
+}
+
+func (r *ChatRepository) getCallByID(ctx context.Context, client *ent.CallSessionClient, id int) (domain.Call, error) {
+	call, err := client.Query().
+		Where(EntCallSession.IDEQ(id)).
+		WithParticipants(func(query *ent.CallParticipantQuery) {
+			query.Order(ent.Asc(EntCallParticipant.FieldID))
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("call not found", zap.Error(err))
+		return domain.Call{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("call not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting call", zap.Error(err))
+		return domain.Call{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entCallSessionToDomainCall(call), nil
+}
+
+// GetCalls returns the latest calls of a room, newest first.
+func (r *ChatRepository) GetCalls(ctx context.Context, filter domain.CallFilter) ([]domain.Call, error) {
+	query := r.client.CallSession.Query().
+		Where(EntCallSession.RoomIDEQ(filter.RoomID))
+	if filter.Active {
+		query = query.Where(EntCallSession.EndedAtIsNil())
+	}
+
+	calls, err := query.
+		WithParticipants(func(query *ent.CallParticipantQuery) {
+			query.Order(ent.Asc(EntCallParticipant.FieldID))
+		}).
+		Order(ent.Desc(EntCallSession.FieldStartedAt), ent.Desc(EntCallSession.FieldID)).
+		Limit(filter.Limit).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting calls", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	var res []domain.Call
+	for _, call := range calls {
+		res = append(res, entCallSessionToDomainCall(call))
+	}
+	return res, nil
+}
+
+// AddCallParticipant puts a user in a call that has not ended. It reports false when
+// the user was already in the call. The call row is updated first, which fails with
+// a conflict once the call has ended and keeps joins from racing with ending it.
+func (r *ChatRepository) AddCallParticipant(ctx context.Context, callID int, user domain.User, now time.Time) (domain.Call, bool, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error starting transaction", zap.Error(err))
+		return domain.Call{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+	defer tx.Rollback()
+
+	if err := r.lockCall(ctx, tx, callID, now); err != nil {
+		return domain.Call{}, false, err
+	}
+
+	inCall, err := tx.CallParticipant.Query().
+		Where(
+			EntCallParticipant.CallIDEQ(callID),
+			EntCallParticipant.UserIDEQ(user.ID),
+			EntCallParticipant.LeftAtIsNil(),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting call participant", zap.Error(err))
+		return domain.Call{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if !inCall {
+		if _, err := tx.CallParticipant.Create().
+			SetCallID(callID).
+			SetUserID(user.ID).
+			SetUsername(user.Username).
+			SetJoinedAt(now).
+			Save(ctx); err != nil {
+			r.logger.Ctx(ctx).Error("error creating call participant", zap.Error(err))
+			return domain.Call{}, false, errors.NewError(errors.ErrorInternal, err)
+		}
+	}
+
+	call, err := r.getCallByID(ctx, tx.CallSession, callID)
+	if err != nil {
+		return domain.Call{}, false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Call{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+	return call, !inCall, nil
+}
+
+// RemoveCallParticipant takes a user out of a call and ends the call when nobody is left in it.
+// It reports whether the user was in the call and whether the call ended, so that the
+// events of leaving and ending are sent exactly once.
+func (r *ChatRepository) RemoveCallParticipant(ctx context.Context, callID int, userID string, now time.Time) (domain.Call, bool, bool, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error starting transaction", zap.Error(err))
+		return domain.Call{}, false, false, errors.NewError(errors.ErrorInternal, err)
+	}
+	defer tx.Rollback()
+
+	if err := r.lockCall(ctx, tx, callID, now); err != nil {
+		return domain.Call{}, false, false, err
+	}
+
+	left, err := tx.CallParticipant.Update().
+		Where(
+			EntCallParticipant.CallIDEQ(callID),
+			EntCallParticipant.UserIDEQ(userID),
+			EntCallParticipant.LeftAtIsNil(),
+		).
+		SetLeftAt(now).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error updating call participant", zap.Error(err))
+		return domain.Call{}, false, false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	remaining, err := tx.CallParticipant.Query().
+		Where(
+			EntCallParticipant.CallIDEQ(callID),
+			EntCallParticipant.LeftAtIsNil(),
+		).
+		Count(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error counting call participants", zap.Error(err))
+		return domain.Call{}, false, false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	ended := remaining == 0
+	if ended {
+		if err := tx.CallSession.UpdateOneID(callID).
+			SetEndedAt(now).
+			Exec(ctx); err != nil {
+			r.logger.Ctx(ctx).Error("error ending call", zap.Error(err))
+			return domain.Call{}, false, false, errors.NewError(errors.ErrorInternal, err)
+		}
+	}
+
+	call, err := r.getCallByID(ctx, tx.CallSession, callID)
+	if err != nil {
+		return domain.Call{}, false, false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Call{}, false, false, errors.NewError(errors.ErrorInternal, err)
+	}
+	return call, left > 0, ended, nil
+}
+
+// EndCall ends a call for all of its participants. It reports false when the call had already ended.
+func (r *ChatRepository) EndCall(ctx context.Context, callID int, now time.Time) (domain.Call, bool, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error starting transaction", zap.Error(err))
+		return domain.Call{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+	defer tx.Rollback()
+
+	updated, err := tx.CallSession.Update().
+		Where(
+			EntCallSession.IDEQ(callID),
+			EntCallSession.EndedAtIsNil(),
+		).
+		SetEndedAt(now).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error ending call", zap.Error(err))
+		return domain.Call{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+	if updated == 0 {
+		return domain.Call{}, false, nil
+	}
+
+	if _, err := tx.CallParticipant.Update().
+		Where(
+			EntCallParticipant.CallIDEQ(callID),
+			EntCallParticipant.LeftAtIsNil(),
+		).
+		SetLeftAt(now).
+		Save(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("error updating call participants", zap.Error(err))
+		return domain.Call{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	call, err := r.getCallByID(ctx, tx.CallSession, callID)
+	if err != nil {
+		return domain.Call{}, false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Call{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+	return call, true, nil
+}
+
+// lockCall touches a call that has not ended, failing with a conflict when it has.
+func (r *ChatRepository) lockCall(ctx context.Context, tx *ent.Tx, callID int, now time.Time) error {
+	updated, err := tx.CallSession.Update().
+		Where(
+			EntCallSession.IDEQ(callID),
+			EntCallSession.EndedAtIsNil(),
+		).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error locking call", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if updated == 0 {
+		return errors.NewError(errors.ErrorConflict, fmt.Errorf("call has ended"))
+	}
+	return nil
+}
+
+func entCallSessionToDomainCall(call *ent.CallSession) domain.Call {
+	res := domain.Call{
+		ID:            call.ID,
+		RoomID:        call.RoomID,
+		StartedBy:     call.StartedBy,
+		StartedByName: call.StartedByName,
+		StartedAt:     call.StartedAt,
+		EndedAt:       call.EndedAt,
+	}
+	for _, participant := range call.Edges.Participants {
+		res.Participants = append(res.Participants, domain.CallParticipant{
+			ID:       participant.ID,
+			CallID:   participant.CallID,
+			UserID:   participant.UserID,
+			Username: participant.Username,
+			JoinedAt: participant.JoinedAt,
+			LeftAt:   participant.LeftAt,
+		})
+	}
+	return res
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent"
+	EntMessage "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	EntRoom "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/room"
+	EntRoomMember "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roommember"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"go.uber.org/zap"
+)
+
+// SearchRooms returns a page of the rooms whose name matches the filter, with their
+// member counts and last activity, and the number of all matching rooms.
+func (r *ChatRepository) SearchRooms(ctx context.Context, filter domain.RoomFilter) ([]domain.RoomSummary, int, error) {
+	query := r.client.Room.Query()
+	if filter.Query != "" {
+		query = query.Where(EntRoom.NameContainsFold(filter.Query))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error counting rooms", zap.Error(err))
+		return nil, 0, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	// Messages and members refer to rooms by the text of their id
+	switch filter.Sort {
+	case domain.RoomSortName:
+		query = query.Order(ent.Asc(EntRoom.FieldName), ent.Asc(EntRoom.FieldID))
+	case domain.RoomSortNewest:
+		query = query.Order(ent.Desc(EntRoom.FieldID))
+	case domain.RoomSortMembers:
+		query = query.Order(func(s *sql.Selector) {
+			s.OrderExpr(sql.Expr(fmt.Sprintf(
+				`(SELECT COUNT(*) FROM "%s" WHERE "%s"."%s" = CAST(%s AS TEXT)) DESC`,
+				EntRoomMember.Table, EntRoomMember.Table, EntRoomMember.FieldRoomID, s.C(EntRoom.FieldID),
+			)))
+		}, ent.Asc(EntRoom.FieldID))
+	default:
+		query = query.Order(func(s *sql.Selector) {
+			s.OrderExpr(sql.Expr(fmt.Sprintf(
+				`(SELECT MAX("%s"."%s") FROM "%s" WHERE "%s"."%s" = CAST(%s AS TEXT) AND "%s"."%s" IS NULL) DESC NULLS LAST`,
+				EntMessage.Table, EntMessage.FieldCreatedAt, EntMessage.Table, EntMessage.Table, EntMessage.FieldRoomID, s.C(EntRoom.FieldID),
+				EntMessage.Table, EntMessage.FieldArchivedAt,
+			)))
+		}, ent.Asc(EntRoom.FieldID))
+	}
+
+	rooms, err := query.
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error searching rooms", zap.Error(err))
+		return nil, 0, errors.NewError(errors.ErrorInternal, err)
+	}
+	if len(rooms) == 0 {
+		return nil, total, nil
+	}
+
+	roomIDs := make([]string, 0, len(rooms))
+	for _, room := range rooms {
+		roomIDs = append(roomIDs, strconv.Itoa(room.ID))
+	}
+
+	var members []struct {
+		RoomID string `json:"room_id"`
+		Count  int    `json:"count"`
+	}
+	if err := r.client.RoomMember.Query().
+		Where(EntRoomMember.RoomIDIn(roomIDs...)).
+		GroupBy(EntRoomMember.FieldRoomID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &members); err != nil {
+		r.logger.Ctx(ctx).Error("error counting room members", zap.Error(err))
+		return nil, 0, errors.NewError(errors.ErrorInternal, err)
+	}
+	memberCounts := make(map[string]int, len(members))
+	for _, member := range members {
+		memberCounts[member.RoomID] = member.Count
+	}
+
+	var activity []struct {
+		RoomID string    `json:"room_id"`
+		Max    time.Time `json:"max"`
+	}
+	if err := r.client.Message.Query().
+		Where(
+			EntMessage.RoomIDIn(roomIDs...),
+			EntMessage.ArchivedAtIsNil(),
+		).
+		GroupBy(EntMessage.FieldRoomID).
+		Aggregate(ent.Max(EntMessage.FieldCreatedAt)).
+		Scan(ctx, &activity); err != nil {
+		r.logger.Ctx(ctx).Error("error getting room activity", zap.Error(err))
+		return nil, 0, errors.NewError(errors.ErrorInternal, err)
+	}
+	lastActivity := make(map[string]time.Time, len(activity))
+	for _, a := range activity {
+		lastActivity[a.RoomID] = a.Max
+	}
+
+	res := make([]domain.RoomSummary, 0, len(rooms))
+	for i, room := range rooms {
+		summary := domain.RoomSummary{
+			Room:    entRoomToDomainRoom(room),
+			Members: memberCounts[roomIDs[i]],
+		}
+		if at, ok := lastActivity[roomIDs[i]]; ok {
+			summary.LastActivityAt = &at
+		}
+		res = append(res, summary)
+	}
+	return res, total, nil
+}
@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent"
+	EntMessage "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	EntRoomExport "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexport"
+	EntRoomExportChunk "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexportchunk"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"go.uber.org/zap"
+)
+
+func (r *ChatRepository) CountMessages(ctx context.Context, filter domain.MessageFilter) (int, error) {
+	count, err := r.client.Message.Query().
+		Where(messageFilterPredicates(filter)...).
+		Count(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error counting messages", zap.Error(err))
+		return 0, errors.NewError(errors.ErrorInternal, err)
+	}
+	return count, nil
+}
+
+func (r *ChatRepository) GetMessages(ctx context.Context, filter domain.MessageFilter) ([]domain.Message, error) {
+	query := r.client.Message.Query().
+		Where(messageFilterPredicates(filter)...).
+		Order(ent.Asc(EntMessage.FieldID))
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	messages, err := query.All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting messages", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	var res []domain.Message
+	for _, message := range messages {
+		res = append(res, entMessageToDomainMessage(message))
+	}
+
+	return res, nil
+}
+
+// GetLatestMessages returns the newest messages matching the filter, oldest first.
+func (r *ChatRepository) GetLatestMessages(ctx context.Context, filter domain.MessageFilter) ([]domain.Message, error) {
+	query := r.client.Message.Query().
+		Where(messageFilterPredicates(filter)...).
+		Order(ent.Desc(EntMessage.FieldID))
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	messages, err := query.All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting messages", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	res := make([]domain.Message, len(messages))
+	for i, message := range messages {
+		res[len(messages)-1-i] = entMessageToDomainMessage(message)
+	}
+
+	return res, nil
+}
+
+func messageFilterPredicates(filter domain.MessageFilter) []predicate.Message {
+	predicates := []predicate.Message{
+		EntMessage.RoomIDEQ(filter.RoomID),
+		EntMessage.ArchivedAtIsNil(),
+		EntMessage.IDGT(filter.AfterID),
+	}
+	if filter.BeforeID > 0 {
+		predicates = append(predicates, EntMessage.IDLT(filter.BeforeID))
+	}
+	if filter.From != nil {
+		predicates = append(predicates, EntMessage.CreatedAtGTE(*filter.From))
+	}
+	if filter.To != nil {
+		predicates = append(predicates, EntMessage.CreatedAtLT(*filter.To))
+	}
+	return predicates
+}
+
+func (r *ChatRepository) AddExport(ctx context.Context, export domain.Export) (domain.Export, error) {
+	createdExport, err := r.client.RoomExport.Create().
+		SetRoomID(export.RoomID).
+		SetUserID(export.UserID).
+		SetFormat(EntRoomExport.Format(export.Format)).
+		SetNillableFrom(export.From).
+		SetNillableTo(export.To).
+		SetMessageCount(export.MessageCount).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error creating export", zap.Error(err))
+		return domain.Export{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entRoomExportToDomainExport(createdExport), nil
+}
+
+func (r *ChatRepository) GetExportByID(ctx context.Context, id int) (domain.Export, error) {
+	export, err := r.client.RoomExport.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("export not found", zap.Error(err))
+		return domain.Export{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("export not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting export", zap.Error(err))
+		return domain.Export{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entRoomExportToDomainExport(export), nil
+}
+
+// GetDueExportIDs returns the exports waiting for a worker: the pending ones and the
+// running ones whose worker stopped renewing the lease.
+func (r *ChatRepository) GetDueExportIDs(ctx context.Context, limit int) ([]int, error) {
+	ids, err := r.client.RoomExport.Query().
+		Where(dueExportPredicate(time.Now())).
+		Order(ent.Asc(EntRoomExport.FieldID)).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting due exports", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+	return ids, nil
+}
+
+// ClaimExport leases a due export until leaseUntil and drops the chunks a previous worker
+// wrote. It reports false when another worker claimed it first.
+func (r *ChatRepository) ClaimExport(ctx context.Context, id int, leaseUntil time.Time) (bool, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error starting transaction", zap.Error(err))
+		return false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	updated, err := tx.RoomExport.Update().
+		Where(
+			EntRoomExport.IDEQ(id),
+			dueExportPredicate(time.Now()),
+		).
+		SetStatus(EntRoomExport.StatusRunning).
+		SetLeaseUntil(leaseUntil).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.logger.Ctx(ctx).Error("error claiming export", zap.Error(err))
+		return false, errors.NewError(errors.ErrorInternal, err)
+	}
+	if updated == 0 {
+		_ = tx.Rollback()
+		return false, nil
+	}
+
+	_, err = tx.RoomExportChunk.Delete().
+		Where(EntRoomExportChunk.ExportIDEQ(id)).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.logger.Ctx(ctx).Error("error deleting export chunks", zap.Error(err))
+		return false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Ctx(ctx).Error("error committing export claim", zap.Error(err))
+		return false, errors.NewError(errors.ErrorInternal, err)
+	}
+	return true, nil
+}
+
+// RenewExportLease moves the lease of a running export to leaseUntil. It reports false
+// when the lease of export expired and another worker claimed it.
+func (r *ChatRepository) RenewExportLease(ctx context.Context, export domain.Export, leaseUntil time.Time) (bool, error) {
+	if export.LeaseUntil == nil {
+		return false, nil
+	}
+	updated, err := r.client.RoomExport.Update().
+		Where(exportLeasePredicate(export.ID, *export.LeaseUntil)).
+		SetLeaseUntil(leaseUntil).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error renewing export lease", zap.Error(err))
+		return false, errors.NewError(errors.ErrorInternal, err)
+	}
+	return updated == 1, nil
+}
+
+func (r *ChatRepository) AddExportChunk(ctx context.Context, chunk domain.ExportChunk) error {
+	err := r.client.RoomExportChunk.Create().
+		SetExportID(chunk.ExportID).
+		SetSeq(chunk.Seq).
+		SetData(chunk.Data).
+		Exec(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error creating export chunk", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+func (r *ChatRepository) GetExportChunk(ctx context.Context, exportID int, seq int) (domain.ExportChunk, error) {
+	chunk, err := r.client.RoomExportChunk.Query().
+		Where(
+			EntRoomExportChunk.ExportIDEQ(exportID),
+			EntRoomExportChunk.SeqEQ(seq),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return domain.ExportChunk{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("export chunk not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting export chunk", zap.Error(err))
+		return domain.ExportChunk{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return domain.ExportChunk{
+		ExportID: chunk.ExportID,
+		Seq:      chunk.Seq,
+		Data:     chunk.Data,
+	}, nil
+}
+
+// UpdateExport records the outcome of a running export and ends its lease. It fails with
+// a conflict when the lease of export expired and another worker claimed it.
+func (r *ChatRepository) UpdateExport(ctx context.Context, export domain.Export) (domain.Export, error) {
+	if export.LeaseUntil == nil {
+		return domain.Export{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("export is not leased"))
+	}
+	updated, err := r.client.RoomExport.Update().
+		Where(exportLeasePredicate(export.ID, *export.LeaseUntil)).
+		SetStatus(EntRoomExport.Status(export.Status)).
+		SetError(export.Error).
+		SetMessageCount(export.MessageCount).
+		SetNillableCompletedAt(export.CompletedAt).
+		ClearLeaseUntil().
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error updating export", zap.Error(err))
+		return domain.Export{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	if updated == 0 {
+		return domain.Export{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("export was claimed by another worker"))
+	}
+	return r.GetExportByID(ctx, export.ID)
+}
+
+// dueExportPredicate matches the exports a worker may claim at now.
+func dueExportPredicate(now time.Time) predicate.RoomExport {
+	return EntRoomExport.Or(
+		EntRoomExport.StatusEQ(EntRoomExport.StatusPending),
+		EntRoomExport.And(
+			EntRoomExport.StatusEQ(EntRoomExport.StatusRunning),
+			EntRoomExport.Or(
+				EntRoomExport.LeaseUntilIsNil(),
+				EntRoomExport.LeaseUntilLT(now),
+			),
+		),
+	)
+}
+
+// exportLeasePredicate matches the export id while its lease still ends at leaseUntil,
+// that is while no other worker claimed it.
+func exportLeasePredicate(id int, leaseUntil time.Time) predicate.RoomExport {
+	return EntRoomExport.And(
+		EntRoomExport.IDEQ(id),
+		EntRoomExport.StatusEQ(EntRoomExport.StatusRunning),
+		EntRoomExport.LeaseUntilEQ(leaseUntil),
+	)
+}
+
+func entRoomExportToDomainExport(export *ent.RoomExport) domain.Export {
+	return domain.Export{
+		ID:           export.ID,
+		RoomID:       export.RoomID,
+		UserID:       export.UserID,
+		Format:       domain.ExportFormat(export.Format),
+		From:         export.From,
+		To:           export.To,
+		Status:       domain.ExportStatus(export.Status),
+		Error:        export.Error,
+		MessageCount: export.MessageCount,
+		CreatedAt:    export.CreatedAt,
+		CompletedAt:  export.CompletedAt,
+		LeaseUntil:   export.LeaseUntil,
+	}
+}
@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent"
+	EntHistoryImport "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/historyimport"
+	EntMessage "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	EntRoom "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/room"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/schema"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"go.uber.org/zap"
+)
+
+// AddImportedRoom returns the room created for the import key of the given room,
+// creating it first if needed. It reports whether the room was created.
+func (r *ChatRepository) AddImportedRoom(ctx context.Context, chat domain.Chat) (domain.Chat, bool, error) {
+	room, err := r.client.Room.Query().
+		Where(EntRoom.ImportKeyEQ(chat.Room.ImportKey)).
+		Only(ctx)
+	if err == nil {
+		return domain.Chat{Room: entRoomToDomainRoom(room)}, false, nil
+	}
+	if !ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Error("error getting imported room", zap.Error(err))
+		return domain.Chat{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	room, err = r.client.Room.Create().
+		SetName(chat.Room.Name).
+		SetImportKey(chat.Room.ImportKey).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error creating imported room", zap.Error(err))
+		return domain.Chat{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	return domain.Chat{Room: entRoomToDomainRoom(room)}, true, nil
+}
+
+// AddImportedMessages inserts the messages whose import keys are not stored yet
+// and returns how many were inserted.
+func (r *ChatRepository) AddImportedMessages(ctx context.Context, messages []domain.Message) (int, error) {
+	if len(messages) == 0 {
+		return 0, nil
+	}
+
+	keys := make([]string, 0, len(messages))
+	for _, message := range messages {
+		keys = append(keys, message.ImportKey)
+	}
+
+	existing, err := r.client.Message.Query().
+		Where(EntMessage.ImportKeyIn(keys...)).
+		Select(EntMessage.FieldImportKey).
+		Strings(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting imported messages", zap.Error(err))
+		return 0, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	imported := make(map[string]bool, len(existing))
+	for _, key := range existing {
+		imported[key] = true
+	}
+
+	var builders []*ent.MessageCreate
+	for _, message := range messages {
+		if imported[message.ImportKey] {
+			continue
+		}
+		// Guard against duplicate keys within the same batch
+		imported[message.ImportKey] = true
+
+		builders = append(builders, r.client.Message.Create().
+			SetRoomID(message.RoomID).
+			SetUsername(message.Username).
+			SetContent(message.Content).
+			SetCreatedAt(message.CreatedAt).
+			SetImportKey(message.ImportKey))
+	}
+	if len(builders) == 0 {
+		return 0, nil
+	}
+
+	if err := r.client.Message.CreateBulk(builders...).Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("error creating imported messages", zap.Error(err))
+		return 0, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	return len(builders), nil
+}
+
+func (r *ChatRepository) AddImport(ctx context.Context, imp domain.Import) (domain.Import, error) {
+	createdImport, err := r.client.HistoryImport.Create().
+		SetFormat(EntHistoryImport.Format(imp.Format)).
+		SetFilePath(imp.FilePath).
+		SetUserID(imp.UserID).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error creating import", zap.Error(err))
+		return domain.Import{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entHistoryImportToDomainImport(createdImport), nil
+}
+
+func (r *ChatRepository) GetImportByID(ctx context.Context, id int) (domain.Import, error) {
+	imp, err := r.client.HistoryImport.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("import not found", zap.Error(err))
+		return domain.Import{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("import not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting import", zap.Error(err))
+		return domain.Import{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entHistoryImportToDomainImport(imp), nil
+}
+
+// ClaimImport marks a pending or failed import as running. It reports false when the import is already running or done.
+func (r *ChatRepository) ClaimImport(ctx context.Context, id int) (bool, error) {
+	updated, err := r.client.HistoryImport.Update().
+		Where(
+			EntHistoryImport.IDEQ(id),
+			EntHistoryImport.StatusIn(EntHistoryImport.StatusPending, EntHistoryImport.StatusFailed),
+		).
+		SetStatus(EntHistoryImport.StatusRunning).
+		SetError("").
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error claiming import", zap.Error(err))
+		return false, errors.NewError(errors.ErrorInternal, err)
+	}
+	return updated == 1, nil
+}
+
+func (r *ChatRepository) UpdateImport(ctx context.Context, imp domain.Import) (domain.Import, error) {
+	unmappedUsers := make([]schema.UnmappedUser, 0, len(imp.UnmappedUsers))
+	for _, user := range imp.UnmappedUsers {
+		unmappedUsers = append(unmappedUsers, schema.UnmappedUser{
+			Key:      user.Key,
+			Name:     user.Name,
+			Email:    user.Email,
+			Messages: user.Messages,
+		})
+	}
+
+	updatedImport, err := r.client.HistoryImport.UpdateOneID(imp.ID).
+		SetStatus(EntHistoryImport.Status(imp.Status)).
+		SetRoomsCreated(imp.RoomsCreated).
+		SetMessagesImported(imp.MessagesImported).
+		SetMessagesSkipped(imp.MessagesSkipped).
+		SetCompletedRooms(imp.CompletedRooms).
+		SetUnmappedUsers(unmappedUsers).
+		SetError(imp.Error).
+		SetNillableCompletedAt(imp.CompletedAt).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("import not found", zap.Error(err))
+		return domain.Import{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("import not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error updating import", zap.Error(err))
+		return domain.Import{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entHistoryImportToDomainImport(updatedImport), nil
+}
+
+func entHistoryImportToDomainImport(imp *ent.HistoryImport) domain.Import {
+	var unmappedUsers []domain.UnmappedUser
+	for _, user := range imp.UnmappedUsers {
+		unmappedUsers = append(unmappedUsers, domain.UnmappedUser{
+			Key:      user.Key,
+			Name:     user.Name,
+			Email:    user.Email,
+			Messages: user.Messages,
+		})
+	}
+
+	return domain.Import{
+		ID:               imp.ID,
+		Format:           domain.ImportFormat(imp.Format),
+		FilePath:         imp.FilePath,
+		UserID:           imp.UserID,
+		Status:           domain.ImportStatus(imp.Status),
+		RoomsCreated:     imp.RoomsCreated,
+		MessagesImported: imp.MessagesImported,
+		MessagesSkipped:  imp.MessagesSkipped,
+		CompletedRooms:   imp.CompletedRooms,
+		UnmappedUsers:    unmappedUsers,
+		Error:            imp.Error,
+		CreatedAt:        imp.CreatedAt,
+		CompletedAt:      imp.CompletedAt,
+	}
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent"
+	EntRoomMember "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roommember"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"go.uber.org/zap"
+)
+
+// AddRoomMember records the user as a member of the room, it is a no-op for existing members.
+func (r *ChatRepository) AddRoomMember(ctx context.Context, chat domain.Chat) error {
+	err := r.client.RoomMember.Create().
+		SetRoomID(chat.Room.ID).
+		SetUserID(chat.User.ID).
+		SetUsername(chat.User.Username).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return nil
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error adding room member", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+func (r *ChatRepository) IsRoomMember(ctx context.Context, chat domain.Chat) (bool, error) {
+	exists, err := r.client.RoomMember.Query().
+		Where(
+			EntRoomMember.RoomIDEQ(chat.Room.ID),
+			EntRoomMember.UserIDEQ(chat.User.ID),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error checking room member", zap.Error(err))
+		return false, errors.NewError(errors.ErrorInternal, err)
+	}
+	return exists, nil
+}
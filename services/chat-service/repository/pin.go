@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent"
+	EntMessage "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	EntPinnedMessage "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pinnedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	EntSavedMessage "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/savedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"go.uber.org/zap"
+)
+
+// GetMessageByID returns a message that has not been archived.
+func (r *ChatRepository) GetMessageByID(ctx context.Context, id int) (domain.Message, error) {
+	message, err := r.client.Message.Query().
+		Where(
+			EntMessage.IDEQ(id),
+			EntMessage.ArchivedAtIsNil(),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("message not found", zap.Error(err))
+		return domain.Message{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("message not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting message", zap.Error(err))
+		return domain.Message{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entMessageToDomainMessage(message), nil
+}
+
+func (r *ChatRepository) AddPinnedMessage(ctx context.Context, pin domain.PinnedMessage) (domain.PinnedMessage, error) {
+	createdPin, err := r.client.PinnedMessage.Create().
+		SetRoomID(pin.RoomID).
+		SetMessageID(pin.MessageID).
+		SetPinnedBy(pin.PinnedBy).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return domain.PinnedMessage{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("message is already pinned"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error pinning message", zap.Error(err))
+		return domain.PinnedMessage{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	res := entPinnedMessageToDomainPinnedMessage(createdPin)
+	res.Message = pin.Message
+	return res, nil
+}
+
+func (r *ChatRepository) DeletePinnedMessage(ctx context.Context, messageID int) error {
+	deleted, err := r.client.PinnedMessage.Delete().
+		Where(EntPinnedMessage.MessageIDEQ(messageID)).
+		Exec(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error unpinning message", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if deleted == 0 {
+		return errors.NewError(errors.ErrorNotFound, fmt.Errorf("message is not pinned"))
+	}
+	return nil
+}
+
+// GetPinnedMessages returns the pins of a room with their messages, most recent pin first.
+// Pins of archived messages are left out.
+func (r *ChatRepository) GetPinnedMessages(ctx context.Context, roomID string) ([]domain.PinnedMessage, error) {
+	pins, err := r.client.PinnedMessage.Query().
+		Where(
+			EntPinnedMessage.RoomIDEQ(roomID),
+			EntPinnedMessage.HasMessageWith(EntMessage.ArchivedAtIsNil()),
+		).
+		WithMessage().
+		Order(ent.Desc(EntPinnedMessage.FieldPinnedAt), ent.Desc(EntPinnedMessage.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting pinned messages", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	var res []domain.PinnedMessage
+	for _, pin := range pins {
+		res = append(res, entPinnedMessageToDomainPinnedMessage(pin))
+	}
+	return res, nil
+}
+
+func (r *ChatRepository) AddSavedMessage(ctx context.Context, saved domain.SavedMessage) (domain.SavedMessage, error) {
+	createdSaved, err := r.client.SavedMessage.Create().
+		SetUserID(saved.UserID).
+		SetMessageID(saved.MessageID).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return domain.SavedMessage{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("message is already saved"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error saving message", zap.Error(err))
+		return domain.SavedMessage{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	res := entSavedMessageToDomainSavedMessage(createdSaved)
+	res.Message = saved.Message
+	return res, nil
+}
+
+func (r *ChatRepository) DeleteSavedMessage(ctx context.Context, userID string, messageID int) error {
+	deleted, err := r.client.SavedMessage.Delete().
+		Where(
+			EntSavedMessage.UserIDEQ(userID),
+			EntSavedMessage.MessageIDEQ(messageID),
+		).
+		Exec(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error removing saved message", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if deleted == 0 {
+		return errors.NewError(errors.ErrorNotFound, fmt.Errorf("message is not saved"))
+	}
+	return nil
+}
+
+// GetSavedMessages returns the saved messages of a user, most recently saved first,
+// optionally only those of one room. Saves of archived messages are left out.
+func (r *ChatRepository) GetSavedMessages(ctx context.Context, userID, roomID string) ([]domain.SavedMessage, error) {
+	messagePredicates := []predicate.Message{EntMessage.ArchivedAtIsNil()}
+	if roomID != "" {
+		messagePredicates = append(messagePredicates, EntMessage.RoomIDEQ(roomID))
+	}
+
+	saved, err := r.client.SavedMessage.Query().
+		Where(
+			EntSavedMessage.UserIDEQ(userID),
+			EntSavedMessage.HasMessageWith(messagePredicates...),
+		).
+		WithMessage().
+		Order(ent.Desc(EntSavedMessage.FieldSavedAt), ent.Desc(EntSavedMessage.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting saved messages", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	var res []domain.SavedMessage
+	for _, s := range saved {
+		res = append(res, entSavedMessageToDomainSavedMessage(s))
+	}
+	return res, nil
+}
+
+func entPinnedMessageToDomainPinnedMessage(pin *ent.PinnedMessage) domain.PinnedMessage {
+	res := domain.PinnedMessage{
+		ID:        pin.ID,
+		RoomID:    pin.RoomID,
+		MessageID: pin.MessageID,
+		PinnedBy:  pin.PinnedBy,
+		PinnedAt:  pin.PinnedAt,
+	}
+	if pin.Edges.Message != nil {
+		res.Message = entMessageToDomainMessage(pin.Edges.Message)
+	}
+	return res
+}
+
+func entSavedMessageToDomainSavedMessage(saved *ent.SavedMessage) domain.SavedMessage {
+	res := domain.SavedMessage{
+		ID:        saved.ID,
+		UserID:    saved.UserID,
+		MessageID: saved.MessageID,
+		SavedAt:   saved.SavedAt,
+	}
+	if saved.Edges.Message != nil {
+		res.Message = entMessageToDomainMessage(saved.Edges.Message)
+	}
+	return res
+}
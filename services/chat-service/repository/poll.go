@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent"
+	EntPoll "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
+	EntPollVote "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pollvote"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"go.uber.org/zap"
+)
+
+// AddPoll posts the question of a poll as a message of its room and creates the poll
+// for it in one transaction.
+func (r *ChatRepository) AddPoll(ctx context.Context, poll domain.Poll) (domain.Poll, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error starting transaction", zap.Error(err))
+		return domain.Poll{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	defer tx.Rollback()
+
+	message, err := tx.Message.Create().
+		SetRoomID(poll.RoomID).
+		SetUsername(poll.CreatedByName).
+		SetContent(poll.Question).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error creating poll message", zap.Error(err))
+		return domain.Poll{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	createdPoll, err := tx.Poll.Create().
+		SetRoomID(poll.RoomID).
+		SetMessageID(message.ID).
+		SetQuestion(poll.Question).
+		SetOptions(poll.Options).
+		SetMultiple(poll.Multiple).
+		SetAnonymous(poll.Anonymous).
+		SetCreatedBy(poll.CreatedBy).
+		SetNillableClosesAt(poll.ClosesAt).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error creating poll", zap.Error(err))
+		return domain.Poll{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Poll{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	createdPoll.Edges.Message = message
+	return entPollToDomainPoll(createdPoll), nil
+}
+
+// GetPollByID returns a poll with its message and all of its votes.
+func (r *ChatRepository) GetPollByID(ctx context.Context, id int) (domain.Poll, error) {
+	return r.getPollByID(ctx, r.client.Poll, id)
+}
+
+func (r *ChatRepository) getPollByID(ctx context.Context, client *ent.PollClient, id int) (domain.Poll, error) {
+	poll, err := client.Query().
+		Where(EntPoll.IDEQ(id)).
+		WithMessage().
+		WithVotes(func(query *ent.PollVoteQuery) {
+			query.Order(ent.Asc(EntPollVote.FieldID))
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("poll not found", zap.Error(err))
+		return domain.Poll{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("poll not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting poll", zap.Error(err))
+		return domain.Poll{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entPollToDomainPoll(poll), nil
+}
+
+// SetPollVotes replaces the votes of a user on a poll; no options retracts the vote.
+// The poll row is updated first, which fails with a conflict once the poll is closed
+// and keeps votes from slipping in while the poll is being closed.
+func (r *ChatRepository) SetPollVotes(ctx context.Context, pollID int, user domain.User, options []int, now time.Time) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error starting transaction", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	defer tx.Rollback()
+
+	updated, err := tx.Poll.Update().
+		Where(
+			EntPoll.IDEQ(pollID),
+			EntPoll.ClosedAtIsNil(),
+			EntPoll.Or(EntPoll.ClosesAtIsNil(), EntPoll.ClosesAtGT(now)),
+		).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error locking poll", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if updated == 0 {
+		return errors.NewError(errors.ErrorConflict, fmt.Errorf("poll is closed"))
+	}
+
+	if _, err := tx.PollVote.Delete().
+		Where(
+			EntPollVote.PollIDEQ(pollID),
+			EntPollVote.UserIDEQ(user.ID),
+		).
+		Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("error deleting poll votes", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if len(options) > 0 {
+		builders := make([]*ent.PollVoteCreate, 0, len(options))
+		for _, option := range options {
+			builders = append(builders, tx.PollVote.Create().
+				SetPollID(pollID).
+				SetUserID(user.ID).
+				SetUsername(user.Username).
+				SetOption(option))
+		}
+		if err := tx.PollVote.CreateBulk(builders...).Exec(ctx); err != nil {
+			r.logger.Ctx(ctx).Error("error creating poll votes", zap.Error(err))
+			return errors.NewError(errors.ErrorInternal, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+// GetDuePolls returns the open polls whose closing time has come.
+func (r *ChatRepository) GetDuePolls(ctx context.Context, now time.Time, limit int) ([]domain.Poll, error) {
+	polls, err := r.client.Poll.Query().
+		Where(
+			EntPoll.ClosedAtIsNil(),
+			EntPoll.ClosesAtLTE(now),
+		).
+		Order(ent.Asc(EntPoll.FieldClosesAt), ent.Asc(EntPoll.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting due polls", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	var res []domain.Poll
+	for _, poll := range polls {
+		res = append(res, entPollToDomainPoll(poll))
+	}
+	return res, nil
+}
+
+// ClosePoll closes an open poll and posts its results as a message in one transaction.
+// It reports false when the poll was already closed, so that with several replicas
+// closing polls the results are posted exactly once.
+func (r *ChatRepository) ClosePoll(ctx context.Context, id int, closedAt time.Time) (domain.Poll, domain.Message, bool, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error starting transaction", zap.Error(err))
+		return domain.Poll{}, domain.Message{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+	defer tx.Rollback()
+
+	updated, err := tx.Poll.Update().
+		Where(
+			EntPoll.IDEQ(id),
+			EntPoll.ClosedAtIsNil(),
+		).
+		SetClosedAt(closedAt).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error closing poll", zap.Error(err))
+		return domain.Poll{}, domain.Message{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+	if updated == 0 {
+		return domain.Poll{}, domain.Message{}, false, nil
+	}
+
+	// The votes are final now that the poll row is locked
+	poll, err := r.getPollByID(ctx, tx.Poll, id)
+	if err != nil {
+		return domain.Poll{}, domain.Message{}, false, err
+	}
+
+	summary, err := tx.Message.Create().
+		SetRoomID(poll.RoomID).
+		SetUsername(poll.CreatedByName).
+		SetContent(poll.Summary()).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error creating poll summary", zap.Error(err))
+		return domain.Poll{}, domain.Message{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if err := tx.Poll.UpdateOneID(id).
+		SetSummaryMessageID(summary.ID).
+		Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("error updating poll", zap.Error(err))
+		return domain.Poll{}, domain.Message{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Poll{}, domain.Message{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	poll.SummaryMessageID = &summary.ID
+	return poll, entMessageToDomainMessage(summary), true, nil
+}
+
+func entPollToDomainPoll(poll *ent.Poll) domain.Poll {
+	res := domain.Poll{
+		ID:               poll.ID,
+		RoomID:           poll.RoomID,
+		MessageID:        poll.MessageID,
+		Question:         poll.Question,
+		Options:          poll.Options,
+		Multiple:         poll.Multiple,
+		Anonymous:        poll.Anonymous,
+		CreatedBy:        poll.CreatedBy,
+		ClosesAt:         poll.ClosesAt,
+		ClosedAt:         poll.ClosedAt,
+		SummaryMessageID: poll.SummaryMessageID,
+		CreatedAt:        poll.CreatedAt,
+	}
+	if poll.Edges.Message != nil {
+		res.CreatedByName = poll.Edges.Message.Username
+	}
+	for _, vote := range poll.Edges.Votes {
+		res.Votes = append(res.Votes, domain.PollVote{
+			PollID:   vote.PollID,
+			UserID:   vote.UserID,
+			Username: vote.Username,
+			Option:   vote.Option,
+		})
+	}
+	return res
+}
@@ -3,13 +3,22 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/ports"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent"
+	EntBotDelivery "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botdelivery"
+	EntBotSubscription "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botsubscription"
+	EntCallSession "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callsession"
 	EntMessage "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	EntRoomMember "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roommember"
+	EntScheduledMessage "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/scheduledmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/schema"
+	EntWebhook "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/webhook"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"go.uber.org/zap"
 )
 
 type ChatRepository struct {
@@ -26,19 +35,19 @@ func NewChatRepository(client *ent.Client, logger *logger.Logger) ports.IChatRep
 
 func (r *ChatRepository) AddRoom(ctx context.Context, chat domain.Chat) (domain.Chat, error) {
 	room := chat.Room
-	createdRoom, err := r.client.Room.Create().
-		SetName(room.Name).
-		Save(ctx)
+	create := r.client.Room.Create().
+		SetName(room.Name)
+	if room.OwnerID != "" {
+		create.SetOwnerID(room.OwnerID)
+	}
+	createdRoom, err := create.Save(ctx)
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("error creating room: %v", err))
+		r.logger.Ctx(ctx).Error("error creating room", zap.Error(err))
 		return domain.Chat{}, errors.NewError(errors.ErrorInternal, err)
 	}
 
 	res := domain.Chat{
-		Room: domain.Room{
-			ID:   fmt.Sprintf("%d", createdRoom.ID),
-			Name: createdRoom.Name,
-		},
+		Room: entRoomToDomainRoom(createdRoom),
 	}
 
 	return res, nil
@@ -47,42 +56,147 @@ func (r *ChatRepository) AddRoom(ctx context.Context, chat domain.Chat) (domain.
 func (r *ChatRepository) GetRooms(ctx context.Context) ([]domain.Chat, error) {
 	rooms, err := r.client.Room.Query().All(ctx)
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("error getting rooms: %v", err))
+		r.logger.Ctx(ctx).Error("error getting rooms", zap.Error(err))
 		return nil, errors.NewError(errors.ErrorInternal, err)
 	}
 
 	var res []domain.Chat
 	for _, room := range rooms {
 		res = append(res, domain.Chat{
-			Room: domain.Room{
-				ID:   fmt.Sprintf("%d", room.ID),
-				Name: room.Name,
-			},
+			Room: entRoomToDomainRoom(room),
 		})
 	}
 
 	return res, nil
 }
 
+func (r *ChatRepository) GetRoomByID(ctx context.Context, chat domain.Chat) (domain.Chat, error) {
+	roomID, err := strconv.Atoi(chat.Room.ID)
+	if err != nil {
+		return domain.Chat{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("invalid room id: %s", chat.Room.ID))
+	}
+
+	room, err := r.client.Room.Get(ctx, roomID)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("room not found", zap.Error(err))
+		return domain.Chat{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("room not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting room", zap.Error(err))
+		return domain.Chat{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	return domain.Chat{
+		Room: entRoomToDomainRoom(room),
+	}, nil
+}
+
+func (r *ChatRepository) UpdateRoom(ctx context.Context, chat domain.Chat) (domain.Chat, error) {
+	roomID, err := strconv.Atoi(chat.Room.ID)
+	if err != nil {
+		return domain.Chat{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("invalid room id: %s", chat.Room.ID))
+	}
+
+	updatedRoom, err := r.client.Room.UpdateOneID(roomID).
+		SetName(chat.Room.Name).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("room not found", zap.Error(err))
+		return domain.Chat{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("room not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error updating room", zap.Error(err))
+		return domain.Chat{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	return domain.Chat{
+		Room: entRoomToDomainRoom(updatedRoom),
+	}, nil
+}
+
+// DeleteRoom deletes a room together with its messages, members, webhooks and bot subscriptions.
+func (r *ChatRepository) DeleteRoom(ctx context.Context, chat domain.Chat) error {
+	roomID, err := strconv.Atoi(chat.Room.ID)
+	if err != nil {
+		return errors.NewError(errors.ErrorBadRequest, fmt.Errorf("invalid room id: %s", chat.Room.ID))
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error starting transaction", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	defer tx.Rollback()
+
+	subscriptionIDs, err := tx.BotSubscription.Query().
+		Where(EntBotSubscription.RoomIDEQ(chat.Room.ID)).
+		IDs(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting room bot subscriptions", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if _, err := tx.BotDelivery.Delete().Where(EntBotDelivery.SubscriptionIDIn(subscriptionIDs...)).Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("error deleting room bot deliveries", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if _, err := tx.BotSubscription.Delete().Where(EntBotSubscription.RoomIDEQ(chat.Room.ID)).Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("error deleting room bot subscriptions", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if _, err := tx.Webhook.Delete().Where(EntWebhook.RoomIDEQ(chat.Room.ID)).Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("error deleting room webhooks", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if _, err := tx.CallSession.Delete().Where(EntCallSession.RoomIDEQ(chat.Room.ID)).Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("error deleting room calls", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if _, err := tx.ScheduledMessage.Delete().Where(EntScheduledMessage.RoomIDEQ(chat.Room.ID)).Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("error deleting room scheduled messages", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if _, err := tx.RoomMember.Delete().Where(EntRoomMember.RoomIDEQ(chat.Room.ID)).Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("error deleting room members", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	if _, err := tx.Message.Delete().Where(EntMessage.RoomIDEQ(chat.Room.ID)).Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("error deleting room messages", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+
+	err = tx.Room.DeleteOneID(roomID).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return errors.NewError(errors.ErrorNotFound, fmt.Errorf("room not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error deleting room", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Ctx(ctx).Error("error committing room deletion", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
 func (r *ChatRepository) AddMessage(ctx context.Context, chat domain.Chat) (domain.Chat, error) {
 	message := chat.Message
 	createdMessage, err := r.client.Message.Create().
 		SetRoomID(message.RoomID).
 		SetUsername(message.Username).
 		SetContent(message.Content).
+		SetBot(message.Bot).
+		SetAttachments(domainAttachmentsToEntAttachments(message.Attachments)).
 		Save(ctx)
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("error creating message: %v", err))
+		r.logger.Ctx(ctx).Error("error creating message", zap.Error(err))
 		return domain.Chat{}, errors.NewError(errors.ErrorInternal, err)
 	}
 
 	res := domain.Chat{
-		Message: domain.Message{
-			ID:       createdMessage.ID,
-			RoomID:   createdMessage.RoomID,
-			Username: createdMessage.Username,
-			Content:  createdMessage.Content,
-		},
+		Message: entMessageToDomainMessage(createdMessage),
 	}
 
 	return res, nil
@@ -90,24 +204,88 @@ func (r *ChatRepository) AddMessage(ctx context.Context, chat domain.Chat) (doma
 
 func (r *ChatRepository) GetMessagesByRoomID(ctx context.Context, chat domain.Chat) ([]domain.Chat, error) {
 	messages, err := r.client.Message.Query().
-		Where(EntMessage.RoomIDEQ(chat.Message.RoomID)).
+		Where(
+			EntMessage.RoomIDEQ(chat.Message.RoomID),
+			EntMessage.ArchivedAtIsNil(),
+		).
+		Order(ent.Asc(EntMessage.FieldID)).
 		All(ctx)
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("error getting messages: %v", err))
+		r.logger.Ctx(ctx).Error("error getting messages", zap.Error(err))
 		return nil, errors.NewError(errors.ErrorInternal, err)
 	}
 
 	var res []domain.Chat
 	for _, message := range messages {
 		res = append(res, domain.Chat{
-			Message: domain.Message{
-				ID:       message.ID,
-				RoomID:   message.RoomID,
-				Username: message.Username,
-				Content:  message.Content,
-			},
+			Message: entMessageToDomainMessage(message),
 		})
 	}
 
 	return res, nil
 }
+
+func entRoomToDomainRoom(room *ent.Room) domain.Room {
+	res := domain.Room{
+		ID:   fmt.Sprintf("%d", room.ID),
+		Name: room.Name,
+		Retention: domain.Retention{
+			MaxAge:      room.RetentionMaxAge,
+			MaxMessages: room.RetentionMaxMessages,
+		},
+	}
+	if room.OwnerID != nil {
+		res.OwnerID = *room.OwnerID
+	}
+	if room.ImportKey != nil {
+		res.ImportKey = *room.ImportKey
+	}
+	return res
+}
+
+func entMessageToDomainMessage(message *ent.Message) domain.Message {
+	res := domain.Message{
+		ID:          message.ID,
+		RoomID:      message.RoomID,
+		Username:    message.Username,
+		Content:     message.Content,
+		Bot:         message.Bot,
+		Attachments: entAttachmentsToDomainAttachments(message.Attachments),
+		CreatedAt:   message.CreatedAt,
+		ArchivedAt:  message.ArchivedAt,
+	}
+	if message.ImportKey != nil {
+		res.ImportKey = *message.ImportKey
+	}
+	return res
+}
+
+func domainAttachmentsToEntAttachments(attachments []domain.Attachment) []schema.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+	res := make([]schema.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		res = append(res, schema.Attachment{
+			Title: attachment.Title,
+			URL:   attachment.URL,
+			Text:  attachment.Text,
+		})
+	}
+	return res
+}
+
+func entAttachmentsToDomainAttachments(attachments []schema.Attachment) []domain.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+	res := make([]domain.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		res = append(res, domain.Attachment{
+			Title: attachment.Title,
+			URL:   attachment.URL,
+			Text:  attachment.Text,
+		})
+	}
+	return res
+}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent"
+	EntMessage "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	EntMessagePurge "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/messagepurge"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"go.uber.org/zap"
+)
+
+func (r *ChatRepository) UpdateRoomRetention(ctx context.Context, chat domain.Chat) (domain.Chat, error) {
+	roomID, err := strconv.Atoi(chat.Room.ID)
+	if err != nil {
+		return domain.Chat{}, errors.NewError(errors.ErrorBadRequest, fmt.Errorf("invalid room id: %s", chat.Room.ID))
+	}
+
+	update := r.client.Room.UpdateOneID(roomID)
+	if chat.Room.Retention.MaxAge != nil {
+		update.SetRetentionMaxAge(*chat.Room.Retention.MaxAge)
+	} else {
+		update.ClearRetentionMaxAge()
+	}
+	if chat.Room.Retention.MaxMessages != nil {
+		update.SetRetentionMaxMessages(*chat.Room.Retention.MaxMessages)
+	} else {
+		update.ClearRetentionMaxMessages()
+	}
+
+	updatedRoom, err := update.Save(ctx)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("room not found", zap.Error(err))
+		return domain.Chat{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("room not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error updating room retention", zap.Error(err))
+		return domain.Chat{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	return domain.Chat{
+		Room: entRoomToDomainRoom(updatedRoom),
+	}, nil
+}
+
+// GetMessageOverflowID returns the ID of the newest message that is not among
+// the newest keep messages of the room, or zero if the room has no overflow.
+func (r *ChatRepository) GetMessageOverflowID(ctx context.Context, roomID string, keep int) (int, error) {
+	ids, err := r.client.Message.Query().
+		Where(
+			EntMessage.RoomIDEQ(roomID),
+			EntMessage.ArchivedAtIsNil(),
+		).
+		Order(ent.Desc(EntMessage.FieldID)).
+		Offset(keep).
+		Limit(1).
+		IDs(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting message overflow", zap.Error(err))
+		return 0, errors.NewError(errors.ErrorInternal, err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return ids[0], nil
+}
+
+func (r *ChatRepository) GetPurgeCandidates(ctx context.Context, filter domain.PurgeFilter) ([]domain.Message, error) {
+	predicates := []predicate.Message{
+		EntMessage.RoomIDEQ(filter.RoomID),
+		EntMessage.ArchivedAtIsNil(),
+		EntMessage.IDGT(filter.AfterID),
+	}
+	if !filter.CreatedBefore.IsZero() {
+		predicates = append(predicates, EntMessage.CreatedAtLT(filter.CreatedBefore))
+	}
+	if filter.MaxID > 0 {
+		predicates = append(predicates, EntMessage.IDLTE(filter.MaxID))
+	}
+
+	messages, err := r.client.Message.Query().
+		Where(predicates...).
+		Order(ent.Asc(EntMessage.FieldID)).
+		Limit(filter.Limit).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting purge candidates", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	var res []domain.Message
+	for _, message := range messages {
+		res = append(res, entMessageToDomainMessage(message))
+	}
+
+	return res, nil
+}
+
+func (r *ChatRepository) DeleteMessages(ctx context.Context, ids []int) (int, error) {
+	deleted, err := r.client.Message.Delete().
+		Where(EntMessage.IDIn(ids...)).
+		Exec(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error deleting messages", zap.Error(err))
+		return 0, errors.NewError(errors.ErrorInternal, err)
+	}
+	return deleted, nil
+}
+
+func (r *ChatRepository) ArchiveMessages(ctx context.Context, ids []int) (int, error) {
+	archived, err := r.client.Message.Update().
+		Where(
+			EntMessage.IDIn(ids...),
+			EntMessage.ArchivedAtIsNil(),
+		).
+		SetArchivedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error archiving messages", zap.Error(err))
+		return 0, errors.NewError(errors.ErrorInternal, err)
+	}
+	return archived, nil
+}
+
+func (r *ChatRepository) AddPurge(ctx context.Context, purge domain.Purge) (domain.Purge, error) {
+	createdPurge, err := r.client.MessagePurge.Create().
+		SetRoomID(purge.RoomID).
+		SetReason(EntMessagePurge.Reason(purge.Reason)).
+		SetMode(EntMessagePurge.Mode(purge.Mode)).
+		SetDryRun(purge.DryRun).
+		SetMessageCount(purge.MessageCount).
+		SetMessageIds(purge.MessageIDs).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error recording purge", zap.Error(err))
+		return domain.Purge{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entMessagePurgeToDomainPurge(createdPurge), nil
+}
+
+func (r *ChatRepository) GetPurges(ctx context.Context, roomID string) ([]domain.Purge, error) {
+	query := r.client.MessagePurge.Query()
+	if roomID != "" {
+		query = query.Where(EntMessagePurge.RoomIDEQ(roomID))
+	}
+
+	purges, err := query.
+		Order(ent.Desc(EntMessagePurge.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting purges", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	var res []domain.Purge
+	for _, purge := range purges {
+		res = append(res, entMessagePurgeToDomainPurge(purge))
+	}
+
+	return res, nil
+}
+
+func entMessagePurgeToDomainPurge(purge *ent.MessagePurge) domain.Purge {
+	return domain.Purge{
+		ID:           purge.ID,
+		RoomID:       purge.RoomID,
+		Reason:       domain.PurgeReason(purge.Reason),
+		Mode:         domain.PurgeMode(purge.Mode),
+		DryRun:       purge.DryRun,
+		MessageCount: purge.MessageCount,
+		MessageIDs:   purge.MessageIds,
+		CreatedAt:    purge.CreatedAt,
+	}
+}
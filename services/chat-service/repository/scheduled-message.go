@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent"
+	EntRoomMember "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roommember"
+	EntScheduledMessage "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/scheduledmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"go.uber.org/zap"
+)
+
+func (r *ChatRepository) AddScheduledMessage(ctx context.Context, scheduledMessage domain.ScheduledMessage) (domain.ScheduledMessage, error) {
+	createdScheduledMessage, err := r.client.ScheduledMessage.Create().
+		SetRoomID(scheduledMessage.RoomID).
+		SetUserID(scheduledMessage.UserID).
+		SetUsername(scheduledMessage.Username).
+		SetContent(scheduledMessage.Content).
+		SetSendAt(scheduledMessage.SendAt).
+		SetTimeZone(scheduledMessage.TimeZone).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error creating scheduled message", zap.Error(err))
+		return domain.ScheduledMessage{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entScheduledMessageToDomainScheduledMessage(createdScheduledMessage), nil
+}
+
+func (r *ChatRepository) GetScheduledMessageByID(ctx context.Context, id int) (domain.ScheduledMessage, error) {
+	scheduledMessage, err := r.client.ScheduledMessage.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("scheduled message not found", zap.Error(err))
+		return domain.ScheduledMessage{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("scheduled message not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting scheduled message", zap.Error(err))
+		return domain.ScheduledMessage{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entScheduledMessageToDomainScheduledMessage(scheduledMessage), nil
+}
+
+func (r *ChatRepository) GetScheduledMessages(ctx context.Context, filter domain.ScheduledMessageFilter) ([]domain.ScheduledMessage, error) {
+	query := r.client.ScheduledMessage.Query()
+	if filter.UserID != "" {
+		query = query.Where(EntScheduledMessage.UserIDEQ(filter.UserID))
+	}
+	if filter.RoomID != "" {
+		query = query.Where(EntScheduledMessage.RoomIDEQ(filter.RoomID))
+	}
+	if filter.Status != "" {
+		query = query.Where(EntScheduledMessage.StatusEQ(EntScheduledMessage.Status(filter.Status)))
+	}
+
+	scheduledMessages, err := query.
+		Order(ent.Asc(EntScheduledMessage.FieldSendAt), ent.Asc(EntScheduledMessage.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting scheduled messages", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	var res []domain.ScheduledMessage
+	for _, scheduledMessage := range scheduledMessages {
+		res = append(res, entScheduledMessageToDomainScheduledMessage(scheduledMessage))
+	}
+	return res, nil
+}
+
+// UpdateScheduledMessage changes the content and the send time of a scheduled message.
+// It fails with a conflict when the message was sent or canceled in the meantime.
+func (r *ChatRepository) UpdateScheduledMessage(ctx context.Context, scheduledMessage domain.ScheduledMessage) (domain.ScheduledMessage, error) {
+	updated, err := r.client.ScheduledMessage.Update().
+		Where(
+			EntScheduledMessage.IDEQ(scheduledMessage.ID),
+			EntScheduledMessage.StatusEQ(EntScheduledMessage.StatusPending),
+		).
+		SetContent(scheduledMessage.Content).
+		SetSendAt(scheduledMessage.SendAt).
+		SetTimeZone(scheduledMessage.TimeZone).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error updating scheduled message", zap.Error(err))
+		return domain.ScheduledMessage{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	if updated == 0 {
+		return domain.ScheduledMessage{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("scheduled message was already sent or canceled"))
+	}
+	return r.GetScheduledMessageByID(ctx, scheduledMessage.ID)
+}
+
+// CancelScheduledMessage cancels a pending scheduled message.
+// It fails with a conflict when the message was already sent or canceled.
+func (r *ChatRepository) CancelScheduledMessage(ctx context.Context, id int) (domain.ScheduledMessage, error) {
+	updated, err := r.client.ScheduledMessage.Update().
+		Where(
+			EntScheduledMessage.IDEQ(id),
+			EntScheduledMessage.StatusEQ(EntScheduledMessage.StatusPending),
+		).
+		SetStatus(EntScheduledMessage.StatusCanceled).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error canceling scheduled message", zap.Error(err))
+		return domain.ScheduledMessage{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	if updated == 0 {
+		return domain.ScheduledMessage{}, errors.NewError(errors.ErrorConflict, fmt.Errorf("scheduled message was already sent or canceled"))
+	}
+	return r.GetScheduledMessageByID(ctx, id)
+}
+
+// GetDueScheduledMessages returns the pending scheduled messages whose send time has come.
+func (r *ChatRepository) GetDueScheduledMessages(ctx context.Context, now time.Time, limit int) ([]domain.ScheduledMessage, error) {
+	scheduledMessages, err := r.client.ScheduledMessage.Query().
+		Where(
+			EntScheduledMessage.StatusEQ(EntScheduledMessage.StatusPending),
+			EntScheduledMessage.SendAtLTE(now),
+		).
+		Order(ent.Asc(EntScheduledMessage.FieldSendAt), ent.Asc(EntScheduledMessage.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting due scheduled messages", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	var res []domain.ScheduledMessage
+	for _, scheduledMessage := range scheduledMessages {
+		res = append(res, entScheduledMessageToDomainScheduledMessage(scheduledMessage))
+	}
+	return res, nil
+}
+
+// SendScheduledMessage marks a due scheduled message as sent and creates its message in
+// one transaction. The conditional update locks the row, so when several replicas race
+// for the same message only one of them sees it pending; the others get false.
+// A message whose author has left its room since is canceled instead of sent.
+func (r *ChatRepository) SendScheduledMessage(ctx context.Context, scheduledMessage domain.ScheduledMessage, sentAt time.Time) (domain.Message, bool, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error starting transaction", zap.Error(err))
+		return domain.Message{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+	defer tx.Rollback()
+
+	isMember, err := tx.RoomMember.Query().
+		Where(
+			EntRoomMember.RoomIDEQ(scheduledMessage.RoomID),
+			EntRoomMember.UserIDEQ(scheduledMessage.UserID),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error checking room member", zap.Error(err))
+		return domain.Message{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+	if !isMember {
+		if err := tx.ScheduledMessage.Update().
+			Where(
+				EntScheduledMessage.IDEQ(scheduledMessage.ID),
+				EntScheduledMessage.StatusEQ(EntScheduledMessage.StatusPending),
+			).
+			SetStatus(EntScheduledMessage.StatusCanceled).
+			Exec(ctx); err != nil {
+			r.logger.Ctx(ctx).Error("error canceling scheduled message", zap.Error(err))
+			return domain.Message{}, false, errors.NewError(errors.ErrorInternal, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return domain.Message{}, false, errors.NewError(errors.ErrorInternal, err)
+		}
+		return domain.Message{}, false, nil
+	}
+
+	updated, err := tx.ScheduledMessage.Update().
+		Where(
+			EntScheduledMessage.IDEQ(scheduledMessage.ID),
+			EntScheduledMessage.StatusEQ(EntScheduledMessage.StatusPending),
+			EntScheduledMessage.SendAtLTE(sentAt),
+		).
+		SetStatus(EntScheduledMessage.StatusSent).
+		SetSentAt(sentAt).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error claiming scheduled message", zap.Error(err))
+		return domain.Message{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+	if updated == 0 {
+		return domain.Message{}, false, nil
+	}
+
+	// Read the message back, it may have been edited since it was listed as due
+	current, err := tx.ScheduledMessage.Get(ctx, scheduledMessage.ID)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting scheduled message", zap.Error(err))
+		return domain.Message{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	createdMessage, err := tx.Message.Create().
+		SetRoomID(current.RoomID).
+		SetUsername(current.Username).
+		SetContent(current.Content).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error creating scheduled message", zap.Error(err))
+		return domain.Message{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if err := tx.ScheduledMessage.UpdateOneID(current.ID).
+		SetMessageID(createdMessage.ID).
+		Exec(ctx); err != nil {
+		r.logger.Ctx(ctx).Error("error updating scheduled message", zap.Error(err))
+		return domain.Message{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Message{}, false, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entMessageToDomainMessage(createdMessage), true, nil
+}
+
+func entScheduledMessageToDomainScheduledMessage(scheduledMessage *ent.ScheduledMessage) domain.ScheduledMessage {
+	return domain.ScheduledMessage{
+		ID:        scheduledMessage.ID,
+		RoomID:    scheduledMessage.RoomID,
+		UserID:    scheduledMessage.UserID,
+		Username:  scheduledMessage.Username,
+		Content:   scheduledMessage.Content,
+		SendAt:    scheduledMessage.SendAt,
+		TimeZone:  scheduledMessage.TimeZone,
+		Status:    domain.ScheduledMessageStatus(scheduledMessage.Status),
+		MessageID: scheduledMessage.MessageID,
+		CreatedAt: scheduledMessage.CreatedAt,
+		UpdatedAt: scheduledMessage.UpdatedAt,
+		SentAt:    scheduledMessage.SentAt,
+	}
+}
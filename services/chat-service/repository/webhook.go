@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent"
+	EntWebhook "github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/webhook"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/errors"
+	"go.uber.org/zap"
+)
+
+func (r *ChatRepository) AddWebhook(ctx context.Context, webhook domain.Webhook) (domain.Webhook, error) {
+	createdWebhook, err := r.client.Webhook.Create().
+		SetRoomID(webhook.RoomID).
+		SetName(webhook.Name).
+		SetCreatedBy(webhook.CreatedBy).
+		SetTokenHash(webhook.TokenHash).
+		Save(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error creating webhook", zap.Error(err))
+		return domain.Webhook{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entWebhookToDomainWebhook(createdWebhook), nil
+}
+
+func (r *ChatRepository) GetWebhookByID(ctx context.Context, id int) (domain.Webhook, error) {
+	webhook, err := r.client.Webhook.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Warn("webhook not found", zap.Error(err))
+		return domain.Webhook{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("webhook not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting webhook", zap.Error(err))
+		return domain.Webhook{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entWebhookToDomainWebhook(webhook), nil
+}
+
+func (r *ChatRepository) GetWebhookByTokenHash(ctx context.Context, tokenHash string) (domain.Webhook, error) {
+	webhook, err := r.client.Webhook.Query().
+		Where(EntWebhook.TokenHashEQ(tokenHash)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return domain.Webhook{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("webhook not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting webhook", zap.Error(err))
+		return domain.Webhook{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entWebhookToDomainWebhook(webhook), nil
+}
+
+func (r *ChatRepository) GetWebhooksByRoomID(ctx context.Context, roomID string) ([]domain.Webhook, error) {
+	webhooks, err := r.client.Webhook.Query().
+		Where(EntWebhook.RoomIDEQ(roomID)).
+		Order(ent.Asc(EntWebhook.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error getting webhooks", zap.Error(err))
+		return nil, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	var res []domain.Webhook
+	for _, webhook := range webhooks {
+		res = append(res, entWebhookToDomainWebhook(webhook))
+	}
+	return res, nil
+}
+
+func (r *ChatRepository) RevokeWebhook(ctx context.Context, id int) (domain.Webhook, error) {
+	webhook, err := r.client.Webhook.UpdateOneID(id).
+		SetIsRevoked(true).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return domain.Webhook{}, errors.NewError(errors.ErrorNotFound, fmt.Errorf("webhook not found"))
+	}
+	if err != nil {
+		r.logger.Ctx(ctx).Error("error revoking webhook", zap.Error(err))
+		return domain.Webhook{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	return entWebhookToDomainWebhook(webhook), nil
+}
+
+func (r *ChatRepository) TouchWebhook(ctx context.Context, id int, usedAt time.Time) error {
+	err := r.client.Webhook.UpdateOneID(id).
+		SetLastUsedAt(usedAt).
+		Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		r.logger.Ctx(ctx).Error("error updating webhook", zap.Error(err))
+		return errors.NewError(errors.ErrorInternal, err)
+	}
+	return nil
+}
+
+func entWebhookToDomainWebhook(webhook *ent.Webhook) domain.Webhook {
+	return domain.Webhook{
+		ID:         webhook.ID,
+		RoomID:     webhook.RoomID,
+		Name:       webhook.Name,
+		CreatedBy:  webhook.CreatedBy,
+		TokenHash:  webhook.TokenHash,
+		IsRevoked:  webhook.IsRevoked,
+		CreatedAt:  webhook.CreatedAt,
+		LastUsedAt: webhook.LastUsedAt,
+	}
+}
@@ -1,30 +1,60 @@
 package router
 
 import (
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/core/domain"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/handler"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/middleware"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/health"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/metrics"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/tracing"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/swagger"
-	"github.com/gofiber/template/html/v2"
 )
 
-func SetupChatRouter(chatHandler *handler.ChatHandler) *fiber.App {
-	// Initialize the HTML engine for rendering views
-	engine := html.New("./views", ".html")
-
+func SetupChatRouter(chatHandler *handler.ChatHandler, engine fiber.Views, config *configs.Config, logger *logger.Logger, checker *health.Checker) *fiber.App {
 	// Create a new Fiber app with custom config
 	app := fiber.New(fiber.Config{
 		Views: engine,
+		// Bodies above the default limit are streamed rather than refused, so that history
+		// imports can be uploaded as a whole; BodyLimitMiddleware bounds them per route
+		StreamRequestBody:            true,
+		DisablePreParseMultipartForm: true,
 	})
 
+	// Health checks come before the middlewares, so probes are not counted, traced or logged
+	app.Get("/healthz", checker.Liveness)
+	app.Get("/readyz", checker.Readiness)
+
+	// Count and time every request
+	app.Use(metrics.Middleware())
+
+	// Trace every request and pass the span on in the user context
+	app.Use(tracing.Middleware())
+
+	// Accept or assign a request ID and log the request with it
+	app.Use(middleware.RequestIDMiddleware(logger))
+
 	// Configure CORS
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:     "http://localhost:3000,http://localhost:3001,https://localhost:3002", // Comma-separated origins as a single string
 		AllowCredentials: true,                                                                 // Allow cookies and credentials
 		AllowMethods:     "GET,POST,PUT,DELETE",                                                // Specify allowed HTTP methods
-		AllowHeaders:     "Content-Type,Authorization",                                         // Specify allowed headers
+		AllowHeaders:     "Content-Type,Authorization,X-Request-ID",                            // Specify allowed headers
+		ExposeHeaders:    "X-Request-ID",                                                       // Let browsers read the request ID
 	}))
 
+	// History imports are uploaded as a whole, so their route comes before the default body limit
+	app.Post("/ws/import-history", middleware.BodyLimitMiddleware(config.Import.MaxUploadSize), middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.ImportHistory)
+
+	// Refuse bodies above the default limit on every other route
+	app.Use(middleware.BodyLimitMiddleware(fiber.DefaultBodyLimit))
+
+	// Prometheus metrics
+	app.Get("/metrics", metrics.Handler())
+
 	// Swagger documentation
 	app.Get("/swagger/*", swagger.HandlerDefault)
 
@@ -60,10 +90,71 @@ func SetupChatRouter(chatHandler *handler.ChatHandler) *fiber.App {
 	})
 
 	// WebSocket routes
-	app.Post("/ws/create-room", chatHandler.CreateRoom)
-	app.Get("/ws/join-room/:roomId", chatHandler.JoinRoom)
+	app.Post("/ws/create-room", middleware.OptionalAuthMiddleware(domain.ScopeChatWrite), chatHandler.CreateRoom)
+	app.Get("/ws/join-room/:roomId", middleware.StreamAuthMiddleware(domain.ScopeChatRead), chatHandler.JoinRoom)
 	app.Get("/ws/get-rooms", chatHandler.GetRooms)
 	app.Get("/ws/get-clients/:roomId", chatHandler.GetClients)
+	app.Get("/ws/get-history/:roomId", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.GetHistory)
+
+	// HTTP transports for clients that cannot open a WebSocket
+	app.Get("/ws/stream-room/:roomId", middleware.StreamAuthMiddleware(domain.ScopeChatRead), chatHandler.StreamRoom)
+	app.Post("/ws/poll-room/:roomId", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.PollRoom)
+	app.Get("/ws/poll/:sessionId", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.Poll)
+	app.Post("/ws/send-message/:sessionId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.SendMessage)
+	app.Delete("/ws/leave-room/:sessionId", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.LeaveRoom)
+
+	// Retention routes protected by AuthMiddleware
+	app.Put("/ws/update-room-retention/:roomId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.UpdateRoomRetention)
+	app.Post("/ws/purge-messages", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.PurgeMessages)
+	app.Get("/ws/get-purges", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.GetPurges)
+
+	// Export routes protected by AuthMiddleware
+	app.Post("/ws/export-room/:roomId", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.ExportRoom)
+	app.Get("/ws/get-export/:exportId", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.GetExport)
+	app.Get("/ws/download-export/:exportId", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.DownloadExport)
+
+	// Import routes protected by AuthMiddleware
+	app.Post("/ws/resume-import/:importId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.ResumeImport)
+	app.Get("/ws/get-import/:importId", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.GetImport)
+
+	// Bot routes protected by AuthMiddleware
+	app.Post("/ws/bot/subscribe", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.SubscribeBot)
+	app.Get("/ws/bot/get-subscriptions", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.GetBotSubscriptions)
+	app.Delete("/ws/bot/unsubscribe/:subscriptionId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.UnsubscribeBot)
+	app.Post("/ws/bot/send-message/:roomId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.SendBotMessage)
+
+	// Webhook management routes protected by AuthMiddleware
+	app.Post("/ws/create-webhook/:roomId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.CreateWebhook)
+	app.Get("/ws/get-webhooks/:roomId", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.GetWebhooks)
+	app.Delete("/ws/revoke-webhook/:webhookId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.RevokeWebhook)
+
+	// Scheduled message routes protected by AuthMiddleware
+	app.Post("/ws/schedule-message/:roomId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.ScheduleMessage)
+	app.Get("/ws/get-scheduled-messages", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.GetScheduledMessages)
+	app.Put("/ws/update-scheduled-message/:scheduledMessageId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.UpdateScheduledMessage)
+	app.Delete("/ws/cancel-scheduled-message/:scheduledMessageId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.CancelScheduledMessage)
+
+	// Pinned and saved message routes protected by AuthMiddleware
+	app.Post("/ws/pin-message/:messageId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.PinMessage)
+	app.Delete("/ws/unpin-message/:messageId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.UnpinMessage)
+	app.Get("/ws/get-pinned-messages/:roomId", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.GetPinnedMessages)
+	app.Post("/ws/save-message/:messageId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.SaveMessage)
+	app.Delete("/ws/unsave-message/:messageId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.UnsaveMessage)
+	app.Get("/ws/get-saved-messages", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.GetSavedMessages)
+
+	// Poll routes protected by AuthMiddleware
+	app.Post("/ws/create-poll/:roomId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.CreatePoll)
+	app.Get("/ws/get-poll/:pollId", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.GetPoll)
+	app.Post("/ws/vote-poll/:pollId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.VotePoll)
+	app.Post("/ws/close-poll/:pollId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.ClosePoll)
+
+	// Call routes protected by AuthMiddleware
+	app.Get("/ws/get-calls/:roomId", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.GetCalls)
+	app.Get("/ws/get-call/:callId", middleware.AuthMiddleware(domain.ScopeChatRead), chatHandler.GetCall)
+	app.Post("/ws/end-call/:callId", middleware.AuthMiddleware(domain.ScopeChatWrite), chatHandler.EndCall)
+
+	// Incoming webhooks are authenticated by the token in the path
+	app.Post("/ws/webhooks/:token", chatHandler.PostWebhookMessage)
 
 	return app
 }
@@ -0,0 +1,31 @@
+// Package authctx carries the bearer token of a request from the middlewares that
+// read it to the usecases that verify it.
+package authctx
+
+import "context"
+
+type tokenKey struct{}
+
+// WithToken returns a context carrying the bearer token of the request.
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+// Token returns the bearer token of the request, if it has one.
+func Token(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(tokenKey{}).(string)
+	return token, ok
+}
+
+type scopeKey struct{}
+
+// WithScope returns a context carrying the scope the route needs the token to have.
+func WithScope(ctx context.Context, scope string) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// Scope returns the scope the route needs the token to have, if it declares one.
+func Scope(ctx context.Context) (string, bool) {
+	scope, ok := ctx.Value(scopeKey{}).(string)
+	return scope, ok
+}
@@ -0,0 +1,154 @@
+// Package callback sends signed HTTP callbacks to bots.
+//
+// Every request carries the event type, the delivery id, a Unix timestamp and
+// a signature. The signature is "sha256=" followed by the hex encoded
+// HMAC-SHA256 of "<timestamp>.<body>" keyed with the subscription secret, so
+// receivers can verify the sender and reject replayed requests.
+//
+// Callbacks are only sent to public addresses: the address a callback host resolves
+// to is checked when it is dialed, and redirects are not followed, so a callback URL
+// can't reach the services next to the chat service.
+package callback
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	HeaderSignature = "X-Chat-Signature"
+	HeaderTimestamp = "X-Chat-Timestamp"
+	HeaderEvent     = "X-Chat-Event"
+	HeaderDelivery  = "X-Chat-Delivery"
+)
+
+// Request is a callback to send.
+type Request struct {
+	URL        string
+	Secret     string
+	Event      string
+	DeliveryID int
+	Body       []byte
+}
+
+// sharedAddressSpace is the carrier-grade NAT range of RFC 6598, which is not public either.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// Sender posts callbacks with a bounded timeout. Unless allowPrivate is set, it refuses to
+// connect to loopback, private, link-local and other non-public addresses.
+type Sender struct {
+	client       *http.Client
+	allowPrivate bool
+}
+
+func NewSender(timeout time.Duration, allowPrivate bool) *Sender {
+	s := &Sender{allowPrivate: allowPrivate}
+
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !s.allowed(addrPort.Addr()) {
+				return fmt.Errorf("callback address %s is not public", addrPort.Addr())
+			}
+			return nil
+		},
+	}
+	s.client = &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		// a redirect could lead anywhere; it is answered like any other non-2xx response
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return s
+}
+
+// ValidateURL checks that callbackURL is an absolute http or https URL whose host, when it
+// is an IP address, is one callbacks may be sent to. Host names are checked when dialed.
+func (s *Sender) ValidateURL(callbackURL string) error {
+	u, err := url.Parse(callbackURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return fmt.Errorf("callback url must be an absolute http or https url")
+	}
+	if s.allowPrivate {
+		return nil
+	}
+	if u.Hostname() == "localhost" {
+		return fmt.Errorf("callback url must not point to localhost")
+	}
+	if addr, err := netip.ParseAddr(u.Hostname()); err == nil && !s.allowed(addr) {
+		return fmt.Errorf("callback url must point to a public address")
+	}
+	return nil
+}
+
+// allowed reports whether callbacks may be sent to addr.
+func (s *Sender) allowed(addr netip.Addr) bool {
+	if s.allowPrivate {
+		return true
+	}
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() &&
+		!addr.IsPrivate() &&
+		!addr.IsLoopback() &&
+		!addr.IsLinkLocalUnicast() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// Sign returns the signature of body sent at timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send posts the callback. Any response other than 2xx is an error.
+func (s *Sender) Send(ctx context.Context, req Request) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return err
+	}
+
+	timestamp := time.Now().Unix()
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set(HeaderEvent, req.Event)
+	httpReq.Header.Set(HeaderDelivery, strconv.Itoa(req.DeliveryID))
+	httpReq.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	httpReq.Header.Set(HeaderSignature, Sign(req.Secret, timestamp, req.Body))
+
+	res, err := s.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("callback responded with status %d", res.StatusCode)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package configs
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
 	"github.com/spf13/viper"
@@ -16,10 +17,23 @@ var Module = fx.Options(
 // Config holds the application wide configurations.
 // The values are read by viper from the config file or environment variables.
 type Config struct {
-	Server ServerConfig `mapstructure:"server"`
-	GRPC   GRPCConfig   `mapstructure:"grpc"`
-	PSQL   PSQLConfig   `mapstructure:"postgres"`
-	Redis  Redis        `mapstructure:"redis"`
+	Server     ServerConfig     `mapstructure:"server"`
+	GRPC       GRPCConfig       `mapstructure:"grpc"`
+	PSQL       PSQLConfig       `mapstructure:"postgres"`
+	Redis      Redis            `mapstructure:"redis"`
+	Retention  RetentionConfig  `mapstructure:"retention"`
+	Export     ExportConfig     `mapstructure:"export"`
+	Import     ImportConfig     `mapstructure:"import"`
+	Bots       BotsConfig       `mapstructure:"bots"`
+	Webhooks   WebhooksConfig   `mapstructure:"webhooks"`
+	Transports TransportsConfig `mapstructure:"transports"`
+	WebSocket  WebSocketConfig  `mapstructure:"websocket"`
+	Scheduler  SchedulerConfig  `mapstructure:"scheduler"`
+	Polls      PollsConfig      `mapstructure:"polls"`
+	Tracing    TracingConfig    `mapstructure:"tracing"`
+	Log        LogConfig        `mapstructure:"log"`
+	Health     HealthConfig     `mapstructure:"health"`
+	Auth       AuthConfig       `mapstructure:"auth"`
 }
 
 type ServerConfig struct {
@@ -31,6 +45,7 @@ type GRPCConfig struct {
 	UserPort int    `mapstructure:"user_port"`
 	AuthHost string `mapstructure:"auth_host"`
 	AuthPort int    `mapstructure:"auth_port"`
+	ChatPort int    `mapstructure:"chat_port"`
 }
 
 // PSQLConfig holds PostgreSQL connection configuration.
@@ -49,6 +64,129 @@ type Redis struct {
 	DB       int    `mapstructure:"db"`
 }
 
+// RetentionConfig holds the global message retention defaults and the purge job settings.
+// A zero MaxAge or MaxMessages keeps messages forever unless a room overrides it.
+type RetentionConfig struct {
+	Enabled     bool          `mapstructure:"enabled"`
+	MaxAge      time.Duration `mapstructure:"max_age"`
+	MaxMessages int           `mapstructure:"max_messages"`
+	Interval    time.Duration `mapstructure:"interval"`
+	BatchSize   int           `mapstructure:"batch_size"`
+	Mode        string        `mapstructure:"mode"`
+	DryRun      bool          `mapstructure:"dry_run"`
+}
+
+// ExportConfig holds the transcript export settings.
+// Exports with more than SyncLimit messages are stored in the database asynchronously.
+// A worker running an export holds it for Lease, which it renews with every chunk.
+type ExportConfig struct {
+	SyncLimit    int           `mapstructure:"sync_limit"`
+	BatchSize    int           `mapstructure:"batch_size"`
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	Lease        time.Duration `mapstructure:"lease"`
+}
+
+// ImportConfig holds the chat history import settings.
+// Uploaded export files are kept in Dir so that interrupted imports can be resumed.
+// MaxUploadSize bounds the request body size of the import route, in bytes.
+type ImportConfig struct {
+	Dir           string `mapstructure:"dir"`
+	BatchSize     int    `mapstructure:"batch_size"`
+	MaxUploadSize int    `mapstructure:"max_upload_size"`
+}
+
+// BotsConfig holds the settings of the bot event delivery.
+// Failed deliveries are retried with exponential backoff, from InitialBackoff
+// up to MaxBackoff, until MaxAttempts is reached. Callbacks only go to public
+// addresses unless AllowPrivateCallbacks is set, for bots on the same network.
+type BotsConfig struct {
+	PollInterval   time.Duration `mapstructure:"poll_interval"`
+	BatchSize      int           `mapstructure:"batch_size"`
+	Timeout        time.Duration `mapstructure:"timeout"`
+	MaxAttempts    int           `mapstructure:"max_attempts"`
+	InitialBackoff time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff     time.Duration `mapstructure:"max_backoff"`
+
+	AllowPrivateCallbacks bool `mapstructure:"allow_private_callbacks"`
+}
+
+// WebhooksConfig holds the limits of the incoming webhooks.
+// Each webhook token may post RateLimit messages per RateWindow.
+type WebhooksConfig struct {
+	RateLimit      int           `mapstructure:"rate_limit"`
+	RateWindow     time.Duration `mapstructure:"rate_window"`
+	MaxTextLength  int           `mapstructure:"max_text_length"`
+	MaxAttachments int           `mapstructure:"max_attachments"`
+}
+
+// TransportsConfig holds the settings of the Server-Sent Events and long-polling transports.
+// Sessions that are neither streaming nor polled for SessionTTL are closed, and each
+// session buffers at most BufferSize undelivered events.
+type TransportsConfig struct {
+	Heartbeat   time.Duration `mapstructure:"heartbeat"`
+	PollTimeout time.Duration `mapstructure:"poll_timeout"`
+	SessionTTL  time.Duration `mapstructure:"session_ttl"`
+	BufferSize  int           `mapstructure:"buffer_size"`
+}
+
+// WebSocketConfig holds the settings of the chat WebSocket endpoint. When Compression is
+// enabled, permessage-deflate is offered to clients and frames are written at
+// CompressionLevel (1 = best speed, 9 = best compression).
+type WebSocketConfig struct {
+	Compression      bool `mapstructure:"compression"`
+	CompressionLevel int  `mapstructure:"compression_level"`
+}
+
+// SchedulerConfig holds the settings of the scheduled messages.
+// Due messages are looked up every PollInterval, at most BatchSize at a time,
+// and messages cannot be scheduled more than MaxDelay ahead.
+type SchedulerConfig struct {
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	BatchSize    int           `mapstructure:"batch_size"`
+	MaxDelay     time.Duration `mapstructure:"max_delay"`
+}
+
+// PollsConfig holds the settings of the polls. Polls with a closing time are closed
+// by a job that looks for due polls every CloseInterval, at most BatchSize at a time.
+type PollsConfig struct {
+	MaxOptions    int           `mapstructure:"max_options"`
+	CloseInterval time.Duration `mapstructure:"close_interval"`
+	BatchSize     int           `mapstructure:"batch_size"`
+}
+
+// TracingConfig selects where spans are exported: none, stdout or an OTLP gRPC collector.
+type TracingConfig struct {
+	Exporter    string  `mapstructure:"exporter"`
+	Endpoint    string  `mapstructure:"endpoint"`
+	Insecure    bool    `mapstructure:"insecure"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+	ServiceName string  `mapstructure:"service_name"`
+}
+
+// LogConfig holds the minimum level of the JSON logs: debug, info, warn or error.
+type LogConfig struct {
+	Level string `mapstructure:"level"`
+}
+
+// HealthConfig sets how often the dependencies are checked to update the gRPC health
+// status and how long a single dependency check may take.
+type HealthConfig struct {
+	Interval time.Duration `mapstructure:"interval"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+}
+
+// AuthConfig sets how access tokens are verified: "local" checks their signature with the
+// keys published by the auth service at JWKSURL, cached for JWKSCacheTTL, and "remote"
+// calls VerifyToken on the auth service for every token. Locally verified tokens have
+// their session checked with the auth service, which is remembered for SessionCacheTTL.
+// Open streams verify their token again every SessionCacheTTL.
+type AuthConfig struct {
+	Verification    string        `mapstructure:"verification"`
+	JWKSURL         string        `mapstructure:"jwks_url"`
+	JWKSCacheTTL    time.Duration `mapstructure:"jwks_cache_ttl"`
+	SessionCacheTTL time.Duration `mapstructure:"session_cache_ttl"`
+}
+
 // NewConfig creates a new Config instance.
 func NewConfig() *Config {
 	return &Config{}
@@ -97,6 +235,59 @@ func LoadConfig(path string, logger *logger.Logger) (*Config, error) {
 		return nil, err
 	}
 
+	if err := validateRetentionConfig(config.Retention); err != nil {
+		return nil, err
+	}
+
+	if err := validateExportConfig(config.Export); err != nil {
+		return nil, err
+	}
+
+	if err := validateImportConfig(config.Import); err != nil {
+		return nil, err
+	}
+
+	if err := validateBotsConfig(config.Bots); err != nil {
+		return nil, err
+	}
+
+	if err := validateWebhooksConfig(config.Webhooks); err != nil {
+		return nil, err
+	}
+
+	if err := validateTransportsConfig(config.Transports); err != nil {
+		return nil, err
+	}
+
+	if err := validateWebSocketConfig(config.WebSocket); err != nil {
+		return nil, err
+	}
+
+	if err := validateSchedulerConfig(config.Scheduler); err != nil {
+		return nil, err
+	}
+
+	if err := validatePollsConfig(config.Polls); err != nil {
+		return nil, err
+	}
+
+	if err := validateTracingConfig(config.Tracing); err != nil {
+		return nil, err
+	}
+
+	// Apply the log level, which also validates it
+	if err := logger.SetLevel(config.Log.Level); err != nil {
+		return nil, fmt.Errorf("invalid log level: %w", err)
+	}
+
+	if err := validateHealthConfig(config.Health); err != nil {
+		return nil, err
+	}
+
+	if err := validateAuthConfig(config.Auth); err != nil {
+		return nil, err
+	}
+
 	return &config, nil
 }
 
@@ -108,6 +299,7 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("grpc.user_port", "8080")
 	v.SetDefault("grpc.auth_host", "localhost")
 	v.SetDefault("grpc.auth_port", "8081")
+	v.SetDefault("grpc.chat_port", "8082")
 
 	v.SetDefault("postgres.host", "localhost")
 	v.SetDefault("postgres.port", "5434")
@@ -119,6 +311,68 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("redis.addr", "localhost:6379")
 	v.SetDefault("redis.password", "")
 	v.SetDefault("redis.db", 0)
+
+	v.SetDefault("retention.enabled", true)
+	v.SetDefault("retention.max_age", "0s")
+	v.SetDefault("retention.max_messages", 0)
+	v.SetDefault("retention.interval", "1h")
+	v.SetDefault("retention.batch_size", 500)
+	v.SetDefault("retention.mode", "delete")
+	v.SetDefault("retention.dry_run", false)
+
+	v.SetDefault("export.sync_limit", 5000)
+	v.SetDefault("export.batch_size", 500)
+	v.SetDefault("export.poll_interval", "5s")
+	v.SetDefault("export.lease", "2m")
+
+	v.SetDefault("import.dir", "imports")
+	v.SetDefault("import.batch_size", 500)
+	v.SetDefault("import.max_upload_size", 100<<20)
+
+	v.SetDefault("bots.poll_interval", "2s")
+	v.SetDefault("bots.batch_size", 50)
+	v.SetDefault("bots.timeout", "10s")
+	v.SetDefault("bots.max_attempts", 8)
+	v.SetDefault("bots.initial_backoff", "5s")
+	v.SetDefault("bots.max_backoff", "1h")
+	v.SetDefault("bots.allow_private_callbacks", false)
+
+	v.SetDefault("webhooks.rate_limit", 30)
+	v.SetDefault("webhooks.rate_window", "1m")
+	v.SetDefault("webhooks.max_text_length", 4000)
+	v.SetDefault("webhooks.max_attachments", 10)
+
+	v.SetDefault("transports.heartbeat", "15s")
+	v.SetDefault("transports.poll_timeout", "25s")
+	v.SetDefault("transports.session_ttl", "1m")
+	v.SetDefault("transports.buffer_size", 100)
+
+	v.SetDefault("websocket.compression", false)
+	v.SetDefault("websocket.compression_level", 1)
+
+	v.SetDefault("scheduler.poll_interval", "5s")
+	v.SetDefault("scheduler.batch_size", 100)
+	v.SetDefault("scheduler.max_delay", "8760h")
+
+	v.SetDefault("polls.max_options", 10)
+	v.SetDefault("polls.close_interval", "10s")
+	v.SetDefault("polls.batch_size", 50)
+
+	v.SetDefault("tracing.exporter", "none")
+	v.SetDefault("tracing.endpoint", "localhost:4317")
+	v.SetDefault("tracing.insecure", true)
+	v.SetDefault("tracing.sample_ratio", 1)
+	v.SetDefault("tracing.service_name", "chat-service")
+
+	v.SetDefault("log.level", "info")
+
+	v.SetDefault("health.interval", "10s")
+	v.SetDefault("health.timeout", "2s")
+
+	v.SetDefault("auth.verification", "local")
+	v.SetDefault("auth.jwks_url", "http://localhost:3001/.well-known/jwks.json")
+	v.SetDefault("auth.jwks_cache_ttl", "5m")
+	v.SetDefault("auth.session_cache_ttl", "5s")
 }
 
 // validateServerConfig ensures that essential server config values are present.
@@ -143,6 +397,9 @@ func validateGRPCConfig(grpcConfig GRPCConfig) error {
 	if grpcConfig.AuthPort == 0 {
 		return fmt.Errorf("auth grpc port is required")
 	}
+	if grpcConfig.ChatPort == 0 {
+		return fmt.Errorf("chat grpc port is required")
+	}
 	return nil
 }
 
@@ -174,7 +431,194 @@ func validateRedisConfig(redisConfig Redis) error {
 	return nil
 }
 
+// validateRetentionConfig ensures that the retention config values are usable.
+func validateRetentionConfig(retentionConfig RetentionConfig) error {
+	if retentionConfig.MaxAge < 0 {
+		return fmt.Errorf("retention max age must not be negative")
+	}
+	if retentionConfig.MaxMessages < 0 {
+		return fmt.Errorf("retention max messages must not be negative")
+	}
+	if retentionConfig.Enabled && retentionConfig.Interval <= 0 {
+		return fmt.Errorf("retention interval is required")
+	}
+	if retentionConfig.BatchSize <= 0 {
+		return fmt.Errorf("retention batch size is required")
+	}
+	if retentionConfig.Mode != "delete" && retentionConfig.Mode != "archive" {
+		return fmt.Errorf("retention mode must be either delete or archive")
+	}
+	return nil
+}
+
+// validateExportConfig ensures that essential export config values are present.
+func validateExportConfig(exportConfig ExportConfig) error {
+	if exportConfig.SyncLimit < 0 {
+		return fmt.Errorf("export sync limit must not be negative")
+	}
+	if exportConfig.BatchSize <= 0 {
+		return fmt.Errorf("export batch size is required")
+	}
+	if exportConfig.PollInterval <= 0 {
+		return fmt.Errorf("export poll interval is required")
+	}
+	if exportConfig.Lease <= 0 {
+		return fmt.Errorf("export lease is required")
+	}
+	return nil
+}
+
 // ProvideConfig is an fx provider that loads the configuration.
 func ProvideConfig(logger *logger.Logger) (*Config, error) {
 	return LoadConfig(".", logger)
 }
+
+// validateImportConfig ensures that essential import config values are present.
+func validateImportConfig(importConfig ImportConfig) error {
+	if importConfig.Dir == "" {
+		return fmt.Errorf("import directory is required")
+	}
+	if importConfig.BatchSize <= 0 {
+		return fmt.Errorf("import batch size is required")
+	}
+	if importConfig.MaxUploadSize <= 0 {
+		return fmt.Errorf("import max upload size is required")
+	}
+	return nil
+}
+
+// validateBotsConfig ensures that essential bot delivery config values are present.
+func validateBotsConfig(botsConfig BotsConfig) error {
+	if botsConfig.PollInterval <= 0 {
+		return fmt.Errorf("bots poll interval is required")
+	}
+	if botsConfig.BatchSize <= 0 {
+		return fmt.Errorf("bots batch size is required")
+	}
+	if botsConfig.Timeout <= 0 {
+		return fmt.Errorf("bots timeout is required")
+	}
+	if botsConfig.MaxAttempts <= 0 {
+		return fmt.Errorf("bots max attempts is required")
+	}
+	if botsConfig.InitialBackoff <= 0 || botsConfig.MaxBackoff < botsConfig.InitialBackoff {
+		return fmt.Errorf("bots backoff must be positive and max backoff must not be below the initial backoff")
+	}
+	return nil
+}
+
+// validateWebhooksConfig ensures that the webhook limits are usable.
+func validateWebhooksConfig(webhooksConfig WebhooksConfig) error {
+	if webhooksConfig.RateLimit <= 0 || webhooksConfig.RateWindow <= 0 {
+		return fmt.Errorf("webhooks rate limit and rate window are required")
+	}
+	if webhooksConfig.MaxTextLength <= 0 {
+		return fmt.Errorf("webhooks max text length is required")
+	}
+	if webhooksConfig.MaxAttachments < 0 {
+		return fmt.Errorf("webhooks max attachments must not be negative")
+	}
+	return nil
+}
+
+// validateTransportsConfig ensures that the HTTP transport settings are usable.
+func validateTransportsConfig(transportsConfig TransportsConfig) error {
+	if transportsConfig.Heartbeat <= 0 || transportsConfig.PollTimeout <= 0 {
+		return fmt.Errorf("transports heartbeat and poll timeout are required")
+	}
+	if transportsConfig.SessionTTL <= transportsConfig.Heartbeat || transportsConfig.SessionTTL <= transportsConfig.PollTimeout {
+		return fmt.Errorf("transports session ttl must be longer than the heartbeat and the poll timeout")
+	}
+	if transportsConfig.BufferSize <= 0 {
+		return fmt.Errorf("transports buffer size is required")
+	}
+	return nil
+}
+
+// validateWebSocketConfig ensures that the compression level is one deflate accepts.
+func validateWebSocketConfig(webSocketConfig WebSocketConfig) error {
+	if webSocketConfig.CompressionLevel < 1 || webSocketConfig.CompressionLevel > 9 {
+		return fmt.Errorf("websocket compression level must be between 1 and 9")
+	}
+	return nil
+}
+
+// validateSchedulerConfig ensures that essential scheduler config values are present.
+func validateSchedulerConfig(schedulerConfig SchedulerConfig) error {
+	if schedulerConfig.PollInterval <= 0 {
+		return fmt.Errorf("scheduler poll interval is required")
+	}
+	if schedulerConfig.BatchSize <= 0 {
+		return fmt.Errorf("scheduler batch size is required")
+	}
+	if schedulerConfig.MaxDelay <= 0 {
+		return fmt.Errorf("scheduler max delay is required")
+	}
+	return nil
+}
+
+// validatePollsConfig ensures that essential poll config values are present.
+func validatePollsConfig(pollsConfig PollsConfig) error {
+	if pollsConfig.MaxOptions < 2 {
+		return fmt.Errorf("polls max options must be at least 2")
+	}
+	if pollsConfig.CloseInterval <= 0 {
+		return fmt.Errorf("polls close interval is required")
+	}
+	if pollsConfig.BatchSize <= 0 {
+		return fmt.Errorf("polls batch size is required")
+	}
+	return nil
+}
+
+// validateTracingConfig ensures that the exporter is known and the sample ratio is a fraction.
+func validateTracingConfig(tracingConfig TracingConfig) error {
+	switch tracingConfig.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if tracingConfig.Endpoint == "" {
+			return fmt.Errorf("tracing endpoint is required for the otlp exporter")
+		}
+	default:
+		return fmt.Errorf("tracing exporter must be none, stdout or otlp")
+	}
+	if tracingConfig.SampleRatio < 0 || tracingConfig.SampleRatio > 1 {
+		return fmt.Errorf("tracing sample ratio must be between 0 and 1")
+	}
+	if tracingConfig.ServiceName == "" {
+		return fmt.Errorf("tracing service name is required")
+	}
+	return nil
+}
+
+// validateHealthConfig ensures that the checks are repeated and time out.
+func validateHealthConfig(healthConfig HealthConfig) error {
+	if healthConfig.Interval <= 0 {
+		return fmt.Errorf("health check interval is required")
+	}
+	if healthConfig.Timeout <= 0 {
+		return fmt.Errorf("health check timeout is required")
+	}
+	return nil
+}
+
+// validateAuthConfig ensures that local verification knows where to fetch the keys.
+func validateAuthConfig(authConfig AuthConfig) error {
+	switch authConfig.Verification {
+	case "remote":
+		return nil
+	case "local":
+	default:
+		return fmt.Errorf("auth verification must be local or remote")
+	}
+	if authConfig.JWKSURL == "" {
+		return fmt.Errorf("auth jwks url is required")
+	}
+	if authConfig.JWKSCacheTTL <= 0 {
+		return fmt.Errorf("auth jwks cache ttl is required")
+	}
+	if authConfig.SessionCacheTTL <= 0 {
+		return fmt.Errorf("auth session cache ttl is required")
+	}
+	return nil
+}
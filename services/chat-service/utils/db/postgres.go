@@ -12,6 +12,8 @@ import (
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/configs"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/metrics"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/tracing"
 	_ "github.com/lib/pq"
 	"go.uber.org/fx"
 )
@@ -46,7 +48,7 @@ func NewEntClient(lc fx.Lifecycle, config *configs.Config, logger *logger.Logger
 	if err != nil {
 		return nil, err
 	}
-	client := ent.NewClient(ent.Driver(driver))
+	client := ent.NewClient(ent.Driver(tracing.InstrumentDriver(metrics.InstrumentDriver(driver, cfg.DBName))))
 
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
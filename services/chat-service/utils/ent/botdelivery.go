@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botdelivery"
+)
+
+// BotDelivery is the model entity for the BotDelivery schema.
+type BotDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SubscriptionID holds the value of the "subscription_id" field.
+	SubscriptionID int `json:"subscription_id,omitempty"`
+	// Event holds the value of the "event" field.
+	Event string `json:"event,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload string `json:"payload,omitempty"`
+	// Status holds the value of the "status" field.
+	Status botdelivery.Status `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// DeliveredAt holds the value of the "delivered_at" field.
+	DeliveredAt  *time.Time `json:"delivered_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BotDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case botdelivery.FieldID, botdelivery.FieldSubscriptionID, botdelivery.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case botdelivery.FieldEvent, botdelivery.FieldPayload, botdelivery.FieldStatus, botdelivery.FieldLastError:
+			values[i] = new(sql.NullString)
+		case botdelivery.FieldNextAttemptAt, botdelivery.FieldCreatedAt, botdelivery.FieldDeliveredAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BotDelivery fields.
+func (bd *BotDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case botdelivery.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bd.ID = int(value.Int64)
+		case botdelivery.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				bd.SubscriptionID = int(value.Int64)
+			}
+		case botdelivery.FieldEvent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event", values[i])
+			} else if value.Valid {
+				bd.Event = value.String
+			}
+		case botdelivery.FieldPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value.Valid {
+				bd.Payload = value.String
+			}
+		case botdelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				bd.Status = botdelivery.Status(value.String)
+			}
+		case botdelivery.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				bd.Attempts = int(value.Int64)
+			}
+		case botdelivery.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				bd.NextAttemptAt = value.Time
+			}
+		case botdelivery.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				bd.LastError = value.String
+			}
+		case botdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				bd.CreatedAt = value.Time
+			}
+		case botdelivery.FieldDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_at", values[i])
+			} else if value.Valid {
+				bd.DeliveredAt = new(time.Time)
+				*bd.DeliveredAt = value.Time
+			}
+		default:
+			bd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BotDelivery.
+// This includes values selected through modifiers, order, etc.
+func (bd *BotDelivery) Value(name string) (ent.Value, error) {
+	return bd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BotDelivery.
+// Note that you need to call BotDelivery.Unwrap() before calling this method if this BotDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bd *BotDelivery) Update() *BotDeliveryUpdateOne {
+	return NewBotDeliveryClient(bd.config).UpdateOne(bd)
+}
+
+// Unwrap unwraps the BotDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bd *BotDelivery) Unwrap() *BotDelivery {
+	_tx, ok := bd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BotDelivery is not a transactional entity")
+	}
+	bd.config.driver = _tx.drv
+	return bd
+}
+
+// String implements the fmt.Stringer.
+func (bd *BotDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("BotDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bd.ID))
+	builder.WriteString("subscription_id=")
+	builder.WriteString(fmt.Sprintf("%v", bd.SubscriptionID))
+	builder.WriteString(", ")
+	builder.WriteString("event=")
+	builder.WriteString(bd.Event)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(bd.Payload)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", bd.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", bd.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(bd.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(bd.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(bd.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := bd.DeliveredAt; v != nil {
+		builder.WriteString("delivered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BotDeliveries is a parsable slice of BotDelivery.
+type BotDeliveries []*BotDelivery
@@ -0,0 +1,362 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botdelivery"
+)
+
+// BotDeliveryCreate is the builder for creating a BotDelivery entity.
+type BotDeliveryCreate struct {
+	config
+	mutation *BotDeliveryMutation
+	hooks    []Hook
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (bdc *BotDeliveryCreate) SetSubscriptionID(i int) *BotDeliveryCreate {
+	bdc.mutation.SetSubscriptionID(i)
+	return bdc
+}
+
+// SetEvent sets the "event" field.
+func (bdc *BotDeliveryCreate) SetEvent(s string) *BotDeliveryCreate {
+	bdc.mutation.SetEvent(s)
+	return bdc
+}
+
+// SetPayload sets the "payload" field.
+func (bdc *BotDeliveryCreate) SetPayload(s string) *BotDeliveryCreate {
+	bdc.mutation.SetPayload(s)
+	return bdc
+}
+
+// SetStatus sets the "status" field.
+func (bdc *BotDeliveryCreate) SetStatus(b botdelivery.Status) *BotDeliveryCreate {
+	bdc.mutation.SetStatus(b)
+	return bdc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bdc *BotDeliveryCreate) SetNillableStatus(b *botdelivery.Status) *BotDeliveryCreate {
+	if b != nil {
+		bdc.SetStatus(*b)
+	}
+	return bdc
+}
+
+// SetAttempts sets the "attempts" field.
+func (bdc *BotDeliveryCreate) SetAttempts(i int) *BotDeliveryCreate {
+	bdc.mutation.SetAttempts(i)
+	return bdc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (bdc *BotDeliveryCreate) SetNillableAttempts(i *int) *BotDeliveryCreate {
+	if i != nil {
+		bdc.SetAttempts(*i)
+	}
+	return bdc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (bdc *BotDeliveryCreate) SetNextAttemptAt(t time.Time) *BotDeliveryCreate {
+	bdc.mutation.SetNextAttemptAt(t)
+	return bdc
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (bdc *BotDeliveryCreate) SetNillableNextAttemptAt(t *time.Time) *BotDeliveryCreate {
+	if t != nil {
+		bdc.SetNextAttemptAt(*t)
+	}
+	return bdc
+}
+
+// SetLastError sets the "last_error" field.
+func (bdc *BotDeliveryCreate) SetLastError(s string) *BotDeliveryCreate {
+	bdc.mutation.SetLastError(s)
+	return bdc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (bdc *BotDeliveryCreate) SetNillableLastError(s *string) *BotDeliveryCreate {
+	if s != nil {
+		bdc.SetLastError(*s)
+	}
+	return bdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bdc *BotDeliveryCreate) SetCreatedAt(t time.Time) *BotDeliveryCreate {
+	bdc.mutation.SetCreatedAt(t)
+	return bdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bdc *BotDeliveryCreate) SetNillableCreatedAt(t *time.Time) *BotDeliveryCreate {
+	if t != nil {
+		bdc.SetCreatedAt(*t)
+	}
+	return bdc
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (bdc *BotDeliveryCreate) SetDeliveredAt(t time.Time) *BotDeliveryCreate {
+	bdc.mutation.SetDeliveredAt(t)
+	return bdc
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (bdc *BotDeliveryCreate) SetNillableDeliveredAt(t *time.Time) *BotDeliveryCreate {
+	if t != nil {
+		bdc.SetDeliveredAt(*t)
+	}
+	return bdc
+}
+
+// Mutation returns the BotDeliveryMutation object of the builder.
+func (bdc *BotDeliveryCreate) Mutation() *BotDeliveryMutation {
+	return bdc.mutation
+}
+
+// Save creates the BotDelivery in the database.
+func (bdc *BotDeliveryCreate) Save(ctx context.Context) (*BotDelivery, error) {
+	bdc.defaults()
+	return withHooks(ctx, bdc.sqlSave, bdc.mutation, bdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bdc *BotDeliveryCreate) SaveX(ctx context.Context) *BotDelivery {
+	v, err := bdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bdc *BotDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := bdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bdc *BotDeliveryCreate) ExecX(ctx context.Context) {
+	if err := bdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bdc *BotDeliveryCreate) defaults() {
+	if _, ok := bdc.mutation.Status(); !ok {
+		v := botdelivery.DefaultStatus
+		bdc.mutation.SetStatus(v)
+	}
+	if _, ok := bdc.mutation.Attempts(); !ok {
+		v := botdelivery.DefaultAttempts
+		bdc.mutation.SetAttempts(v)
+	}
+	if _, ok := bdc.mutation.NextAttemptAt(); !ok {
+		v := botdelivery.DefaultNextAttemptAt()
+		bdc.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := bdc.mutation.CreatedAt(); !ok {
+		v := botdelivery.DefaultCreatedAt()
+		bdc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bdc *BotDeliveryCreate) check() error {
+	if _, ok := bdc.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription_id", err: errors.New(`ent: missing required field "BotDelivery.subscription_id"`)}
+	}
+	if _, ok := bdc.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "BotDelivery.event"`)}
+	}
+	if v, ok := bdc.mutation.Event(); ok {
+		if err := botdelivery.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "BotDelivery.event": %w`, err)}
+		}
+	}
+	if _, ok := bdc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "BotDelivery.payload"`)}
+	}
+	if _, ok := bdc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BotDelivery.status"`)}
+	}
+	if v, ok := bdc.mutation.Status(); ok {
+		if err := botdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BotDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := bdc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "BotDelivery.attempts"`)}
+	}
+	if _, ok := bdc.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "BotDelivery.next_attempt_at"`)}
+	}
+	if _, ok := bdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BotDelivery.created_at"`)}
+	}
+	return nil
+}
+
+func (bdc *BotDeliveryCreate) sqlSave(ctx context.Context) (*BotDelivery, error) {
+	if err := bdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	bdc.mutation.id = &_node.ID
+	bdc.mutation.done = true
+	return _node, nil
+}
+
+func (bdc *BotDeliveryCreate) createSpec() (*BotDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BotDelivery{config: bdc.config}
+		_spec = sqlgraph.NewCreateSpec(botdelivery.Table, sqlgraph.NewFieldSpec(botdelivery.FieldID, field.TypeInt))
+	)
+	if value, ok := bdc.mutation.SubscriptionID(); ok {
+		_spec.SetField(botdelivery.FieldSubscriptionID, field.TypeInt, value)
+		_node.SubscriptionID = value
+	}
+	if value, ok := bdc.mutation.Event(); ok {
+		_spec.SetField(botdelivery.FieldEvent, field.TypeString, value)
+		_node.Event = value
+	}
+	if value, ok := bdc.mutation.Payload(); ok {
+		_spec.SetField(botdelivery.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := bdc.mutation.Status(); ok {
+		_spec.SetField(botdelivery.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := bdc.mutation.Attempts(); ok {
+		_spec.SetField(botdelivery.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := bdc.mutation.NextAttemptAt(); ok {
+		_spec.SetField(botdelivery.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := bdc.mutation.LastError(); ok {
+		_spec.SetField(botdelivery.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := bdc.mutation.CreatedAt(); ok {
+		_spec.SetField(botdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := bdc.mutation.DeliveredAt(); ok {
+		_spec.SetField(botdelivery.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = &value
+	}
+	return _node, _spec
+}
+
+// BotDeliveryCreateBulk is the builder for creating many BotDelivery entities in bulk.
+type BotDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*BotDeliveryCreate
+}
+
+// Save creates the BotDelivery entities in the database.
+func (bdcb *BotDeliveryCreateBulk) Save(ctx context.Context) ([]*BotDelivery, error) {
+	if bdcb.err != nil {
+		return nil, bdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bdcb.builders))
+	nodes := make([]*BotDelivery, len(bdcb.builders))
+	mutators := make([]Mutator, len(bdcb.builders))
+	for i := range bdcb.builders {
+		func(i int, root context.Context) {
+			builder := bdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BotDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bdcb *BotDeliveryCreateBulk) SaveX(ctx context.Context) []*BotDelivery {
+	v, err := bdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bdcb *BotDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := bdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bdcb *BotDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := bdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
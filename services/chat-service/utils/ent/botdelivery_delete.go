@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botdelivery"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// BotDeliveryDelete is the builder for deleting a BotDelivery entity.
+type BotDeliveryDelete struct {
+	config
+	hooks    []Hook
+	mutation *BotDeliveryMutation
+}
+
+// Where appends a list predicates to the BotDeliveryDelete builder.
+func (bdd *BotDeliveryDelete) Where(ps ...predicate.BotDelivery) *BotDeliveryDelete {
+	bdd.mutation.Where(ps...)
+	return bdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bdd *BotDeliveryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bdd.sqlExec, bdd.mutation, bdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bdd *BotDeliveryDelete) ExecX(ctx context.Context) int {
+	n, err := bdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bdd *BotDeliveryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(botdelivery.Table, sqlgraph.NewFieldSpec(botdelivery.FieldID, field.TypeInt))
+	if ps := bdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bdd.mutation.done = true
+	return affected, err
+}
+
+// BotDeliveryDeleteOne is the builder for deleting a single BotDelivery entity.
+type BotDeliveryDeleteOne struct {
+	bdd *BotDeliveryDelete
+}
+
+// Where appends a list predicates to the BotDeliveryDelete builder.
+func (bddo *BotDeliveryDeleteOne) Where(ps ...predicate.BotDelivery) *BotDeliveryDeleteOne {
+	bddo.bdd.mutation.Where(ps...)
+	return bddo
+}
+
+// Exec executes the deletion query.
+func (bddo *BotDeliveryDeleteOne) Exec(ctx context.Context) error {
+	n, err := bddo.bdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{botdelivery.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bddo *BotDeliveryDeleteOne) ExecX(ctx context.Context) {
+	if err := bddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
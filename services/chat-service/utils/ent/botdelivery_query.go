@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botdelivery"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// BotDeliveryQuery is the builder for querying BotDelivery entities.
+type BotDeliveryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []botdelivery.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BotDelivery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BotDeliveryQuery builder.
+func (bdq *BotDeliveryQuery) Where(ps ...predicate.BotDelivery) *BotDeliveryQuery {
+	bdq.predicates = append(bdq.predicates, ps...)
+	return bdq
+}
+
+// Limit the number of records to be returned by this query.
+func (bdq *BotDeliveryQuery) Limit(limit int) *BotDeliveryQuery {
+	bdq.ctx.Limit = &limit
+	return bdq
+}
+
+// Offset to start from.
+func (bdq *BotDeliveryQuery) Offset(offset int) *BotDeliveryQuery {
+	bdq.ctx.Offset = &offset
+	return bdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bdq *BotDeliveryQuery) Unique(unique bool) *BotDeliveryQuery {
+	bdq.ctx.Unique = &unique
+	return bdq
+}
+
+// Order specifies how the records should be ordered.
+func (bdq *BotDeliveryQuery) Order(o ...botdelivery.OrderOption) *BotDeliveryQuery {
+	bdq.order = append(bdq.order, o...)
+	return bdq
+}
+
+// First returns the first BotDelivery entity from the query.
+// Returns a *NotFoundError when no BotDelivery was found.
+func (bdq *BotDeliveryQuery) First(ctx context.Context) (*BotDelivery, error) {
+	nodes, err := bdq.Limit(1).All(setContextOp(ctx, bdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{botdelivery.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bdq *BotDeliveryQuery) FirstX(ctx context.Context) *BotDelivery {
+	node, err := bdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BotDelivery ID from the query.
+// Returns a *NotFoundError when no BotDelivery ID was found.
+func (bdq *BotDeliveryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = bdq.Limit(1).IDs(setContextOp(ctx, bdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{botdelivery.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bdq *BotDeliveryQuery) FirstIDX(ctx context.Context) int {
+	id, err := bdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BotDelivery entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BotDelivery entity is found.
+// Returns a *NotFoundError when no BotDelivery entities are found.
+func (bdq *BotDeliveryQuery) Only(ctx context.Context) (*BotDelivery, error) {
+	nodes, err := bdq.Limit(2).All(setContextOp(ctx, bdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{botdelivery.Label}
+	default:
+		return nil, &NotSingularError{botdelivery.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bdq *BotDeliveryQuery) OnlyX(ctx context.Context) *BotDelivery {
+	node, err := bdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BotDelivery ID in the query.
+// Returns a *NotSingularError when more than one BotDelivery ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bdq *BotDeliveryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = bdq.Limit(2).IDs(setContextOp(ctx, bdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{botdelivery.Label}
+	default:
+		err = &NotSingularError{botdelivery.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bdq *BotDeliveryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := bdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BotDeliveries.
+func (bdq *BotDeliveryQuery) All(ctx context.Context) ([]*BotDelivery, error) {
+	ctx = setContextOp(ctx, bdq.ctx, ent.OpQueryAll)
+	if err := bdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BotDelivery, *BotDeliveryQuery]()
+	return withInterceptors[[]*BotDelivery](ctx, bdq, qr, bdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bdq *BotDeliveryQuery) AllX(ctx context.Context) []*BotDelivery {
+	nodes, err := bdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BotDelivery IDs.
+func (bdq *BotDeliveryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if bdq.ctx.Unique == nil && bdq.path != nil {
+		bdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, bdq.ctx, ent.OpQueryIDs)
+	if err = bdq.Select(botdelivery.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bdq *BotDeliveryQuery) IDsX(ctx context.Context) []int {
+	ids, err := bdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bdq *BotDeliveryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, bdq.ctx, ent.OpQueryCount)
+	if err := bdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, bdq, querierCount[*BotDeliveryQuery](), bdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bdq *BotDeliveryQuery) CountX(ctx context.Context) int {
+	count, err := bdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bdq *BotDeliveryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, bdq.ctx, ent.OpQueryExist)
+	switch _, err := bdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bdq *BotDeliveryQuery) ExistX(ctx context.Context) bool {
+	exist, err := bdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BotDeliveryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bdq *BotDeliveryQuery) Clone() *BotDeliveryQuery {
+	if bdq == nil {
+		return nil
+	}
+	return &BotDeliveryQuery{
+		config:     bdq.config,
+		ctx:        bdq.ctx.Clone(),
+		order:      append([]botdelivery.OrderOption{}, bdq.order...),
+		inters:     append([]Interceptor{}, bdq.inters...),
+		predicates: append([]predicate.BotDelivery{}, bdq.predicates...),
+		// clone intermediate query.
+		sql:  bdq.sql.Clone(),
+		path: bdq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SubscriptionID int `json:"subscription_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BotDelivery.Query().
+//		GroupBy(botdelivery.FieldSubscriptionID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (bdq *BotDeliveryQuery) GroupBy(field string, fields ...string) *BotDeliveryGroupBy {
+	bdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BotDeliveryGroupBy{build: bdq}
+	grbuild.flds = &bdq.ctx.Fields
+	grbuild.label = botdelivery.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SubscriptionID int `json:"subscription_id,omitempty"`
+//	}
+//
+//	client.BotDelivery.Query().
+//		Select(botdelivery.FieldSubscriptionID).
+//		Scan(ctx, &v)
+func (bdq *BotDeliveryQuery) Select(fields ...string) *BotDeliverySelect {
+	bdq.ctx.Fields = append(bdq.ctx.Fields, fields...)
+	sbuild := &BotDeliverySelect{BotDeliveryQuery: bdq}
+	sbuild.label = botdelivery.Label
+	sbuild.flds, sbuild.scan = &bdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BotDeliverySelect configured with the given aggregations.
+func (bdq *BotDeliveryQuery) Aggregate(fns ...AggregateFunc) *BotDeliverySelect {
+	return bdq.Select().Aggregate(fns...)
+}
+
+func (bdq *BotDeliveryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range bdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, bdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range bdq.ctx.Fields {
+		if !botdelivery.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bdq.path != nil {
+		prev, err := bdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bdq.sql = prev
+	}
+	return nil
+}
+
+func (bdq *BotDeliveryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BotDelivery, error) {
+	var (
+		nodes = []*BotDelivery{}
+		_spec = bdq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BotDelivery).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BotDelivery{config: bdq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (bdq *BotDeliveryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bdq.querySpec()
+	_spec.Node.Columns = bdq.ctx.Fields
+	if len(bdq.ctx.Fields) > 0 {
+		_spec.Unique = bdq.ctx.Unique != nil && *bdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, bdq.driver, _spec)
+}
+
+func (bdq *BotDeliveryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(botdelivery.Table, botdelivery.Columns, sqlgraph.NewFieldSpec(botdelivery.FieldID, field.TypeInt))
+	_spec.From = bdq.sql
+	if unique := bdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if bdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := bdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, botdelivery.FieldID)
+		for i := range fields {
+			if fields[i] != botdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := bdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bdq *BotDeliveryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bdq.driver.Dialect())
+	t1 := builder.Table(botdelivery.Table)
+	columns := bdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = botdelivery.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bdq.sql != nil {
+		selector = bdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bdq.ctx.Unique != nil && *bdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range bdq.predicates {
+		p(selector)
+	}
+	for _, p := range bdq.order {
+		p(selector)
+	}
+	if offset := bdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BotDeliveryGroupBy is the group-by builder for BotDelivery entities.
+type BotDeliveryGroupBy struct {
+	selector
+	build *BotDeliveryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bdgb *BotDeliveryGroupBy) Aggregate(fns ...AggregateFunc) *BotDeliveryGroupBy {
+	bdgb.fns = append(bdgb.fns, fns...)
+	return bdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bdgb *BotDeliveryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := bdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BotDeliveryQuery, *BotDeliveryGroupBy](ctx, bdgb.build, bdgb, bdgb.build.inters, v)
+}
+
+func (bdgb *BotDeliveryGroupBy) sqlScan(ctx context.Context, root *BotDeliveryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(bdgb.fns))
+	for _, fn := range bdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*bdgb.flds)+len(bdgb.fns))
+		for _, f := range *bdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*bdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BotDeliverySelect is the builder for selecting fields of BotDelivery entities.
+type BotDeliverySelect struct {
+	*BotDeliveryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bds *BotDeliverySelect) Aggregate(fns ...AggregateFunc) *BotDeliverySelect {
+	bds.fns = append(bds.fns, fns...)
+	return bds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bds *BotDeliverySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bds.ctx, ent.OpQuerySelect)
+	if err := bds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BotDeliveryQuery, *BotDeliverySelect](ctx, bds.BotDeliveryQuery, bds, bds.inters, v)
+}
+
+func (bds *BotDeliverySelect) sqlScan(ctx context.Context, root *BotDeliveryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bds.fns))
+	for _, fn := range bds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,560 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botdelivery"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// BotDeliveryUpdate is the builder for updating BotDelivery entities.
+type BotDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BotDeliveryMutation
+}
+
+// Where appends a list predicates to the BotDeliveryUpdate builder.
+func (bdu *BotDeliveryUpdate) Where(ps ...predicate.BotDelivery) *BotDeliveryUpdate {
+	bdu.mutation.Where(ps...)
+	return bdu
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (bdu *BotDeliveryUpdate) SetSubscriptionID(i int) *BotDeliveryUpdate {
+	bdu.mutation.ResetSubscriptionID()
+	bdu.mutation.SetSubscriptionID(i)
+	return bdu
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (bdu *BotDeliveryUpdate) SetNillableSubscriptionID(i *int) *BotDeliveryUpdate {
+	if i != nil {
+		bdu.SetSubscriptionID(*i)
+	}
+	return bdu
+}
+
+// AddSubscriptionID adds i to the "subscription_id" field.
+func (bdu *BotDeliveryUpdate) AddSubscriptionID(i int) *BotDeliveryUpdate {
+	bdu.mutation.AddSubscriptionID(i)
+	return bdu
+}
+
+// SetEvent sets the "event" field.
+func (bdu *BotDeliveryUpdate) SetEvent(s string) *BotDeliveryUpdate {
+	bdu.mutation.SetEvent(s)
+	return bdu
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (bdu *BotDeliveryUpdate) SetNillableEvent(s *string) *BotDeliveryUpdate {
+	if s != nil {
+		bdu.SetEvent(*s)
+	}
+	return bdu
+}
+
+// SetPayload sets the "payload" field.
+func (bdu *BotDeliveryUpdate) SetPayload(s string) *BotDeliveryUpdate {
+	bdu.mutation.SetPayload(s)
+	return bdu
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (bdu *BotDeliveryUpdate) SetNillablePayload(s *string) *BotDeliveryUpdate {
+	if s != nil {
+		bdu.SetPayload(*s)
+	}
+	return bdu
+}
+
+// SetStatus sets the "status" field.
+func (bdu *BotDeliveryUpdate) SetStatus(b botdelivery.Status) *BotDeliveryUpdate {
+	bdu.mutation.SetStatus(b)
+	return bdu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bdu *BotDeliveryUpdate) SetNillableStatus(b *botdelivery.Status) *BotDeliveryUpdate {
+	if b != nil {
+		bdu.SetStatus(*b)
+	}
+	return bdu
+}
+
+// SetAttempts sets the "attempts" field.
+func (bdu *BotDeliveryUpdate) SetAttempts(i int) *BotDeliveryUpdate {
+	bdu.mutation.ResetAttempts()
+	bdu.mutation.SetAttempts(i)
+	return bdu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (bdu *BotDeliveryUpdate) SetNillableAttempts(i *int) *BotDeliveryUpdate {
+	if i != nil {
+		bdu.SetAttempts(*i)
+	}
+	return bdu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (bdu *BotDeliveryUpdate) AddAttempts(i int) *BotDeliveryUpdate {
+	bdu.mutation.AddAttempts(i)
+	return bdu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (bdu *BotDeliveryUpdate) SetNextAttemptAt(t time.Time) *BotDeliveryUpdate {
+	bdu.mutation.SetNextAttemptAt(t)
+	return bdu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (bdu *BotDeliveryUpdate) SetNillableNextAttemptAt(t *time.Time) *BotDeliveryUpdate {
+	if t != nil {
+		bdu.SetNextAttemptAt(*t)
+	}
+	return bdu
+}
+
+// SetLastError sets the "last_error" field.
+func (bdu *BotDeliveryUpdate) SetLastError(s string) *BotDeliveryUpdate {
+	bdu.mutation.SetLastError(s)
+	return bdu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (bdu *BotDeliveryUpdate) SetNillableLastError(s *string) *BotDeliveryUpdate {
+	if s != nil {
+		bdu.SetLastError(*s)
+	}
+	return bdu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (bdu *BotDeliveryUpdate) ClearLastError() *BotDeliveryUpdate {
+	bdu.mutation.ClearLastError()
+	return bdu
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (bdu *BotDeliveryUpdate) SetDeliveredAt(t time.Time) *BotDeliveryUpdate {
+	bdu.mutation.SetDeliveredAt(t)
+	return bdu
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (bdu *BotDeliveryUpdate) SetNillableDeliveredAt(t *time.Time) *BotDeliveryUpdate {
+	if t != nil {
+		bdu.SetDeliveredAt(*t)
+	}
+	return bdu
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (bdu *BotDeliveryUpdate) ClearDeliveredAt() *BotDeliveryUpdate {
+	bdu.mutation.ClearDeliveredAt()
+	return bdu
+}
+
+// Mutation returns the BotDeliveryMutation object of the builder.
+func (bdu *BotDeliveryUpdate) Mutation() *BotDeliveryMutation {
+	return bdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bdu *BotDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bdu.sqlSave, bdu.mutation, bdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bdu *BotDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := bdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bdu *BotDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := bdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bdu *BotDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := bdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bdu *BotDeliveryUpdate) check() error {
+	if v, ok := bdu.mutation.Event(); ok {
+		if err := botdelivery.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "BotDelivery.event": %w`, err)}
+		}
+	}
+	if v, ok := bdu.mutation.Status(); ok {
+		if err := botdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BotDelivery.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bdu *BotDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(botdelivery.Table, botdelivery.Columns, sqlgraph.NewFieldSpec(botdelivery.FieldID, field.TypeInt))
+	if ps := bdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bdu.mutation.SubscriptionID(); ok {
+		_spec.SetField(botdelivery.FieldSubscriptionID, field.TypeInt, value)
+	}
+	if value, ok := bdu.mutation.AddedSubscriptionID(); ok {
+		_spec.AddField(botdelivery.FieldSubscriptionID, field.TypeInt, value)
+	}
+	if value, ok := bdu.mutation.Event(); ok {
+		_spec.SetField(botdelivery.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := bdu.mutation.Payload(); ok {
+		_spec.SetField(botdelivery.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := bdu.mutation.Status(); ok {
+		_spec.SetField(botdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := bdu.mutation.Attempts(); ok {
+		_spec.SetField(botdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := bdu.mutation.AddedAttempts(); ok {
+		_spec.AddField(botdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := bdu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(botdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := bdu.mutation.LastError(); ok {
+		_spec.SetField(botdelivery.FieldLastError, field.TypeString, value)
+	}
+	if bdu.mutation.LastErrorCleared() {
+		_spec.ClearField(botdelivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := bdu.mutation.DeliveredAt(); ok {
+		_spec.SetField(botdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if bdu.mutation.DeliveredAtCleared() {
+		_spec.ClearField(botdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{botdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bdu.mutation.done = true
+	return n, nil
+}
+
+// BotDeliveryUpdateOne is the builder for updating a single BotDelivery entity.
+type BotDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BotDeliveryMutation
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (bduo *BotDeliveryUpdateOne) SetSubscriptionID(i int) *BotDeliveryUpdateOne {
+	bduo.mutation.ResetSubscriptionID()
+	bduo.mutation.SetSubscriptionID(i)
+	return bduo
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (bduo *BotDeliveryUpdateOne) SetNillableSubscriptionID(i *int) *BotDeliveryUpdateOne {
+	if i != nil {
+		bduo.SetSubscriptionID(*i)
+	}
+	return bduo
+}
+
+// AddSubscriptionID adds i to the "subscription_id" field.
+func (bduo *BotDeliveryUpdateOne) AddSubscriptionID(i int) *BotDeliveryUpdateOne {
+	bduo.mutation.AddSubscriptionID(i)
+	return bduo
+}
+
+// SetEvent sets the "event" field.
+func (bduo *BotDeliveryUpdateOne) SetEvent(s string) *BotDeliveryUpdateOne {
+	bduo.mutation.SetEvent(s)
+	return bduo
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (bduo *BotDeliveryUpdateOne) SetNillableEvent(s *string) *BotDeliveryUpdateOne {
+	if s != nil {
+		bduo.SetEvent(*s)
+	}
+	return bduo
+}
+
+// SetPayload sets the "payload" field.
+func (bduo *BotDeliveryUpdateOne) SetPayload(s string) *BotDeliveryUpdateOne {
+	bduo.mutation.SetPayload(s)
+	return bduo
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (bduo *BotDeliveryUpdateOne) SetNillablePayload(s *string) *BotDeliveryUpdateOne {
+	if s != nil {
+		bduo.SetPayload(*s)
+	}
+	return bduo
+}
+
+// SetStatus sets the "status" field.
+func (bduo *BotDeliveryUpdateOne) SetStatus(b botdelivery.Status) *BotDeliveryUpdateOne {
+	bduo.mutation.SetStatus(b)
+	return bduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bduo *BotDeliveryUpdateOne) SetNillableStatus(b *botdelivery.Status) *BotDeliveryUpdateOne {
+	if b != nil {
+		bduo.SetStatus(*b)
+	}
+	return bduo
+}
+
+// SetAttempts sets the "attempts" field.
+func (bduo *BotDeliveryUpdateOne) SetAttempts(i int) *BotDeliveryUpdateOne {
+	bduo.mutation.ResetAttempts()
+	bduo.mutation.SetAttempts(i)
+	return bduo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (bduo *BotDeliveryUpdateOne) SetNillableAttempts(i *int) *BotDeliveryUpdateOne {
+	if i != nil {
+		bduo.SetAttempts(*i)
+	}
+	return bduo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (bduo *BotDeliveryUpdateOne) AddAttempts(i int) *BotDeliveryUpdateOne {
+	bduo.mutation.AddAttempts(i)
+	return bduo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (bduo *BotDeliveryUpdateOne) SetNextAttemptAt(t time.Time) *BotDeliveryUpdateOne {
+	bduo.mutation.SetNextAttemptAt(t)
+	return bduo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (bduo *BotDeliveryUpdateOne) SetNillableNextAttemptAt(t *time.Time) *BotDeliveryUpdateOne {
+	if t != nil {
+		bduo.SetNextAttemptAt(*t)
+	}
+	return bduo
+}
+
+// SetLastError sets the "last_error" field.
+func (bduo *BotDeliveryUpdateOne) SetLastError(s string) *BotDeliveryUpdateOne {
+	bduo.mutation.SetLastError(s)
+	return bduo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (bduo *BotDeliveryUpdateOne) SetNillableLastError(s *string) *BotDeliveryUpdateOne {
+	if s != nil {
+		bduo.SetLastError(*s)
+	}
+	return bduo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (bduo *BotDeliveryUpdateOne) ClearLastError() *BotDeliveryUpdateOne {
+	bduo.mutation.ClearLastError()
+	return bduo
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (bduo *BotDeliveryUpdateOne) SetDeliveredAt(t time.Time) *BotDeliveryUpdateOne {
+	bduo.mutation.SetDeliveredAt(t)
+	return bduo
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (bduo *BotDeliveryUpdateOne) SetNillableDeliveredAt(t *time.Time) *BotDeliveryUpdateOne {
+	if t != nil {
+		bduo.SetDeliveredAt(*t)
+	}
+	return bduo
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (bduo *BotDeliveryUpdateOne) ClearDeliveredAt() *BotDeliveryUpdateOne {
+	bduo.mutation.ClearDeliveredAt()
+	return bduo
+}
+
+// Mutation returns the BotDeliveryMutation object of the builder.
+func (bduo *BotDeliveryUpdateOne) Mutation() *BotDeliveryMutation {
+	return bduo.mutation
+}
+
+// Where appends a list predicates to the BotDeliveryUpdate builder.
+func (bduo *BotDeliveryUpdateOne) Where(ps ...predicate.BotDelivery) *BotDeliveryUpdateOne {
+	bduo.mutation.Where(ps...)
+	return bduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bduo *BotDeliveryUpdateOne) Select(field string, fields ...string) *BotDeliveryUpdateOne {
+	bduo.fields = append([]string{field}, fields...)
+	return bduo
+}
+
+// Save executes the query and returns the updated BotDelivery entity.
+func (bduo *BotDeliveryUpdateOne) Save(ctx context.Context) (*BotDelivery, error) {
+	return withHooks(ctx, bduo.sqlSave, bduo.mutation, bduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bduo *BotDeliveryUpdateOne) SaveX(ctx context.Context) *BotDelivery {
+	node, err := bduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bduo *BotDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := bduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bduo *BotDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := bduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bduo *BotDeliveryUpdateOne) check() error {
+	if v, ok := bduo.mutation.Event(); ok {
+		if err := botdelivery.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "BotDelivery.event": %w`, err)}
+		}
+	}
+	if v, ok := bduo.mutation.Status(); ok {
+		if err := botdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BotDelivery.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bduo *BotDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *BotDelivery, err error) {
+	if err := bduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(botdelivery.Table, botdelivery.Columns, sqlgraph.NewFieldSpec(botdelivery.FieldID, field.TypeInt))
+	id, ok := bduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BotDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, botdelivery.FieldID)
+		for _, f := range fields {
+			if !botdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != botdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bduo.mutation.SubscriptionID(); ok {
+		_spec.SetField(botdelivery.FieldSubscriptionID, field.TypeInt, value)
+	}
+	if value, ok := bduo.mutation.AddedSubscriptionID(); ok {
+		_spec.AddField(botdelivery.FieldSubscriptionID, field.TypeInt, value)
+	}
+	if value, ok := bduo.mutation.Event(); ok {
+		_spec.SetField(botdelivery.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := bduo.mutation.Payload(); ok {
+		_spec.SetField(botdelivery.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := bduo.mutation.Status(); ok {
+		_spec.SetField(botdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := bduo.mutation.Attempts(); ok {
+		_spec.SetField(botdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := bduo.mutation.AddedAttempts(); ok {
+		_spec.AddField(botdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := bduo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(botdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := bduo.mutation.LastError(); ok {
+		_spec.SetField(botdelivery.FieldLastError, field.TypeString, value)
+	}
+	if bduo.mutation.LastErrorCleared() {
+		_spec.ClearField(botdelivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := bduo.mutation.DeliveredAt(); ok {
+		_spec.SetField(botdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if bduo.mutation.DeliveredAtCleared() {
+		_spec.ClearField(botdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	_node = &BotDelivery{config: bduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{botdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botsubscription"
+)
+
+// BotSubscription is the model entity for the BotSubscription schema.
+type BotSubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// BotID holds the value of the "bot_id" field.
+	BotID string `json:"bot_id,omitempty"`
+	// BotName holds the value of the "bot_name" field.
+	BotName string `json:"bot_name,omitempty"`
+	// RoomID holds the value of the "room_id" field.
+	RoomID string `json:"room_id,omitempty"`
+	// CallbackURL holds the value of the "callback_url" field.
+	CallbackURL string `json:"callback_url,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// Events holds the value of the "events" field.
+	Events []string `json:"events,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BotSubscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case botsubscription.FieldEvents:
+			values[i] = new([]byte)
+		case botsubscription.FieldID:
+			values[i] = new(sql.NullInt64)
+		case botsubscription.FieldBotID, botsubscription.FieldBotName, botsubscription.FieldRoomID, botsubscription.FieldCallbackURL, botsubscription.FieldSecret:
+			values[i] = new(sql.NullString)
+		case botsubscription.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BotSubscription fields.
+func (bs *BotSubscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case botsubscription.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bs.ID = int(value.Int64)
+		case botsubscription.FieldBotID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bot_id", values[i])
+			} else if value.Valid {
+				bs.BotID = value.String
+			}
+		case botsubscription.FieldBotName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bot_name", values[i])
+			} else if value.Valid {
+				bs.BotName = value.String
+			}
+		case botsubscription.FieldRoomID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value.Valid {
+				bs.RoomID = value.String
+			}
+		case botsubscription.FieldCallbackURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field callback_url", values[i])
+			} else if value.Valid {
+				bs.CallbackURL = value.String
+			}
+		case botsubscription.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				bs.Secret = value.String
+			}
+		case botsubscription.FieldEvents:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field events", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &bs.Events); err != nil {
+					return fmt.Errorf("unmarshal field events: %w", err)
+				}
+			}
+		case botsubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				bs.CreatedAt = value.Time
+			}
+		default:
+			bs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BotSubscription.
+// This includes values selected through modifiers, order, etc.
+func (bs *BotSubscription) Value(name string) (ent.Value, error) {
+	return bs.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BotSubscription.
+// Note that you need to call BotSubscription.Unwrap() before calling this method if this BotSubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bs *BotSubscription) Update() *BotSubscriptionUpdateOne {
+	return NewBotSubscriptionClient(bs.config).UpdateOne(bs)
+}
+
+// Unwrap unwraps the BotSubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bs *BotSubscription) Unwrap() *BotSubscription {
+	_tx, ok := bs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BotSubscription is not a transactional entity")
+	}
+	bs.config.driver = _tx.drv
+	return bs
+}
+
+// String implements the fmt.Stringer.
+func (bs *BotSubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("BotSubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bs.ID))
+	builder.WriteString("bot_id=")
+	builder.WriteString(bs.BotID)
+	builder.WriteString(", ")
+	builder.WriteString("bot_name=")
+	builder.WriteString(bs.BotName)
+	builder.WriteString(", ")
+	builder.WriteString("room_id=")
+	builder.WriteString(bs.RoomID)
+	builder.WriteString(", ")
+	builder.WriteString("callback_url=")
+	builder.WriteString(bs.CallbackURL)
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("events=")
+	builder.WriteString(fmt.Sprintf("%v", bs.Events))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(bs.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BotSubscriptions is a parsable slice of BotSubscription.
+type BotSubscriptions []*BotSubscription
@@ -0,0 +1,465 @@
+// Code generated by ent, DO NOT EDIT.
+
+package botsubscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldLTE(FieldID, id))
+}
+
+// BotID applies equality check predicate on the "bot_id" field. It's identical to BotIDEQ.
+func BotID(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEQ(FieldBotID, v))
+}
+
+// BotName applies equality check predicate on the "bot_name" field. It's identical to BotNameEQ.
+func BotName(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEQ(FieldBotName, v))
+}
+
+// RoomID applies equality check predicate on the "room_id" field. It's identical to RoomIDEQ.
+func RoomID(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEQ(FieldRoomID, v))
+}
+
+// CallbackURL applies equality check predicate on the "callback_url" field. It's identical to CallbackURLEQ.
+func CallbackURL(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEQ(FieldCallbackURL, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEQ(FieldSecret, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// BotIDEQ applies the EQ predicate on the "bot_id" field.
+func BotIDEQ(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEQ(FieldBotID, v))
+}
+
+// BotIDNEQ applies the NEQ predicate on the "bot_id" field.
+func BotIDNEQ(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldNEQ(FieldBotID, v))
+}
+
+// BotIDIn applies the In predicate on the "bot_id" field.
+func BotIDIn(vs ...string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldIn(FieldBotID, vs...))
+}
+
+// BotIDNotIn applies the NotIn predicate on the "bot_id" field.
+func BotIDNotIn(vs ...string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldNotIn(FieldBotID, vs...))
+}
+
+// BotIDGT applies the GT predicate on the "bot_id" field.
+func BotIDGT(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldGT(FieldBotID, v))
+}
+
+// BotIDGTE applies the GTE predicate on the "bot_id" field.
+func BotIDGTE(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldGTE(FieldBotID, v))
+}
+
+// BotIDLT applies the LT predicate on the "bot_id" field.
+func BotIDLT(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldLT(FieldBotID, v))
+}
+
+// BotIDLTE applies the LTE predicate on the "bot_id" field.
+func BotIDLTE(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldLTE(FieldBotID, v))
+}
+
+// BotIDContains applies the Contains predicate on the "bot_id" field.
+func BotIDContains(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldContains(FieldBotID, v))
+}
+
+// BotIDHasPrefix applies the HasPrefix predicate on the "bot_id" field.
+func BotIDHasPrefix(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldHasPrefix(FieldBotID, v))
+}
+
+// BotIDHasSuffix applies the HasSuffix predicate on the "bot_id" field.
+func BotIDHasSuffix(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldHasSuffix(FieldBotID, v))
+}
+
+// BotIDEqualFold applies the EqualFold predicate on the "bot_id" field.
+func BotIDEqualFold(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEqualFold(FieldBotID, v))
+}
+
+// BotIDContainsFold applies the ContainsFold predicate on the "bot_id" field.
+func BotIDContainsFold(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldContainsFold(FieldBotID, v))
+}
+
+// BotNameEQ applies the EQ predicate on the "bot_name" field.
+func BotNameEQ(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEQ(FieldBotName, v))
+}
+
+// BotNameNEQ applies the NEQ predicate on the "bot_name" field.
+func BotNameNEQ(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldNEQ(FieldBotName, v))
+}
+
+// BotNameIn applies the In predicate on the "bot_name" field.
+func BotNameIn(vs ...string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldIn(FieldBotName, vs...))
+}
+
+// BotNameNotIn applies the NotIn predicate on the "bot_name" field.
+func BotNameNotIn(vs ...string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldNotIn(FieldBotName, vs...))
+}
+
+// BotNameGT applies the GT predicate on the "bot_name" field.
+func BotNameGT(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldGT(FieldBotName, v))
+}
+
+// BotNameGTE applies the GTE predicate on the "bot_name" field.
+func BotNameGTE(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldGTE(FieldBotName, v))
+}
+
+// BotNameLT applies the LT predicate on the "bot_name" field.
+func BotNameLT(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldLT(FieldBotName, v))
+}
+
+// BotNameLTE applies the LTE predicate on the "bot_name" field.
+func BotNameLTE(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldLTE(FieldBotName, v))
+}
+
+// BotNameContains applies the Contains predicate on the "bot_name" field.
+func BotNameContains(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldContains(FieldBotName, v))
+}
+
+// BotNameHasPrefix applies the HasPrefix predicate on the "bot_name" field.
+func BotNameHasPrefix(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldHasPrefix(FieldBotName, v))
+}
+
+// BotNameHasSuffix applies the HasSuffix predicate on the "bot_name" field.
+func BotNameHasSuffix(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldHasSuffix(FieldBotName, v))
+}
+
+// BotNameEqualFold applies the EqualFold predicate on the "bot_name" field.
+func BotNameEqualFold(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEqualFold(FieldBotName, v))
+}
+
+// BotNameContainsFold applies the ContainsFold predicate on the "bot_name" field.
+func BotNameContainsFold(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldContainsFold(FieldBotName, v))
+}
+
+// RoomIDEQ applies the EQ predicate on the "room_id" field.
+func RoomIDEQ(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEQ(FieldRoomID, v))
+}
+
+// RoomIDNEQ applies the NEQ predicate on the "room_id" field.
+func RoomIDNEQ(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldNEQ(FieldRoomID, v))
+}
+
+// RoomIDIn applies the In predicate on the "room_id" field.
+func RoomIDIn(vs ...string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldIn(FieldRoomID, vs...))
+}
+
+// RoomIDNotIn applies the NotIn predicate on the "room_id" field.
+func RoomIDNotIn(vs ...string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldNotIn(FieldRoomID, vs...))
+}
+
+// RoomIDGT applies the GT predicate on the "room_id" field.
+func RoomIDGT(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldGT(FieldRoomID, v))
+}
+
+// RoomIDGTE applies the GTE predicate on the "room_id" field.
+func RoomIDGTE(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldGTE(FieldRoomID, v))
+}
+
+// RoomIDLT applies the LT predicate on the "room_id" field.
+func RoomIDLT(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldLT(FieldRoomID, v))
+}
+
+// RoomIDLTE applies the LTE predicate on the "room_id" field.
+func RoomIDLTE(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldLTE(FieldRoomID, v))
+}
+
+// RoomIDContains applies the Contains predicate on the "room_id" field.
+func RoomIDContains(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldContains(FieldRoomID, v))
+}
+
+// RoomIDHasPrefix applies the HasPrefix predicate on the "room_id" field.
+func RoomIDHasPrefix(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldHasPrefix(FieldRoomID, v))
+}
+
+// RoomIDHasSuffix applies the HasSuffix predicate on the "room_id" field.
+func RoomIDHasSuffix(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldHasSuffix(FieldRoomID, v))
+}
+
+// RoomIDEqualFold applies the EqualFold predicate on the "room_id" field.
+func RoomIDEqualFold(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEqualFold(FieldRoomID, v))
+}
+
+// RoomIDContainsFold applies the ContainsFold predicate on the "room_id" field.
+func RoomIDContainsFold(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldContainsFold(FieldRoomID, v))
+}
+
+// CallbackURLEQ applies the EQ predicate on the "callback_url" field.
+func CallbackURLEQ(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEQ(FieldCallbackURL, v))
+}
+
+// CallbackURLNEQ applies the NEQ predicate on the "callback_url" field.
+func CallbackURLNEQ(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldNEQ(FieldCallbackURL, v))
+}
+
+// CallbackURLIn applies the In predicate on the "callback_url" field.
+func CallbackURLIn(vs ...string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldIn(FieldCallbackURL, vs...))
+}
+
+// CallbackURLNotIn applies the NotIn predicate on the "callback_url" field.
+func CallbackURLNotIn(vs ...string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldNotIn(FieldCallbackURL, vs...))
+}
+
+// CallbackURLGT applies the GT predicate on the "callback_url" field.
+func CallbackURLGT(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldGT(FieldCallbackURL, v))
+}
+
+// CallbackURLGTE applies the GTE predicate on the "callback_url" field.
+func CallbackURLGTE(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldGTE(FieldCallbackURL, v))
+}
+
+// CallbackURLLT applies the LT predicate on the "callback_url" field.
+func CallbackURLLT(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldLT(FieldCallbackURL, v))
+}
+
+// CallbackURLLTE applies the LTE predicate on the "callback_url" field.
+func CallbackURLLTE(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldLTE(FieldCallbackURL, v))
+}
+
+// CallbackURLContains applies the Contains predicate on the "callback_url" field.
+func CallbackURLContains(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldContains(FieldCallbackURL, v))
+}
+
+// CallbackURLHasPrefix applies the HasPrefix predicate on the "callback_url" field.
+func CallbackURLHasPrefix(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldHasPrefix(FieldCallbackURL, v))
+}
+
+// CallbackURLHasSuffix applies the HasSuffix predicate on the "callback_url" field.
+func CallbackURLHasSuffix(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldHasSuffix(FieldCallbackURL, v))
+}
+
+// CallbackURLEqualFold applies the EqualFold predicate on the "callback_url" field.
+func CallbackURLEqualFold(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEqualFold(FieldCallbackURL, v))
+}
+
+// CallbackURLContainsFold applies the ContainsFold predicate on the "callback_url" field.
+func CallbackURLContainsFold(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldContainsFold(FieldCallbackURL, v))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldGT(FieldSecret, v))
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldGTE(FieldSecret, v))
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldLT(FieldSecret, v))
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldLTE(FieldSecret, v))
+}
+
+// SecretContains applies the Contains predicate on the "secret" field.
+func SecretContains(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldContains(FieldSecret, v))
+}
+
+// SecretHasPrefix applies the HasPrefix predicate on the "secret" field.
+func SecretHasPrefix(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldHasPrefix(FieldSecret, v))
+}
+
+// SecretHasSuffix applies the HasSuffix predicate on the "secret" field.
+func SecretHasSuffix(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldHasSuffix(FieldSecret, v))
+}
+
+// SecretEqualFold applies the EqualFold predicate on the "secret" field.
+func SecretEqualFold(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEqualFold(FieldSecret, v))
+}
+
+// SecretContainsFold applies the ContainsFold predicate on the "secret" field.
+func SecretContainsFold(v string) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldContainsFold(FieldSecret, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BotSubscription) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BotSubscription) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BotSubscription) predicate.BotSubscription {
+	return predicate.BotSubscription(sql.NotPredicates(p))
+}
@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botsubscription"
+)
+
+// BotSubscriptionCreate is the builder for creating a BotSubscription entity.
+type BotSubscriptionCreate struct {
+	config
+	mutation *BotSubscriptionMutation
+	hooks    []Hook
+}
+
+// SetBotID sets the "bot_id" field.
+func (bsc *BotSubscriptionCreate) SetBotID(s string) *BotSubscriptionCreate {
+	bsc.mutation.SetBotID(s)
+	return bsc
+}
+
+// SetBotName sets the "bot_name" field.
+func (bsc *BotSubscriptionCreate) SetBotName(s string) *BotSubscriptionCreate {
+	bsc.mutation.SetBotName(s)
+	return bsc
+}
+
+// SetRoomID sets the "room_id" field.
+func (bsc *BotSubscriptionCreate) SetRoomID(s string) *BotSubscriptionCreate {
+	bsc.mutation.SetRoomID(s)
+	return bsc
+}
+
+// SetCallbackURL sets the "callback_url" field.
+func (bsc *BotSubscriptionCreate) SetCallbackURL(s string) *BotSubscriptionCreate {
+	bsc.mutation.SetCallbackURL(s)
+	return bsc
+}
+
+// SetSecret sets the "secret" field.
+func (bsc *BotSubscriptionCreate) SetSecret(s string) *BotSubscriptionCreate {
+	bsc.mutation.SetSecret(s)
+	return bsc
+}
+
+// SetEvents sets the "events" field.
+func (bsc *BotSubscriptionCreate) SetEvents(s []string) *BotSubscriptionCreate {
+	bsc.mutation.SetEvents(s)
+	return bsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bsc *BotSubscriptionCreate) SetCreatedAt(t time.Time) *BotSubscriptionCreate {
+	bsc.mutation.SetCreatedAt(t)
+	return bsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bsc *BotSubscriptionCreate) SetNillableCreatedAt(t *time.Time) *BotSubscriptionCreate {
+	if t != nil {
+		bsc.SetCreatedAt(*t)
+	}
+	return bsc
+}
+
+// Mutation returns the BotSubscriptionMutation object of the builder.
+func (bsc *BotSubscriptionCreate) Mutation() *BotSubscriptionMutation {
+	return bsc.mutation
+}
+
+// Save creates the BotSubscription in the database.
+func (bsc *BotSubscriptionCreate) Save(ctx context.Context) (*BotSubscription, error) {
+	bsc.defaults()
+	return withHooks(ctx, bsc.sqlSave, bsc.mutation, bsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bsc *BotSubscriptionCreate) SaveX(ctx context.Context) *BotSubscription {
+	v, err := bsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bsc *BotSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := bsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsc *BotSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := bsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bsc *BotSubscriptionCreate) defaults() {
+	if _, ok := bsc.mutation.CreatedAt(); !ok {
+		v := botsubscription.DefaultCreatedAt()
+		bsc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsc *BotSubscriptionCreate) check() error {
+	if _, ok := bsc.mutation.BotID(); !ok {
+		return &ValidationError{Name: "bot_id", err: errors.New(`ent: missing required field "BotSubscription.bot_id"`)}
+	}
+	if v, ok := bsc.mutation.BotID(); ok {
+		if err := botsubscription.BotIDValidator(v); err != nil {
+			return &ValidationError{Name: "bot_id", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.bot_id": %w`, err)}
+		}
+	}
+	if _, ok := bsc.mutation.BotName(); !ok {
+		return &ValidationError{Name: "bot_name", err: errors.New(`ent: missing required field "BotSubscription.bot_name"`)}
+	}
+	if v, ok := bsc.mutation.BotName(); ok {
+		if err := botsubscription.BotNameValidator(v); err != nil {
+			return &ValidationError{Name: "bot_name", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.bot_name": %w`, err)}
+		}
+	}
+	if _, ok := bsc.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room_id", err: errors.New(`ent: missing required field "BotSubscription.room_id"`)}
+	}
+	if v, ok := bsc.mutation.RoomID(); ok {
+		if err := botsubscription.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.room_id": %w`, err)}
+		}
+	}
+	if _, ok := bsc.mutation.CallbackURL(); !ok {
+		return &ValidationError{Name: "callback_url", err: errors.New(`ent: missing required field "BotSubscription.callback_url"`)}
+	}
+	if v, ok := bsc.mutation.CallbackURL(); ok {
+		if err := botsubscription.CallbackURLValidator(v); err != nil {
+			return &ValidationError{Name: "callback_url", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.callback_url": %w`, err)}
+		}
+	}
+	if _, ok := bsc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "BotSubscription.secret"`)}
+	}
+	if v, ok := bsc.mutation.Secret(); ok {
+		if err := botsubscription.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.secret": %w`, err)}
+		}
+	}
+	if _, ok := bsc.mutation.Events(); !ok {
+		return &ValidationError{Name: "events", err: errors.New(`ent: missing required field "BotSubscription.events"`)}
+	}
+	if _, ok := bsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BotSubscription.created_at"`)}
+	}
+	return nil
+}
+
+func (bsc *BotSubscriptionCreate) sqlSave(ctx context.Context) (*BotSubscription, error) {
+	if err := bsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	bsc.mutation.id = &_node.ID
+	bsc.mutation.done = true
+	return _node, nil
+}
+
+func (bsc *BotSubscriptionCreate) createSpec() (*BotSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BotSubscription{config: bsc.config}
+		_spec = sqlgraph.NewCreateSpec(botsubscription.Table, sqlgraph.NewFieldSpec(botsubscription.FieldID, field.TypeInt))
+	)
+	if value, ok := bsc.mutation.BotID(); ok {
+		_spec.SetField(botsubscription.FieldBotID, field.TypeString, value)
+		_node.BotID = value
+	}
+	if value, ok := bsc.mutation.BotName(); ok {
+		_spec.SetField(botsubscription.FieldBotName, field.TypeString, value)
+		_node.BotName = value
+	}
+	if value, ok := bsc.mutation.RoomID(); ok {
+		_spec.SetField(botsubscription.FieldRoomID, field.TypeString, value)
+		_node.RoomID = value
+	}
+	if value, ok := bsc.mutation.CallbackURL(); ok {
+		_spec.SetField(botsubscription.FieldCallbackURL, field.TypeString, value)
+		_node.CallbackURL = value
+	}
+	if value, ok := bsc.mutation.Secret(); ok {
+		_spec.SetField(botsubscription.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := bsc.mutation.Events(); ok {
+		_spec.SetField(botsubscription.FieldEvents, field.TypeJSON, value)
+		_node.Events = value
+	}
+	if value, ok := bsc.mutation.CreatedAt(); ok {
+		_spec.SetField(botsubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// BotSubscriptionCreateBulk is the builder for creating many BotSubscription entities in bulk.
+type BotSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*BotSubscriptionCreate
+}
+
+// Save creates the BotSubscription entities in the database.
+func (bscb *BotSubscriptionCreateBulk) Save(ctx context.Context) ([]*BotSubscription, error) {
+	if bscb.err != nil {
+		return nil, bscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bscb.builders))
+	nodes := make([]*BotSubscription, len(bscb.builders))
+	mutators := make([]Mutator, len(bscb.builders))
+	for i := range bscb.builders {
+		func(i int, root context.Context) {
+			builder := bscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BotSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bscb *BotSubscriptionCreateBulk) SaveX(ctx context.Context) []*BotSubscription {
+	v, err := bscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bscb *BotSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := bscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bscb *BotSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := bscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botsubscription"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// BotSubscriptionDelete is the builder for deleting a BotSubscription entity.
+type BotSubscriptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *BotSubscriptionMutation
+}
+
+// Where appends a list predicates to the BotSubscriptionDelete builder.
+func (bsd *BotSubscriptionDelete) Where(ps ...predicate.BotSubscription) *BotSubscriptionDelete {
+	bsd.mutation.Where(ps...)
+	return bsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bsd *BotSubscriptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bsd.sqlExec, bsd.mutation, bsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsd *BotSubscriptionDelete) ExecX(ctx context.Context) int {
+	n, err := bsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bsd *BotSubscriptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(botsubscription.Table, sqlgraph.NewFieldSpec(botsubscription.FieldID, field.TypeInt))
+	if ps := bsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bsd.mutation.done = true
+	return affected, err
+}
+
+// BotSubscriptionDeleteOne is the builder for deleting a single BotSubscription entity.
+type BotSubscriptionDeleteOne struct {
+	bsd *BotSubscriptionDelete
+}
+
+// Where appends a list predicates to the BotSubscriptionDelete builder.
+func (bsdo *BotSubscriptionDeleteOne) Where(ps ...predicate.BotSubscription) *BotSubscriptionDeleteOne {
+	bsdo.bsd.mutation.Where(ps...)
+	return bsdo
+}
+
+// Exec executes the deletion query.
+func (bsdo *BotSubscriptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := bsdo.bsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{botsubscription.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsdo *BotSubscriptionDeleteOne) ExecX(ctx context.Context) {
+	if err := bsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,452 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botsubscription"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// BotSubscriptionUpdate is the builder for updating BotSubscription entities.
+type BotSubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BotSubscriptionMutation
+}
+
+// Where appends a list predicates to the BotSubscriptionUpdate builder.
+func (bsu *BotSubscriptionUpdate) Where(ps ...predicate.BotSubscription) *BotSubscriptionUpdate {
+	bsu.mutation.Where(ps...)
+	return bsu
+}
+
+// SetBotID sets the "bot_id" field.
+func (bsu *BotSubscriptionUpdate) SetBotID(s string) *BotSubscriptionUpdate {
+	bsu.mutation.SetBotID(s)
+	return bsu
+}
+
+// SetNillableBotID sets the "bot_id" field if the given value is not nil.
+func (bsu *BotSubscriptionUpdate) SetNillableBotID(s *string) *BotSubscriptionUpdate {
+	if s != nil {
+		bsu.SetBotID(*s)
+	}
+	return bsu
+}
+
+// SetBotName sets the "bot_name" field.
+func (bsu *BotSubscriptionUpdate) SetBotName(s string) *BotSubscriptionUpdate {
+	bsu.mutation.SetBotName(s)
+	return bsu
+}
+
+// SetNillableBotName sets the "bot_name" field if the given value is not nil.
+func (bsu *BotSubscriptionUpdate) SetNillableBotName(s *string) *BotSubscriptionUpdate {
+	if s != nil {
+		bsu.SetBotName(*s)
+	}
+	return bsu
+}
+
+// SetRoomID sets the "room_id" field.
+func (bsu *BotSubscriptionUpdate) SetRoomID(s string) *BotSubscriptionUpdate {
+	bsu.mutation.SetRoomID(s)
+	return bsu
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (bsu *BotSubscriptionUpdate) SetNillableRoomID(s *string) *BotSubscriptionUpdate {
+	if s != nil {
+		bsu.SetRoomID(*s)
+	}
+	return bsu
+}
+
+// SetCallbackURL sets the "callback_url" field.
+func (bsu *BotSubscriptionUpdate) SetCallbackURL(s string) *BotSubscriptionUpdate {
+	bsu.mutation.SetCallbackURL(s)
+	return bsu
+}
+
+// SetNillableCallbackURL sets the "callback_url" field if the given value is not nil.
+func (bsu *BotSubscriptionUpdate) SetNillableCallbackURL(s *string) *BotSubscriptionUpdate {
+	if s != nil {
+		bsu.SetCallbackURL(*s)
+	}
+	return bsu
+}
+
+// SetSecret sets the "secret" field.
+func (bsu *BotSubscriptionUpdate) SetSecret(s string) *BotSubscriptionUpdate {
+	bsu.mutation.SetSecret(s)
+	return bsu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (bsu *BotSubscriptionUpdate) SetNillableSecret(s *string) *BotSubscriptionUpdate {
+	if s != nil {
+		bsu.SetSecret(*s)
+	}
+	return bsu
+}
+
+// SetEvents sets the "events" field.
+func (bsu *BotSubscriptionUpdate) SetEvents(s []string) *BotSubscriptionUpdate {
+	bsu.mutation.SetEvents(s)
+	return bsu
+}
+
+// AppendEvents appends s to the "events" field.
+func (bsu *BotSubscriptionUpdate) AppendEvents(s []string) *BotSubscriptionUpdate {
+	bsu.mutation.AppendEvents(s)
+	return bsu
+}
+
+// Mutation returns the BotSubscriptionMutation object of the builder.
+func (bsu *BotSubscriptionUpdate) Mutation() *BotSubscriptionMutation {
+	return bsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bsu *BotSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bsu.sqlSave, bsu.mutation, bsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bsu *BotSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := bsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bsu *BotSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := bsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsu *BotSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := bsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsu *BotSubscriptionUpdate) check() error {
+	if v, ok := bsu.mutation.BotID(); ok {
+		if err := botsubscription.BotIDValidator(v); err != nil {
+			return &ValidationError{Name: "bot_id", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.bot_id": %w`, err)}
+		}
+	}
+	if v, ok := bsu.mutation.BotName(); ok {
+		if err := botsubscription.BotNameValidator(v); err != nil {
+			return &ValidationError{Name: "bot_name", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.bot_name": %w`, err)}
+		}
+	}
+	if v, ok := bsu.mutation.RoomID(); ok {
+		if err := botsubscription.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.room_id": %w`, err)}
+		}
+	}
+	if v, ok := bsu.mutation.CallbackURL(); ok {
+		if err := botsubscription.CallbackURLValidator(v); err != nil {
+			return &ValidationError{Name: "callback_url", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.callback_url": %w`, err)}
+		}
+	}
+	if v, ok := bsu.mutation.Secret(); ok {
+		if err := botsubscription.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bsu *BotSubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(botsubscription.Table, botsubscription.Columns, sqlgraph.NewFieldSpec(botsubscription.FieldID, field.TypeInt))
+	if ps := bsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bsu.mutation.BotID(); ok {
+		_spec.SetField(botsubscription.FieldBotID, field.TypeString, value)
+	}
+	if value, ok := bsu.mutation.BotName(); ok {
+		_spec.SetField(botsubscription.FieldBotName, field.TypeString, value)
+	}
+	if value, ok := bsu.mutation.RoomID(); ok {
+		_spec.SetField(botsubscription.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := bsu.mutation.CallbackURL(); ok {
+		_spec.SetField(botsubscription.FieldCallbackURL, field.TypeString, value)
+	}
+	if value, ok := bsu.mutation.Secret(); ok {
+		_spec.SetField(botsubscription.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := bsu.mutation.Events(); ok {
+		_spec.SetField(botsubscription.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := bsu.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, botsubscription.FieldEvents, value)
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{botsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bsu.mutation.done = true
+	return n, nil
+}
+
+// BotSubscriptionUpdateOne is the builder for updating a single BotSubscription entity.
+type BotSubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BotSubscriptionMutation
+}
+
+// SetBotID sets the "bot_id" field.
+func (bsuo *BotSubscriptionUpdateOne) SetBotID(s string) *BotSubscriptionUpdateOne {
+	bsuo.mutation.SetBotID(s)
+	return bsuo
+}
+
+// SetNillableBotID sets the "bot_id" field if the given value is not nil.
+func (bsuo *BotSubscriptionUpdateOne) SetNillableBotID(s *string) *BotSubscriptionUpdateOne {
+	if s != nil {
+		bsuo.SetBotID(*s)
+	}
+	return bsuo
+}
+
+// SetBotName sets the "bot_name" field.
+func (bsuo *BotSubscriptionUpdateOne) SetBotName(s string) *BotSubscriptionUpdateOne {
+	bsuo.mutation.SetBotName(s)
+	return bsuo
+}
+
+// SetNillableBotName sets the "bot_name" field if the given value is not nil.
+func (bsuo *BotSubscriptionUpdateOne) SetNillableBotName(s *string) *BotSubscriptionUpdateOne {
+	if s != nil {
+		bsuo.SetBotName(*s)
+	}
+	return bsuo
+}
+
+// SetRoomID sets the "room_id" field.
+func (bsuo *BotSubscriptionUpdateOne) SetRoomID(s string) *BotSubscriptionUpdateOne {
+	bsuo.mutation.SetRoomID(s)
+	return bsuo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (bsuo *BotSubscriptionUpdateOne) SetNillableRoomID(s *string) *BotSubscriptionUpdateOne {
+	if s != nil {
+		bsuo.SetRoomID(*s)
+	}
+	return bsuo
+}
+
+// SetCallbackURL sets the "callback_url" field.
+func (bsuo *BotSubscriptionUpdateOne) SetCallbackURL(s string) *BotSubscriptionUpdateOne {
+	bsuo.mutation.SetCallbackURL(s)
+	return bsuo
+}
+
+// SetNillableCallbackURL sets the "callback_url" field if the given value is not nil.
+func (bsuo *BotSubscriptionUpdateOne) SetNillableCallbackURL(s *string) *BotSubscriptionUpdateOne {
+	if s != nil {
+		bsuo.SetCallbackURL(*s)
+	}
+	return bsuo
+}
+
+// SetSecret sets the "secret" field.
+func (bsuo *BotSubscriptionUpdateOne) SetSecret(s string) *BotSubscriptionUpdateOne {
+	bsuo.mutation.SetSecret(s)
+	return bsuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (bsuo *BotSubscriptionUpdateOne) SetNillableSecret(s *string) *BotSubscriptionUpdateOne {
+	if s != nil {
+		bsuo.SetSecret(*s)
+	}
+	return bsuo
+}
+
+// SetEvents sets the "events" field.
+func (bsuo *BotSubscriptionUpdateOne) SetEvents(s []string) *BotSubscriptionUpdateOne {
+	bsuo.mutation.SetEvents(s)
+	return bsuo
+}
+
+// AppendEvents appends s to the "events" field.
+func (bsuo *BotSubscriptionUpdateOne) AppendEvents(s []string) *BotSubscriptionUpdateOne {
+	bsuo.mutation.AppendEvents(s)
+	return bsuo
+}
+
+// Mutation returns the BotSubscriptionMutation object of the builder.
+func (bsuo *BotSubscriptionUpdateOne) Mutation() *BotSubscriptionMutation {
+	return bsuo.mutation
+}
+
+// Where appends a list predicates to the BotSubscriptionUpdate builder.
+func (bsuo *BotSubscriptionUpdateOne) Where(ps ...predicate.BotSubscription) *BotSubscriptionUpdateOne {
+	bsuo.mutation.Where(ps...)
+	return bsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bsuo *BotSubscriptionUpdateOne) Select(field string, fields ...string) *BotSubscriptionUpdateOne {
+	bsuo.fields = append([]string{field}, fields...)
+	return bsuo
+}
+
+// Save executes the query and returns the updated BotSubscription entity.
+func (bsuo *BotSubscriptionUpdateOne) Save(ctx context.Context) (*BotSubscription, error) {
+	return withHooks(ctx, bsuo.sqlSave, bsuo.mutation, bsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bsuo *BotSubscriptionUpdateOne) SaveX(ctx context.Context) *BotSubscription {
+	node, err := bsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bsuo *BotSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := bsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsuo *BotSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := bsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsuo *BotSubscriptionUpdateOne) check() error {
+	if v, ok := bsuo.mutation.BotID(); ok {
+		if err := botsubscription.BotIDValidator(v); err != nil {
+			return &ValidationError{Name: "bot_id", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.bot_id": %w`, err)}
+		}
+	}
+	if v, ok := bsuo.mutation.BotName(); ok {
+		if err := botsubscription.BotNameValidator(v); err != nil {
+			return &ValidationError{Name: "bot_name", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.bot_name": %w`, err)}
+		}
+	}
+	if v, ok := bsuo.mutation.RoomID(); ok {
+		if err := botsubscription.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.room_id": %w`, err)}
+		}
+	}
+	if v, ok := bsuo.mutation.CallbackURL(); ok {
+		if err := botsubscription.CallbackURLValidator(v); err != nil {
+			return &ValidationError{Name: "callback_url", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.callback_url": %w`, err)}
+		}
+	}
+	if v, ok := bsuo.mutation.Secret(); ok {
+		if err := botsubscription.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "BotSubscription.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bsuo *BotSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *BotSubscription, err error) {
+	if err := bsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(botsubscription.Table, botsubscription.Columns, sqlgraph.NewFieldSpec(botsubscription.FieldID, field.TypeInt))
+	id, ok := bsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BotSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, botsubscription.FieldID)
+		for _, f := range fields {
+			if !botsubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != botsubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bsuo.mutation.BotID(); ok {
+		_spec.SetField(botsubscription.FieldBotID, field.TypeString, value)
+	}
+	if value, ok := bsuo.mutation.BotName(); ok {
+		_spec.SetField(botsubscription.FieldBotName, field.TypeString, value)
+	}
+	if value, ok := bsuo.mutation.RoomID(); ok {
+		_spec.SetField(botsubscription.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := bsuo.mutation.CallbackURL(); ok {
+		_spec.SetField(botsubscription.FieldCallbackURL, field.TypeString, value)
+	}
+	if value, ok := bsuo.mutation.Secret(); ok {
+		_spec.SetField(botsubscription.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := bsuo.mutation.Events(); ok {
+		_spec.SetField(botsubscription.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := bsuo.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, botsubscription.FieldEvents, value)
+		})
+	}
+	_node = &BotSubscription{config: bsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{botsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bsuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callparticipant"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callsession"
+)
+
+// CallParticipantCreate is the builder for creating a CallParticipant entity.
+type CallParticipantCreate struct {
+	config
+	mutation *CallParticipantMutation
+	hooks    []Hook
+}
+
+// SetCallID sets the "call_id" field.
+func (cpc *CallParticipantCreate) SetCallID(i int) *CallParticipantCreate {
+	cpc.mutation.SetCallID(i)
+	return cpc
+}
+
+// SetUserID sets the "user_id" field.
+func (cpc *CallParticipantCreate) SetUserID(s string) *CallParticipantCreate {
+	cpc.mutation.SetUserID(s)
+	return cpc
+}
+
+// SetUsername sets the "username" field.
+func (cpc *CallParticipantCreate) SetUsername(s string) *CallParticipantCreate {
+	cpc.mutation.SetUsername(s)
+	return cpc
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (cpc *CallParticipantCreate) SetJoinedAt(t time.Time) *CallParticipantCreate {
+	cpc.mutation.SetJoinedAt(t)
+	return cpc
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (cpc *CallParticipantCreate) SetNillableJoinedAt(t *time.Time) *CallParticipantCreate {
+	if t != nil {
+		cpc.SetJoinedAt(*t)
+	}
+	return cpc
+}
+
+// SetLeftAt sets the "left_at" field.
+func (cpc *CallParticipantCreate) SetLeftAt(t time.Time) *CallParticipantCreate {
+	cpc.mutation.SetLeftAt(t)
+	return cpc
+}
+
+// SetNillableLeftAt sets the "left_at" field if the given value is not nil.
+func (cpc *CallParticipantCreate) SetNillableLeftAt(t *time.Time) *CallParticipantCreate {
+	if t != nil {
+		cpc.SetLeftAt(*t)
+	}
+	return cpc
+}
+
+// SetCall sets the "call" edge to the CallSession entity.
+func (cpc *CallParticipantCreate) SetCall(c *CallSession) *CallParticipantCreate {
+	return cpc.SetCallID(c.ID)
+}
+
+// Mutation returns the CallParticipantMutation object of the builder.
+func (cpc *CallParticipantCreate) Mutation() *CallParticipantMutation {
+	return cpc.mutation
+}
+
+// Save creates the CallParticipant in the database.
+func (cpc *CallParticipantCreate) Save(ctx context.Context) (*CallParticipant, error) {
+	cpc.defaults()
+	return withHooks(ctx, cpc.sqlSave, cpc.mutation, cpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cpc *CallParticipantCreate) SaveX(ctx context.Context) *CallParticipant {
+	v, err := cpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cpc *CallParticipantCreate) Exec(ctx context.Context) error {
+	_, err := cpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpc *CallParticipantCreate) ExecX(ctx context.Context) {
+	if err := cpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cpc *CallParticipantCreate) defaults() {
+	if _, ok := cpc.mutation.JoinedAt(); !ok {
+		v := callparticipant.DefaultJoinedAt()
+		cpc.mutation.SetJoinedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cpc *CallParticipantCreate) check() error {
+	if _, ok := cpc.mutation.CallID(); !ok {
+		return &ValidationError{Name: "call_id", err: errors.New(`ent: missing required field "CallParticipant.call_id"`)}
+	}
+	if _, ok := cpc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "CallParticipant.user_id"`)}
+	}
+	if v, ok := cpc.mutation.UserID(); ok {
+		if err := callparticipant.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "CallParticipant.user_id": %w`, err)}
+		}
+	}
+	if _, ok := cpc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "CallParticipant.username"`)}
+	}
+	if v, ok := cpc.mutation.Username(); ok {
+		if err := callparticipant.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "CallParticipant.username": %w`, err)}
+		}
+	}
+	if _, ok := cpc.mutation.JoinedAt(); !ok {
+		return &ValidationError{Name: "joined_at", err: errors.New(`ent: missing required field "CallParticipant.joined_at"`)}
+	}
+	if len(cpc.mutation.CallIDs()) == 0 {
+		return &ValidationError{Name: "call", err: errors.New(`ent: missing required edge "CallParticipant.call"`)}
+	}
+	return nil
+}
+
+func (cpc *CallParticipantCreate) sqlSave(ctx context.Context) (*CallParticipant, error) {
+	if err := cpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cpc.mutation.id = &_node.ID
+	cpc.mutation.done = true
+	return _node, nil
+}
+
+func (cpc *CallParticipantCreate) createSpec() (*CallParticipant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CallParticipant{config: cpc.config}
+		_spec = sqlgraph.NewCreateSpec(callparticipant.Table, sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt))
+	)
+	if value, ok := cpc.mutation.UserID(); ok {
+		_spec.SetField(callparticipant.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := cpc.mutation.Username(); ok {
+		_spec.SetField(callparticipant.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := cpc.mutation.JoinedAt(); ok {
+		_spec.SetField(callparticipant.FieldJoinedAt, field.TypeTime, value)
+		_node.JoinedAt = value
+	}
+	if value, ok := cpc.mutation.LeftAt(); ok {
+		_spec.SetField(callparticipant.FieldLeftAt, field.TypeTime, value)
+		_node.LeftAt = &value
+	}
+	if nodes := cpc.mutation.CallIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   callparticipant.CallTable,
+			Columns: []string{callparticipant.CallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CallID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CallParticipantCreateBulk is the builder for creating many CallParticipant entities in bulk.
+type CallParticipantCreateBulk struct {
+	config
+	err      error
+	builders []*CallParticipantCreate
+}
+
+// Save creates the CallParticipant entities in the database.
+func (cpcb *CallParticipantCreateBulk) Save(ctx context.Context) ([]*CallParticipant, error) {
+	if cpcb.err != nil {
+		return nil, cpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cpcb.builders))
+	nodes := make([]*CallParticipant, len(cpcb.builders))
+	mutators := make([]Mutator, len(cpcb.builders))
+	for i := range cpcb.builders {
+		func(i int, root context.Context) {
+			builder := cpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CallParticipantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpcb *CallParticipantCreateBulk) SaveX(ctx context.Context) []*CallParticipant {
+	v, err := cpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cpcb *CallParticipantCreateBulk) Exec(ctx context.Context) error {
+	_, err := cpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpcb *CallParticipantCreateBulk) ExecX(ctx context.Context) {
+	if err := cpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
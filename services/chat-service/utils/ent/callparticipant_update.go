@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callparticipant"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callsession"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// CallParticipantUpdate is the builder for updating CallParticipant entities.
+type CallParticipantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CallParticipantMutation
+}
+
+// Where appends a list predicates to the CallParticipantUpdate builder.
+func (cpu *CallParticipantUpdate) Where(ps ...predicate.CallParticipant) *CallParticipantUpdate {
+	cpu.mutation.Where(ps...)
+	return cpu
+}
+
+// SetCallID sets the "call_id" field.
+func (cpu *CallParticipantUpdate) SetCallID(i int) *CallParticipantUpdate {
+	cpu.mutation.SetCallID(i)
+	return cpu
+}
+
+// SetNillableCallID sets the "call_id" field if the given value is not nil.
+func (cpu *CallParticipantUpdate) SetNillableCallID(i *int) *CallParticipantUpdate {
+	if i != nil {
+		cpu.SetCallID(*i)
+	}
+	return cpu
+}
+
+// SetUserID sets the "user_id" field.
+func (cpu *CallParticipantUpdate) SetUserID(s string) *CallParticipantUpdate {
+	cpu.mutation.SetUserID(s)
+	return cpu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cpu *CallParticipantUpdate) SetNillableUserID(s *string) *CallParticipantUpdate {
+	if s != nil {
+		cpu.SetUserID(*s)
+	}
+	return cpu
+}
+
+// SetUsername sets the "username" field.
+func (cpu *CallParticipantUpdate) SetUsername(s string) *CallParticipantUpdate {
+	cpu.mutation.SetUsername(s)
+	return cpu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (cpu *CallParticipantUpdate) SetNillableUsername(s *string) *CallParticipantUpdate {
+	if s != nil {
+		cpu.SetUsername(*s)
+	}
+	return cpu
+}
+
+// SetLeftAt sets the "left_at" field.
+func (cpu *CallParticipantUpdate) SetLeftAt(t time.Time) *CallParticipantUpdate {
+	cpu.mutation.SetLeftAt(t)
+	return cpu
+}
+
+// SetNillableLeftAt sets the "left_at" field if the given value is not nil.
+func (cpu *CallParticipantUpdate) SetNillableLeftAt(t *time.Time) *CallParticipantUpdate {
+	if t != nil {
+		cpu.SetLeftAt(*t)
+	}
+	return cpu
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (cpu *CallParticipantUpdate) ClearLeftAt() *CallParticipantUpdate {
+	cpu.mutation.ClearLeftAt()
+	return cpu
+}
+
+// SetCall sets the "call" edge to the CallSession entity.
+func (cpu *CallParticipantUpdate) SetCall(c *CallSession) *CallParticipantUpdate {
+	return cpu.SetCallID(c.ID)
+}
+
+// Mutation returns the CallParticipantMutation object of the builder.
+func (cpu *CallParticipantUpdate) Mutation() *CallParticipantMutation {
+	return cpu.mutation
+}
+
+// ClearCall clears the "call" edge to the CallSession entity.
+func (cpu *CallParticipantUpdate) ClearCall() *CallParticipantUpdate {
+	cpu.mutation.ClearCall()
+	return cpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cpu *CallParticipantUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cpu.sqlSave, cpu.mutation, cpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpu *CallParticipantUpdate) SaveX(ctx context.Context) int {
+	affected, err := cpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cpu *CallParticipantUpdate) Exec(ctx context.Context) error {
+	_, err := cpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpu *CallParticipantUpdate) ExecX(ctx context.Context) {
+	if err := cpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cpu *CallParticipantUpdate) check() error {
+	if v, ok := cpu.mutation.UserID(); ok {
+		if err := callparticipant.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "CallParticipant.user_id": %w`, err)}
+		}
+	}
+	if v, ok := cpu.mutation.Username(); ok {
+		if err := callparticipant.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "CallParticipant.username": %w`, err)}
+		}
+	}
+	if cpu.mutation.CallCleared() && len(cpu.mutation.CallIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CallParticipant.call"`)
+	}
+	return nil
+}
+
+func (cpu *CallParticipantUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(callparticipant.Table, callparticipant.Columns, sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt))
+	if ps := cpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cpu.mutation.UserID(); ok {
+		_spec.SetField(callparticipant.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := cpu.mutation.Username(); ok {
+		_spec.SetField(callparticipant.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := cpu.mutation.LeftAt(); ok {
+		_spec.SetField(callparticipant.FieldLeftAt, field.TypeTime, value)
+	}
+	if cpu.mutation.LeftAtCleared() {
+		_spec.ClearField(callparticipant.FieldLeftAt, field.TypeTime)
+	}
+	if cpu.mutation.CallCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   callparticipant.CallTable,
+			Columns: []string{callparticipant.CallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callsession.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cpu.mutation.CallIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   callparticipant.CallTable,
+			Columns: []string{callparticipant.CallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{callparticipant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cpu.mutation.done = true
+	return n, nil
+}
+
+// CallParticipantUpdateOne is the builder for updating a single CallParticipant entity.
+type CallParticipantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CallParticipantMutation
+}
+
+// SetCallID sets the "call_id" field.
+func (cpuo *CallParticipantUpdateOne) SetCallID(i int) *CallParticipantUpdateOne {
+	cpuo.mutation.SetCallID(i)
+	return cpuo
+}
+
+// SetNillableCallID sets the "call_id" field if the given value is not nil.
+func (cpuo *CallParticipantUpdateOne) SetNillableCallID(i *int) *CallParticipantUpdateOne {
+	if i != nil {
+		cpuo.SetCallID(*i)
+	}
+	return cpuo
+}
+
+// SetUserID sets the "user_id" field.
+func (cpuo *CallParticipantUpdateOne) SetUserID(s string) *CallParticipantUpdateOne {
+	cpuo.mutation.SetUserID(s)
+	return cpuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cpuo *CallParticipantUpdateOne) SetNillableUserID(s *string) *CallParticipantUpdateOne {
+	if s != nil {
+		cpuo.SetUserID(*s)
+	}
+	return cpuo
+}
+
+// SetUsername sets the "username" field.
+func (cpuo *CallParticipantUpdateOne) SetUsername(s string) *CallParticipantUpdateOne {
+	cpuo.mutation.SetUsername(s)
+	return cpuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (cpuo *CallParticipantUpdateOne) SetNillableUsername(s *string) *CallParticipantUpdateOne {
+	if s != nil {
+		cpuo.SetUsername(*s)
+	}
+	return cpuo
+}
+
+// SetLeftAt sets the "left_at" field.
+func (cpuo *CallParticipantUpdateOne) SetLeftAt(t time.Time) *CallParticipantUpdateOne {
+	cpuo.mutation.SetLeftAt(t)
+	return cpuo
+}
+
+// SetNillableLeftAt sets the "left_at" field if the given value is not nil.
+func (cpuo *CallParticipantUpdateOne) SetNillableLeftAt(t *time.Time) *CallParticipantUpdateOne {
+	if t != nil {
+		cpuo.SetLeftAt(*t)
+	}
+	return cpuo
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (cpuo *CallParticipantUpdateOne) ClearLeftAt() *CallParticipantUpdateOne {
+	cpuo.mutation.ClearLeftAt()
+	return cpuo
+}
+
+// SetCall sets the "call" edge to the CallSession entity.
+func (cpuo *CallParticipantUpdateOne) SetCall(c *CallSession) *CallParticipantUpdateOne {
+	return cpuo.SetCallID(c.ID)
+}
+
+// Mutation returns the CallParticipantMutation object of the builder.
+func (cpuo *CallParticipantUpdateOne) Mutation() *CallParticipantMutation {
+	return cpuo.mutation
+}
+
+// ClearCall clears the "call" edge to the CallSession entity.
+func (cpuo *CallParticipantUpdateOne) ClearCall() *CallParticipantUpdateOne {
+	cpuo.mutation.ClearCall()
+	return cpuo
+}
+
+// Where appends a list predicates to the CallParticipantUpdate builder.
+func (cpuo *CallParticipantUpdateOne) Where(ps ...predicate.CallParticipant) *CallParticipantUpdateOne {
+	cpuo.mutation.Where(ps...)
+	return cpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cpuo *CallParticipantUpdateOne) Select(field string, fields ...string) *CallParticipantUpdateOne {
+	cpuo.fields = append([]string{field}, fields...)
+	return cpuo
+}
+
+// Save executes the query and returns the updated CallParticipant entity.
+func (cpuo *CallParticipantUpdateOne) Save(ctx context.Context) (*CallParticipant, error) {
+	return withHooks(ctx, cpuo.sqlSave, cpuo.mutation, cpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpuo *CallParticipantUpdateOne) SaveX(ctx context.Context) *CallParticipant {
+	node, err := cpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cpuo *CallParticipantUpdateOne) Exec(ctx context.Context) error {
+	_, err := cpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpuo *CallParticipantUpdateOne) ExecX(ctx context.Context) {
+	if err := cpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cpuo *CallParticipantUpdateOne) check() error {
+	if v, ok := cpuo.mutation.UserID(); ok {
+		if err := callparticipant.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "CallParticipant.user_id": %w`, err)}
+		}
+	}
+	if v, ok := cpuo.mutation.Username(); ok {
+		if err := callparticipant.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "CallParticipant.username": %w`, err)}
+		}
+	}
+	if cpuo.mutation.CallCleared() && len(cpuo.mutation.CallIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CallParticipant.call"`)
+	}
+	return nil
+}
+
+func (cpuo *CallParticipantUpdateOne) sqlSave(ctx context.Context) (_node *CallParticipant, err error) {
+	if err := cpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(callparticipant.Table, callparticipant.Columns, sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt))
+	id, ok := cpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CallParticipant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, callparticipant.FieldID)
+		for _, f := range fields {
+			if !callparticipant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != callparticipant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cpuo.mutation.UserID(); ok {
+		_spec.SetField(callparticipant.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := cpuo.mutation.Username(); ok {
+		_spec.SetField(callparticipant.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := cpuo.mutation.LeftAt(); ok {
+		_spec.SetField(callparticipant.FieldLeftAt, field.TypeTime, value)
+	}
+	if cpuo.mutation.LeftAtCleared() {
+		_spec.ClearField(callparticipant.FieldLeftAt, field.TypeTime)
+	}
+	if cpuo.mutation.CallCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   callparticipant.CallTable,
+			Columns: []string{callparticipant.CallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callsession.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cpuo.mutation.CallIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   callparticipant.CallTable,
+			Columns: []string{callparticipant.CallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CallParticipant{config: cpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{callparticipant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cpuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callsession"
+)
+
+// CallSession is the model entity for the CallSession schema.
+type CallSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// RoomID holds the value of the "room_id" field.
+	RoomID string `json:"room_id,omitempty"`
+	// StartedBy holds the value of the "started_by" field.
+	StartedBy string `json:"started_by,omitempty"`
+	// StartedByName holds the value of the "started_by_name" field.
+	StartedByName string `json:"started_by_name,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CallSessionQuery when eager-loading is set.
+	Edges        CallSessionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CallSessionEdges holds the relations/edges for other nodes in the graph.
+type CallSessionEdges struct {
+	// Participants holds the value of the participants edge.
+	Participants []*CallParticipant `json:"participants,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ParticipantsOrErr returns the Participants value or an error if the edge
+// was not loaded in eager-loading.
+func (e CallSessionEdges) ParticipantsOrErr() ([]*CallParticipant, error) {
+	if e.loadedTypes[0] {
+		return e.Participants, nil
+	}
+	return nil, &NotLoadedError{edge: "participants"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CallSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case callsession.FieldID:
+			values[i] = new(sql.NullInt64)
+		case callsession.FieldRoomID, callsession.FieldStartedBy, callsession.FieldStartedByName:
+			values[i] = new(sql.NullString)
+		case callsession.FieldStartedAt, callsession.FieldEndedAt, callsession.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CallSession fields.
+func (cs *CallSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case callsession.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cs.ID = int(value.Int64)
+		case callsession.FieldRoomID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value.Valid {
+				cs.RoomID = value.String
+			}
+		case callsession.FieldStartedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field started_by", values[i])
+			} else if value.Valid {
+				cs.StartedBy = value.String
+			}
+		case callsession.FieldStartedByName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field started_by_name", values[i])
+			} else if value.Valid {
+				cs.StartedByName = value.String
+			}
+		case callsession.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				cs.StartedAt = value.Time
+			}
+		case callsession.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				cs.EndedAt = new(time.Time)
+				*cs.EndedAt = value.Time
+			}
+		case callsession.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cs.UpdatedAt = value.Time
+			}
+		default:
+			cs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CallSession.
+// This includes values selected through modifiers, order, etc.
+func (cs *CallSession) Value(name string) (ent.Value, error) {
+	return cs.selectValues.Get(name)
+}
+
+// QueryParticipants queries the "participants" edge of the CallSession entity.
+func (cs *CallSession) QueryParticipants() *CallParticipantQuery {
+	return NewCallSessionClient(cs.config).QueryParticipants(cs)
+}
+
+// Update returns a builder for updating this CallSession.
+// Note that you need to call CallSession.Unwrap() before calling this method if this CallSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cs *CallSession) Update() *CallSessionUpdateOne {
+	return NewCallSessionClient(cs.config).UpdateOne(cs)
+}
+
+// Unwrap unwraps the CallSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cs *CallSession) Unwrap() *CallSession {
+	_tx, ok := cs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CallSession is not a transactional entity")
+	}
+	cs.config.driver = _tx.drv
+	return cs
+}
+
+// String implements the fmt.Stringer.
+func (cs *CallSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("CallSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cs.ID))
+	builder.WriteString("room_id=")
+	builder.WriteString(cs.RoomID)
+	builder.WriteString(", ")
+	builder.WriteString("started_by=")
+	builder.WriteString(cs.StartedBy)
+	builder.WriteString(", ")
+	builder.WriteString("started_by_name=")
+	builder.WriteString(cs.StartedByName)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(cs.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := cs.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cs.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CallSessions is a parsable slice of CallSession.
+type CallSessions []*CallSession
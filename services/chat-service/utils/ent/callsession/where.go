@@ -0,0 +1,449 @@
+// Code generated by ent, DO NOT EDIT.
+
+package callsession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CallSession {
+	return predicate.CallSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CallSession {
+	return predicate.CallSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CallSession {
+	return predicate.CallSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CallSession {
+	return predicate.CallSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CallSession {
+	return predicate.CallSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CallSession {
+	return predicate.CallSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CallSession {
+	return predicate.CallSession(sql.FieldLTE(FieldID, id))
+}
+
+// RoomID applies equality check predicate on the "room_id" field. It's identical to RoomIDEQ.
+func RoomID(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEQ(FieldRoomID, v))
+}
+
+// StartedBy applies equality check predicate on the "started_by" field. It's identical to StartedByEQ.
+func StartedBy(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEQ(FieldStartedBy, v))
+}
+
+// StartedByName applies equality check predicate on the "started_by_name" field. It's identical to StartedByNameEQ.
+func StartedByName(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEQ(FieldStartedByName, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// RoomIDEQ applies the EQ predicate on the "room_id" field.
+func RoomIDEQ(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEQ(FieldRoomID, v))
+}
+
+// RoomIDNEQ applies the NEQ predicate on the "room_id" field.
+func RoomIDNEQ(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldNEQ(FieldRoomID, v))
+}
+
+// RoomIDIn applies the In predicate on the "room_id" field.
+func RoomIDIn(vs ...string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldIn(FieldRoomID, vs...))
+}
+
+// RoomIDNotIn applies the NotIn predicate on the "room_id" field.
+func RoomIDNotIn(vs ...string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldNotIn(FieldRoomID, vs...))
+}
+
+// RoomIDGT applies the GT predicate on the "room_id" field.
+func RoomIDGT(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldGT(FieldRoomID, v))
+}
+
+// RoomIDGTE applies the GTE predicate on the "room_id" field.
+func RoomIDGTE(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldGTE(FieldRoomID, v))
+}
+
+// RoomIDLT applies the LT predicate on the "room_id" field.
+func RoomIDLT(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldLT(FieldRoomID, v))
+}
+
+// RoomIDLTE applies the LTE predicate on the "room_id" field.
+func RoomIDLTE(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldLTE(FieldRoomID, v))
+}
+
+// RoomIDContains applies the Contains predicate on the "room_id" field.
+func RoomIDContains(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldContains(FieldRoomID, v))
+}
+
+// RoomIDHasPrefix applies the HasPrefix predicate on the "room_id" field.
+func RoomIDHasPrefix(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldHasPrefix(FieldRoomID, v))
+}
+
+// RoomIDHasSuffix applies the HasSuffix predicate on the "room_id" field.
+func RoomIDHasSuffix(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldHasSuffix(FieldRoomID, v))
+}
+
+// RoomIDEqualFold applies the EqualFold predicate on the "room_id" field.
+func RoomIDEqualFold(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEqualFold(FieldRoomID, v))
+}
+
+// RoomIDContainsFold applies the ContainsFold predicate on the "room_id" field.
+func RoomIDContainsFold(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldContainsFold(FieldRoomID, v))
+}
+
+// StartedByEQ applies the EQ predicate on the "started_by" field.
+func StartedByEQ(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEQ(FieldStartedBy, v))
+}
+
+// StartedByNEQ applies the NEQ predicate on the "started_by" field.
+func StartedByNEQ(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldNEQ(FieldStartedBy, v))
+}
+
+// StartedByIn applies the In predicate on the "started_by" field.
+func StartedByIn(vs ...string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldIn(FieldStartedBy, vs...))
+}
+
+// StartedByNotIn applies the NotIn predicate on the "started_by" field.
+func StartedByNotIn(vs ...string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldNotIn(FieldStartedBy, vs...))
+}
+
+// StartedByGT applies the GT predicate on the "started_by" field.
+func StartedByGT(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldGT(FieldStartedBy, v))
+}
+
+// StartedByGTE applies the GTE predicate on the "started_by" field.
+func StartedByGTE(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldGTE(FieldStartedBy, v))
+}
+
+// StartedByLT applies the LT predicate on the "started_by" field.
+func StartedByLT(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldLT(FieldStartedBy, v))
+}
+
+// StartedByLTE applies the LTE predicate on the "started_by" field.
+func StartedByLTE(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldLTE(FieldStartedBy, v))
+}
+
+// StartedByContains applies the Contains predicate on the "started_by" field.
+func StartedByContains(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldContains(FieldStartedBy, v))
+}
+
+// StartedByHasPrefix applies the HasPrefix predicate on the "started_by" field.
+func StartedByHasPrefix(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldHasPrefix(FieldStartedBy, v))
+}
+
+// StartedByHasSuffix applies the HasSuffix predicate on the "started_by" field.
+func StartedByHasSuffix(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldHasSuffix(FieldStartedBy, v))
+}
+
+// StartedByEqualFold applies the EqualFold predicate on the "started_by" field.
+func StartedByEqualFold(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEqualFold(FieldStartedBy, v))
+}
+
+// StartedByContainsFold applies the ContainsFold predicate on the "started_by" field.
+func StartedByContainsFold(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldContainsFold(FieldStartedBy, v))
+}
+
+// StartedByNameEQ applies the EQ predicate on the "started_by_name" field.
+func StartedByNameEQ(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEQ(FieldStartedByName, v))
+}
+
+// StartedByNameNEQ applies the NEQ predicate on the "started_by_name" field.
+func StartedByNameNEQ(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldNEQ(FieldStartedByName, v))
+}
+
+// StartedByNameIn applies the In predicate on the "started_by_name" field.
+func StartedByNameIn(vs ...string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldIn(FieldStartedByName, vs...))
+}
+
+// StartedByNameNotIn applies the NotIn predicate on the "started_by_name" field.
+func StartedByNameNotIn(vs ...string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldNotIn(FieldStartedByName, vs...))
+}
+
+// StartedByNameGT applies the GT predicate on the "started_by_name" field.
+func StartedByNameGT(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldGT(FieldStartedByName, v))
+}
+
+// StartedByNameGTE applies the GTE predicate on the "started_by_name" field.
+func StartedByNameGTE(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldGTE(FieldStartedByName, v))
+}
+
+// StartedByNameLT applies the LT predicate on the "started_by_name" field.
+func StartedByNameLT(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldLT(FieldStartedByName, v))
+}
+
+// StartedByNameLTE applies the LTE predicate on the "started_by_name" field.
+func StartedByNameLTE(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldLTE(FieldStartedByName, v))
+}
+
+// StartedByNameContains applies the Contains predicate on the "started_by_name" field.
+func StartedByNameContains(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldContains(FieldStartedByName, v))
+}
+
+// StartedByNameHasPrefix applies the HasPrefix predicate on the "started_by_name" field.
+func StartedByNameHasPrefix(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldHasPrefix(FieldStartedByName, v))
+}
+
+// StartedByNameHasSuffix applies the HasSuffix predicate on the "started_by_name" field.
+func StartedByNameHasSuffix(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldHasSuffix(FieldStartedByName, v))
+}
+
+// StartedByNameEqualFold applies the EqualFold predicate on the "started_by_name" field.
+func StartedByNameEqualFold(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEqualFold(FieldStartedByName, v))
+}
+
+// StartedByNameContainsFold applies the ContainsFold predicate on the "started_by_name" field.
+func StartedByNameContainsFold(v string) predicate.CallSession {
+	return predicate.CallSession(sql.FieldContainsFold(FieldStartedByName, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.CallSession {
+	return predicate.CallSession(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.CallSession {
+	return predicate.CallSession(sql.FieldNotNull(FieldEndedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CallSession {
+	return predicate.CallSession(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasParticipants applies the HasEdge predicate on the "participants" edge.
+func HasParticipants() predicate.CallSession {
+	return predicate.CallSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ParticipantsTable, ParticipantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParticipantsWith applies the HasEdge predicate on the "participants" edge with a given conditions (other predicates).
+func HasParticipantsWith(preds ...predicate.CallParticipant) predicate.CallSession {
+	return predicate.CallSession(func(s *sql.Selector) {
+		step := newParticipantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CallSession) predicate.CallSession {
+	return predicate.CallSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CallSession) predicate.CallSession {
+	return predicate.CallSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CallSession) predicate.CallSession {
+	return predicate.CallSession(sql.NotPredicates(p))
+}
@@ -0,0 +1,331 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callparticipant"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callsession"
+)
+
+// CallSessionCreate is the builder for creating a CallSession entity.
+type CallSessionCreate struct {
+	config
+	mutation *CallSessionMutation
+	hooks    []Hook
+}
+
+// SetRoomID sets the "room_id" field.
+func (csc *CallSessionCreate) SetRoomID(s string) *CallSessionCreate {
+	csc.mutation.SetRoomID(s)
+	return csc
+}
+
+// SetStartedBy sets the "started_by" field.
+func (csc *CallSessionCreate) SetStartedBy(s string) *CallSessionCreate {
+	csc.mutation.SetStartedBy(s)
+	return csc
+}
+
+// SetStartedByName sets the "started_by_name" field.
+func (csc *CallSessionCreate) SetStartedByName(s string) *CallSessionCreate {
+	csc.mutation.SetStartedByName(s)
+	return csc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (csc *CallSessionCreate) SetStartedAt(t time.Time) *CallSessionCreate {
+	csc.mutation.SetStartedAt(t)
+	return csc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (csc *CallSessionCreate) SetNillableStartedAt(t *time.Time) *CallSessionCreate {
+	if t != nil {
+		csc.SetStartedAt(*t)
+	}
+	return csc
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (csc *CallSessionCreate) SetEndedAt(t time.Time) *CallSessionCreate {
+	csc.mutation.SetEndedAt(t)
+	return csc
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (csc *CallSessionCreate) SetNillableEndedAt(t *time.Time) *CallSessionCreate {
+	if t != nil {
+		csc.SetEndedAt(*t)
+	}
+	return csc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (csc *CallSessionCreate) SetUpdatedAt(t time.Time) *CallSessionCreate {
+	csc.mutation.SetUpdatedAt(t)
+	return csc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (csc *CallSessionCreate) SetNillableUpdatedAt(t *time.Time) *CallSessionCreate {
+	if t != nil {
+		csc.SetUpdatedAt(*t)
+	}
+	return csc
+}
+
+// AddParticipantIDs adds the "participants" edge to the CallParticipant entity by IDs.
+func (csc *CallSessionCreate) AddParticipantIDs(ids ...int) *CallSessionCreate {
+	csc.mutation.AddParticipantIDs(ids...)
+	return csc
+}
+
+// AddParticipants adds the "participants" edges to the CallParticipant entity.
+func (csc *CallSessionCreate) AddParticipants(c ...*CallParticipant) *CallSessionCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csc.AddParticipantIDs(ids...)
+}
+
+// Mutation returns the CallSessionMutation object of the builder.
+func (csc *CallSessionCreate) Mutation() *CallSessionMutation {
+	return csc.mutation
+}
+
+// Save creates the CallSession in the database.
+func (csc *CallSessionCreate) Save(ctx context.Context) (*CallSession, error) {
+	csc.defaults()
+	return withHooks(ctx, csc.sqlSave, csc.mutation, csc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (csc *CallSessionCreate) SaveX(ctx context.Context) *CallSession {
+	v, err := csc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (csc *CallSessionCreate) Exec(ctx context.Context) error {
+	_, err := csc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csc *CallSessionCreate) ExecX(ctx context.Context) {
+	if err := csc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csc *CallSessionCreate) defaults() {
+	if _, ok := csc.mutation.StartedAt(); !ok {
+		v := callsession.DefaultStartedAt()
+		csc.mutation.SetStartedAt(v)
+	}
+	if _, ok := csc.mutation.UpdatedAt(); !ok {
+		v := callsession.DefaultUpdatedAt()
+		csc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csc *CallSessionCreate) check() error {
+	if _, ok := csc.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room_id", err: errors.New(`ent: missing required field "CallSession.room_id"`)}
+	}
+	if v, ok := csc.mutation.RoomID(); ok {
+		if err := callsession.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "CallSession.room_id": %w`, err)}
+		}
+	}
+	if _, ok := csc.mutation.StartedBy(); !ok {
+		return &ValidationError{Name: "started_by", err: errors.New(`ent: missing required field "CallSession.started_by"`)}
+	}
+	if v, ok := csc.mutation.StartedBy(); ok {
+		if err := callsession.StartedByValidator(v); err != nil {
+			return &ValidationError{Name: "started_by", err: fmt.Errorf(`ent: validator failed for field "CallSession.started_by": %w`, err)}
+		}
+	}
+	if _, ok := csc.mutation.StartedByName(); !ok {
+		return &ValidationError{Name: "started_by_name", err: errors.New(`ent: missing required field "CallSession.started_by_name"`)}
+	}
+	if v, ok := csc.mutation.StartedByName(); ok {
+		if err := callsession.StartedByNameValidator(v); err != nil {
+			return &ValidationError{Name: "started_by_name", err: fmt.Errorf(`ent: validator failed for field "CallSession.started_by_name": %w`, err)}
+		}
+	}
+	if _, ok := csc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "CallSession.started_at"`)}
+	}
+	if _, ok := csc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CallSession.updated_at"`)}
+	}
+	return nil
+}
+
+func (csc *CallSessionCreate) sqlSave(ctx context.Context) (*CallSession, error) {
+	if err := csc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := csc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, csc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	csc.mutation.id = &_node.ID
+	csc.mutation.done = true
+	return _node, nil
+}
+
+func (csc *CallSessionCreate) createSpec() (*CallSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CallSession{config: csc.config}
+		_spec = sqlgraph.NewCreateSpec(callsession.Table, sqlgraph.NewFieldSpec(callsession.FieldID, field.TypeInt))
+	)
+	if value, ok := csc.mutation.RoomID(); ok {
+		_spec.SetField(callsession.FieldRoomID, field.TypeString, value)
+		_node.RoomID = value
+	}
+	if value, ok := csc.mutation.StartedBy(); ok {
+		_spec.SetField(callsession.FieldStartedBy, field.TypeString, value)
+		_node.StartedBy = value
+	}
+	if value, ok := csc.mutation.StartedByName(); ok {
+		_spec.SetField(callsession.FieldStartedByName, field.TypeString, value)
+		_node.StartedByName = value
+	}
+	if value, ok := csc.mutation.StartedAt(); ok {
+		_spec.SetField(callsession.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := csc.mutation.EndedAt(); ok {
+		_spec.SetField(callsession.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if value, ok := csc.mutation.UpdatedAt(); ok {
+		_spec.SetField(callsession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := csc.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   callsession.ParticipantsTable,
+			Columns: []string{callsession.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CallSessionCreateBulk is the builder for creating many CallSession entities in bulk.
+type CallSessionCreateBulk struct {
+	config
+	err      error
+	builders []*CallSessionCreate
+}
+
+// Save creates the CallSession entities in the database.
+func (cscb *CallSessionCreateBulk) Save(ctx context.Context) ([]*CallSession, error) {
+	if cscb.err != nil {
+		return nil, cscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cscb.builders))
+	nodes := make([]*CallSession, len(cscb.builders))
+	mutators := make([]Mutator, len(cscb.builders))
+	for i := range cscb.builders {
+		func(i int, root context.Context) {
+			builder := cscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CallSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cscb *CallSessionCreateBulk) SaveX(ctx context.Context) []*CallSession {
+	v, err := cscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cscb *CallSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := cscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cscb *CallSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := cscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callsession"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// CallSessionDelete is the builder for deleting a CallSession entity.
+type CallSessionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CallSessionMutation
+}
+
+// Where appends a list predicates to the CallSessionDelete builder.
+func (csd *CallSessionDelete) Where(ps ...predicate.CallSession) *CallSessionDelete {
+	csd.mutation.Where(ps...)
+	return csd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (csd *CallSessionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, csd.sqlExec, csd.mutation, csd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csd *CallSessionDelete) ExecX(ctx context.Context) int {
+	n, err := csd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (csd *CallSessionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(callsession.Table, sqlgraph.NewFieldSpec(callsession.FieldID, field.TypeInt))
+	if ps := csd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, csd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	csd.mutation.done = true
+	return affected, err
+}
+
+// CallSessionDeleteOne is the builder for deleting a single CallSession entity.
+type CallSessionDeleteOne struct {
+	csd *CallSessionDelete
+}
+
+// Where appends a list predicates to the CallSessionDelete builder.
+func (csdo *CallSessionDeleteOne) Where(ps ...predicate.CallSession) *CallSessionDeleteOne {
+	csdo.csd.mutation.Where(ps...)
+	return csdo
+}
+
+// Exec executes the deletion query.
+func (csdo *CallSessionDeleteOne) Exec(ctx context.Context) error {
+	n, err := csdo.csd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{callsession.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csdo *CallSessionDeleteOne) ExecX(ctx context.Context) {
+	if err := csdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
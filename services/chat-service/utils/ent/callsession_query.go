@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callparticipant"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callsession"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// CallSessionQuery is the builder for querying CallSession entities.
+type CallSessionQuery struct {
+	config
+	ctx              *QueryContext
+	order            []callsession.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.CallSession
+	withParticipants *CallParticipantQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CallSessionQuery builder.
+func (csq *CallSessionQuery) Where(ps ...predicate.CallSession) *CallSessionQuery {
+	csq.predicates = append(csq.predicates, ps...)
+	return csq
+}
+
+// Limit the number of records to be returned by this query.
+func (csq *CallSessionQuery) Limit(limit int) *CallSessionQuery {
+	csq.ctx.Limit = &limit
+	return csq
+}
+
+// Offset to start from.
+func (csq *CallSessionQuery) Offset(offset int) *CallSessionQuery {
+	csq.ctx.Offset = &offset
+	return csq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (csq *CallSessionQuery) Unique(unique bool) *CallSessionQuery {
+	csq.ctx.Unique = &unique
+	return csq
+}
+
+// Order specifies how the records should be ordered.
+func (csq *CallSessionQuery) Order(o ...callsession.OrderOption) *CallSessionQuery {
+	csq.order = append(csq.order, o...)
+	return csq
+}
+
+// QueryParticipants chains the current query on the "participants" edge.
+func (csq *CallSessionQuery) QueryParticipants() *CallParticipantQuery {
+	query := (&CallParticipantClient{config: csq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := csq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := csq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(callsession.Table, callsession.FieldID, selector),
+			sqlgraph.To(callparticipant.Table, callparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, callsession.ParticipantsTable, callsession.ParticipantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(csq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CallSession entity from the query.
+// Returns a *NotFoundError when no CallSession was found.
+func (csq *CallSessionQuery) First(ctx context.Context) (*CallSession, error) {
+	nodes, err := csq.Limit(1).All(setContextOp(ctx, csq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{callsession.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (csq *CallSessionQuery) FirstX(ctx context.Context) *CallSession {
+	node, err := csq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CallSession ID from the query.
+// Returns a *NotFoundError when no CallSession ID was found.
+func (csq *CallSessionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = csq.Limit(1).IDs(setContextOp(ctx, csq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{callsession.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (csq *CallSessionQuery) FirstIDX(ctx context.Context) int {
+	id, err := csq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CallSession entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CallSession entity is found.
+// Returns a *NotFoundError when no CallSession entities are found.
+func (csq *CallSessionQuery) Only(ctx context.Context) (*CallSession, error) {
+	nodes, err := csq.Limit(2).All(setContextOp(ctx, csq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{callsession.Label}
+	default:
+		return nil, &NotSingularError{callsession.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (csq *CallSessionQuery) OnlyX(ctx context.Context) *CallSession {
+	node, err := csq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CallSession ID in the query.
+// Returns a *NotSingularError when more than one CallSession ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (csq *CallSessionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = csq.Limit(2).IDs(setContextOp(ctx, csq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{callsession.Label}
+	default:
+		err = &NotSingularError{callsession.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (csq *CallSessionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := csq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CallSessions.
+func (csq *CallSessionQuery) All(ctx context.Context) ([]*CallSession, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryAll)
+	if err := csq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CallSession, *CallSessionQuery]()
+	return withInterceptors[[]*CallSession](ctx, csq, qr, csq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (csq *CallSessionQuery) AllX(ctx context.Context) []*CallSession {
+	nodes, err := csq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CallSession IDs.
+func (csq *CallSessionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if csq.ctx.Unique == nil && csq.path != nil {
+		csq.Unique(true)
+	}
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryIDs)
+	if err = csq.Select(callsession.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (csq *CallSessionQuery) IDsX(ctx context.Context) []int {
+	ids, err := csq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (csq *CallSessionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryCount)
+	if err := csq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, csq, querierCount[*CallSessionQuery](), csq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (csq *CallSessionQuery) CountX(ctx context.Context) int {
+	count, err := csq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (csq *CallSessionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryExist)
+	switch _, err := csq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (csq *CallSessionQuery) ExistX(ctx context.Context) bool {
+	exist, err := csq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CallSessionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (csq *CallSessionQuery) Clone() *CallSessionQuery {
+	if csq == nil {
+		return nil
+	}
+	return &CallSessionQuery{
+		config:           csq.config,
+		ctx:              csq.ctx.Clone(),
+		order:            append([]callsession.OrderOption{}, csq.order...),
+		inters:           append([]Interceptor{}, csq.inters...),
+		predicates:       append([]predicate.CallSession{}, csq.predicates...),
+		withParticipants: csq.withParticipants.Clone(),
+		// clone intermediate query.
+		sql:  csq.sql.Clone(),
+		path: csq.path,
+	}
+}
+
+// WithParticipants tells the query-builder to eager-load the nodes that are connected to
+// the "participants" edge. The optional arguments are used to configure the query builder of the edge.
+func (csq *CallSessionQuery) WithParticipants(opts ...func(*CallParticipantQuery)) *CallSessionQuery {
+	query := (&CallParticipantClient{config: csq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	csq.withParticipants = query
+	return csq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		RoomID string `json:"room_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CallSession.Query().
+//		GroupBy(callsession.FieldRoomID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (csq *CallSessionQuery) GroupBy(field string, fields ...string) *CallSessionGroupBy {
+	csq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CallSessionGroupBy{build: csq}
+	grbuild.flds = &csq.ctx.Fields
+	grbuild.label = callsession.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		RoomID string `json:"room_id,omitempty"`
+//	}
+//
+//	client.CallSession.Query().
+//		Select(callsession.FieldRoomID).
+//		Scan(ctx, &v)
+func (csq *CallSessionQuery) Select(fields ...string) *CallSessionSelect {
+	csq.ctx.Fields = append(csq.ctx.Fields, fields...)
+	sbuild := &CallSessionSelect{CallSessionQuery: csq}
+	sbuild.label = callsession.Label
+	sbuild.flds, sbuild.scan = &csq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CallSessionSelect configured with the given aggregations.
+func (csq *CallSessionQuery) Aggregate(fns ...AggregateFunc) *CallSessionSelect {
+	return csq.Select().Aggregate(fns...)
+}
+
+func (csq *CallSessionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range csq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, csq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range csq.ctx.Fields {
+		if !callsession.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if csq.path != nil {
+		prev, err := csq.path(ctx)
+		if err != nil {
+			return err
+		}
+		csq.sql = prev
+	}
+	return nil
+}
+
+func (csq *CallSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CallSession, error) {
+	var (
+		nodes       = []*CallSession{}
+		_spec       = csq.querySpec()
+		loadedTypes = [1]bool{
+			csq.withParticipants != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CallSession).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CallSession{config: csq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, csq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := csq.withParticipants; query != nil {
+		if err := csq.loadParticipants(ctx, query, nodes,
+			func(n *CallSession) { n.Edges.Participants = []*CallParticipant{} },
+			func(n *CallSession, e *CallParticipant) { n.Edges.Participants = append(n.Edges.Participants, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (csq *CallSessionQuery) loadParticipants(ctx context.Context, query *CallParticipantQuery, nodes []*CallSession, init func(*CallSession), assign func(*CallSession, *CallParticipant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*CallSession)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(callparticipant.FieldCallID)
+	}
+	query.Where(predicate.CallParticipant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(callsession.ParticipantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CallID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "call_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (csq *CallSessionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := csq.querySpec()
+	_spec.Node.Columns = csq.ctx.Fields
+	if len(csq.ctx.Fields) > 0 {
+		_spec.Unique = csq.ctx.Unique != nil && *csq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, csq.driver, _spec)
+}
+
+func (csq *CallSessionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(callsession.Table, callsession.Columns, sqlgraph.NewFieldSpec(callsession.FieldID, field.TypeInt))
+	_spec.From = csq.sql
+	if unique := csq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if csq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := csq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, callsession.FieldID)
+		for i := range fields {
+			if fields[i] != callsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := csq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := csq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := csq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := csq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (csq *CallSessionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(csq.driver.Dialect())
+	t1 := builder.Table(callsession.Table)
+	columns := csq.ctx.Fields
+	if len(columns) == 0 {
+		columns = callsession.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if csq.sql != nil {
+		selector = csq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if csq.ctx.Unique != nil && *csq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range csq.predicates {
+		p(selector)
+	}
+	for _, p := range csq.order {
+		p(selector)
+	}
+	if offset := csq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := csq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CallSessionGroupBy is the group-by builder for CallSession entities.
+type CallSessionGroupBy struct {
+	selector
+	build *CallSessionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (csgb *CallSessionGroupBy) Aggregate(fns ...AggregateFunc) *CallSessionGroupBy {
+	csgb.fns = append(csgb.fns, fns...)
+	return csgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (csgb *CallSessionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, csgb.build.ctx, ent.OpQueryGroupBy)
+	if err := csgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CallSessionQuery, *CallSessionGroupBy](ctx, csgb.build, csgb, csgb.build.inters, v)
+}
+
+func (csgb *CallSessionGroupBy) sqlScan(ctx context.Context, root *CallSessionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(csgb.fns))
+	for _, fn := range csgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*csgb.flds)+len(csgb.fns))
+		for _, f := range *csgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*csgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := csgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CallSessionSelect is the builder for selecting fields of CallSession entities.
+type CallSessionSelect struct {
+	*CallSessionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (css *CallSessionSelect) Aggregate(fns ...AggregateFunc) *CallSessionSelect {
+	css.fns = append(css.fns, fns...)
+	return css
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (css *CallSessionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, css.ctx, ent.OpQuerySelect)
+	if err := css.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CallSessionQuery, *CallSessionSelect](ctx, css.CallSessionQuery, css, css.inters, v)
+}
+
+func (css *CallSessionSelect) sqlScan(ctx context.Context, root *CallSessionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(css.fns))
+	for _, fn := range css.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*css.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := css.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
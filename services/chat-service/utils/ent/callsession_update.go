@@ -0,0 +1,575 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callparticipant"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callsession"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// CallSessionUpdate is the builder for updating CallSession entities.
+type CallSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CallSessionMutation
+}
+
+// Where appends a list predicates to the CallSessionUpdate builder.
+func (csu *CallSessionUpdate) Where(ps ...predicate.CallSession) *CallSessionUpdate {
+	csu.mutation.Where(ps...)
+	return csu
+}
+
+// SetRoomID sets the "room_id" field.
+func (csu *CallSessionUpdate) SetRoomID(s string) *CallSessionUpdate {
+	csu.mutation.SetRoomID(s)
+	return csu
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (csu *CallSessionUpdate) SetNillableRoomID(s *string) *CallSessionUpdate {
+	if s != nil {
+		csu.SetRoomID(*s)
+	}
+	return csu
+}
+
+// SetStartedBy sets the "started_by" field.
+func (csu *CallSessionUpdate) SetStartedBy(s string) *CallSessionUpdate {
+	csu.mutation.SetStartedBy(s)
+	return csu
+}
+
+// SetNillableStartedBy sets the "started_by" field if the given value is not nil.
+func (csu *CallSessionUpdate) SetNillableStartedBy(s *string) *CallSessionUpdate {
+	if s != nil {
+		csu.SetStartedBy(*s)
+	}
+	return csu
+}
+
+// SetStartedByName sets the "started_by_name" field.
+func (csu *CallSessionUpdate) SetStartedByName(s string) *CallSessionUpdate {
+	csu.mutation.SetStartedByName(s)
+	return csu
+}
+
+// SetNillableStartedByName sets the "started_by_name" field if the given value is not nil.
+func (csu *CallSessionUpdate) SetNillableStartedByName(s *string) *CallSessionUpdate {
+	if s != nil {
+		csu.SetStartedByName(*s)
+	}
+	return csu
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (csu *CallSessionUpdate) SetEndedAt(t time.Time) *CallSessionUpdate {
+	csu.mutation.SetEndedAt(t)
+	return csu
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (csu *CallSessionUpdate) SetNillableEndedAt(t *time.Time) *CallSessionUpdate {
+	if t != nil {
+		csu.SetEndedAt(*t)
+	}
+	return csu
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (csu *CallSessionUpdate) ClearEndedAt() *CallSessionUpdate {
+	csu.mutation.ClearEndedAt()
+	return csu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (csu *CallSessionUpdate) SetUpdatedAt(t time.Time) *CallSessionUpdate {
+	csu.mutation.SetUpdatedAt(t)
+	return csu
+}
+
+// AddParticipantIDs adds the "participants" edge to the CallParticipant entity by IDs.
+func (csu *CallSessionUpdate) AddParticipantIDs(ids ...int) *CallSessionUpdate {
+	csu.mutation.AddParticipantIDs(ids...)
+	return csu
+}
+
+// AddParticipants adds the "participants" edges to the CallParticipant entity.
+func (csu *CallSessionUpdate) AddParticipants(c ...*CallParticipant) *CallSessionUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csu.AddParticipantIDs(ids...)
+}
+
+// Mutation returns the CallSessionMutation object of the builder.
+func (csu *CallSessionUpdate) Mutation() *CallSessionMutation {
+	return csu.mutation
+}
+
+// ClearParticipants clears all "participants" edges to the CallParticipant entity.
+func (csu *CallSessionUpdate) ClearParticipants() *CallSessionUpdate {
+	csu.mutation.ClearParticipants()
+	return csu
+}
+
+// RemoveParticipantIDs removes the "participants" edge to CallParticipant entities by IDs.
+func (csu *CallSessionUpdate) RemoveParticipantIDs(ids ...int) *CallSessionUpdate {
+	csu.mutation.RemoveParticipantIDs(ids...)
+	return csu
+}
+
+// RemoveParticipants removes "participants" edges to CallParticipant entities.
+func (csu *CallSessionUpdate) RemoveParticipants(c ...*CallParticipant) *CallSessionUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csu.RemoveParticipantIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (csu *CallSessionUpdate) Save(ctx context.Context) (int, error) {
+	csu.defaults()
+	return withHooks(ctx, csu.sqlSave, csu.mutation, csu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csu *CallSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := csu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (csu *CallSessionUpdate) Exec(ctx context.Context) error {
+	_, err := csu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csu *CallSessionUpdate) ExecX(ctx context.Context) {
+	if err := csu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csu *CallSessionUpdate) defaults() {
+	if _, ok := csu.mutation.UpdatedAt(); !ok {
+		v := callsession.UpdateDefaultUpdatedAt()
+		csu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csu *CallSessionUpdate) check() error {
+	if v, ok := csu.mutation.RoomID(); ok {
+		if err := callsession.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "CallSession.room_id": %w`, err)}
+		}
+	}
+	if v, ok := csu.mutation.StartedBy(); ok {
+		if err := callsession.StartedByValidator(v); err != nil {
+			return &ValidationError{Name: "started_by", err: fmt.Errorf(`ent: validator failed for field "CallSession.started_by": %w`, err)}
+		}
+	}
+	if v, ok := csu.mutation.StartedByName(); ok {
+		if err := callsession.StartedByNameValidator(v); err != nil {
+			return &ValidationError{Name: "started_by_name", err: fmt.Errorf(`ent: validator failed for field "CallSession.started_by_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (csu *CallSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := csu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(callsession.Table, callsession.Columns, sqlgraph.NewFieldSpec(callsession.FieldID, field.TypeInt))
+	if ps := csu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csu.mutation.RoomID(); ok {
+		_spec.SetField(callsession.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := csu.mutation.StartedBy(); ok {
+		_spec.SetField(callsession.FieldStartedBy, field.TypeString, value)
+	}
+	if value, ok := csu.mutation.StartedByName(); ok {
+		_spec.SetField(callsession.FieldStartedByName, field.TypeString, value)
+	}
+	if value, ok := csu.mutation.EndedAt(); ok {
+		_spec.SetField(callsession.FieldEndedAt, field.TypeTime, value)
+	}
+	if csu.mutation.EndedAtCleared() {
+		_spec.ClearField(callsession.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := csu.mutation.UpdatedAt(); ok {
+		_spec.SetField(callsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if csu.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   callsession.ParticipantsTable,
+			Columns: []string{callsession.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csu.mutation.RemovedParticipantsIDs(); len(nodes) > 0 && !csu.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   callsession.ParticipantsTable,
+			Columns: []string{callsession.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csu.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   callsession.ParticipantsTable,
+			Columns: []string{callsession.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, csu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{callsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	csu.mutation.done = true
+	return n, nil
+}
+
+// CallSessionUpdateOne is the builder for updating a single CallSession entity.
+type CallSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CallSessionMutation
+}
+
+// SetRoomID sets the "room_id" field.
+func (csuo *CallSessionUpdateOne) SetRoomID(s string) *CallSessionUpdateOne {
+	csuo.mutation.SetRoomID(s)
+	return csuo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (csuo *CallSessionUpdateOne) SetNillableRoomID(s *string) *CallSessionUpdateOne {
+	if s != nil {
+		csuo.SetRoomID(*s)
+	}
+	return csuo
+}
+
+// SetStartedBy sets the "started_by" field.
+func (csuo *CallSessionUpdateOne) SetStartedBy(s string) *CallSessionUpdateOne {
+	csuo.mutation.SetStartedBy(s)
+	return csuo
+}
+
+// SetNillableStartedBy sets the "started_by" field if the given value is not nil.
+func (csuo *CallSessionUpdateOne) SetNillableStartedBy(s *string) *CallSessionUpdateOne {
+	if s != nil {
+		csuo.SetStartedBy(*s)
+	}
+	return csuo
+}
+
+// SetStartedByName sets the "started_by_name" field.
+func (csuo *CallSessionUpdateOne) SetStartedByName(s string) *CallSessionUpdateOne {
+	csuo.mutation.SetStartedByName(s)
+	return csuo
+}
+
+// SetNillableStartedByName sets the "started_by_name" field if the given value is not nil.
+func (csuo *CallSessionUpdateOne) SetNillableStartedByName(s *string) *CallSessionUpdateOne {
+	if s != nil {
+		csuo.SetStartedByName(*s)
+	}
+	return csuo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (csuo *CallSessionUpdateOne) SetEndedAt(t time.Time) *CallSessionUpdateOne {
+	csuo.mutation.SetEndedAt(t)
+	return csuo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (csuo *CallSessionUpdateOne) SetNillableEndedAt(t *time.Time) *CallSessionUpdateOne {
+	if t != nil {
+		csuo.SetEndedAt(*t)
+	}
+	return csuo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (csuo *CallSessionUpdateOne) ClearEndedAt() *CallSessionUpdateOne {
+	csuo.mutation.ClearEndedAt()
+	return csuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (csuo *CallSessionUpdateOne) SetUpdatedAt(t time.Time) *CallSessionUpdateOne {
+	csuo.mutation.SetUpdatedAt(t)
+	return csuo
+}
+
+// AddParticipantIDs adds the "participants" edge to the CallParticipant entity by IDs.
+func (csuo *CallSessionUpdateOne) AddParticipantIDs(ids ...int) *CallSessionUpdateOne {
+	csuo.mutation.AddParticipantIDs(ids...)
+	return csuo
+}
+
+// AddParticipants adds the "participants" edges to the CallParticipant entity.
+func (csuo *CallSessionUpdateOne) AddParticipants(c ...*CallParticipant) *CallSessionUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csuo.AddParticipantIDs(ids...)
+}
+
+// Mutation returns the CallSessionMutation object of the builder.
+func (csuo *CallSessionUpdateOne) Mutation() *CallSessionMutation {
+	return csuo.mutation
+}
+
+// ClearParticipants clears all "participants" edges to the CallParticipant entity.
+func (csuo *CallSessionUpdateOne) ClearParticipants() *CallSessionUpdateOne {
+	csuo.mutation.ClearParticipants()
+	return csuo
+}
+
+// RemoveParticipantIDs removes the "participants" edge to CallParticipant entities by IDs.
+func (csuo *CallSessionUpdateOne) RemoveParticipantIDs(ids ...int) *CallSessionUpdateOne {
+	csuo.mutation.RemoveParticipantIDs(ids...)
+	return csuo
+}
+
+// RemoveParticipants removes "participants" edges to CallParticipant entities.
+func (csuo *CallSessionUpdateOne) RemoveParticipants(c ...*CallParticipant) *CallSessionUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return csuo.RemoveParticipantIDs(ids...)
+}
+
+// Where appends a list predicates to the CallSessionUpdate builder.
+func (csuo *CallSessionUpdateOne) Where(ps ...predicate.CallSession) *CallSessionUpdateOne {
+	csuo.mutation.Where(ps...)
+	return csuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (csuo *CallSessionUpdateOne) Select(field string, fields ...string) *CallSessionUpdateOne {
+	csuo.fields = append([]string{field}, fields...)
+	return csuo
+}
+
+// Save executes the query and returns the updated CallSession entity.
+func (csuo *CallSessionUpdateOne) Save(ctx context.Context) (*CallSession, error) {
+	csuo.defaults()
+	return withHooks(ctx, csuo.sqlSave, csuo.mutation, csuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csuo *CallSessionUpdateOne) SaveX(ctx context.Context) *CallSession {
+	node, err := csuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (csuo *CallSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := csuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csuo *CallSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := csuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csuo *CallSessionUpdateOne) defaults() {
+	if _, ok := csuo.mutation.UpdatedAt(); !ok {
+		v := callsession.UpdateDefaultUpdatedAt()
+		csuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csuo *CallSessionUpdateOne) check() error {
+	if v, ok := csuo.mutation.RoomID(); ok {
+		if err := callsession.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "CallSession.room_id": %w`, err)}
+		}
+	}
+	if v, ok := csuo.mutation.StartedBy(); ok {
+		if err := callsession.StartedByValidator(v); err != nil {
+			return &ValidationError{Name: "started_by", err: fmt.Errorf(`ent: validator failed for field "CallSession.started_by": %w`, err)}
+		}
+	}
+	if v, ok := csuo.mutation.StartedByName(); ok {
+		if err := callsession.StartedByNameValidator(v); err != nil {
+			return &ValidationError{Name: "started_by_name", err: fmt.Errorf(`ent: validator failed for field "CallSession.started_by_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (csuo *CallSessionUpdateOne) sqlSave(ctx context.Context) (_node *CallSession, err error) {
+	if err := csuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(callsession.Table, callsession.Columns, sqlgraph.NewFieldSpec(callsession.FieldID, field.TypeInt))
+	id, ok := csuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CallSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := csuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, callsession.FieldID)
+		for _, f := range fields {
+			if !callsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != callsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := csuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csuo.mutation.RoomID(); ok {
+		_spec.SetField(callsession.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := csuo.mutation.StartedBy(); ok {
+		_spec.SetField(callsession.FieldStartedBy, field.TypeString, value)
+	}
+	if value, ok := csuo.mutation.StartedByName(); ok {
+		_spec.SetField(callsession.FieldStartedByName, field.TypeString, value)
+	}
+	if value, ok := csuo.mutation.EndedAt(); ok {
+		_spec.SetField(callsession.FieldEndedAt, field.TypeTime, value)
+	}
+	if csuo.mutation.EndedAtCleared() {
+		_spec.ClearField(callsession.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := csuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(callsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if csuo.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   callsession.ParticipantsTable,
+			Columns: []string{callsession.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csuo.mutation.RemovedParticipantsIDs(); len(nodes) > 0 && !csuo.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   callsession.ParticipantsTable,
+			Columns: []string{callsession.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csuo.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   callsession.ParticipantsTable,
+			Columns: []string{callsession.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CallSession{config: csuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, csuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{callsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	csuo.mutation.done = true
+	return _node, nil
+}
@@ -14,8 +14,26 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botdelivery"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botsubscription"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callparticipant"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callsession"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/historyimport"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/messagepurge"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pinnedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pollvote"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/room"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexport"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexportchunk"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roommember"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/savedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/scheduledmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/webhook"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -23,10 +41,40 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// BotDelivery is the client for interacting with the BotDelivery builders.
+	BotDelivery *BotDeliveryClient
+	// BotSubscription is the client for interacting with the BotSubscription builders.
+	BotSubscription *BotSubscriptionClient
+	// CallParticipant is the client for interacting with the CallParticipant builders.
+	CallParticipant *CallParticipantClient
+	// CallSession is the client for interacting with the CallSession builders.
+	CallSession *CallSessionClient
+	// HistoryImport is the client for interacting with the HistoryImport builders.
+	HistoryImport *HistoryImportClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// MessagePurge is the client for interacting with the MessagePurge builders.
+	MessagePurge *MessagePurgeClient
+	// PinnedMessage is the client for interacting with the PinnedMessage builders.
+	PinnedMessage *PinnedMessageClient
+	// Poll is the client for interacting with the Poll builders.
+	Poll *PollClient
+	// PollVote is the client for interacting with the PollVote builders.
+	PollVote *PollVoteClient
 	// Room is the client for interacting with the Room builders.
 	Room *RoomClient
+	// RoomExport is the client for interacting with the RoomExport builders.
+	RoomExport *RoomExportClient
+	// RoomExportChunk is the client for interacting with the RoomExportChunk builders.
+	RoomExportChunk *RoomExportChunkClient
+	// RoomMember is the client for interacting with the RoomMember builders.
+	RoomMember *RoomMemberClient
+	// SavedMessage is the client for interacting with the SavedMessage builders.
+	SavedMessage *SavedMessageClient
+	// ScheduledMessage is the client for interacting with the ScheduledMessage builders.
+	ScheduledMessage *ScheduledMessageClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -38,8 +86,23 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.BotDelivery = NewBotDeliveryClient(c.config)
+	c.BotSubscription = NewBotSubscriptionClient(c.config)
+	c.CallParticipant = NewCallParticipantClient(c.config)
+	c.CallSession = NewCallSessionClient(c.config)
+	c.HistoryImport = NewHistoryImportClient(c.config)
 	c.Message = NewMessageClient(c.config)
+	c.MessagePurge = NewMessagePurgeClient(c.config)
+	c.PinnedMessage = NewPinnedMessageClient(c.config)
+	c.Poll = NewPollClient(c.config)
+	c.PollVote = NewPollVoteClient(c.config)
 	c.Room = NewRoomClient(c.config)
+	c.RoomExport = NewRoomExportClient(c.config)
+	c.RoomExportChunk = NewRoomExportChunkClient(c.config)
+	c.RoomMember = NewRoomMemberClient(c.config)
+	c.SavedMessage = NewSavedMessageClient(c.config)
+	c.ScheduledMessage = NewScheduledMessageClient(c.config)
+	c.Webhook = NewWebhookClient(c.config)
 }
 
 type (
@@ -130,10 +193,25 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Message: NewMessageClient(cfg),
-		Room:    NewRoomClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		BotDelivery:      NewBotDeliveryClient(cfg),
+		BotSubscription:  NewBotSubscriptionClient(cfg),
+		CallParticipant:  NewCallParticipantClient(cfg),
+		CallSession:      NewCallSessionClient(cfg),
+		HistoryImport:    NewHistoryImportClient(cfg),
+		Message:          NewMessageClient(cfg),
+		MessagePurge:     NewMessagePurgeClient(cfg),
+		PinnedMessage:    NewPinnedMessageClient(cfg),
+		Poll:             NewPollClient(cfg),
+		PollVote:         NewPollVoteClient(cfg),
+		Room:             NewRoomClient(cfg),
+		RoomExport:       NewRoomExportClient(cfg),
+		RoomExportChunk:  NewRoomExportChunkClient(cfg),
+		RoomMember:       NewRoomMemberClient(cfg),
+		SavedMessage:     NewSavedMessageClient(cfg),
+		ScheduledMessage: NewScheduledMessageClient(cfg),
+		Webhook:          NewWebhookClient(cfg),
 	}, nil
 }
 
@@ -151,17 +229,32 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Message: NewMessageClient(cfg),
-		Room:    NewRoomClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		BotDelivery:      NewBotDeliveryClient(cfg),
+		BotSubscription:  NewBotSubscriptionClient(cfg),
+		CallParticipant:  NewCallParticipantClient(cfg),
+		CallSession:      NewCallSessionClient(cfg),
+		HistoryImport:    NewHistoryImportClient(cfg),
+		Message:          NewMessageClient(cfg),
+		MessagePurge:     NewMessagePurgeClient(cfg),
+		PinnedMessage:    NewPinnedMessageClient(cfg),
+		Poll:             NewPollClient(cfg),
+		PollVote:         NewPollVoteClient(cfg),
+		Room:             NewRoomClient(cfg),
+		RoomExport:       NewRoomExportClient(cfg),
+		RoomExportChunk:  NewRoomExportChunkClient(cfg),
+		RoomMember:       NewRoomMemberClient(cfg),
+		SavedMessage:     NewSavedMessageClient(cfg),
+		ScheduledMessage: NewScheduledMessageClient(cfg),
+		Webhook:          NewWebhookClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Message.
+//		BotDelivery.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -183,130 +276,172 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Message.Use(hooks...)
-	c.Room.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.BotDelivery, c.BotSubscription, c.CallParticipant, c.CallSession,
+		c.HistoryImport, c.Message, c.MessagePurge, c.PinnedMessage, c.Poll,
+		c.PollVote, c.Room, c.RoomExport, c.RoomExportChunk, c.RoomMember,
+		c.SavedMessage, c.ScheduledMessage, c.Webhook,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Message.Intercept(interceptors...)
-	c.Room.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.BotDelivery, c.BotSubscription, c.CallParticipant, c.CallSession,
+		c.HistoryImport, c.Message, c.MessagePurge, c.PinnedMessage, c.Poll,
+		c.PollVote, c.Room, c.RoomExport, c.RoomExportChunk, c.RoomMember,
+		c.SavedMessage, c.ScheduledMessage, c.Webhook,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *BotDeliveryMutation:
+		return c.BotDelivery.mutate(ctx, m)
+	case *BotSubscriptionMutation:
+		return c.BotSubscription.mutate(ctx, m)
+	case *CallParticipantMutation:
+		return c.CallParticipant.mutate(ctx, m)
+	case *CallSessionMutation:
+		return c.CallSession.mutate(ctx, m)
+	case *HistoryImportMutation:
+		return c.HistoryImport.mutate(ctx, m)
 	case *MessageMutation:
 		return c.Message.mutate(ctx, m)
+	case *MessagePurgeMutation:
+		return c.MessagePurge.mutate(ctx, m)
+	case *PinnedMessageMutation:
+		return c.PinnedMessage.mutate(ctx, m)
+	case *PollMutation:
+		return c.Poll.mutate(ctx, m)
+	case *PollVoteMutation:
+		return c.PollVote.mutate(ctx, m)
 	case *RoomMutation:
 		return c.Room.mutate(ctx, m)
+	case *RoomExportMutation:
+		return c.RoomExport.mutate(ctx, m)
+	case *RoomExportChunkMutation:
+		return c.RoomExportChunk.mutate(ctx, m)
+	case *RoomMemberMutation:
+		return c.RoomMember.mutate(ctx, m)
+	case *SavedMessageMutation:
+		return c.SavedMessage.mutate(ctx, m)
+	case *ScheduledMessageMutation:
+		return c.ScheduledMessage.mutate(ctx, m)
+	case *WebhookMutation:
+		return c.Webhook.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
-// MessageClient is a client for the Message schema.
-type MessageClient struct {
+// BotDeliveryClient is a client for the BotDelivery schema.
+type BotDeliveryClient struct {
 	config
 }
 
-// NewMessageClient returns a client for the Message from the given config.
-func NewMessageClient(c config) *MessageClient {
-	return &MessageClient{config: c}
+// NewBotDeliveryClient returns a client for the BotDelivery from the given config.
+func NewBotDeliveryClient(c config) *BotDeliveryClient {
+	return &BotDeliveryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
-func (c *MessageClient) Use(hooks ...Hook) {
-	c.hooks.Message = append(c.hooks.Message, hooks...)
+// A call to `Use(f, g, h)` equals to `botdelivery.Hooks(f(g(h())))`.
+func (c *BotDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.BotDelivery = append(c.hooks.BotDelivery, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
-func (c *MessageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Message = append(c.inters.Message, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `botdelivery.Intercept(f(g(h())))`.
+func (c *BotDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BotDelivery = append(c.inters.BotDelivery, interceptors...)
 }
 
-// Create returns a builder for creating a Message entity.
-func (c *MessageClient) Create() *MessageCreate {
-	mutation := newMessageMutation(c.config, OpCreate)
-	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BotDelivery entity.
+func (c *BotDeliveryClient) Create() *BotDeliveryCreate {
+	mutation := newBotDeliveryMutation(c.config, OpCreate)
+	return &BotDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Message entities.
-func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
-	return &MessageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BotDelivery entities.
+func (c *BotDeliveryClient) CreateBulk(builders ...*BotDeliveryCreate) *BotDeliveryCreateBulk {
+	return &BotDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+func (c *BotDeliveryClient) MapCreateBulk(slice any, setFunc func(*BotDeliveryCreate, int)) *BotDeliveryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BotDeliveryCreateBulk{err: fmt.Errorf("calling to BotDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MessageCreate, rv.Len())
+	builders := make([]*BotDeliveryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MessageCreateBulk{config: c.config, builders: builders}
+	return &BotDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Message.
-func (c *MessageClient) Update() *MessageUpdate {
-	mutation := newMessageMutation(c.config, OpUpdate)
-	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BotDelivery.
+func (c *BotDeliveryClient) Update() *BotDeliveryUpdate {
+	mutation := newBotDeliveryMutation(c.config, OpUpdate)
+	return &BotDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MessageClient) UpdateOne(m *Message) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(m))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BotDeliveryClient) UpdateOne(bd *BotDelivery) *BotDeliveryUpdateOne {
+	mutation := newBotDeliveryMutation(c.config, OpUpdateOne, withBotDelivery(bd))
+	return &BotDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MessageClient) UpdateOneID(id int) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BotDeliveryClient) UpdateOneID(id int) *BotDeliveryUpdateOne {
+	mutation := newBotDeliveryMutation(c.config, OpUpdateOne, withBotDeliveryID(id))
+	return &BotDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Message.
-func (c *MessageClient) Delete() *MessageDelete {
-	mutation := newMessageMutation(c.config, OpDelete)
-	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BotDelivery.
+func (c *BotDeliveryClient) Delete() *BotDeliveryDelete {
+	mutation := newBotDeliveryMutation(c.config, OpDelete)
+	return &BotDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MessageClient) DeleteOne(m *Message) *MessageDeleteOne {
-	return c.DeleteOneID(m.ID)
+func (c *BotDeliveryClient) DeleteOne(bd *BotDelivery) *BotDeliveryDeleteOne {
+	return c.DeleteOneID(bd.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MessageClient) DeleteOneID(id int) *MessageDeleteOne {
-	builder := c.Delete().Where(message.ID(id))
+func (c *BotDeliveryClient) DeleteOneID(id int) *BotDeliveryDeleteOne {
+	builder := c.Delete().Where(botdelivery.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MessageDeleteOne{builder}
+	return &BotDeliveryDeleteOne{builder}
 }
 
-// Query returns a query builder for Message.
-func (c *MessageClient) Query() *MessageQuery {
-	return &MessageQuery{
+// Query returns a query builder for BotDelivery.
+func (c *BotDeliveryClient) Query() *BotDeliveryQuery {
+	return &BotDeliveryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMessage},
+		ctx:    &QueryContext{Type: TypeBotDelivery},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Message entity by its id.
-func (c *MessageClient) Get(ctx context.Context, id int) (*Message, error) {
-	return c.Query().Where(message.ID(id)).Only(ctx)
+// Get returns a BotDelivery entity by its id.
+func (c *BotDeliveryClient) Get(ctx context.Context, id int) (*BotDelivery, error) {
+	return c.Query().Where(botdelivery.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MessageClient) GetX(ctx context.Context, id int) *Message {
+func (c *BotDeliveryClient) GetX(ctx context.Context, id int) *BotDelivery {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -315,131 +450,131 @@ func (c *MessageClient) GetX(ctx context.Context, id int) *Message {
 }
 
 // Hooks returns the client hooks.
-func (c *MessageClient) Hooks() []Hook {
-	return c.hooks.Message
+func (c *BotDeliveryClient) Hooks() []Hook {
+	return c.hooks.BotDelivery
 }
 
 // Interceptors returns the client interceptors.
-func (c *MessageClient) Interceptors() []Interceptor {
-	return c.inters.Message
+func (c *BotDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.BotDelivery
 }
 
-func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+func (c *BotDeliveryClient) mutate(ctx context.Context, m *BotDeliveryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BotDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BotDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BotDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BotDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BotDelivery mutation op: %q", m.Op())
 	}
 }
 
-// RoomClient is a client for the Room schema.
-type RoomClient struct {
+// BotSubscriptionClient is a client for the BotSubscription schema.
+type BotSubscriptionClient struct {
 	config
 }
 
-// NewRoomClient returns a client for the Room from the given config.
-func NewRoomClient(c config) *RoomClient {
-	return &RoomClient{config: c}
+// NewBotSubscriptionClient returns a client for the BotSubscription from the given config.
+func NewBotSubscriptionClient(c config) *BotSubscriptionClient {
+	return &BotSubscriptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `room.Hooks(f(g(h())))`.
-func (c *RoomClient) Use(hooks ...Hook) {
-	c.hooks.Room = append(c.hooks.Room, hooks...)
+// A call to `Use(f, g, h)` equals to `botsubscription.Hooks(f(g(h())))`.
+func (c *BotSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.BotSubscription = append(c.hooks.BotSubscription, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `room.Intercept(f(g(h())))`.
-func (c *RoomClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Room = append(c.inters.Room, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `botsubscription.Intercept(f(g(h())))`.
+func (c *BotSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BotSubscription = append(c.inters.BotSubscription, interceptors...)
 }
 
-// Create returns a builder for creating a Room entity.
-func (c *RoomClient) Create() *RoomCreate {
-	mutation := newRoomMutation(c.config, OpCreate)
-	return &RoomCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BotSubscription entity.
+func (c *BotSubscriptionClient) Create() *BotSubscriptionCreate {
+	mutation := newBotSubscriptionMutation(c.config, OpCreate)
+	return &BotSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Room entities.
-func (c *RoomClient) CreateBulk(builders ...*RoomCreate) *RoomCreateBulk {
-	return &RoomCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BotSubscription entities.
+func (c *BotSubscriptionClient) CreateBulk(builders ...*BotSubscriptionCreate) *BotSubscriptionCreateBulk {
+	return &BotSubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RoomClient) MapCreateBulk(slice any, setFunc func(*RoomCreate, int)) *RoomCreateBulk {
+func (c *BotSubscriptionClient) MapCreateBulk(slice any, setFunc func(*BotSubscriptionCreate, int)) *BotSubscriptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RoomCreateBulk{err: fmt.Errorf("calling to RoomClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BotSubscriptionCreateBulk{err: fmt.Errorf("calling to BotSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RoomCreate, rv.Len())
+	builders := make([]*BotSubscriptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RoomCreateBulk{config: c.config, builders: builders}
+	return &BotSubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Room.
-func (c *RoomClient) Update() *RoomUpdate {
-	mutation := newRoomMutation(c.config, OpUpdate)
-	return &RoomUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BotSubscription.
+func (c *BotSubscriptionClient) Update() *BotSubscriptionUpdate {
+	mutation := newBotSubscriptionMutation(c.config, OpUpdate)
+	return &BotSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RoomClient) UpdateOne(r *Room) *RoomUpdateOne {
-	mutation := newRoomMutation(c.config, OpUpdateOne, withRoom(r))
-	return &RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BotSubscriptionClient) UpdateOne(bs *BotSubscription) *BotSubscriptionUpdateOne {
+	mutation := newBotSubscriptionMutation(c.config, OpUpdateOne, withBotSubscription(bs))
+	return &BotSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RoomClient) UpdateOneID(id int) *RoomUpdateOne {
-	mutation := newRoomMutation(c.config, OpUpdateOne, withRoomID(id))
-	return &RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BotSubscriptionClient) UpdateOneID(id int) *BotSubscriptionUpdateOne {
+	mutation := newBotSubscriptionMutation(c.config, OpUpdateOne, withBotSubscriptionID(id))
+	return &BotSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Room.
-func (c *RoomClient) Delete() *RoomDelete {
-	mutation := newRoomMutation(c.config, OpDelete)
-	return &RoomDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BotSubscription.
+func (c *BotSubscriptionClient) Delete() *BotSubscriptionDelete {
+	mutation := newBotSubscriptionMutation(c.config, OpDelete)
+	return &BotSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RoomClient) DeleteOne(r *Room) *RoomDeleteOne {
-	return c.DeleteOneID(r.ID)
+func (c *BotSubscriptionClient) DeleteOne(bs *BotSubscription) *BotSubscriptionDeleteOne {
+	return c.DeleteOneID(bs.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RoomClient) DeleteOneID(id int) *RoomDeleteOne {
-	builder := c.Delete().Where(room.ID(id))
+func (c *BotSubscriptionClient) DeleteOneID(id int) *BotSubscriptionDeleteOne {
+	builder := c.Delete().Where(botsubscription.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RoomDeleteOne{builder}
+	return &BotSubscriptionDeleteOne{builder}
 }
 
-// Query returns a query builder for Room.
-func (c *RoomClient) Query() *RoomQuery {
-	return &RoomQuery{
+// Query returns a query builder for BotSubscription.
+func (c *BotSubscriptionClient) Query() *BotSubscriptionQuery {
+	return &BotSubscriptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRoom},
+		ctx:    &QueryContext{Type: TypeBotSubscription},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Room entity by its id.
-func (c *RoomClient) Get(ctx context.Context, id int) (*Room, error) {
-	return c.Query().Where(room.ID(id)).Only(ctx)
+// Get returns a BotSubscription entity by its id.
+func (c *BotSubscriptionClient) Get(ctx context.Context, id int) (*BotSubscription, error) {
+	return c.Query().Where(botsubscription.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RoomClient) GetX(ctx context.Context, id int) *Room {
+func (c *BotSubscriptionClient) GetX(ctx context.Context, id int) *BotSubscription {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -448,36 +583,2220 @@ func (c *RoomClient) GetX(ctx context.Context, id int) *Room {
 }
 
 // Hooks returns the client hooks.
-func (c *RoomClient) Hooks() []Hook {
-	return c.hooks.Room
+func (c *BotSubscriptionClient) Hooks() []Hook {
+	return c.hooks.BotSubscription
 }
 
 // Interceptors returns the client interceptors.
-func (c *RoomClient) Interceptors() []Interceptor {
-	return c.inters.Room
+func (c *BotSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.BotSubscription
 }
 
-func (c *RoomClient) mutate(ctx context.Context, m *RoomMutation) (Value, error) {
+func (c *BotSubscriptionClient) mutate(ctx context.Context, m *BotSubscriptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RoomCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BotSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RoomUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BotSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BotSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RoomDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BotSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Room mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BotSubscription mutation op: %q", m.Op())
 	}
 }
 
-// hooks and interceptors per client, for fast access.
-type (
-	hooks struct {
-		Message, Room []ent.Hook
+// CallParticipantClient is a client for the CallParticipant schema.
+type CallParticipantClient struct {
+	config
+}
+
+// NewCallParticipantClient returns a client for the CallParticipant from the given config.
+func NewCallParticipantClient(c config) *CallParticipantClient {
+	return &CallParticipantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `callparticipant.Hooks(f(g(h())))`.
+func (c *CallParticipantClient) Use(hooks ...Hook) {
+	c.hooks.CallParticipant = append(c.hooks.CallParticipant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `callparticipant.Intercept(f(g(h())))`.
+func (c *CallParticipantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CallParticipant = append(c.inters.CallParticipant, interceptors...)
+}
+
+// Create returns a builder for creating a CallParticipant entity.
+func (c *CallParticipantClient) Create() *CallParticipantCreate {
+	mutation := newCallParticipantMutation(c.config, OpCreate)
+	return &CallParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CallParticipant entities.
+func (c *CallParticipantClient) CreateBulk(builders ...*CallParticipantCreate) *CallParticipantCreateBulk {
+	return &CallParticipantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CallParticipantClient) MapCreateBulk(slice any, setFunc func(*CallParticipantCreate, int)) *CallParticipantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CallParticipantCreateBulk{err: fmt.Errorf("calling to CallParticipantClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	inters struct {
-		Message, Room []ent.Interceptor
+	builders := make([]*CallParticipantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
 	}
-)
+	return &CallParticipantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CallParticipant.
+func (c *CallParticipantClient) Update() *CallParticipantUpdate {
+	mutation := newCallParticipantMutation(c.config, OpUpdate)
+	return &CallParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CallParticipantClient) UpdateOne(cp *CallParticipant) *CallParticipantUpdateOne {
+	mutation := newCallParticipantMutation(c.config, OpUpdateOne, withCallParticipant(cp))
+	return &CallParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CallParticipantClient) UpdateOneID(id int) *CallParticipantUpdateOne {
+	mutation := newCallParticipantMutation(c.config, OpUpdateOne, withCallParticipantID(id))
+	return &CallParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CallParticipant.
+func (c *CallParticipantClient) Delete() *CallParticipantDelete {
+	mutation := newCallParticipantMutation(c.config, OpDelete)
+	return &CallParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CallParticipantClient) DeleteOne(cp *CallParticipant) *CallParticipantDeleteOne {
+	return c.DeleteOneID(cp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CallParticipantClient) DeleteOneID(id int) *CallParticipantDeleteOne {
+	builder := c.Delete().Where(callparticipant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CallParticipantDeleteOne{builder}
+}
+
+// Query returns a query builder for CallParticipant.
+func (c *CallParticipantClient) Query() *CallParticipantQuery {
+	return &CallParticipantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCallParticipant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CallParticipant entity by its id.
+func (c *CallParticipantClient) Get(ctx context.Context, id int) (*CallParticipant, error) {
+	return c.Query().Where(callparticipant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CallParticipantClient) GetX(ctx context.Context, id int) *CallParticipant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCall queries the call edge of a CallParticipant.
+func (c *CallParticipantClient) QueryCall(cp *CallParticipant) *CallSessionQuery {
+	query := (&CallSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(callparticipant.Table, callparticipant.FieldID, id),
+			sqlgraph.To(callsession.Table, callsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, callparticipant.CallTable, callparticipant.CallColumn),
+		)
+		fromV = sqlgraph.Neighbors(cp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CallParticipantClient) Hooks() []Hook {
+	return c.hooks.CallParticipant
+}
+
+// Interceptors returns the client interceptors.
+func (c *CallParticipantClient) Interceptors() []Interceptor {
+	return c.inters.CallParticipant
+}
+
+func (c *CallParticipantClient) mutate(ctx context.Context, m *CallParticipantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CallParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CallParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CallParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CallParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CallParticipant mutation op: %q", m.Op())
+	}
+}
+
+// CallSessionClient is a client for the CallSession schema.
+type CallSessionClient struct {
+	config
+}
+
+// NewCallSessionClient returns a client for the CallSession from the given config.
+func NewCallSessionClient(c config) *CallSessionClient {
+	return &CallSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `callsession.Hooks(f(g(h())))`.
+func (c *CallSessionClient) Use(hooks ...Hook) {
+	c.hooks.CallSession = append(c.hooks.CallSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `callsession.Intercept(f(g(h())))`.
+func (c *CallSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CallSession = append(c.inters.CallSession, interceptors...)
+}
+
+// Create returns a builder for creating a CallSession entity.
+func (c *CallSessionClient) Create() *CallSessionCreate {
+	mutation := newCallSessionMutation(c.config, OpCreate)
+	return &CallSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CallSession entities.
+func (c *CallSessionClient) CreateBulk(builders ...*CallSessionCreate) *CallSessionCreateBulk {
+	return &CallSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CallSessionClient) MapCreateBulk(slice any, setFunc func(*CallSessionCreate, int)) *CallSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CallSessionCreateBulk{err: fmt.Errorf("calling to CallSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CallSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CallSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CallSession.
+func (c *CallSessionClient) Update() *CallSessionUpdate {
+	mutation := newCallSessionMutation(c.config, OpUpdate)
+	return &CallSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CallSessionClient) UpdateOne(cs *CallSession) *CallSessionUpdateOne {
+	mutation := newCallSessionMutation(c.config, OpUpdateOne, withCallSession(cs))
+	return &CallSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CallSessionClient) UpdateOneID(id int) *CallSessionUpdateOne {
+	mutation := newCallSessionMutation(c.config, OpUpdateOne, withCallSessionID(id))
+	return &CallSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CallSession.
+func (c *CallSessionClient) Delete() *CallSessionDelete {
+	mutation := newCallSessionMutation(c.config, OpDelete)
+	return &CallSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CallSessionClient) DeleteOne(cs *CallSession) *CallSessionDeleteOne {
+	return c.DeleteOneID(cs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CallSessionClient) DeleteOneID(id int) *CallSessionDeleteOne {
+	builder := c.Delete().Where(callsession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CallSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for CallSession.
+func (c *CallSessionClient) Query() *CallSessionQuery {
+	return &CallSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCallSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CallSession entity by its id.
+func (c *CallSessionClient) Get(ctx context.Context, id int) (*CallSession, error) {
+	return c.Query().Where(callsession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CallSessionClient) GetX(ctx context.Context, id int) *CallSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryParticipants queries the participants edge of a CallSession.
+func (c *CallSessionClient) QueryParticipants(cs *CallSession) *CallParticipantQuery {
+	query := (&CallParticipantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(callsession.Table, callsession.FieldID, id),
+			sqlgraph.To(callparticipant.Table, callparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, callsession.ParticipantsTable, callsession.ParticipantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CallSessionClient) Hooks() []Hook {
+	return c.hooks.CallSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *CallSessionClient) Interceptors() []Interceptor {
+	return c.inters.CallSession
+}
+
+func (c *CallSessionClient) mutate(ctx context.Context, m *CallSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CallSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CallSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CallSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CallSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CallSession mutation op: %q", m.Op())
+	}
+}
+
+// HistoryImportClient is a client for the HistoryImport schema.
+type HistoryImportClient struct {
+	config
+}
+
+// NewHistoryImportClient returns a client for the HistoryImport from the given config.
+func NewHistoryImportClient(c config) *HistoryImportClient {
+	return &HistoryImportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `historyimport.Hooks(f(g(h())))`.
+func (c *HistoryImportClient) Use(hooks ...Hook) {
+	c.hooks.HistoryImport = append(c.hooks.HistoryImport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `historyimport.Intercept(f(g(h())))`.
+func (c *HistoryImportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HistoryImport = append(c.inters.HistoryImport, interceptors...)
+}
+
+// Create returns a builder for creating a HistoryImport entity.
+func (c *HistoryImportClient) Create() *HistoryImportCreate {
+	mutation := newHistoryImportMutation(c.config, OpCreate)
+	return &HistoryImportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HistoryImport entities.
+func (c *HistoryImportClient) CreateBulk(builders ...*HistoryImportCreate) *HistoryImportCreateBulk {
+	return &HistoryImportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HistoryImportClient) MapCreateBulk(slice any, setFunc func(*HistoryImportCreate, int)) *HistoryImportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HistoryImportCreateBulk{err: fmt.Errorf("calling to HistoryImportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HistoryImportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HistoryImportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HistoryImport.
+func (c *HistoryImportClient) Update() *HistoryImportUpdate {
+	mutation := newHistoryImportMutation(c.config, OpUpdate)
+	return &HistoryImportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HistoryImportClient) UpdateOne(hi *HistoryImport) *HistoryImportUpdateOne {
+	mutation := newHistoryImportMutation(c.config, OpUpdateOne, withHistoryImport(hi))
+	return &HistoryImportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HistoryImportClient) UpdateOneID(id int) *HistoryImportUpdateOne {
+	mutation := newHistoryImportMutation(c.config, OpUpdateOne, withHistoryImportID(id))
+	return &HistoryImportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HistoryImport.
+func (c *HistoryImportClient) Delete() *HistoryImportDelete {
+	mutation := newHistoryImportMutation(c.config, OpDelete)
+	return &HistoryImportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HistoryImportClient) DeleteOne(hi *HistoryImport) *HistoryImportDeleteOne {
+	return c.DeleteOneID(hi.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HistoryImportClient) DeleteOneID(id int) *HistoryImportDeleteOne {
+	builder := c.Delete().Where(historyimport.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HistoryImportDeleteOne{builder}
+}
+
+// Query returns a query builder for HistoryImport.
+func (c *HistoryImportClient) Query() *HistoryImportQuery {
+	return &HistoryImportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHistoryImport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HistoryImport entity by its id.
+func (c *HistoryImportClient) Get(ctx context.Context, id int) (*HistoryImport, error) {
+	return c.Query().Where(historyimport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HistoryImportClient) GetX(ctx context.Context, id int) *HistoryImport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *HistoryImportClient) Hooks() []Hook {
+	return c.hooks.HistoryImport
+}
+
+// Interceptors returns the client interceptors.
+func (c *HistoryImportClient) Interceptors() []Interceptor {
+	return c.inters.HistoryImport
+}
+
+func (c *HistoryImportClient) mutate(ctx context.Context, m *HistoryImportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HistoryImportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HistoryImportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HistoryImportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HistoryImportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HistoryImport mutation op: %q", m.Op())
+	}
+}
+
+// MessageClient is a client for the Message schema.
+type MessageClient struct {
+	config
+}
+
+// NewMessageClient returns a client for the Message from the given config.
+func NewMessageClient(c config) *MessageClient {
+	return &MessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
+func (c *MessageClient) Use(hooks ...Hook) {
+	c.hooks.Message = append(c.hooks.Message, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
+func (c *MessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Message = append(c.inters.Message, interceptors...)
+}
+
+// Create returns a builder for creating a Message entity.
+func (c *MessageClient) Create() *MessageCreate {
+	mutation := newMessageMutation(c.config, OpCreate)
+	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Message entities.
+func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Message.
+func (c *MessageClient) Update() *MessageUpdate {
+	mutation := newMessageMutation(c.config, OpUpdate)
+	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageClient) UpdateOne(m *Message) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(m))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageClient) UpdateOneID(id int) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Message.
+func (c *MessageClient) Delete() *MessageDelete {
+	mutation := newMessageMutation(c.config, OpDelete)
+	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageClient) DeleteOne(m *Message) *MessageDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageClient) DeleteOneID(id int) *MessageDeleteOne {
+	builder := c.Delete().Where(message.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageDeleteOne{builder}
+}
+
+// Query returns a query builder for Message.
+func (c *MessageClient) Query() *MessageQuery {
+	return &MessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Message entity by its id.
+func (c *MessageClient) Get(ctx context.Context, id int) (*Message, error) {
+	return c.Query().Where(message.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageClient) GetX(ctx context.Context, id int) *Message {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPoll queries the poll edge of a Message.
+func (c *MessageClient) QueryPoll(m *Message) *PollQuery {
+	query := (&PollClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(poll.Table, poll.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, message.PollTable, message.PollColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPins queries the pins edge of a Message.
+func (c *MessageClient) QueryPins(m *Message) *PinnedMessageQuery {
+	query := (&PinnedMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(pinnedmessage.Table, pinnedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.PinsTable, message.PinsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySaves queries the saves edge of a Message.
+func (c *MessageClient) QuerySaves(m *Message) *SavedMessageQuery {
+	query := (&SavedMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(savedmessage.Table, savedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.SavesTable, message.SavesColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageClient) Hooks() []Hook {
+	return c.hooks.Message
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageClient) Interceptors() []Interceptor {
+	return c.inters.Message
+}
+
+func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+	}
+}
+
+// MessagePurgeClient is a client for the MessagePurge schema.
+type MessagePurgeClient struct {
+	config
+}
+
+// NewMessagePurgeClient returns a client for the MessagePurge from the given config.
+func NewMessagePurgeClient(c config) *MessagePurgeClient {
+	return &MessagePurgeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagepurge.Hooks(f(g(h())))`.
+func (c *MessagePurgeClient) Use(hooks ...Hook) {
+	c.hooks.MessagePurge = append(c.hooks.MessagePurge, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messagepurge.Intercept(f(g(h())))`.
+func (c *MessagePurgeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessagePurge = append(c.inters.MessagePurge, interceptors...)
+}
+
+// Create returns a builder for creating a MessagePurge entity.
+func (c *MessagePurgeClient) Create() *MessagePurgeCreate {
+	mutation := newMessagePurgeMutation(c.config, OpCreate)
+	return &MessagePurgeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessagePurge entities.
+func (c *MessagePurgeClient) CreateBulk(builders ...*MessagePurgeCreate) *MessagePurgeCreateBulk {
+	return &MessagePurgeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessagePurgeClient) MapCreateBulk(slice any, setFunc func(*MessagePurgeCreate, int)) *MessagePurgeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessagePurgeCreateBulk{err: fmt.Errorf("calling to MessagePurgeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessagePurgeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessagePurgeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessagePurge.
+func (c *MessagePurgeClient) Update() *MessagePurgeUpdate {
+	mutation := newMessagePurgeMutation(c.config, OpUpdate)
+	return &MessagePurgeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessagePurgeClient) UpdateOne(mp *MessagePurge) *MessagePurgeUpdateOne {
+	mutation := newMessagePurgeMutation(c.config, OpUpdateOne, withMessagePurge(mp))
+	return &MessagePurgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessagePurgeClient) UpdateOneID(id int) *MessagePurgeUpdateOne {
+	mutation := newMessagePurgeMutation(c.config, OpUpdateOne, withMessagePurgeID(id))
+	return &MessagePurgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessagePurge.
+func (c *MessagePurgeClient) Delete() *MessagePurgeDelete {
+	mutation := newMessagePurgeMutation(c.config, OpDelete)
+	return &MessagePurgeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessagePurgeClient) DeleteOne(mp *MessagePurge) *MessagePurgeDeleteOne {
+	return c.DeleteOneID(mp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessagePurgeClient) DeleteOneID(id int) *MessagePurgeDeleteOne {
+	builder := c.Delete().Where(messagepurge.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessagePurgeDeleteOne{builder}
+}
+
+// Query returns a query builder for MessagePurge.
+func (c *MessagePurgeClient) Query() *MessagePurgeQuery {
+	return &MessagePurgeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessagePurge},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessagePurge entity by its id.
+func (c *MessagePurgeClient) Get(ctx context.Context, id int) (*MessagePurge, error) {
+	return c.Query().Where(messagepurge.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessagePurgeClient) GetX(ctx context.Context, id int) *MessagePurge {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MessagePurgeClient) Hooks() []Hook {
+	return c.hooks.MessagePurge
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessagePurgeClient) Interceptors() []Interceptor {
+	return c.inters.MessagePurge
+}
+
+func (c *MessagePurgeClient) mutate(ctx context.Context, m *MessagePurgeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessagePurgeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessagePurgeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessagePurgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessagePurgeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessagePurge mutation op: %q", m.Op())
+	}
+}
+
+// PinnedMessageClient is a client for the PinnedMessage schema.
+type PinnedMessageClient struct {
+	config
+}
+
+// NewPinnedMessageClient returns a client for the PinnedMessage from the given config.
+func NewPinnedMessageClient(c config) *PinnedMessageClient {
+	return &PinnedMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pinnedmessage.Hooks(f(g(h())))`.
+func (c *PinnedMessageClient) Use(hooks ...Hook) {
+	c.hooks.PinnedMessage = append(c.hooks.PinnedMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pinnedmessage.Intercept(f(g(h())))`.
+func (c *PinnedMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PinnedMessage = append(c.inters.PinnedMessage, interceptors...)
+}
+
+// Create returns a builder for creating a PinnedMessage entity.
+func (c *PinnedMessageClient) Create() *PinnedMessageCreate {
+	mutation := newPinnedMessageMutation(c.config, OpCreate)
+	return &PinnedMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PinnedMessage entities.
+func (c *PinnedMessageClient) CreateBulk(builders ...*PinnedMessageCreate) *PinnedMessageCreateBulk {
+	return &PinnedMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PinnedMessageClient) MapCreateBulk(slice any, setFunc func(*PinnedMessageCreate, int)) *PinnedMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PinnedMessageCreateBulk{err: fmt.Errorf("calling to PinnedMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PinnedMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PinnedMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PinnedMessage.
+func (c *PinnedMessageClient) Update() *PinnedMessageUpdate {
+	mutation := newPinnedMessageMutation(c.config, OpUpdate)
+	return &PinnedMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PinnedMessageClient) UpdateOne(pm *PinnedMessage) *PinnedMessageUpdateOne {
+	mutation := newPinnedMessageMutation(c.config, OpUpdateOne, withPinnedMessage(pm))
+	return &PinnedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PinnedMessageClient) UpdateOneID(id int) *PinnedMessageUpdateOne {
+	mutation := newPinnedMessageMutation(c.config, OpUpdateOne, withPinnedMessageID(id))
+	return &PinnedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PinnedMessage.
+func (c *PinnedMessageClient) Delete() *PinnedMessageDelete {
+	mutation := newPinnedMessageMutation(c.config, OpDelete)
+	return &PinnedMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PinnedMessageClient) DeleteOne(pm *PinnedMessage) *PinnedMessageDeleteOne {
+	return c.DeleteOneID(pm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PinnedMessageClient) DeleteOneID(id int) *PinnedMessageDeleteOne {
+	builder := c.Delete().Where(pinnedmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PinnedMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for PinnedMessage.
+func (c *PinnedMessageClient) Query() *PinnedMessageQuery {
+	return &PinnedMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePinnedMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PinnedMessage entity by its id.
+func (c *PinnedMessageClient) Get(ctx context.Context, id int) (*PinnedMessage, error) {
+	return c.Query().Where(pinnedmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PinnedMessageClient) GetX(ctx context.Context, id int) *PinnedMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a PinnedMessage.
+func (c *PinnedMessageClient) QueryMessage(pm *PinnedMessage) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pinnedmessage.Table, pinnedmessage.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pinnedmessage.MessageTable, pinnedmessage.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(pm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PinnedMessageClient) Hooks() []Hook {
+	return c.hooks.PinnedMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *PinnedMessageClient) Interceptors() []Interceptor {
+	return c.inters.PinnedMessage
+}
+
+func (c *PinnedMessageClient) mutate(ctx context.Context, m *PinnedMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PinnedMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PinnedMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PinnedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PinnedMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PinnedMessage mutation op: %q", m.Op())
+	}
+}
+
+// PollClient is a client for the Poll schema.
+type PollClient struct {
+	config
+}
+
+// NewPollClient returns a client for the Poll from the given config.
+func NewPollClient(c config) *PollClient {
+	return &PollClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `poll.Hooks(f(g(h())))`.
+func (c *PollClient) Use(hooks ...Hook) {
+	c.hooks.Poll = append(c.hooks.Poll, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `poll.Intercept(f(g(h())))`.
+func (c *PollClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Poll = append(c.inters.Poll, interceptors...)
+}
+
+// Create returns a builder for creating a Poll entity.
+func (c *PollClient) Create() *PollCreate {
+	mutation := newPollMutation(c.config, OpCreate)
+	return &PollCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Poll entities.
+func (c *PollClient) CreateBulk(builders ...*PollCreate) *PollCreateBulk {
+	return &PollCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PollClient) MapCreateBulk(slice any, setFunc func(*PollCreate, int)) *PollCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PollCreateBulk{err: fmt.Errorf("calling to PollClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PollCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PollCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Poll.
+func (c *PollClient) Update() *PollUpdate {
+	mutation := newPollMutation(c.config, OpUpdate)
+	return &PollUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PollClient) UpdateOne(po *Poll) *PollUpdateOne {
+	mutation := newPollMutation(c.config, OpUpdateOne, withPoll(po))
+	return &PollUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PollClient) UpdateOneID(id int) *PollUpdateOne {
+	mutation := newPollMutation(c.config, OpUpdateOne, withPollID(id))
+	return &PollUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Poll.
+func (c *PollClient) Delete() *PollDelete {
+	mutation := newPollMutation(c.config, OpDelete)
+	return &PollDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PollClient) DeleteOne(po *Poll) *PollDeleteOne {
+	return c.DeleteOneID(po.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PollClient) DeleteOneID(id int) *PollDeleteOne {
+	builder := c.Delete().Where(poll.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PollDeleteOne{builder}
+}
+
+// Query returns a query builder for Poll.
+func (c *PollClient) Query() *PollQuery {
+	return &PollQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePoll},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Poll entity by its id.
+func (c *PollClient) Get(ctx context.Context, id int) (*Poll, error) {
+	return c.Query().Where(poll.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PollClient) GetX(ctx context.Context, id int) *Poll {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a Poll.
+func (c *PollClient) QueryMessage(po *Poll) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, poll.MessageTable, poll.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVotes queries the votes edge of a Poll.
+func (c *PollClient) QueryVotes(po *Poll) *PollVoteQuery {
+	query := (&PollVoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, id),
+			sqlgraph.To(pollvote.Table, pollvote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, poll.VotesTable, poll.VotesColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PollClient) Hooks() []Hook {
+	return c.hooks.Poll
+}
+
+// Interceptors returns the client interceptors.
+func (c *PollClient) Interceptors() []Interceptor {
+	return c.inters.Poll
+}
+
+func (c *PollClient) mutate(ctx context.Context, m *PollMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PollCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PollUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PollUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PollDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Poll mutation op: %q", m.Op())
+	}
+}
+
+// PollVoteClient is a client for the PollVote schema.
+type PollVoteClient struct {
+	config
+}
+
+// NewPollVoteClient returns a client for the PollVote from the given config.
+func NewPollVoteClient(c config) *PollVoteClient {
+	return &PollVoteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pollvote.Hooks(f(g(h())))`.
+func (c *PollVoteClient) Use(hooks ...Hook) {
+	c.hooks.PollVote = append(c.hooks.PollVote, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pollvote.Intercept(f(g(h())))`.
+func (c *PollVoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PollVote = append(c.inters.PollVote, interceptors...)
+}
+
+// Create returns a builder for creating a PollVote entity.
+func (c *PollVoteClient) Create() *PollVoteCreate {
+	mutation := newPollVoteMutation(c.config, OpCreate)
+	return &PollVoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PollVote entities.
+func (c *PollVoteClient) CreateBulk(builders ...*PollVoteCreate) *PollVoteCreateBulk {
+	return &PollVoteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PollVoteClient) MapCreateBulk(slice any, setFunc func(*PollVoteCreate, int)) *PollVoteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PollVoteCreateBulk{err: fmt.Errorf("calling to PollVoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PollVoteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PollVoteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PollVote.
+func (c *PollVoteClient) Update() *PollVoteUpdate {
+	mutation := newPollVoteMutation(c.config, OpUpdate)
+	return &PollVoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PollVoteClient) UpdateOne(pv *PollVote) *PollVoteUpdateOne {
+	mutation := newPollVoteMutation(c.config, OpUpdateOne, withPollVote(pv))
+	return &PollVoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PollVoteClient) UpdateOneID(id int) *PollVoteUpdateOne {
+	mutation := newPollVoteMutation(c.config, OpUpdateOne, withPollVoteID(id))
+	return &PollVoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PollVote.
+func (c *PollVoteClient) Delete() *PollVoteDelete {
+	mutation := newPollVoteMutation(c.config, OpDelete)
+	return &PollVoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PollVoteClient) DeleteOne(pv *PollVote) *PollVoteDeleteOne {
+	return c.DeleteOneID(pv.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PollVoteClient) DeleteOneID(id int) *PollVoteDeleteOne {
+	builder := c.Delete().Where(pollvote.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PollVoteDeleteOne{builder}
+}
+
+// Query returns a query builder for PollVote.
+func (c *PollVoteClient) Query() *PollVoteQuery {
+	return &PollVoteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePollVote},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PollVote entity by its id.
+func (c *PollVoteClient) Get(ctx context.Context, id int) (*PollVote, error) {
+	return c.Query().Where(pollvote.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PollVoteClient) GetX(ctx context.Context, id int) *PollVote {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPoll queries the poll edge of a PollVote.
+func (c *PollVoteClient) QueryPoll(pv *PollVote) *PollQuery {
+	query := (&PollClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pollvote.Table, pollvote.FieldID, id),
+			sqlgraph.To(poll.Table, poll.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pollvote.PollTable, pollvote.PollColumn),
+		)
+		fromV = sqlgraph.Neighbors(pv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PollVoteClient) Hooks() []Hook {
+	return c.hooks.PollVote
+}
+
+// Interceptors returns the client interceptors.
+func (c *PollVoteClient) Interceptors() []Interceptor {
+	return c.inters.PollVote
+}
+
+func (c *PollVoteClient) mutate(ctx context.Context, m *PollVoteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PollVoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PollVoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PollVoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PollVoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PollVote mutation op: %q", m.Op())
+	}
+}
+
+// RoomClient is a client for the Room schema.
+type RoomClient struct {
+	config
+}
+
+// NewRoomClient returns a client for the Room from the given config.
+func NewRoomClient(c config) *RoomClient {
+	return &RoomClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `room.Hooks(f(g(h())))`.
+func (c *RoomClient) Use(hooks ...Hook) {
+	c.hooks.Room = append(c.hooks.Room, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `room.Intercept(f(g(h())))`.
+func (c *RoomClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Room = append(c.inters.Room, interceptors...)
+}
+
+// Create returns a builder for creating a Room entity.
+func (c *RoomClient) Create() *RoomCreate {
+	mutation := newRoomMutation(c.config, OpCreate)
+	return &RoomCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Room entities.
+func (c *RoomClient) CreateBulk(builders ...*RoomCreate) *RoomCreateBulk {
+	return &RoomCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoomClient) MapCreateBulk(slice any, setFunc func(*RoomCreate, int)) *RoomCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoomCreateBulk{err: fmt.Errorf("calling to RoomClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoomCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoomCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Room.
+func (c *RoomClient) Update() *RoomUpdate {
+	mutation := newRoomMutation(c.config, OpUpdate)
+	return &RoomUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoomClient) UpdateOne(r *Room) *RoomUpdateOne {
+	mutation := newRoomMutation(c.config, OpUpdateOne, withRoom(r))
+	return &RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoomClient) UpdateOneID(id int) *RoomUpdateOne {
+	mutation := newRoomMutation(c.config, OpUpdateOne, withRoomID(id))
+	return &RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Room.
+func (c *RoomClient) Delete() *RoomDelete {
+	mutation := newRoomMutation(c.config, OpDelete)
+	return &RoomDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoomClient) DeleteOne(r *Room) *RoomDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoomClient) DeleteOneID(id int) *RoomDeleteOne {
+	builder := c.Delete().Where(room.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoomDeleteOne{builder}
+}
+
+// Query returns a query builder for Room.
+func (c *RoomClient) Query() *RoomQuery {
+	return &RoomQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoom},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Room entity by its id.
+func (c *RoomClient) Get(ctx context.Context, id int) (*Room, error) {
+	return c.Query().Where(room.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoomClient) GetX(ctx context.Context, id int) *Room {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RoomClient) Hooks() []Hook {
+	return c.hooks.Room
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoomClient) Interceptors() []Interceptor {
+	return c.inters.Room
+}
+
+func (c *RoomClient) mutate(ctx context.Context, m *RoomMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoomCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoomUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoomDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Room mutation op: %q", m.Op())
+	}
+}
+
+// RoomExportClient is a client for the RoomExport schema.
+type RoomExportClient struct {
+	config
+}
+
+// NewRoomExportClient returns a client for the RoomExport from the given config.
+func NewRoomExportClient(c config) *RoomExportClient {
+	return &RoomExportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roomexport.Hooks(f(g(h())))`.
+func (c *RoomExportClient) Use(hooks ...Hook) {
+	c.hooks.RoomExport = append(c.hooks.RoomExport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roomexport.Intercept(f(g(h())))`.
+func (c *RoomExportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoomExport = append(c.inters.RoomExport, interceptors...)
+}
+
+// Create returns a builder for creating a RoomExport entity.
+func (c *RoomExportClient) Create() *RoomExportCreate {
+	mutation := newRoomExportMutation(c.config, OpCreate)
+	return &RoomExportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoomExport entities.
+func (c *RoomExportClient) CreateBulk(builders ...*RoomExportCreate) *RoomExportCreateBulk {
+	return &RoomExportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoomExportClient) MapCreateBulk(slice any, setFunc func(*RoomExportCreate, int)) *RoomExportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoomExportCreateBulk{err: fmt.Errorf("calling to RoomExportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoomExportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoomExportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoomExport.
+func (c *RoomExportClient) Update() *RoomExportUpdate {
+	mutation := newRoomExportMutation(c.config, OpUpdate)
+	return &RoomExportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoomExportClient) UpdateOne(re *RoomExport) *RoomExportUpdateOne {
+	mutation := newRoomExportMutation(c.config, OpUpdateOne, withRoomExport(re))
+	return &RoomExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoomExportClient) UpdateOneID(id int) *RoomExportUpdateOne {
+	mutation := newRoomExportMutation(c.config, OpUpdateOne, withRoomExportID(id))
+	return &RoomExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoomExport.
+func (c *RoomExportClient) Delete() *RoomExportDelete {
+	mutation := newRoomExportMutation(c.config, OpDelete)
+	return &RoomExportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoomExportClient) DeleteOne(re *RoomExport) *RoomExportDeleteOne {
+	return c.DeleteOneID(re.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoomExportClient) DeleteOneID(id int) *RoomExportDeleteOne {
+	builder := c.Delete().Where(roomexport.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoomExportDeleteOne{builder}
+}
+
+// Query returns a query builder for RoomExport.
+func (c *RoomExportClient) Query() *RoomExportQuery {
+	return &RoomExportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoomExport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoomExport entity by its id.
+func (c *RoomExportClient) Get(ctx context.Context, id int) (*RoomExport, error) {
+	return c.Query().Where(roomexport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoomExportClient) GetX(ctx context.Context, id int) *RoomExport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RoomExportClient) Hooks() []Hook {
+	return c.hooks.RoomExport
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoomExportClient) Interceptors() []Interceptor {
+	return c.inters.RoomExport
+}
+
+func (c *RoomExportClient) mutate(ctx context.Context, m *RoomExportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoomExportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoomExportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoomExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoomExportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoomExport mutation op: %q", m.Op())
+	}
+}
+
+// RoomExportChunkClient is a client for the RoomExportChunk schema.
+type RoomExportChunkClient struct {
+	config
+}
+
+// NewRoomExportChunkClient returns a client for the RoomExportChunk from the given config.
+func NewRoomExportChunkClient(c config) *RoomExportChunkClient {
+	return &RoomExportChunkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roomexportchunk.Hooks(f(g(h())))`.
+func (c *RoomExportChunkClient) Use(hooks ...Hook) {
+	c.hooks.RoomExportChunk = append(c.hooks.RoomExportChunk, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roomexportchunk.Intercept(f(g(h())))`.
+func (c *RoomExportChunkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoomExportChunk = append(c.inters.RoomExportChunk, interceptors...)
+}
+
+// Create returns a builder for creating a RoomExportChunk entity.
+func (c *RoomExportChunkClient) Create() *RoomExportChunkCreate {
+	mutation := newRoomExportChunkMutation(c.config, OpCreate)
+	return &RoomExportChunkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoomExportChunk entities.
+func (c *RoomExportChunkClient) CreateBulk(builders ...*RoomExportChunkCreate) *RoomExportChunkCreateBulk {
+	return &RoomExportChunkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoomExportChunkClient) MapCreateBulk(slice any, setFunc func(*RoomExportChunkCreate, int)) *RoomExportChunkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoomExportChunkCreateBulk{err: fmt.Errorf("calling to RoomExportChunkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoomExportChunkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoomExportChunkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoomExportChunk.
+func (c *RoomExportChunkClient) Update() *RoomExportChunkUpdate {
+	mutation := newRoomExportChunkMutation(c.config, OpUpdate)
+	return &RoomExportChunkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoomExportChunkClient) UpdateOne(rec *RoomExportChunk) *RoomExportChunkUpdateOne {
+	mutation := newRoomExportChunkMutation(c.config, OpUpdateOne, withRoomExportChunk(rec))
+	return &RoomExportChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoomExportChunkClient) UpdateOneID(id int) *RoomExportChunkUpdateOne {
+	mutation := newRoomExportChunkMutation(c.config, OpUpdateOne, withRoomExportChunkID(id))
+	return &RoomExportChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoomExportChunk.
+func (c *RoomExportChunkClient) Delete() *RoomExportChunkDelete {
+	mutation := newRoomExportChunkMutation(c.config, OpDelete)
+	return &RoomExportChunkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoomExportChunkClient) DeleteOne(rec *RoomExportChunk) *RoomExportChunkDeleteOne {
+	return c.DeleteOneID(rec.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoomExportChunkClient) DeleteOneID(id int) *RoomExportChunkDeleteOne {
+	builder := c.Delete().Where(roomexportchunk.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoomExportChunkDeleteOne{builder}
+}
+
+// Query returns a query builder for RoomExportChunk.
+func (c *RoomExportChunkClient) Query() *RoomExportChunkQuery {
+	return &RoomExportChunkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoomExportChunk},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoomExportChunk entity by its id.
+func (c *RoomExportChunkClient) Get(ctx context.Context, id int) (*RoomExportChunk, error) {
+	return c.Query().Where(roomexportchunk.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoomExportChunkClient) GetX(ctx context.Context, id int) *RoomExportChunk {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RoomExportChunkClient) Hooks() []Hook {
+	return c.hooks.RoomExportChunk
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoomExportChunkClient) Interceptors() []Interceptor {
+	return c.inters.RoomExportChunk
+}
+
+func (c *RoomExportChunkClient) mutate(ctx context.Context, m *RoomExportChunkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoomExportChunkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoomExportChunkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoomExportChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoomExportChunkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoomExportChunk mutation op: %q", m.Op())
+	}
+}
+
+// RoomMemberClient is a client for the RoomMember schema.
+type RoomMemberClient struct {
+	config
+}
+
+// NewRoomMemberClient returns a client for the RoomMember from the given config.
+func NewRoomMemberClient(c config) *RoomMemberClient {
+	return &RoomMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roommember.Hooks(f(g(h())))`.
+func (c *RoomMemberClient) Use(hooks ...Hook) {
+	c.hooks.RoomMember = append(c.hooks.RoomMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roommember.Intercept(f(g(h())))`.
+func (c *RoomMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoomMember = append(c.inters.RoomMember, interceptors...)
+}
+
+// Create returns a builder for creating a RoomMember entity.
+func (c *RoomMemberClient) Create() *RoomMemberCreate {
+	mutation := newRoomMemberMutation(c.config, OpCreate)
+	return &RoomMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoomMember entities.
+func (c *RoomMemberClient) CreateBulk(builders ...*RoomMemberCreate) *RoomMemberCreateBulk {
+	return &RoomMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoomMemberClient) MapCreateBulk(slice any, setFunc func(*RoomMemberCreate, int)) *RoomMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoomMemberCreateBulk{err: fmt.Errorf("calling to RoomMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoomMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoomMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoomMember.
+func (c *RoomMemberClient) Update() *RoomMemberUpdate {
+	mutation := newRoomMemberMutation(c.config, OpUpdate)
+	return &RoomMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoomMemberClient) UpdateOne(rm *RoomMember) *RoomMemberUpdateOne {
+	mutation := newRoomMemberMutation(c.config, OpUpdateOne, withRoomMember(rm))
+	return &RoomMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoomMemberClient) UpdateOneID(id int) *RoomMemberUpdateOne {
+	mutation := newRoomMemberMutation(c.config, OpUpdateOne, withRoomMemberID(id))
+	return &RoomMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoomMember.
+func (c *RoomMemberClient) Delete() *RoomMemberDelete {
+	mutation := newRoomMemberMutation(c.config, OpDelete)
+	return &RoomMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoomMemberClient) DeleteOne(rm *RoomMember) *RoomMemberDeleteOne {
+	return c.DeleteOneID(rm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoomMemberClient) DeleteOneID(id int) *RoomMemberDeleteOne {
+	builder := c.Delete().Where(roommember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoomMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for RoomMember.
+func (c *RoomMemberClient) Query() *RoomMemberQuery {
+	return &RoomMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoomMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoomMember entity by its id.
+func (c *RoomMemberClient) Get(ctx context.Context, id int) (*RoomMember, error) {
+	return c.Query().Where(roommember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoomMemberClient) GetX(ctx context.Context, id int) *RoomMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RoomMemberClient) Hooks() []Hook {
+	return c.hooks.RoomMember
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoomMemberClient) Interceptors() []Interceptor {
+	return c.inters.RoomMember
+}
+
+func (c *RoomMemberClient) mutate(ctx context.Context, m *RoomMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoomMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoomMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoomMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoomMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoomMember mutation op: %q", m.Op())
+	}
+}
+
+// SavedMessageClient is a client for the SavedMessage schema.
+type SavedMessageClient struct {
+	config
+}
+
+// NewSavedMessageClient returns a client for the SavedMessage from the given config.
+func NewSavedMessageClient(c config) *SavedMessageClient {
+	return &SavedMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `savedmessage.Hooks(f(g(h())))`.
+func (c *SavedMessageClient) Use(hooks ...Hook) {
+	c.hooks.SavedMessage = append(c.hooks.SavedMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `savedmessage.Intercept(f(g(h())))`.
+func (c *SavedMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SavedMessage = append(c.inters.SavedMessage, interceptors...)
+}
+
+// Create returns a builder for creating a SavedMessage entity.
+func (c *SavedMessageClient) Create() *SavedMessageCreate {
+	mutation := newSavedMessageMutation(c.config, OpCreate)
+	return &SavedMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SavedMessage entities.
+func (c *SavedMessageClient) CreateBulk(builders ...*SavedMessageCreate) *SavedMessageCreateBulk {
+	return &SavedMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SavedMessageClient) MapCreateBulk(slice any, setFunc func(*SavedMessageCreate, int)) *SavedMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SavedMessageCreateBulk{err: fmt.Errorf("calling to SavedMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SavedMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SavedMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SavedMessage.
+func (c *SavedMessageClient) Update() *SavedMessageUpdate {
+	mutation := newSavedMessageMutation(c.config, OpUpdate)
+	return &SavedMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SavedMessageClient) UpdateOne(sm *SavedMessage) *SavedMessageUpdateOne {
+	mutation := newSavedMessageMutation(c.config, OpUpdateOne, withSavedMessage(sm))
+	return &SavedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SavedMessageClient) UpdateOneID(id int) *SavedMessageUpdateOne {
+	mutation := newSavedMessageMutation(c.config, OpUpdateOne, withSavedMessageID(id))
+	return &SavedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SavedMessage.
+func (c *SavedMessageClient) Delete() *SavedMessageDelete {
+	mutation := newSavedMessageMutation(c.config, OpDelete)
+	return &SavedMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SavedMessageClient) DeleteOne(sm *SavedMessage) *SavedMessageDeleteOne {
+	return c.DeleteOneID(sm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SavedMessageClient) DeleteOneID(id int) *SavedMessageDeleteOne {
+	builder := c.Delete().Where(savedmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SavedMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for SavedMessage.
+func (c *SavedMessageClient) Query() *SavedMessageQuery {
+	return &SavedMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSavedMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SavedMessage entity by its id.
+func (c *SavedMessageClient) Get(ctx context.Context, id int) (*SavedMessage, error) {
+	return c.Query().Where(savedmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SavedMessageClient) GetX(ctx context.Context, id int) *SavedMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a SavedMessage.
+func (c *SavedMessageClient) QueryMessage(sm *SavedMessage) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedmessage.Table, savedmessage.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedmessage.MessageTable, savedmessage.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(sm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SavedMessageClient) Hooks() []Hook {
+	return c.hooks.SavedMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *SavedMessageClient) Interceptors() []Interceptor {
+	return c.inters.SavedMessage
+}
+
+func (c *SavedMessageClient) mutate(ctx context.Context, m *SavedMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SavedMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SavedMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SavedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SavedMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SavedMessage mutation op: %q", m.Op())
+	}
+}
+
+// ScheduledMessageClient is a client for the ScheduledMessage schema.
+type ScheduledMessageClient struct {
+	config
+}
+
+// NewScheduledMessageClient returns a client for the ScheduledMessage from the given config.
+func NewScheduledMessageClient(c config) *ScheduledMessageClient {
+	return &ScheduledMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scheduledmessage.Hooks(f(g(h())))`.
+func (c *ScheduledMessageClient) Use(hooks ...Hook) {
+	c.hooks.ScheduledMessage = append(c.hooks.ScheduledMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scheduledmessage.Intercept(f(g(h())))`.
+func (c *ScheduledMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScheduledMessage = append(c.inters.ScheduledMessage, interceptors...)
+}
+
+// Create returns a builder for creating a ScheduledMessage entity.
+func (c *ScheduledMessageClient) Create() *ScheduledMessageCreate {
+	mutation := newScheduledMessageMutation(c.config, OpCreate)
+	return &ScheduledMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScheduledMessage entities.
+func (c *ScheduledMessageClient) CreateBulk(builders ...*ScheduledMessageCreate) *ScheduledMessageCreateBulk {
+	return &ScheduledMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScheduledMessageClient) MapCreateBulk(slice any, setFunc func(*ScheduledMessageCreate, int)) *ScheduledMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScheduledMessageCreateBulk{err: fmt.Errorf("calling to ScheduledMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScheduledMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScheduledMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Update() *ScheduledMessageUpdate {
+	mutation := newScheduledMessageMutation(c.config, OpUpdate)
+	return &ScheduledMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScheduledMessageClient) UpdateOne(sm *ScheduledMessage) *ScheduledMessageUpdateOne {
+	mutation := newScheduledMessageMutation(c.config, OpUpdateOne, withScheduledMessage(sm))
+	return &ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScheduledMessageClient) UpdateOneID(id int) *ScheduledMessageUpdateOne {
+	mutation := newScheduledMessageMutation(c.config, OpUpdateOne, withScheduledMessageID(id))
+	return &ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Delete() *ScheduledMessageDelete {
+	mutation := newScheduledMessageMutation(c.config, OpDelete)
+	return &ScheduledMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScheduledMessageClient) DeleteOne(sm *ScheduledMessage) *ScheduledMessageDeleteOne {
+	return c.DeleteOneID(sm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScheduledMessageClient) DeleteOneID(id int) *ScheduledMessageDeleteOne {
+	builder := c.Delete().Where(scheduledmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScheduledMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Query() *ScheduledMessageQuery {
+	return &ScheduledMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScheduledMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScheduledMessage entity by its id.
+func (c *ScheduledMessageClient) Get(ctx context.Context, id int) (*ScheduledMessage, error) {
+	return c.Query().Where(scheduledmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScheduledMessageClient) GetX(ctx context.Context, id int) *ScheduledMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ScheduledMessageClient) Hooks() []Hook {
+	return c.hooks.ScheduledMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScheduledMessageClient) Interceptors() []Interceptor {
+	return c.inters.ScheduledMessage
+}
+
+func (c *ScheduledMessageClient) mutate(ctx context.Context, m *ScheduledMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScheduledMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScheduledMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScheduledMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScheduledMessage mutation op: %q", m.Op())
+	}
+}
+
+// WebhookClient is a client for the Webhook schema.
+type WebhookClient struct {
+	config
+}
+
+// NewWebhookClient returns a client for the Webhook from the given config.
+func NewWebhookClient(c config) *WebhookClient {
+	return &WebhookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhook.Hooks(f(g(h())))`.
+func (c *WebhookClient) Use(hooks ...Hook) {
+	c.hooks.Webhook = append(c.hooks.Webhook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhook.Intercept(f(g(h())))`.
+func (c *WebhookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Webhook = append(c.inters.Webhook, interceptors...)
+}
+
+// Create returns a builder for creating a Webhook entity.
+func (c *WebhookClient) Create() *WebhookCreate {
+	mutation := newWebhookMutation(c.config, OpCreate)
+	return &WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Webhook entities.
+func (c *WebhookClient) CreateBulk(builders ...*WebhookCreate) *WebhookCreateBulk {
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookClient) MapCreateBulk(slice any, setFunc func(*WebhookCreate, int)) *WebhookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookCreateBulk{err: fmt.Errorf("calling to WebhookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Webhook.
+func (c *WebhookClient) Update() *WebhookUpdate {
+	mutation := newWebhookMutation(c.config, OpUpdate)
+	return &WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookClient) UpdateOne(w *Webhook) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhook(w))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookClient) UpdateOneID(id int) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhookID(id))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Webhook.
+func (c *WebhookClient) Delete() *WebhookDelete {
+	mutation := newWebhookMutation(c.config, OpDelete)
+	return &WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookClient) DeleteOne(w *Webhook) *WebhookDeleteOne {
+	return c.DeleteOneID(w.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookClient) DeleteOneID(id int) *WebhookDeleteOne {
+	builder := c.Delete().Where(webhook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeleteOne{builder}
+}
+
+// Query returns a query builder for Webhook.
+func (c *WebhookClient) Query() *WebhookQuery {
+	return &WebhookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Webhook entity by its id.
+func (c *WebhookClient) Get(ctx context.Context, id int) (*Webhook, error) {
+	return c.Query().Where(webhook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookClient) GetX(ctx context.Context, id int) *Webhook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookClient) Hooks() []Hook {
+	return c.hooks.Webhook
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookClient) Interceptors() []Interceptor {
+	return c.inters.Webhook
+}
+
+func (c *WebhookClient) mutate(ctx context.Context, m *WebhookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Webhook mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		BotDelivery, BotSubscription, CallParticipant, CallSession, HistoryImport,
+		Message, MessagePurge, PinnedMessage, Poll, PollVote, Room, RoomExport,
+		RoomExportChunk, RoomMember, SavedMessage, ScheduledMessage, Webhook []ent.Hook
+	}
+	inters struct {
+		BotDelivery, BotSubscription, CallParticipant, CallSession, HistoryImport,
+		Message, MessagePurge, PinnedMessage, Poll, PollVote, Room, RoomExport,
+		RoomExportChunk, RoomMember, SavedMessage, ScheduledMessage,
+		Webhook []ent.Interceptor
+	}
+)
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
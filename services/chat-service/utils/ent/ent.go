@@ -12,8 +12,23 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botdelivery"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botsubscription"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callparticipant"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callsession"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/historyimport"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/messagepurge"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pinnedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pollvote"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/room"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexport"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexportchunk"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roommember"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/savedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/scheduledmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/webhook"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -74,8 +89,23 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			message.Table: message.ValidColumn,
-			room.Table:    room.ValidColumn,
+			botdelivery.Table:      botdelivery.ValidColumn,
+			botsubscription.Table:  botsubscription.ValidColumn,
+			callparticipant.Table:  callparticipant.ValidColumn,
+			callsession.Table:      callsession.ValidColumn,
+			historyimport.Table:    historyimport.ValidColumn,
+			message.Table:          message.ValidColumn,
+			messagepurge.Table:     messagepurge.ValidColumn,
+			pinnedmessage.Table:    pinnedmessage.ValidColumn,
+			poll.Table:             poll.ValidColumn,
+			pollvote.Table:         pollvote.ValidColumn,
+			room.Table:             room.ValidColumn,
+			roomexport.Table:       roomexport.ValidColumn,
+			roomexportchunk.Table:  roomexportchunk.ValidColumn,
+			roommember.Table:       roommember.ValidColumn,
+			savedmessage.Table:     savedmessage.ValidColumn,
+			scheduledmessage.Table: scheduledmessage.ValidColumn,
+			webhook.Table:          webhook.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/historyimport"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/schema"
+)
+
+// HistoryImport is the model entity for the HistoryImport schema.
+type HistoryImport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Format holds the value of the "format" field.
+	Format historyimport.Format `json:"format,omitempty"`
+	// FilePath holds the value of the "file_path" field.
+	FilePath string `json:"file_path,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status historyimport.Status `json:"status,omitempty"`
+	// RoomsCreated holds the value of the "rooms_created" field.
+	RoomsCreated int `json:"rooms_created,omitempty"`
+	// MessagesImported holds the value of the "messages_imported" field.
+	MessagesImported int `json:"messages_imported,omitempty"`
+	// MessagesSkipped holds the value of the "messages_skipped" field.
+	MessagesSkipped int `json:"messages_skipped,omitempty"`
+	// CompletedRooms holds the value of the "completed_rooms" field.
+	CompletedRooms []string `json:"completed_rooms,omitempty"`
+	// UnmappedUsers holds the value of the "unmapped_users" field.
+	UnmappedUsers []schema.UnmappedUser `json:"unmapped_users,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HistoryImport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case historyimport.FieldCompletedRooms, historyimport.FieldUnmappedUsers:
+			values[i] = new([]byte)
+		case historyimport.FieldID, historyimport.FieldRoomsCreated, historyimport.FieldMessagesImported, historyimport.FieldMessagesSkipped:
+			values[i] = new(sql.NullInt64)
+		case historyimport.FieldFormat, historyimport.FieldFilePath, historyimport.FieldUserID, historyimport.FieldStatus, historyimport.FieldError:
+			values[i] = new(sql.NullString)
+		case historyimport.FieldCreatedAt, historyimport.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HistoryImport fields.
+func (hi *HistoryImport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case historyimport.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			hi.ID = int(value.Int64)
+		case historyimport.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				hi.Format = historyimport.Format(value.String)
+			}
+		case historyimport.FieldFilePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_path", values[i])
+			} else if value.Valid {
+				hi.FilePath = value.String
+			}
+		case historyimport.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				hi.UserID = value.String
+			}
+		case historyimport.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				hi.Status = historyimport.Status(value.String)
+			}
+		case historyimport.FieldRoomsCreated:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rooms_created", values[i])
+			} else if value.Valid {
+				hi.RoomsCreated = int(value.Int64)
+			}
+		case historyimport.FieldMessagesImported:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field messages_imported", values[i])
+			} else if value.Valid {
+				hi.MessagesImported = int(value.Int64)
+			}
+		case historyimport.FieldMessagesSkipped:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field messages_skipped", values[i])
+			} else if value.Valid {
+				hi.MessagesSkipped = int(value.Int64)
+			}
+		case historyimport.FieldCompletedRooms:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_rooms", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &hi.CompletedRooms); err != nil {
+					return fmt.Errorf("unmarshal field completed_rooms: %w", err)
+				}
+			}
+		case historyimport.FieldUnmappedUsers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field unmapped_users", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &hi.UnmappedUsers); err != nil {
+					return fmt.Errorf("unmarshal field unmapped_users: %w", err)
+				}
+			}
+		case historyimport.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				hi.Error = value.String
+			}
+		case historyimport.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				hi.CreatedAt = value.Time
+			}
+		case historyimport.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				hi.CompletedAt = new(time.Time)
+				*hi.CompletedAt = value.Time
+			}
+		default:
+			hi.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the HistoryImport.
+// This includes values selected through modifiers, order, etc.
+func (hi *HistoryImport) Value(name string) (ent.Value, error) {
+	return hi.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this HistoryImport.
+// Note that you need to call HistoryImport.Unwrap() before calling this method if this HistoryImport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (hi *HistoryImport) Update() *HistoryImportUpdateOne {
+	return NewHistoryImportClient(hi.config).UpdateOne(hi)
+}
+
+// Unwrap unwraps the HistoryImport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (hi *HistoryImport) Unwrap() *HistoryImport {
+	_tx, ok := hi.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: HistoryImport is not a transactional entity")
+	}
+	hi.config.driver = _tx.drv
+	return hi
+}
+
+// String implements the fmt.Stringer.
+func (hi *HistoryImport) String() string {
+	var builder strings.Builder
+	builder.WriteString("HistoryImport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", hi.ID))
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", hi.Format))
+	builder.WriteString(", ")
+	builder.WriteString("file_path=")
+	builder.WriteString(hi.FilePath)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(hi.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", hi.Status))
+	builder.WriteString(", ")
+	builder.WriteString("rooms_created=")
+	builder.WriteString(fmt.Sprintf("%v", hi.RoomsCreated))
+	builder.WriteString(", ")
+	builder.WriteString("messages_imported=")
+	builder.WriteString(fmt.Sprintf("%v", hi.MessagesImported))
+	builder.WriteString(", ")
+	builder.WriteString("messages_skipped=")
+	builder.WriteString(fmt.Sprintf("%v", hi.MessagesSkipped))
+	builder.WriteString(", ")
+	builder.WriteString("completed_rooms=")
+	builder.WriteString(fmt.Sprintf("%v", hi.CompletedRooms))
+	builder.WriteString(", ")
+	builder.WriteString("unmapped_users=")
+	builder.WriteString(fmt.Sprintf("%v", hi.UnmappedUsers))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(hi.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(hi.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := hi.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HistoryImports is a parsable slice of HistoryImport.
+type HistoryImports []*HistoryImport
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package historyimport
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the historyimport type in the database.
+	Label = "history_import"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldFilePath holds the string denoting the file_path field in the database.
+	FieldFilePath = "file_path"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldRoomsCreated holds the string denoting the rooms_created field in the database.
+	FieldRoomsCreated = "rooms_created"
+	// FieldMessagesImported holds the string denoting the messages_imported field in the database.
+	FieldMessagesImported = "messages_imported"
+	// FieldMessagesSkipped holds the string denoting the messages_skipped field in the database.
+	FieldMessagesSkipped = "messages_skipped"
+	// FieldCompletedRooms holds the string denoting the completed_rooms field in the database.
+	FieldCompletedRooms = "completed_rooms"
+	// FieldUnmappedUsers holds the string denoting the unmapped_users field in the database.
+	FieldUnmappedUsers = "unmapped_users"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// Table holds the table name of the historyimport in the database.
+	Table = "history_imports"
+)
+
+// Columns holds all SQL columns for historyimport fields.
+var Columns = []string{
+	FieldID,
+	FieldFormat,
+	FieldFilePath,
+	FieldUserID,
+	FieldStatus,
+	FieldRoomsCreated,
+	FieldMessagesImported,
+	FieldMessagesSkipped,
+	FieldCompletedRooms,
+	FieldUnmappedUsers,
+	FieldError,
+	FieldCreatedAt,
+	FieldCompletedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// FilePathValidator is a validator for the "file_path" field. It is called by the builders before save.
+	FilePathValidator func(string) error
+	// DefaultRoomsCreated holds the default value on creation for the "rooms_created" field.
+	DefaultRoomsCreated int
+	// DefaultMessagesImported holds the default value on creation for the "messages_imported" field.
+	DefaultMessagesImported int
+	// DefaultMessagesSkipped holds the default value on creation for the "messages_skipped" field.
+	DefaultMessagesSkipped int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Format defines the type for the "format" enum field.
+type Format string
+
+// Format values.
+const (
+	FormatSlack Format = "slack"
+	FormatJSON  Format = "json"
+)
+
+func (f Format) String() string {
+	return string(f)
+}
+
+// FormatValidator is a validator for the "format" field enum values. It is called by the builders before save.
+func FormatValidator(f Format) error {
+	switch f {
+	case FormatSlack, FormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("historyimport: invalid enum value for format field: %q", f)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusRunning, StatusCompleted, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("historyimport: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the HistoryImport queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByFilePath orders the results by the file_path field.
+func ByFilePath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilePath, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByRoomsCreated orders the results by the rooms_created field.
+func ByRoomsCreated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoomsCreated, opts...).ToFunc()
+}
+
+// ByMessagesImported orders the results by the messages_imported field.
+func ByMessagesImported(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessagesImported, opts...).ToFunc()
+}
+
+// ByMessagesSkipped orders the results by the messages_skipped field.
+func ByMessagesSkipped(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessagesSkipped, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
@@ -0,0 +1,595 @@
+// Code generated by ent, DO NOT EDIT.
+
+package historyimport
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLTE(FieldID, id))
+}
+
+// FilePath applies equality check predicate on the "file_path" field. It's identical to FilePathEQ.
+func FilePath(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldFilePath, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldUserID, v))
+}
+
+// RoomsCreated applies equality check predicate on the "rooms_created" field. It's identical to RoomsCreatedEQ.
+func RoomsCreated(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldRoomsCreated, v))
+}
+
+// MessagesImported applies equality check predicate on the "messages_imported" field. It's identical to MessagesImportedEQ.
+func MessagesImported(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldMessagesImported, v))
+}
+
+// MessagesSkipped applies equality check predicate on the "messages_skipped" field. It's identical to MessagesSkippedEQ.
+func MessagesSkipped(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldMessagesSkipped, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// FilePathEQ applies the EQ predicate on the "file_path" field.
+func FilePathEQ(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldFilePath, v))
+}
+
+// FilePathNEQ applies the NEQ predicate on the "file_path" field.
+func FilePathNEQ(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNEQ(FieldFilePath, v))
+}
+
+// FilePathIn applies the In predicate on the "file_path" field.
+func FilePathIn(vs ...string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIn(FieldFilePath, vs...))
+}
+
+// FilePathNotIn applies the NotIn predicate on the "file_path" field.
+func FilePathNotIn(vs ...string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotIn(FieldFilePath, vs...))
+}
+
+// FilePathGT applies the GT predicate on the "file_path" field.
+func FilePathGT(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGT(FieldFilePath, v))
+}
+
+// FilePathGTE applies the GTE predicate on the "file_path" field.
+func FilePathGTE(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGTE(FieldFilePath, v))
+}
+
+// FilePathLT applies the LT predicate on the "file_path" field.
+func FilePathLT(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLT(FieldFilePath, v))
+}
+
+// FilePathLTE applies the LTE predicate on the "file_path" field.
+func FilePathLTE(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLTE(FieldFilePath, v))
+}
+
+// FilePathContains applies the Contains predicate on the "file_path" field.
+func FilePathContains(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldContains(FieldFilePath, v))
+}
+
+// FilePathHasPrefix applies the HasPrefix predicate on the "file_path" field.
+func FilePathHasPrefix(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldHasPrefix(FieldFilePath, v))
+}
+
+// FilePathHasSuffix applies the HasSuffix predicate on the "file_path" field.
+func FilePathHasSuffix(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldHasSuffix(FieldFilePath, v))
+}
+
+// FilePathEqualFold applies the EqualFold predicate on the "file_path" field.
+func FilePathEqualFold(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEqualFold(FieldFilePath, v))
+}
+
+// FilePathContainsFold applies the ContainsFold predicate on the "file_path" field.
+func FilePathContainsFold(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldContainsFold(FieldFilePath, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotNull(FieldUserID))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// RoomsCreatedEQ applies the EQ predicate on the "rooms_created" field.
+func RoomsCreatedEQ(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldRoomsCreated, v))
+}
+
+// RoomsCreatedNEQ applies the NEQ predicate on the "rooms_created" field.
+func RoomsCreatedNEQ(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNEQ(FieldRoomsCreated, v))
+}
+
+// RoomsCreatedIn applies the In predicate on the "rooms_created" field.
+func RoomsCreatedIn(vs ...int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIn(FieldRoomsCreated, vs...))
+}
+
+// RoomsCreatedNotIn applies the NotIn predicate on the "rooms_created" field.
+func RoomsCreatedNotIn(vs ...int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotIn(FieldRoomsCreated, vs...))
+}
+
+// RoomsCreatedGT applies the GT predicate on the "rooms_created" field.
+func RoomsCreatedGT(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGT(FieldRoomsCreated, v))
+}
+
+// RoomsCreatedGTE applies the GTE predicate on the "rooms_created" field.
+func RoomsCreatedGTE(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGTE(FieldRoomsCreated, v))
+}
+
+// RoomsCreatedLT applies the LT predicate on the "rooms_created" field.
+func RoomsCreatedLT(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLT(FieldRoomsCreated, v))
+}
+
+// RoomsCreatedLTE applies the LTE predicate on the "rooms_created" field.
+func RoomsCreatedLTE(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLTE(FieldRoomsCreated, v))
+}
+
+// MessagesImportedEQ applies the EQ predicate on the "messages_imported" field.
+func MessagesImportedEQ(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldMessagesImported, v))
+}
+
+// MessagesImportedNEQ applies the NEQ predicate on the "messages_imported" field.
+func MessagesImportedNEQ(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNEQ(FieldMessagesImported, v))
+}
+
+// MessagesImportedIn applies the In predicate on the "messages_imported" field.
+func MessagesImportedIn(vs ...int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIn(FieldMessagesImported, vs...))
+}
+
+// MessagesImportedNotIn applies the NotIn predicate on the "messages_imported" field.
+func MessagesImportedNotIn(vs ...int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotIn(FieldMessagesImported, vs...))
+}
+
+// MessagesImportedGT applies the GT predicate on the "messages_imported" field.
+func MessagesImportedGT(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGT(FieldMessagesImported, v))
+}
+
+// MessagesImportedGTE applies the GTE predicate on the "messages_imported" field.
+func MessagesImportedGTE(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGTE(FieldMessagesImported, v))
+}
+
+// MessagesImportedLT applies the LT predicate on the "messages_imported" field.
+func MessagesImportedLT(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLT(FieldMessagesImported, v))
+}
+
+// MessagesImportedLTE applies the LTE predicate on the "messages_imported" field.
+func MessagesImportedLTE(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLTE(FieldMessagesImported, v))
+}
+
+// MessagesSkippedEQ applies the EQ predicate on the "messages_skipped" field.
+func MessagesSkippedEQ(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldMessagesSkipped, v))
+}
+
+// MessagesSkippedNEQ applies the NEQ predicate on the "messages_skipped" field.
+func MessagesSkippedNEQ(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNEQ(FieldMessagesSkipped, v))
+}
+
+// MessagesSkippedIn applies the In predicate on the "messages_skipped" field.
+func MessagesSkippedIn(vs ...int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIn(FieldMessagesSkipped, vs...))
+}
+
+// MessagesSkippedNotIn applies the NotIn predicate on the "messages_skipped" field.
+func MessagesSkippedNotIn(vs ...int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotIn(FieldMessagesSkipped, vs...))
+}
+
+// MessagesSkippedGT applies the GT predicate on the "messages_skipped" field.
+func MessagesSkippedGT(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGT(FieldMessagesSkipped, v))
+}
+
+// MessagesSkippedGTE applies the GTE predicate on the "messages_skipped" field.
+func MessagesSkippedGTE(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGTE(FieldMessagesSkipped, v))
+}
+
+// MessagesSkippedLT applies the LT predicate on the "messages_skipped" field.
+func MessagesSkippedLT(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLT(FieldMessagesSkipped, v))
+}
+
+// MessagesSkippedLTE applies the LTE predicate on the "messages_skipped" field.
+func MessagesSkippedLTE(v int) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLTE(FieldMessagesSkipped, v))
+}
+
+// CompletedRoomsIsNil applies the IsNil predicate on the "completed_rooms" field.
+func CompletedRoomsIsNil() predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIsNull(FieldCompletedRooms))
+}
+
+// CompletedRoomsNotNil applies the NotNil predicate on the "completed_rooms" field.
+func CompletedRoomsNotNil() predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotNull(FieldCompletedRooms))
+}
+
+// UnmappedUsersIsNil applies the IsNil predicate on the "unmapped_users" field.
+func UnmappedUsersIsNil() predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIsNull(FieldUnmappedUsers))
+}
+
+// UnmappedUsersNotNil applies the NotNil predicate on the "unmapped_users" field.
+func UnmappedUsersNotNil() predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotNull(FieldUnmappedUsers))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.HistoryImport {
+	return predicate.HistoryImport(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.HistoryImport) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.HistoryImport) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.HistoryImport) predicate.HistoryImport {
+	return predicate.HistoryImport(sql.NotPredicates(p))
+}
@@ -0,0 +1,418 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/historyimport"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/schema"
+)
+
+// HistoryImportCreate is the builder for creating a HistoryImport entity.
+type HistoryImportCreate struct {
+	config
+	mutation *HistoryImportMutation
+	hooks    []Hook
+}
+
+// SetFormat sets the "format" field.
+func (hic *HistoryImportCreate) SetFormat(h historyimport.Format) *HistoryImportCreate {
+	hic.mutation.SetFormat(h)
+	return hic
+}
+
+// SetFilePath sets the "file_path" field.
+func (hic *HistoryImportCreate) SetFilePath(s string) *HistoryImportCreate {
+	hic.mutation.SetFilePath(s)
+	return hic
+}
+
+// SetUserID sets the "user_id" field.
+func (hic *HistoryImportCreate) SetUserID(s string) *HistoryImportCreate {
+	hic.mutation.SetUserID(s)
+	return hic
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (hic *HistoryImportCreate) SetNillableUserID(s *string) *HistoryImportCreate {
+	if s != nil {
+		hic.SetUserID(*s)
+	}
+	return hic
+}
+
+// SetStatus sets the "status" field.
+func (hic *HistoryImportCreate) SetStatus(h historyimport.Status) *HistoryImportCreate {
+	hic.mutation.SetStatus(h)
+	return hic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (hic *HistoryImportCreate) SetNillableStatus(h *historyimport.Status) *HistoryImportCreate {
+	if h != nil {
+		hic.SetStatus(*h)
+	}
+	return hic
+}
+
+// SetRoomsCreated sets the "rooms_created" field.
+func (hic *HistoryImportCreate) SetRoomsCreated(i int) *HistoryImportCreate {
+	hic.mutation.SetRoomsCreated(i)
+	return hic
+}
+
+// SetNillableRoomsCreated sets the "rooms_created" field if the given value is not nil.
+func (hic *HistoryImportCreate) SetNillableRoomsCreated(i *int) *HistoryImportCreate {
+	if i != nil {
+		hic.SetRoomsCreated(*i)
+	}
+	return hic
+}
+
+// SetMessagesImported sets the "messages_imported" field.
+func (hic *HistoryImportCreate) SetMessagesImported(i int) *HistoryImportCreate {
+	hic.mutation.SetMessagesImported(i)
+	return hic
+}
+
+// SetNillableMessagesImported sets the "messages_imported" field if the given value is not nil.
+func (hic *HistoryImportCreate) SetNillableMessagesImported(i *int) *HistoryImportCreate {
+	if i != nil {
+		hic.SetMessagesImported(*i)
+	}
+	return hic
+}
+
+// SetMessagesSkipped sets the "messages_skipped" field.
+func (hic *HistoryImportCreate) SetMessagesSkipped(i int) *HistoryImportCreate {
+	hic.mutation.SetMessagesSkipped(i)
+	return hic
+}
+
+// SetNillableMessagesSkipped sets the "messages_skipped" field if the given value is not nil.
+func (hic *HistoryImportCreate) SetNillableMessagesSkipped(i *int) *HistoryImportCreate {
+	if i != nil {
+		hic.SetMessagesSkipped(*i)
+	}
+	return hic
+}
+
+// SetCompletedRooms sets the "completed_rooms" field.
+func (hic *HistoryImportCreate) SetCompletedRooms(s []string) *HistoryImportCreate {
+	hic.mutation.SetCompletedRooms(s)
+	return hic
+}
+
+// SetUnmappedUsers sets the "unmapped_users" field.
+func (hic *HistoryImportCreate) SetUnmappedUsers(su []schema.UnmappedUser) *HistoryImportCreate {
+	hic.mutation.SetUnmappedUsers(su)
+	return hic
+}
+
+// SetError sets the "error" field.
+func (hic *HistoryImportCreate) SetError(s string) *HistoryImportCreate {
+	hic.mutation.SetError(s)
+	return hic
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (hic *HistoryImportCreate) SetNillableError(s *string) *HistoryImportCreate {
+	if s != nil {
+		hic.SetError(*s)
+	}
+	return hic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (hic *HistoryImportCreate) SetCreatedAt(t time.Time) *HistoryImportCreate {
+	hic.mutation.SetCreatedAt(t)
+	return hic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (hic *HistoryImportCreate) SetNillableCreatedAt(t *time.Time) *HistoryImportCreate {
+	if t != nil {
+		hic.SetCreatedAt(*t)
+	}
+	return hic
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (hic *HistoryImportCreate) SetCompletedAt(t time.Time) *HistoryImportCreate {
+	hic.mutation.SetCompletedAt(t)
+	return hic
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (hic *HistoryImportCreate) SetNillableCompletedAt(t *time.Time) *HistoryImportCreate {
+	if t != nil {
+		hic.SetCompletedAt(*t)
+	}
+	return hic
+}
+
+// Mutation returns the HistoryImportMutation object of the builder.
+func (hic *HistoryImportCreate) Mutation() *HistoryImportMutation {
+	return hic.mutation
+}
+
+// Save creates the HistoryImport in the database.
+func (hic *HistoryImportCreate) Save(ctx context.Context) (*HistoryImport, error) {
+	hic.defaults()
+	return withHooks(ctx, hic.sqlSave, hic.mutation, hic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (hic *HistoryImportCreate) SaveX(ctx context.Context) *HistoryImport {
+	v, err := hic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hic *HistoryImportCreate) Exec(ctx context.Context) error {
+	_, err := hic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hic *HistoryImportCreate) ExecX(ctx context.Context) {
+	if err := hic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hic *HistoryImportCreate) defaults() {
+	if _, ok := hic.mutation.Status(); !ok {
+		v := historyimport.DefaultStatus
+		hic.mutation.SetStatus(v)
+	}
+	if _, ok := hic.mutation.RoomsCreated(); !ok {
+		v := historyimport.DefaultRoomsCreated
+		hic.mutation.SetRoomsCreated(v)
+	}
+	if _, ok := hic.mutation.MessagesImported(); !ok {
+		v := historyimport.DefaultMessagesImported
+		hic.mutation.SetMessagesImported(v)
+	}
+	if _, ok := hic.mutation.MessagesSkipped(); !ok {
+		v := historyimport.DefaultMessagesSkipped
+		hic.mutation.SetMessagesSkipped(v)
+	}
+	if _, ok := hic.mutation.CreatedAt(); !ok {
+		v := historyimport.DefaultCreatedAt()
+		hic.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hic *HistoryImportCreate) check() error {
+	if _, ok := hic.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "HistoryImport.format"`)}
+	}
+	if v, ok := hic.mutation.Format(); ok {
+		if err := historyimport.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "HistoryImport.format": %w`, err)}
+		}
+	}
+	if _, ok := hic.mutation.FilePath(); !ok {
+		return &ValidationError{Name: "file_path", err: errors.New(`ent: missing required field "HistoryImport.file_path"`)}
+	}
+	if v, ok := hic.mutation.FilePath(); ok {
+		if err := historyimport.FilePathValidator(v); err != nil {
+			return &ValidationError{Name: "file_path", err: fmt.Errorf(`ent: validator failed for field "HistoryImport.file_path": %w`, err)}
+		}
+	}
+	if _, ok := hic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "HistoryImport.status"`)}
+	}
+	if v, ok := hic.mutation.Status(); ok {
+		if err := historyimport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "HistoryImport.status": %w`, err)}
+		}
+	}
+	if _, ok := hic.mutation.RoomsCreated(); !ok {
+		return &ValidationError{Name: "rooms_created", err: errors.New(`ent: missing required field "HistoryImport.rooms_created"`)}
+	}
+	if _, ok := hic.mutation.MessagesImported(); !ok {
+		return &ValidationError{Name: "messages_imported", err: errors.New(`ent: missing required field "HistoryImport.messages_imported"`)}
+	}
+	if _, ok := hic.mutation.MessagesSkipped(); !ok {
+		return &ValidationError{Name: "messages_skipped", err: errors.New(`ent: missing required field "HistoryImport.messages_skipped"`)}
+	}
+	if _, ok := hic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "HistoryImport.created_at"`)}
+	}
+	return nil
+}
+
+func (hic *HistoryImportCreate) sqlSave(ctx context.Context) (*HistoryImport, error) {
+	if err := hic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := hic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, hic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	hic.mutation.id = &_node.ID
+	hic.mutation.done = true
+	return _node, nil
+}
+
+func (hic *HistoryImportCreate) createSpec() (*HistoryImport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HistoryImport{config: hic.config}
+		_spec = sqlgraph.NewCreateSpec(historyimport.Table, sqlgraph.NewFieldSpec(historyimport.FieldID, field.TypeInt))
+	)
+	if value, ok := hic.mutation.Format(); ok {
+		_spec.SetField(historyimport.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := hic.mutation.FilePath(); ok {
+		_spec.SetField(historyimport.FieldFilePath, field.TypeString, value)
+		_node.FilePath = value
+	}
+	if value, ok := hic.mutation.UserID(); ok {
+		_spec.SetField(historyimport.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := hic.mutation.Status(); ok {
+		_spec.SetField(historyimport.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := hic.mutation.RoomsCreated(); ok {
+		_spec.SetField(historyimport.FieldRoomsCreated, field.TypeInt, value)
+		_node.RoomsCreated = value
+	}
+	if value, ok := hic.mutation.MessagesImported(); ok {
+		_spec.SetField(historyimport.FieldMessagesImported, field.TypeInt, value)
+		_node.MessagesImported = value
+	}
+	if value, ok := hic.mutation.MessagesSkipped(); ok {
+		_spec.SetField(historyimport.FieldMessagesSkipped, field.TypeInt, value)
+		_node.MessagesSkipped = value
+	}
+	if value, ok := hic.mutation.CompletedRooms(); ok {
+		_spec.SetField(historyimport.FieldCompletedRooms, field.TypeJSON, value)
+		_node.CompletedRooms = value
+	}
+	if value, ok := hic.mutation.UnmappedUsers(); ok {
+		_spec.SetField(historyimport.FieldUnmappedUsers, field.TypeJSON, value)
+		_node.UnmappedUsers = value
+	}
+	if value, ok := hic.mutation.Error(); ok {
+		_spec.SetField(historyimport.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := hic.mutation.CreatedAt(); ok {
+		_spec.SetField(historyimport.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := hic.mutation.CompletedAt(); ok {
+		_spec.SetField(historyimport.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	return _node, _spec
+}
+
+// HistoryImportCreateBulk is the builder for creating many HistoryImport entities in bulk.
+type HistoryImportCreateBulk struct {
+	config
+	err      error
+	builders []*HistoryImportCreate
+}
+
+// Save creates the HistoryImport entities in the database.
+func (hicb *HistoryImportCreateBulk) Save(ctx context.Context) ([]*HistoryImport, error) {
+	if hicb.err != nil {
+		return nil, hicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(hicb.builders))
+	nodes := make([]*HistoryImport, len(hicb.builders))
+	mutators := make([]Mutator, len(hicb.builders))
+	for i := range hicb.builders {
+		func(i int, root context.Context) {
+			builder := hicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HistoryImportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, hicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, hicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, hicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hicb *HistoryImportCreateBulk) SaveX(ctx context.Context) []*HistoryImport {
+	v, err := hicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hicb *HistoryImportCreateBulk) Exec(ctx context.Context) error {
+	_, err := hicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hicb *HistoryImportCreateBulk) ExecX(ctx context.Context) {
+	if err := hicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
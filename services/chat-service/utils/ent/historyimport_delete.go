@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/historyimport"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// HistoryImportDelete is the builder for deleting a HistoryImport entity.
+type HistoryImportDelete struct {
+	config
+	hooks    []Hook
+	mutation *HistoryImportMutation
+}
+
+// Where appends a list predicates to the HistoryImportDelete builder.
+func (hid *HistoryImportDelete) Where(ps ...predicate.HistoryImport) *HistoryImportDelete {
+	hid.mutation.Where(ps...)
+	return hid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (hid *HistoryImportDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, hid.sqlExec, hid.mutation, hid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hid *HistoryImportDelete) ExecX(ctx context.Context) int {
+	n, err := hid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (hid *HistoryImportDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(historyimport.Table, sqlgraph.NewFieldSpec(historyimport.FieldID, field.TypeInt))
+	if ps := hid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, hid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	hid.mutation.done = true
+	return affected, err
+}
+
+// HistoryImportDeleteOne is the builder for deleting a single HistoryImport entity.
+type HistoryImportDeleteOne struct {
+	hid *HistoryImportDelete
+}
+
+// Where appends a list predicates to the HistoryImportDelete builder.
+func (hido *HistoryImportDeleteOne) Where(ps ...predicate.HistoryImport) *HistoryImportDeleteOne {
+	hido.hid.mutation.Where(ps...)
+	return hido
+}
+
+// Exec executes the deletion query.
+func (hido *HistoryImportDeleteOne) Exec(ctx context.Context) error {
+	n, err := hido.hid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{historyimport.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hido *HistoryImportDeleteOne) ExecX(ctx context.Context) {
+	if err := hido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
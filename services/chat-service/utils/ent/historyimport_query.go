@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/historyimport"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// HistoryImportQuery is the builder for querying HistoryImport entities.
+type HistoryImportQuery struct {
+	config
+	ctx        *QueryContext
+	order      []historyimport.OrderOption
+	inters     []Interceptor
+	predicates []predicate.HistoryImport
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the HistoryImportQuery builder.
+func (hiq *HistoryImportQuery) Where(ps ...predicate.HistoryImport) *HistoryImportQuery {
+	hiq.predicates = append(hiq.predicates, ps...)
+	return hiq
+}
+
+// Limit the number of records to be returned by this query.
+func (hiq *HistoryImportQuery) Limit(limit int) *HistoryImportQuery {
+	hiq.ctx.Limit = &limit
+	return hiq
+}
+
+// Offset to start from.
+func (hiq *HistoryImportQuery) Offset(offset int) *HistoryImportQuery {
+	hiq.ctx.Offset = &offset
+	return hiq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (hiq *HistoryImportQuery) Unique(unique bool) *HistoryImportQuery {
+	hiq.ctx.Unique = &unique
+	return hiq
+}
+
+// Order specifies how the records should be ordered.
+func (hiq *HistoryImportQuery) Order(o ...historyimport.OrderOption) *HistoryImportQuery {
+	hiq.order = append(hiq.order, o...)
+	return hiq
+}
+
+// First returns the first HistoryImport entity from the query.
+// Returns a *NotFoundError when no HistoryImport was found.
+func (hiq *HistoryImportQuery) First(ctx context.Context) (*HistoryImport, error) {
+	nodes, err := hiq.Limit(1).All(setContextOp(ctx, hiq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{historyimport.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (hiq *HistoryImportQuery) FirstX(ctx context.Context) *HistoryImport {
+	node, err := hiq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first HistoryImport ID from the query.
+// Returns a *NotFoundError when no HistoryImport ID was found.
+func (hiq *HistoryImportQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = hiq.Limit(1).IDs(setContextOp(ctx, hiq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{historyimport.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (hiq *HistoryImportQuery) FirstIDX(ctx context.Context) int {
+	id, err := hiq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single HistoryImport entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one HistoryImport entity is found.
+// Returns a *NotFoundError when no HistoryImport entities are found.
+func (hiq *HistoryImportQuery) Only(ctx context.Context) (*HistoryImport, error) {
+	nodes, err := hiq.Limit(2).All(setContextOp(ctx, hiq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{historyimport.Label}
+	default:
+		return nil, &NotSingularError{historyimport.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (hiq *HistoryImportQuery) OnlyX(ctx context.Context) *HistoryImport {
+	node, err := hiq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only HistoryImport ID in the query.
+// Returns a *NotSingularError when more than one HistoryImport ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (hiq *HistoryImportQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = hiq.Limit(2).IDs(setContextOp(ctx, hiq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{historyimport.Label}
+	default:
+		err = &NotSingularError{historyimport.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (hiq *HistoryImportQuery) OnlyIDX(ctx context.Context) int {
+	id, err := hiq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of HistoryImports.
+func (hiq *HistoryImportQuery) All(ctx context.Context) ([]*HistoryImport, error) {
+	ctx = setContextOp(ctx, hiq.ctx, ent.OpQueryAll)
+	if err := hiq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*HistoryImport, *HistoryImportQuery]()
+	return withInterceptors[[]*HistoryImport](ctx, hiq, qr, hiq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (hiq *HistoryImportQuery) AllX(ctx context.Context) []*HistoryImport {
+	nodes, err := hiq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of HistoryImport IDs.
+func (hiq *HistoryImportQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if hiq.ctx.Unique == nil && hiq.path != nil {
+		hiq.Unique(true)
+	}
+	ctx = setContextOp(ctx, hiq.ctx, ent.OpQueryIDs)
+	if err = hiq.Select(historyimport.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (hiq *HistoryImportQuery) IDsX(ctx context.Context) []int {
+	ids, err := hiq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (hiq *HistoryImportQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, hiq.ctx, ent.OpQueryCount)
+	if err := hiq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, hiq, querierCount[*HistoryImportQuery](), hiq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (hiq *HistoryImportQuery) CountX(ctx context.Context) int {
+	count, err := hiq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (hiq *HistoryImportQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, hiq.ctx, ent.OpQueryExist)
+	switch _, err := hiq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (hiq *HistoryImportQuery) ExistX(ctx context.Context) bool {
+	exist, err := hiq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the HistoryImportQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (hiq *HistoryImportQuery) Clone() *HistoryImportQuery {
+	if hiq == nil {
+		return nil
+	}
+	return &HistoryImportQuery{
+		config:     hiq.config,
+		ctx:        hiq.ctx.Clone(),
+		order:      append([]historyimport.OrderOption{}, hiq.order...),
+		inters:     append([]Interceptor{}, hiq.inters...),
+		predicates: append([]predicate.HistoryImport{}, hiq.predicates...),
+		// clone intermediate query.
+		sql:  hiq.sql.Clone(),
+		path: hiq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Format historyimport.Format `json:"format,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.HistoryImport.Query().
+//		GroupBy(historyimport.FieldFormat).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (hiq *HistoryImportQuery) GroupBy(field string, fields ...string) *HistoryImportGroupBy {
+	hiq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &HistoryImportGroupBy{build: hiq}
+	grbuild.flds = &hiq.ctx.Fields
+	grbuild.label = historyimport.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Format historyimport.Format `json:"format,omitempty"`
+//	}
+//
+//	client.HistoryImport.Query().
+//		Select(historyimport.FieldFormat).
+//		Scan(ctx, &v)
+func (hiq *HistoryImportQuery) Select(fields ...string) *HistoryImportSelect {
+	hiq.ctx.Fields = append(hiq.ctx.Fields, fields...)
+	sbuild := &HistoryImportSelect{HistoryImportQuery: hiq}
+	sbuild.label = historyimport.Label
+	sbuild.flds, sbuild.scan = &hiq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a HistoryImportSelect configured with the given aggregations.
+func (hiq *HistoryImportQuery) Aggregate(fns ...AggregateFunc) *HistoryImportSelect {
+	return hiq.Select().Aggregate(fns...)
+}
+
+func (hiq *HistoryImportQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range hiq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, hiq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range hiq.ctx.Fields {
+		if !historyimport.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if hiq.path != nil {
+		prev, err := hiq.path(ctx)
+		if err != nil {
+			return err
+		}
+		hiq.sql = prev
+	}
+	return nil
+}
+
+func (hiq *HistoryImportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*HistoryImport, error) {
+	var (
+		nodes = []*HistoryImport{}
+		_spec = hiq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*HistoryImport).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &HistoryImport{config: hiq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, hiq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (hiq *HistoryImportQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := hiq.querySpec()
+	_spec.Node.Columns = hiq.ctx.Fields
+	if len(hiq.ctx.Fields) > 0 {
+		_spec.Unique = hiq.ctx.Unique != nil && *hiq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, hiq.driver, _spec)
+}
+
+func (hiq *HistoryImportQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(historyimport.Table, historyimport.Columns, sqlgraph.NewFieldSpec(historyimport.FieldID, field.TypeInt))
+	_spec.From = hiq.sql
+	if unique := hiq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if hiq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := hiq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, historyimport.FieldID)
+		for i := range fields {
+			if fields[i] != historyimport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := hiq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := hiq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := hiq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := hiq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (hiq *HistoryImportQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(hiq.driver.Dialect())
+	t1 := builder.Table(historyimport.Table)
+	columns := hiq.ctx.Fields
+	if len(columns) == 0 {
+		columns = historyimport.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if hiq.sql != nil {
+		selector = hiq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if hiq.ctx.Unique != nil && *hiq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range hiq.predicates {
+		p(selector)
+	}
+	for _, p := range hiq.order {
+		p(selector)
+	}
+	if offset := hiq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := hiq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// HistoryImportGroupBy is the group-by builder for HistoryImport entities.
+type HistoryImportGroupBy struct {
+	selector
+	build *HistoryImportQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (higb *HistoryImportGroupBy) Aggregate(fns ...AggregateFunc) *HistoryImportGroupBy {
+	higb.fns = append(higb.fns, fns...)
+	return higb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (higb *HistoryImportGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, higb.build.ctx, ent.OpQueryGroupBy)
+	if err := higb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HistoryImportQuery, *HistoryImportGroupBy](ctx, higb.build, higb, higb.build.inters, v)
+}
+
+func (higb *HistoryImportGroupBy) sqlScan(ctx context.Context, root *HistoryImportQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(higb.fns))
+	for _, fn := range higb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*higb.flds)+len(higb.fns))
+		for _, f := range *higb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*higb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := higb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// HistoryImportSelect is the builder for selecting fields of HistoryImport entities.
+type HistoryImportSelect struct {
+	*HistoryImportQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (his *HistoryImportSelect) Aggregate(fns ...AggregateFunc) *HistoryImportSelect {
+	his.fns = append(his.fns, fns...)
+	return his
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (his *HistoryImportSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, his.ctx, ent.OpQuerySelect)
+	if err := his.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HistoryImportQuery, *HistoryImportSelect](ctx, his.HistoryImportQuery, his, his.inters, v)
+}
+
+func (his *HistoryImportSelect) sqlScan(ctx context.Context, root *HistoryImportQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(his.fns))
+	for _, fn := range his.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*his.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := his.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
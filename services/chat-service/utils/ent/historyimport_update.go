@@ -0,0 +1,760 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/historyimport"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/schema"
+)
+
+// HistoryImportUpdate is the builder for updating HistoryImport entities.
+type HistoryImportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HistoryImportMutation
+}
+
+// Where appends a list predicates to the HistoryImportUpdate builder.
+func (hiu *HistoryImportUpdate) Where(ps ...predicate.HistoryImport) *HistoryImportUpdate {
+	hiu.mutation.Where(ps...)
+	return hiu
+}
+
+// SetFormat sets the "format" field.
+func (hiu *HistoryImportUpdate) SetFormat(h historyimport.Format) *HistoryImportUpdate {
+	hiu.mutation.SetFormat(h)
+	return hiu
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (hiu *HistoryImportUpdate) SetNillableFormat(h *historyimport.Format) *HistoryImportUpdate {
+	if h != nil {
+		hiu.SetFormat(*h)
+	}
+	return hiu
+}
+
+// SetFilePath sets the "file_path" field.
+func (hiu *HistoryImportUpdate) SetFilePath(s string) *HistoryImportUpdate {
+	hiu.mutation.SetFilePath(s)
+	return hiu
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (hiu *HistoryImportUpdate) SetNillableFilePath(s *string) *HistoryImportUpdate {
+	if s != nil {
+		hiu.SetFilePath(*s)
+	}
+	return hiu
+}
+
+// SetUserID sets the "user_id" field.
+func (hiu *HistoryImportUpdate) SetUserID(s string) *HistoryImportUpdate {
+	hiu.mutation.SetUserID(s)
+	return hiu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (hiu *HistoryImportUpdate) SetNillableUserID(s *string) *HistoryImportUpdate {
+	if s != nil {
+		hiu.SetUserID(*s)
+	}
+	return hiu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (hiu *HistoryImportUpdate) ClearUserID() *HistoryImportUpdate {
+	hiu.mutation.ClearUserID()
+	return hiu
+}
+
+// SetStatus sets the "status" field.
+func (hiu *HistoryImportUpdate) SetStatus(h historyimport.Status) *HistoryImportUpdate {
+	hiu.mutation.SetStatus(h)
+	return hiu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (hiu *HistoryImportUpdate) SetNillableStatus(h *historyimport.Status) *HistoryImportUpdate {
+	if h != nil {
+		hiu.SetStatus(*h)
+	}
+	return hiu
+}
+
+// SetRoomsCreated sets the "rooms_created" field.
+func (hiu *HistoryImportUpdate) SetRoomsCreated(i int) *HistoryImportUpdate {
+	hiu.mutation.ResetRoomsCreated()
+	hiu.mutation.SetRoomsCreated(i)
+	return hiu
+}
+
+// SetNillableRoomsCreated sets the "rooms_created" field if the given value is not nil.
+func (hiu *HistoryImportUpdate) SetNillableRoomsCreated(i *int) *HistoryImportUpdate {
+	if i != nil {
+		hiu.SetRoomsCreated(*i)
+	}
+	return hiu
+}
+
+// AddRoomsCreated adds i to the "rooms_created" field.
+func (hiu *HistoryImportUpdate) AddRoomsCreated(i int) *HistoryImportUpdate {
+	hiu.mutation.AddRoomsCreated(i)
+	return hiu
+}
+
+// SetMessagesImported sets the "messages_imported" field.
+func (hiu *HistoryImportUpdate) SetMessagesImported(i int) *HistoryImportUpdate {
+	hiu.mutation.ResetMessagesImported()
+	hiu.mutation.SetMessagesImported(i)
+	return hiu
+}
+
+// SetNillableMessagesImported sets the "messages_imported" field if the given value is not nil.
+func (hiu *HistoryImportUpdate) SetNillableMessagesImported(i *int) *HistoryImportUpdate {
+	if i != nil {
+		hiu.SetMessagesImported(*i)
+	}
+	return hiu
+}
+
+// AddMessagesImported adds i to the "messages_imported" field.
+func (hiu *HistoryImportUpdate) AddMessagesImported(i int) *HistoryImportUpdate {
+	hiu.mutation.AddMessagesImported(i)
+	return hiu
+}
+
+// SetMessagesSkipped sets the "messages_skipped" field.
+func (hiu *HistoryImportUpdate) SetMessagesSkipped(i int) *HistoryImportUpdate {
+	hiu.mutation.ResetMessagesSkipped()
+	hiu.mutation.SetMessagesSkipped(i)
+	return hiu
+}
+
+// SetNillableMessagesSkipped sets the "messages_skipped" field if the given value is not nil.
+func (hiu *HistoryImportUpdate) SetNillableMessagesSkipped(i *int) *HistoryImportUpdate {
+	if i != nil {
+		hiu.SetMessagesSkipped(*i)
+	}
+	return hiu
+}
+
+// AddMessagesSkipped adds i to the "messages_skipped" field.
+func (hiu *HistoryImportUpdate) AddMessagesSkipped(i int) *HistoryImportUpdate {
+	hiu.mutation.AddMessagesSkipped(i)
+	return hiu
+}
+
+// SetCompletedRooms sets the "completed_rooms" field.
+func (hiu *HistoryImportUpdate) SetCompletedRooms(s []string) *HistoryImportUpdate {
+	hiu.mutation.SetCompletedRooms(s)
+	return hiu
+}
+
+// AppendCompletedRooms appends s to the "completed_rooms" field.
+func (hiu *HistoryImportUpdate) AppendCompletedRooms(s []string) *HistoryImportUpdate {
+	hiu.mutation.AppendCompletedRooms(s)
+	return hiu
+}
+
+// ClearCompletedRooms clears the value of the "completed_rooms" field.
+func (hiu *HistoryImportUpdate) ClearCompletedRooms() *HistoryImportUpdate {
+	hiu.mutation.ClearCompletedRooms()
+	return hiu
+}
+
+// SetUnmappedUsers sets the "unmapped_users" field.
+func (hiu *HistoryImportUpdate) SetUnmappedUsers(su []schema.UnmappedUser) *HistoryImportUpdate {
+	hiu.mutation.SetUnmappedUsers(su)
+	return hiu
+}
+
+// AppendUnmappedUsers appends su to the "unmapped_users" field.
+func (hiu *HistoryImportUpdate) AppendUnmappedUsers(su []schema.UnmappedUser) *HistoryImportUpdate {
+	hiu.mutation.AppendUnmappedUsers(su)
+	return hiu
+}
+
+// ClearUnmappedUsers clears the value of the "unmapped_users" field.
+func (hiu *HistoryImportUpdate) ClearUnmappedUsers() *HistoryImportUpdate {
+	hiu.mutation.ClearUnmappedUsers()
+	return hiu
+}
+
+// SetError sets the "error" field.
+func (hiu *HistoryImportUpdate) SetError(s string) *HistoryImportUpdate {
+	hiu.mutation.SetError(s)
+	return hiu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (hiu *HistoryImportUpdate) SetNillableError(s *string) *HistoryImportUpdate {
+	if s != nil {
+		hiu.SetError(*s)
+	}
+	return hiu
+}
+
+// ClearError clears the value of the "error" field.
+func (hiu *HistoryImportUpdate) ClearError() *HistoryImportUpdate {
+	hiu.mutation.ClearError()
+	return hiu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (hiu *HistoryImportUpdate) SetCompletedAt(t time.Time) *HistoryImportUpdate {
+	hiu.mutation.SetCompletedAt(t)
+	return hiu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (hiu *HistoryImportUpdate) SetNillableCompletedAt(t *time.Time) *HistoryImportUpdate {
+	if t != nil {
+		hiu.SetCompletedAt(*t)
+	}
+	return hiu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (hiu *HistoryImportUpdate) ClearCompletedAt() *HistoryImportUpdate {
+	hiu.mutation.ClearCompletedAt()
+	return hiu
+}
+
+// Mutation returns the HistoryImportMutation object of the builder.
+func (hiu *HistoryImportUpdate) Mutation() *HistoryImportMutation {
+	return hiu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (hiu *HistoryImportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, hiu.sqlSave, hiu.mutation, hiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hiu *HistoryImportUpdate) SaveX(ctx context.Context) int {
+	affected, err := hiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (hiu *HistoryImportUpdate) Exec(ctx context.Context) error {
+	_, err := hiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hiu *HistoryImportUpdate) ExecX(ctx context.Context) {
+	if err := hiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hiu *HistoryImportUpdate) check() error {
+	if v, ok := hiu.mutation.Format(); ok {
+		if err := historyimport.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "HistoryImport.format": %w`, err)}
+		}
+	}
+	if v, ok := hiu.mutation.FilePath(); ok {
+		if err := historyimport.FilePathValidator(v); err != nil {
+			return &ValidationError{Name: "file_path", err: fmt.Errorf(`ent: validator failed for field "HistoryImport.file_path": %w`, err)}
+		}
+	}
+	if v, ok := hiu.mutation.Status(); ok {
+		if err := historyimport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "HistoryImport.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (hiu *HistoryImportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := hiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(historyimport.Table, historyimport.Columns, sqlgraph.NewFieldSpec(historyimport.FieldID, field.TypeInt))
+	if ps := hiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hiu.mutation.Format(); ok {
+		_spec.SetField(historyimport.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := hiu.mutation.FilePath(); ok {
+		_spec.SetField(historyimport.FieldFilePath, field.TypeString, value)
+	}
+	if value, ok := hiu.mutation.UserID(); ok {
+		_spec.SetField(historyimport.FieldUserID, field.TypeString, value)
+	}
+	if hiu.mutation.UserIDCleared() {
+		_spec.ClearField(historyimport.FieldUserID, field.TypeString)
+	}
+	if value, ok := hiu.mutation.Status(); ok {
+		_spec.SetField(historyimport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := hiu.mutation.RoomsCreated(); ok {
+		_spec.SetField(historyimport.FieldRoomsCreated, field.TypeInt, value)
+	}
+	if value, ok := hiu.mutation.AddedRoomsCreated(); ok {
+		_spec.AddField(historyimport.FieldRoomsCreated, field.TypeInt, value)
+	}
+	if value, ok := hiu.mutation.MessagesImported(); ok {
+		_spec.SetField(historyimport.FieldMessagesImported, field.TypeInt, value)
+	}
+	if value, ok := hiu.mutation.AddedMessagesImported(); ok {
+		_spec.AddField(historyimport.FieldMessagesImported, field.TypeInt, value)
+	}
+	if value, ok := hiu.mutation.MessagesSkipped(); ok {
+		_spec.SetField(historyimport.FieldMessagesSkipped, field.TypeInt, value)
+	}
+	if value, ok := hiu.mutation.AddedMessagesSkipped(); ok {
+		_spec.AddField(historyimport.FieldMessagesSkipped, field.TypeInt, value)
+	}
+	if value, ok := hiu.mutation.CompletedRooms(); ok {
+		_spec.SetField(historyimport.FieldCompletedRooms, field.TypeJSON, value)
+	}
+	if value, ok := hiu.mutation.AppendedCompletedRooms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, historyimport.FieldCompletedRooms, value)
+		})
+	}
+	if hiu.mutation.CompletedRoomsCleared() {
+		_spec.ClearField(historyimport.FieldCompletedRooms, field.TypeJSON)
+	}
+	if value, ok := hiu.mutation.UnmappedUsers(); ok {
+		_spec.SetField(historyimport.FieldUnmappedUsers, field.TypeJSON, value)
+	}
+	if value, ok := hiu.mutation.AppendedUnmappedUsers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, historyimport.FieldUnmappedUsers, value)
+		})
+	}
+	if hiu.mutation.UnmappedUsersCleared() {
+		_spec.ClearField(historyimport.FieldUnmappedUsers, field.TypeJSON)
+	}
+	if value, ok := hiu.mutation.Error(); ok {
+		_spec.SetField(historyimport.FieldError, field.TypeString, value)
+	}
+	if hiu.mutation.ErrorCleared() {
+		_spec.ClearField(historyimport.FieldError, field.TypeString)
+	}
+	if value, ok := hiu.mutation.CompletedAt(); ok {
+		_spec.SetField(historyimport.FieldCompletedAt, field.TypeTime, value)
+	}
+	if hiu.mutation.CompletedAtCleared() {
+		_spec.ClearField(historyimport.FieldCompletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, hiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{historyimport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	hiu.mutation.done = true
+	return n, nil
+}
+
+// HistoryImportUpdateOne is the builder for updating a single HistoryImport entity.
+type HistoryImportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HistoryImportMutation
+}
+
+// SetFormat sets the "format" field.
+func (hiuo *HistoryImportUpdateOne) SetFormat(h historyimport.Format) *HistoryImportUpdateOne {
+	hiuo.mutation.SetFormat(h)
+	return hiuo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (hiuo *HistoryImportUpdateOne) SetNillableFormat(h *historyimport.Format) *HistoryImportUpdateOne {
+	if h != nil {
+		hiuo.SetFormat(*h)
+	}
+	return hiuo
+}
+
+// SetFilePath sets the "file_path" field.
+func (hiuo *HistoryImportUpdateOne) SetFilePath(s string) *HistoryImportUpdateOne {
+	hiuo.mutation.SetFilePath(s)
+	return hiuo
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (hiuo *HistoryImportUpdateOne) SetNillableFilePath(s *string) *HistoryImportUpdateOne {
+	if s != nil {
+		hiuo.SetFilePath(*s)
+	}
+	return hiuo
+}
+
+// SetUserID sets the "user_id" field.
+func (hiuo *HistoryImportUpdateOne) SetUserID(s string) *HistoryImportUpdateOne {
+	hiuo.mutation.SetUserID(s)
+	return hiuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (hiuo *HistoryImportUpdateOne) SetNillableUserID(s *string) *HistoryImportUpdateOne {
+	if s != nil {
+		hiuo.SetUserID(*s)
+	}
+	return hiuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (hiuo *HistoryImportUpdateOne) ClearUserID() *HistoryImportUpdateOne {
+	hiuo.mutation.ClearUserID()
+	return hiuo
+}
+
+// SetStatus sets the "status" field.
+func (hiuo *HistoryImportUpdateOne) SetStatus(h historyimport.Status) *HistoryImportUpdateOne {
+	hiuo.mutation.SetStatus(h)
+	return hiuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (hiuo *HistoryImportUpdateOne) SetNillableStatus(h *historyimport.Status) *HistoryImportUpdateOne {
+	if h != nil {
+		hiuo.SetStatus(*h)
+	}
+	return hiuo
+}
+
+// SetRoomsCreated sets the "rooms_created" field.
+func (hiuo *HistoryImportUpdateOne) SetRoomsCreated(i int) *HistoryImportUpdateOne {
+	hiuo.mutation.ResetRoomsCreated()
+	hiuo.mutation.SetRoomsCreated(i)
+	return hiuo
+}
+
+// SetNillableRoomsCreated sets the "rooms_created" field if the given value is not nil.
+func (hiuo *HistoryImportUpdateOne) SetNillableRoomsCreated(i *int) *HistoryImportUpdateOne {
+	if i != nil {
+		hiuo.SetRoomsCreated(*i)
+	}
+	return hiuo
+}
+
+// AddRoomsCreated adds i to the "rooms_created" field.
+func (hiuo *HistoryImportUpdateOne) AddRoomsCreated(i int) *HistoryImportUpdateOne {
+	hiuo.mutation.AddRoomsCreated(i)
+	return hiuo
+}
+
+// SetMessagesImported sets the "messages_imported" field.
+func (hiuo *HistoryImportUpdateOne) SetMessagesImported(i int) *HistoryImportUpdateOne {
+	hiuo.mutation.ResetMessagesImported()
+	hiuo.mutation.SetMessagesImported(i)
+	return hiuo
+}
+
+// SetNillableMessagesImported sets the "messages_imported" field if the given value is not nil.
+func (hiuo *HistoryImportUpdateOne) SetNillableMessagesImported(i *int) *HistoryImportUpdateOne {
+	if i != nil {
+		hiuo.SetMessagesImported(*i)
+	}
+	return hiuo
+}
+
+// AddMessagesImported adds i to the "messages_imported" field.
+func (hiuo *HistoryImportUpdateOne) AddMessagesImported(i int) *HistoryImportUpdateOne {
+	hiuo.mutation.AddMessagesImported(i)
+	return hiuo
+}
+
+// SetMessagesSkipped sets the "messages_skipped" field.
+func (hiuo *HistoryImportUpdateOne) SetMessagesSkipped(i int) *HistoryImportUpdateOne {
+	hiuo.mutation.ResetMessagesSkipped()
+	hiuo.mutation.SetMessagesSkipped(i)
+	return hiuo
+}
+
+// SetNillableMessagesSkipped sets the "messages_skipped" field if the given value is not nil.
+func (hiuo *HistoryImportUpdateOne) SetNillableMessagesSkipped(i *int) *HistoryImportUpdateOne {
+	if i != nil {
+		hiuo.SetMessagesSkipped(*i)
+	}
+	return hiuo
+}
+
+// AddMessagesSkipped adds i to the "messages_skipped" field.
+func (hiuo *HistoryImportUpdateOne) AddMessagesSkipped(i int) *HistoryImportUpdateOne {
+	hiuo.mutation.AddMessagesSkipped(i)
+	return hiuo
+}
+
+// SetCompletedRooms sets the "completed_rooms" field.
+func (hiuo *HistoryImportUpdateOne) SetCompletedRooms(s []string) *HistoryImportUpdateOne {
+	hiuo.mutation.SetCompletedRooms(s)
+	return hiuo
+}
+
+// AppendCompletedRooms appends s to the "completed_rooms" field.
+func (hiuo *HistoryImportUpdateOne) AppendCompletedRooms(s []string) *HistoryImportUpdateOne {
+	hiuo.mutation.AppendCompletedRooms(s)
+	return hiuo
+}
+
+// ClearCompletedRooms clears the value of the "completed_rooms" field.
+func (hiuo *HistoryImportUpdateOne) ClearCompletedRooms() *HistoryImportUpdateOne {
+	hiuo.mutation.ClearCompletedRooms()
+	return hiuo
+}
+
+// SetUnmappedUsers sets the "unmapped_users" field.
+func (hiuo *HistoryImportUpdateOne) SetUnmappedUsers(su []schema.UnmappedUser) *HistoryImportUpdateOne {
+	hiuo.mutation.SetUnmappedUsers(su)
+	return hiuo
+}
+
+// AppendUnmappedUsers appends su to the "unmapped_users" field.
+func (hiuo *HistoryImportUpdateOne) AppendUnmappedUsers(su []schema.UnmappedUser) *HistoryImportUpdateOne {
+	hiuo.mutation.AppendUnmappedUsers(su)
+	return hiuo
+}
+
+// ClearUnmappedUsers clears the value of the "unmapped_users" field.
+func (hiuo *HistoryImportUpdateOne) ClearUnmappedUsers() *HistoryImportUpdateOne {
+	hiuo.mutation.ClearUnmappedUsers()
+	return hiuo
+}
+
+// SetError sets the "error" field.
+func (hiuo *HistoryImportUpdateOne) SetError(s string) *HistoryImportUpdateOne {
+	hiuo.mutation.SetError(s)
+	return hiuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (hiuo *HistoryImportUpdateOne) SetNillableError(s *string) *HistoryImportUpdateOne {
+	if s != nil {
+		hiuo.SetError(*s)
+	}
+	return hiuo
+}
+
+// ClearError clears the value of the "error" field.
+func (hiuo *HistoryImportUpdateOne) ClearError() *HistoryImportUpdateOne {
+	hiuo.mutation.ClearError()
+	return hiuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (hiuo *HistoryImportUpdateOne) SetCompletedAt(t time.Time) *HistoryImportUpdateOne {
+	hiuo.mutation.SetCompletedAt(t)
+	return hiuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (hiuo *HistoryImportUpdateOne) SetNillableCompletedAt(t *time.Time) *HistoryImportUpdateOne {
+	if t != nil {
+		hiuo.SetCompletedAt(*t)
+	}
+	return hiuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (hiuo *HistoryImportUpdateOne) ClearCompletedAt() *HistoryImportUpdateOne {
+	hiuo.mutation.ClearCompletedAt()
+	return hiuo
+}
+
+// Mutation returns the HistoryImportMutation object of the builder.
+func (hiuo *HistoryImportUpdateOne) Mutation() *HistoryImportMutation {
+	return hiuo.mutation
+}
+
+// Where appends a list predicates to the HistoryImportUpdate builder.
+func (hiuo *HistoryImportUpdateOne) Where(ps ...predicate.HistoryImport) *HistoryImportUpdateOne {
+	hiuo.mutation.Where(ps...)
+	return hiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (hiuo *HistoryImportUpdateOne) Select(field string, fields ...string) *HistoryImportUpdateOne {
+	hiuo.fields = append([]string{field}, fields...)
+	return hiuo
+}
+
+// Save executes the query and returns the updated HistoryImport entity.
+func (hiuo *HistoryImportUpdateOne) Save(ctx context.Context) (*HistoryImport, error) {
+	return withHooks(ctx, hiuo.sqlSave, hiuo.mutation, hiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hiuo *HistoryImportUpdateOne) SaveX(ctx context.Context) *HistoryImport {
+	node, err := hiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (hiuo *HistoryImportUpdateOne) Exec(ctx context.Context) error {
+	_, err := hiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hiuo *HistoryImportUpdateOne) ExecX(ctx context.Context) {
+	if err := hiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hiuo *HistoryImportUpdateOne) check() error {
+	if v, ok := hiuo.mutation.Format(); ok {
+		if err := historyimport.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "HistoryImport.format": %w`, err)}
+		}
+	}
+	if v, ok := hiuo.mutation.FilePath(); ok {
+		if err := historyimport.FilePathValidator(v); err != nil {
+			return &ValidationError{Name: "file_path", err: fmt.Errorf(`ent: validator failed for field "HistoryImport.file_path": %w`, err)}
+		}
+	}
+	if v, ok := hiuo.mutation.Status(); ok {
+		if err := historyimport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "HistoryImport.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (hiuo *HistoryImportUpdateOne) sqlSave(ctx context.Context) (_node *HistoryImport, err error) {
+	if err := hiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(historyimport.Table, historyimport.Columns, sqlgraph.NewFieldSpec(historyimport.FieldID, field.TypeInt))
+	id, ok := hiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "HistoryImport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := hiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, historyimport.FieldID)
+		for _, f := range fields {
+			if !historyimport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != historyimport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := hiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hiuo.mutation.Format(); ok {
+		_spec.SetField(historyimport.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := hiuo.mutation.FilePath(); ok {
+		_spec.SetField(historyimport.FieldFilePath, field.TypeString, value)
+	}
+	if value, ok := hiuo.mutation.UserID(); ok {
+		_spec.SetField(historyimport.FieldUserID, field.TypeString, value)
+	}
+	if hiuo.mutation.UserIDCleared() {
+		_spec.ClearField(historyimport.FieldUserID, field.TypeString)
+	}
+	if value, ok := hiuo.mutation.Status(); ok {
+		_spec.SetField(historyimport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := hiuo.mutation.RoomsCreated(); ok {
+		_spec.SetField(historyimport.FieldRoomsCreated, field.TypeInt, value)
+	}
+	if value, ok := hiuo.mutation.AddedRoomsCreated(); ok {
+		_spec.AddField(historyimport.FieldRoomsCreated, field.TypeInt, value)
+	}
+	if value, ok := hiuo.mutation.MessagesImported(); ok {
+		_spec.SetField(historyimport.FieldMessagesImported, field.TypeInt, value)
+	}
+	if value, ok := hiuo.mutation.AddedMessagesImported(); ok {
+		_spec.AddField(historyimport.FieldMessagesImported, field.TypeInt, value)
+	}
+	if value, ok := hiuo.mutation.MessagesSkipped(); ok {
+		_spec.SetField(historyimport.FieldMessagesSkipped, field.TypeInt, value)
+	}
+	if value, ok := hiuo.mutation.AddedMessagesSkipped(); ok {
+		_spec.AddField(historyimport.FieldMessagesSkipped, field.TypeInt, value)
+	}
+	if value, ok := hiuo.mutation.CompletedRooms(); ok {
+		_spec.SetField(historyimport.FieldCompletedRooms, field.TypeJSON, value)
+	}
+	if value, ok := hiuo.mutation.AppendedCompletedRooms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, historyimport.FieldCompletedRooms, value)
+		})
+	}
+	if hiuo.mutation.CompletedRoomsCleared() {
+		_spec.ClearField(historyimport.FieldCompletedRooms, field.TypeJSON)
+	}
+	if value, ok := hiuo.mutation.UnmappedUsers(); ok {
+		_spec.SetField(historyimport.FieldUnmappedUsers, field.TypeJSON, value)
+	}
+	if value, ok := hiuo.mutation.AppendedUnmappedUsers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, historyimport.FieldUnmappedUsers, value)
+		})
+	}
+	if hiuo.mutation.UnmappedUsersCleared() {
+		_spec.ClearField(historyimport.FieldUnmappedUsers, field.TypeJSON)
+	}
+	if value, ok := hiuo.mutation.Error(); ok {
+		_spec.SetField(historyimport.FieldError, field.TypeString, value)
+	}
+	if hiuo.mutation.ErrorCleared() {
+		_spec.ClearField(historyimport.FieldError, field.TypeString)
+	}
+	if value, ok := hiuo.mutation.CompletedAt(); ok {
+		_spec.SetField(historyimport.FieldCompletedAt, field.TypeTime, value)
+	}
+	if hiuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(historyimport.FieldCompletedAt, field.TypeTime)
+	}
+	_node = &HistoryImport{config: hiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, hiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{historyimport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	hiuo.mutation.done = true
+	return _node, nil
+}
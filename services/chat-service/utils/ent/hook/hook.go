@@ -9,6 +9,66 @@ import (
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent"
 )
 
+// The BotDeliveryFunc type is an adapter to allow the use of ordinary
+// function as BotDelivery mutator.
+type BotDeliveryFunc func(context.Context, *ent.BotDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BotDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BotDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BotDeliveryMutation", m)
+}
+
+// The BotSubscriptionFunc type is an adapter to allow the use of ordinary
+// function as BotSubscription mutator.
+type BotSubscriptionFunc func(context.Context, *ent.BotSubscriptionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BotSubscriptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BotSubscriptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BotSubscriptionMutation", m)
+}
+
+// The CallParticipantFunc type is an adapter to allow the use of ordinary
+// function as CallParticipant mutator.
+type CallParticipantFunc func(context.Context, *ent.CallParticipantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CallParticipantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CallParticipantMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CallParticipantMutation", m)
+}
+
+// The CallSessionFunc type is an adapter to allow the use of ordinary
+// function as CallSession mutator.
+type CallSessionFunc func(context.Context, *ent.CallSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CallSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CallSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CallSessionMutation", m)
+}
+
+// The HistoryImportFunc type is an adapter to allow the use of ordinary
+// function as HistoryImport mutator.
+type HistoryImportFunc func(context.Context, *ent.HistoryImportMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HistoryImportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HistoryImportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HistoryImportMutation", m)
+}
+
 // The MessageFunc type is an adapter to allow the use of ordinary
 // function as Message mutator.
 type MessageFunc func(context.Context, *ent.MessageMutation) (ent.Value, error)
@@ -21,6 +81,54 @@ func (f MessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageMutation", m)
 }
 
+// The MessagePurgeFunc type is an adapter to allow the use of ordinary
+// function as MessagePurge mutator.
+type MessagePurgeFunc func(context.Context, *ent.MessagePurgeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessagePurgeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MessagePurgeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessagePurgeMutation", m)
+}
+
+// The PinnedMessageFunc type is an adapter to allow the use of ordinary
+// function as PinnedMessage mutator.
+type PinnedMessageFunc func(context.Context, *ent.PinnedMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PinnedMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PinnedMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PinnedMessageMutation", m)
+}
+
+// The PollFunc type is an adapter to allow the use of ordinary
+// function as Poll mutator.
+type PollFunc func(context.Context, *ent.PollMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PollFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PollMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PollMutation", m)
+}
+
+// The PollVoteFunc type is an adapter to allow the use of ordinary
+// function as PollVote mutator.
+type PollVoteFunc func(context.Context, *ent.PollVoteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PollVoteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PollVoteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PollVoteMutation", m)
+}
+
 // The RoomFunc type is an adapter to allow the use of ordinary
 // function as Room mutator.
 type RoomFunc func(context.Context, *ent.RoomMutation) (ent.Value, error)
@@ -33,6 +141,78 @@ func (f RoomFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoomMutation", m)
 }
 
+// The RoomExportFunc type is an adapter to allow the use of ordinary
+// function as RoomExport mutator.
+type RoomExportFunc func(context.Context, *ent.RoomExportMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoomExportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoomExportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoomExportMutation", m)
+}
+
+// The RoomExportChunkFunc type is an adapter to allow the use of ordinary
+// function as RoomExportChunk mutator.
+type RoomExportChunkFunc func(context.Context, *ent.RoomExportChunkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoomExportChunkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoomExportChunkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoomExportChunkMutation", m)
+}
+
+// The RoomMemberFunc type is an adapter to allow the use of ordinary
+// function as RoomMember mutator.
+type RoomMemberFunc func(context.Context, *ent.RoomMemberMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoomMemberFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoomMemberMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoomMemberMutation", m)
+}
+
+// The SavedMessageFunc type is an adapter to allow the use of ordinary
+// function as SavedMessage mutator.
+type SavedMessageFunc func(context.Context, *ent.SavedMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SavedMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SavedMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SavedMessageMutation", m)
+}
+
+// The ScheduledMessageFunc type is an adapter to allow the use of ordinary
+// function as ScheduledMessage mutator.
+type ScheduledMessageFunc func(context.Context, *ent.ScheduledMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduledMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScheduledMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScheduledMessageMutation", m)
+}
+
+// The WebhookFunc type is an adapter to allow the use of ordinary
+// function as Webhook mutator.
+type WebhookFunc func(context.Context, *ent.WebhookMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -3,12 +3,16 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/schema"
 )
 
 // Message is the model entity for the Message schema.
@@ -21,19 +25,80 @@ type Message struct {
 	// RoomID holds the value of the "room_id" field.
 	RoomID string `json:"room_id,omitempty"`
 	// Username holds the value of the "username" field.
-	Username     string `json:"username,omitempty"`
+	Username string `json:"username,omitempty"`
+	// Bot holds the value of the "bot" field.
+	Bot bool `json:"bot,omitempty"`
+	// Attachments holds the value of the "attachments" field.
+	Attachments []schema.Attachment `json:"attachments,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// ImportKey holds the value of the "import_key" field.
+	ImportKey *string `json:"import_key,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MessageQuery when eager-loading is set.
+	Edges        MessageEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// MessageEdges holds the relations/edges for other nodes in the graph.
+type MessageEdges struct {
+	// Poll holds the value of the poll edge.
+	Poll *Poll `json:"poll,omitempty"`
+	// Pins holds the value of the pins edge.
+	Pins []*PinnedMessage `json:"pins,omitempty"`
+	// Saves holds the value of the saves edge.
+	Saves []*SavedMessage `json:"saves,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// PollOrErr returns the Poll value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) PollOrErr() (*Poll, error) {
+	if e.Poll != nil {
+		return e.Poll, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: poll.Label}
+	}
+	return nil, &NotLoadedError{edge: "poll"}
+}
+
+// PinsOrErr returns the Pins value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) PinsOrErr() ([]*PinnedMessage, error) {
+	if e.loadedTypes[1] {
+		return e.Pins, nil
+	}
+	return nil, &NotLoadedError{edge: "pins"}
+}
+
+// SavesOrErr returns the Saves value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) SavesOrErr() ([]*SavedMessage, error) {
+	if e.loadedTypes[2] {
+		return e.Saves, nil
+	}
+	return nil, &NotLoadedError{edge: "saves"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Message) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case message.FieldAttachments:
+			values[i] = new([]byte)
+		case message.FieldBot:
+			values[i] = new(sql.NullBool)
 		case message.FieldID:
 			values[i] = new(sql.NullInt64)
-		case message.FieldContent, message.FieldRoomID, message.FieldUsername:
+		case message.FieldContent, message.FieldRoomID, message.FieldUsername, message.FieldImportKey:
 			values[i] = new(sql.NullString)
+		case message.FieldCreatedAt, message.FieldArchivedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -73,6 +138,40 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.Username = value.String
 			}
+		case message.FieldBot:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field bot", values[i])
+			} else if value.Valid {
+				m.Bot = value.Bool
+			}
+		case message.FieldAttachments:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attachments", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &m.Attachments); err != nil {
+					return fmt.Errorf("unmarshal field attachments: %w", err)
+				}
+			}
+		case message.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				m.CreatedAt = value.Time
+			}
+		case message.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				m.ArchivedAt = new(time.Time)
+				*m.ArchivedAt = value.Time
+			}
+		case message.FieldImportKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field import_key", values[i])
+			} else if value.Valid {
+				m.ImportKey = new(string)
+				*m.ImportKey = value.String
+			}
 		default:
 			m.selectValues.Set(columns[i], values[i])
 		}
@@ -86,6 +185,21 @@ func (m *Message) Value(name string) (ent.Value, error) {
 	return m.selectValues.Get(name)
 }
 
+// QueryPoll queries the "poll" edge of the Message entity.
+func (m *Message) QueryPoll() *PollQuery {
+	return NewMessageClient(m.config).QueryPoll(m)
+}
+
+// QueryPins queries the "pins" edge of the Message entity.
+func (m *Message) QueryPins() *PinnedMessageQuery {
+	return NewMessageClient(m.config).QueryPins(m)
+}
+
+// QuerySaves queries the "saves" edge of the Message entity.
+func (m *Message) QuerySaves() *SavedMessageQuery {
+	return NewMessageClient(m.config).QuerySaves(m)
+}
+
 // Update returns a builder for updating this Message.
 // Note that you need to call Message.Unwrap() before calling this method if this Message
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -117,6 +231,25 @@ func (m *Message) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("username=")
 	builder.WriteString(m.Username)
+	builder.WriteString(", ")
+	builder.WriteString("bot=")
+	builder.WriteString(fmt.Sprintf("%v", m.Bot))
+	builder.WriteString(", ")
+	builder.WriteString("attachments=")
+	builder.WriteString(fmt.Sprintf("%v", m.Attachments))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := m.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := m.ImportKey; v != nil {
+		builder.WriteString("import_key=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
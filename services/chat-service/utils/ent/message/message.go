@@ -3,7 +3,10 @@
 package message
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -17,8 +20,45 @@ const (
 	FieldRoomID = "room_id"
 	// FieldUsername holds the string denoting the username field in the database.
 	FieldUsername = "username"
+	// FieldBot holds the string denoting the bot field in the database.
+	FieldBot = "bot"
+	// FieldAttachments holds the string denoting the attachments field in the database.
+	FieldAttachments = "attachments"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
+	// FieldImportKey holds the string denoting the import_key field in the database.
+	FieldImportKey = "import_key"
+	// EdgePoll holds the string denoting the poll edge name in mutations.
+	EdgePoll = "poll"
+	// EdgePins holds the string denoting the pins edge name in mutations.
+	EdgePins = "pins"
+	// EdgeSaves holds the string denoting the saves edge name in mutations.
+	EdgeSaves = "saves"
 	// Table holds the table name of the message in the database.
 	Table = "messages"
+	// PollTable is the table that holds the poll relation/edge.
+	PollTable = "polls"
+	// PollInverseTable is the table name for the Poll entity.
+	// It exists in this package in order to avoid circular dependency with the "poll" package.
+	PollInverseTable = "polls"
+	// PollColumn is the table column denoting the poll relation/edge.
+	PollColumn = "message_id"
+	// PinsTable is the table that holds the pins relation/edge.
+	PinsTable = "pinned_messages"
+	// PinsInverseTable is the table name for the PinnedMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "pinnedmessage" package.
+	PinsInverseTable = "pinned_messages"
+	// PinsColumn is the table column denoting the pins relation/edge.
+	PinsColumn = "message_id"
+	// SavesTable is the table that holds the saves relation/edge.
+	SavesTable = "saved_messages"
+	// SavesInverseTable is the table name for the SavedMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "savedmessage" package.
+	SavesInverseTable = "saved_messages"
+	// SavesColumn is the table column denoting the saves relation/edge.
+	SavesColumn = "message_id"
 )
 
 // Columns holds all SQL columns for message fields.
@@ -27,6 +67,11 @@ var Columns = []string{
 	FieldContent,
 	FieldRoomID,
 	FieldUsername,
+	FieldBot,
+	FieldAttachments,
+	FieldCreatedAt,
+	FieldArchivedAt,
+	FieldImportKey,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -46,6 +91,10 @@ var (
 	RoomIDValidator func(string) error
 	// UsernameValidator is a validator for the "username" field. It is called by the builders before save.
 	UsernameValidator func(string) error
+	// DefaultBot holds the default value on creation for the "bot" field.
+	DefaultBot bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
 )
 
 // OrderOption defines the ordering options for the Message queries.
@@ -70,3 +119,79 @@ func ByRoomID(opts ...sql.OrderTermOption) OrderOption {
 func ByUsername(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsername, opts...).ToFunc()
 }
+
+// ByBot orders the results by the bot field.
+func ByBot(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBot, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
+// ByImportKey orders the results by the import_key field.
+func ByImportKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImportKey, opts...).ToFunc()
+}
+
+// ByPollField orders the results by poll field.
+func ByPollField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPollStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPinsCount orders the results by pins count.
+func ByPinsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPinsStep(), opts...)
+	}
+}
+
+// ByPins orders the results by pins terms.
+func ByPins(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPinsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavesCount orders the results by saves count.
+func BySavesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavesStep(), opts...)
+	}
+}
+
+// BySaves orders the results by saves terms.
+func BySaves(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPollStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PollInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, PollTable, PollColumn),
+	)
+}
+func newPinsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PinsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PinsTable, PinsColumn),
+	)
+}
+func newSavesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavesTable, SavesColumn),
+	)
+}
@@ -6,10 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pinnedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/savedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/schema"
 )
 
 // MessageCreate is the builder for creating a Message entity.
@@ -37,6 +42,117 @@ func (mc *MessageCreate) SetUsername(s string) *MessageCreate {
 	return mc
 }
 
+// SetBot sets the "bot" field.
+func (mc *MessageCreate) SetBot(b bool) *MessageCreate {
+	mc.mutation.SetBot(b)
+	return mc
+}
+
+// SetNillableBot sets the "bot" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableBot(b *bool) *MessageCreate {
+	if b != nil {
+		mc.SetBot(*b)
+	}
+	return mc
+}
+
+// SetAttachments sets the "attachments" field.
+func (mc *MessageCreate) SetAttachments(s []schema.Attachment) *MessageCreate {
+	mc.mutation.SetAttachments(s)
+	return mc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mc *MessageCreate) SetCreatedAt(t time.Time) *MessageCreate {
+	mc.mutation.SetCreatedAt(t)
+	return mc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableCreatedAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetCreatedAt(*t)
+	}
+	return mc
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (mc *MessageCreate) SetArchivedAt(t time.Time) *MessageCreate {
+	mc.mutation.SetArchivedAt(t)
+	return mc
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableArchivedAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetArchivedAt(*t)
+	}
+	return mc
+}
+
+// SetImportKey sets the "import_key" field.
+func (mc *MessageCreate) SetImportKey(s string) *MessageCreate {
+	mc.mutation.SetImportKey(s)
+	return mc
+}
+
+// SetNillableImportKey sets the "import_key" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableImportKey(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetImportKey(*s)
+	}
+	return mc
+}
+
+// SetPollID sets the "poll" edge to the Poll entity by ID.
+func (mc *MessageCreate) SetPollID(id int) *MessageCreate {
+	mc.mutation.SetPollID(id)
+	return mc
+}
+
+// SetNillablePollID sets the "poll" edge to the Poll entity by ID if the given value is not nil.
+func (mc *MessageCreate) SetNillablePollID(id *int) *MessageCreate {
+	if id != nil {
+		mc = mc.SetPollID(*id)
+	}
+	return mc
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (mc *MessageCreate) SetPoll(p *Poll) *MessageCreate {
+	return mc.SetPollID(p.ID)
+}
+
+// AddPinIDs adds the "pins" edge to the PinnedMessage entity by IDs.
+func (mc *MessageCreate) AddPinIDs(ids ...int) *MessageCreate {
+	mc.mutation.AddPinIDs(ids...)
+	return mc
+}
+
+// AddPins adds the "pins" edges to the PinnedMessage entity.
+func (mc *MessageCreate) AddPins(p ...*PinnedMessage) *MessageCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return mc.AddPinIDs(ids...)
+}
+
+// AddSafeIDs adds the "saves" edge to the SavedMessage entity by IDs.
+func (mc *MessageCreate) AddSafeIDs(ids ...int) *MessageCreate {
+	mc.mutation.AddSafeIDs(ids...)
+	return mc
+}
+
+// AddSaves adds the "saves" edges to the SavedMessage entity.
+func (mc *MessageCreate) AddSaves(s ...*SavedMessage) *MessageCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return mc.AddSafeIDs(ids...)
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (mc *MessageCreate) Mutation() *MessageMutation {
 	return mc.mutation
@@ -44,6 +160,7 @@ func (mc *MessageCreate) Mutation() *MessageMutation {
 
 // Save creates the Message in the database.
 func (mc *MessageCreate) Save(ctx context.Context) (*Message, error) {
+	mc.defaults()
 	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
 }
 
@@ -69,6 +186,18 @@ func (mc *MessageCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (mc *MessageCreate) defaults() {
+	if _, ok := mc.mutation.Bot(); !ok {
+		v := message.DefaultBot
+		mc.mutation.SetBot(v)
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		v := message.DefaultCreatedAt()
+		mc.mutation.SetCreatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (mc *MessageCreate) check() error {
 	if _, ok := mc.mutation.Content(); !ok {
@@ -95,6 +224,12 @@ func (mc *MessageCreate) check() error {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "Message.username": %w`, err)}
 		}
 	}
+	if _, ok := mc.mutation.Bot(); !ok {
+		return &ValidationError{Name: "bot", err: errors.New(`ent: missing required field "Message.bot"`)}
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Message.created_at"`)}
+	}
 	return nil
 }
 
@@ -133,6 +268,74 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldUsername, field.TypeString, value)
 		_node.Username = value
 	}
+	if value, ok := mc.mutation.Bot(); ok {
+		_spec.SetField(message.FieldBot, field.TypeBool, value)
+		_node.Bot = value
+	}
+	if value, ok := mc.mutation.Attachments(); ok {
+		_spec.SetField(message.FieldAttachments, field.TypeJSON, value)
+		_node.Attachments = value
+	}
+	if value, ok := mc.mutation.CreatedAt(); ok {
+		_spec.SetField(message.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mc.mutation.ArchivedAt(); ok {
+		_spec.SetField(message.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
+	if value, ok := mc.mutation.ImportKey(); ok {
+		_spec.SetField(message.FieldImportKey, field.TypeString, value)
+		_node.ImportKey = &value
+	}
+	if nodes := mc.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.PollTable,
+			Columns: []string{message.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.SavesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.SavesTable,
+			Columns: []string{message.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedmessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -154,6 +357,7 @@ func (mcb *MessageCreateBulk) Save(ctx context.Context) ([]*Message, error) {
 	for i := range mcb.builders {
 		func(i int, root context.Context) {
 			builder := mcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*MessageMutation)
 				if !ok {
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,7 +13,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pinnedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/savedmessage"
 )
 
 // MessageQuery is the builder for querying Message entities.
@@ -22,6 +26,9 @@ type MessageQuery struct {
 	order      []message.OrderOption
 	inters     []Interceptor
 	predicates []predicate.Message
+	withPoll   *PollQuery
+	withPins   *PinnedMessageQuery
+	withSaves  *SavedMessageQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +65,72 @@ func (mq *MessageQuery) Order(o ...message.OrderOption) *MessageQuery {
 	return mq
 }
 
+// QueryPoll chains the current query on the "poll" edge.
+func (mq *MessageQuery) QueryPoll() *PollQuery {
+	query := (&PollClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(poll.Table, poll.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, message.PollTable, message.PollColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPins chains the current query on the "pins" edge.
+func (mq *MessageQuery) QueryPins() *PinnedMessageQuery {
+	query := (&PinnedMessageClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(pinnedmessage.Table, pinnedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.PinsTable, message.PinsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySaves chains the current query on the "saves" edge.
+func (mq *MessageQuery) QuerySaves() *SavedMessageQuery {
+	query := (&SavedMessageClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(savedmessage.Table, savedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.SavesTable, message.SavesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Message entity from the query.
 // Returns a *NotFoundError when no Message was found.
 func (mq *MessageQuery) First(ctx context.Context) (*Message, error) {
@@ -250,12 +323,48 @@ func (mq *MessageQuery) Clone() *MessageQuery {
 		order:      append([]message.OrderOption{}, mq.order...),
 		inters:     append([]Interceptor{}, mq.inters...),
 		predicates: append([]predicate.Message{}, mq.predicates...),
+		withPoll:   mq.withPoll.Clone(),
+		withPins:   mq.withPins.Clone(),
+		withSaves:  mq.withSaves.Clone(),
 		// clone intermediate query.
 		sql:  mq.sql.Clone(),
 		path: mq.path,
 	}
 }
 
+// WithPoll tells the query-builder to eager-load the nodes that are connected to
+// the "poll" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithPoll(opts ...func(*PollQuery)) *MessageQuery {
+	query := (&PollClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withPoll = query
+	return mq
+}
+
+// WithPins tells the query-builder to eager-load the nodes that are connected to
+// the "pins" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithPins(opts ...func(*PinnedMessageQuery)) *MessageQuery {
+	query := (&PinnedMessageClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withPins = query
+	return mq
+}
+
+// WithSaves tells the query-builder to eager-load the nodes that are connected to
+// the "saves" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithSaves(opts ...func(*SavedMessageQuery)) *MessageQuery {
+	query := (&SavedMessageClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withSaves = query
+	return mq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +441,13 @@ func (mq *MessageQuery) prepareQuery(ctx context.Context) error {
 
 func (mq *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Message, error) {
 	var (
-		nodes = []*Message{}
-		_spec = mq.querySpec()
+		nodes       = []*Message{}
+		_spec       = mq.querySpec()
+		loadedTypes = [3]bool{
+			mq.withPoll != nil,
+			mq.withPins != nil,
+			mq.withSaves != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Message).scanValues(nil, columns)
@@ -341,6 +455,7 @@ func (mq *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mess
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Message{config: mq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +467,117 @@ func (mq *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mess
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := mq.withPoll; query != nil {
+		if err := mq.loadPoll(ctx, query, nodes, nil,
+			func(n *Message, e *Poll) { n.Edges.Poll = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withPins; query != nil {
+		if err := mq.loadPins(ctx, query, nodes,
+			func(n *Message) { n.Edges.Pins = []*PinnedMessage{} },
+			func(n *Message, e *PinnedMessage) { n.Edges.Pins = append(n.Edges.Pins, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withSaves; query != nil {
+		if err := mq.loadSaves(ctx, query, nodes,
+			func(n *Message) { n.Edges.Saves = []*SavedMessage{} },
+			func(n *Message, e *SavedMessage) { n.Edges.Saves = append(n.Edges.Saves, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (mq *MessageQuery) loadPoll(ctx context.Context, query *PollQuery, nodes []*Message, init func(*Message), assign func(*Message, *Poll)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(poll.FieldMessageID)
+	}
+	query.Where(predicate.Poll(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.PollColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MessageQuery) loadPins(ctx context.Context, query *PinnedMessageQuery, nodes []*Message, init func(*Message), assign func(*Message, *PinnedMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pinnedmessage.FieldMessageID)
+	}
+	query.Where(predicate.PinnedMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.PinsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MessageQuery) loadSaves(ctx context.Context, query *SavedMessageQuery, nodes []*Message, init func(*Message), assign func(*Message, *SavedMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(savedmessage.FieldMessageID)
+	}
+	query.Where(predicate.SavedMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.SavesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (mq *MessageQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := mq.querySpec()
 	_spec.Node.Columns = mq.ctx.Fields
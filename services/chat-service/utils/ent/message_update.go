@@ -6,12 +6,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pinnedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/savedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/schema"
 )
 
 // MessageUpdate is the builder for updating Message entities.
@@ -69,11 +75,160 @@ func (mu *MessageUpdate) SetNillableUsername(s *string) *MessageUpdate {
 	return mu
 }
 
+// SetBot sets the "bot" field.
+func (mu *MessageUpdate) SetBot(b bool) *MessageUpdate {
+	mu.mutation.SetBot(b)
+	return mu
+}
+
+// SetNillableBot sets the "bot" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableBot(b *bool) *MessageUpdate {
+	if b != nil {
+		mu.SetBot(*b)
+	}
+	return mu
+}
+
+// SetAttachments sets the "attachments" field.
+func (mu *MessageUpdate) SetAttachments(s []schema.Attachment) *MessageUpdate {
+	mu.mutation.SetAttachments(s)
+	return mu
+}
+
+// AppendAttachments appends s to the "attachments" field.
+func (mu *MessageUpdate) AppendAttachments(s []schema.Attachment) *MessageUpdate {
+	mu.mutation.AppendAttachments(s)
+	return mu
+}
+
+// ClearAttachments clears the value of the "attachments" field.
+func (mu *MessageUpdate) ClearAttachments() *MessageUpdate {
+	mu.mutation.ClearAttachments()
+	return mu
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (mu *MessageUpdate) SetArchivedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetArchivedAt(t)
+	return mu
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableArchivedAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetArchivedAt(*t)
+	}
+	return mu
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (mu *MessageUpdate) ClearArchivedAt() *MessageUpdate {
+	mu.mutation.ClearArchivedAt()
+	return mu
+}
+
+// SetPollID sets the "poll" edge to the Poll entity by ID.
+func (mu *MessageUpdate) SetPollID(id int) *MessageUpdate {
+	mu.mutation.SetPollID(id)
+	return mu
+}
+
+// SetNillablePollID sets the "poll" edge to the Poll entity by ID if the given value is not nil.
+func (mu *MessageUpdate) SetNillablePollID(id *int) *MessageUpdate {
+	if id != nil {
+		mu = mu.SetPollID(*id)
+	}
+	return mu
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (mu *MessageUpdate) SetPoll(p *Poll) *MessageUpdate {
+	return mu.SetPollID(p.ID)
+}
+
+// AddPinIDs adds the "pins" edge to the PinnedMessage entity by IDs.
+func (mu *MessageUpdate) AddPinIDs(ids ...int) *MessageUpdate {
+	mu.mutation.AddPinIDs(ids...)
+	return mu
+}
+
+// AddPins adds the "pins" edges to the PinnedMessage entity.
+func (mu *MessageUpdate) AddPins(p ...*PinnedMessage) *MessageUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return mu.AddPinIDs(ids...)
+}
+
+// AddSafeIDs adds the "saves" edge to the SavedMessage entity by IDs.
+func (mu *MessageUpdate) AddSafeIDs(ids ...int) *MessageUpdate {
+	mu.mutation.AddSafeIDs(ids...)
+	return mu
+}
+
+// AddSaves adds the "saves" edges to the SavedMessage entity.
+func (mu *MessageUpdate) AddSaves(s ...*SavedMessage) *MessageUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return mu.AddSafeIDs(ids...)
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (mu *MessageUpdate) Mutation() *MessageMutation {
 	return mu.mutation
 }
 
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (mu *MessageUpdate) ClearPoll() *MessageUpdate {
+	mu.mutation.ClearPoll()
+	return mu
+}
+
+// ClearPins clears all "pins" edges to the PinnedMessage entity.
+func (mu *MessageUpdate) ClearPins() *MessageUpdate {
+	mu.mutation.ClearPins()
+	return mu
+}
+
+// RemovePinIDs removes the "pins" edge to PinnedMessage entities by IDs.
+func (mu *MessageUpdate) RemovePinIDs(ids ...int) *MessageUpdate {
+	mu.mutation.RemovePinIDs(ids...)
+	return mu
+}
+
+// RemovePins removes "pins" edges to PinnedMessage entities.
+func (mu *MessageUpdate) RemovePins(p ...*PinnedMessage) *MessageUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return mu.RemovePinIDs(ids...)
+}
+
+// ClearSaves clears all "saves" edges to the SavedMessage entity.
+func (mu *MessageUpdate) ClearSaves() *MessageUpdate {
+	mu.mutation.ClearSaves()
+	return mu
+}
+
+// RemoveSafeIDs removes the "saves" edge to SavedMessage entities by IDs.
+func (mu *MessageUpdate) RemoveSafeIDs(ids ...int) *MessageUpdate {
+	mu.mutation.RemoveSafeIDs(ids...)
+	return mu
+}
+
+// RemoveSaves removes "saves" edges to SavedMessage entities.
+func (mu *MessageUpdate) RemoveSaves(s ...*SavedMessage) *MessageUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return mu.RemoveSafeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (mu *MessageUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
@@ -142,6 +297,148 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.Username(); ok {
 		_spec.SetField(message.FieldUsername, field.TypeString, value)
 	}
+	if value, ok := mu.mutation.Bot(); ok {
+		_spec.SetField(message.FieldBot, field.TypeBool, value)
+	}
+	if value, ok := mu.mutation.Attachments(); ok {
+		_spec.SetField(message.FieldAttachments, field.TypeJSON, value)
+	}
+	if value, ok := mu.mutation.AppendedAttachments(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, message.FieldAttachments, value)
+		})
+	}
+	if mu.mutation.AttachmentsCleared() {
+		_spec.ClearField(message.FieldAttachments, field.TypeJSON)
+	}
+	if value, ok := mu.mutation.ArchivedAt(); ok {
+		_spec.SetField(message.FieldArchivedAt, field.TypeTime, value)
+	}
+	if mu.mutation.ArchivedAtCleared() {
+		_spec.ClearField(message.FieldArchivedAt, field.TypeTime)
+	}
+	if mu.mutation.ImportKeyCleared() {
+		_spec.ClearField(message.FieldImportKey, field.TypeString)
+	}
+	if mu.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.PollTable,
+			Columns: []string{message.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.PollTable,
+			Columns: []string{message.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedPinsIDs(); len(nodes) > 0 && !mu.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.SavesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.SavesTable,
+			Columns: []string{message.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedmessage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedSavesIDs(); len(nodes) > 0 && !mu.mutation.SavesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.SavesTable,
+			Columns: []string{message.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedmessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.SavesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.SavesTable,
+			Columns: []string{message.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedmessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{message.Label}
@@ -204,11 +501,160 @@ func (muo *MessageUpdateOne) SetNillableUsername(s *string) *MessageUpdateOne {
 	return muo
 }
 
+// SetBot sets the "bot" field.
+func (muo *MessageUpdateOne) SetBot(b bool) *MessageUpdateOne {
+	muo.mutation.SetBot(b)
+	return muo
+}
+
+// SetNillableBot sets the "bot" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableBot(b *bool) *MessageUpdateOne {
+	if b != nil {
+		muo.SetBot(*b)
+	}
+	return muo
+}
+
+// SetAttachments sets the "attachments" field.
+func (muo *MessageUpdateOne) SetAttachments(s []schema.Attachment) *MessageUpdateOne {
+	muo.mutation.SetAttachments(s)
+	return muo
+}
+
+// AppendAttachments appends s to the "attachments" field.
+func (muo *MessageUpdateOne) AppendAttachments(s []schema.Attachment) *MessageUpdateOne {
+	muo.mutation.AppendAttachments(s)
+	return muo
+}
+
+// ClearAttachments clears the value of the "attachments" field.
+func (muo *MessageUpdateOne) ClearAttachments() *MessageUpdateOne {
+	muo.mutation.ClearAttachments()
+	return muo
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (muo *MessageUpdateOne) SetArchivedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetArchivedAt(t)
+	return muo
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableArchivedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetArchivedAt(*t)
+	}
+	return muo
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (muo *MessageUpdateOne) ClearArchivedAt() *MessageUpdateOne {
+	muo.mutation.ClearArchivedAt()
+	return muo
+}
+
+// SetPollID sets the "poll" edge to the Poll entity by ID.
+func (muo *MessageUpdateOne) SetPollID(id int) *MessageUpdateOne {
+	muo.mutation.SetPollID(id)
+	return muo
+}
+
+// SetNillablePollID sets the "poll" edge to the Poll entity by ID if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillablePollID(id *int) *MessageUpdateOne {
+	if id != nil {
+		muo = muo.SetPollID(*id)
+	}
+	return muo
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (muo *MessageUpdateOne) SetPoll(p *Poll) *MessageUpdateOne {
+	return muo.SetPollID(p.ID)
+}
+
+// AddPinIDs adds the "pins" edge to the PinnedMessage entity by IDs.
+func (muo *MessageUpdateOne) AddPinIDs(ids ...int) *MessageUpdateOne {
+	muo.mutation.AddPinIDs(ids...)
+	return muo
+}
+
+// AddPins adds the "pins" edges to the PinnedMessage entity.
+func (muo *MessageUpdateOne) AddPins(p ...*PinnedMessage) *MessageUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return muo.AddPinIDs(ids...)
+}
+
+// AddSafeIDs adds the "saves" edge to the SavedMessage entity by IDs.
+func (muo *MessageUpdateOne) AddSafeIDs(ids ...int) *MessageUpdateOne {
+	muo.mutation.AddSafeIDs(ids...)
+	return muo
+}
+
+// AddSaves adds the "saves" edges to the SavedMessage entity.
+func (muo *MessageUpdateOne) AddSaves(s ...*SavedMessage) *MessageUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return muo.AddSafeIDs(ids...)
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (muo *MessageUpdateOne) Mutation() *MessageMutation {
 	return muo.mutation
 }
 
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (muo *MessageUpdateOne) ClearPoll() *MessageUpdateOne {
+	muo.mutation.ClearPoll()
+	return muo
+}
+
+// ClearPins clears all "pins" edges to the PinnedMessage entity.
+func (muo *MessageUpdateOne) ClearPins() *MessageUpdateOne {
+	muo.mutation.ClearPins()
+	return muo
+}
+
+// RemovePinIDs removes the "pins" edge to PinnedMessage entities by IDs.
+func (muo *MessageUpdateOne) RemovePinIDs(ids ...int) *MessageUpdateOne {
+	muo.mutation.RemovePinIDs(ids...)
+	return muo
+}
+
+// RemovePins removes "pins" edges to PinnedMessage entities.
+func (muo *MessageUpdateOne) RemovePins(p ...*PinnedMessage) *MessageUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return muo.RemovePinIDs(ids...)
+}
+
+// ClearSaves clears all "saves" edges to the SavedMessage entity.
+func (muo *MessageUpdateOne) ClearSaves() *MessageUpdateOne {
+	muo.mutation.ClearSaves()
+	return muo
+}
+
+// RemoveSafeIDs removes the "saves" edge to SavedMessage entities by IDs.
+func (muo *MessageUpdateOne) RemoveSafeIDs(ids ...int) *MessageUpdateOne {
+	muo.mutation.RemoveSafeIDs(ids...)
+	return muo
+}
+
+// RemoveSaves removes "saves" edges to SavedMessage entities.
+func (muo *MessageUpdateOne) RemoveSaves(s ...*SavedMessage) *MessageUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return muo.RemoveSafeIDs(ids...)
+}
+
 // Where appends a list predicates to the MessageUpdate builder.
 func (muo *MessageUpdateOne) Where(ps ...predicate.Message) *MessageUpdateOne {
 	muo.mutation.Where(ps...)
@@ -307,6 +753,148 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 	if value, ok := muo.mutation.Username(); ok {
 		_spec.SetField(message.FieldUsername, field.TypeString, value)
 	}
+	if value, ok := muo.mutation.Bot(); ok {
+		_spec.SetField(message.FieldBot, field.TypeBool, value)
+	}
+	if value, ok := muo.mutation.Attachments(); ok {
+		_spec.SetField(message.FieldAttachments, field.TypeJSON, value)
+	}
+	if value, ok := muo.mutation.AppendedAttachments(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, message.FieldAttachments, value)
+		})
+	}
+	if muo.mutation.AttachmentsCleared() {
+		_spec.ClearField(message.FieldAttachments, field.TypeJSON)
+	}
+	if value, ok := muo.mutation.ArchivedAt(); ok {
+		_spec.SetField(message.FieldArchivedAt, field.TypeTime, value)
+	}
+	if muo.mutation.ArchivedAtCleared() {
+		_spec.ClearField(message.FieldArchivedAt, field.TypeTime)
+	}
+	if muo.mutation.ImportKeyCleared() {
+		_spec.ClearField(message.FieldImportKey, field.TypeString)
+	}
+	if muo.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.PollTable,
+			Columns: []string{message.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.PollTable,
+			Columns: []string{message.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedPinsIDs(); len(nodes) > 0 && !muo.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.SavesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.SavesTable,
+			Columns: []string{message.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedmessage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedSavesIDs(); len(nodes) > 0 && !muo.mutation.SavesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.SavesTable,
+			Columns: []string{message.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedmessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.SavesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.SavesTable,
+			Columns: []string{message.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedmessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Message{config: muo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/messagepurge"
+)
+
+// MessagePurge is the model entity for the MessagePurge schema.
+type MessagePurge struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// RoomID holds the value of the "room_id" field.
+	RoomID string `json:"room_id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason messagepurge.Reason `json:"reason,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode messagepurge.Mode `json:"mode,omitempty"`
+	// DryRun holds the value of the "dry_run" field.
+	DryRun bool `json:"dry_run,omitempty"`
+	// MessageCount holds the value of the "message_count" field.
+	MessageCount int `json:"message_count,omitempty"`
+	// MessageIds holds the value of the "message_ids" field.
+	MessageIds []int `json:"message_ids,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessagePurge) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messagepurge.FieldMessageIds:
+			values[i] = new([]byte)
+		case messagepurge.FieldDryRun:
+			values[i] = new(sql.NullBool)
+		case messagepurge.FieldID, messagepurge.FieldMessageCount:
+			values[i] = new(sql.NullInt64)
+		case messagepurge.FieldRoomID, messagepurge.FieldReason, messagepurge.FieldMode:
+			values[i] = new(sql.NullString)
+		case messagepurge.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessagePurge fields.
+func (mp *MessagePurge) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messagepurge.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mp.ID = int(value.Int64)
+		case messagepurge.FieldRoomID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value.Valid {
+				mp.RoomID = value.String
+			}
+		case messagepurge.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				mp.Reason = messagepurge.Reason(value.String)
+			}
+		case messagepurge.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				mp.Mode = messagepurge.Mode(value.String)
+			}
+		case messagepurge.FieldDryRun:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field dry_run", values[i])
+			} else if value.Valid {
+				mp.DryRun = value.Bool
+			}
+		case messagepurge.FieldMessageCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_count", values[i])
+			} else if value.Valid {
+				mp.MessageCount = int(value.Int64)
+			}
+		case messagepurge.FieldMessageIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field message_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mp.MessageIds); err != nil {
+					return fmt.Errorf("unmarshal field message_ids: %w", err)
+				}
+			}
+		case messagepurge.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mp.CreatedAt = value.Time
+			}
+		default:
+			mp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessagePurge.
+// This includes values selected through modifiers, order, etc.
+func (mp *MessagePurge) Value(name string) (ent.Value, error) {
+	return mp.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MessagePurge.
+// Note that you need to call MessagePurge.Unwrap() before calling this method if this MessagePurge
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mp *MessagePurge) Update() *MessagePurgeUpdateOne {
+	return NewMessagePurgeClient(mp.config).UpdateOne(mp)
+}
+
+// Unwrap unwraps the MessagePurge entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mp *MessagePurge) Unwrap() *MessagePurge {
+	_tx, ok := mp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessagePurge is not a transactional entity")
+	}
+	mp.config.driver = _tx.drv
+	return mp
+}
+
+// String implements the fmt.Stringer.
+func (mp *MessagePurge) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessagePurge(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mp.ID))
+	builder.WriteString("room_id=")
+	builder.WriteString(mp.RoomID)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(fmt.Sprintf("%v", mp.Reason))
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(fmt.Sprintf("%v", mp.Mode))
+	builder.WriteString(", ")
+	builder.WriteString("dry_run=")
+	builder.WriteString(fmt.Sprintf("%v", mp.DryRun))
+	builder.WriteString(", ")
+	builder.WriteString("message_count=")
+	builder.WriteString(fmt.Sprintf("%v", mp.MessageCount))
+	builder.WriteString(", ")
+	builder.WriteString("message_ids=")
+	builder.WriteString(fmt.Sprintf("%v", mp.MessageIds))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mp.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessagePurges is a parsable slice of MessagePurge.
+type MessagePurges []*MessagePurge
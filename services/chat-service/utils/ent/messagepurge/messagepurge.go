@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messagepurge
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the messagepurge type in the database.
+	Label = "message_purge"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRoomID holds the string denoting the room_id field in the database.
+	FieldRoomID = "room_id"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldDryRun holds the string denoting the dry_run field in the database.
+	FieldDryRun = "dry_run"
+	// FieldMessageCount holds the string denoting the message_count field in the database.
+	FieldMessageCount = "message_count"
+	// FieldMessageIds holds the string denoting the message_ids field in the database.
+	FieldMessageIds = "message_ids"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the messagepurge in the database.
+	Table = "message_purges"
+)
+
+// Columns holds all SQL columns for messagepurge fields.
+var Columns = []string{
+	FieldID,
+	FieldRoomID,
+	FieldReason,
+	FieldMode,
+	FieldDryRun,
+	FieldMessageCount,
+	FieldMessageIds,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RoomIDValidator is a validator for the "room_id" field. It is called by the builders before save.
+	RoomIDValidator func(string) error
+	// DefaultDryRun holds the default value on creation for the "dry_run" field.
+	DefaultDryRun bool
+	// MessageCountValidator is a validator for the "message_count" field. It is called by the builders before save.
+	MessageCountValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Reason defines the type for the "reason" enum field.
+type Reason string
+
+// Reason values.
+const (
+	ReasonMaxAge      Reason = "max_age"
+	ReasonMaxMessages Reason = "max_messages"
+)
+
+func (r Reason) String() string {
+	return string(r)
+}
+
+// ReasonValidator is a validator for the "reason" field enum values. It is called by the builders before save.
+func ReasonValidator(r Reason) error {
+	switch r {
+	case ReasonMaxAge, ReasonMaxMessages:
+		return nil
+	default:
+		return fmt.Errorf("messagepurge: invalid enum value for reason field: %q", r)
+	}
+}
+
+// Mode defines the type for the "mode" enum field.
+type Mode string
+
+// Mode values.
+const (
+	ModeDelete  Mode = "delete"
+	ModeArchive Mode = "archive"
+)
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+// ModeValidator is a validator for the "mode" field enum values. It is called by the builders before save.
+func ModeValidator(m Mode) error {
+	switch m {
+	case ModeDelete, ModeArchive:
+		return nil
+	default:
+		return fmt.Errorf("messagepurge: invalid enum value for mode field: %q", m)
+	}
+}
+
+// OrderOption defines the ordering options for the MessagePurge queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRoomID orders the results by the room_id field.
+func ByRoomID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoomID, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByDryRun orders the results by the dry_run field.
+func ByDryRun(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDryRun, opts...).ToFunc()
+}
+
+// ByMessageCount orders the results by the message_count field.
+func ByMessageCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageCount, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messagepurge
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldLTE(FieldID, id))
+}
+
+// RoomID applies equality check predicate on the "room_id" field. It's identical to RoomIDEQ.
+func RoomID(v string) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldEQ(FieldRoomID, v))
+}
+
+// DryRun applies equality check predicate on the "dry_run" field. It's identical to DryRunEQ.
+func DryRun(v bool) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldEQ(FieldDryRun, v))
+}
+
+// MessageCount applies equality check predicate on the "message_count" field. It's identical to MessageCountEQ.
+func MessageCount(v int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldEQ(FieldMessageCount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RoomIDEQ applies the EQ predicate on the "room_id" field.
+func RoomIDEQ(v string) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldEQ(FieldRoomID, v))
+}
+
+// RoomIDNEQ applies the NEQ predicate on the "room_id" field.
+func RoomIDNEQ(v string) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldNEQ(FieldRoomID, v))
+}
+
+// RoomIDIn applies the In predicate on the "room_id" field.
+func RoomIDIn(vs ...string) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldIn(FieldRoomID, vs...))
+}
+
+// RoomIDNotIn applies the NotIn predicate on the "room_id" field.
+func RoomIDNotIn(vs ...string) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldNotIn(FieldRoomID, vs...))
+}
+
+// RoomIDGT applies the GT predicate on the "room_id" field.
+func RoomIDGT(v string) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldGT(FieldRoomID, v))
+}
+
+// RoomIDGTE applies the GTE predicate on the "room_id" field.
+func RoomIDGTE(v string) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldGTE(FieldRoomID, v))
+}
+
+// RoomIDLT applies the LT predicate on the "room_id" field.
+func RoomIDLT(v string) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldLT(FieldRoomID, v))
+}
+
+// RoomIDLTE applies the LTE predicate on the "room_id" field.
+func RoomIDLTE(v string) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldLTE(FieldRoomID, v))
+}
+
+// RoomIDContains applies the Contains predicate on the "room_id" field.
+func RoomIDContains(v string) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldContains(FieldRoomID, v))
+}
+
+// RoomIDHasPrefix applies the HasPrefix predicate on the "room_id" field.
+func RoomIDHasPrefix(v string) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldHasPrefix(FieldRoomID, v))
+}
+
+// RoomIDHasSuffix applies the HasSuffix predicate on the "room_id" field.
+func RoomIDHasSuffix(v string) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldHasSuffix(FieldRoomID, v))
+}
+
+// RoomIDEqualFold applies the EqualFold predicate on the "room_id" field.
+func RoomIDEqualFold(v string) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldEqualFold(FieldRoomID, v))
+}
+
+// RoomIDContainsFold applies the ContainsFold predicate on the "room_id" field.
+func RoomIDContainsFold(v string) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldContainsFold(FieldRoomID, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v Reason) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v Reason) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...Reason) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...Reason) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v Mode) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v Mode) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...Mode) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...Mode) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// DryRunEQ applies the EQ predicate on the "dry_run" field.
+func DryRunEQ(v bool) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldEQ(FieldDryRun, v))
+}
+
+// DryRunNEQ applies the NEQ predicate on the "dry_run" field.
+func DryRunNEQ(v bool) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldNEQ(FieldDryRun, v))
+}
+
+// MessageCountEQ applies the EQ predicate on the "message_count" field.
+func MessageCountEQ(v int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldEQ(FieldMessageCount, v))
+}
+
+// MessageCountNEQ applies the NEQ predicate on the "message_count" field.
+func MessageCountNEQ(v int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldNEQ(FieldMessageCount, v))
+}
+
+// MessageCountIn applies the In predicate on the "message_count" field.
+func MessageCountIn(vs ...int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldIn(FieldMessageCount, vs...))
+}
+
+// MessageCountNotIn applies the NotIn predicate on the "message_count" field.
+func MessageCountNotIn(vs ...int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldNotIn(FieldMessageCount, vs...))
+}
+
+// MessageCountGT applies the GT predicate on the "message_count" field.
+func MessageCountGT(v int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldGT(FieldMessageCount, v))
+}
+
+// MessageCountGTE applies the GTE predicate on the "message_count" field.
+func MessageCountGTE(v int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldGTE(FieldMessageCount, v))
+}
+
+// MessageCountLT applies the LT predicate on the "message_count" field.
+func MessageCountLT(v int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldLT(FieldMessageCount, v))
+}
+
+// MessageCountLTE applies the LTE predicate on the "message_count" field.
+func MessageCountLTE(v int) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldLTE(FieldMessageCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessagePurge) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessagePurge) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessagePurge) predicate.MessagePurge {
+	return predicate.MessagePurge(sql.NotPredicates(p))
+}
@@ -0,0 +1,312 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/messagepurge"
+)
+
+// MessagePurgeCreate is the builder for creating a MessagePurge entity.
+type MessagePurgeCreate struct {
+	config
+	mutation *MessagePurgeMutation
+	hooks    []Hook
+}
+
+// SetRoomID sets the "room_id" field.
+func (mpc *MessagePurgeCreate) SetRoomID(s string) *MessagePurgeCreate {
+	mpc.mutation.SetRoomID(s)
+	return mpc
+}
+
+// SetReason sets the "reason" field.
+func (mpc *MessagePurgeCreate) SetReason(m messagepurge.Reason) *MessagePurgeCreate {
+	mpc.mutation.SetReason(m)
+	return mpc
+}
+
+// SetMode sets the "mode" field.
+func (mpc *MessagePurgeCreate) SetMode(m messagepurge.Mode) *MessagePurgeCreate {
+	mpc.mutation.SetMode(m)
+	return mpc
+}
+
+// SetDryRun sets the "dry_run" field.
+func (mpc *MessagePurgeCreate) SetDryRun(b bool) *MessagePurgeCreate {
+	mpc.mutation.SetDryRun(b)
+	return mpc
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (mpc *MessagePurgeCreate) SetNillableDryRun(b *bool) *MessagePurgeCreate {
+	if b != nil {
+		mpc.SetDryRun(*b)
+	}
+	return mpc
+}
+
+// SetMessageCount sets the "message_count" field.
+func (mpc *MessagePurgeCreate) SetMessageCount(i int) *MessagePurgeCreate {
+	mpc.mutation.SetMessageCount(i)
+	return mpc
+}
+
+// SetMessageIds sets the "message_ids" field.
+func (mpc *MessagePurgeCreate) SetMessageIds(i []int) *MessagePurgeCreate {
+	mpc.mutation.SetMessageIds(i)
+	return mpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mpc *MessagePurgeCreate) SetCreatedAt(t time.Time) *MessagePurgeCreate {
+	mpc.mutation.SetCreatedAt(t)
+	return mpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mpc *MessagePurgeCreate) SetNillableCreatedAt(t *time.Time) *MessagePurgeCreate {
+	if t != nil {
+		mpc.SetCreatedAt(*t)
+	}
+	return mpc
+}
+
+// Mutation returns the MessagePurgeMutation object of the builder.
+func (mpc *MessagePurgeCreate) Mutation() *MessagePurgeMutation {
+	return mpc.mutation
+}
+
+// Save creates the MessagePurge in the database.
+func (mpc *MessagePurgeCreate) Save(ctx context.Context) (*MessagePurge, error) {
+	mpc.defaults()
+	return withHooks(ctx, mpc.sqlSave, mpc.mutation, mpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mpc *MessagePurgeCreate) SaveX(ctx context.Context) *MessagePurge {
+	v, err := mpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mpc *MessagePurgeCreate) Exec(ctx context.Context) error {
+	_, err := mpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpc *MessagePurgeCreate) ExecX(ctx context.Context) {
+	if err := mpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpc *MessagePurgeCreate) defaults() {
+	if _, ok := mpc.mutation.DryRun(); !ok {
+		v := messagepurge.DefaultDryRun
+		mpc.mutation.SetDryRun(v)
+	}
+	if _, ok := mpc.mutation.CreatedAt(); !ok {
+		v := messagepurge.DefaultCreatedAt()
+		mpc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mpc *MessagePurgeCreate) check() error {
+	if _, ok := mpc.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room_id", err: errors.New(`ent: missing required field "MessagePurge.room_id"`)}
+	}
+	if v, ok := mpc.mutation.RoomID(); ok {
+		if err := messagepurge.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "MessagePurge.room_id": %w`, err)}
+		}
+	}
+	if _, ok := mpc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "MessagePurge.reason"`)}
+	}
+	if v, ok := mpc.mutation.Reason(); ok {
+		if err := messagepurge.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "MessagePurge.reason": %w`, err)}
+		}
+	}
+	if _, ok := mpc.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "MessagePurge.mode"`)}
+	}
+	if v, ok := mpc.mutation.Mode(); ok {
+		if err := messagepurge.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "MessagePurge.mode": %w`, err)}
+		}
+	}
+	if _, ok := mpc.mutation.DryRun(); !ok {
+		return &ValidationError{Name: "dry_run", err: errors.New(`ent: missing required field "MessagePurge.dry_run"`)}
+	}
+	if _, ok := mpc.mutation.MessageCount(); !ok {
+		return &ValidationError{Name: "message_count", err: errors.New(`ent: missing required field "MessagePurge.message_count"`)}
+	}
+	if v, ok := mpc.mutation.MessageCount(); ok {
+		if err := messagepurge.MessageCountValidator(v); err != nil {
+			return &ValidationError{Name: "message_count", err: fmt.Errorf(`ent: validator failed for field "MessagePurge.message_count": %w`, err)}
+		}
+	}
+	if _, ok := mpc.mutation.MessageIds(); !ok {
+		return &ValidationError{Name: "message_ids", err: errors.New(`ent: missing required field "MessagePurge.message_ids"`)}
+	}
+	if _, ok := mpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MessagePurge.created_at"`)}
+	}
+	return nil
+}
+
+func (mpc *MessagePurgeCreate) sqlSave(ctx context.Context) (*MessagePurge, error) {
+	if err := mpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mpc.mutation.id = &_node.ID
+	mpc.mutation.done = true
+	return _node, nil
+}
+
+func (mpc *MessagePurgeCreate) createSpec() (*MessagePurge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessagePurge{config: mpc.config}
+		_spec = sqlgraph.NewCreateSpec(messagepurge.Table, sqlgraph.NewFieldSpec(messagepurge.FieldID, field.TypeInt))
+	)
+	if value, ok := mpc.mutation.RoomID(); ok {
+		_spec.SetField(messagepurge.FieldRoomID, field.TypeString, value)
+		_node.RoomID = value
+	}
+	if value, ok := mpc.mutation.Reason(); ok {
+		_spec.SetField(messagepurge.FieldReason, field.TypeEnum, value)
+		_node.Reason = value
+	}
+	if value, ok := mpc.mutation.Mode(); ok {
+		_spec.SetField(messagepurge.FieldMode, field.TypeEnum, value)
+		_node.Mode = value
+	}
+	if value, ok := mpc.mutation.DryRun(); ok {
+		_spec.SetField(messagepurge.FieldDryRun, field.TypeBool, value)
+		_node.DryRun = value
+	}
+	if value, ok := mpc.mutation.MessageCount(); ok {
+		_spec.SetField(messagepurge.FieldMessageCount, field.TypeInt, value)
+		_node.MessageCount = value
+	}
+	if value, ok := mpc.mutation.MessageIds(); ok {
+		_spec.SetField(messagepurge.FieldMessageIds, field.TypeJSON, value)
+		_node.MessageIds = value
+	}
+	if value, ok := mpc.mutation.CreatedAt(); ok {
+		_spec.SetField(messagepurge.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// MessagePurgeCreateBulk is the builder for creating many MessagePurge entities in bulk.
+type MessagePurgeCreateBulk struct {
+	config
+	err      error
+	builders []*MessagePurgeCreate
+}
+
+// Save creates the MessagePurge entities in the database.
+func (mpcb *MessagePurgeCreateBulk) Save(ctx context.Context) ([]*MessagePurge, error) {
+	if mpcb.err != nil {
+		return nil, mpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mpcb.builders))
+	nodes := make([]*MessagePurge, len(mpcb.builders))
+	mutators := make([]Mutator, len(mpcb.builders))
+	for i := range mpcb.builders {
+		func(i int, root context.Context) {
+			builder := mpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessagePurgeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpcb *MessagePurgeCreateBulk) SaveX(ctx context.Context) []*MessagePurge {
+	v, err := mpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mpcb *MessagePurgeCreateBulk) Exec(ctx context.Context) error {
+	_, err := mpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpcb *MessagePurgeCreateBulk) ExecX(ctx context.Context) {
+	if err := mpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
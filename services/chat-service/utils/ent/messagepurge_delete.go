@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/messagepurge"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// MessagePurgeDelete is the builder for deleting a MessagePurge entity.
+type MessagePurgeDelete struct {
+	config
+	hooks    []Hook
+	mutation *MessagePurgeMutation
+}
+
+// Where appends a list predicates to the MessagePurgeDelete builder.
+func (mpd *MessagePurgeDelete) Where(ps ...predicate.MessagePurge) *MessagePurgeDelete {
+	mpd.mutation.Where(ps...)
+	return mpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mpd *MessagePurgeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mpd.sqlExec, mpd.mutation, mpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpd *MessagePurgeDelete) ExecX(ctx context.Context) int {
+	n, err := mpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mpd *MessagePurgeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(messagepurge.Table, sqlgraph.NewFieldSpec(messagepurge.FieldID, field.TypeInt))
+	if ps := mpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mpd.mutation.done = true
+	return affected, err
+}
+
+// MessagePurgeDeleteOne is the builder for deleting a single MessagePurge entity.
+type MessagePurgeDeleteOne struct {
+	mpd *MessagePurgeDelete
+}
+
+// Where appends a list predicates to the MessagePurgeDelete builder.
+func (mpdo *MessagePurgeDeleteOne) Where(ps ...predicate.MessagePurge) *MessagePurgeDeleteOne {
+	mpdo.mpd.mutation.Where(ps...)
+	return mpdo
+}
+
+// Exec executes the deletion query.
+func (mpdo *MessagePurgeDeleteOne) Exec(ctx context.Context) error {
+	n, err := mpdo.mpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{messagepurge.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpdo *MessagePurgeDeleteOne) ExecX(ctx context.Context) {
+	if err := mpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
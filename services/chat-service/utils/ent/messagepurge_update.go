@@ -0,0 +1,462 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/messagepurge"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// MessagePurgeUpdate is the builder for updating MessagePurge entities.
+type MessagePurgeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessagePurgeMutation
+}
+
+// Where appends a list predicates to the MessagePurgeUpdate builder.
+func (mpu *MessagePurgeUpdate) Where(ps ...predicate.MessagePurge) *MessagePurgeUpdate {
+	mpu.mutation.Where(ps...)
+	return mpu
+}
+
+// SetRoomID sets the "room_id" field.
+func (mpu *MessagePurgeUpdate) SetRoomID(s string) *MessagePurgeUpdate {
+	mpu.mutation.SetRoomID(s)
+	return mpu
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (mpu *MessagePurgeUpdate) SetNillableRoomID(s *string) *MessagePurgeUpdate {
+	if s != nil {
+		mpu.SetRoomID(*s)
+	}
+	return mpu
+}
+
+// SetReason sets the "reason" field.
+func (mpu *MessagePurgeUpdate) SetReason(m messagepurge.Reason) *MessagePurgeUpdate {
+	mpu.mutation.SetReason(m)
+	return mpu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mpu *MessagePurgeUpdate) SetNillableReason(m *messagepurge.Reason) *MessagePurgeUpdate {
+	if m != nil {
+		mpu.SetReason(*m)
+	}
+	return mpu
+}
+
+// SetMode sets the "mode" field.
+func (mpu *MessagePurgeUpdate) SetMode(m messagepurge.Mode) *MessagePurgeUpdate {
+	mpu.mutation.SetMode(m)
+	return mpu
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (mpu *MessagePurgeUpdate) SetNillableMode(m *messagepurge.Mode) *MessagePurgeUpdate {
+	if m != nil {
+		mpu.SetMode(*m)
+	}
+	return mpu
+}
+
+// SetDryRun sets the "dry_run" field.
+func (mpu *MessagePurgeUpdate) SetDryRun(b bool) *MessagePurgeUpdate {
+	mpu.mutation.SetDryRun(b)
+	return mpu
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (mpu *MessagePurgeUpdate) SetNillableDryRun(b *bool) *MessagePurgeUpdate {
+	if b != nil {
+		mpu.SetDryRun(*b)
+	}
+	return mpu
+}
+
+// SetMessageCount sets the "message_count" field.
+func (mpu *MessagePurgeUpdate) SetMessageCount(i int) *MessagePurgeUpdate {
+	mpu.mutation.ResetMessageCount()
+	mpu.mutation.SetMessageCount(i)
+	return mpu
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (mpu *MessagePurgeUpdate) SetNillableMessageCount(i *int) *MessagePurgeUpdate {
+	if i != nil {
+		mpu.SetMessageCount(*i)
+	}
+	return mpu
+}
+
+// AddMessageCount adds i to the "message_count" field.
+func (mpu *MessagePurgeUpdate) AddMessageCount(i int) *MessagePurgeUpdate {
+	mpu.mutation.AddMessageCount(i)
+	return mpu
+}
+
+// SetMessageIds sets the "message_ids" field.
+func (mpu *MessagePurgeUpdate) SetMessageIds(i []int) *MessagePurgeUpdate {
+	mpu.mutation.SetMessageIds(i)
+	return mpu
+}
+
+// AppendMessageIds appends i to the "message_ids" field.
+func (mpu *MessagePurgeUpdate) AppendMessageIds(i []int) *MessagePurgeUpdate {
+	mpu.mutation.AppendMessageIds(i)
+	return mpu
+}
+
+// Mutation returns the MessagePurgeMutation object of the builder.
+func (mpu *MessagePurgeUpdate) Mutation() *MessagePurgeMutation {
+	return mpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mpu *MessagePurgeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mpu.sqlSave, mpu.mutation, mpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpu *MessagePurgeUpdate) SaveX(ctx context.Context) int {
+	affected, err := mpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mpu *MessagePurgeUpdate) Exec(ctx context.Context) error {
+	_, err := mpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpu *MessagePurgeUpdate) ExecX(ctx context.Context) {
+	if err := mpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mpu *MessagePurgeUpdate) check() error {
+	if v, ok := mpu.mutation.RoomID(); ok {
+		if err := messagepurge.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "MessagePurge.room_id": %w`, err)}
+		}
+	}
+	if v, ok := mpu.mutation.Reason(); ok {
+		if err := messagepurge.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "MessagePurge.reason": %w`, err)}
+		}
+	}
+	if v, ok := mpu.mutation.Mode(); ok {
+		if err := messagepurge.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "MessagePurge.mode": %w`, err)}
+		}
+	}
+	if v, ok := mpu.mutation.MessageCount(); ok {
+		if err := messagepurge.MessageCountValidator(v); err != nil {
+			return &ValidationError{Name: "message_count", err: fmt.Errorf(`ent: validator failed for field "MessagePurge.message_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mpu *MessagePurgeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagepurge.Table, messagepurge.Columns, sqlgraph.NewFieldSpec(messagepurge.FieldID, field.TypeInt))
+	if ps := mpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mpu.mutation.RoomID(); ok {
+		_spec.SetField(messagepurge.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := mpu.mutation.Reason(); ok {
+		_spec.SetField(messagepurge.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := mpu.mutation.Mode(); ok {
+		_spec.SetField(messagepurge.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := mpu.mutation.DryRun(); ok {
+		_spec.SetField(messagepurge.FieldDryRun, field.TypeBool, value)
+	}
+	if value, ok := mpu.mutation.MessageCount(); ok {
+		_spec.SetField(messagepurge.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := mpu.mutation.AddedMessageCount(); ok {
+		_spec.AddField(messagepurge.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := mpu.mutation.MessageIds(); ok {
+		_spec.SetField(messagepurge.FieldMessageIds, field.TypeJSON, value)
+	}
+	if value, ok := mpu.mutation.AppendedMessageIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, messagepurge.FieldMessageIds, value)
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagepurge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mpu.mutation.done = true
+	return n, nil
+}
+
+// MessagePurgeUpdateOne is the builder for updating a single MessagePurge entity.
+type MessagePurgeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessagePurgeMutation
+}
+
+// SetRoomID sets the "room_id" field.
+func (mpuo *MessagePurgeUpdateOne) SetRoomID(s string) *MessagePurgeUpdateOne {
+	mpuo.mutation.SetRoomID(s)
+	return mpuo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (mpuo *MessagePurgeUpdateOne) SetNillableRoomID(s *string) *MessagePurgeUpdateOne {
+	if s != nil {
+		mpuo.SetRoomID(*s)
+	}
+	return mpuo
+}
+
+// SetReason sets the "reason" field.
+func (mpuo *MessagePurgeUpdateOne) SetReason(m messagepurge.Reason) *MessagePurgeUpdateOne {
+	mpuo.mutation.SetReason(m)
+	return mpuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mpuo *MessagePurgeUpdateOne) SetNillableReason(m *messagepurge.Reason) *MessagePurgeUpdateOne {
+	if m != nil {
+		mpuo.SetReason(*m)
+	}
+	return mpuo
+}
+
+// SetMode sets the "mode" field.
+func (mpuo *MessagePurgeUpdateOne) SetMode(m messagepurge.Mode) *MessagePurgeUpdateOne {
+	mpuo.mutation.SetMode(m)
+	return mpuo
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (mpuo *MessagePurgeUpdateOne) SetNillableMode(m *messagepurge.Mode) *MessagePurgeUpdateOne {
+	if m != nil {
+		mpuo.SetMode(*m)
+	}
+	return mpuo
+}
+
+// SetDryRun sets the "dry_run" field.
+func (mpuo *MessagePurgeUpdateOne) SetDryRun(b bool) *MessagePurgeUpdateOne {
+	mpuo.mutation.SetDryRun(b)
+	return mpuo
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (mpuo *MessagePurgeUpdateOne) SetNillableDryRun(b *bool) *MessagePurgeUpdateOne {
+	if b != nil {
+		mpuo.SetDryRun(*b)
+	}
+	return mpuo
+}
+
+// SetMessageCount sets the "message_count" field.
+func (mpuo *MessagePurgeUpdateOne) SetMessageCount(i int) *MessagePurgeUpdateOne {
+	mpuo.mutation.ResetMessageCount()
+	mpuo.mutation.SetMessageCount(i)
+	return mpuo
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (mpuo *MessagePurgeUpdateOne) SetNillableMessageCount(i *int) *MessagePurgeUpdateOne {
+	if i != nil {
+		mpuo.SetMessageCount(*i)
+	}
+	return mpuo
+}
+
+// AddMessageCount adds i to the "message_count" field.
+func (mpuo *MessagePurgeUpdateOne) AddMessageCount(i int) *MessagePurgeUpdateOne {
+	mpuo.mutation.AddMessageCount(i)
+	return mpuo
+}
+
+// SetMessageIds sets the "message_ids" field.
+func (mpuo *MessagePurgeUpdateOne) SetMessageIds(i []int) *MessagePurgeUpdateOne {
+	mpuo.mutation.SetMessageIds(i)
+	return mpuo
+}
+
+// AppendMessageIds appends i to the "message_ids" field.
+func (mpuo *MessagePurgeUpdateOne) AppendMessageIds(i []int) *MessagePurgeUpdateOne {
+	mpuo.mutation.AppendMessageIds(i)
+	return mpuo
+}
+
+// Mutation returns the MessagePurgeMutation object of the builder.
+func (mpuo *MessagePurgeUpdateOne) Mutation() *MessagePurgeMutation {
+	return mpuo.mutation
+}
+
+// Where appends a list predicates to the MessagePurgeUpdate builder.
+func (mpuo *MessagePurgeUpdateOne) Where(ps ...predicate.MessagePurge) *MessagePurgeUpdateOne {
+	mpuo.mutation.Where(ps...)
+	return mpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mpuo *MessagePurgeUpdateOne) Select(field string, fields ...string) *MessagePurgeUpdateOne {
+	mpuo.fields = append([]string{field}, fields...)
+	return mpuo
+}
+
+// Save executes the query and returns the updated MessagePurge entity.
+func (mpuo *MessagePurgeUpdateOne) Save(ctx context.Context) (*MessagePurge, error) {
+	return withHooks(ctx, mpuo.sqlSave, mpuo.mutation, mpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpuo *MessagePurgeUpdateOne) SaveX(ctx context.Context) *MessagePurge {
+	node, err := mpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mpuo *MessagePurgeUpdateOne) Exec(ctx context.Context) error {
+	_, err := mpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpuo *MessagePurgeUpdateOne) ExecX(ctx context.Context) {
+	if err := mpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mpuo *MessagePurgeUpdateOne) check() error {
+	if v, ok := mpuo.mutation.RoomID(); ok {
+		if err := messagepurge.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "MessagePurge.room_id": %w`, err)}
+		}
+	}
+	if v, ok := mpuo.mutation.Reason(); ok {
+		if err := messagepurge.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "MessagePurge.reason": %w`, err)}
+		}
+	}
+	if v, ok := mpuo.mutation.Mode(); ok {
+		if err := messagepurge.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "MessagePurge.mode": %w`, err)}
+		}
+	}
+	if v, ok := mpuo.mutation.MessageCount(); ok {
+		if err := messagepurge.MessageCountValidator(v); err != nil {
+			return &ValidationError{Name: "message_count", err: fmt.Errorf(`ent: validator failed for field "MessagePurge.message_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mpuo *MessagePurgeUpdateOne) sqlSave(ctx context.Context) (_node *MessagePurge, err error) {
+	if err := mpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagepurge.Table, messagepurge.Columns, sqlgraph.NewFieldSpec(messagepurge.FieldID, field.TypeInt))
+	id, ok := mpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessagePurge.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagepurge.FieldID)
+		for _, f := range fields {
+			if !messagepurge.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagepurge.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mpuo.mutation.RoomID(); ok {
+		_spec.SetField(messagepurge.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := mpuo.mutation.Reason(); ok {
+		_spec.SetField(messagepurge.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := mpuo.mutation.Mode(); ok {
+		_spec.SetField(messagepurge.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := mpuo.mutation.DryRun(); ok {
+		_spec.SetField(messagepurge.FieldDryRun, field.TypeBool, value)
+	}
+	if value, ok := mpuo.mutation.MessageCount(); ok {
+		_spec.SetField(messagepurge.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := mpuo.mutation.AddedMessageCount(); ok {
+		_spec.AddField(messagepurge.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := mpuo.mutation.MessageIds(); ok {
+		_spec.SetField(messagepurge.FieldMessageIds, field.TypeJSON, value)
+	}
+	if value, ok := mpuo.mutation.AppendedMessageIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, messagepurge.FieldMessageIds, value)
+		})
+	}
+	_node = &MessagePurge{config: mpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagepurge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mpuo.mutation.done = true
+	return _node, nil
+}
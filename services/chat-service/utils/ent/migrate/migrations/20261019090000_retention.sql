@@ -0,0 +1,10 @@
+-- Create "messages" table
+CREATE TABLE "messages" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "content" character varying NOT NULL, "room_id" character varying NOT NULL, "username" character varying NOT NULL, "created_at" timestamptz NOT NULL, "archived_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "message_room_id_created_at" to table: "messages"
+CREATE INDEX "message_room_id_created_at" ON "messages" ("room_id", "created_at");
+-- Create "message_purges" table
+CREATE TABLE "message_purges" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "room_id" character varying NOT NULL, "reason" character varying NOT NULL, "mode" character varying NOT NULL, "dry_run" boolean NOT NULL DEFAULT false, "message_count" bigint NOT NULL, "message_ids" jsonb NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "messagepurge_room_id_created_at" to table: "message_purges"
+CREATE INDEX "messagepurge_room_id_created_at" ON "message_purges" ("room_id", "created_at");
+-- Create "rooms" table
+CREATE TABLE "rooms" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "retention_max_age" bigint NULL, "retention_max_messages" bigint NULL, PRIMARY KEY ("id"));
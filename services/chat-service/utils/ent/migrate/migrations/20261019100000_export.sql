@@ -0,0 +1,8 @@
+-- Create "room_exports" table
+CREATE TABLE "room_exports" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "room_id" character varying NOT NULL, "user_id" character varying NOT NULL, "format" character varying NOT NULL, "from" timestamptz NULL, "to" timestamptz NULL, "status" character varying NOT NULL DEFAULT 'pending', "file_path" character varying NULL, "error" character varying NULL, "message_count" bigint NOT NULL DEFAULT 0, "created_at" timestamptz NOT NULL, "completed_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "roomexport_status" to table: "room_exports"
+CREATE INDEX "roomexport_status" ON "room_exports" ("status");
+-- Create "room_members" table
+CREATE TABLE "room_members" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "room_id" character varying NOT NULL, "user_id" character varying NOT NULL, "username" character varying NOT NULL, "joined_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "roommember_room_id_user_id" to table: "room_members"
+CREATE UNIQUE INDEX "roommember_room_id_user_id" ON "room_members" ("room_id", "user_id");
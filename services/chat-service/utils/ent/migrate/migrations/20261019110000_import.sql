@@ -0,0 +1,12 @@
+-- Create "history_imports" table
+CREATE TABLE "history_imports" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "format" character varying NOT NULL, "file_path" character varying NOT NULL, "user_id" character varying NULL, "status" character varying NOT NULL DEFAULT 'pending', "rooms_created" bigint NOT NULL DEFAULT 0, "messages_imported" bigint NOT NULL DEFAULT 0, "messages_skipped" bigint NOT NULL DEFAULT 0, "completed_rooms" jsonb NULL, "unmapped_users" jsonb NULL, "error" character varying NULL, "created_at" timestamptz NOT NULL, "completed_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "historyimport_status" to table: "history_imports"
+CREATE INDEX "historyimport_status" ON "history_imports" ("status");
+-- Modify "messages" table
+ALTER TABLE "messages" ADD COLUMN "import_key" character varying NULL;
+-- Create index "messages_import_key_key" to table: "messages"
+CREATE UNIQUE INDEX "messages_import_key_key" ON "messages" ("import_key");
+-- Modify "rooms" table
+ALTER TABLE "rooms" ADD COLUMN "import_key" character varying NULL;
+-- Create index "rooms_import_key_key" to table: "rooms"
+CREATE UNIQUE INDEX "rooms_import_key_key" ON "rooms" ("import_key");
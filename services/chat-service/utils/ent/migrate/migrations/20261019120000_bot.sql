@@ -0,0 +1,14 @@
+-- Create "bot_deliveries" table
+CREATE TABLE "bot_deliveries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "subscription_id" bigint NOT NULL, "event" character varying NOT NULL, "payload" text NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "attempts" bigint NOT NULL DEFAULT 0, "next_attempt_at" timestamptz NOT NULL, "last_error" character varying NULL, "created_at" timestamptz NOT NULL, "delivered_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "botdelivery_status_next_attempt_at" to table: "bot_deliveries"
+CREATE INDEX "botdelivery_status_next_attempt_at" ON "bot_deliveries" ("status", "next_attempt_at");
+-- Create index "botdelivery_subscription_id" to table: "bot_deliveries"
+CREATE INDEX "botdelivery_subscription_id" ON "bot_deliveries" ("subscription_id");
+-- Create "bot_subscriptions" table
+CREATE TABLE "bot_subscriptions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "bot_id" character varying NOT NULL, "bot_name" character varying NOT NULL, "room_id" character varying NOT NULL, "callback_url" character varying NOT NULL, "secret" character varying NOT NULL, "events" jsonb NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "botsubscription_bot_id" to table: "bot_subscriptions"
+CREATE INDEX "botsubscription_bot_id" ON "bot_subscriptions" ("bot_id");
+-- Create index "botsubscription_room_id" to table: "bot_subscriptions"
+CREATE INDEX "botsubscription_room_id" ON "bot_subscriptions" ("room_id");
+-- Modify "messages" table
+ALTER TABLE "messages" ADD COLUMN "bot" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,10 @@
+-- Modify "messages" table
+ALTER TABLE "messages" ADD COLUMN "attachments" jsonb NULL;
+-- Modify "rooms" table
+ALTER TABLE "rooms" ADD COLUMN "owner_id" character varying NULL;
+-- Create "webhooks" table
+CREATE TABLE "webhooks" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "room_id" character varying NOT NULL, "name" character varying NOT NULL, "created_by" character varying NOT NULL, "token_hash" character varying NOT NULL, "is_revoked" boolean NOT NULL DEFAULT false, "created_at" timestamptz NOT NULL, "last_used_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "webhook_room_id" to table: "webhooks"
+CREATE INDEX "webhook_room_id" ON "webhooks" ("room_id");
+-- Create index "webhooks_token_hash_key" to table: "webhooks"
+CREATE UNIQUE INDEX "webhooks_token_hash_key" ON "webhooks" ("token_hash");
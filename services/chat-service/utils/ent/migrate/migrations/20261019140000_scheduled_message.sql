@@ -0,0 +1,6 @@
+-- Create "scheduled_messages" table
+CREATE TABLE "scheduled_messages" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "room_id" character varying NOT NULL, "user_id" character varying NOT NULL, "username" character varying NOT NULL, "content" character varying NOT NULL, "send_at" timestamptz NOT NULL, "time_zone" character varying NOT NULL DEFAULT 'UTC', "status" character varying NOT NULL DEFAULT 'pending', "message_id" bigint NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "sent_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "scheduledmessage_status_send_at" to table: "scheduled_messages"
+CREATE INDEX "scheduledmessage_status_send_at" ON "scheduled_messages" ("status", "send_at");
+-- Create index "scheduledmessage_user_id" to table: "scheduled_messages"
+CREATE INDEX "scheduledmessage_user_id" ON "scheduled_messages" ("user_id");
@@ -0,0 +1,10 @@
+-- Create "pinned_messages" table
+CREATE TABLE "pinned_messages" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "room_id" character varying NOT NULL, "pinned_by" character varying NOT NULL, "pinned_at" timestamptz NOT NULL, "message_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "pinned_messages_messages_pins" FOREIGN KEY ("message_id") REFERENCES "messages" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "pinned_messages_message_id_key" to table: "pinned_messages"
+CREATE UNIQUE INDEX "pinned_messages_message_id_key" ON "pinned_messages" ("message_id");
+-- Create index "pinnedmessage_room_id_pinned_at" to table: "pinned_messages"
+CREATE INDEX "pinnedmessage_room_id_pinned_at" ON "pinned_messages" ("room_id", "pinned_at");
+-- Create "saved_messages" table
+CREATE TABLE "saved_messages" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "user_id" character varying NOT NULL, "saved_at" timestamptz NOT NULL, "message_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "saved_messages_messages_saves" FOREIGN KEY ("message_id") REFERENCES "messages" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "savedmessage_user_id_message_id" to table: "saved_messages"
+CREATE UNIQUE INDEX "savedmessage_user_id_message_id" ON "saved_messages" ("user_id", "message_id");
@@ -0,0 +1,10 @@
+-- Create "polls" table
+CREATE TABLE "polls" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "room_id" character varying NOT NULL, "question" character varying NOT NULL, "options" jsonb NOT NULL, "multiple" boolean NOT NULL DEFAULT false, "anonymous" boolean NOT NULL DEFAULT false, "created_by" character varying NOT NULL, "closes_at" timestamptz NULL, "closed_at" timestamptz NULL, "summary_message_id" bigint NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "message_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "polls_messages_poll" FOREIGN KEY ("message_id") REFERENCES "messages" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "poll_closed_at_closes_at" to table: "polls"
+CREATE INDEX "poll_closed_at_closes_at" ON "polls" ("closed_at", "closes_at");
+-- Create index "polls_message_id_key" to table: "polls"
+CREATE UNIQUE INDEX "polls_message_id_key" ON "polls" ("message_id");
+-- Create "poll_votes" table
+CREATE TABLE "poll_votes" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "user_id" character varying NOT NULL, "username" character varying NOT NULL, "option" bigint NOT NULL, "created_at" timestamptz NOT NULL, "poll_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "poll_votes_polls_votes" FOREIGN KEY ("poll_id") REFERENCES "polls" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "pollvote_poll_id_user_id_option" to table: "poll_votes"
+CREATE UNIQUE INDEX "pollvote_poll_id_user_id_option" ON "poll_votes" ("poll_id", "user_id", "option");
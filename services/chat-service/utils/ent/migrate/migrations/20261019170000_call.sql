@@ -0,0 +1,10 @@
+-- Create "call_sessions" table
+CREATE TABLE "call_sessions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "room_id" character varying NOT NULL, "started_by" character varying NOT NULL, "started_by_name" character varying NOT NULL, "started_at" timestamptz NOT NULL, "ended_at" timestamptz NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "callsession_room_id" to table: "call_sessions"
+CREATE UNIQUE INDEX "callsession_room_id" ON "call_sessions" ("room_id") WHERE (ended_at IS NULL);
+-- Create index "callsession_room_id_started_at" to table: "call_sessions"
+CREATE INDEX "callsession_room_id_started_at" ON "call_sessions" ("room_id", "started_at");
+-- Create "call_participants" table
+CREATE TABLE "call_participants" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "user_id" character varying NOT NULL, "username" character varying NOT NULL, "joined_at" timestamptz NOT NULL, "left_at" timestamptz NULL, "call_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "call_participants_call_sessions_participants" FOREIGN KEY ("call_id") REFERENCES "call_sessions" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "callparticipant_call_id_user_id" to table: "call_participants"
+CREATE UNIQUE INDEX "callparticipant_call_id_user_id" ON "call_participants" ("call_id", "user_id") WHERE (left_at IS NULL);
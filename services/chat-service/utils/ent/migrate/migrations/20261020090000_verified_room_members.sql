@@ -0,0 +1,2 @@
+-- Drop "room_members" rows recorded from unauthenticated joins, keeping subscribed bots
+DELETE FROM "room_members" WHERE NOT EXISTS (SELECT 1 FROM "bot_subscriptions" WHERE "bot_subscriptions"."bot_id" = "room_members"."user_id" AND "bot_subscriptions"."room_id" = "room_members"."room_id");
@@ -0,0 +1,8 @@
+-- Fail the completed exports, whose files are not kept in the database
+UPDATE "room_exports" SET "status" = 'failed', "error" = 'export file is no longer available, export the room again' WHERE "status" = 'completed';
+-- Modify "room_exports" table
+ALTER TABLE "room_exports" DROP COLUMN "file_path", ADD COLUMN "lease_until" timestamptz NULL;
+-- Create "room_export_chunks" table
+CREATE TABLE "room_export_chunks" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "export_id" bigint NOT NULL, "seq" bigint NOT NULL, "data" bytea NOT NULL, PRIMARY KEY ("id"));
+-- Create index "roomexportchunk_export_id_seq" to table: "room_export_chunks"
+CREATE UNIQUE INDEX "roomexportchunk_export_id_seq" ON "room_export_chunks" ("export_id", "seq");
@@ -3,28 +3,318 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// BotDeliveriesColumns holds the columns for the "bot_deliveries" table.
+	BotDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "subscription_id", Type: field.TypeInt},
+		{Name: "event", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "delivered", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+	}
+	// BotDeliveriesTable holds the schema information for the "bot_deliveries" table.
+	BotDeliveriesTable = &schema.Table{
+		Name:       "bot_deliveries",
+		Columns:    BotDeliveriesColumns,
+		PrimaryKey: []*schema.Column{BotDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "botdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{BotDeliveriesColumns[4], BotDeliveriesColumns[6]},
+			},
+			{
+				Name:    "botdelivery_subscription_id",
+				Unique:  false,
+				Columns: []*schema.Column{BotDeliveriesColumns[1]},
+			},
+		},
+	}
+	// BotSubscriptionsColumns holds the columns for the "bot_subscriptions" table.
+	BotSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "bot_id", Type: field.TypeString},
+		{Name: "bot_name", Type: field.TypeString},
+		{Name: "room_id", Type: field.TypeString},
+		{Name: "callback_url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BotSubscriptionsTable holds the schema information for the "bot_subscriptions" table.
+	BotSubscriptionsTable = &schema.Table{
+		Name:       "bot_subscriptions",
+		Columns:    BotSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{BotSubscriptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "botsubscription_room_id",
+				Unique:  false,
+				Columns: []*schema.Column{BotSubscriptionsColumns[3]},
+			},
+			{
+				Name:    "botsubscription_bot_id",
+				Unique:  false,
+				Columns: []*schema.Column{BotSubscriptionsColumns[1]},
+			},
+		},
+	}
+	// CallParticipantsColumns holds the columns for the "call_participants" table.
+	CallParticipantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "username", Type: field.TypeString},
+		{Name: "joined_at", Type: field.TypeTime},
+		{Name: "left_at", Type: field.TypeTime, Nullable: true},
+		{Name: "call_id", Type: field.TypeInt},
+	}
+	// CallParticipantsTable holds the schema information for the "call_participants" table.
+	CallParticipantsTable = &schema.Table{
+		Name:       "call_participants",
+		Columns:    CallParticipantsColumns,
+		PrimaryKey: []*schema.Column{CallParticipantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "call_participants_call_sessions_participants",
+				Columns:    []*schema.Column{CallParticipantsColumns[5]},
+				RefColumns: []*schema.Column{CallSessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "callparticipant_call_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{CallParticipantsColumns[5], CallParticipantsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "left_at IS NULL",
+				},
+			},
+		},
+	}
+	// CallSessionsColumns holds the columns for the "call_sessions" table.
+	CallSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "room_id", Type: field.TypeString},
+		{Name: "started_by", Type: field.TypeString},
+		{Name: "started_by_name", Type: field.TypeString},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CallSessionsTable holds the schema information for the "call_sessions" table.
+	CallSessionsTable = &schema.Table{
+		Name:       "call_sessions",
+		Columns:    CallSessionsColumns,
+		PrimaryKey: []*schema.Column{CallSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "callsession_room_id",
+				Unique:  true,
+				Columns: []*schema.Column{CallSessionsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "ended_at IS NULL",
+				},
+			},
+			{
+				Name:    "callsession_room_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{CallSessionsColumns[1], CallSessionsColumns[4]},
+			},
+		},
+	}
+	// HistoryImportsColumns holds the columns for the "history_imports" table.
+	HistoryImportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"slack", "json"}},
+		{Name: "file_path", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "completed", "failed"}, Default: "pending"},
+		{Name: "rooms_created", Type: field.TypeInt, Default: 0},
+		{Name: "messages_imported", Type: field.TypeInt, Default: 0},
+		{Name: "messages_skipped", Type: field.TypeInt, Default: 0},
+		{Name: "completed_rooms", Type: field.TypeJSON, Nullable: true},
+		{Name: "unmapped_users", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// HistoryImportsTable holds the schema information for the "history_imports" table.
+	HistoryImportsTable = &schema.Table{
+		Name:       "history_imports",
+		Columns:    HistoryImportsColumns,
+		PrimaryKey: []*schema.Column{HistoryImportsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "historyimport_status",
+				Unique:  false,
+				Columns: []*schema.Column{HistoryImportsColumns[4]},
+			},
+		},
+	}
 	// MessagesColumns holds the columns for the "messages" table.
 	MessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "content", Type: field.TypeString},
 		{Name: "room_id", Type: field.TypeString},
 		{Name: "username", Type: field.TypeString},
+		{Name: "bot", Type: field.TypeBool, Default: false},
+		{Name: "attachments", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "import_key", Type: field.TypeString, Unique: true, Nullable: true},
 	}
 	// MessagesTable holds the schema information for the "messages" table.
 	MessagesTable = &schema.Table{
 		Name:       "messages",
 		Columns:    MessagesColumns,
 		PrimaryKey: []*schema.Column{MessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "message_room_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[2], MessagesColumns[6]},
+			},
+		},
+	}
+	// MessagePurgesColumns holds the columns for the "message_purges" table.
+	MessagePurgesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "room_id", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"max_age", "max_messages"}},
+		{Name: "mode", Type: field.TypeEnum, Enums: []string{"delete", "archive"}},
+		{Name: "dry_run", Type: field.TypeBool, Default: false},
+		{Name: "message_count", Type: field.TypeInt},
+		{Name: "message_ids", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// MessagePurgesTable holds the schema information for the "message_purges" table.
+	MessagePurgesTable = &schema.Table{
+		Name:       "message_purges",
+		Columns:    MessagePurgesColumns,
+		PrimaryKey: []*schema.Column{MessagePurgesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagepurge_room_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagePurgesColumns[1], MessagePurgesColumns[7]},
+			},
+		},
+	}
+	// PinnedMessagesColumns holds the columns for the "pinned_messages" table.
+	PinnedMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "room_id", Type: field.TypeString},
+		{Name: "pinned_by", Type: field.TypeString},
+		{Name: "pinned_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeInt},
+	}
+	// PinnedMessagesTable holds the schema information for the "pinned_messages" table.
+	PinnedMessagesTable = &schema.Table{
+		Name:       "pinned_messages",
+		Columns:    PinnedMessagesColumns,
+		PrimaryKey: []*schema.Column{PinnedMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pinned_messages_messages_pins",
+				Columns:    []*schema.Column{PinnedMessagesColumns[4]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pinnedmessage_room_id_pinned_at",
+				Unique:  false,
+				Columns: []*schema.Column{PinnedMessagesColumns[1], PinnedMessagesColumns[3]},
+			},
+		},
+	}
+	// PollsColumns holds the columns for the "polls" table.
+	PollsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "room_id", Type: field.TypeString},
+		{Name: "question", Type: field.TypeString},
+		{Name: "options", Type: field.TypeJSON},
+		{Name: "multiple", Type: field.TypeBool, Default: false},
+		{Name: "anonymous", Type: field.TypeBool, Default: false},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "closes_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "summary_message_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeInt, Unique: true},
+	}
+	// PollsTable holds the schema information for the "polls" table.
+	PollsTable = &schema.Table{
+		Name:       "polls",
+		Columns:    PollsColumns,
+		PrimaryKey: []*schema.Column{PollsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "polls_messages_poll",
+				Columns:    []*schema.Column{PollsColumns[12]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "poll_closed_at_closes_at",
+				Unique:  false,
+				Columns: []*schema.Column{PollsColumns[8], PollsColumns[7]},
+			},
+		},
+	}
+	// PollVotesColumns holds the columns for the "poll_votes" table.
+	PollVotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "username", Type: field.TypeString},
+		{Name: "option", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "poll_id", Type: field.TypeInt},
+	}
+	// PollVotesTable holds the schema information for the "poll_votes" table.
+	PollVotesTable = &schema.Table{
+		Name:       "poll_votes",
+		Columns:    PollVotesColumns,
+		PrimaryKey: []*schema.Column{PollVotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "poll_votes_polls_votes",
+				Columns:    []*schema.Column{PollVotesColumns[5]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pollvote_poll_id_user_id_option",
+				Unique:  true,
+				Columns: []*schema.Column{PollVotesColumns[5], PollVotesColumns[1], PollVotesColumns[3]},
+			},
+		},
 	}
 	// RoomsColumns holds the columns for the "rooms" table.
 	RoomsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString},
+		{Name: "retention_max_age", Type: field.TypeInt64, Nullable: true},
+		{Name: "retention_max_messages", Type: field.TypeInt, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "import_key", Type: field.TypeString, Unique: true, Nullable: true},
 	}
 	// RoomsTable holds the schema information for the "rooms" table.
 	RoomsTable = &schema.Table{
@@ -32,12 +322,186 @@ var (
 		Columns:    RoomsColumns,
 		PrimaryKey: []*schema.Column{RoomsColumns[0]},
 	}
+	// RoomExportsColumns holds the columns for the "room_exports" table.
+	RoomExportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "room_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"jsonl", "csv", "html"}},
+		{Name: "from", Type: field.TypeTime, Nullable: true},
+		{Name: "to", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "completed", "failed"}, Default: "pending"},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "message_count", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "lease_until", Type: field.TypeTime, Nullable: true},
+	}
+	// RoomExportsTable holds the schema information for the "room_exports" table.
+	RoomExportsTable = &schema.Table{
+		Name:       "room_exports",
+		Columns:    RoomExportsColumns,
+		PrimaryKey: []*schema.Column{RoomExportsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "roomexport_status",
+				Unique:  false,
+				Columns: []*schema.Column{RoomExportsColumns[6]},
+			},
+		},
+	}
+	// RoomExportChunksColumns holds the columns for the "room_export_chunks" table.
+	RoomExportChunksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "export_id", Type: field.TypeInt},
+		{Name: "seq", Type: field.TypeInt},
+		{Name: "data", Type: field.TypeBytes},
+	}
+	// RoomExportChunksTable holds the schema information for the "room_export_chunks" table.
+	RoomExportChunksTable = &schema.Table{
+		Name:       "room_export_chunks",
+		Columns:    RoomExportChunksColumns,
+		PrimaryKey: []*schema.Column{RoomExportChunksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "roomexportchunk_export_id_seq",
+				Unique:  true,
+				Columns: []*schema.Column{RoomExportChunksColumns[1], RoomExportChunksColumns[2]},
+			},
+		},
+	}
+	// RoomMembersColumns holds the columns for the "room_members" table.
+	RoomMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "room_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "username", Type: field.TypeString},
+		{Name: "joined_at", Type: field.TypeTime},
+	}
+	// RoomMembersTable holds the schema information for the "room_members" table.
+	RoomMembersTable = &schema.Table{
+		Name:       "room_members",
+		Columns:    RoomMembersColumns,
+		PrimaryKey: []*schema.Column{RoomMembersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "roommember_room_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{RoomMembersColumns[1], RoomMembersColumns[2]},
+			},
+		},
+	}
+	// SavedMessagesColumns holds the columns for the "saved_messages" table.
+	SavedMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "saved_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeInt},
+	}
+	// SavedMessagesTable holds the schema information for the "saved_messages" table.
+	SavedMessagesTable = &schema.Table{
+		Name:       "saved_messages",
+		Columns:    SavedMessagesColumns,
+		PrimaryKey: []*schema.Column{SavedMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_messages_messages_saves",
+				Columns:    []*schema.Column{SavedMessagesColumns[3]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "savedmessage_user_id_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{SavedMessagesColumns[1], SavedMessagesColumns[3]},
+			},
+		},
+	}
+	// ScheduledMessagesColumns holds the columns for the "scheduled_messages" table.
+	ScheduledMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "room_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "username", Type: field.TypeString},
+		{Name: "content", Type: field.TypeString},
+		{Name: "send_at", Type: field.TypeTime},
+		{Name: "time_zone", Type: field.TypeString, Default: "UTC"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sent", "canceled"}, Default: "pending"},
+		{Name: "message_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ScheduledMessagesTable holds the schema information for the "scheduled_messages" table.
+	ScheduledMessagesTable = &schema.Table{
+		Name:       "scheduled_messages",
+		Columns:    ScheduledMessagesColumns,
+		PrimaryKey: []*schema.Column{ScheduledMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scheduledmessage_status_send_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledMessagesColumns[7], ScheduledMessagesColumns[5]},
+			},
+			{
+				Name:    "scheduledmessage_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledMessagesColumns[2]},
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "room_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "is_revoked", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhook_room_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhooksColumns[1]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BotDeliveriesTable,
+		BotSubscriptionsTable,
+		CallParticipantsTable,
+		CallSessionsTable,
+		HistoryImportsTable,
 		MessagesTable,
+		MessagePurgesTable,
+		PinnedMessagesTable,
+		PollsTable,
+		PollVotesTable,
 		RoomsTable,
+		RoomExportsTable,
+		RoomExportChunksTable,
+		RoomMembersTable,
+		SavedMessagesTable,
+		ScheduledMessagesTable,
+		WebhooksTable,
 	}
 )
 
 func init() {
+	CallParticipantsTable.ForeignKeys[0].RefTable = CallSessionsTable
+	PinnedMessagesTable.ForeignKeys[0].RefTable = MessagesTable
+	PollsTable.ForeignKeys[0].RefTable = MessagesTable
+	PollVotesTable.ForeignKeys[0].RefTable = PollsTable
+	SavedMessagesTable.ForeignKeys[0].RefTable = MessagesTable
 }
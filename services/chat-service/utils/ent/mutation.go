@@ -7,12 +7,29 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botdelivery"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botsubscription"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callparticipant"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callsession"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/historyimport"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/messagepurge"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pinnedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pollvote"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/room"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexport"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexportchunk"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roommember"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/savedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/scheduledmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/schema"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/webhook"
 )
 
 const (
@@ -24,36 +41,11321 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeMessage = "Message"
-	TypeRoom    = "Room"
+	TypeBotDelivery      = "BotDelivery"
+	TypeBotSubscription  = "BotSubscription"
+	TypeCallParticipant  = "CallParticipant"
+	TypeCallSession      = "CallSession"
+	TypeHistoryImport    = "HistoryImport"
+	TypeMessage          = "Message"
+	TypeMessagePurge     = "MessagePurge"
+	TypePinnedMessage    = "PinnedMessage"
+	TypePoll             = "Poll"
+	TypePollVote         = "PollVote"
+	TypeRoom             = "Room"
+	TypeRoomExport       = "RoomExport"
+	TypeRoomExportChunk  = "RoomExportChunk"
+	TypeRoomMember       = "RoomMember"
+	TypeSavedMessage     = "SavedMessage"
+	TypeScheduledMessage = "ScheduledMessage"
+	TypeWebhook          = "Webhook"
 )
 
+// BotDeliveryMutation represents an operation that mutates the BotDelivery nodes in the graph.
+type BotDeliveryMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	subscription_id    *int
+	addsubscription_id *int
+	event              *string
+	payload            *string
+	status             *botdelivery.Status
+	attempts           *int
+	addattempts        *int
+	next_attempt_at    *time.Time
+	last_error         *string
+	created_at         *time.Time
+	delivered_at       *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*BotDelivery, error)
+	predicates         []predicate.BotDelivery
+}
+
+var _ ent.Mutation = (*BotDeliveryMutation)(nil)
+
+// botdeliveryOption allows management of the mutation configuration using functional options.
+type botdeliveryOption func(*BotDeliveryMutation)
+
+// newBotDeliveryMutation creates new mutation for the BotDelivery entity.
+func newBotDeliveryMutation(c config, op Op, opts ...botdeliveryOption) *BotDeliveryMutation {
+	m := &BotDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBotDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBotDeliveryID sets the ID field of the mutation.
+func withBotDeliveryID(id int) botdeliveryOption {
+	return func(m *BotDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BotDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*BotDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BotDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBotDelivery sets the old BotDelivery of the mutation.
+func withBotDelivery(node *BotDelivery) botdeliveryOption {
+	return func(m *BotDeliveryMutation) {
+		m.oldValue = func(context.Context) (*BotDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BotDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BotDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BotDeliveryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BotDeliveryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BotDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (m *BotDeliveryMutation) SetSubscriptionID(i int) {
+	m.subscription_id = &i
+	m.addsubscription_id = nil
+}
+
+// SubscriptionID returns the value of the "subscription_id" field in the mutation.
+func (m *BotDeliveryMutation) SubscriptionID() (r int, exists bool) {
+	v := m.subscription_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubscriptionID returns the old "subscription_id" field's value of the BotDelivery entity.
+// If the BotDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotDeliveryMutation) OldSubscriptionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubscriptionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubscriptionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubscriptionID: %w", err)
+	}
+	return oldValue.SubscriptionID, nil
+}
+
+// AddSubscriptionID adds i to the "subscription_id" field.
+func (m *BotDeliveryMutation) AddSubscriptionID(i int) {
+	if m.addsubscription_id != nil {
+		*m.addsubscription_id += i
+	} else {
+		m.addsubscription_id = &i
+	}
+}
+
+// AddedSubscriptionID returns the value that was added to the "subscription_id" field in this mutation.
+func (m *BotDeliveryMutation) AddedSubscriptionID() (r int, exists bool) {
+	v := m.addsubscription_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSubscriptionID resets all changes to the "subscription_id" field.
+func (m *BotDeliveryMutation) ResetSubscriptionID() {
+	m.subscription_id = nil
+	m.addsubscription_id = nil
+}
+
+// SetEvent sets the "event" field.
+func (m *BotDeliveryMutation) SetEvent(s string) {
+	m.event = &s
+}
+
+// Event returns the value of the "event" field in the mutation.
+func (m *BotDeliveryMutation) Event() (r string, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvent returns the old "event" field's value of the BotDelivery entity.
+// If the BotDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotDeliveryMutation) OldEvent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
+}
+
+// ResetEvent resets all changes to the "event" field.
+func (m *BotDeliveryMutation) ResetEvent() {
+	m.event = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *BotDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *BotDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the BotDelivery entity.
+// If the BotDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *BotDeliveryMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *BotDeliveryMutation) SetStatus(b botdelivery.Status) {
+	m.status = &b
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *BotDeliveryMutation) Status() (r botdelivery.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the BotDelivery entity.
+// If the BotDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotDeliveryMutation) OldStatus(ctx context.Context) (v botdelivery.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *BotDeliveryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *BotDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *BotDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the BotDelivery entity.
+// If the BotDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *BotDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *BotDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *BotDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *BotDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *BotDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the BotDelivery entity.
+// If the BotDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *BotDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *BotDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *BotDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the BotDelivery entity.
+// If the BotDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotDeliveryMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *BotDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[botdelivery.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *BotDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[botdelivery.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *BotDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, botdelivery.FieldLastError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BotDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BotDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BotDelivery entity.
+// If the BotDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BotDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *BotDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *BotDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveredAt returns the old "delivered_at" field's value of the BotDelivery entity.
+// If the BotDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
+	}
+	return oldValue.DeliveredAt, nil
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *BotDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[botdelivery.FieldDeliveredAt] = struct{}{}
+}
+
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *BotDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[botdelivery.FieldDeliveredAt]
+	return ok
+}
+
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *BotDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, botdelivery.FieldDeliveredAt)
+}
+
+// Where appends a list predicates to the BotDeliveryMutation builder.
+func (m *BotDeliveryMutation) Where(ps ...predicate.BotDelivery) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BotDeliveryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BotDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BotDelivery, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BotDeliveryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BotDeliveryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BotDelivery).
+func (m *BotDeliveryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BotDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.subscription_id != nil {
+		fields = append(fields, botdelivery.FieldSubscriptionID)
+	}
+	if m.event != nil {
+		fields = append(fields, botdelivery.FieldEvent)
+	}
+	if m.payload != nil {
+		fields = append(fields, botdelivery.FieldPayload)
+	}
+	if m.status != nil {
+		fields = append(fields, botdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, botdelivery.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, botdelivery.FieldNextAttemptAt)
+	}
+	if m.last_error != nil {
+		fields = append(fields, botdelivery.FieldLastError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, botdelivery.FieldCreatedAt)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, botdelivery.FieldDeliveredAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BotDeliveryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case botdelivery.FieldSubscriptionID:
+		return m.SubscriptionID()
+	case botdelivery.FieldEvent:
+		return m.Event()
+	case botdelivery.FieldPayload:
+		return m.Payload()
+	case botdelivery.FieldStatus:
+		return m.Status()
+	case botdelivery.FieldAttempts:
+		return m.Attempts()
+	case botdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case botdelivery.FieldLastError:
+		return m.LastError()
+	case botdelivery.FieldCreatedAt:
+		return m.CreatedAt()
+	case botdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BotDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case botdelivery.FieldSubscriptionID:
+		return m.OldSubscriptionID(ctx)
+	case botdelivery.FieldEvent:
+		return m.OldEvent(ctx)
+	case botdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case botdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case botdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case botdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case botdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case botdelivery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case botdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BotDelivery field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BotDeliveryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case botdelivery.FieldSubscriptionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubscriptionID(v)
+		return nil
+	case botdelivery.FieldEvent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvent(v)
+		return nil
+	case botdelivery.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case botdelivery.FieldStatus:
+		v, ok := value.(botdelivery.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case botdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case botdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case botdelivery.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case botdelivery.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case botdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BotDelivery field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BotDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addsubscription_id != nil {
+		fields = append(fields, botdelivery.FieldSubscriptionID)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, botdelivery.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BotDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case botdelivery.FieldSubscriptionID:
+		return m.AddedSubscriptionID()
+	case botdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BotDeliveryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case botdelivery.FieldSubscriptionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubscriptionID(v)
+		return nil
+	case botdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BotDelivery numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BotDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(botdelivery.FieldLastError) {
+		fields = append(fields, botdelivery.FieldLastError)
+	}
+	if m.FieldCleared(botdelivery.FieldDeliveredAt) {
+		fields = append(fields, botdelivery.FieldDeliveredAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BotDeliveryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BotDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case botdelivery.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case botdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BotDelivery nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BotDeliveryMutation) ResetField(name string) error {
+	switch name {
+	case botdelivery.FieldSubscriptionID:
+		m.ResetSubscriptionID()
+		return nil
+	case botdelivery.FieldEvent:
+		m.ResetEvent()
+		return nil
+	case botdelivery.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case botdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case botdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case botdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case botdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case botdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case botdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BotDelivery field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BotDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BotDeliveryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BotDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BotDeliveryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BotDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BotDeliveryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BotDeliveryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BotDelivery unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BotDeliveryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BotDelivery edge %s", name)
+}
+
+// BotSubscriptionMutation represents an operation that mutates the BotSubscription nodes in the graph.
+type BotSubscriptionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	bot_id        *string
+	bot_name      *string
+	room_id       *string
+	callback_url  *string
+	secret        *string
+	events        *[]string
+	appendevents  []string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*BotSubscription, error)
+	predicates    []predicate.BotSubscription
+}
+
+var _ ent.Mutation = (*BotSubscriptionMutation)(nil)
+
+// botsubscriptionOption allows management of the mutation configuration using functional options.
+type botsubscriptionOption func(*BotSubscriptionMutation)
+
+// newBotSubscriptionMutation creates new mutation for the BotSubscription entity.
+func newBotSubscriptionMutation(c config, op Op, opts ...botsubscriptionOption) *BotSubscriptionMutation {
+	m := &BotSubscriptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBotSubscription,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBotSubscriptionID sets the ID field of the mutation.
+func withBotSubscriptionID(id int) botsubscriptionOption {
+	return func(m *BotSubscriptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BotSubscription
+		)
+		m.oldValue = func(ctx context.Context) (*BotSubscription, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BotSubscription.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBotSubscription sets the old BotSubscription of the mutation.
+func withBotSubscription(node *BotSubscription) botsubscriptionOption {
+	return func(m *BotSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*BotSubscription, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BotSubscriptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BotSubscriptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BotSubscriptionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BotSubscriptionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BotSubscription.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBotID sets the "bot_id" field.
+func (m *BotSubscriptionMutation) SetBotID(s string) {
+	m.bot_id = &s
+}
+
+// BotID returns the value of the "bot_id" field in the mutation.
+func (m *BotSubscriptionMutation) BotID() (r string, exists bool) {
+	v := m.bot_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBotID returns the old "bot_id" field's value of the BotSubscription entity.
+// If the BotSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotSubscriptionMutation) OldBotID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBotID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBotID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBotID: %w", err)
+	}
+	return oldValue.BotID, nil
+}
+
+// ResetBotID resets all changes to the "bot_id" field.
+func (m *BotSubscriptionMutation) ResetBotID() {
+	m.bot_id = nil
+}
+
+// SetBotName sets the "bot_name" field.
+func (m *BotSubscriptionMutation) SetBotName(s string) {
+	m.bot_name = &s
+}
+
+// BotName returns the value of the "bot_name" field in the mutation.
+func (m *BotSubscriptionMutation) BotName() (r string, exists bool) {
+	v := m.bot_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBotName returns the old "bot_name" field's value of the BotSubscription entity.
+// If the BotSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotSubscriptionMutation) OldBotName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBotName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBotName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBotName: %w", err)
+	}
+	return oldValue.BotName, nil
+}
+
+// ResetBotName resets all changes to the "bot_name" field.
+func (m *BotSubscriptionMutation) ResetBotName() {
+	m.bot_name = nil
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *BotSubscriptionMutation) SetRoomID(s string) {
+	m.room_id = &s
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *BotSubscriptionMutation) RoomID() (r string, exists bool) {
+	v := m.room_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the BotSubscription entity.
+// If the BotSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotSubscriptionMutation) OldRoomID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *BotSubscriptionMutation) ResetRoomID() {
+	m.room_id = nil
+}
+
+// SetCallbackURL sets the "callback_url" field.
+func (m *BotSubscriptionMutation) SetCallbackURL(s string) {
+	m.callback_url = &s
+}
+
+// CallbackURL returns the value of the "callback_url" field in the mutation.
+func (m *BotSubscriptionMutation) CallbackURL() (r string, exists bool) {
+	v := m.callback_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCallbackURL returns the old "callback_url" field's value of the BotSubscription entity.
+// If the BotSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotSubscriptionMutation) OldCallbackURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCallbackURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCallbackURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCallbackURL: %w", err)
+	}
+	return oldValue.CallbackURL, nil
+}
+
+// ResetCallbackURL resets all changes to the "callback_url" field.
+func (m *BotSubscriptionMutation) ResetCallbackURL() {
+	m.callback_url = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *BotSubscriptionMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *BotSubscriptionMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the BotSubscription entity.
+// If the BotSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotSubscriptionMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *BotSubscriptionMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetEvents sets the "events" field.
+func (m *BotSubscriptionMutation) SetEvents(s []string) {
+	m.events = &s
+	m.appendevents = nil
+}
+
+// Events returns the value of the "events" field in the mutation.
+func (m *BotSubscriptionMutation) Events() (r []string, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvents returns the old "events" field's value of the BotSubscription entity.
+// If the BotSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotSubscriptionMutation) OldEvents(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
+}
+
+// AppendEvents adds s to the "events" field.
+func (m *BotSubscriptionMutation) AppendEvents(s []string) {
+	m.appendevents = append(m.appendevents, s...)
+}
+
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *BotSubscriptionMutation) AppendedEvents() ([]string, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
+	}
+	return m.appendevents, true
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *BotSubscriptionMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BotSubscriptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BotSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BotSubscription entity.
+// If the BotSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BotSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BotSubscriptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the BotSubscriptionMutation builder.
+func (m *BotSubscriptionMutation) Where(ps ...predicate.BotSubscription) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BotSubscriptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BotSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BotSubscription, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BotSubscriptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BotSubscriptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BotSubscription).
+func (m *BotSubscriptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BotSubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.bot_id != nil {
+		fields = append(fields, botsubscription.FieldBotID)
+	}
+	if m.bot_name != nil {
+		fields = append(fields, botsubscription.FieldBotName)
+	}
+	if m.room_id != nil {
+		fields = append(fields, botsubscription.FieldRoomID)
+	}
+	if m.callback_url != nil {
+		fields = append(fields, botsubscription.FieldCallbackURL)
+	}
+	if m.secret != nil {
+		fields = append(fields, botsubscription.FieldSecret)
+	}
+	if m.events != nil {
+		fields = append(fields, botsubscription.FieldEvents)
+	}
+	if m.created_at != nil {
+		fields = append(fields, botsubscription.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BotSubscriptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case botsubscription.FieldBotID:
+		return m.BotID()
+	case botsubscription.FieldBotName:
+		return m.BotName()
+	case botsubscription.FieldRoomID:
+		return m.RoomID()
+	case botsubscription.FieldCallbackURL:
+		return m.CallbackURL()
+	case botsubscription.FieldSecret:
+		return m.Secret()
+	case botsubscription.FieldEvents:
+		return m.Events()
+	case botsubscription.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BotSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case botsubscription.FieldBotID:
+		return m.OldBotID(ctx)
+	case botsubscription.FieldBotName:
+		return m.OldBotName(ctx)
+	case botsubscription.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case botsubscription.FieldCallbackURL:
+		return m.OldCallbackURL(ctx)
+	case botsubscription.FieldSecret:
+		return m.OldSecret(ctx)
+	case botsubscription.FieldEvents:
+		return m.OldEvents(ctx)
+	case botsubscription.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BotSubscription field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BotSubscriptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case botsubscription.FieldBotID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBotID(v)
+		return nil
+	case botsubscription.FieldBotName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBotName(v)
+		return nil
+	case botsubscription.FieldRoomID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case botsubscription.FieldCallbackURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCallbackURL(v)
+		return nil
+	case botsubscription.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case botsubscription.FieldEvents:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case botsubscription.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BotSubscription field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BotSubscriptionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BotSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BotSubscriptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BotSubscription numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BotSubscriptionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BotSubscriptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BotSubscriptionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BotSubscription nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BotSubscriptionMutation) ResetField(name string) error {
+	switch name {
+	case botsubscription.FieldBotID:
+		m.ResetBotID()
+		return nil
+	case botsubscription.FieldBotName:
+		m.ResetBotName()
+		return nil
+	case botsubscription.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case botsubscription.FieldCallbackURL:
+		m.ResetCallbackURL()
+		return nil
+	case botsubscription.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case botsubscription.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case botsubscription.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BotSubscription field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BotSubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BotSubscriptionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BotSubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BotSubscriptionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BotSubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BotSubscriptionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BotSubscriptionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BotSubscription unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BotSubscriptionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BotSubscription edge %s", name)
+}
+
+// CallParticipantMutation represents an operation that mutates the CallParticipant nodes in the graph.
+type CallParticipantMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	user_id       *string
+	username      *string
+	joined_at     *time.Time
+	left_at       *time.Time
+	clearedFields map[string]struct{}
+	call          *int
+	clearedcall   bool
+	done          bool
+	oldValue      func(context.Context) (*CallParticipant, error)
+	predicates    []predicate.CallParticipant
+}
+
+var _ ent.Mutation = (*CallParticipantMutation)(nil)
+
+// callparticipantOption allows management of the mutation configuration using functional options.
+type callparticipantOption func(*CallParticipantMutation)
+
+// newCallParticipantMutation creates new mutation for the CallParticipant entity.
+func newCallParticipantMutation(c config, op Op, opts ...callparticipantOption) *CallParticipantMutation {
+	m := &CallParticipantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCallParticipant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCallParticipantID sets the ID field of the mutation.
+func withCallParticipantID(id int) callparticipantOption {
+	return func(m *CallParticipantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CallParticipant
+		)
+		m.oldValue = func(ctx context.Context) (*CallParticipant, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CallParticipant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCallParticipant sets the old CallParticipant of the mutation.
+func withCallParticipant(node *CallParticipant) callparticipantOption {
+	return func(m *CallParticipantMutation) {
+		m.oldValue = func(context.Context) (*CallParticipant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CallParticipantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CallParticipantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CallParticipantMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CallParticipantMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CallParticipant.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCallID sets the "call_id" field.
+func (m *CallParticipantMutation) SetCallID(i int) {
+	m.call = &i
+}
+
+// CallID returns the value of the "call_id" field in the mutation.
+func (m *CallParticipantMutation) CallID() (r int, exists bool) {
+	v := m.call
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCallID returns the old "call_id" field's value of the CallParticipant entity.
+// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallParticipantMutation) OldCallID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCallID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCallID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCallID: %w", err)
+	}
+	return oldValue.CallID, nil
+}
+
+// ResetCallID resets all changes to the "call_id" field.
+func (m *CallParticipantMutation) ResetCallID() {
+	m.call = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *CallParticipantMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *CallParticipantMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the CallParticipant entity.
+// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallParticipantMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *CallParticipantMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *CallParticipantMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *CallParticipantMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the CallParticipant entity.
+// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallParticipantMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *CallParticipantMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (m *CallParticipantMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
+}
+
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *CallParticipantMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinedAt returns the old "joined_at" field's value of the CallParticipant entity.
+// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallParticipantMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+	}
+	return oldValue.JoinedAt, nil
+}
+
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *CallParticipantMutation) ResetJoinedAt() {
+	m.joined_at = nil
+}
+
+// SetLeftAt sets the "left_at" field.
+func (m *CallParticipantMutation) SetLeftAt(t time.Time) {
+	m.left_at = &t
+}
+
+// LeftAt returns the value of the "left_at" field in the mutation.
+func (m *CallParticipantMutation) LeftAt() (r time.Time, exists bool) {
+	v := m.left_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeftAt returns the old "left_at" field's value of the CallParticipant entity.
+// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallParticipantMutation) OldLeftAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeftAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeftAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeftAt: %w", err)
+	}
+	return oldValue.LeftAt, nil
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (m *CallParticipantMutation) ClearLeftAt() {
+	m.left_at = nil
+	m.clearedFields[callparticipant.FieldLeftAt] = struct{}{}
+}
+
+// LeftAtCleared returns if the "left_at" field was cleared in this mutation.
+func (m *CallParticipantMutation) LeftAtCleared() bool {
+	_, ok := m.clearedFields[callparticipant.FieldLeftAt]
+	return ok
+}
+
+// ResetLeftAt resets all changes to the "left_at" field.
+func (m *CallParticipantMutation) ResetLeftAt() {
+	m.left_at = nil
+	delete(m.clearedFields, callparticipant.FieldLeftAt)
+}
+
+// ClearCall clears the "call" edge to the CallSession entity.
+func (m *CallParticipantMutation) ClearCall() {
+	m.clearedcall = true
+	m.clearedFields[callparticipant.FieldCallID] = struct{}{}
+}
+
+// CallCleared reports if the "call" edge to the CallSession entity was cleared.
+func (m *CallParticipantMutation) CallCleared() bool {
+	return m.clearedcall
+}
+
+// CallIDs returns the "call" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CallID instead. It exists only for internal usage by the builders.
+func (m *CallParticipantMutation) CallIDs() (ids []int) {
+	if id := m.call; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCall resets all changes to the "call" edge.
+func (m *CallParticipantMutation) ResetCall() {
+	m.call = nil
+	m.clearedcall = false
+}
+
+// Where appends a list predicates to the CallParticipantMutation builder.
+func (m *CallParticipantMutation) Where(ps ...predicate.CallParticipant) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CallParticipantMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CallParticipantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CallParticipant, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CallParticipantMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CallParticipantMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CallParticipant).
+func (m *CallParticipantMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CallParticipantMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.call != nil {
+		fields = append(fields, callparticipant.FieldCallID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, callparticipant.FieldUserID)
+	}
+	if m.username != nil {
+		fields = append(fields, callparticipant.FieldUsername)
+	}
+	if m.joined_at != nil {
+		fields = append(fields, callparticipant.FieldJoinedAt)
+	}
+	if m.left_at != nil {
+		fields = append(fields, callparticipant.FieldLeftAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CallParticipantMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case callparticipant.FieldCallID:
+		return m.CallID()
+	case callparticipant.FieldUserID:
+		return m.UserID()
+	case callparticipant.FieldUsername:
+		return m.Username()
+	case callparticipant.FieldJoinedAt:
+		return m.JoinedAt()
+	case callparticipant.FieldLeftAt:
+		return m.LeftAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CallParticipantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case callparticipant.FieldCallID:
+		return m.OldCallID(ctx)
+	case callparticipant.FieldUserID:
+		return m.OldUserID(ctx)
+	case callparticipant.FieldUsername:
+		return m.OldUsername(ctx)
+	case callparticipant.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	case callparticipant.FieldLeftAt:
+		return m.OldLeftAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CallParticipant field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CallParticipantMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case callparticipant.FieldCallID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCallID(v)
+		return nil
+	case callparticipant.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case callparticipant.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case callparticipant.FieldJoinedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinedAt(v)
+		return nil
+	case callparticipant.FieldLeftAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeftAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CallParticipant field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CallParticipantMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CallParticipantMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CallParticipantMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CallParticipant numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CallParticipantMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(callparticipant.FieldLeftAt) {
+		fields = append(fields, callparticipant.FieldLeftAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CallParticipantMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CallParticipantMutation) ClearField(name string) error {
+	switch name {
+	case callparticipant.FieldLeftAt:
+		m.ClearLeftAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CallParticipant nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CallParticipantMutation) ResetField(name string) error {
+	switch name {
+	case callparticipant.FieldCallID:
+		m.ResetCallID()
+		return nil
+	case callparticipant.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case callparticipant.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case callparticipant.FieldJoinedAt:
+		m.ResetJoinedAt()
+		return nil
+	case callparticipant.FieldLeftAt:
+		m.ResetLeftAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CallParticipant field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CallParticipantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.call != nil {
+		edges = append(edges, callparticipant.EdgeCall)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CallParticipantMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case callparticipant.EdgeCall:
+		if id := m.call; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CallParticipantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CallParticipantMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CallParticipantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcall {
+		edges = append(edges, callparticipant.EdgeCall)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CallParticipantMutation) EdgeCleared(name string) bool {
+	switch name {
+	case callparticipant.EdgeCall:
+		return m.clearedcall
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CallParticipantMutation) ClearEdge(name string) error {
+	switch name {
+	case callparticipant.EdgeCall:
+		m.ClearCall()
+		return nil
+	}
+	return fmt.Errorf("unknown CallParticipant unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CallParticipantMutation) ResetEdge(name string) error {
+	switch name {
+	case callparticipant.EdgeCall:
+		m.ResetCall()
+		return nil
+	}
+	return fmt.Errorf("unknown CallParticipant edge %s", name)
+}
+
+// CallSessionMutation represents an operation that mutates the CallSession nodes in the graph.
+type CallSessionMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	room_id             *string
+	started_by          *string
+	started_by_name     *string
+	started_at          *time.Time
+	ended_at            *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	participants        map[int]struct{}
+	removedparticipants map[int]struct{}
+	clearedparticipants bool
+	done                bool
+	oldValue            func(context.Context) (*CallSession, error)
+	predicates          []predicate.CallSession
+}
+
+var _ ent.Mutation = (*CallSessionMutation)(nil)
+
+// callsessionOption allows management of the mutation configuration using functional options.
+type callsessionOption func(*CallSessionMutation)
+
+// newCallSessionMutation creates new mutation for the CallSession entity.
+func newCallSessionMutation(c config, op Op, opts ...callsessionOption) *CallSessionMutation {
+	m := &CallSessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCallSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCallSessionID sets the ID field of the mutation.
+func withCallSessionID(id int) callsessionOption {
+	return func(m *CallSessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CallSession
+		)
+		m.oldValue = func(ctx context.Context) (*CallSession, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CallSession.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCallSession sets the old CallSession of the mutation.
+func withCallSession(node *CallSession) callsessionOption {
+	return func(m *CallSessionMutation) {
+		m.oldValue = func(context.Context) (*CallSession, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CallSessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CallSessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CallSessionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CallSessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CallSession.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *CallSessionMutation) SetRoomID(s string) {
+	m.room_id = &s
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *CallSessionMutation) RoomID() (r string, exists bool) {
+	v := m.room_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the CallSession entity.
+// If the CallSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallSessionMutation) OldRoomID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *CallSessionMutation) ResetRoomID() {
+	m.room_id = nil
+}
+
+// SetStartedBy sets the "started_by" field.
+func (m *CallSessionMutation) SetStartedBy(s string) {
+	m.started_by = &s
+}
+
+// StartedBy returns the value of the "started_by" field in the mutation.
+func (m *CallSessionMutation) StartedBy() (r string, exists bool) {
+	v := m.started_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedBy returns the old "started_by" field's value of the CallSession entity.
+// If the CallSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallSessionMutation) OldStartedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedBy: %w", err)
+	}
+	return oldValue.StartedBy, nil
+}
+
+// ResetStartedBy resets all changes to the "started_by" field.
+func (m *CallSessionMutation) ResetStartedBy() {
+	m.started_by = nil
+}
+
+// SetStartedByName sets the "started_by_name" field.
+func (m *CallSessionMutation) SetStartedByName(s string) {
+	m.started_by_name = &s
+}
+
+// StartedByName returns the value of the "started_by_name" field in the mutation.
+func (m *CallSessionMutation) StartedByName() (r string, exists bool) {
+	v := m.started_by_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedByName returns the old "started_by_name" field's value of the CallSession entity.
+// If the CallSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallSessionMutation) OldStartedByName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedByName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedByName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedByName: %w", err)
+	}
+	return oldValue.StartedByName, nil
+}
+
+// ResetStartedByName resets all changes to the "started_by_name" field.
+func (m *CallSessionMutation) ResetStartedByName() {
+	m.started_by_name = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *CallSessionMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *CallSessionMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the CallSession entity.
+// If the CallSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallSessionMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *CallSessionMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (m *CallSessionMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
+}
+
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *CallSessionMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndedAt returns the old "ended_at" field's value of the CallSession entity.
+// If the CallSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallSessionMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+	}
+	return oldValue.EndedAt, nil
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *CallSessionMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[callsession.FieldEndedAt] = struct{}{}
+}
+
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *CallSessionMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[callsession.FieldEndedAt]
+	return ok
+}
+
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *CallSessionMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, callsession.FieldEndedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CallSessionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CallSessionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the CallSession entity.
+// If the CallSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallSessionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CallSessionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddParticipantIDs adds the "participants" edge to the CallParticipant entity by ids.
+func (m *CallSessionMutation) AddParticipantIDs(ids ...int) {
+	if m.participants == nil {
+		m.participants = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.participants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearParticipants clears the "participants" edge to the CallParticipant entity.
+func (m *CallSessionMutation) ClearParticipants() {
+	m.clearedparticipants = true
+}
+
+// ParticipantsCleared reports if the "participants" edge to the CallParticipant entity was cleared.
+func (m *CallSessionMutation) ParticipantsCleared() bool {
+	return m.clearedparticipants
+}
+
+// RemoveParticipantIDs removes the "participants" edge to the CallParticipant entity by IDs.
+func (m *CallSessionMutation) RemoveParticipantIDs(ids ...int) {
+	if m.removedparticipants == nil {
+		m.removedparticipants = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.participants, ids[i])
+		m.removedparticipants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedParticipants returns the removed IDs of the "participants" edge to the CallParticipant entity.
+func (m *CallSessionMutation) RemovedParticipantsIDs() (ids []int) {
+	for id := range m.removedparticipants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ParticipantsIDs returns the "participants" edge IDs in the mutation.
+func (m *CallSessionMutation) ParticipantsIDs() (ids []int) {
+	for id := range m.participants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetParticipants resets all changes to the "participants" edge.
+func (m *CallSessionMutation) ResetParticipants() {
+	m.participants = nil
+	m.clearedparticipants = false
+	m.removedparticipants = nil
+}
+
+// Where appends a list predicates to the CallSessionMutation builder.
+func (m *CallSessionMutation) Where(ps ...predicate.CallSession) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CallSessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CallSessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CallSession, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CallSessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CallSessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CallSession).
+func (m *CallSessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CallSessionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.room_id != nil {
+		fields = append(fields, callsession.FieldRoomID)
+	}
+	if m.started_by != nil {
+		fields = append(fields, callsession.FieldStartedBy)
+	}
+	if m.started_by_name != nil {
+		fields = append(fields, callsession.FieldStartedByName)
+	}
+	if m.started_at != nil {
+		fields = append(fields, callsession.FieldStartedAt)
+	}
+	if m.ended_at != nil {
+		fields = append(fields, callsession.FieldEndedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, callsession.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CallSessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case callsession.FieldRoomID:
+		return m.RoomID()
+	case callsession.FieldStartedBy:
+		return m.StartedBy()
+	case callsession.FieldStartedByName:
+		return m.StartedByName()
+	case callsession.FieldStartedAt:
+		return m.StartedAt()
+	case callsession.FieldEndedAt:
+		return m.EndedAt()
+	case callsession.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CallSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case callsession.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case callsession.FieldStartedBy:
+		return m.OldStartedBy(ctx)
+	case callsession.FieldStartedByName:
+		return m.OldStartedByName(ctx)
+	case callsession.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case callsession.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	case callsession.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CallSession field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CallSessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case callsession.FieldRoomID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case callsession.FieldStartedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedBy(v)
+		return nil
+	case callsession.FieldStartedByName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedByName(v)
+		return nil
+	case callsession.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case callsession.FieldEndedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedAt(v)
+		return nil
+	case callsession.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CallSession field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CallSessionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CallSessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CallSessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CallSession numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CallSessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(callsession.FieldEndedAt) {
+		fields = append(fields, callsession.FieldEndedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CallSessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CallSessionMutation) ClearField(name string) error {
+	switch name {
+	case callsession.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CallSession nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CallSessionMutation) ResetField(name string) error {
+	switch name {
+	case callsession.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case callsession.FieldStartedBy:
+		m.ResetStartedBy()
+		return nil
+	case callsession.FieldStartedByName:
+		m.ResetStartedByName()
+		return nil
+	case callsession.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case callsession.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	case callsession.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CallSession field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CallSessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.participants != nil {
+		edges = append(edges, callsession.EdgeParticipants)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CallSessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case callsession.EdgeParticipants:
+		ids := make([]ent.Value, 0, len(m.participants))
+		for id := range m.participants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CallSessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedparticipants != nil {
+		edges = append(edges, callsession.EdgeParticipants)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CallSessionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case callsession.EdgeParticipants:
+		ids := make([]ent.Value, 0, len(m.removedparticipants))
+		for id := range m.removedparticipants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CallSessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedparticipants {
+		edges = append(edges, callsession.EdgeParticipants)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CallSessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case callsession.EdgeParticipants:
+		return m.clearedparticipants
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CallSessionMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CallSession unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CallSessionMutation) ResetEdge(name string) error {
+	switch name {
+	case callsession.EdgeParticipants:
+		m.ResetParticipants()
+		return nil
+	}
+	return fmt.Errorf("unknown CallSession edge %s", name)
+}
+
+// HistoryImportMutation represents an operation that mutates the HistoryImport nodes in the graph.
+type HistoryImportMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	format                *historyimport.Format
+	file_path             *string
+	user_id               *string
+	status                *historyimport.Status
+	rooms_created         *int
+	addrooms_created      *int
+	messages_imported     *int
+	addmessages_imported  *int
+	messages_skipped      *int
+	addmessages_skipped   *int
+	completed_rooms       *[]string
+	appendcompleted_rooms []string
+	unmapped_users        *[]schema.UnmappedUser
+	appendunmapped_users  []schema.UnmappedUser
+	error                 *string
+	created_at            *time.Time
+	completed_at          *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*HistoryImport, error)
+	predicates            []predicate.HistoryImport
+}
+
+var _ ent.Mutation = (*HistoryImportMutation)(nil)
+
+// historyimportOption allows management of the mutation configuration using functional options.
+type historyimportOption func(*HistoryImportMutation)
+
+// newHistoryImportMutation creates new mutation for the HistoryImport entity.
+func newHistoryImportMutation(c config, op Op, opts ...historyimportOption) *HistoryImportMutation {
+	m := &HistoryImportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHistoryImport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHistoryImportID sets the ID field of the mutation.
+func withHistoryImportID(id int) historyimportOption {
+	return func(m *HistoryImportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HistoryImport
+		)
+		m.oldValue = func(ctx context.Context) (*HistoryImport, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HistoryImport.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHistoryImport sets the old HistoryImport of the mutation.
+func withHistoryImport(node *HistoryImport) historyimportOption {
+	return func(m *HistoryImportMutation) {
+		m.oldValue = func(context.Context) (*HistoryImport, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HistoryImportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HistoryImportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HistoryImportMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HistoryImportMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HistoryImport.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFormat sets the "format" field.
+func (m *HistoryImportMutation) SetFormat(h historyimport.Format) {
+	m.format = &h
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *HistoryImportMutation) Format() (r historyimport.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the HistoryImport entity.
+// If the HistoryImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryImportMutation) OldFormat(ctx context.Context) (v historyimport.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *HistoryImportMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetFilePath sets the "file_path" field.
+func (m *HistoryImportMutation) SetFilePath(s string) {
+	m.file_path = &s
+}
+
+// FilePath returns the value of the "file_path" field in the mutation.
+func (m *HistoryImportMutation) FilePath() (r string, exists bool) {
+	v := m.file_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilePath returns the old "file_path" field's value of the HistoryImport entity.
+// If the HistoryImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryImportMutation) OldFilePath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilePath: %w", err)
+	}
+	return oldValue.FilePath, nil
+}
+
+// ResetFilePath resets all changes to the "file_path" field.
+func (m *HistoryImportMutation) ResetFilePath() {
+	m.file_path = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *HistoryImportMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *HistoryImportMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the HistoryImport entity.
+// If the HistoryImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryImportMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *HistoryImportMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[historyimport.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *HistoryImportMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[historyimport.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *HistoryImportMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, historyimport.FieldUserID)
+}
+
+// SetStatus sets the "status" field.
+func (m *HistoryImportMutation) SetStatus(h historyimport.Status) {
+	m.status = &h
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *HistoryImportMutation) Status() (r historyimport.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the HistoryImport entity.
+// If the HistoryImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryImportMutation) OldStatus(ctx context.Context) (v historyimport.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *HistoryImportMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRoomsCreated sets the "rooms_created" field.
+func (m *HistoryImportMutation) SetRoomsCreated(i int) {
+	m.rooms_created = &i
+	m.addrooms_created = nil
+}
+
+// RoomsCreated returns the value of the "rooms_created" field in the mutation.
+func (m *HistoryImportMutation) RoomsCreated() (r int, exists bool) {
+	v := m.rooms_created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomsCreated returns the old "rooms_created" field's value of the HistoryImport entity.
+// If the HistoryImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryImportMutation) OldRoomsCreated(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomsCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomsCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomsCreated: %w", err)
+	}
+	return oldValue.RoomsCreated, nil
+}
+
+// AddRoomsCreated adds i to the "rooms_created" field.
+func (m *HistoryImportMutation) AddRoomsCreated(i int) {
+	if m.addrooms_created != nil {
+		*m.addrooms_created += i
+	} else {
+		m.addrooms_created = &i
+	}
+}
+
+// AddedRoomsCreated returns the value that was added to the "rooms_created" field in this mutation.
+func (m *HistoryImportMutation) AddedRoomsCreated() (r int, exists bool) {
+	v := m.addrooms_created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRoomsCreated resets all changes to the "rooms_created" field.
+func (m *HistoryImportMutation) ResetRoomsCreated() {
+	m.rooms_created = nil
+	m.addrooms_created = nil
+}
+
+// SetMessagesImported sets the "messages_imported" field.
+func (m *HistoryImportMutation) SetMessagesImported(i int) {
+	m.messages_imported = &i
+	m.addmessages_imported = nil
+}
+
+// MessagesImported returns the value of the "messages_imported" field in the mutation.
+func (m *HistoryImportMutation) MessagesImported() (r int, exists bool) {
+	v := m.messages_imported
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessagesImported returns the old "messages_imported" field's value of the HistoryImport entity.
+// If the HistoryImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryImportMutation) OldMessagesImported(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessagesImported is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessagesImported requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessagesImported: %w", err)
+	}
+	return oldValue.MessagesImported, nil
+}
+
+// AddMessagesImported adds i to the "messages_imported" field.
+func (m *HistoryImportMutation) AddMessagesImported(i int) {
+	if m.addmessages_imported != nil {
+		*m.addmessages_imported += i
+	} else {
+		m.addmessages_imported = &i
+	}
+}
+
+// AddedMessagesImported returns the value that was added to the "messages_imported" field in this mutation.
+func (m *HistoryImportMutation) AddedMessagesImported() (r int, exists bool) {
+	v := m.addmessages_imported
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessagesImported resets all changes to the "messages_imported" field.
+func (m *HistoryImportMutation) ResetMessagesImported() {
+	m.messages_imported = nil
+	m.addmessages_imported = nil
+}
+
+// SetMessagesSkipped sets the "messages_skipped" field.
+func (m *HistoryImportMutation) SetMessagesSkipped(i int) {
+	m.messages_skipped = &i
+	m.addmessages_skipped = nil
+}
+
+// MessagesSkipped returns the value of the "messages_skipped" field in the mutation.
+func (m *HistoryImportMutation) MessagesSkipped() (r int, exists bool) {
+	v := m.messages_skipped
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessagesSkipped returns the old "messages_skipped" field's value of the HistoryImport entity.
+// If the HistoryImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryImportMutation) OldMessagesSkipped(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessagesSkipped is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessagesSkipped requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessagesSkipped: %w", err)
+	}
+	return oldValue.MessagesSkipped, nil
+}
+
+// AddMessagesSkipped adds i to the "messages_skipped" field.
+func (m *HistoryImportMutation) AddMessagesSkipped(i int) {
+	if m.addmessages_skipped != nil {
+		*m.addmessages_skipped += i
+	} else {
+		m.addmessages_skipped = &i
+	}
+}
+
+// AddedMessagesSkipped returns the value that was added to the "messages_skipped" field in this mutation.
+func (m *HistoryImportMutation) AddedMessagesSkipped() (r int, exists bool) {
+	v := m.addmessages_skipped
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessagesSkipped resets all changes to the "messages_skipped" field.
+func (m *HistoryImportMutation) ResetMessagesSkipped() {
+	m.messages_skipped = nil
+	m.addmessages_skipped = nil
+}
+
+// SetCompletedRooms sets the "completed_rooms" field.
+func (m *HistoryImportMutation) SetCompletedRooms(s []string) {
+	m.completed_rooms = &s
+	m.appendcompleted_rooms = nil
+}
+
+// CompletedRooms returns the value of the "completed_rooms" field in the mutation.
+func (m *HistoryImportMutation) CompletedRooms() (r []string, exists bool) {
+	v := m.completed_rooms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedRooms returns the old "completed_rooms" field's value of the HistoryImport entity.
+// If the HistoryImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryImportMutation) OldCompletedRooms(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedRooms is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedRooms requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedRooms: %w", err)
+	}
+	return oldValue.CompletedRooms, nil
+}
+
+// AppendCompletedRooms adds s to the "completed_rooms" field.
+func (m *HistoryImportMutation) AppendCompletedRooms(s []string) {
+	m.appendcompleted_rooms = append(m.appendcompleted_rooms, s...)
+}
+
+// AppendedCompletedRooms returns the list of values that were appended to the "completed_rooms" field in this mutation.
+func (m *HistoryImportMutation) AppendedCompletedRooms() ([]string, bool) {
+	if len(m.appendcompleted_rooms) == 0 {
+		return nil, false
+	}
+	return m.appendcompleted_rooms, true
+}
+
+// ClearCompletedRooms clears the value of the "completed_rooms" field.
+func (m *HistoryImportMutation) ClearCompletedRooms() {
+	m.completed_rooms = nil
+	m.appendcompleted_rooms = nil
+	m.clearedFields[historyimport.FieldCompletedRooms] = struct{}{}
+}
+
+// CompletedRoomsCleared returns if the "completed_rooms" field was cleared in this mutation.
+func (m *HistoryImportMutation) CompletedRoomsCleared() bool {
+	_, ok := m.clearedFields[historyimport.FieldCompletedRooms]
+	return ok
+}
+
+// ResetCompletedRooms resets all changes to the "completed_rooms" field.
+func (m *HistoryImportMutation) ResetCompletedRooms() {
+	m.completed_rooms = nil
+	m.appendcompleted_rooms = nil
+	delete(m.clearedFields, historyimport.FieldCompletedRooms)
+}
+
+// SetUnmappedUsers sets the "unmapped_users" field.
+func (m *HistoryImportMutation) SetUnmappedUsers(su []schema.UnmappedUser) {
+	m.unmapped_users = &su
+	m.appendunmapped_users = nil
+}
+
+// UnmappedUsers returns the value of the "unmapped_users" field in the mutation.
+func (m *HistoryImportMutation) UnmappedUsers() (r []schema.UnmappedUser, exists bool) {
+	v := m.unmapped_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnmappedUsers returns the old "unmapped_users" field's value of the HistoryImport entity.
+// If the HistoryImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryImportMutation) OldUnmappedUsers(ctx context.Context) (v []schema.UnmappedUser, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnmappedUsers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnmappedUsers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnmappedUsers: %w", err)
+	}
+	return oldValue.UnmappedUsers, nil
+}
+
+// AppendUnmappedUsers adds su to the "unmapped_users" field.
+func (m *HistoryImportMutation) AppendUnmappedUsers(su []schema.UnmappedUser) {
+	m.appendunmapped_users = append(m.appendunmapped_users, su...)
+}
+
+// AppendedUnmappedUsers returns the list of values that were appended to the "unmapped_users" field in this mutation.
+func (m *HistoryImportMutation) AppendedUnmappedUsers() ([]schema.UnmappedUser, bool) {
+	if len(m.appendunmapped_users) == 0 {
+		return nil, false
+	}
+	return m.appendunmapped_users, true
+}
+
+// ClearUnmappedUsers clears the value of the "unmapped_users" field.
+func (m *HistoryImportMutation) ClearUnmappedUsers() {
+	m.unmapped_users = nil
+	m.appendunmapped_users = nil
+	m.clearedFields[historyimport.FieldUnmappedUsers] = struct{}{}
+}
+
+// UnmappedUsersCleared returns if the "unmapped_users" field was cleared in this mutation.
+func (m *HistoryImportMutation) UnmappedUsersCleared() bool {
+	_, ok := m.clearedFields[historyimport.FieldUnmappedUsers]
+	return ok
+}
+
+// ResetUnmappedUsers resets all changes to the "unmapped_users" field.
+func (m *HistoryImportMutation) ResetUnmappedUsers() {
+	m.unmapped_users = nil
+	m.appendunmapped_users = nil
+	delete(m.clearedFields, historyimport.FieldUnmappedUsers)
+}
+
+// SetError sets the "error" field.
+func (m *HistoryImportMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *HistoryImportMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the HistoryImport entity.
+// If the HistoryImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryImportMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *HistoryImportMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[historyimport.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *HistoryImportMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[historyimport.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *HistoryImportMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, historyimport.FieldError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *HistoryImportMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HistoryImportMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the HistoryImport entity.
+// If the HistoryImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryImportMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HistoryImportMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *HistoryImportMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *HistoryImportMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the HistoryImport entity.
+// If the HistoryImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryImportMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *HistoryImportMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[historyimport.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *HistoryImportMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[historyimport.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *HistoryImportMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, historyimport.FieldCompletedAt)
+}
+
+// Where appends a list predicates to the HistoryImportMutation builder.
+func (m *HistoryImportMutation) Where(ps ...predicate.HistoryImport) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HistoryImportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HistoryImportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HistoryImport, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HistoryImportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HistoryImportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HistoryImport).
+func (m *HistoryImportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HistoryImportMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.format != nil {
+		fields = append(fields, historyimport.FieldFormat)
+	}
+	if m.file_path != nil {
+		fields = append(fields, historyimport.FieldFilePath)
+	}
+	if m.user_id != nil {
+		fields = append(fields, historyimport.FieldUserID)
+	}
+	if m.status != nil {
+		fields = append(fields, historyimport.FieldStatus)
+	}
+	if m.rooms_created != nil {
+		fields = append(fields, historyimport.FieldRoomsCreated)
+	}
+	if m.messages_imported != nil {
+		fields = append(fields, historyimport.FieldMessagesImported)
+	}
+	if m.messages_skipped != nil {
+		fields = append(fields, historyimport.FieldMessagesSkipped)
+	}
+	if m.completed_rooms != nil {
+		fields = append(fields, historyimport.FieldCompletedRooms)
+	}
+	if m.unmapped_users != nil {
+		fields = append(fields, historyimport.FieldUnmappedUsers)
+	}
+	if m.error != nil {
+		fields = append(fields, historyimport.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, historyimport.FieldCreatedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, historyimport.FieldCompletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HistoryImportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case historyimport.FieldFormat:
+		return m.Format()
+	case historyimport.FieldFilePath:
+		return m.FilePath()
+	case historyimport.FieldUserID:
+		return m.UserID()
+	case historyimport.FieldStatus:
+		return m.Status()
+	case historyimport.FieldRoomsCreated:
+		return m.RoomsCreated()
+	case historyimport.FieldMessagesImported:
+		return m.MessagesImported()
+	case historyimport.FieldMessagesSkipped:
+		return m.MessagesSkipped()
+	case historyimport.FieldCompletedRooms:
+		return m.CompletedRooms()
+	case historyimport.FieldUnmappedUsers:
+		return m.UnmappedUsers()
+	case historyimport.FieldError:
+		return m.Error()
+	case historyimport.FieldCreatedAt:
+		return m.CreatedAt()
+	case historyimport.FieldCompletedAt:
+		return m.CompletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HistoryImportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case historyimport.FieldFormat:
+		return m.OldFormat(ctx)
+	case historyimport.FieldFilePath:
+		return m.OldFilePath(ctx)
+	case historyimport.FieldUserID:
+		return m.OldUserID(ctx)
+	case historyimport.FieldStatus:
+		return m.OldStatus(ctx)
+	case historyimport.FieldRoomsCreated:
+		return m.OldRoomsCreated(ctx)
+	case historyimport.FieldMessagesImported:
+		return m.OldMessagesImported(ctx)
+	case historyimport.FieldMessagesSkipped:
+		return m.OldMessagesSkipped(ctx)
+	case historyimport.FieldCompletedRooms:
+		return m.OldCompletedRooms(ctx)
+	case historyimport.FieldUnmappedUsers:
+		return m.OldUnmappedUsers(ctx)
+	case historyimport.FieldError:
+		return m.OldError(ctx)
+	case historyimport.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case historyimport.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown HistoryImport field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HistoryImportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case historyimport.FieldFormat:
+		v, ok := value.(historyimport.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case historyimport.FieldFilePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilePath(v)
+		return nil
+	case historyimport.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case historyimport.FieldStatus:
+		v, ok := value.(historyimport.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case historyimport.FieldRoomsCreated:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomsCreated(v)
+		return nil
+	case historyimport.FieldMessagesImported:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessagesImported(v)
+		return nil
+	case historyimport.FieldMessagesSkipped:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessagesSkipped(v)
+		return nil
+	case historyimport.FieldCompletedRooms:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedRooms(v)
+		return nil
+	case historyimport.FieldUnmappedUsers:
+		v, ok := value.([]schema.UnmappedUser)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnmappedUsers(v)
+		return nil
+	case historyimport.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case historyimport.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case historyimport.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HistoryImport field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HistoryImportMutation) AddedFields() []string {
+	var fields []string
+	if m.addrooms_created != nil {
+		fields = append(fields, historyimport.FieldRoomsCreated)
+	}
+	if m.addmessages_imported != nil {
+		fields = append(fields, historyimport.FieldMessagesImported)
+	}
+	if m.addmessages_skipped != nil {
+		fields = append(fields, historyimport.FieldMessagesSkipped)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HistoryImportMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case historyimport.FieldRoomsCreated:
+		return m.AddedRoomsCreated()
+	case historyimport.FieldMessagesImported:
+		return m.AddedMessagesImported()
+	case historyimport.FieldMessagesSkipped:
+		return m.AddedMessagesSkipped()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HistoryImportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case historyimport.FieldRoomsCreated:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRoomsCreated(v)
+		return nil
+	case historyimport.FieldMessagesImported:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessagesImported(v)
+		return nil
+	case historyimport.FieldMessagesSkipped:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessagesSkipped(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HistoryImport numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HistoryImportMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(historyimport.FieldUserID) {
+		fields = append(fields, historyimport.FieldUserID)
+	}
+	if m.FieldCleared(historyimport.FieldCompletedRooms) {
+		fields = append(fields, historyimport.FieldCompletedRooms)
+	}
+	if m.FieldCleared(historyimport.FieldUnmappedUsers) {
+		fields = append(fields, historyimport.FieldUnmappedUsers)
+	}
+	if m.FieldCleared(historyimport.FieldError) {
+		fields = append(fields, historyimport.FieldError)
+	}
+	if m.FieldCleared(historyimport.FieldCompletedAt) {
+		fields = append(fields, historyimport.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HistoryImportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HistoryImportMutation) ClearField(name string) error {
+	switch name {
+	case historyimport.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case historyimport.FieldCompletedRooms:
+		m.ClearCompletedRooms()
+		return nil
+	case historyimport.FieldUnmappedUsers:
+		m.ClearUnmappedUsers()
+		return nil
+	case historyimport.FieldError:
+		m.ClearError()
+		return nil
+	case historyimport.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown HistoryImport nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HistoryImportMutation) ResetField(name string) error {
+	switch name {
+	case historyimport.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case historyimport.FieldFilePath:
+		m.ResetFilePath()
+		return nil
+	case historyimport.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case historyimport.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case historyimport.FieldRoomsCreated:
+		m.ResetRoomsCreated()
+		return nil
+	case historyimport.FieldMessagesImported:
+		m.ResetMessagesImported()
+		return nil
+	case historyimport.FieldMessagesSkipped:
+		m.ResetMessagesSkipped()
+		return nil
+	case historyimport.FieldCompletedRooms:
+		m.ResetCompletedRooms()
+		return nil
+	case historyimport.FieldUnmappedUsers:
+		m.ResetUnmappedUsers()
+		return nil
+	case historyimport.FieldError:
+		m.ResetError()
+		return nil
+	case historyimport.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case historyimport.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown HistoryImport field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HistoryImportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HistoryImportMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HistoryImportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HistoryImportMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HistoryImportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HistoryImportMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HistoryImportMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown HistoryImport unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HistoryImportMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown HistoryImport edge %s", name)
+}
+
 // MessageMutation represents an operation that mutates the Message nodes in the graph.
 type MessageMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	content           *string
+	room_id           *string
+	username          *string
+	bot               *bool
+	attachments       *[]schema.Attachment
+	appendattachments []schema.Attachment
+	created_at        *time.Time
+	archived_at       *time.Time
+	import_key        *string
+	clearedFields     map[string]struct{}
+	poll              *int
+	clearedpoll       bool
+	pins              map[int]struct{}
+	removedpins       map[int]struct{}
+	clearedpins       bool
+	saves             map[int]struct{}
+	removedsaves      map[int]struct{}
+	clearedsaves      bool
+	done              bool
+	oldValue          func(context.Context) (*Message, error)
+	predicates        []predicate.Message
+}
+
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id int) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetContent sets the "content" field.
+func (m *MessageMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *MessageMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *MessageMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *MessageMutation) SetRoomID(s string) {
+	m.room_id = &s
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *MessageMutation) RoomID() (r string, exists bool) {
+	v := m.room_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldRoomID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *MessageMutation) ResetRoomID() {
+	m.room_id = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *MessageMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *MessageMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *MessageMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetBot sets the "bot" field.
+func (m *MessageMutation) SetBot(b bool) {
+	m.bot = &b
+}
+
+// Bot returns the value of the "bot" field in the mutation.
+func (m *MessageMutation) Bot() (r bool, exists bool) {
+	v := m.bot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBot returns the old "bot" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldBot(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBot: %w", err)
+	}
+	return oldValue.Bot, nil
+}
+
+// ResetBot resets all changes to the "bot" field.
+func (m *MessageMutation) ResetBot() {
+	m.bot = nil
+}
+
+// SetAttachments sets the "attachments" field.
+func (m *MessageMutation) SetAttachments(s []schema.Attachment) {
+	m.attachments = &s
+	m.appendattachments = nil
+}
+
+// Attachments returns the value of the "attachments" field in the mutation.
+func (m *MessageMutation) Attachments() (r []schema.Attachment, exists bool) {
+	v := m.attachments
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttachments returns the old "attachments" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldAttachments(ctx context.Context) (v []schema.Attachment, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttachments is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttachments requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttachments: %w", err)
+	}
+	return oldValue.Attachments, nil
+}
+
+// AppendAttachments adds s to the "attachments" field.
+func (m *MessageMutation) AppendAttachments(s []schema.Attachment) {
+	m.appendattachments = append(m.appendattachments, s...)
+}
+
+// AppendedAttachments returns the list of values that were appended to the "attachments" field in this mutation.
+func (m *MessageMutation) AppendedAttachments() ([]schema.Attachment, bool) {
+	if len(m.appendattachments) == 0 {
+		return nil, false
+	}
+	return m.appendattachments, true
+}
+
+// ClearAttachments clears the value of the "attachments" field.
+func (m *MessageMutation) ClearAttachments() {
+	m.attachments = nil
+	m.appendattachments = nil
+	m.clearedFields[message.FieldAttachments] = struct{}{}
+}
+
+// AttachmentsCleared returns if the "attachments" field was cleared in this mutation.
+func (m *MessageMutation) AttachmentsCleared() bool {
+	_, ok := m.clearedFields[message.FieldAttachments]
+	return ok
+}
+
+// ResetAttachments resets all changes to the "attachments" field.
+func (m *MessageMutation) ResetAttachments() {
+	m.attachments = nil
+	m.appendattachments = nil
+	delete(m.clearedFields, message.FieldAttachments)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (m *MessageMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
+}
+
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *MessageMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchivedAt returns the old "archived_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldArchivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *MessageMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[message.FieldArchivedAt] = struct{}{}
+}
+
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *MessageMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldArchivedAt]
+	return ok
+}
+
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *MessageMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, message.FieldArchivedAt)
+}
+
+// SetImportKey sets the "import_key" field.
+func (m *MessageMutation) SetImportKey(s string) {
+	m.import_key = &s
+}
+
+// ImportKey returns the value of the "import_key" field in the mutation.
+func (m *MessageMutation) ImportKey() (r string, exists bool) {
+	v := m.import_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImportKey returns the old "import_key" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldImportKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImportKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImportKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImportKey: %w", err)
+	}
+	return oldValue.ImportKey, nil
+}
+
+// ClearImportKey clears the value of the "import_key" field.
+func (m *MessageMutation) ClearImportKey() {
+	m.import_key = nil
+	m.clearedFields[message.FieldImportKey] = struct{}{}
+}
+
+// ImportKeyCleared returns if the "import_key" field was cleared in this mutation.
+func (m *MessageMutation) ImportKeyCleared() bool {
+	_, ok := m.clearedFields[message.FieldImportKey]
+	return ok
+}
+
+// ResetImportKey resets all changes to the "import_key" field.
+func (m *MessageMutation) ResetImportKey() {
+	m.import_key = nil
+	delete(m.clearedFields, message.FieldImportKey)
+}
+
+// SetPollID sets the "poll" edge to the Poll entity by id.
+func (m *MessageMutation) SetPollID(id int) {
+	m.poll = &id
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (m *MessageMutation) ClearPoll() {
+	m.clearedpoll = true
+}
+
+// PollCleared reports if the "poll" edge to the Poll entity was cleared.
+func (m *MessageMutation) PollCleared() bool {
+	return m.clearedpoll
+}
+
+// PollID returns the "poll" edge ID in the mutation.
+func (m *MessageMutation) PollID() (id int, exists bool) {
+	if m.poll != nil {
+		return *m.poll, true
+	}
+	return
+}
+
+// PollIDs returns the "poll" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PollID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) PollIDs() (ids []int) {
+	if id := m.poll; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPoll resets all changes to the "poll" edge.
+func (m *MessageMutation) ResetPoll() {
+	m.poll = nil
+	m.clearedpoll = false
+}
+
+// AddPinIDs adds the "pins" edge to the PinnedMessage entity by ids.
+func (m *MessageMutation) AddPinIDs(ids ...int) {
+	if m.pins == nil {
+		m.pins = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.pins[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPins clears the "pins" edge to the PinnedMessage entity.
+func (m *MessageMutation) ClearPins() {
+	m.clearedpins = true
+}
+
+// PinsCleared reports if the "pins" edge to the PinnedMessage entity was cleared.
+func (m *MessageMutation) PinsCleared() bool {
+	return m.clearedpins
+}
+
+// RemovePinIDs removes the "pins" edge to the PinnedMessage entity by IDs.
+func (m *MessageMutation) RemovePinIDs(ids ...int) {
+	if m.removedpins == nil {
+		m.removedpins = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.pins, ids[i])
+		m.removedpins[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPins returns the removed IDs of the "pins" edge to the PinnedMessage entity.
+func (m *MessageMutation) RemovedPinsIDs() (ids []int) {
+	for id := range m.removedpins {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PinsIDs returns the "pins" edge IDs in the mutation.
+func (m *MessageMutation) PinsIDs() (ids []int) {
+	for id := range m.pins {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPins resets all changes to the "pins" edge.
+func (m *MessageMutation) ResetPins() {
+	m.pins = nil
+	m.clearedpins = false
+	m.removedpins = nil
+}
+
+// AddSafeIDs adds the "saves" edge to the SavedMessage entity by ids.
+func (m *MessageMutation) AddSafeIDs(ids ...int) {
+	if m.saves == nil {
+		m.saves = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.saves[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSaves clears the "saves" edge to the SavedMessage entity.
+func (m *MessageMutation) ClearSaves() {
+	m.clearedsaves = true
+}
+
+// SavesCleared reports if the "saves" edge to the SavedMessage entity was cleared.
+func (m *MessageMutation) SavesCleared() bool {
+	return m.clearedsaves
+}
+
+// RemoveSafeIDs removes the "saves" edge to the SavedMessage entity by IDs.
+func (m *MessageMutation) RemoveSafeIDs(ids ...int) {
+	if m.removedsaves == nil {
+		m.removedsaves = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.saves, ids[i])
+		m.removedsaves[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSaves returns the removed IDs of the "saves" edge to the SavedMessage entity.
+func (m *MessageMutation) RemovedSavesIDs() (ids []int) {
+	for id := range m.removedsaves {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavesIDs returns the "saves" edge IDs in the mutation.
+func (m *MessageMutation) SavesIDs() (ids []int) {
+	for id := range m.saves {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSaves resets all changes to the "saves" edge.
+func (m *MessageMutation) ResetSaves() {
+	m.saves = nil
+	m.clearedsaves = false
+	m.removedsaves = nil
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.content != nil {
+		fields = append(fields, message.FieldContent)
+	}
+	if m.room_id != nil {
+		fields = append(fields, message.FieldRoomID)
+	}
+	if m.username != nil {
+		fields = append(fields, message.FieldUsername)
+	}
+	if m.bot != nil {
+		fields = append(fields, message.FieldBot)
+	}
+	if m.attachments != nil {
+		fields = append(fields, message.FieldAttachments)
+	}
+	if m.created_at != nil {
+		fields = append(fields, message.FieldCreatedAt)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, message.FieldArchivedAt)
+	}
+	if m.import_key != nil {
+		fields = append(fields, message.FieldImportKey)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldContent:
+		return m.Content()
+	case message.FieldRoomID:
+		return m.RoomID()
+	case message.FieldUsername:
+		return m.Username()
+	case message.FieldBot:
+		return m.Bot()
+	case message.FieldAttachments:
+		return m.Attachments()
+	case message.FieldCreatedAt:
+		return m.CreatedAt()
+	case message.FieldArchivedAt:
+		return m.ArchivedAt()
+	case message.FieldImportKey:
+		return m.ImportKey()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldContent:
+		return m.OldContent(ctx)
+	case message.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case message.FieldUsername:
+		return m.OldUsername(ctx)
+	case message.FieldBot:
+		return m.OldBot(ctx)
+	case message.FieldAttachments:
+		return m.OldAttachments(ctx)
+	case message.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case message.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
+	case message.FieldImportKey:
+		return m.OldImportKey(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case message.FieldRoomID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case message.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case message.FieldBot:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBot(v)
+		return nil
+	case message.FieldAttachments:
+		v, ok := value.([]schema.Attachment)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachments(v)
+		return nil
+	case message.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case message.FieldArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchivedAt(v)
+		return nil
+	case message.FieldImportKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImportKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldAttachments) {
+		fields = append(fields, message.FieldAttachments)
+	}
+	if m.FieldCleared(message.FieldArchivedAt) {
+		fields = append(fields, message.FieldArchivedAt)
+	}
+	if m.FieldCleared(message.FieldImportKey) {
+		fields = append(fields, message.FieldImportKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldAttachments:
+		m.ClearAttachments()
+		return nil
+	case message.FieldArchivedAt:
+		m.ClearArchivedAt()
+		return nil
+	case message.FieldImportKey:
+		m.ClearImportKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldContent:
+		m.ResetContent()
+		return nil
+	case message.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case message.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case message.FieldBot:
+		m.ResetBot()
+		return nil
+	case message.FieldAttachments:
+		m.ResetAttachments()
+		return nil
+	case message.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case message.FieldArchivedAt:
+		m.ResetArchivedAt()
+		return nil
+	case message.FieldImportKey:
+		m.ResetImportKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.poll != nil {
+		edges = append(edges, message.EdgePoll)
+	}
+	if m.pins != nil {
+		edges = append(edges, message.EdgePins)
+	}
+	if m.saves != nil {
+		edges = append(edges, message.EdgeSaves)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgePoll:
+		if id := m.poll; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgePins:
+		ids := make([]ent.Value, 0, len(m.pins))
+		for id := range m.pins {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeSaves:
+		ids := make([]ent.Value, 0, len(m.saves))
+		for id := range m.saves {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedpins != nil {
+		edges = append(edges, message.EdgePins)
+	}
+	if m.removedsaves != nil {
+		edges = append(edges, message.EdgeSaves)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgePins:
+		ids := make([]ent.Value, 0, len(m.removedpins))
+		for id := range m.removedpins {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeSaves:
+		ids := make([]ent.Value, 0, len(m.removedsaves))
+		for id := range m.removedsaves {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedpoll {
+		edges = append(edges, message.EdgePoll)
+	}
+	if m.clearedpins {
+		edges = append(edges, message.EdgePins)
+	}
+	if m.clearedsaves {
+		edges = append(edges, message.EdgeSaves)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgePoll:
+		return m.clearedpoll
+	case message.EdgePins:
+		return m.clearedpins
+	case message.EdgeSaves:
+		return m.clearedsaves
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgePoll:
+		m.ClearPoll()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgePoll:
+		m.ResetPoll()
+		return nil
+	case message.EdgePins:
+		m.ResetPins()
+		return nil
+	case message.EdgeSaves:
+		m.ResetSaves()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// MessagePurgeMutation represents an operation that mutates the MessagePurge nodes in the graph.
+type MessagePurgeMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	room_id           *string
+	reason            *messagepurge.Reason
+	mode              *messagepurge.Mode
+	dry_run           *bool
+	message_count     *int
+	addmessage_count  *int
+	message_ids       *[]int
+	appendmessage_ids []int
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*MessagePurge, error)
+	predicates        []predicate.MessagePurge
+}
+
+var _ ent.Mutation = (*MessagePurgeMutation)(nil)
+
+// messagepurgeOption allows management of the mutation configuration using functional options.
+type messagepurgeOption func(*MessagePurgeMutation)
+
+// newMessagePurgeMutation creates new mutation for the MessagePurge entity.
+func newMessagePurgeMutation(c config, op Op, opts ...messagepurgeOption) *MessagePurgeMutation {
+	m := &MessagePurgeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessagePurge,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessagePurgeID sets the ID field of the mutation.
+func withMessagePurgeID(id int) messagepurgeOption {
+	return func(m *MessagePurgeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessagePurge
+		)
+		m.oldValue = func(ctx context.Context) (*MessagePurge, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessagePurge.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessagePurge sets the old MessagePurge of the mutation.
+func withMessagePurge(node *MessagePurge) messagepurgeOption {
+	return func(m *MessagePurgeMutation) {
+		m.oldValue = func(context.Context) (*MessagePurge, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessagePurgeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessagePurgeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessagePurgeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessagePurgeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessagePurge.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *MessagePurgeMutation) SetRoomID(s string) {
+	m.room_id = &s
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *MessagePurgeMutation) RoomID() (r string, exists bool) {
+	v := m.room_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the MessagePurge entity.
+// If the MessagePurge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessagePurgeMutation) OldRoomID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *MessagePurgeMutation) ResetRoomID() {
+	m.room_id = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *MessagePurgeMutation) SetReason(value messagepurge.Reason) {
+	m.reason = &value
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *MessagePurgeMutation) Reason() (r messagepurge.Reason, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the MessagePurge entity.
+// If the MessagePurge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessagePurgeMutation) OldReason(ctx context.Context) (v messagepurge.Reason, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *MessagePurgeMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetMode sets the "mode" field.
+func (m *MessagePurgeMutation) SetMode(value messagepurge.Mode) {
+	m.mode = &value
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *MessagePurgeMutation) Mode() (r messagepurge.Mode, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the MessagePurge entity.
+// If the MessagePurge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessagePurgeMutation) OldMode(ctx context.Context) (v messagepurge.Mode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *MessagePurgeMutation) ResetMode() {
+	m.mode = nil
+}
+
+// SetDryRun sets the "dry_run" field.
+func (m *MessagePurgeMutation) SetDryRun(b bool) {
+	m.dry_run = &b
+}
+
+// DryRun returns the value of the "dry_run" field in the mutation.
+func (m *MessagePurgeMutation) DryRun() (r bool, exists bool) {
+	v := m.dry_run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDryRun returns the old "dry_run" field's value of the MessagePurge entity.
+// If the MessagePurge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessagePurgeMutation) OldDryRun(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDryRun is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDryRun requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDryRun: %w", err)
+	}
+	return oldValue.DryRun, nil
+}
+
+// ResetDryRun resets all changes to the "dry_run" field.
+func (m *MessagePurgeMutation) ResetDryRun() {
+	m.dry_run = nil
+}
+
+// SetMessageCount sets the "message_count" field.
+func (m *MessagePurgeMutation) SetMessageCount(i int) {
+	m.message_count = &i
+	m.addmessage_count = nil
+}
+
+// MessageCount returns the value of the "message_count" field in the mutation.
+func (m *MessagePurgeMutation) MessageCount() (r int, exists bool) {
+	v := m.message_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageCount returns the old "message_count" field's value of the MessagePurge entity.
+// If the MessagePurge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessagePurgeMutation) OldMessageCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageCount: %w", err)
+	}
+	return oldValue.MessageCount, nil
+}
+
+// AddMessageCount adds i to the "message_count" field.
+func (m *MessagePurgeMutation) AddMessageCount(i int) {
+	if m.addmessage_count != nil {
+		*m.addmessage_count += i
+	} else {
+		m.addmessage_count = &i
+	}
+}
+
+// AddedMessageCount returns the value that was added to the "message_count" field in this mutation.
+func (m *MessagePurgeMutation) AddedMessageCount() (r int, exists bool) {
+	v := m.addmessage_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessageCount resets all changes to the "message_count" field.
+func (m *MessagePurgeMutation) ResetMessageCount() {
+	m.message_count = nil
+	m.addmessage_count = nil
+}
+
+// SetMessageIds sets the "message_ids" field.
+func (m *MessagePurgeMutation) SetMessageIds(i []int) {
+	m.message_ids = &i
+	m.appendmessage_ids = nil
+}
+
+// MessageIds returns the value of the "message_ids" field in the mutation.
+func (m *MessagePurgeMutation) MessageIds() (r []int, exists bool) {
+	v := m.message_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageIds returns the old "message_ids" field's value of the MessagePurge entity.
+// If the MessagePurge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessagePurgeMutation) OldMessageIds(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageIds: %w", err)
+	}
+	return oldValue.MessageIds, nil
+}
+
+// AppendMessageIds adds i to the "message_ids" field.
+func (m *MessagePurgeMutation) AppendMessageIds(i []int) {
+	m.appendmessage_ids = append(m.appendmessage_ids, i...)
+}
+
+// AppendedMessageIds returns the list of values that were appended to the "message_ids" field in this mutation.
+func (m *MessagePurgeMutation) AppendedMessageIds() ([]int, bool) {
+	if len(m.appendmessage_ids) == 0 {
+		return nil, false
+	}
+	return m.appendmessage_ids, true
+}
+
+// ResetMessageIds resets all changes to the "message_ids" field.
+func (m *MessagePurgeMutation) ResetMessageIds() {
+	m.message_ids = nil
+	m.appendmessage_ids = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessagePurgeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessagePurgeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessagePurge entity.
+// If the MessagePurge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessagePurgeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessagePurgeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the MessagePurgeMutation builder.
+func (m *MessagePurgeMutation) Where(ps ...predicate.MessagePurge) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessagePurgeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessagePurgeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessagePurge, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessagePurgeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessagePurgeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessagePurge).
+func (m *MessagePurgeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessagePurgeMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.room_id != nil {
+		fields = append(fields, messagepurge.FieldRoomID)
+	}
+	if m.reason != nil {
+		fields = append(fields, messagepurge.FieldReason)
+	}
+	if m.mode != nil {
+		fields = append(fields, messagepurge.FieldMode)
+	}
+	if m.dry_run != nil {
+		fields = append(fields, messagepurge.FieldDryRun)
+	}
+	if m.message_count != nil {
+		fields = append(fields, messagepurge.FieldMessageCount)
+	}
+	if m.message_ids != nil {
+		fields = append(fields, messagepurge.FieldMessageIds)
+	}
+	if m.created_at != nil {
+		fields = append(fields, messagepurge.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessagePurgeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagepurge.FieldRoomID:
+		return m.RoomID()
+	case messagepurge.FieldReason:
+		return m.Reason()
+	case messagepurge.FieldMode:
+		return m.Mode()
+	case messagepurge.FieldDryRun:
+		return m.DryRun()
+	case messagepurge.FieldMessageCount:
+		return m.MessageCount()
+	case messagepurge.FieldMessageIds:
+		return m.MessageIds()
+	case messagepurge.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessagePurgeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagepurge.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case messagepurge.FieldReason:
+		return m.OldReason(ctx)
+	case messagepurge.FieldMode:
+		return m.OldMode(ctx)
+	case messagepurge.FieldDryRun:
+		return m.OldDryRun(ctx)
+	case messagepurge.FieldMessageCount:
+		return m.OldMessageCount(ctx)
+	case messagepurge.FieldMessageIds:
+		return m.OldMessageIds(ctx)
+	case messagepurge.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessagePurge field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessagePurgeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagepurge.FieldRoomID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case messagepurge.FieldReason:
+		v, ok := value.(messagepurge.Reason)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case messagepurge.FieldMode:
+		v, ok := value.(messagepurge.Mode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case messagepurge.FieldDryRun:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDryRun(v)
+		return nil
+	case messagepurge.FieldMessageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageCount(v)
+		return nil
+	case messagepurge.FieldMessageIds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageIds(v)
+		return nil
+	case messagepurge.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessagePurge field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessagePurgeMutation) AddedFields() []string {
+	var fields []string
+	if m.addmessage_count != nil {
+		fields = append(fields, messagepurge.FieldMessageCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessagePurgeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messagepurge.FieldMessageCount:
+		return m.AddedMessageCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessagePurgeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messagepurge.FieldMessageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessagePurge numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessagePurgeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessagePurgeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessagePurgeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessagePurge nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessagePurgeMutation) ResetField(name string) error {
+	switch name {
+	case messagepurge.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case messagepurge.FieldReason:
+		m.ResetReason()
+		return nil
+	case messagepurge.FieldMode:
+		m.ResetMode()
+		return nil
+	case messagepurge.FieldDryRun:
+		m.ResetDryRun()
+		return nil
+	case messagepurge.FieldMessageCount:
+		m.ResetMessageCount()
+		return nil
+	case messagepurge.FieldMessageIds:
+		m.ResetMessageIds()
+		return nil
+	case messagepurge.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessagePurge field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessagePurgeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessagePurgeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessagePurgeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessagePurgeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessagePurgeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessagePurgeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessagePurgeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessagePurge unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessagePurgeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessagePurge edge %s", name)
+}
+
+// PinnedMessageMutation represents an operation that mutates the PinnedMessage nodes in the graph.
+type PinnedMessageMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	room_id        *string
+	pinned_by      *string
+	pinned_at      *time.Time
+	clearedFields  map[string]struct{}
+	message        *int
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*PinnedMessage, error)
+	predicates     []predicate.PinnedMessage
+}
+
+var _ ent.Mutation = (*PinnedMessageMutation)(nil)
+
+// pinnedmessageOption allows management of the mutation configuration using functional options.
+type pinnedmessageOption func(*PinnedMessageMutation)
+
+// newPinnedMessageMutation creates new mutation for the PinnedMessage entity.
+func newPinnedMessageMutation(c config, op Op, opts ...pinnedmessageOption) *PinnedMessageMutation {
+	m := &PinnedMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePinnedMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPinnedMessageID sets the ID field of the mutation.
+func withPinnedMessageID(id int) pinnedmessageOption {
+	return func(m *PinnedMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PinnedMessage
+		)
+		m.oldValue = func(ctx context.Context) (*PinnedMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PinnedMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPinnedMessage sets the old PinnedMessage of the mutation.
+func withPinnedMessage(node *PinnedMessage) pinnedmessageOption {
+	return func(m *PinnedMessageMutation) {
+		m.oldValue = func(context.Context) (*PinnedMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PinnedMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PinnedMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PinnedMessageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PinnedMessageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PinnedMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *PinnedMessageMutation) SetRoomID(s string) {
+	m.room_id = &s
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *PinnedMessageMutation) RoomID() (r string, exists bool) {
+	v := m.room_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the PinnedMessage entity.
+// If the PinnedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedMessageMutation) OldRoomID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *PinnedMessageMutation) ResetRoomID() {
+	m.room_id = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *PinnedMessageMutation) SetMessageID(i int) {
+	m.message = &i
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *PinnedMessageMutation) MessageID() (r int, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the PinnedMessage entity.
+// If the PinnedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedMessageMutation) OldMessageID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *PinnedMessageMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetPinnedBy sets the "pinned_by" field.
+func (m *PinnedMessageMutation) SetPinnedBy(s string) {
+	m.pinned_by = &s
+}
+
+// PinnedBy returns the value of the "pinned_by" field in the mutation.
+func (m *PinnedMessageMutation) PinnedBy() (r string, exists bool) {
+	v := m.pinned_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPinnedBy returns the old "pinned_by" field's value of the PinnedMessage entity.
+// If the PinnedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedMessageMutation) OldPinnedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinnedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinnedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinnedBy: %w", err)
+	}
+	return oldValue.PinnedBy, nil
+}
+
+// ResetPinnedBy resets all changes to the "pinned_by" field.
+func (m *PinnedMessageMutation) ResetPinnedBy() {
+	m.pinned_by = nil
+}
+
+// SetPinnedAt sets the "pinned_at" field.
+func (m *PinnedMessageMutation) SetPinnedAt(t time.Time) {
+	m.pinned_at = &t
+}
+
+// PinnedAt returns the value of the "pinned_at" field in the mutation.
+func (m *PinnedMessageMutation) PinnedAt() (r time.Time, exists bool) {
+	v := m.pinned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPinnedAt returns the old "pinned_at" field's value of the PinnedMessage entity.
+// If the PinnedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedMessageMutation) OldPinnedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinnedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinnedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinnedAt: %w", err)
+	}
+	return oldValue.PinnedAt, nil
+}
+
+// ResetPinnedAt resets all changes to the "pinned_at" field.
+func (m *PinnedMessageMutation) ResetPinnedAt() {
+	m.pinned_at = nil
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *PinnedMessageMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[pinnedmessage.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *PinnedMessageMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *PinnedMessageMutation) MessageIDs() (ids []int) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *PinnedMessageMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the PinnedMessageMutation builder.
+func (m *PinnedMessageMutation) Where(ps ...predicate.PinnedMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PinnedMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PinnedMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PinnedMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PinnedMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PinnedMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PinnedMessage).
+func (m *PinnedMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PinnedMessageMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.room_id != nil {
+		fields = append(fields, pinnedmessage.FieldRoomID)
+	}
+	if m.message != nil {
+		fields = append(fields, pinnedmessage.FieldMessageID)
+	}
+	if m.pinned_by != nil {
+		fields = append(fields, pinnedmessage.FieldPinnedBy)
+	}
+	if m.pinned_at != nil {
+		fields = append(fields, pinnedmessage.FieldPinnedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PinnedMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pinnedmessage.FieldRoomID:
+		return m.RoomID()
+	case pinnedmessage.FieldMessageID:
+		return m.MessageID()
+	case pinnedmessage.FieldPinnedBy:
+		return m.PinnedBy()
+	case pinnedmessage.FieldPinnedAt:
+		return m.PinnedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PinnedMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pinnedmessage.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case pinnedmessage.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case pinnedmessage.FieldPinnedBy:
+		return m.OldPinnedBy(ctx)
+	case pinnedmessage.FieldPinnedAt:
+		return m.OldPinnedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PinnedMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PinnedMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pinnedmessage.FieldRoomID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case pinnedmessage.FieldMessageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case pinnedmessage.FieldPinnedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinnedBy(v)
+		return nil
+	case pinnedmessage.FieldPinnedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinnedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PinnedMessageMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PinnedMessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PinnedMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PinnedMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PinnedMessageMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PinnedMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PinnedMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PinnedMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PinnedMessageMutation) ResetField(name string) error {
+	switch name {
+	case pinnedmessage.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case pinnedmessage.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case pinnedmessage.FieldPinnedBy:
+		m.ResetPinnedBy()
+		return nil
+	case pinnedmessage.FieldPinnedAt:
+		m.ResetPinnedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PinnedMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, pinnedmessage.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PinnedMessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pinnedmessage.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PinnedMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PinnedMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PinnedMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, pinnedmessage.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PinnedMessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pinnedmessage.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PinnedMessageMutation) ClearEdge(name string) error {
+	switch name {
+	case pinnedmessage.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PinnedMessageMutation) ResetEdge(name string) error {
+	switch name {
+	case pinnedmessage.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedMessage edge %s", name)
+}
+
+// PollMutation represents an operation that mutates the Poll nodes in the graph.
+type PollMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	room_id               *string
+	question              *string
+	options               *[]string
+	appendoptions         []string
+	multiple              *bool
+	anonymous             *bool
+	created_by            *string
+	closes_at             *time.Time
+	closed_at             *time.Time
+	summary_message_id    *int
+	addsummary_message_id *int
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	message               *int
+	clearedmessage        bool
+	votes                 map[int]struct{}
+	removedvotes          map[int]struct{}
+	clearedvotes          bool
+	done                  bool
+	oldValue              func(context.Context) (*Poll, error)
+	predicates            []predicate.Poll
+}
+
+var _ ent.Mutation = (*PollMutation)(nil)
+
+// pollOption allows management of the mutation configuration using functional options.
+type pollOption func(*PollMutation)
+
+// newPollMutation creates new mutation for the Poll entity.
+func newPollMutation(c config, op Op, opts ...pollOption) *PollMutation {
+	m := &PollMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePoll,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPollID sets the ID field of the mutation.
+func withPollID(id int) pollOption {
+	return func(m *PollMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Poll
+		)
+		m.oldValue = func(ctx context.Context) (*Poll, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Poll.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPoll sets the old Poll of the mutation.
+func withPoll(node *Poll) pollOption {
+	return func(m *PollMutation) {
+		m.oldValue = func(context.Context) (*Poll, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PollMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PollMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PollMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PollMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Poll.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *PollMutation) SetRoomID(s string) {
+	m.room_id = &s
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *PollMutation) RoomID() (r string, exists bool) {
+	v := m.room_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldRoomID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *PollMutation) ResetRoomID() {
+	m.room_id = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *PollMutation) SetMessageID(i int) {
+	m.message = &i
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *PollMutation) MessageID() (r int, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldMessageID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *PollMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetQuestion sets the "question" field.
+func (m *PollMutation) SetQuestion(s string) {
+	m.question = &s
+}
+
+// Question returns the value of the "question" field in the mutation.
+func (m *PollMutation) Question() (r string, exists bool) {
+	v := m.question
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestion returns the old "question" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldQuestion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestion: %w", err)
+	}
+	return oldValue.Question, nil
+}
+
+// ResetQuestion resets all changes to the "question" field.
+func (m *PollMutation) ResetQuestion() {
+	m.question = nil
+}
+
+// SetOptions sets the "options" field.
+func (m *PollMutation) SetOptions(s []string) {
+	m.options = &s
+	m.appendoptions = nil
+}
+
+// Options returns the value of the "options" field in the mutation.
+func (m *PollMutation) Options() (r []string, exists bool) {
+	v := m.options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptions returns the old "options" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldOptions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+	}
+	return oldValue.Options, nil
+}
+
+// AppendOptions adds s to the "options" field.
+func (m *PollMutation) AppendOptions(s []string) {
+	m.appendoptions = append(m.appendoptions, s...)
+}
+
+// AppendedOptions returns the list of values that were appended to the "options" field in this mutation.
+func (m *PollMutation) AppendedOptions() ([]string, bool) {
+	if len(m.appendoptions) == 0 {
+		return nil, false
+	}
+	return m.appendoptions, true
+}
+
+// ResetOptions resets all changes to the "options" field.
+func (m *PollMutation) ResetOptions() {
+	m.options = nil
+	m.appendoptions = nil
+}
+
+// SetMultiple sets the "multiple" field.
+func (m *PollMutation) SetMultiple(b bool) {
+	m.multiple = &b
+}
+
+// Multiple returns the value of the "multiple" field in the mutation.
+func (m *PollMutation) Multiple() (r bool, exists bool) {
+	v := m.multiple
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMultiple returns the old "multiple" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldMultiple(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMultiple is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMultiple requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMultiple: %w", err)
+	}
+	return oldValue.Multiple, nil
+}
+
+// ResetMultiple resets all changes to the "multiple" field.
+func (m *PollMutation) ResetMultiple() {
+	m.multiple = nil
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (m *PollMutation) SetAnonymous(b bool) {
+	m.anonymous = &b
+}
+
+// Anonymous returns the value of the "anonymous" field in the mutation.
+func (m *PollMutation) Anonymous() (r bool, exists bool) {
+	v := m.anonymous
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnonymous returns the old "anonymous" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldAnonymous(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnonymous is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnonymous requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnonymous: %w", err)
+	}
+	return oldValue.Anonymous, nil
+}
+
+// ResetAnonymous resets all changes to the "anonymous" field.
+func (m *PollMutation) ResetAnonymous() {
+	m.anonymous = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *PollMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *PollMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *PollMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (m *PollMutation) SetClosesAt(t time.Time) {
+	m.closes_at = &t
+}
+
+// ClosesAt returns the value of the "closes_at" field in the mutation.
+func (m *PollMutation) ClosesAt() (r time.Time, exists bool) {
+	v := m.closes_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosesAt returns the old "closes_at" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldClosesAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosesAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosesAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosesAt: %w", err)
+	}
+	return oldValue.ClosesAt, nil
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (m *PollMutation) ClearClosesAt() {
+	m.closes_at = nil
+	m.clearedFields[poll.FieldClosesAt] = struct{}{}
+}
+
+// ClosesAtCleared returns if the "closes_at" field was cleared in this mutation.
+func (m *PollMutation) ClosesAtCleared() bool {
+	_, ok := m.clearedFields[poll.FieldClosesAt]
+	return ok
+}
+
+// ResetClosesAt resets all changes to the "closes_at" field.
+func (m *PollMutation) ResetClosesAt() {
+	m.closes_at = nil
+	delete(m.clearedFields, poll.FieldClosesAt)
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (m *PollMutation) SetClosedAt(t time.Time) {
+	m.closed_at = &t
+}
+
+// ClosedAt returns the value of the "closed_at" field in the mutation.
+func (m *PollMutation) ClosedAt() (r time.Time, exists bool) {
+	v := m.closed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosedAt returns the old "closed_at" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldClosedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosedAt: %w", err)
+	}
+	return oldValue.ClosedAt, nil
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (m *PollMutation) ClearClosedAt() {
+	m.closed_at = nil
+	m.clearedFields[poll.FieldClosedAt] = struct{}{}
+}
+
+// ClosedAtCleared returns if the "closed_at" field was cleared in this mutation.
+func (m *PollMutation) ClosedAtCleared() bool {
+	_, ok := m.clearedFields[poll.FieldClosedAt]
+	return ok
+}
+
+// ResetClosedAt resets all changes to the "closed_at" field.
+func (m *PollMutation) ResetClosedAt() {
+	m.closed_at = nil
+	delete(m.clearedFields, poll.FieldClosedAt)
+}
+
+// SetSummaryMessageID sets the "summary_message_id" field.
+func (m *PollMutation) SetSummaryMessageID(i int) {
+	m.summary_message_id = &i
+	m.addsummary_message_id = nil
+}
+
+// SummaryMessageID returns the value of the "summary_message_id" field in the mutation.
+func (m *PollMutation) SummaryMessageID() (r int, exists bool) {
+	v := m.summary_message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummaryMessageID returns the old "summary_message_id" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldSummaryMessageID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummaryMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummaryMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummaryMessageID: %w", err)
+	}
+	return oldValue.SummaryMessageID, nil
+}
+
+// AddSummaryMessageID adds i to the "summary_message_id" field.
+func (m *PollMutation) AddSummaryMessageID(i int) {
+	if m.addsummary_message_id != nil {
+		*m.addsummary_message_id += i
+	} else {
+		m.addsummary_message_id = &i
+	}
+}
+
+// AddedSummaryMessageID returns the value that was added to the "summary_message_id" field in this mutation.
+func (m *PollMutation) AddedSummaryMessageID() (r int, exists bool) {
+	v := m.addsummary_message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSummaryMessageID clears the value of the "summary_message_id" field.
+func (m *PollMutation) ClearSummaryMessageID() {
+	m.summary_message_id = nil
+	m.addsummary_message_id = nil
+	m.clearedFields[poll.FieldSummaryMessageID] = struct{}{}
+}
+
+// SummaryMessageIDCleared returns if the "summary_message_id" field was cleared in this mutation.
+func (m *PollMutation) SummaryMessageIDCleared() bool {
+	_, ok := m.clearedFields[poll.FieldSummaryMessageID]
+	return ok
+}
+
+// ResetSummaryMessageID resets all changes to the "summary_message_id" field.
+func (m *PollMutation) ResetSummaryMessageID() {
+	m.summary_message_id = nil
+	m.addsummary_message_id = nil
+	delete(m.clearedFields, poll.FieldSummaryMessageID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PollMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PollMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PollMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PollMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PollMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PollMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *PollMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[poll.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *PollMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *PollMutation) MessageIDs() (ids []int) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *PollMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// AddVoteIDs adds the "votes" edge to the PollVote entity by ids.
+func (m *PollMutation) AddVoteIDs(ids ...int) {
+	if m.votes == nil {
+		m.votes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.votes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVotes clears the "votes" edge to the PollVote entity.
+func (m *PollMutation) ClearVotes() {
+	m.clearedvotes = true
+}
+
+// VotesCleared reports if the "votes" edge to the PollVote entity was cleared.
+func (m *PollMutation) VotesCleared() bool {
+	return m.clearedvotes
+}
+
+// RemoveVoteIDs removes the "votes" edge to the PollVote entity by IDs.
+func (m *PollMutation) RemoveVoteIDs(ids ...int) {
+	if m.removedvotes == nil {
+		m.removedvotes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.votes, ids[i])
+		m.removedvotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVotes returns the removed IDs of the "votes" edge to the PollVote entity.
+func (m *PollMutation) RemovedVotesIDs() (ids []int) {
+	for id := range m.removedvotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VotesIDs returns the "votes" edge IDs in the mutation.
+func (m *PollMutation) VotesIDs() (ids []int) {
+	for id := range m.votes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVotes resets all changes to the "votes" edge.
+func (m *PollMutation) ResetVotes() {
+	m.votes = nil
+	m.clearedvotes = false
+	m.removedvotes = nil
+}
+
+// Where appends a list predicates to the PollMutation builder.
+func (m *PollMutation) Where(ps ...predicate.Poll) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PollMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PollMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Poll, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PollMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PollMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Poll).
+func (m *PollMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PollMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.room_id != nil {
+		fields = append(fields, poll.FieldRoomID)
+	}
+	if m.message != nil {
+		fields = append(fields, poll.FieldMessageID)
+	}
+	if m.question != nil {
+		fields = append(fields, poll.FieldQuestion)
+	}
+	if m.options != nil {
+		fields = append(fields, poll.FieldOptions)
+	}
+	if m.multiple != nil {
+		fields = append(fields, poll.FieldMultiple)
+	}
+	if m.anonymous != nil {
+		fields = append(fields, poll.FieldAnonymous)
+	}
+	if m.created_by != nil {
+		fields = append(fields, poll.FieldCreatedBy)
+	}
+	if m.closes_at != nil {
+		fields = append(fields, poll.FieldClosesAt)
+	}
+	if m.closed_at != nil {
+		fields = append(fields, poll.FieldClosedAt)
+	}
+	if m.summary_message_id != nil {
+		fields = append(fields, poll.FieldSummaryMessageID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, poll.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, poll.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PollMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case poll.FieldRoomID:
+		return m.RoomID()
+	case poll.FieldMessageID:
+		return m.MessageID()
+	case poll.FieldQuestion:
+		return m.Question()
+	case poll.FieldOptions:
+		return m.Options()
+	case poll.FieldMultiple:
+		return m.Multiple()
+	case poll.FieldAnonymous:
+		return m.Anonymous()
+	case poll.FieldCreatedBy:
+		return m.CreatedBy()
+	case poll.FieldClosesAt:
+		return m.ClosesAt()
+	case poll.FieldClosedAt:
+		return m.ClosedAt()
+	case poll.FieldSummaryMessageID:
+		return m.SummaryMessageID()
+	case poll.FieldCreatedAt:
+		return m.CreatedAt()
+	case poll.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PollMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case poll.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case poll.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case poll.FieldQuestion:
+		return m.OldQuestion(ctx)
+	case poll.FieldOptions:
+		return m.OldOptions(ctx)
+	case poll.FieldMultiple:
+		return m.OldMultiple(ctx)
+	case poll.FieldAnonymous:
+		return m.OldAnonymous(ctx)
+	case poll.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case poll.FieldClosesAt:
+		return m.OldClosesAt(ctx)
+	case poll.FieldClosedAt:
+		return m.OldClosedAt(ctx)
+	case poll.FieldSummaryMessageID:
+		return m.OldSummaryMessageID(ctx)
+	case poll.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case poll.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Poll field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PollMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case poll.FieldRoomID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case poll.FieldMessageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case poll.FieldQuestion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestion(v)
+		return nil
+	case poll.FieldOptions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptions(v)
+		return nil
+	case poll.FieldMultiple:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMultiple(v)
+		return nil
+	case poll.FieldAnonymous:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnonymous(v)
+		return nil
+	case poll.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case poll.FieldClosesAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosesAt(v)
+		return nil
+	case poll.FieldClosedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosedAt(v)
+		return nil
+	case poll.FieldSummaryMessageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummaryMessageID(v)
+		return nil
+	case poll.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case poll.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Poll field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PollMutation) AddedFields() []string {
+	var fields []string
+	if m.addsummary_message_id != nil {
+		fields = append(fields, poll.FieldSummaryMessageID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PollMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case poll.FieldSummaryMessageID:
+		return m.AddedSummaryMessageID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PollMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case poll.FieldSummaryMessageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSummaryMessageID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Poll numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PollMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(poll.FieldClosesAt) {
+		fields = append(fields, poll.FieldClosesAt)
+	}
+	if m.FieldCleared(poll.FieldClosedAt) {
+		fields = append(fields, poll.FieldClosedAt)
+	}
+	if m.FieldCleared(poll.FieldSummaryMessageID) {
+		fields = append(fields, poll.FieldSummaryMessageID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PollMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PollMutation) ClearField(name string) error {
+	switch name {
+	case poll.FieldClosesAt:
+		m.ClearClosesAt()
+		return nil
+	case poll.FieldClosedAt:
+		m.ClearClosedAt()
+		return nil
+	case poll.FieldSummaryMessageID:
+		m.ClearSummaryMessageID()
+		return nil
+	}
+	return fmt.Errorf("unknown Poll nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PollMutation) ResetField(name string) error {
+	switch name {
+	case poll.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case poll.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case poll.FieldQuestion:
+		m.ResetQuestion()
+		return nil
+	case poll.FieldOptions:
+		m.ResetOptions()
+		return nil
+	case poll.FieldMultiple:
+		m.ResetMultiple()
+		return nil
+	case poll.FieldAnonymous:
+		m.ResetAnonymous()
+		return nil
+	case poll.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case poll.FieldClosesAt:
+		m.ResetClosesAt()
+		return nil
+	case poll.FieldClosedAt:
+		m.ResetClosedAt()
+		return nil
+	case poll.FieldSummaryMessageID:
+		m.ResetSummaryMessageID()
+		return nil
+	case poll.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case poll.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Poll field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PollMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.message != nil {
+		edges = append(edges, poll.EdgeMessage)
+	}
+	if m.votes != nil {
+		edges = append(edges, poll.EdgeVotes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PollMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case poll.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case poll.EdgeVotes:
+		ids := make([]ent.Value, 0, len(m.votes))
+		for id := range m.votes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PollMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedvotes != nil {
+		edges = append(edges, poll.EdgeVotes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PollMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case poll.EdgeVotes:
+		ids := make([]ent.Value, 0, len(m.removedvotes))
+		for id := range m.removedvotes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PollMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmessage {
+		edges = append(edges, poll.EdgeMessage)
+	}
+	if m.clearedvotes {
+		edges = append(edges, poll.EdgeVotes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PollMutation) EdgeCleared(name string) bool {
+	switch name {
+	case poll.EdgeMessage:
+		return m.clearedmessage
+	case poll.EdgeVotes:
+		return m.clearedvotes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PollMutation) ClearEdge(name string) error {
+	switch name {
+	case poll.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown Poll unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PollMutation) ResetEdge(name string) error {
+	switch name {
+	case poll.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case poll.EdgeVotes:
+		m.ResetVotes()
+		return nil
+	}
+	return fmt.Errorf("unknown Poll edge %s", name)
+}
+
+// PollVoteMutation represents an operation that mutates the PollVote nodes in the graph.
+type PollVoteMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	user_id       *string
+	username      *string
+	option        *int
+	addoption     *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	poll          *int
+	clearedpoll   bool
+	done          bool
+	oldValue      func(context.Context) (*PollVote, error)
+	predicates    []predicate.PollVote
+}
+
+var _ ent.Mutation = (*PollVoteMutation)(nil)
+
+// pollvoteOption allows management of the mutation configuration using functional options.
+type pollvoteOption func(*PollVoteMutation)
+
+// newPollVoteMutation creates new mutation for the PollVote entity.
+func newPollVoteMutation(c config, op Op, opts ...pollvoteOption) *PollVoteMutation {
+	m := &PollVoteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePollVote,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPollVoteID sets the ID field of the mutation.
+func withPollVoteID(id int) pollvoteOption {
+	return func(m *PollVoteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PollVote
+		)
+		m.oldValue = func(ctx context.Context) (*PollVote, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PollVote.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPollVote sets the old PollVote of the mutation.
+func withPollVote(node *PollVote) pollvoteOption {
+	return func(m *PollVoteMutation) {
+		m.oldValue = func(context.Context) (*PollVote, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PollVoteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PollVoteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PollVoteMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PollVoteMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PollVote.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPollID sets the "poll_id" field.
+func (m *PollVoteMutation) SetPollID(i int) {
+	m.poll = &i
+}
+
+// PollID returns the value of the "poll_id" field in the mutation.
+func (m *PollVoteMutation) PollID() (r int, exists bool) {
+	v := m.poll
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPollID returns the old "poll_id" field's value of the PollVote entity.
+// If the PollVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollVoteMutation) OldPollID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPollID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPollID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPollID: %w", err)
+	}
+	return oldValue.PollID, nil
+}
+
+// ResetPollID resets all changes to the "poll_id" field.
+func (m *PollVoteMutation) ResetPollID() {
+	m.poll = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PollVoteMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PollVoteMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PollVote entity.
+// If the PollVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollVoteMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PollVoteMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *PollVoteMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *PollVoteMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the PollVote entity.
+// If the PollVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollVoteMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *PollVoteMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetOption sets the "option" field.
+func (m *PollVoteMutation) SetOption(i int) {
+	m.option = &i
+	m.addoption = nil
+}
+
+// Option returns the value of the "option" field in the mutation.
+func (m *PollVoteMutation) Option() (r int, exists bool) {
+	v := m.option
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOption returns the old "option" field's value of the PollVote entity.
+// If the PollVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollVoteMutation) OldOption(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOption is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOption requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOption: %w", err)
+	}
+	return oldValue.Option, nil
+}
+
+// AddOption adds i to the "option" field.
+func (m *PollVoteMutation) AddOption(i int) {
+	if m.addoption != nil {
+		*m.addoption += i
+	} else {
+		m.addoption = &i
+	}
+}
+
+// AddedOption returns the value that was added to the "option" field in this mutation.
+func (m *PollVoteMutation) AddedOption() (r int, exists bool) {
+	v := m.addoption
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOption resets all changes to the "option" field.
+func (m *PollVoteMutation) ResetOption() {
+	m.option = nil
+	m.addoption = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PollVoteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PollVoteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PollVote entity.
+// If the PollVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollVoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PollVoteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (m *PollVoteMutation) ClearPoll() {
+	m.clearedpoll = true
+	m.clearedFields[pollvote.FieldPollID] = struct{}{}
+}
+
+// PollCleared reports if the "poll" edge to the Poll entity was cleared.
+func (m *PollVoteMutation) PollCleared() bool {
+	return m.clearedpoll
+}
+
+// PollIDs returns the "poll" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PollID instead. It exists only for internal usage by the builders.
+func (m *PollVoteMutation) PollIDs() (ids []int) {
+	if id := m.poll; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPoll resets all changes to the "poll" edge.
+func (m *PollVoteMutation) ResetPoll() {
+	m.poll = nil
+	m.clearedpoll = false
+}
+
+// Where appends a list predicates to the PollVoteMutation builder.
+func (m *PollVoteMutation) Where(ps ...predicate.PollVote) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PollVoteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PollVoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PollVote, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PollVoteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PollVoteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PollVote).
+func (m *PollVoteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PollVoteMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.poll != nil {
+		fields = append(fields, pollvote.FieldPollID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, pollvote.FieldUserID)
+	}
+	if m.username != nil {
+		fields = append(fields, pollvote.FieldUsername)
+	}
+	if m.option != nil {
+		fields = append(fields, pollvote.FieldOption)
+	}
+	if m.created_at != nil {
+		fields = append(fields, pollvote.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PollVoteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pollvote.FieldPollID:
+		return m.PollID()
+	case pollvote.FieldUserID:
+		return m.UserID()
+	case pollvote.FieldUsername:
+		return m.Username()
+	case pollvote.FieldOption:
+		return m.Option()
+	case pollvote.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PollVoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pollvote.FieldPollID:
+		return m.OldPollID(ctx)
+	case pollvote.FieldUserID:
+		return m.OldUserID(ctx)
+	case pollvote.FieldUsername:
+		return m.OldUsername(ctx)
+	case pollvote.FieldOption:
+		return m.OldOption(ctx)
+	case pollvote.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PollVote field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PollVoteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pollvote.FieldPollID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPollID(v)
+		return nil
+	case pollvote.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case pollvote.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case pollvote.FieldOption:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOption(v)
+		return nil
+	case pollvote.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PollVote field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PollVoteMutation) AddedFields() []string {
+	var fields []string
+	if m.addoption != nil {
+		fields = append(fields, pollvote.FieldOption)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PollVoteMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pollvote.FieldOption:
+		return m.AddedOption()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PollVoteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pollvote.FieldOption:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOption(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PollVote numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PollVoteMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PollVoteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PollVoteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PollVote nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PollVoteMutation) ResetField(name string) error {
+	switch name {
+	case pollvote.FieldPollID:
+		m.ResetPollID()
+		return nil
+	case pollvote.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case pollvote.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case pollvote.FieldOption:
+		m.ResetOption()
+		return nil
+	case pollvote.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PollVote field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PollVoteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.poll != nil {
+		edges = append(edges, pollvote.EdgePoll)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PollVoteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pollvote.EdgePoll:
+		if id := m.poll; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PollVoteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PollVoteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PollVoteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpoll {
+		edges = append(edges, pollvote.EdgePoll)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PollVoteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pollvote.EdgePoll:
+		return m.clearedpoll
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PollVoteMutation) ClearEdge(name string) error {
+	switch name {
+	case pollvote.EdgePoll:
+		m.ClearPoll()
+		return nil
+	}
+	return fmt.Errorf("unknown PollVote unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PollVoteMutation) ResetEdge(name string) error {
+	switch name {
+	case pollvote.EdgePoll:
+		m.ResetPoll()
+		return nil
+	}
+	return fmt.Errorf("unknown PollVote edge %s", name)
+}
+
+// RoomMutation represents an operation that mutates the Room nodes in the graph.
+type RoomMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	name                      *string
+	retention_max_age         *time.Duration
+	addretention_max_age      *time.Duration
+	retention_max_messages    *int
+	addretention_max_messages *int
+	owner_id                  *string
+	import_key                *string
+	clearedFields             map[string]struct{}
+	done                      bool
+	oldValue                  func(context.Context) (*Room, error)
+	predicates                []predicate.Room
+}
+
+var _ ent.Mutation = (*RoomMutation)(nil)
+
+// roomOption allows management of the mutation configuration using functional options.
+type roomOption func(*RoomMutation)
+
+// newRoomMutation creates new mutation for the Room entity.
+func newRoomMutation(c config, op Op, opts ...roomOption) *RoomMutation {
+	m := &RoomMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoom,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoomID sets the ID field of the mutation.
+func withRoomID(id int) roomOption {
+	return func(m *RoomMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Room
+		)
+		m.oldValue = func(ctx context.Context) (*Room, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Room.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoom sets the old Room of the mutation.
+func withRoom(node *Room) roomOption {
+	return func(m *RoomMutation) {
+		m.oldValue = func(context.Context) (*Room, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoomMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoomMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoomMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoomMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Room.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RoomMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoomMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoomMutation) ResetName() {
+	m.name = nil
+}
+
+// SetRetentionMaxAge sets the "retention_max_age" field.
+func (m *RoomMutation) SetRetentionMaxAge(t time.Duration) {
+	m.retention_max_age = &t
+	m.addretention_max_age = nil
+}
+
+// RetentionMaxAge returns the value of the "retention_max_age" field in the mutation.
+func (m *RoomMutation) RetentionMaxAge() (r time.Duration, exists bool) {
+	v := m.retention_max_age
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetentionMaxAge returns the old "retention_max_age" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldRetentionMaxAge(ctx context.Context) (v *time.Duration, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetentionMaxAge is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetentionMaxAge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetentionMaxAge: %w", err)
+	}
+	return oldValue.RetentionMaxAge, nil
+}
+
+// AddRetentionMaxAge adds t to the "retention_max_age" field.
+func (m *RoomMutation) AddRetentionMaxAge(t time.Duration) {
+	if m.addretention_max_age != nil {
+		*m.addretention_max_age += t
+	} else {
+		m.addretention_max_age = &t
+	}
+}
+
+// AddedRetentionMaxAge returns the value that was added to the "retention_max_age" field in this mutation.
+func (m *RoomMutation) AddedRetentionMaxAge() (r time.Duration, exists bool) {
+	v := m.addretention_max_age
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRetentionMaxAge clears the value of the "retention_max_age" field.
+func (m *RoomMutation) ClearRetentionMaxAge() {
+	m.retention_max_age = nil
+	m.addretention_max_age = nil
+	m.clearedFields[room.FieldRetentionMaxAge] = struct{}{}
+}
+
+// RetentionMaxAgeCleared returns if the "retention_max_age" field was cleared in this mutation.
+func (m *RoomMutation) RetentionMaxAgeCleared() bool {
+	_, ok := m.clearedFields[room.FieldRetentionMaxAge]
+	return ok
+}
+
+// ResetRetentionMaxAge resets all changes to the "retention_max_age" field.
+func (m *RoomMutation) ResetRetentionMaxAge() {
+	m.retention_max_age = nil
+	m.addretention_max_age = nil
+	delete(m.clearedFields, room.FieldRetentionMaxAge)
+}
+
+// SetRetentionMaxMessages sets the "retention_max_messages" field.
+func (m *RoomMutation) SetRetentionMaxMessages(i int) {
+	m.retention_max_messages = &i
+	m.addretention_max_messages = nil
+}
+
+// RetentionMaxMessages returns the value of the "retention_max_messages" field in the mutation.
+func (m *RoomMutation) RetentionMaxMessages() (r int, exists bool) {
+	v := m.retention_max_messages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetentionMaxMessages returns the old "retention_max_messages" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldRetentionMaxMessages(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetentionMaxMessages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetentionMaxMessages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetentionMaxMessages: %w", err)
+	}
+	return oldValue.RetentionMaxMessages, nil
+}
+
+// AddRetentionMaxMessages adds i to the "retention_max_messages" field.
+func (m *RoomMutation) AddRetentionMaxMessages(i int) {
+	if m.addretention_max_messages != nil {
+		*m.addretention_max_messages += i
+	} else {
+		m.addretention_max_messages = &i
+	}
+}
+
+// AddedRetentionMaxMessages returns the value that was added to the "retention_max_messages" field in this mutation.
+func (m *RoomMutation) AddedRetentionMaxMessages() (r int, exists bool) {
+	v := m.addretention_max_messages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRetentionMaxMessages clears the value of the "retention_max_messages" field.
+func (m *RoomMutation) ClearRetentionMaxMessages() {
+	m.retention_max_messages = nil
+	m.addretention_max_messages = nil
+	m.clearedFields[room.FieldRetentionMaxMessages] = struct{}{}
+}
+
+// RetentionMaxMessagesCleared returns if the "retention_max_messages" field was cleared in this mutation.
+func (m *RoomMutation) RetentionMaxMessagesCleared() bool {
+	_, ok := m.clearedFields[room.FieldRetentionMaxMessages]
+	return ok
+}
+
+// ResetRetentionMaxMessages resets all changes to the "retention_max_messages" field.
+func (m *RoomMutation) ResetRetentionMaxMessages() {
+	m.retention_max_messages = nil
+	m.addretention_max_messages = nil
+	delete(m.clearedFields, room.FieldRetentionMaxMessages)
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *RoomMutation) SetOwnerID(s string) {
+	m.owner_id = &s
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *RoomMutation) OwnerID() (r string, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldOwnerID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (m *RoomMutation) ClearOwnerID() {
+	m.owner_id = nil
+	m.clearedFields[room.FieldOwnerID] = struct{}{}
+}
+
+// OwnerIDCleared returns if the "owner_id" field was cleared in this mutation.
+func (m *RoomMutation) OwnerIDCleared() bool {
+	_, ok := m.clearedFields[room.FieldOwnerID]
+	return ok
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *RoomMutation) ResetOwnerID() {
+	m.owner_id = nil
+	delete(m.clearedFields, room.FieldOwnerID)
+}
+
+// SetImportKey sets the "import_key" field.
+func (m *RoomMutation) SetImportKey(s string) {
+	m.import_key = &s
+}
+
+// ImportKey returns the value of the "import_key" field in the mutation.
+func (m *RoomMutation) ImportKey() (r string, exists bool) {
+	v := m.import_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImportKey returns the old "import_key" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldImportKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImportKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImportKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImportKey: %w", err)
+	}
+	return oldValue.ImportKey, nil
+}
+
+// ClearImportKey clears the value of the "import_key" field.
+func (m *RoomMutation) ClearImportKey() {
+	m.import_key = nil
+	m.clearedFields[room.FieldImportKey] = struct{}{}
+}
+
+// ImportKeyCleared returns if the "import_key" field was cleared in this mutation.
+func (m *RoomMutation) ImportKeyCleared() bool {
+	_, ok := m.clearedFields[room.FieldImportKey]
+	return ok
+}
+
+// ResetImportKey resets all changes to the "import_key" field.
+func (m *RoomMutation) ResetImportKey() {
+	m.import_key = nil
+	delete(m.clearedFields, room.FieldImportKey)
+}
+
+// Where appends a list predicates to the RoomMutation builder.
+func (m *RoomMutation) Where(ps ...predicate.Room) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoomMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoomMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Room, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoomMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoomMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Room).
+func (m *RoomMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoomMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, room.FieldName)
+	}
+	if m.retention_max_age != nil {
+		fields = append(fields, room.FieldRetentionMaxAge)
+	}
+	if m.retention_max_messages != nil {
+		fields = append(fields, room.FieldRetentionMaxMessages)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, room.FieldOwnerID)
+	}
+	if m.import_key != nil {
+		fields = append(fields, room.FieldImportKey)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoomMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case room.FieldName:
+		return m.Name()
+	case room.FieldRetentionMaxAge:
+		return m.RetentionMaxAge()
+	case room.FieldRetentionMaxMessages:
+		return m.RetentionMaxMessages()
+	case room.FieldOwnerID:
+		return m.OwnerID()
+	case room.FieldImportKey:
+		return m.ImportKey()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoomMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case room.FieldName:
+		return m.OldName(ctx)
+	case room.FieldRetentionMaxAge:
+		return m.OldRetentionMaxAge(ctx)
+	case room.FieldRetentionMaxMessages:
+		return m.OldRetentionMaxMessages(ctx)
+	case room.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case room.FieldImportKey:
+		return m.OldImportKey(ctx)
+	}
+	return nil, fmt.Errorf("unknown Room field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case room.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case room.FieldRetentionMaxAge:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetentionMaxAge(v)
+		return nil
+	case room.FieldRetentionMaxMessages:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetentionMaxMessages(v)
+		return nil
+	case room.FieldOwnerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case room.FieldImportKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImportKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Room field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoomMutation) AddedFields() []string {
+	var fields []string
+	if m.addretention_max_age != nil {
+		fields = append(fields, room.FieldRetentionMaxAge)
+	}
+	if m.addretention_max_messages != nil {
+		fields = append(fields, room.FieldRetentionMaxMessages)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoomMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case room.FieldRetentionMaxAge:
+		return m.AddedRetentionMaxAge()
+	case room.FieldRetentionMaxMessages:
+		return m.AddedRetentionMaxMessages()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case room.FieldRetentionMaxAge:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetentionMaxAge(v)
+		return nil
+	case room.FieldRetentionMaxMessages:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetentionMaxMessages(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Room numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoomMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(room.FieldRetentionMaxAge) {
+		fields = append(fields, room.FieldRetentionMaxAge)
+	}
+	if m.FieldCleared(room.FieldRetentionMaxMessages) {
+		fields = append(fields, room.FieldRetentionMaxMessages)
+	}
+	if m.FieldCleared(room.FieldOwnerID) {
+		fields = append(fields, room.FieldOwnerID)
+	}
+	if m.FieldCleared(room.FieldImportKey) {
+		fields = append(fields, room.FieldImportKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoomMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoomMutation) ClearField(name string) error {
+	switch name {
+	case room.FieldRetentionMaxAge:
+		m.ClearRetentionMaxAge()
+		return nil
+	case room.FieldRetentionMaxMessages:
+		m.ClearRetentionMaxMessages()
+		return nil
+	case room.FieldOwnerID:
+		m.ClearOwnerID()
+		return nil
+	case room.FieldImportKey:
+		m.ClearImportKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Room nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoomMutation) ResetField(name string) error {
+	switch name {
+	case room.FieldName:
+		m.ResetName()
+		return nil
+	case room.FieldRetentionMaxAge:
+		m.ResetRetentionMaxAge()
+		return nil
+	case room.FieldRetentionMaxMessages:
+		m.ResetRetentionMaxMessages()
+		return nil
+	case room.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case room.FieldImportKey:
+		m.ResetImportKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Room field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoomMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoomMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoomMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoomMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoomMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoomMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoomMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Room unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoomMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Room edge %s", name)
+}
+
+// RoomExportMutation represents an operation that mutates the RoomExport nodes in the graph.
+type RoomExportMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	room_id          *string
+	user_id          *string
+	format           *roomexport.Format
+	from             *time.Time
+	to               *time.Time
+	status           *roomexport.Status
+	error            *string
+	message_count    *int
+	addmessage_count *int
+	created_at       *time.Time
+	completed_at     *time.Time
+	lease_until      *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*RoomExport, error)
+	predicates       []predicate.RoomExport
+}
+
+var _ ent.Mutation = (*RoomExportMutation)(nil)
+
+// roomexportOption allows management of the mutation configuration using functional options.
+type roomexportOption func(*RoomExportMutation)
+
+// newRoomExportMutation creates new mutation for the RoomExport entity.
+func newRoomExportMutation(c config, op Op, opts ...roomexportOption) *RoomExportMutation {
+	m := &RoomExportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoomExport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoomExportID sets the ID field of the mutation.
+func withRoomExportID(id int) roomexportOption {
+	return func(m *RoomExportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoomExport
+		)
+		m.oldValue = func(ctx context.Context) (*RoomExport, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoomExport.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoomExport sets the old RoomExport of the mutation.
+func withRoomExport(node *RoomExport) roomexportOption {
+	return func(m *RoomExportMutation) {
+		m.oldValue = func(context.Context) (*RoomExport, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoomExportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoomExportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoomExportMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoomExportMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoomExport.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *RoomExportMutation) SetRoomID(s string) {
+	m.room_id = &s
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *RoomExportMutation) RoomID() (r string, exists bool) {
+	v := m.room_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the RoomExport entity.
+// If the RoomExport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomExportMutation) OldRoomID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *RoomExportMutation) ResetRoomID() {
+	m.room_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RoomExportMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RoomExportMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RoomExport entity.
+// If the RoomExport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomExportMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RoomExportMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *RoomExportMutation) SetFormat(r roomexport.Format) {
+	m.format = &r
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *RoomExportMutation) Format() (r roomexport.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the RoomExport entity.
+// If the RoomExport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomExportMutation) OldFormat(ctx context.Context) (v roomexport.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *RoomExportMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetFrom sets the "from" field.
+func (m *RoomExportMutation) SetFrom(t time.Time) {
+	m.from = &t
+}
+
+// From returns the value of the "from" field in the mutation.
+func (m *RoomExportMutation) From() (r time.Time, exists bool) {
+	v := m.from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrom returns the old "from" field's value of the RoomExport entity.
+// If the RoomExport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomExportMutation) OldFrom(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrom: %w", err)
+	}
+	return oldValue.From, nil
+}
+
+// ClearFrom clears the value of the "from" field.
+func (m *RoomExportMutation) ClearFrom() {
+	m.from = nil
+	m.clearedFields[roomexport.FieldFrom] = struct{}{}
+}
+
+// FromCleared returns if the "from" field was cleared in this mutation.
+func (m *RoomExportMutation) FromCleared() bool {
+	_, ok := m.clearedFields[roomexport.FieldFrom]
+	return ok
+}
+
+// ResetFrom resets all changes to the "from" field.
+func (m *RoomExportMutation) ResetFrom() {
+	m.from = nil
+	delete(m.clearedFields, roomexport.FieldFrom)
+}
+
+// SetTo sets the "to" field.
+func (m *RoomExportMutation) SetTo(t time.Time) {
+	m.to = &t
+}
+
+// To returns the value of the "to" field in the mutation.
+func (m *RoomExportMutation) To() (r time.Time, exists bool) {
+	v := m.to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTo returns the old "to" field's value of the RoomExport entity.
+// If the RoomExport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomExportMutation) OldTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTo: %w", err)
+	}
+	return oldValue.To, nil
+}
+
+// ClearTo clears the value of the "to" field.
+func (m *RoomExportMutation) ClearTo() {
+	m.to = nil
+	m.clearedFields[roomexport.FieldTo] = struct{}{}
+}
+
+// ToCleared returns if the "to" field was cleared in this mutation.
+func (m *RoomExportMutation) ToCleared() bool {
+	_, ok := m.clearedFields[roomexport.FieldTo]
+	return ok
+}
+
+// ResetTo resets all changes to the "to" field.
+func (m *RoomExportMutation) ResetTo() {
+	m.to = nil
+	delete(m.clearedFields, roomexport.FieldTo)
+}
+
+// SetStatus sets the "status" field.
+func (m *RoomExportMutation) SetStatus(r roomexport.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RoomExportMutation) Status() (r roomexport.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the RoomExport entity.
+// If the RoomExport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomExportMutation) OldStatus(ctx context.Context) (v roomexport.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *RoomExportMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetError sets the "error" field.
+func (m *RoomExportMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *RoomExportMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the RoomExport entity.
+// If the RoomExport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomExportMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *RoomExportMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[roomexport.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *RoomExportMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[roomexport.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *RoomExportMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, roomexport.FieldError)
+}
+
+// SetMessageCount sets the "message_count" field.
+func (m *RoomExportMutation) SetMessageCount(i int) {
+	m.message_count = &i
+	m.addmessage_count = nil
+}
+
+// MessageCount returns the value of the "message_count" field in the mutation.
+func (m *RoomExportMutation) MessageCount() (r int, exists bool) {
+	v := m.message_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageCount returns the old "message_count" field's value of the RoomExport entity.
+// If the RoomExport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomExportMutation) OldMessageCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageCount: %w", err)
+	}
+	return oldValue.MessageCount, nil
+}
+
+// AddMessageCount adds i to the "message_count" field.
+func (m *RoomExportMutation) AddMessageCount(i int) {
+	if m.addmessage_count != nil {
+		*m.addmessage_count += i
+	} else {
+		m.addmessage_count = &i
+	}
+}
+
+// AddedMessageCount returns the value that was added to the "message_count" field in this mutation.
+func (m *RoomExportMutation) AddedMessageCount() (r int, exists bool) {
+	v := m.addmessage_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessageCount resets all changes to the "message_count" field.
+func (m *RoomExportMutation) ResetMessageCount() {
+	m.message_count = nil
+	m.addmessage_count = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoomExportMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoomExportMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RoomExport entity.
+// If the RoomExport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomExportMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoomExportMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *RoomExportMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *RoomExportMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the RoomExport entity.
+// If the RoomExport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomExportMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *RoomExportMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[roomexport.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *RoomExportMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[roomexport.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *RoomExportMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, roomexport.FieldCompletedAt)
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (m *RoomExportMutation) SetLeaseUntil(t time.Time) {
+	m.lease_until = &t
+}
+
+// LeaseUntil returns the value of the "lease_until" field in the mutation.
+func (m *RoomExportMutation) LeaseUntil() (r time.Time, exists bool) {
+	v := m.lease_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseUntil returns the old "lease_until" field's value of the RoomExport entity.
+// If the RoomExport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomExportMutation) OldLeaseUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseUntil: %w", err)
+	}
+	return oldValue.LeaseUntil, nil
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (m *RoomExportMutation) ClearLeaseUntil() {
+	m.lease_until = nil
+	m.clearedFields[roomexport.FieldLeaseUntil] = struct{}{}
+}
+
+// LeaseUntilCleared returns if the "lease_until" field was cleared in this mutation.
+func (m *RoomExportMutation) LeaseUntilCleared() bool {
+	_, ok := m.clearedFields[roomexport.FieldLeaseUntil]
+	return ok
+}
+
+// ResetLeaseUntil resets all changes to the "lease_until" field.
+func (m *RoomExportMutation) ResetLeaseUntil() {
+	m.lease_until = nil
+	delete(m.clearedFields, roomexport.FieldLeaseUntil)
+}
+
+// Where appends a list predicates to the RoomExportMutation builder.
+func (m *RoomExportMutation) Where(ps ...predicate.RoomExport) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoomExportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoomExportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoomExport, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoomExportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoomExportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoomExport).
+func (m *RoomExportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoomExportMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.room_id != nil {
+		fields = append(fields, roomexport.FieldRoomID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, roomexport.FieldUserID)
+	}
+	if m.format != nil {
+		fields = append(fields, roomexport.FieldFormat)
+	}
+	if m.from != nil {
+		fields = append(fields, roomexport.FieldFrom)
+	}
+	if m.to != nil {
+		fields = append(fields, roomexport.FieldTo)
+	}
+	if m.status != nil {
+		fields = append(fields, roomexport.FieldStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, roomexport.FieldError)
+	}
+	if m.message_count != nil {
+		fields = append(fields, roomexport.FieldMessageCount)
+	}
+	if m.created_at != nil {
+		fields = append(fields, roomexport.FieldCreatedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, roomexport.FieldCompletedAt)
+	}
+	if m.lease_until != nil {
+		fields = append(fields, roomexport.FieldLeaseUntil)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoomExportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case roomexport.FieldRoomID:
+		return m.RoomID()
+	case roomexport.FieldUserID:
+		return m.UserID()
+	case roomexport.FieldFormat:
+		return m.Format()
+	case roomexport.FieldFrom:
+		return m.From()
+	case roomexport.FieldTo:
+		return m.To()
+	case roomexport.FieldStatus:
+		return m.Status()
+	case roomexport.FieldError:
+		return m.Error()
+	case roomexport.FieldMessageCount:
+		return m.MessageCount()
+	case roomexport.FieldCreatedAt:
+		return m.CreatedAt()
+	case roomexport.FieldCompletedAt:
+		return m.CompletedAt()
+	case roomexport.FieldLeaseUntil:
+		return m.LeaseUntil()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoomExportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case roomexport.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case roomexport.FieldUserID:
+		return m.OldUserID(ctx)
+	case roomexport.FieldFormat:
+		return m.OldFormat(ctx)
+	case roomexport.FieldFrom:
+		return m.OldFrom(ctx)
+	case roomexport.FieldTo:
+		return m.OldTo(ctx)
+	case roomexport.FieldStatus:
+		return m.OldStatus(ctx)
+	case roomexport.FieldError:
+		return m.OldError(ctx)
+	case roomexport.FieldMessageCount:
+		return m.OldMessageCount(ctx)
+	case roomexport.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case roomexport.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case roomexport.FieldLeaseUntil:
+		return m.OldLeaseUntil(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoomExport field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomExportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case roomexport.FieldRoomID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case roomexport.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case roomexport.FieldFormat:
+		v, ok := value.(roomexport.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case roomexport.FieldFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrom(v)
+		return nil
+	case roomexport.FieldTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTo(v)
+		return nil
+	case roomexport.FieldStatus:
+		v, ok := value.(roomexport.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case roomexport.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case roomexport.FieldMessageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageCount(v)
+		return nil
+	case roomexport.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case roomexport.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case roomexport.FieldLeaseUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseUntil(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoomExport field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoomExportMutation) AddedFields() []string {
+	var fields []string
+	if m.addmessage_count != nil {
+		fields = append(fields, roomexport.FieldMessageCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoomExportMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case roomexport.FieldMessageCount:
+		return m.AddedMessageCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomExportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case roomexport.FieldMessageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoomExport numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoomExportMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(roomexport.FieldFrom) {
+		fields = append(fields, roomexport.FieldFrom)
+	}
+	if m.FieldCleared(roomexport.FieldTo) {
+		fields = append(fields, roomexport.FieldTo)
+	}
+	if m.FieldCleared(roomexport.FieldError) {
+		fields = append(fields, roomexport.FieldError)
+	}
+	if m.FieldCleared(roomexport.FieldCompletedAt) {
+		fields = append(fields, roomexport.FieldCompletedAt)
+	}
+	if m.FieldCleared(roomexport.FieldLeaseUntil) {
+		fields = append(fields, roomexport.FieldLeaseUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoomExportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoomExportMutation) ClearField(name string) error {
+	switch name {
+	case roomexport.FieldFrom:
+		m.ClearFrom()
+		return nil
+	case roomexport.FieldTo:
+		m.ClearTo()
+		return nil
+	case roomexport.FieldError:
+		m.ClearError()
+		return nil
+	case roomexport.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case roomexport.FieldLeaseUntil:
+		m.ClearLeaseUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomExport nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoomExportMutation) ResetField(name string) error {
+	switch name {
+	case roomexport.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case roomexport.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case roomexport.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case roomexport.FieldFrom:
+		m.ResetFrom()
+		return nil
+	case roomexport.FieldTo:
+		m.ResetTo()
+		return nil
+	case roomexport.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case roomexport.FieldError:
+		m.ResetError()
+		return nil
+	case roomexport.FieldMessageCount:
+		m.ResetMessageCount()
+		return nil
+	case roomexport.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case roomexport.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case roomexport.FieldLeaseUntil:
+		m.ResetLeaseUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomExport field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoomExportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoomExportMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoomExportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoomExportMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoomExportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoomExportMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoomExportMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RoomExport unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoomExportMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RoomExport edge %s", name)
+}
+
+// RoomExportChunkMutation represents an operation that mutates the RoomExportChunk nodes in the graph.
+type RoomExportChunkMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	export_id     *int
+	addexport_id  *int
+	seq           *int
+	addseq        *int
+	data          *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*RoomExportChunk, error)
+	predicates    []predicate.RoomExportChunk
+}
+
+var _ ent.Mutation = (*RoomExportChunkMutation)(nil)
+
+// roomexportchunkOption allows management of the mutation configuration using functional options.
+type roomexportchunkOption func(*RoomExportChunkMutation)
+
+// newRoomExportChunkMutation creates new mutation for the RoomExportChunk entity.
+func newRoomExportChunkMutation(c config, op Op, opts ...roomexportchunkOption) *RoomExportChunkMutation {
+	m := &RoomExportChunkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoomExportChunk,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoomExportChunkID sets the ID field of the mutation.
+func withRoomExportChunkID(id int) roomexportchunkOption {
+	return func(m *RoomExportChunkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoomExportChunk
+		)
+		m.oldValue = func(ctx context.Context) (*RoomExportChunk, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoomExportChunk.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoomExportChunk sets the old RoomExportChunk of the mutation.
+func withRoomExportChunk(node *RoomExportChunk) roomexportchunkOption {
+	return func(m *RoomExportChunkMutation) {
+		m.oldValue = func(context.Context) (*RoomExportChunk, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoomExportChunkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoomExportChunkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoomExportChunkMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoomExportChunkMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoomExportChunk.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetExportID sets the "export_id" field.
+func (m *RoomExportChunkMutation) SetExportID(i int) {
+	m.export_id = &i
+	m.addexport_id = nil
+}
+
+// ExportID returns the value of the "export_id" field in the mutation.
+func (m *RoomExportChunkMutation) ExportID() (r int, exists bool) {
+	v := m.export_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExportID returns the old "export_id" field's value of the RoomExportChunk entity.
+// If the RoomExportChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomExportChunkMutation) OldExportID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExportID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExportID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExportID: %w", err)
+	}
+	return oldValue.ExportID, nil
+}
+
+// AddExportID adds i to the "export_id" field.
+func (m *RoomExportChunkMutation) AddExportID(i int) {
+	if m.addexport_id != nil {
+		*m.addexport_id += i
+	} else {
+		m.addexport_id = &i
+	}
+}
+
+// AddedExportID returns the value that was added to the "export_id" field in this mutation.
+func (m *RoomExportChunkMutation) AddedExportID() (r int, exists bool) {
+	v := m.addexport_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExportID resets all changes to the "export_id" field.
+func (m *RoomExportChunkMutation) ResetExportID() {
+	m.export_id = nil
+	m.addexport_id = nil
+}
+
+// SetSeq sets the "seq" field.
+func (m *RoomExportChunkMutation) SetSeq(i int) {
+	m.seq = &i
+	m.addseq = nil
+}
+
+// Seq returns the value of the "seq" field in the mutation.
+func (m *RoomExportChunkMutation) Seq() (r int, exists bool) {
+	v := m.seq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeq returns the old "seq" field's value of the RoomExportChunk entity.
+// If the RoomExportChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomExportChunkMutation) OldSeq(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeq requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
+	}
+	return oldValue.Seq, nil
+}
+
+// AddSeq adds i to the "seq" field.
+func (m *RoomExportChunkMutation) AddSeq(i int) {
+	if m.addseq != nil {
+		*m.addseq += i
+	} else {
+		m.addseq = &i
+	}
+}
+
+// AddedSeq returns the value that was added to the "seq" field in this mutation.
+func (m *RoomExportChunkMutation) AddedSeq() (r int, exists bool) {
+	v := m.addseq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeq resets all changes to the "seq" field.
+func (m *RoomExportChunkMutation) ResetSeq() {
+	m.seq = nil
+	m.addseq = nil
+}
+
+// SetData sets the "data" field.
+func (m *RoomExportChunkMutation) SetData(b []byte) {
+	m.data = &b
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *RoomExportChunkMutation) Data() (r []byte, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the RoomExportChunk entity.
+// If the RoomExportChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomExportChunkMutation) OldData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *RoomExportChunkMutation) ResetData() {
+	m.data = nil
+}
+
+// Where appends a list predicates to the RoomExportChunkMutation builder.
+func (m *RoomExportChunkMutation) Where(ps ...predicate.RoomExportChunk) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoomExportChunkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoomExportChunkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoomExportChunk, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoomExportChunkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoomExportChunkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoomExportChunk).
+func (m *RoomExportChunkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoomExportChunkMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.export_id != nil {
+		fields = append(fields, roomexportchunk.FieldExportID)
+	}
+	if m.seq != nil {
+		fields = append(fields, roomexportchunk.FieldSeq)
+	}
+	if m.data != nil {
+		fields = append(fields, roomexportchunk.FieldData)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoomExportChunkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case roomexportchunk.FieldExportID:
+		return m.ExportID()
+	case roomexportchunk.FieldSeq:
+		return m.Seq()
+	case roomexportchunk.FieldData:
+		return m.Data()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoomExportChunkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case roomexportchunk.FieldExportID:
+		return m.OldExportID(ctx)
+	case roomexportchunk.FieldSeq:
+		return m.OldSeq(ctx)
+	case roomexportchunk.FieldData:
+		return m.OldData(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoomExportChunk field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomExportChunkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case roomexportchunk.FieldExportID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExportID(v)
+		return nil
+	case roomexportchunk.FieldSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeq(v)
+		return nil
+	case roomexportchunk.FieldData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoomExportChunk field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoomExportChunkMutation) AddedFields() []string {
+	var fields []string
+	if m.addexport_id != nil {
+		fields = append(fields, roomexportchunk.FieldExportID)
+	}
+	if m.addseq != nil {
+		fields = append(fields, roomexportchunk.FieldSeq)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoomExportChunkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case roomexportchunk.FieldExportID:
+		return m.AddedExportID()
+	case roomexportchunk.FieldSeq:
+		return m.AddedSeq()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomExportChunkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case roomexportchunk.FieldExportID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExportID(v)
+		return nil
+	case roomexportchunk.FieldSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeq(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoomExportChunk numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoomExportChunkMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoomExportChunkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoomExportChunkMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RoomExportChunk nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoomExportChunkMutation) ResetField(name string) error {
+	switch name {
+	case roomexportchunk.FieldExportID:
+		m.ResetExportID()
+		return nil
+	case roomexportchunk.FieldSeq:
+		m.ResetSeq()
+		return nil
+	case roomexportchunk.FieldData:
+		m.ResetData()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomExportChunk field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoomExportChunkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoomExportChunkMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoomExportChunkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoomExportChunkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoomExportChunkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoomExportChunkMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoomExportChunkMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RoomExportChunk unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoomExportChunkMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RoomExportChunk edge %s", name)
+}
+
+// RoomMemberMutation represents an operation that mutates the RoomMember nodes in the graph.
+type RoomMemberMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	room_id       *string
+	user_id       *string
+	username      *string
+	joined_at     *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*RoomMember, error)
+	predicates    []predicate.RoomMember
+}
+
+var _ ent.Mutation = (*RoomMemberMutation)(nil)
+
+// roommemberOption allows management of the mutation configuration using functional options.
+type roommemberOption func(*RoomMemberMutation)
+
+// newRoomMemberMutation creates new mutation for the RoomMember entity.
+func newRoomMemberMutation(c config, op Op, opts ...roommemberOption) *RoomMemberMutation {
+	m := &RoomMemberMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoomMember,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoomMemberID sets the ID field of the mutation.
+func withRoomMemberID(id int) roommemberOption {
+	return func(m *RoomMemberMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoomMember
+		)
+		m.oldValue = func(ctx context.Context) (*RoomMember, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoomMember.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoomMember sets the old RoomMember of the mutation.
+func withRoomMember(node *RoomMember) roommemberOption {
+	return func(m *RoomMemberMutation) {
+		m.oldValue = func(context.Context) (*RoomMember, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoomMemberMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoomMemberMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoomMemberMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoomMemberMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoomMember.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *RoomMemberMutation) SetRoomID(s string) {
+	m.room_id = &s
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *RoomMemberMutation) RoomID() (r string, exists bool) {
+	v := m.room_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldRoomID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *RoomMemberMutation) ResetRoomID() {
+	m.room_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RoomMemberMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RoomMemberMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RoomMemberMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *RoomMemberMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *RoomMemberMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *RoomMemberMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (m *RoomMemberMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
+}
+
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *RoomMemberMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinedAt returns the old "joined_at" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+	}
+	return oldValue.JoinedAt, nil
+}
+
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *RoomMemberMutation) ResetJoinedAt() {
+	m.joined_at = nil
+}
+
+// Where appends a list predicates to the RoomMemberMutation builder.
+func (m *RoomMemberMutation) Where(ps ...predicate.RoomMember) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoomMemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoomMemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoomMember, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoomMemberMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoomMemberMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoomMember).
+func (m *RoomMemberMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoomMemberMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.room_id != nil {
+		fields = append(fields, roommember.FieldRoomID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, roommember.FieldUserID)
+	}
+	if m.username != nil {
+		fields = append(fields, roommember.FieldUsername)
+	}
+	if m.joined_at != nil {
+		fields = append(fields, roommember.FieldJoinedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoomMemberMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case roommember.FieldRoomID:
+		return m.RoomID()
+	case roommember.FieldUserID:
+		return m.UserID()
+	case roommember.FieldUsername:
+		return m.Username()
+	case roommember.FieldJoinedAt:
+		return m.JoinedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoomMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case roommember.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case roommember.FieldUserID:
+		return m.OldUserID(ctx)
+	case roommember.FieldUsername:
+		return m.OldUsername(ctx)
+	case roommember.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoomMember field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMemberMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case roommember.FieldRoomID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case roommember.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case roommember.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case roommember.FieldJoinedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMember field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoomMemberMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoomMemberMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMemberMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RoomMember numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoomMemberMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoomMemberMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoomMemberMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RoomMember nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoomMemberMutation) ResetField(name string) error {
+	switch name {
+	case roommember.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case roommember.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case roommember.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case roommember.FieldJoinedAt:
+		m.ResetJoinedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMember field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoomMemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoomMemberMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoomMemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoomMemberMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoomMemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoomMemberMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoomMemberMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RoomMember unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoomMemberMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RoomMember edge %s", name)
+}
+
+// SavedMessageMutation represents an operation that mutates the SavedMessage nodes in the graph.
+type SavedMessageMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	user_id        *string
+	saved_at       *time.Time
+	clearedFields  map[string]struct{}
+	message        *int
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*SavedMessage, error)
+	predicates     []predicate.SavedMessage
+}
+
+var _ ent.Mutation = (*SavedMessageMutation)(nil)
+
+// savedmessageOption allows management of the mutation configuration using functional options.
+type savedmessageOption func(*SavedMessageMutation)
+
+// newSavedMessageMutation creates new mutation for the SavedMessage entity.
+func newSavedMessageMutation(c config, op Op, opts ...savedmessageOption) *SavedMessageMutation {
+	m := &SavedMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSavedMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSavedMessageID sets the ID field of the mutation.
+func withSavedMessageID(id int) savedmessageOption {
+	return func(m *SavedMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SavedMessage
+		)
+		m.oldValue = func(ctx context.Context) (*SavedMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SavedMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSavedMessage sets the old SavedMessage of the mutation.
+func withSavedMessage(node *SavedMessage) savedmessageOption {
+	return func(m *SavedMessageMutation) {
+		m.oldValue = func(context.Context) (*SavedMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SavedMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SavedMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SavedMessageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SavedMessageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SavedMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SavedMessageMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SavedMessageMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SavedMessage entity.
+// If the SavedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedMessageMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SavedMessageMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *SavedMessageMutation) SetMessageID(i int) {
+	m.message = &i
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *SavedMessageMutation) MessageID() (r int, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the SavedMessage entity.
+// If the SavedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedMessageMutation) OldMessageID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *SavedMessageMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetSavedAt sets the "saved_at" field.
+func (m *SavedMessageMutation) SetSavedAt(t time.Time) {
+	m.saved_at = &t
+}
+
+// SavedAt returns the value of the "saved_at" field in the mutation.
+func (m *SavedMessageMutation) SavedAt() (r time.Time, exists bool) {
+	v := m.saved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSavedAt returns the old "saved_at" field's value of the SavedMessage entity.
+// If the SavedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedMessageMutation) OldSavedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSavedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSavedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSavedAt: %w", err)
+	}
+	return oldValue.SavedAt, nil
+}
+
+// ResetSavedAt resets all changes to the "saved_at" field.
+func (m *SavedMessageMutation) ResetSavedAt() {
+	m.saved_at = nil
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *SavedMessageMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[savedmessage.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *SavedMessageMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *SavedMessageMutation) MessageIDs() (ids []int) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *SavedMessageMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the SavedMessageMutation builder.
+func (m *SavedMessageMutation) Where(ps ...predicate.SavedMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SavedMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SavedMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SavedMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SavedMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SavedMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SavedMessage).
+func (m *SavedMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SavedMessageMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user_id != nil {
+		fields = append(fields, savedmessage.FieldUserID)
+	}
+	if m.message != nil {
+		fields = append(fields, savedmessage.FieldMessageID)
+	}
+	if m.saved_at != nil {
+		fields = append(fields, savedmessage.FieldSavedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SavedMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case savedmessage.FieldUserID:
+		return m.UserID()
+	case savedmessage.FieldMessageID:
+		return m.MessageID()
+	case savedmessage.FieldSavedAt:
+		return m.SavedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SavedMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case savedmessage.FieldUserID:
+		return m.OldUserID(ctx)
+	case savedmessage.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case savedmessage.FieldSavedAt:
+		return m.OldSavedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SavedMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case savedmessage.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case savedmessage.FieldMessageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case savedmessage.FieldSavedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSavedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SavedMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SavedMessageMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SavedMessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SavedMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SavedMessageMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SavedMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SavedMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SavedMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SavedMessageMutation) ResetField(name string) error {
+	switch name {
+	case savedmessage.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case savedmessage.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case savedmessage.FieldSavedAt:
+		m.ResetSavedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SavedMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, savedmessage.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SavedMessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case savedmessage.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SavedMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SavedMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SavedMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, savedmessage.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SavedMessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case savedmessage.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SavedMessageMutation) ClearEdge(name string) error {
+	switch name {
+	case savedmessage.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SavedMessageMutation) ResetEdge(name string) error {
+	switch name {
+	case savedmessage.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedMessage edge %s", name)
+}
+
+// ScheduledMessageMutation represents an operation that mutates the ScheduledMessage nodes in the graph.
+type ScheduledMessageMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	content       *string
 	room_id       *string
+	user_id       *string
 	username      *string
+	content       *string
+	send_at       *time.Time
+	time_zone     *string
+	status        *scheduledmessage.Status
+	message_id    *int
+	addmessage_id *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	sent_at       *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Message, error)
-	predicates    []predicate.Message
+	oldValue      func(context.Context) (*ScheduledMessage, error)
+	predicates    []predicate.ScheduledMessage
 }
 
-var _ ent.Mutation = (*MessageMutation)(nil)
+var _ ent.Mutation = (*ScheduledMessageMutation)(nil)
 
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
+// scheduledmessageOption allows management of the mutation configuration using functional options.
+type scheduledmessageOption func(*ScheduledMessageMutation)
 
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+// newScheduledMessageMutation creates new mutation for the ScheduledMessage entity.
+func newScheduledMessageMutation(c config, op Op, opts ...scheduledmessageOption) *ScheduledMessageMutation {
+	m := &ScheduledMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeScheduledMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -62,20 +11364,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id int) messageOption {
-	return func(m *MessageMutation) {
+// withScheduledMessageID sets the ID field of the mutation.
+func withScheduledMessageID(id int) scheduledmessageOption {
+	return func(m *ScheduledMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *ScheduledMessage
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*ScheduledMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().ScheduledMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -84,180 +11386,515 @@ func withMessageID(id int) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// withScheduledMessage sets the old ScheduledMessage of the mutation.
+func withScheduledMessage(node *ScheduledMessage) scheduledmessageOption {
+	return func(m *ScheduledMessageMutation) {
+		m.oldValue = func(context.Context) (*ScheduledMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScheduledMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScheduledMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScheduledMessageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScheduledMessageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ScheduledMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *ScheduledMessageMutation) SetRoomID(s string) {
+	m.room_id = &s
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *ScheduledMessageMutation) RoomID() (r string, exists bool) {
+	v := m.room_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldRoomID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *ScheduledMessageMutation) ResetRoomID() {
+	m.room_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ScheduledMessageMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ScheduledMessageMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ScheduledMessageMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *ScheduledMessageMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *ScheduledMessageMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *ScheduledMessageMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetContent sets the "content" field.
+func (m *ScheduledMessageMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *ScheduledMessageMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *ScheduledMessageMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetSendAt sets the "send_at" field.
+func (m *ScheduledMessageMutation) SetSendAt(t time.Time) {
+	m.send_at = &t
+}
+
+// SendAt returns the value of the "send_at" field in the mutation.
+func (m *ScheduledMessageMutation) SendAt() (r time.Time, exists bool) {
+	v := m.send_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSendAt returns the old "send_at" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldSendAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSendAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSendAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSendAt: %w", err)
+	}
+	return oldValue.SendAt, nil
+}
+
+// ResetSendAt resets all changes to the "send_at" field.
+func (m *ScheduledMessageMutation) ResetSendAt() {
+	m.send_at = nil
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (m *ScheduledMessageMutation) SetTimeZone(s string) {
+	m.time_zone = &s
+}
+
+// TimeZone returns the value of the "time_zone" field in the mutation.
+func (m *ScheduledMessageMutation) TimeZone() (r string, exists bool) {
+	v := m.time_zone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeZone returns the old "time_zone" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldTimeZone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeZone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeZone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeZone: %w", err)
+	}
+	return oldValue.TimeZone, nil
+}
+
+// ResetTimeZone resets all changes to the "time_zone" field.
+func (m *ScheduledMessageMutation) ResetTimeZone() {
+	m.time_zone = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ScheduledMessageMutation) SetStatus(s scheduledmessage.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ScheduledMessageMutation) Status() (r scheduledmessage.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldStatus(ctx context.Context) (v scheduledmessage.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ScheduledMessageMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *ScheduledMessageMutation) SetMessageID(i int) {
+	m.message_id = &i
+	m.addmessage_id = nil
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *ScheduledMessageMutation) MessageID() (r int, exists bool) {
+	v := m.message_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// OldMessageID returns the old "message_id" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldMessageID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddMessageID adds i to the "message_id" field.
+func (m *ScheduledMessageMutation) AddMessageID(i int) {
+	if m.addmessage_id != nil {
+		*m.addmessage_id += i
+	} else {
+		m.addmessage_id = &i
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AddedMessageID returns the value that was added to the "message_id" field in this mutation.
+func (m *ScheduledMessageMutation) AddedMessageID() (r int, exists bool) {
+	v := m.addmessage_id
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ClearMessageID clears the value of the "message_id" field.
+func (m *ScheduledMessageMutation) ClearMessageID() {
+	m.message_id = nil
+	m.addmessage_id = nil
+	m.clearedFields[scheduledmessage.FieldMessageID] = struct{}{}
 }
 
-// SetContent sets the "content" field.
-func (m *MessageMutation) SetContent(s string) {
-	m.content = &s
+// MessageIDCleared returns if the "message_id" field was cleared in this mutation.
+func (m *ScheduledMessageMutation) MessageIDCleared() bool {
+	_, ok := m.clearedFields[scheduledmessage.FieldMessageID]
+	return ok
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *MessageMutation) Content() (r string, exists bool) {
-	v := m.content
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *ScheduledMessageMutation) ResetMessageID() {
+	m.message_id = nil
+	m.addmessage_id = nil
+	delete(m.clearedFields, scheduledmessage.FieldMessageID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ScheduledMessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ScheduledMessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *ScheduledMessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *MessageMutation) ResetContent() {
-	m.content = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ScheduledMessageMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetRoomID sets the "room_id" field.
-func (m *MessageMutation) SetRoomID(s string) {
-	m.room_id = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ScheduledMessageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RoomID returns the value of the "room_id" field in the mutation.
-func (m *MessageMutation) RoomID() (r string, exists bool) {
-	v := m.room_id
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ScheduledMessageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoomID returns the old "room_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldRoomID(ctx context.Context) (v string, err error) {
+func (m *ScheduledMessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoomID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.RoomID, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetRoomID resets all changes to the "room_id" field.
-func (m *MessageMutation) ResetRoomID() {
-	m.room_id = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ScheduledMessageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetUsername sets the "username" field.
-func (m *MessageMutation) SetUsername(s string) {
-	m.username = &s
+// SetSentAt sets the "sent_at" field.
+func (m *ScheduledMessageMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *MessageMutation) Username() (r string, exists bool) {
-	v := m.username
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *ScheduledMessageMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldSentAt returns the old "sent_at" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *ScheduledMessageMutation) OldSentAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.SentAt, nil
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *MessageMutation) ResetUsername() {
-	m.username = nil
+// ClearSentAt clears the value of the "sent_at" field.
+func (m *ScheduledMessageMutation) ClearSentAt() {
+	m.sent_at = nil
+	m.clearedFields[scheduledmessage.FieldSentAt] = struct{}{}
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// SentAtCleared returns if the "sent_at" field was cleared in this mutation.
+func (m *ScheduledMessageMutation) SentAtCleared() bool {
+	_, ok := m.clearedFields[scheduledmessage.FieldSentAt]
+	return ok
+}
+
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *ScheduledMessageMutation) ResetSentAt() {
+	m.sent_at = nil
+	delete(m.clearedFields, scheduledmessage.FieldSentAt)
+}
+
+// Where appends a list predicates to the ScheduledMessageMutation builder.
+func (m *ScheduledMessageMutation) Where(ps ...predicate.ScheduledMessage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ScheduledMessageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *ScheduledMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScheduledMessage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -265,33 +11902,57 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *ScheduledMessageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *ScheduledMessageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (ScheduledMessage).
+func (m *ScheduledMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.content != nil {
-		fields = append(fields, message.FieldContent)
-	}
+func (m *ScheduledMessageMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.room_id != nil {
-		fields = append(fields, message.FieldRoomID)
+		fields = append(fields, scheduledmessage.FieldRoomID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, scheduledmessage.FieldUserID)
 	}
 	if m.username != nil {
-		fields = append(fields, message.FieldUsername)
+		fields = append(fields, scheduledmessage.FieldUsername)
+	}
+	if m.content != nil {
+		fields = append(fields, scheduledmessage.FieldContent)
+	}
+	if m.send_at != nil {
+		fields = append(fields, scheduledmessage.FieldSendAt)
+	}
+	if m.time_zone != nil {
+		fields = append(fields, scheduledmessage.FieldTimeZone)
+	}
+	if m.status != nil {
+		fields = append(fields, scheduledmessage.FieldStatus)
+	}
+	if m.message_id != nil {
+		fields = append(fields, scheduledmessage.FieldMessageID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, scheduledmessage.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, scheduledmessage.FieldUpdatedAt)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, scheduledmessage.FieldSentAt)
 	}
 	return fields
 }
@@ -299,14 +11960,30 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *ScheduledMessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldContent:
-		return m.Content()
-	case message.FieldRoomID:
+	case scheduledmessage.FieldRoomID:
 		return m.RoomID()
-	case message.FieldUsername:
+	case scheduledmessage.FieldUserID:
+		return m.UserID()
+	case scheduledmessage.FieldUsername:
 		return m.Username()
+	case scheduledmessage.FieldContent:
+		return m.Content()
+	case scheduledmessage.FieldSendAt:
+		return m.SendAt()
+	case scheduledmessage.FieldTimeZone:
+		return m.TimeZone()
+	case scheduledmessage.FieldStatus:
+		return m.Status()
+	case scheduledmessage.FieldMessageID:
+		return m.MessageID()
+	case scheduledmessage.FieldCreatedAt:
+		return m.CreatedAt()
+	case scheduledmessage.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case scheduledmessage.FieldSentAt:
+		return m.SentAt()
 	}
 	return nil, false
 }
@@ -314,178 +11991,310 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ScheduledMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldContent:
-		return m.OldContent(ctx)
-	case message.FieldRoomID:
+	case scheduledmessage.FieldRoomID:
 		return m.OldRoomID(ctx)
-	case message.FieldUsername:
+	case scheduledmessage.FieldUserID:
+		return m.OldUserID(ctx)
+	case scheduledmessage.FieldUsername:
 		return m.OldUsername(ctx)
+	case scheduledmessage.FieldContent:
+		return m.OldContent(ctx)
+	case scheduledmessage.FieldSendAt:
+		return m.OldSendAt(ctx)
+	case scheduledmessage.FieldTimeZone:
+		return m.OldTimeZone(ctx)
+	case scheduledmessage.FieldStatus:
+		return m.OldStatus(ctx)
+	case scheduledmessage.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case scheduledmessage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case scheduledmessage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case scheduledmessage.FieldSentAt:
+		return m.OldSentAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown ScheduledMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *ScheduledMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldContent:
+	case scheduledmessage.FieldRoomID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetRoomID(v)
 		return nil
-	case message.FieldRoomID:
+	case scheduledmessage.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoomID(v)
+		m.SetUserID(v)
 		return nil
-	case message.FieldUsername:
+	case scheduledmessage.FieldUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUsername(v)
 		return nil
+	case scheduledmessage.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case scheduledmessage.FieldSendAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSendAt(v)
+		return nil
+	case scheduledmessage.FieldTimeZone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeZone(v)
+		return nil
+	case scheduledmessage.FieldStatus:
+		v, ok := value.(scheduledmessage.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case scheduledmessage.FieldMessageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case scheduledmessage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case scheduledmessage.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case scheduledmessage.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown ScheduledMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
-	return nil
+func (m *ScheduledMessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addmessage_id != nil {
+		fields = append(fields, scheduledmessage.FieldMessageID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ScheduledMessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case scheduledmessage.FieldMessageID:
+		return m.AddedMessageID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *ScheduledMessageMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case scheduledmessage.FieldMessageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown ScheduledMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
-	return nil
+func (m *ScheduledMessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(scheduledmessage.FieldMessageID) {
+		fields = append(fields, scheduledmessage.FieldMessageID)
+	}
+	if m.FieldCleared(scheduledmessage.FieldSentAt) {
+		fields = append(fields, scheduledmessage.FieldSentAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *ScheduledMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Message nullable field %s", name)
+func (m *ScheduledMessageMutation) ClearField(name string) error {
+	switch name {
+	case scheduledmessage.FieldMessageID:
+		m.ClearMessageID()
+		return nil
+	case scheduledmessage.FieldSentAt:
+		m.ClearSentAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *ScheduledMessageMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldContent:
-		m.ResetContent()
-		return nil
-	case message.FieldRoomID:
+	case scheduledmessage.FieldRoomID:
 		m.ResetRoomID()
 		return nil
-	case message.FieldUsername:
+	case scheduledmessage.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case scheduledmessage.FieldUsername:
 		m.ResetUsername()
 		return nil
+	case scheduledmessage.FieldContent:
+		m.ResetContent()
+		return nil
+	case scheduledmessage.FieldSendAt:
+		m.ResetSendAt()
+		return nil
+	case scheduledmessage.FieldTimeZone:
+		m.ResetTimeZone()
+		return nil
+	case scheduledmessage.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case scheduledmessage.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case scheduledmessage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case scheduledmessage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case scheduledmessage.FieldSentAt:
+		m.ResetSentAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown ScheduledMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
+func (m *ScheduledMessageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+func (m *ScheduledMessageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
+func (m *ScheduledMessageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *ScheduledMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
+func (m *ScheduledMessageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
+func (m *ScheduledMessageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Message unique edge %s", name)
+func (m *ScheduledMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ScheduledMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Message edge %s", name)
+func (m *ScheduledMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ScheduledMessage edge %s", name)
 }
 
-// RoomMutation represents an operation that mutates the Room nodes in the graph.
-type RoomMutation struct {
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
+	room_id       *string
 	name          *string
+	created_by    *string
+	token_hash    *string
+	is_revoked    *bool
+	created_at    *time.Time
+	last_used_at  *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Room, error)
-	predicates    []predicate.Room
+	oldValue      func(context.Context) (*Webhook, error)
+	predicates    []predicate.Webhook
 }
 
-var _ ent.Mutation = (*RoomMutation)(nil)
+var _ ent.Mutation = (*WebhookMutation)(nil)
 
-// roomOption allows management of the mutation configuration using functional options.
-type roomOption func(*RoomMutation)
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
 
-// newRoomMutation creates new mutation for the Room entity.
-func newRoomMutation(c config, op Op, opts ...roomOption) *RoomMutation {
-	m := &RoomMutation{
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRoom,
+		typ:           TypeWebhook,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -494,20 +12303,20 @@ func newRoomMutation(c config, op Op, opts ...roomOption) *RoomMutation {
 	return m
 }
 
-// withRoomID sets the ID field of the mutation.
-func withRoomID(id int) roomOption {
-	return func(m *RoomMutation) {
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id int) webhookOption {
+	return func(m *WebhookMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Room
+			value *Webhook
 		)
-		m.oldValue = func(ctx context.Context) (*Room, error) {
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Room.Get(ctx, id)
+					value, err = m.Client().Webhook.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -516,10 +12325,10 @@ func withRoomID(id int) roomOption {
 	}
 }
 
-// withRoom sets the old Room of the mutation.
-func withRoom(node *Room) roomOption {
-	return func(m *RoomMutation) {
-		m.oldValue = func(context.Context) (*Room, error) {
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -528,7 +12337,7 @@ func withRoom(node *Room) roomOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoomMutation) Client() *Client {
+func (m WebhookMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -536,7 +12345,7 @@ func (m RoomMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RoomMutation) Tx() (*Tx, error) {
+func (m WebhookMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -547,7 +12356,7 @@ func (m RoomMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RoomMutation) ID() (id int, exists bool) {
+func (m *WebhookMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -558,7 +12367,7 @@ func (m *RoomMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RoomMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WebhookMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -567,19 +12376,55 @@ func (m *RoomMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Room.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetRoomID sets the "room_id" field.
+func (m *WebhookMutation) SetRoomID(s string) {
+	m.room_id = &s
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *WebhookMutation) RoomID() (r string, exists bool) {
+	v := m.room_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldRoomID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *WebhookMutation) ResetRoomID() {
+	m.room_id = nil
+}
+
 // SetName sets the "name" field.
-func (m *RoomMutation) SetName(s string) {
+func (m *WebhookMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *RoomMutation) Name() (r string, exists bool) {
+func (m *WebhookMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -587,10 +12432,10 @@ func (m *RoomMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -605,19 +12450,212 @@ func (m *RoomMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *RoomMutation) ResetName() {
+func (m *WebhookMutation) ResetName() {
 	m.name = nil
 }
 
-// Where appends a list predicates to the RoomMutation builder.
-func (m *RoomMutation) Where(ps ...predicate.Room) {
+// SetCreatedBy sets the "created_by" field.
+func (m *WebhookMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *WebhookMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *WebhookMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *WebhookMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *WebhookMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *WebhookMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetIsRevoked sets the "is_revoked" field.
+func (m *WebhookMutation) SetIsRevoked(b bool) {
+	m.is_revoked = &b
+}
+
+// IsRevoked returns the value of the "is_revoked" field in the mutation.
+func (m *WebhookMutation) IsRevoked() (r bool, exists bool) {
+	v := m.is_revoked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsRevoked returns the old "is_revoked" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldIsRevoked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsRevoked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsRevoked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsRevoked: %w", err)
+	}
+	return oldValue.IsRevoked, nil
+}
+
+// ResetIsRevoked resets all changes to the "is_revoked" field.
+func (m *WebhookMutation) ResetIsRevoked() {
+	m.is_revoked = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *WebhookMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *WebhookMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *WebhookMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[webhook.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *WebhookMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *WebhookMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, webhook.FieldLastUsedAt)
+}
+
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RoomMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoomMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Room, len(ps))
+func (m *WebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Webhook, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -625,27 +12663,45 @@ func (m *RoomMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RoomMutation) Op() Op {
+func (m *WebhookMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RoomMutation) SetOp(op Op) {
+func (m *WebhookMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Room).
-func (m *RoomMutation) Type() string {
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RoomMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.room_id != nil {
+		fields = append(fields, webhook.FieldRoomID)
+	}
 	if m.name != nil {
-		fields = append(fields, room.FieldName)
+		fields = append(fields, webhook.FieldName)
+	}
+	if m.created_by != nil {
+		fields = append(fields, webhook.FieldCreatedBy)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, webhook.FieldTokenHash)
+	}
+	if m.is_revoked != nil {
+		fields = append(fields, webhook.FieldIsRevoked)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhook.FieldCreatedAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, webhook.FieldLastUsedAt)
 	}
 	return fields
 }
@@ -653,10 +12709,22 @@ func (m *RoomMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RoomMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case room.FieldName:
+	case webhook.FieldRoomID:
+		return m.RoomID()
+	case webhook.FieldName:
 		return m.Name()
+	case webhook.FieldCreatedBy:
+		return m.CreatedBy()
+	case webhook.FieldTokenHash:
+		return m.TokenHash()
+	case webhook.FieldIsRevoked:
+		return m.IsRevoked()
+	case webhook.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhook.FieldLastUsedAt:
+		return m.LastUsedAt()
 	}
 	return nil, false
 }
@@ -664,126 +12732,207 @@ func (m *RoomMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoomMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case room.FieldName:
+	case webhook.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case webhook.FieldName:
 		return m.OldName(ctx)
+	case webhook.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case webhook.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case webhook.FieldIsRevoked:
+		return m.OldIsRevoked(ctx)
+	case webhook.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhook.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Room field %s", name)
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoomMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case room.FieldName:
+	case webhook.FieldRoomID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case webhook.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
+	case webhook.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case webhook.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case webhook.FieldIsRevoked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsRevoked(v)
+		return nil
+	case webhook.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhook.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Room field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoomMutation) AddedFields() []string {
+func (m *WebhookMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoomMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoomMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Room numeric field %s", name)
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoomMutation) ClearedFields() []string {
-	return nil
+func (m *WebhookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhook.FieldLastUsedAt) {
+		fields = append(fields, webhook.FieldLastUsedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoomMutation) FieldCleared(name string) bool {
+func (m *WebhookMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoomMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Room nullable field %s", name)
+func (m *WebhookMutation) ClearField(name string) error {
+	switch name {
+	case webhook.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoomMutation) ResetField(name string) error {
+func (m *WebhookMutation) ResetField(name string) error {
 	switch name {
-	case room.FieldName:
+	case webhook.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case webhook.FieldName:
 		m.ResetName()
 		return nil
+	case webhook.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case webhook.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case webhook.FieldIsRevoked:
+		m.ResetIsRevoked()
+		return nil
+	case webhook.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webhook.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Room field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoomMutation) AddedEdges() []string {
+func (m *WebhookMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoomMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoomMutation) RemovedEdges() []string {
+func (m *WebhookMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoomMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoomMutation) ClearedEdges() []string {
+func (m *WebhookMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoomMutation) EdgeCleared(name string) bool {
+func (m *WebhookMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoomMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Room unique edge %s", name)
+func (m *WebhookMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoomMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Room edge %s", name)
+func (m *WebhookMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Webhook edge %s", name)
 }
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pinnedmessage"
+)
+
+// PinnedMessage is the model entity for the PinnedMessage schema.
+type PinnedMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// RoomID holds the value of the "room_id" field.
+	RoomID string `json:"room_id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID int `json:"message_id,omitempty"`
+	// PinnedBy holds the value of the "pinned_by" field.
+	PinnedBy string `json:"pinned_by,omitempty"`
+	// PinnedAt holds the value of the "pinned_at" field.
+	PinnedAt time.Time `json:"pinned_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PinnedMessageQuery when eager-loading is set.
+	Edges        PinnedMessageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PinnedMessageEdges holds the relations/edges for other nodes in the graph.
+type PinnedMessageEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PinnedMessageEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PinnedMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pinnedmessage.FieldID, pinnedmessage.FieldMessageID:
+			values[i] = new(sql.NullInt64)
+		case pinnedmessage.FieldRoomID, pinnedmessage.FieldPinnedBy:
+			values[i] = new(sql.NullString)
+		case pinnedmessage.FieldPinnedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PinnedMessage fields.
+func (pm *PinnedMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pinnedmessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pm.ID = int(value.Int64)
+		case pinnedmessage.FieldRoomID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value.Valid {
+				pm.RoomID = value.String
+			}
+		case pinnedmessage.FieldMessageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				pm.MessageID = int(value.Int64)
+			}
+		case pinnedmessage.FieldPinnedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned_by", values[i])
+			} else if value.Valid {
+				pm.PinnedBy = value.String
+			}
+		case pinnedmessage.FieldPinnedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned_at", values[i])
+			} else if value.Valid {
+				pm.PinnedAt = value.Time
+			}
+		default:
+			pm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PinnedMessage.
+// This includes values selected through modifiers, order, etc.
+func (pm *PinnedMessage) Value(name string) (ent.Value, error) {
+	return pm.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the PinnedMessage entity.
+func (pm *PinnedMessage) QueryMessage() *MessageQuery {
+	return NewPinnedMessageClient(pm.config).QueryMessage(pm)
+}
+
+// Update returns a builder for updating this PinnedMessage.
+// Note that you need to call PinnedMessage.Unwrap() before calling this method if this PinnedMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pm *PinnedMessage) Update() *PinnedMessageUpdateOne {
+	return NewPinnedMessageClient(pm.config).UpdateOne(pm)
+}
+
+// Unwrap unwraps the PinnedMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pm *PinnedMessage) Unwrap() *PinnedMessage {
+	_tx, ok := pm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PinnedMessage is not a transactional entity")
+	}
+	pm.config.driver = _tx.drv
+	return pm
+}
+
+// String implements the fmt.Stringer.
+func (pm *PinnedMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("PinnedMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pm.ID))
+	builder.WriteString("room_id=")
+	builder.WriteString(pm.RoomID)
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", pm.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("pinned_by=")
+	builder.WriteString(pm.PinnedBy)
+	builder.WriteString(", ")
+	builder.WriteString("pinned_at=")
+	builder.WriteString(pm.PinnedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PinnedMessages is a parsable slice of PinnedMessage.
+type PinnedMessages []*PinnedMessage
@@ -0,0 +1,106 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pinnedmessage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the pinnedmessage type in the database.
+	Label = "pinned_message"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRoomID holds the string denoting the room_id field in the database.
+	FieldRoomID = "room_id"
+	// FieldMessageID holds the string denoting the message_id field in the database.
+	FieldMessageID = "message_id"
+	// FieldPinnedBy holds the string denoting the pinned_by field in the database.
+	FieldPinnedBy = "pinned_by"
+	// FieldPinnedAt holds the string denoting the pinned_at field in the database.
+	FieldPinnedAt = "pinned_at"
+	// EdgeMessage holds the string denoting the message edge name in mutations.
+	EdgeMessage = "message"
+	// Table holds the table name of the pinnedmessage in the database.
+	Table = "pinned_messages"
+	// MessageTable is the table that holds the message relation/edge.
+	MessageTable = "pinned_messages"
+	// MessageInverseTable is the table name for the Message entity.
+	// It exists in this package in order to avoid circular dependency with the "message" package.
+	MessageInverseTable = "messages"
+	// MessageColumn is the table column denoting the message relation/edge.
+	MessageColumn = "message_id"
+)
+
+// Columns holds all SQL columns for pinnedmessage fields.
+var Columns = []string{
+	FieldID,
+	FieldRoomID,
+	FieldMessageID,
+	FieldPinnedBy,
+	FieldPinnedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RoomIDValidator is a validator for the "room_id" field. It is called by the builders before save.
+	RoomIDValidator func(string) error
+	// PinnedByValidator is a validator for the "pinned_by" field. It is called by the builders before save.
+	PinnedByValidator func(string) error
+	// DefaultPinnedAt holds the default value on creation for the "pinned_at" field.
+	DefaultPinnedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PinnedMessage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRoomID orders the results by the room_id field.
+func ByRoomID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoomID, opts...).ToFunc()
+}
+
+// ByMessageID orders the results by the message_id field.
+func ByMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageID, opts...).ToFunc()
+}
+
+// ByPinnedBy orders the results by the pinned_by field.
+func ByPinnedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinnedBy, opts...).ToFunc()
+}
+
+// ByPinnedAt orders the results by the pinned_at field.
+func ByPinnedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinnedAt, opts...).ToFunc()
+}
+
+// ByMessageField orders the results by message field.
+func ByMessageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMessageStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMessageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MessageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MessageTable, MessageColumn),
+	)
+}
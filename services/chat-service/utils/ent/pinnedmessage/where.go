@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pinnedmessage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLTE(FieldID, id))
+}
+
+// RoomID applies equality check predicate on the "room_id" field. It's identical to RoomIDEQ.
+func RoomID(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldRoomID, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v int) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// PinnedBy applies equality check predicate on the "pinned_by" field. It's identical to PinnedByEQ.
+func PinnedBy(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldPinnedBy, v))
+}
+
+// PinnedAt applies equality check predicate on the "pinned_at" field. It's identical to PinnedAtEQ.
+func PinnedAt(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldPinnedAt, v))
+}
+
+// RoomIDEQ applies the EQ predicate on the "room_id" field.
+func RoomIDEQ(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldRoomID, v))
+}
+
+// RoomIDNEQ applies the NEQ predicate on the "room_id" field.
+func RoomIDNEQ(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldRoomID, v))
+}
+
+// RoomIDIn applies the In predicate on the "room_id" field.
+func RoomIDIn(vs ...string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldRoomID, vs...))
+}
+
+// RoomIDNotIn applies the NotIn predicate on the "room_id" field.
+func RoomIDNotIn(vs ...string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldRoomID, vs...))
+}
+
+// RoomIDGT applies the GT predicate on the "room_id" field.
+func RoomIDGT(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGT(FieldRoomID, v))
+}
+
+// RoomIDGTE applies the GTE predicate on the "room_id" field.
+func RoomIDGTE(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGTE(FieldRoomID, v))
+}
+
+// RoomIDLT applies the LT predicate on the "room_id" field.
+func RoomIDLT(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLT(FieldRoomID, v))
+}
+
+// RoomIDLTE applies the LTE predicate on the "room_id" field.
+func RoomIDLTE(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLTE(FieldRoomID, v))
+}
+
+// RoomIDContains applies the Contains predicate on the "room_id" field.
+func RoomIDContains(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldContains(FieldRoomID, v))
+}
+
+// RoomIDHasPrefix applies the HasPrefix predicate on the "room_id" field.
+func RoomIDHasPrefix(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldHasPrefix(FieldRoomID, v))
+}
+
+// RoomIDHasSuffix applies the HasSuffix predicate on the "room_id" field.
+func RoomIDHasSuffix(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldHasSuffix(FieldRoomID, v))
+}
+
+// RoomIDEqualFold applies the EqualFold predicate on the "room_id" field.
+func RoomIDEqualFold(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEqualFold(FieldRoomID, v))
+}
+
+// RoomIDContainsFold applies the ContainsFold predicate on the "room_id" field.
+func RoomIDContainsFold(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldContainsFold(FieldRoomID, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v int) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v int) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...int) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...int) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// PinnedByEQ applies the EQ predicate on the "pinned_by" field.
+func PinnedByEQ(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldPinnedBy, v))
+}
+
+// PinnedByNEQ applies the NEQ predicate on the "pinned_by" field.
+func PinnedByNEQ(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldPinnedBy, v))
+}
+
+// PinnedByIn applies the In predicate on the "pinned_by" field.
+func PinnedByIn(vs ...string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldPinnedBy, vs...))
+}
+
+// PinnedByNotIn applies the NotIn predicate on the "pinned_by" field.
+func PinnedByNotIn(vs ...string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldPinnedBy, vs...))
+}
+
+// PinnedByGT applies the GT predicate on the "pinned_by" field.
+func PinnedByGT(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGT(FieldPinnedBy, v))
+}
+
+// PinnedByGTE applies the GTE predicate on the "pinned_by" field.
+func PinnedByGTE(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGTE(FieldPinnedBy, v))
+}
+
+// PinnedByLT applies the LT predicate on the "pinned_by" field.
+func PinnedByLT(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLT(FieldPinnedBy, v))
+}
+
+// PinnedByLTE applies the LTE predicate on the "pinned_by" field.
+func PinnedByLTE(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLTE(FieldPinnedBy, v))
+}
+
+// PinnedByContains applies the Contains predicate on the "pinned_by" field.
+func PinnedByContains(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldContains(FieldPinnedBy, v))
+}
+
+// PinnedByHasPrefix applies the HasPrefix predicate on the "pinned_by" field.
+func PinnedByHasPrefix(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldHasPrefix(FieldPinnedBy, v))
+}
+
+// PinnedByHasSuffix applies the HasSuffix predicate on the "pinned_by" field.
+func PinnedByHasSuffix(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldHasSuffix(FieldPinnedBy, v))
+}
+
+// PinnedByEqualFold applies the EqualFold predicate on the "pinned_by" field.
+func PinnedByEqualFold(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEqualFold(FieldPinnedBy, v))
+}
+
+// PinnedByContainsFold applies the ContainsFold predicate on the "pinned_by" field.
+func PinnedByContainsFold(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldContainsFold(FieldPinnedBy, v))
+}
+
+// PinnedAtEQ applies the EQ predicate on the "pinned_at" field.
+func PinnedAtEQ(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldPinnedAt, v))
+}
+
+// PinnedAtNEQ applies the NEQ predicate on the "pinned_at" field.
+func PinnedAtNEQ(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldPinnedAt, v))
+}
+
+// PinnedAtIn applies the In predicate on the "pinned_at" field.
+func PinnedAtIn(vs ...time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldPinnedAt, vs...))
+}
+
+// PinnedAtNotIn applies the NotIn predicate on the "pinned_at" field.
+func PinnedAtNotIn(vs ...time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldPinnedAt, vs...))
+}
+
+// PinnedAtGT applies the GT predicate on the "pinned_at" field.
+func PinnedAtGT(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGT(FieldPinnedAt, v))
+}
+
+// PinnedAtGTE applies the GTE predicate on the "pinned_at" field.
+func PinnedAtGTE(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGTE(FieldPinnedAt, v))
+}
+
+// PinnedAtLT applies the LT predicate on the "pinned_at" field.
+func PinnedAtLT(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLT(FieldPinnedAt, v))
+}
+
+// PinnedAtLTE applies the LTE predicate on the "pinned_at" field.
+func PinnedAtLTE(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLTE(FieldPinnedAt, v))
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.PinnedMessage {
+	return predicate.PinnedMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.Message) predicate.PinnedMessage {
+	return predicate.PinnedMessage(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PinnedMessage) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PinnedMessage) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PinnedMessage) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pinnedmessage"
+)
+
+// PinnedMessageCreate is the builder for creating a PinnedMessage entity.
+type PinnedMessageCreate struct {
+	config
+	mutation *PinnedMessageMutation
+	hooks    []Hook
+}
+
+// SetRoomID sets the "room_id" field.
+func (pmc *PinnedMessageCreate) SetRoomID(s string) *PinnedMessageCreate {
+	pmc.mutation.SetRoomID(s)
+	return pmc
+}
+
+// SetMessageID sets the "message_id" field.
+func (pmc *PinnedMessageCreate) SetMessageID(i int) *PinnedMessageCreate {
+	pmc.mutation.SetMessageID(i)
+	return pmc
+}
+
+// SetPinnedBy sets the "pinned_by" field.
+func (pmc *PinnedMessageCreate) SetPinnedBy(s string) *PinnedMessageCreate {
+	pmc.mutation.SetPinnedBy(s)
+	return pmc
+}
+
+// SetPinnedAt sets the "pinned_at" field.
+func (pmc *PinnedMessageCreate) SetPinnedAt(t time.Time) *PinnedMessageCreate {
+	pmc.mutation.SetPinnedAt(t)
+	return pmc
+}
+
+// SetNillablePinnedAt sets the "pinned_at" field if the given value is not nil.
+func (pmc *PinnedMessageCreate) SetNillablePinnedAt(t *time.Time) *PinnedMessageCreate {
+	if t != nil {
+		pmc.SetPinnedAt(*t)
+	}
+	return pmc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (pmc *PinnedMessageCreate) SetMessage(m *Message) *PinnedMessageCreate {
+	return pmc.SetMessageID(m.ID)
+}
+
+// Mutation returns the PinnedMessageMutation object of the builder.
+func (pmc *PinnedMessageCreate) Mutation() *PinnedMessageMutation {
+	return pmc.mutation
+}
+
+// Save creates the PinnedMessage in the database.
+func (pmc *PinnedMessageCreate) Save(ctx context.Context) (*PinnedMessage, error) {
+	pmc.defaults()
+	return withHooks(ctx, pmc.sqlSave, pmc.mutation, pmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pmc *PinnedMessageCreate) SaveX(ctx context.Context) *PinnedMessage {
+	v, err := pmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmc *PinnedMessageCreate) Exec(ctx context.Context) error {
+	_, err := pmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmc *PinnedMessageCreate) ExecX(ctx context.Context) {
+	if err := pmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pmc *PinnedMessageCreate) defaults() {
+	if _, ok := pmc.mutation.PinnedAt(); !ok {
+		v := pinnedmessage.DefaultPinnedAt()
+		pmc.mutation.SetPinnedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmc *PinnedMessageCreate) check() error {
+	if _, ok := pmc.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room_id", err: errors.New(`ent: missing required field "PinnedMessage.room_id"`)}
+	}
+	if v, ok := pmc.mutation.RoomID(); ok {
+		if err := pinnedmessage.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.room_id": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "PinnedMessage.message_id"`)}
+	}
+	if _, ok := pmc.mutation.PinnedBy(); !ok {
+		return &ValidationError{Name: "pinned_by", err: errors.New(`ent: missing required field "PinnedMessage.pinned_by"`)}
+	}
+	if v, ok := pmc.mutation.PinnedBy(); ok {
+		if err := pinnedmessage.PinnedByValidator(v); err != nil {
+			return &ValidationError{Name: "pinned_by", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.pinned_by": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.PinnedAt(); !ok {
+		return &ValidationError{Name: "pinned_at", err: errors.New(`ent: missing required field "PinnedMessage.pinned_at"`)}
+	}
+	if len(pmc.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "PinnedMessage.message"`)}
+	}
+	return nil
+}
+
+func (pmc *PinnedMessageCreate) sqlSave(ctx context.Context) (*PinnedMessage, error) {
+	if err := pmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pmc.mutation.id = &_node.ID
+	pmc.mutation.done = true
+	return _node, nil
+}
+
+func (pmc *PinnedMessageCreate) createSpec() (*PinnedMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PinnedMessage{config: pmc.config}
+		_spec = sqlgraph.NewCreateSpec(pinnedmessage.Table, sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeInt))
+	)
+	if value, ok := pmc.mutation.RoomID(); ok {
+		_spec.SetField(pinnedmessage.FieldRoomID, field.TypeString, value)
+		_node.RoomID = value
+	}
+	if value, ok := pmc.mutation.PinnedBy(); ok {
+		_spec.SetField(pinnedmessage.FieldPinnedBy, field.TypeString, value)
+		_node.PinnedBy = value
+	}
+	if value, ok := pmc.mutation.PinnedAt(); ok {
+		_spec.SetField(pinnedmessage.FieldPinnedAt, field.TypeTime, value)
+		_node.PinnedAt = value
+	}
+	if nodes := pmc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PinnedMessageCreateBulk is the builder for creating many PinnedMessage entities in bulk.
+type PinnedMessageCreateBulk struct {
+	config
+	err      error
+	builders []*PinnedMessageCreate
+}
+
+// Save creates the PinnedMessage entities in the database.
+func (pmcb *PinnedMessageCreateBulk) Save(ctx context.Context) ([]*PinnedMessage, error) {
+	if pmcb.err != nil {
+		return nil, pmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pmcb.builders))
+	nodes := make([]*PinnedMessage, len(pmcb.builders))
+	mutators := make([]Mutator, len(pmcb.builders))
+	for i := range pmcb.builders {
+		func(i int, root context.Context) {
+			builder := pmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PinnedMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmcb *PinnedMessageCreateBulk) SaveX(ctx context.Context) []*PinnedMessage {
+	v, err := pmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmcb *PinnedMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := pmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmcb *PinnedMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := pmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
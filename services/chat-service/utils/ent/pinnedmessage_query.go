@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pinnedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// PinnedMessageQuery is the builder for querying PinnedMessage entities.
+type PinnedMessageQuery struct {
+	config
+	ctx         *QueryContext
+	order       []pinnedmessage.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.PinnedMessage
+	withMessage *MessageQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PinnedMessageQuery builder.
+func (pmq *PinnedMessageQuery) Where(ps ...predicate.PinnedMessage) *PinnedMessageQuery {
+	pmq.predicates = append(pmq.predicates, ps...)
+	return pmq
+}
+
+// Limit the number of records to be returned by this query.
+func (pmq *PinnedMessageQuery) Limit(limit int) *PinnedMessageQuery {
+	pmq.ctx.Limit = &limit
+	return pmq
+}
+
+// Offset to start from.
+func (pmq *PinnedMessageQuery) Offset(offset int) *PinnedMessageQuery {
+	pmq.ctx.Offset = &offset
+	return pmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pmq *PinnedMessageQuery) Unique(unique bool) *PinnedMessageQuery {
+	pmq.ctx.Unique = &unique
+	return pmq
+}
+
+// Order specifies how the records should be ordered.
+func (pmq *PinnedMessageQuery) Order(o ...pinnedmessage.OrderOption) *PinnedMessageQuery {
+	pmq.order = append(pmq.order, o...)
+	return pmq
+}
+
+// QueryMessage chains the current query on the "message" edge.
+func (pmq *PinnedMessageQuery) QueryMessage() *MessageQuery {
+	query := (&MessageClient{config: pmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pinnedmessage.Table, pinnedmessage.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pinnedmessage.MessageTable, pinnedmessage.MessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PinnedMessage entity from the query.
+// Returns a *NotFoundError when no PinnedMessage was found.
+func (pmq *PinnedMessageQuery) First(ctx context.Context) (*PinnedMessage, error) {
+	nodes, err := pmq.Limit(1).All(setContextOp(ctx, pmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pinnedmessage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pmq *PinnedMessageQuery) FirstX(ctx context.Context) *PinnedMessage {
+	node, err := pmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PinnedMessage ID from the query.
+// Returns a *NotFoundError when no PinnedMessage ID was found.
+func (pmq *PinnedMessageQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pmq.Limit(1).IDs(setContextOp(ctx, pmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pinnedmessage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pmq *PinnedMessageQuery) FirstIDX(ctx context.Context) int {
+	id, err := pmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PinnedMessage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PinnedMessage entity is found.
+// Returns a *NotFoundError when no PinnedMessage entities are found.
+func (pmq *PinnedMessageQuery) Only(ctx context.Context) (*PinnedMessage, error) {
+	nodes, err := pmq.Limit(2).All(setContextOp(ctx, pmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pinnedmessage.Label}
+	default:
+		return nil, &NotSingularError{pinnedmessage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pmq *PinnedMessageQuery) OnlyX(ctx context.Context) *PinnedMessage {
+	node, err := pmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PinnedMessage ID in the query.
+// Returns a *NotSingularError when more than one PinnedMessage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pmq *PinnedMessageQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pmq.Limit(2).IDs(setContextOp(ctx, pmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pinnedmessage.Label}
+	default:
+		err = &NotSingularError{pinnedmessage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pmq *PinnedMessageQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PinnedMessages.
+func (pmq *PinnedMessageQuery) All(ctx context.Context) ([]*PinnedMessage, error) {
+	ctx = setContextOp(ctx, pmq.ctx, ent.OpQueryAll)
+	if err := pmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PinnedMessage, *PinnedMessageQuery]()
+	return withInterceptors[[]*PinnedMessage](ctx, pmq, qr, pmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pmq *PinnedMessageQuery) AllX(ctx context.Context) []*PinnedMessage {
+	nodes, err := pmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PinnedMessage IDs.
+func (pmq *PinnedMessageQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pmq.ctx.Unique == nil && pmq.path != nil {
+		pmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pmq.ctx, ent.OpQueryIDs)
+	if err = pmq.Select(pinnedmessage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pmq *PinnedMessageQuery) IDsX(ctx context.Context) []int {
+	ids, err := pmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pmq *PinnedMessageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pmq.ctx, ent.OpQueryCount)
+	if err := pmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pmq, querierCount[*PinnedMessageQuery](), pmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pmq *PinnedMessageQuery) CountX(ctx context.Context) int {
+	count, err := pmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pmq *PinnedMessageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pmq.ctx, ent.OpQueryExist)
+	switch _, err := pmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pmq *PinnedMessageQuery) ExistX(ctx context.Context) bool {
+	exist, err := pmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PinnedMessageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pmq *PinnedMessageQuery) Clone() *PinnedMessageQuery {
+	if pmq == nil {
+		return nil
+	}
+	return &PinnedMessageQuery{
+		config:      pmq.config,
+		ctx:         pmq.ctx.Clone(),
+		order:       append([]pinnedmessage.OrderOption{}, pmq.order...),
+		inters:      append([]Interceptor{}, pmq.inters...),
+		predicates:  append([]predicate.PinnedMessage{}, pmq.predicates...),
+		withMessage: pmq.withMessage.Clone(),
+		// clone intermediate query.
+		sql:  pmq.sql.Clone(),
+		path: pmq.path,
+	}
+}
+
+// WithMessage tells the query-builder to eager-load the nodes that are connected to
+// the "message" edge. The optional arguments are used to configure the query builder of the edge.
+func (pmq *PinnedMessageQuery) WithMessage(opts ...func(*MessageQuery)) *PinnedMessageQuery {
+	query := (&MessageClient{config: pmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pmq.withMessage = query
+	return pmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		RoomID string `json:"room_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PinnedMessage.Query().
+//		GroupBy(pinnedmessage.FieldRoomID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pmq *PinnedMessageQuery) GroupBy(field string, fields ...string) *PinnedMessageGroupBy {
+	pmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PinnedMessageGroupBy{build: pmq}
+	grbuild.flds = &pmq.ctx.Fields
+	grbuild.label = pinnedmessage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		RoomID string `json:"room_id,omitempty"`
+//	}
+//
+//	client.PinnedMessage.Query().
+//		Select(pinnedmessage.FieldRoomID).
+//		Scan(ctx, &v)
+func (pmq *PinnedMessageQuery) Select(fields ...string) *PinnedMessageSelect {
+	pmq.ctx.Fields = append(pmq.ctx.Fields, fields...)
+	sbuild := &PinnedMessageSelect{PinnedMessageQuery: pmq}
+	sbuild.label = pinnedmessage.Label
+	sbuild.flds, sbuild.scan = &pmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PinnedMessageSelect configured with the given aggregations.
+func (pmq *PinnedMessageQuery) Aggregate(fns ...AggregateFunc) *PinnedMessageSelect {
+	return pmq.Select().Aggregate(fns...)
+}
+
+func (pmq *PinnedMessageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pmq.ctx.Fields {
+		if !pinnedmessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pmq.path != nil {
+		prev, err := pmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pmq.sql = prev
+	}
+	return nil
+}
+
+func (pmq *PinnedMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PinnedMessage, error) {
+	var (
+		nodes       = []*PinnedMessage{}
+		_spec       = pmq.querySpec()
+		loadedTypes = [1]bool{
+			pmq.withMessage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PinnedMessage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PinnedMessage{config: pmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pmq.withMessage; query != nil {
+		if err := pmq.loadMessage(ctx, query, nodes, nil,
+			func(n *PinnedMessage, e *Message) { n.Edges.Message = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pmq *PinnedMessageQuery) loadMessage(ctx context.Context, query *MessageQuery, nodes []*PinnedMessage, init func(*PinnedMessage), assign func(*PinnedMessage, *Message)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PinnedMessage)
+	for i := range nodes {
+		fk := nodes[i].MessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pmq *PinnedMessageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pmq.querySpec()
+	_spec.Node.Columns = pmq.ctx.Fields
+	if len(pmq.ctx.Fields) > 0 {
+		_spec.Unique = pmq.ctx.Unique != nil && *pmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pmq.driver, _spec)
+}
+
+func (pmq *PinnedMessageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(pinnedmessage.Table, pinnedmessage.Columns, sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeInt))
+	_spec.From = pmq.sql
+	if unique := pmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pinnedmessage.FieldID)
+		for i := range fields {
+			if fields[i] != pinnedmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if pmq.withMessage != nil {
+			_spec.Node.AddColumnOnce(pinnedmessage.FieldMessageID)
+		}
+	}
+	if ps := pmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pmq *PinnedMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pmq.driver.Dialect())
+	t1 := builder.Table(pinnedmessage.Table)
+	columns := pmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = pinnedmessage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pmq.sql != nil {
+		selector = pmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pmq.ctx.Unique != nil && *pmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pmq.predicates {
+		p(selector)
+	}
+	for _, p := range pmq.order {
+		p(selector)
+	}
+	if offset := pmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PinnedMessageGroupBy is the group-by builder for PinnedMessage entities.
+type PinnedMessageGroupBy struct {
+	selector
+	build *PinnedMessageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pmgb *PinnedMessageGroupBy) Aggregate(fns ...AggregateFunc) *PinnedMessageGroupBy {
+	pmgb.fns = append(pmgb.fns, fns...)
+	return pmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pmgb *PinnedMessageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PinnedMessageQuery, *PinnedMessageGroupBy](ctx, pmgb.build, pmgb, pmgb.build.inters, v)
+}
+
+func (pmgb *PinnedMessageGroupBy) sqlScan(ctx context.Context, root *PinnedMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pmgb.fns))
+	for _, fn := range pmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pmgb.flds)+len(pmgb.fns))
+		for _, f := range *pmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PinnedMessageSelect is the builder for selecting fields of PinnedMessage entities.
+type PinnedMessageSelect struct {
+	*PinnedMessageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pms *PinnedMessageSelect) Aggregate(fns ...AggregateFunc) *PinnedMessageSelect {
+	pms.fns = append(pms.fns, fns...)
+	return pms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pms *PinnedMessageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pms.ctx, ent.OpQuerySelect)
+	if err := pms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PinnedMessageQuery, *PinnedMessageSelect](ctx, pms.PinnedMessageQuery, pms, pms.inters, v)
+}
+
+func (pms *PinnedMessageSelect) sqlScan(ctx context.Context, root *PinnedMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pms.fns))
+	for _, fn := range pms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pinnedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// PinnedMessageUpdate is the builder for updating PinnedMessage entities.
+type PinnedMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PinnedMessageMutation
+}
+
+// Where appends a list predicates to the PinnedMessageUpdate builder.
+func (pmu *PinnedMessageUpdate) Where(ps ...predicate.PinnedMessage) *PinnedMessageUpdate {
+	pmu.mutation.Where(ps...)
+	return pmu
+}
+
+// SetRoomID sets the "room_id" field.
+func (pmu *PinnedMessageUpdate) SetRoomID(s string) *PinnedMessageUpdate {
+	pmu.mutation.SetRoomID(s)
+	return pmu
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (pmu *PinnedMessageUpdate) SetNillableRoomID(s *string) *PinnedMessageUpdate {
+	if s != nil {
+		pmu.SetRoomID(*s)
+	}
+	return pmu
+}
+
+// SetMessageID sets the "message_id" field.
+func (pmu *PinnedMessageUpdate) SetMessageID(i int) *PinnedMessageUpdate {
+	pmu.mutation.SetMessageID(i)
+	return pmu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (pmu *PinnedMessageUpdate) SetNillableMessageID(i *int) *PinnedMessageUpdate {
+	if i != nil {
+		pmu.SetMessageID(*i)
+	}
+	return pmu
+}
+
+// SetPinnedBy sets the "pinned_by" field.
+func (pmu *PinnedMessageUpdate) SetPinnedBy(s string) *PinnedMessageUpdate {
+	pmu.mutation.SetPinnedBy(s)
+	return pmu
+}
+
+// SetNillablePinnedBy sets the "pinned_by" field if the given value is not nil.
+func (pmu *PinnedMessageUpdate) SetNillablePinnedBy(s *string) *PinnedMessageUpdate {
+	if s != nil {
+		pmu.SetPinnedBy(*s)
+	}
+	return pmu
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (pmu *PinnedMessageUpdate) SetMessage(m *Message) *PinnedMessageUpdate {
+	return pmu.SetMessageID(m.ID)
+}
+
+// Mutation returns the PinnedMessageMutation object of the builder.
+func (pmu *PinnedMessageUpdate) Mutation() *PinnedMessageMutation {
+	return pmu.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (pmu *PinnedMessageUpdate) ClearMessage() *PinnedMessageUpdate {
+	pmu.mutation.ClearMessage()
+	return pmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pmu *PinnedMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pmu.sqlSave, pmu.mutation, pmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmu *PinnedMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := pmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pmu *PinnedMessageUpdate) Exec(ctx context.Context) error {
+	_, err := pmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmu *PinnedMessageUpdate) ExecX(ctx context.Context) {
+	if err := pmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmu *PinnedMessageUpdate) check() error {
+	if v, ok := pmu.mutation.RoomID(); ok {
+		if err := pinnedmessage.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.room_id": %w`, err)}
+		}
+	}
+	if v, ok := pmu.mutation.PinnedBy(); ok {
+		if err := pinnedmessage.PinnedByValidator(v); err != nil {
+			return &ValidationError{Name: "pinned_by", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.pinned_by": %w`, err)}
+		}
+	}
+	if pmu.mutation.MessageCleared() && len(pmu.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedMessage.message"`)
+	}
+	return nil
+}
+
+func (pmu *PinnedMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pinnedmessage.Table, pinnedmessage.Columns, sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeInt))
+	if ps := pmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmu.mutation.RoomID(); ok {
+		_spec.SetField(pinnedmessage.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := pmu.mutation.PinnedBy(); ok {
+		_spec.SetField(pinnedmessage.FieldPinnedBy, field.TypeString, value)
+	}
+	if pmu.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmu.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pinnedmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pmu.mutation.done = true
+	return n, nil
+}
+
+// PinnedMessageUpdateOne is the builder for updating a single PinnedMessage entity.
+type PinnedMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PinnedMessageMutation
+}
+
+// SetRoomID sets the "room_id" field.
+func (pmuo *PinnedMessageUpdateOne) SetRoomID(s string) *PinnedMessageUpdateOne {
+	pmuo.mutation.SetRoomID(s)
+	return pmuo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (pmuo *PinnedMessageUpdateOne) SetNillableRoomID(s *string) *PinnedMessageUpdateOne {
+	if s != nil {
+		pmuo.SetRoomID(*s)
+	}
+	return pmuo
+}
+
+// SetMessageID sets the "message_id" field.
+func (pmuo *PinnedMessageUpdateOne) SetMessageID(i int) *PinnedMessageUpdateOne {
+	pmuo.mutation.SetMessageID(i)
+	return pmuo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (pmuo *PinnedMessageUpdateOne) SetNillableMessageID(i *int) *PinnedMessageUpdateOne {
+	if i != nil {
+		pmuo.SetMessageID(*i)
+	}
+	return pmuo
+}
+
+// SetPinnedBy sets the "pinned_by" field.
+func (pmuo *PinnedMessageUpdateOne) SetPinnedBy(s string) *PinnedMessageUpdateOne {
+	pmuo.mutation.SetPinnedBy(s)
+	return pmuo
+}
+
+// SetNillablePinnedBy sets the "pinned_by" field if the given value is not nil.
+func (pmuo *PinnedMessageUpdateOne) SetNillablePinnedBy(s *string) *PinnedMessageUpdateOne {
+	if s != nil {
+		pmuo.SetPinnedBy(*s)
+	}
+	return pmuo
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (pmuo *PinnedMessageUpdateOne) SetMessage(m *Message) *PinnedMessageUpdateOne {
+	return pmuo.SetMessageID(m.ID)
+}
+
+// Mutation returns the PinnedMessageMutation object of the builder.
+func (pmuo *PinnedMessageUpdateOne) Mutation() *PinnedMessageMutation {
+	return pmuo.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (pmuo *PinnedMessageUpdateOne) ClearMessage() *PinnedMessageUpdateOne {
+	pmuo.mutation.ClearMessage()
+	return pmuo
+}
+
+// Where appends a list predicates to the PinnedMessageUpdate builder.
+func (pmuo *PinnedMessageUpdateOne) Where(ps ...predicate.PinnedMessage) *PinnedMessageUpdateOne {
+	pmuo.mutation.Where(ps...)
+	return pmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pmuo *PinnedMessageUpdateOne) Select(field string, fields ...string) *PinnedMessageUpdateOne {
+	pmuo.fields = append([]string{field}, fields...)
+	return pmuo
+}
+
+// Save executes the query and returns the updated PinnedMessage entity.
+func (pmuo *PinnedMessageUpdateOne) Save(ctx context.Context) (*PinnedMessage, error) {
+	return withHooks(ctx, pmuo.sqlSave, pmuo.mutation, pmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmuo *PinnedMessageUpdateOne) SaveX(ctx context.Context) *PinnedMessage {
+	node, err := pmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pmuo *PinnedMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := pmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmuo *PinnedMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := pmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmuo *PinnedMessageUpdateOne) check() error {
+	if v, ok := pmuo.mutation.RoomID(); ok {
+		if err := pinnedmessage.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.room_id": %w`, err)}
+		}
+	}
+	if v, ok := pmuo.mutation.PinnedBy(); ok {
+		if err := pinnedmessage.PinnedByValidator(v); err != nil {
+			return &ValidationError{Name: "pinned_by", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.pinned_by": %w`, err)}
+		}
+	}
+	if pmuo.mutation.MessageCleared() && len(pmuo.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedMessage.message"`)
+	}
+	return nil
+}
+
+func (pmuo *PinnedMessageUpdateOne) sqlSave(ctx context.Context) (_node *PinnedMessage, err error) {
+	if err := pmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pinnedmessage.Table, pinnedmessage.Columns, sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeInt))
+	id, ok := pmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PinnedMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pinnedmessage.FieldID)
+		for _, f := range fields {
+			if !pinnedmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pinnedmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmuo.mutation.RoomID(); ok {
+		_spec.SetField(pinnedmessage.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := pmuo.mutation.PinnedBy(); ok {
+		_spec.SetField(pinnedmessage.FieldPinnedBy, field.TypeString, value)
+	}
+	if pmuo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmuo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PinnedMessage{config: pmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pinnedmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pmuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
+)
+
+// Poll is the model entity for the Poll schema.
+type Poll struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// RoomID holds the value of the "room_id" field.
+	RoomID string `json:"room_id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID int `json:"message_id,omitempty"`
+	// Question holds the value of the "question" field.
+	Question string `json:"question,omitempty"`
+	// Options holds the value of the "options" field.
+	Options []string `json:"options,omitempty"`
+	// Multiple holds the value of the "multiple" field.
+	Multiple bool `json:"multiple,omitempty"`
+	// Anonymous holds the value of the "anonymous" field.
+	Anonymous bool `json:"anonymous,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// ClosesAt holds the value of the "closes_at" field.
+	ClosesAt *time.Time `json:"closes_at,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// SummaryMessageID holds the value of the "summary_message_id" field.
+	SummaryMessageID *int `json:"summary_message_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PollQuery when eager-loading is set.
+	Edges        PollEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PollEdges holds the relations/edges for other nodes in the graph.
+type PollEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// Votes holds the value of the votes edge.
+	Votes []*PollVote `json:"votes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PollEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// VotesOrErr returns the Votes value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) VotesOrErr() ([]*PollVote, error) {
+	if e.loadedTypes[1] {
+		return e.Votes, nil
+	}
+	return nil, &NotLoadedError{edge: "votes"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Poll) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case poll.FieldOptions:
+			values[i] = new([]byte)
+		case poll.FieldMultiple, poll.FieldAnonymous:
+			values[i] = new(sql.NullBool)
+		case poll.FieldID, poll.FieldMessageID, poll.FieldSummaryMessageID:
+			values[i] = new(sql.NullInt64)
+		case poll.FieldRoomID, poll.FieldQuestion, poll.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case poll.FieldClosesAt, poll.FieldClosedAt, poll.FieldCreatedAt, poll.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Poll fields.
+func (po *Poll) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case poll.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			po.ID = int(value.Int64)
+		case poll.FieldRoomID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value.Valid {
+				po.RoomID = value.String
+			}
+		case poll.FieldMessageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				po.MessageID = int(value.Int64)
+			}
+		case poll.FieldQuestion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field question", values[i])
+			} else if value.Valid {
+				po.Question = value.String
+			}
+		case poll.FieldOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &po.Options); err != nil {
+					return fmt.Errorf("unmarshal field options: %w", err)
+				}
+			}
+		case poll.FieldMultiple:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field multiple", values[i])
+			} else if value.Valid {
+				po.Multiple = value.Bool
+			}
+		case poll.FieldAnonymous:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field anonymous", values[i])
+			} else if value.Valid {
+				po.Anonymous = value.Bool
+			}
+		case poll.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				po.CreatedBy = value.String
+			}
+		case poll.FieldClosesAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closes_at", values[i])
+			} else if value.Valid {
+				po.ClosesAt = new(time.Time)
+				*po.ClosesAt = value.Time
+			}
+		case poll.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				po.ClosedAt = new(time.Time)
+				*po.ClosedAt = value.Time
+			}
+		case poll.FieldSummaryMessageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field summary_message_id", values[i])
+			} else if value.Valid {
+				po.SummaryMessageID = new(int)
+				*po.SummaryMessageID = int(value.Int64)
+			}
+		case poll.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				po.CreatedAt = value.Time
+			}
+		case poll.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				po.UpdatedAt = value.Time
+			}
+		default:
+			po.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Poll.
+// This includes values selected through modifiers, order, etc.
+func (po *Poll) Value(name string) (ent.Value, error) {
+	return po.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the Poll entity.
+func (po *Poll) QueryMessage() *MessageQuery {
+	return NewPollClient(po.config).QueryMessage(po)
+}
+
+// QueryVotes queries the "votes" edge of the Poll entity.
+func (po *Poll) QueryVotes() *PollVoteQuery {
+	return NewPollClient(po.config).QueryVotes(po)
+}
+
+// Update returns a builder for updating this Poll.
+// Note that you need to call Poll.Unwrap() before calling this method if this Poll
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (po *Poll) Update() *PollUpdateOne {
+	return NewPollClient(po.config).UpdateOne(po)
+}
+
+// Unwrap unwraps the Poll entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (po *Poll) Unwrap() *Poll {
+	_tx, ok := po.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Poll is not a transactional entity")
+	}
+	po.config.driver = _tx.drv
+	return po
+}
+
+// String implements the fmt.Stringer.
+func (po *Poll) String() string {
+	var builder strings.Builder
+	builder.WriteString("Poll(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", po.ID))
+	builder.WriteString("room_id=")
+	builder.WriteString(po.RoomID)
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", po.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("question=")
+	builder.WriteString(po.Question)
+	builder.WriteString(", ")
+	builder.WriteString("options=")
+	builder.WriteString(fmt.Sprintf("%v", po.Options))
+	builder.WriteString(", ")
+	builder.WriteString("multiple=")
+	builder.WriteString(fmt.Sprintf("%v", po.Multiple))
+	builder.WriteString(", ")
+	builder.WriteString("anonymous=")
+	builder.WriteString(fmt.Sprintf("%v", po.Anonymous))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(po.CreatedBy)
+	builder.WriteString(", ")
+	if v := po.ClosesAt; v != nil {
+		builder.WriteString("closes_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := po.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := po.SummaryMessageID; v != nil {
+		builder.WriteString("summary_message_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(po.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(po.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Polls is a parsable slice of Poll.
+type Polls []*Poll
@@ -0,0 +1,465 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pollvote"
+)
+
+// PollCreate is the builder for creating a Poll entity.
+type PollCreate struct {
+	config
+	mutation *PollMutation
+	hooks    []Hook
+}
+
+// SetRoomID sets the "room_id" field.
+func (pc *PollCreate) SetRoomID(s string) *PollCreate {
+	pc.mutation.SetRoomID(s)
+	return pc
+}
+
+// SetMessageID sets the "message_id" field.
+func (pc *PollCreate) SetMessageID(i int) *PollCreate {
+	pc.mutation.SetMessageID(i)
+	return pc
+}
+
+// SetQuestion sets the "question" field.
+func (pc *PollCreate) SetQuestion(s string) *PollCreate {
+	pc.mutation.SetQuestion(s)
+	return pc
+}
+
+// SetOptions sets the "options" field.
+func (pc *PollCreate) SetOptions(s []string) *PollCreate {
+	pc.mutation.SetOptions(s)
+	return pc
+}
+
+// SetMultiple sets the "multiple" field.
+func (pc *PollCreate) SetMultiple(b bool) *PollCreate {
+	pc.mutation.SetMultiple(b)
+	return pc
+}
+
+// SetNillableMultiple sets the "multiple" field if the given value is not nil.
+func (pc *PollCreate) SetNillableMultiple(b *bool) *PollCreate {
+	if b != nil {
+		pc.SetMultiple(*b)
+	}
+	return pc
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (pc *PollCreate) SetAnonymous(b bool) *PollCreate {
+	pc.mutation.SetAnonymous(b)
+	return pc
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (pc *PollCreate) SetNillableAnonymous(b *bool) *PollCreate {
+	if b != nil {
+		pc.SetAnonymous(*b)
+	}
+	return pc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pc *PollCreate) SetCreatedBy(s string) *PollCreate {
+	pc.mutation.SetCreatedBy(s)
+	return pc
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (pc *PollCreate) SetClosesAt(t time.Time) *PollCreate {
+	pc.mutation.SetClosesAt(t)
+	return pc
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (pc *PollCreate) SetNillableClosesAt(t *time.Time) *PollCreate {
+	if t != nil {
+		pc.SetClosesAt(*t)
+	}
+	return pc
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (pc *PollCreate) SetClosedAt(t time.Time) *PollCreate {
+	pc.mutation.SetClosedAt(t)
+	return pc
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (pc *PollCreate) SetNillableClosedAt(t *time.Time) *PollCreate {
+	if t != nil {
+		pc.SetClosedAt(*t)
+	}
+	return pc
+}
+
+// SetSummaryMessageID sets the "summary_message_id" field.
+func (pc *PollCreate) SetSummaryMessageID(i int) *PollCreate {
+	pc.mutation.SetSummaryMessageID(i)
+	return pc
+}
+
+// SetNillableSummaryMessageID sets the "summary_message_id" field if the given value is not nil.
+func (pc *PollCreate) SetNillableSummaryMessageID(i *int) *PollCreate {
+	if i != nil {
+		pc.SetSummaryMessageID(*i)
+	}
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PollCreate) SetCreatedAt(t time.Time) *PollCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PollCreate) SetNillableCreatedAt(t *time.Time) *PollCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *PollCreate) SetUpdatedAt(t time.Time) *PollCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *PollCreate) SetNillableUpdatedAt(t *time.Time) *PollCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (pc *PollCreate) SetMessage(m *Message) *PollCreate {
+	return pc.SetMessageID(m.ID)
+}
+
+// AddVoteIDs adds the "votes" edge to the PollVote entity by IDs.
+func (pc *PollCreate) AddVoteIDs(ids ...int) *PollCreate {
+	pc.mutation.AddVoteIDs(ids...)
+	return pc
+}
+
+// AddVotes adds the "votes" edges to the PollVote entity.
+func (pc *PollCreate) AddVotes(p ...*PollVote) *PollCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddVoteIDs(ids...)
+}
+
+// Mutation returns the PollMutation object of the builder.
+func (pc *PollCreate) Mutation() *PollMutation {
+	return pc.mutation
+}
+
+// Save creates the Poll in the database.
+func (pc *PollCreate) Save(ctx context.Context) (*Poll, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PollCreate) SaveX(ctx context.Context) *Poll {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PollCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PollCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PollCreate) defaults() {
+	if _, ok := pc.mutation.Multiple(); !ok {
+		v := poll.DefaultMultiple
+		pc.mutation.SetMultiple(v)
+	}
+	if _, ok := pc.mutation.Anonymous(); !ok {
+		v := poll.DefaultAnonymous
+		pc.mutation.SetAnonymous(v)
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := poll.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := poll.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PollCreate) check() error {
+	if _, ok := pc.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room_id", err: errors.New(`ent: missing required field "Poll.room_id"`)}
+	}
+	if v, ok := pc.mutation.RoomID(); ok {
+		if err := poll.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "Poll.room_id": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "Poll.message_id"`)}
+	}
+	if _, ok := pc.mutation.Question(); !ok {
+		return &ValidationError{Name: "question", err: errors.New(`ent: missing required field "Poll.question"`)}
+	}
+	if v, ok := pc.mutation.Question(); ok {
+		if err := poll.QuestionValidator(v); err != nil {
+			return &ValidationError{Name: "question", err: fmt.Errorf(`ent: validator failed for field "Poll.question": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Options(); !ok {
+		return &ValidationError{Name: "options", err: errors.New(`ent: missing required field "Poll.options"`)}
+	}
+	if _, ok := pc.mutation.Multiple(); !ok {
+		return &ValidationError{Name: "multiple", err: errors.New(`ent: missing required field "Poll.multiple"`)}
+	}
+	if _, ok := pc.mutation.Anonymous(); !ok {
+		return &ValidationError{Name: "anonymous", err: errors.New(`ent: missing required field "Poll.anonymous"`)}
+	}
+	if _, ok := pc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Poll.created_by"`)}
+	}
+	if v, ok := pc.mutation.CreatedBy(); ok {
+		if err := poll.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Poll.created_by": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Poll.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Poll.updated_at"`)}
+	}
+	if len(pc.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "Poll.message"`)}
+	}
+	return nil
+}
+
+func (pc *PollCreate) sqlSave(ctx context.Context) (*Poll, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PollCreate) createSpec() (*Poll, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Poll{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(poll.Table, sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt))
+	)
+	if value, ok := pc.mutation.RoomID(); ok {
+		_spec.SetField(poll.FieldRoomID, field.TypeString, value)
+		_node.RoomID = value
+	}
+	if value, ok := pc.mutation.Question(); ok {
+		_spec.SetField(poll.FieldQuestion, field.TypeString, value)
+		_node.Question = value
+	}
+	if value, ok := pc.mutation.Options(); ok {
+		_spec.SetField(poll.FieldOptions, field.TypeJSON, value)
+		_node.Options = value
+	}
+	if value, ok := pc.mutation.Multiple(); ok {
+		_spec.SetField(poll.FieldMultiple, field.TypeBool, value)
+		_node.Multiple = value
+	}
+	if value, ok := pc.mutation.Anonymous(); ok {
+		_spec.SetField(poll.FieldAnonymous, field.TypeBool, value)
+		_node.Anonymous = value
+	}
+	if value, ok := pc.mutation.CreatedBy(); ok {
+		_spec.SetField(poll.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := pc.mutation.ClosesAt(); ok {
+		_spec.SetField(poll.FieldClosesAt, field.TypeTime, value)
+		_node.ClosesAt = &value
+	}
+	if value, ok := pc.mutation.ClosedAt(); ok {
+		_spec.SetField(poll.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if value, ok := pc.mutation.SummaryMessageID(); ok {
+		_spec.SetField(poll.FieldSummaryMessageID, field.TypeInt, value)
+		_node.SummaryMessageID = &value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(poll.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(poll.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   poll.MessageTable,
+			Columns: []string{poll.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PollCreateBulk is the builder for creating many Poll entities in bulk.
+type PollCreateBulk struct {
+	config
+	err      error
+	builders []*PollCreate
+}
+
+// Save creates the Poll entities in the database.
+func (pcb *PollCreateBulk) Save(ctx context.Context) ([]*Poll, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Poll, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PollMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PollCreateBulk) SaveX(ctx context.Context) []*Poll {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PollCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PollCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,923 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pollvote"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// PollUpdate is the builder for updating Poll entities.
+type PollUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PollMutation
+}
+
+// Where appends a list predicates to the PollUpdate builder.
+func (pu *PollUpdate) Where(ps ...predicate.Poll) *PollUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetRoomID sets the "room_id" field.
+func (pu *PollUpdate) SetRoomID(s string) *PollUpdate {
+	pu.mutation.SetRoomID(s)
+	return pu
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (pu *PollUpdate) SetNillableRoomID(s *string) *PollUpdate {
+	if s != nil {
+		pu.SetRoomID(*s)
+	}
+	return pu
+}
+
+// SetMessageID sets the "message_id" field.
+func (pu *PollUpdate) SetMessageID(i int) *PollUpdate {
+	pu.mutation.SetMessageID(i)
+	return pu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (pu *PollUpdate) SetNillableMessageID(i *int) *PollUpdate {
+	if i != nil {
+		pu.SetMessageID(*i)
+	}
+	return pu
+}
+
+// SetQuestion sets the "question" field.
+func (pu *PollUpdate) SetQuestion(s string) *PollUpdate {
+	pu.mutation.SetQuestion(s)
+	return pu
+}
+
+// SetNillableQuestion sets the "question" field if the given value is not nil.
+func (pu *PollUpdate) SetNillableQuestion(s *string) *PollUpdate {
+	if s != nil {
+		pu.SetQuestion(*s)
+	}
+	return pu
+}
+
+// SetOptions sets the "options" field.
+func (pu *PollUpdate) SetOptions(s []string) *PollUpdate {
+	pu.mutation.SetOptions(s)
+	return pu
+}
+
+// AppendOptions appends s to the "options" field.
+func (pu *PollUpdate) AppendOptions(s []string) *PollUpdate {
+	pu.mutation.AppendOptions(s)
+	return pu
+}
+
+// SetMultiple sets the "multiple" field.
+func (pu *PollUpdate) SetMultiple(b bool) *PollUpdate {
+	pu.mutation.SetMultiple(b)
+	return pu
+}
+
+// SetNillableMultiple sets the "multiple" field if the given value is not nil.
+func (pu *PollUpdate) SetNillableMultiple(b *bool) *PollUpdate {
+	if b != nil {
+		pu.SetMultiple(*b)
+	}
+	return pu
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (pu *PollUpdate) SetAnonymous(b bool) *PollUpdate {
+	pu.mutation.SetAnonymous(b)
+	return pu
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (pu *PollUpdate) SetNillableAnonymous(b *bool) *PollUpdate {
+	if b != nil {
+		pu.SetAnonymous(*b)
+	}
+	return pu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pu *PollUpdate) SetCreatedBy(s string) *PollUpdate {
+	pu.mutation.SetCreatedBy(s)
+	return pu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (pu *PollUpdate) SetNillableCreatedBy(s *string) *PollUpdate {
+	if s != nil {
+		pu.SetCreatedBy(*s)
+	}
+	return pu
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (pu *PollUpdate) SetClosesAt(t time.Time) *PollUpdate {
+	pu.mutation.SetClosesAt(t)
+	return pu
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (pu *PollUpdate) SetNillableClosesAt(t *time.Time) *PollUpdate {
+	if t != nil {
+		pu.SetClosesAt(*t)
+	}
+	return pu
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (pu *PollUpdate) ClearClosesAt() *PollUpdate {
+	pu.mutation.ClearClosesAt()
+	return pu
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (pu *PollUpdate) SetClosedAt(t time.Time) *PollUpdate {
+	pu.mutation.SetClosedAt(t)
+	return pu
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (pu *PollUpdate) SetNillableClosedAt(t *time.Time) *PollUpdate {
+	if t != nil {
+		pu.SetClosedAt(*t)
+	}
+	return pu
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (pu *PollUpdate) ClearClosedAt() *PollUpdate {
+	pu.mutation.ClearClosedAt()
+	return pu
+}
+
+// SetSummaryMessageID sets the "summary_message_id" field.
+func (pu *PollUpdate) SetSummaryMessageID(i int) *PollUpdate {
+	pu.mutation.ResetSummaryMessageID()
+	pu.mutation.SetSummaryMessageID(i)
+	return pu
+}
+
+// SetNillableSummaryMessageID sets the "summary_message_id" field if the given value is not nil.
+func (pu *PollUpdate) SetNillableSummaryMessageID(i *int) *PollUpdate {
+	if i != nil {
+		pu.SetSummaryMessageID(*i)
+	}
+	return pu
+}
+
+// AddSummaryMessageID adds i to the "summary_message_id" field.
+func (pu *PollUpdate) AddSummaryMessageID(i int) *PollUpdate {
+	pu.mutation.AddSummaryMessageID(i)
+	return pu
+}
+
+// ClearSummaryMessageID clears the value of the "summary_message_id" field.
+func (pu *PollUpdate) ClearSummaryMessageID() *PollUpdate {
+	pu.mutation.ClearSummaryMessageID()
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PollUpdate) SetUpdatedAt(t time.Time) *PollUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (pu *PollUpdate) SetMessage(m *Message) *PollUpdate {
+	return pu.SetMessageID(m.ID)
+}
+
+// AddVoteIDs adds the "votes" edge to the PollVote entity by IDs.
+func (pu *PollUpdate) AddVoteIDs(ids ...int) *PollUpdate {
+	pu.mutation.AddVoteIDs(ids...)
+	return pu
+}
+
+// AddVotes adds the "votes" edges to the PollVote entity.
+func (pu *PollUpdate) AddVotes(p ...*PollVote) *PollUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddVoteIDs(ids...)
+}
+
+// Mutation returns the PollMutation object of the builder.
+func (pu *PollUpdate) Mutation() *PollMutation {
+	return pu.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (pu *PollUpdate) ClearMessage() *PollUpdate {
+	pu.mutation.ClearMessage()
+	return pu
+}
+
+// ClearVotes clears all "votes" edges to the PollVote entity.
+func (pu *PollUpdate) ClearVotes() *PollUpdate {
+	pu.mutation.ClearVotes()
+	return pu
+}
+
+// RemoveVoteIDs removes the "votes" edge to PollVote entities by IDs.
+func (pu *PollUpdate) RemoveVoteIDs(ids ...int) *PollUpdate {
+	pu.mutation.RemoveVoteIDs(ids...)
+	return pu
+}
+
+// RemoveVotes removes "votes" edges to PollVote entities.
+func (pu *PollUpdate) RemoveVotes(p ...*PollVote) *PollUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveVoteIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PollUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PollUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PollUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PollUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PollUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := poll.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PollUpdate) check() error {
+	if v, ok := pu.mutation.RoomID(); ok {
+		if err := poll.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "Poll.room_id": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Question(); ok {
+		if err := poll.QuestionValidator(v); err != nil {
+			return &ValidationError{Name: "question", err: fmt.Errorf(`ent: validator failed for field "Poll.question": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.CreatedBy(); ok {
+		if err := poll.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Poll.created_by": %w`, err)}
+		}
+	}
+	if pu.mutation.MessageCleared() && len(pu.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Poll.message"`)
+	}
+	return nil
+}
+
+func (pu *PollUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(poll.Table, poll.Columns, sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.RoomID(); ok {
+		_spec.SetField(poll.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Question(); ok {
+		_spec.SetField(poll.FieldQuestion, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Options(); ok {
+		_spec.SetField(poll.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, poll.FieldOptions, value)
+		})
+	}
+	if value, ok := pu.mutation.Multiple(); ok {
+		_spec.SetField(poll.FieldMultiple, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.Anonymous(); ok {
+		_spec.SetField(poll.FieldAnonymous, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.CreatedBy(); ok {
+		_spec.SetField(poll.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.ClosesAt(); ok {
+		_spec.SetField(poll.FieldClosesAt, field.TypeTime, value)
+	}
+	if pu.mutation.ClosesAtCleared() {
+		_spec.ClearField(poll.FieldClosesAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.ClosedAt(); ok {
+		_spec.SetField(poll.FieldClosedAt, field.TypeTime, value)
+	}
+	if pu.mutation.ClosedAtCleared() {
+		_spec.ClearField(poll.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.SummaryMessageID(); ok {
+		_spec.SetField(poll.FieldSummaryMessageID, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedSummaryMessageID(); ok {
+		_spec.AddField(poll.FieldSummaryMessageID, field.TypeInt, value)
+	}
+	if pu.mutation.SummaryMessageIDCleared() {
+		_spec.ClearField(poll.FieldSummaryMessageID, field.TypeInt)
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(poll.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pu.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   poll.MessageTable,
+			Columns: []string{poll.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   poll.MessageTable,
+			Columns: []string{poll.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedVotesIDs(); len(nodes) > 0 && !pu.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{poll.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PollUpdateOne is the builder for updating a single Poll entity.
+type PollUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PollMutation
+}
+
+// SetRoomID sets the "room_id" field.
+func (puo *PollUpdateOne) SetRoomID(s string) *PollUpdateOne {
+	puo.mutation.SetRoomID(s)
+	return puo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (puo *PollUpdateOne) SetNillableRoomID(s *string) *PollUpdateOne {
+	if s != nil {
+		puo.SetRoomID(*s)
+	}
+	return puo
+}
+
+// SetMessageID sets the "message_id" field.
+func (puo *PollUpdateOne) SetMessageID(i int) *PollUpdateOne {
+	puo.mutation.SetMessageID(i)
+	return puo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (puo *PollUpdateOne) SetNillableMessageID(i *int) *PollUpdateOne {
+	if i != nil {
+		puo.SetMessageID(*i)
+	}
+	return puo
+}
+
+// SetQuestion sets the "question" field.
+func (puo *PollUpdateOne) SetQuestion(s string) *PollUpdateOne {
+	puo.mutation.SetQuestion(s)
+	return puo
+}
+
+// SetNillableQuestion sets the "question" field if the given value is not nil.
+func (puo *PollUpdateOne) SetNillableQuestion(s *string) *PollUpdateOne {
+	if s != nil {
+		puo.SetQuestion(*s)
+	}
+	return puo
+}
+
+// SetOptions sets the "options" field.
+func (puo *PollUpdateOne) SetOptions(s []string) *PollUpdateOne {
+	puo.mutation.SetOptions(s)
+	return puo
+}
+
+// AppendOptions appends s to the "options" field.
+func (puo *PollUpdateOne) AppendOptions(s []string) *PollUpdateOne {
+	puo.mutation.AppendOptions(s)
+	return puo
+}
+
+// SetMultiple sets the "multiple" field.
+func (puo *PollUpdateOne) SetMultiple(b bool) *PollUpdateOne {
+	puo.mutation.SetMultiple(b)
+	return puo
+}
+
+// SetNillableMultiple sets the "multiple" field if the given value is not nil.
+func (puo *PollUpdateOne) SetNillableMultiple(b *bool) *PollUpdateOne {
+	if b != nil {
+		puo.SetMultiple(*b)
+	}
+	return puo
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (puo *PollUpdateOne) SetAnonymous(b bool) *PollUpdateOne {
+	puo.mutation.SetAnonymous(b)
+	return puo
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (puo *PollUpdateOne) SetNillableAnonymous(b *bool) *PollUpdateOne {
+	if b != nil {
+		puo.SetAnonymous(*b)
+	}
+	return puo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (puo *PollUpdateOne) SetCreatedBy(s string) *PollUpdateOne {
+	puo.mutation.SetCreatedBy(s)
+	return puo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (puo *PollUpdateOne) SetNillableCreatedBy(s *string) *PollUpdateOne {
+	if s != nil {
+		puo.SetCreatedBy(*s)
+	}
+	return puo
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (puo *PollUpdateOne) SetClosesAt(t time.Time) *PollUpdateOne {
+	puo.mutation.SetClosesAt(t)
+	return puo
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (puo *PollUpdateOne) SetNillableClosesAt(t *time.Time) *PollUpdateOne {
+	if t != nil {
+		puo.SetClosesAt(*t)
+	}
+	return puo
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (puo *PollUpdateOne) ClearClosesAt() *PollUpdateOne {
+	puo.mutation.ClearClosesAt()
+	return puo
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (puo *PollUpdateOne) SetClosedAt(t time.Time) *PollUpdateOne {
+	puo.mutation.SetClosedAt(t)
+	return puo
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (puo *PollUpdateOne) SetNillableClosedAt(t *time.Time) *PollUpdateOne {
+	if t != nil {
+		puo.SetClosedAt(*t)
+	}
+	return puo
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (puo *PollUpdateOne) ClearClosedAt() *PollUpdateOne {
+	puo.mutation.ClearClosedAt()
+	return puo
+}
+
+// SetSummaryMessageID sets the "summary_message_id" field.
+func (puo *PollUpdateOne) SetSummaryMessageID(i int) *PollUpdateOne {
+	puo.mutation.ResetSummaryMessageID()
+	puo.mutation.SetSummaryMessageID(i)
+	return puo
+}
+
+// SetNillableSummaryMessageID sets the "summary_message_id" field if the given value is not nil.
+func (puo *PollUpdateOne) SetNillableSummaryMessageID(i *int) *PollUpdateOne {
+	if i != nil {
+		puo.SetSummaryMessageID(*i)
+	}
+	return puo
+}
+
+// AddSummaryMessageID adds i to the "summary_message_id" field.
+func (puo *PollUpdateOne) AddSummaryMessageID(i int) *PollUpdateOne {
+	puo.mutation.AddSummaryMessageID(i)
+	return puo
+}
+
+// ClearSummaryMessageID clears the value of the "summary_message_id" field.
+func (puo *PollUpdateOne) ClearSummaryMessageID() *PollUpdateOne {
+	puo.mutation.ClearSummaryMessageID()
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PollUpdateOne) SetUpdatedAt(t time.Time) *PollUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (puo *PollUpdateOne) SetMessage(m *Message) *PollUpdateOne {
+	return puo.SetMessageID(m.ID)
+}
+
+// AddVoteIDs adds the "votes" edge to the PollVote entity by IDs.
+func (puo *PollUpdateOne) AddVoteIDs(ids ...int) *PollUpdateOne {
+	puo.mutation.AddVoteIDs(ids...)
+	return puo
+}
+
+// AddVotes adds the "votes" edges to the PollVote entity.
+func (puo *PollUpdateOne) AddVotes(p ...*PollVote) *PollUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddVoteIDs(ids...)
+}
+
+// Mutation returns the PollMutation object of the builder.
+func (puo *PollUpdateOne) Mutation() *PollMutation {
+	return puo.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (puo *PollUpdateOne) ClearMessage() *PollUpdateOne {
+	puo.mutation.ClearMessage()
+	return puo
+}
+
+// ClearVotes clears all "votes" edges to the PollVote entity.
+func (puo *PollUpdateOne) ClearVotes() *PollUpdateOne {
+	puo.mutation.ClearVotes()
+	return puo
+}
+
+// RemoveVoteIDs removes the "votes" edge to PollVote entities by IDs.
+func (puo *PollUpdateOne) RemoveVoteIDs(ids ...int) *PollUpdateOne {
+	puo.mutation.RemoveVoteIDs(ids...)
+	return puo
+}
+
+// RemoveVotes removes "votes" edges to PollVote entities.
+func (puo *PollUpdateOne) RemoveVotes(p ...*PollVote) *PollUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveVoteIDs(ids...)
+}
+
+// Where appends a list predicates to the PollUpdate builder.
+func (puo *PollUpdateOne) Where(ps ...predicate.Poll) *PollUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PollUpdateOne) Select(field string, fields ...string) *PollUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Poll entity.
+func (puo *PollUpdateOne) Save(ctx context.Context) (*Poll, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PollUpdateOne) SaveX(ctx context.Context) *Poll {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PollUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PollUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PollUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := poll.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PollUpdateOne) check() error {
+	if v, ok := puo.mutation.RoomID(); ok {
+		if err := poll.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "Poll.room_id": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Question(); ok {
+		if err := poll.QuestionValidator(v); err != nil {
+			return &ValidationError{Name: "question", err: fmt.Errorf(`ent: validator failed for field "Poll.question": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.CreatedBy(); ok {
+		if err := poll.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Poll.created_by": %w`, err)}
+		}
+	}
+	if puo.mutation.MessageCleared() && len(puo.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Poll.message"`)
+	}
+	return nil
+}
+
+func (puo *PollUpdateOne) sqlSave(ctx context.Context) (_node *Poll, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(poll.Table, poll.Columns, sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Poll.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, poll.FieldID)
+		for _, f := range fields {
+			if !poll.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != poll.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.RoomID(); ok {
+		_spec.SetField(poll.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Question(); ok {
+		_spec.SetField(poll.FieldQuestion, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Options(); ok {
+		_spec.SetField(poll.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, poll.FieldOptions, value)
+		})
+	}
+	if value, ok := puo.mutation.Multiple(); ok {
+		_spec.SetField(poll.FieldMultiple, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.Anonymous(); ok {
+		_spec.SetField(poll.FieldAnonymous, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.CreatedBy(); ok {
+		_spec.SetField(poll.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.ClosesAt(); ok {
+		_spec.SetField(poll.FieldClosesAt, field.TypeTime, value)
+	}
+	if puo.mutation.ClosesAtCleared() {
+		_spec.ClearField(poll.FieldClosesAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.ClosedAt(); ok {
+		_spec.SetField(poll.FieldClosedAt, field.TypeTime, value)
+	}
+	if puo.mutation.ClosedAtCleared() {
+		_spec.ClearField(poll.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.SummaryMessageID(); ok {
+		_spec.SetField(poll.FieldSummaryMessageID, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedSummaryMessageID(); ok {
+		_spec.AddField(poll.FieldSummaryMessageID, field.TypeInt, value)
+	}
+	if puo.mutation.SummaryMessageIDCleared() {
+		_spec.ClearField(poll.FieldSummaryMessageID, field.TypeInt)
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(poll.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   poll.MessageTable,
+			Columns: []string{poll.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   poll.MessageTable,
+			Columns: []string{poll.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedVotesIDs(); len(nodes) > 0 && !puo.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Poll{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{poll.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
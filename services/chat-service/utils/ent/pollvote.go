@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pollvote"
+)
+
+// PollVote is the model entity for the PollVote schema.
+type PollVote struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PollID holds the value of the "poll_id" field.
+	PollID int `json:"poll_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Option holds the value of the "option" field.
+	Option int `json:"option,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PollVoteQuery when eager-loading is set.
+	Edges        PollVoteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PollVoteEdges holds the relations/edges for other nodes in the graph.
+type PollVoteEdges struct {
+	// Poll holds the value of the poll edge.
+	Poll *Poll `json:"poll,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PollOrErr returns the Poll value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PollVoteEdges) PollOrErr() (*Poll, error) {
+	if e.Poll != nil {
+		return e.Poll, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: poll.Label}
+	}
+	return nil, &NotLoadedError{edge: "poll"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PollVote) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pollvote.FieldID, pollvote.FieldPollID, pollvote.FieldOption:
+			values[i] = new(sql.NullInt64)
+		case pollvote.FieldUserID, pollvote.FieldUsername:
+			values[i] = new(sql.NullString)
+		case pollvote.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PollVote fields.
+func (pv *PollVote) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pollvote.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pv.ID = int(value.Int64)
+		case pollvote.FieldPollID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_id", values[i])
+			} else if value.Valid {
+				pv.PollID = int(value.Int64)
+			}
+		case pollvote.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				pv.UserID = value.String
+			}
+		case pollvote.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				pv.Username = value.String
+			}
+		case pollvote.FieldOption:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field option", values[i])
+			} else if value.Valid {
+				pv.Option = int(value.Int64)
+			}
+		case pollvote.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pv.CreatedAt = value.Time
+			}
+		default:
+			pv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PollVote.
+// This includes values selected through modifiers, order, etc.
+func (pv *PollVote) Value(name string) (ent.Value, error) {
+	return pv.selectValues.Get(name)
+}
+
+// QueryPoll queries the "poll" edge of the PollVote entity.
+func (pv *PollVote) QueryPoll() *PollQuery {
+	return NewPollVoteClient(pv.config).QueryPoll(pv)
+}
+
+// Update returns a builder for updating this PollVote.
+// Note that you need to call PollVote.Unwrap() before calling this method if this PollVote
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pv *PollVote) Update() *PollVoteUpdateOne {
+	return NewPollVoteClient(pv.config).UpdateOne(pv)
+}
+
+// Unwrap unwraps the PollVote entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pv *PollVote) Unwrap() *PollVote {
+	_tx, ok := pv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PollVote is not a transactional entity")
+	}
+	pv.config.driver = _tx.drv
+	return pv
+}
+
+// String implements the fmt.Stringer.
+func (pv *PollVote) String() string {
+	var builder strings.Builder
+	builder.WriteString("PollVote(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pv.ID))
+	builder.WriteString("poll_id=")
+	builder.WriteString(fmt.Sprintf("%v", pv.PollID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(pv.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(pv.Username)
+	builder.WriteString(", ")
+	builder.WriteString("option=")
+	builder.WriteString(fmt.Sprintf("%v", pv.Option))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pv.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PollVotes is a parsable slice of PollVote.
+type PollVotes []*PollVote
@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pollvote
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the pollvote type in the database.
+	Label = "poll_vote"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPollID holds the string denoting the poll_id field in the database.
+	FieldPollID = "poll_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldOption holds the string denoting the option field in the database.
+	FieldOption = "option"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgePoll holds the string denoting the poll edge name in mutations.
+	EdgePoll = "poll"
+	// Table holds the table name of the pollvote in the database.
+	Table = "poll_votes"
+	// PollTable is the table that holds the poll relation/edge.
+	PollTable = "poll_votes"
+	// PollInverseTable is the table name for the Poll entity.
+	// It exists in this package in order to avoid circular dependency with the "poll" package.
+	PollInverseTable = "polls"
+	// PollColumn is the table column denoting the poll relation/edge.
+	PollColumn = "poll_id"
+)
+
+// Columns holds all SQL columns for pollvote fields.
+var Columns = []string{
+	FieldID,
+	FieldPollID,
+	FieldUserID,
+	FieldUsername,
+	FieldOption,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	UsernameValidator func(string) error
+	// OptionValidator is a validator for the "option" field. It is called by the builders before save.
+	OptionValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PollVote queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPollID orders the results by the poll_id field.
+func ByPollID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPollID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByOption orders the results by the option field.
+func ByOption(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOption, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPollField orders the results by poll field.
+func ByPollField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPollStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPollStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PollInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PollTable, PollColumn),
+	)
+}
@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pollvote
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldLTE(FieldID, id))
+}
+
+// PollID applies equality check predicate on the "poll_id" field. It's identical to PollIDEQ.
+func PollID(v int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldEQ(FieldPollID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldEQ(FieldUserID, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldEQ(FieldUsername, v))
+}
+
+// Option applies equality check predicate on the "option" field. It's identical to OptionEQ.
+func Option(v int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldEQ(FieldOption, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PollVote {
+	return predicate.PollVote(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PollIDEQ applies the EQ predicate on the "poll_id" field.
+func PollIDEQ(v int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldEQ(FieldPollID, v))
+}
+
+// PollIDNEQ applies the NEQ predicate on the "poll_id" field.
+func PollIDNEQ(v int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldNEQ(FieldPollID, v))
+}
+
+// PollIDIn applies the In predicate on the "poll_id" field.
+func PollIDIn(vs ...int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldIn(FieldPollID, vs...))
+}
+
+// PollIDNotIn applies the NotIn predicate on the "poll_id" field.
+func PollIDNotIn(vs ...int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldNotIn(FieldPollID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.PollVote {
+	return predicate.PollVote(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// OptionEQ applies the EQ predicate on the "option" field.
+func OptionEQ(v int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldEQ(FieldOption, v))
+}
+
+// OptionNEQ applies the NEQ predicate on the "option" field.
+func OptionNEQ(v int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldNEQ(FieldOption, v))
+}
+
+// OptionIn applies the In predicate on the "option" field.
+func OptionIn(vs ...int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldIn(FieldOption, vs...))
+}
+
+// OptionNotIn applies the NotIn predicate on the "option" field.
+func OptionNotIn(vs ...int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldNotIn(FieldOption, vs...))
+}
+
+// OptionGT applies the GT predicate on the "option" field.
+func OptionGT(v int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldGT(FieldOption, v))
+}
+
+// OptionGTE applies the GTE predicate on the "option" field.
+func OptionGTE(v int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldGTE(FieldOption, v))
+}
+
+// OptionLT applies the LT predicate on the "option" field.
+func OptionLT(v int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldLT(FieldOption, v))
+}
+
+// OptionLTE applies the LTE predicate on the "option" field.
+func OptionLTE(v int) predicate.PollVote {
+	return predicate.PollVote(sql.FieldLTE(FieldOption, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PollVote {
+	return predicate.PollVote(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PollVote {
+	return predicate.PollVote(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PollVote {
+	return predicate.PollVote(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PollVote {
+	return predicate.PollVote(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PollVote {
+	return predicate.PollVote(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PollVote {
+	return predicate.PollVote(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PollVote {
+	return predicate.PollVote(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PollVote {
+	return predicate.PollVote(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPoll applies the HasEdge predicate on the "poll" edge.
+func HasPoll() predicate.PollVote {
+	return predicate.PollVote(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PollTable, PollColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPollWith applies the HasEdge predicate on the "poll" edge with a given conditions (other predicates).
+func HasPollWith(preds ...predicate.Poll) predicate.PollVote {
+	return predicate.PollVote(func(s *sql.Selector) {
+		step := newPollStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PollVote) predicate.PollVote {
+	return predicate.PollVote(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PollVote) predicate.PollVote {
+	return predicate.PollVote(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PollVote) predicate.PollVote {
+	return predicate.PollVote(sql.NotPredicates(p))
+}
@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pollvote"
+)
+
+// PollVoteCreate is the builder for creating a PollVote entity.
+type PollVoteCreate struct {
+	config
+	mutation *PollVoteMutation
+	hooks    []Hook
+}
+
+// SetPollID sets the "poll_id" field.
+func (pvc *PollVoteCreate) SetPollID(i int) *PollVoteCreate {
+	pvc.mutation.SetPollID(i)
+	return pvc
+}
+
+// SetUserID sets the "user_id" field.
+func (pvc *PollVoteCreate) SetUserID(s string) *PollVoteCreate {
+	pvc.mutation.SetUserID(s)
+	return pvc
+}
+
+// SetUsername sets the "username" field.
+func (pvc *PollVoteCreate) SetUsername(s string) *PollVoteCreate {
+	pvc.mutation.SetUsername(s)
+	return pvc
+}
+
+// SetOption sets the "option" field.
+func (pvc *PollVoteCreate) SetOption(i int) *PollVoteCreate {
+	pvc.mutation.SetOption(i)
+	return pvc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pvc *PollVoteCreate) SetCreatedAt(t time.Time) *PollVoteCreate {
+	pvc.mutation.SetCreatedAt(t)
+	return pvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pvc *PollVoteCreate) SetNillableCreatedAt(t *time.Time) *PollVoteCreate {
+	if t != nil {
+		pvc.SetCreatedAt(*t)
+	}
+	return pvc
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (pvc *PollVoteCreate) SetPoll(p *Poll) *PollVoteCreate {
+	return pvc.SetPollID(p.ID)
+}
+
+// Mutation returns the PollVoteMutation object of the builder.
+func (pvc *PollVoteCreate) Mutation() *PollVoteMutation {
+	return pvc.mutation
+}
+
+// Save creates the PollVote in the database.
+func (pvc *PollVoteCreate) Save(ctx context.Context) (*PollVote, error) {
+	pvc.defaults()
+	return withHooks(ctx, pvc.sqlSave, pvc.mutation, pvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pvc *PollVoteCreate) SaveX(ctx context.Context) *PollVote {
+	v, err := pvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pvc *PollVoteCreate) Exec(ctx context.Context) error {
+	_, err := pvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvc *PollVoteCreate) ExecX(ctx context.Context) {
+	if err := pvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pvc *PollVoteCreate) defaults() {
+	if _, ok := pvc.mutation.CreatedAt(); !ok {
+		v := pollvote.DefaultCreatedAt()
+		pvc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pvc *PollVoteCreate) check() error {
+	if _, ok := pvc.mutation.PollID(); !ok {
+		return &ValidationError{Name: "poll_id", err: errors.New(`ent: missing required field "PollVote.poll_id"`)}
+	}
+	if _, ok := pvc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PollVote.user_id"`)}
+	}
+	if v, ok := pvc.mutation.UserID(); ok {
+		if err := pollvote.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PollVote.user_id": %w`, err)}
+		}
+	}
+	if _, ok := pvc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "PollVote.username"`)}
+	}
+	if v, ok := pvc.mutation.Username(); ok {
+		if err := pollvote.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "PollVote.username": %w`, err)}
+		}
+	}
+	if _, ok := pvc.mutation.Option(); !ok {
+		return &ValidationError{Name: "option", err: errors.New(`ent: missing required field "PollVote.option"`)}
+	}
+	if v, ok := pvc.mutation.Option(); ok {
+		if err := pollvote.OptionValidator(v); err != nil {
+			return &ValidationError{Name: "option", err: fmt.Errorf(`ent: validator failed for field "PollVote.option": %w`, err)}
+		}
+	}
+	if _, ok := pvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PollVote.created_at"`)}
+	}
+	if len(pvc.mutation.PollIDs()) == 0 {
+		return &ValidationError{Name: "poll", err: errors.New(`ent: missing required edge "PollVote.poll"`)}
+	}
+	return nil
+}
+
+func (pvc *PollVoteCreate) sqlSave(ctx context.Context) (*PollVote, error) {
+	if err := pvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pvc.mutation.id = &_node.ID
+	pvc.mutation.done = true
+	return _node, nil
+}
+
+func (pvc *PollVoteCreate) createSpec() (*PollVote, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PollVote{config: pvc.config}
+		_spec = sqlgraph.NewCreateSpec(pollvote.Table, sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeInt))
+	)
+	if value, ok := pvc.mutation.UserID(); ok {
+		_spec.SetField(pollvote.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := pvc.mutation.Username(); ok {
+		_spec.SetField(pollvote.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := pvc.mutation.Option(); ok {
+		_spec.SetField(pollvote.FieldOption, field.TypeInt, value)
+		_node.Option = value
+	}
+	if value, ok := pvc.mutation.CreatedAt(); ok {
+		_spec.SetField(pollvote.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := pvc.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pollvote.PollTable,
+			Columns: []string{pollvote.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PollID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PollVoteCreateBulk is the builder for creating many PollVote entities in bulk.
+type PollVoteCreateBulk struct {
+	config
+	err      error
+	builders []*PollVoteCreate
+}
+
+// Save creates the PollVote entities in the database.
+func (pvcb *PollVoteCreateBulk) Save(ctx context.Context) ([]*PollVote, error) {
+	if pvcb.err != nil {
+		return nil, pvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pvcb.builders))
+	nodes := make([]*PollVote, len(pvcb.builders))
+	mutators := make([]Mutator, len(pvcb.builders))
+	for i := range pvcb.builders {
+		func(i int, root context.Context) {
+			builder := pvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PollVoteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pvcb *PollVoteCreateBulk) SaveX(ctx context.Context) []*PollVote {
+	v, err := pvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pvcb *PollVoteCreateBulk) Exec(ctx context.Context) error {
+	_, err := pvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvcb *PollVoteCreateBulk) ExecX(ctx context.Context) {
+	if err := pvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
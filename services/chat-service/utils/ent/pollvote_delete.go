@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pollvote"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// PollVoteDelete is the builder for deleting a PollVote entity.
+type PollVoteDelete struct {
+	config
+	hooks    []Hook
+	mutation *PollVoteMutation
+}
+
+// Where appends a list predicates to the PollVoteDelete builder.
+func (pvd *PollVoteDelete) Where(ps ...predicate.PollVote) *PollVoteDelete {
+	pvd.mutation.Where(ps...)
+	return pvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pvd *PollVoteDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pvd.sqlExec, pvd.mutation, pvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvd *PollVoteDelete) ExecX(ctx context.Context) int {
+	n, err := pvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pvd *PollVoteDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pollvote.Table, sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeInt))
+	if ps := pvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pvd.mutation.done = true
+	return affected, err
+}
+
+// PollVoteDeleteOne is the builder for deleting a single PollVote entity.
+type PollVoteDeleteOne struct {
+	pvd *PollVoteDelete
+}
+
+// Where appends a list predicates to the PollVoteDelete builder.
+func (pvdo *PollVoteDeleteOne) Where(ps ...predicate.PollVote) *PollVoteDeleteOne {
+	pvdo.pvd.mutation.Where(ps...)
+	return pvdo
+}
+
+// Exec executes the deletion query.
+func (pvdo *PollVoteDeleteOne) Exec(ctx context.Context) error {
+	n, err := pvdo.pvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pollvote.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvdo *PollVoteDeleteOne) ExecX(ctx context.Context) {
+	if err := pvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
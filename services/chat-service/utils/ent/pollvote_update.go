@@ -0,0 +1,458 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pollvote"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// PollVoteUpdate is the builder for updating PollVote entities.
+type PollVoteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PollVoteMutation
+}
+
+// Where appends a list predicates to the PollVoteUpdate builder.
+func (pvu *PollVoteUpdate) Where(ps ...predicate.PollVote) *PollVoteUpdate {
+	pvu.mutation.Where(ps...)
+	return pvu
+}
+
+// SetPollID sets the "poll_id" field.
+func (pvu *PollVoteUpdate) SetPollID(i int) *PollVoteUpdate {
+	pvu.mutation.SetPollID(i)
+	return pvu
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (pvu *PollVoteUpdate) SetNillablePollID(i *int) *PollVoteUpdate {
+	if i != nil {
+		pvu.SetPollID(*i)
+	}
+	return pvu
+}
+
+// SetUserID sets the "user_id" field.
+func (pvu *PollVoteUpdate) SetUserID(s string) *PollVoteUpdate {
+	pvu.mutation.SetUserID(s)
+	return pvu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (pvu *PollVoteUpdate) SetNillableUserID(s *string) *PollVoteUpdate {
+	if s != nil {
+		pvu.SetUserID(*s)
+	}
+	return pvu
+}
+
+// SetUsername sets the "username" field.
+func (pvu *PollVoteUpdate) SetUsername(s string) *PollVoteUpdate {
+	pvu.mutation.SetUsername(s)
+	return pvu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (pvu *PollVoteUpdate) SetNillableUsername(s *string) *PollVoteUpdate {
+	if s != nil {
+		pvu.SetUsername(*s)
+	}
+	return pvu
+}
+
+// SetOption sets the "option" field.
+func (pvu *PollVoteUpdate) SetOption(i int) *PollVoteUpdate {
+	pvu.mutation.ResetOption()
+	pvu.mutation.SetOption(i)
+	return pvu
+}
+
+// SetNillableOption sets the "option" field if the given value is not nil.
+func (pvu *PollVoteUpdate) SetNillableOption(i *int) *PollVoteUpdate {
+	if i != nil {
+		pvu.SetOption(*i)
+	}
+	return pvu
+}
+
+// AddOption adds i to the "option" field.
+func (pvu *PollVoteUpdate) AddOption(i int) *PollVoteUpdate {
+	pvu.mutation.AddOption(i)
+	return pvu
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (pvu *PollVoteUpdate) SetPoll(p *Poll) *PollVoteUpdate {
+	return pvu.SetPollID(p.ID)
+}
+
+// Mutation returns the PollVoteMutation object of the builder.
+func (pvu *PollVoteUpdate) Mutation() *PollVoteMutation {
+	return pvu.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (pvu *PollVoteUpdate) ClearPoll() *PollVoteUpdate {
+	pvu.mutation.ClearPoll()
+	return pvu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pvu *PollVoteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pvu.sqlSave, pvu.mutation, pvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pvu *PollVoteUpdate) SaveX(ctx context.Context) int {
+	affected, err := pvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pvu *PollVoteUpdate) Exec(ctx context.Context) error {
+	_, err := pvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvu *PollVoteUpdate) ExecX(ctx context.Context) {
+	if err := pvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pvu *PollVoteUpdate) check() error {
+	if v, ok := pvu.mutation.UserID(); ok {
+		if err := pollvote.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PollVote.user_id": %w`, err)}
+		}
+	}
+	if v, ok := pvu.mutation.Username(); ok {
+		if err := pollvote.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "PollVote.username": %w`, err)}
+		}
+	}
+	if v, ok := pvu.mutation.Option(); ok {
+		if err := pollvote.OptionValidator(v); err != nil {
+			return &ValidationError{Name: "option", err: fmt.Errorf(`ent: validator failed for field "PollVote.option": %w`, err)}
+		}
+	}
+	if pvu.mutation.PollCleared() && len(pvu.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PollVote.poll"`)
+	}
+	return nil
+}
+
+func (pvu *PollVoteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pollvote.Table, pollvote.Columns, sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeInt))
+	if ps := pvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pvu.mutation.UserID(); ok {
+		_spec.SetField(pollvote.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := pvu.mutation.Username(); ok {
+		_spec.SetField(pollvote.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := pvu.mutation.Option(); ok {
+		_spec.SetField(pollvote.FieldOption, field.TypeInt, value)
+	}
+	if value, ok := pvu.mutation.AddedOption(); ok {
+		_spec.AddField(pollvote.FieldOption, field.TypeInt, value)
+	}
+	if pvu.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pollvote.PollTable,
+			Columns: []string{pollvote.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pvu.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pollvote.PollTable,
+			Columns: []string{pollvote.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pollvote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pvu.mutation.done = true
+	return n, nil
+}
+
+// PollVoteUpdateOne is the builder for updating a single PollVote entity.
+type PollVoteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PollVoteMutation
+}
+
+// SetPollID sets the "poll_id" field.
+func (pvuo *PollVoteUpdateOne) SetPollID(i int) *PollVoteUpdateOne {
+	pvuo.mutation.SetPollID(i)
+	return pvuo
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (pvuo *PollVoteUpdateOne) SetNillablePollID(i *int) *PollVoteUpdateOne {
+	if i != nil {
+		pvuo.SetPollID(*i)
+	}
+	return pvuo
+}
+
+// SetUserID sets the "user_id" field.
+func (pvuo *PollVoteUpdateOne) SetUserID(s string) *PollVoteUpdateOne {
+	pvuo.mutation.SetUserID(s)
+	return pvuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (pvuo *PollVoteUpdateOne) SetNillableUserID(s *string) *PollVoteUpdateOne {
+	if s != nil {
+		pvuo.SetUserID(*s)
+	}
+	return pvuo
+}
+
+// SetUsername sets the "username" field.
+func (pvuo *PollVoteUpdateOne) SetUsername(s string) *PollVoteUpdateOne {
+	pvuo.mutation.SetUsername(s)
+	return pvuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (pvuo *PollVoteUpdateOne) SetNillableUsername(s *string) *PollVoteUpdateOne {
+	if s != nil {
+		pvuo.SetUsername(*s)
+	}
+	return pvuo
+}
+
+// SetOption sets the "option" field.
+func (pvuo *PollVoteUpdateOne) SetOption(i int) *PollVoteUpdateOne {
+	pvuo.mutation.ResetOption()
+	pvuo.mutation.SetOption(i)
+	return pvuo
+}
+
+// SetNillableOption sets the "option" field if the given value is not nil.
+func (pvuo *PollVoteUpdateOne) SetNillableOption(i *int) *PollVoteUpdateOne {
+	if i != nil {
+		pvuo.SetOption(*i)
+	}
+	return pvuo
+}
+
+// AddOption adds i to the "option" field.
+func (pvuo *PollVoteUpdateOne) AddOption(i int) *PollVoteUpdateOne {
+	pvuo.mutation.AddOption(i)
+	return pvuo
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (pvuo *PollVoteUpdateOne) SetPoll(p *Poll) *PollVoteUpdateOne {
+	return pvuo.SetPollID(p.ID)
+}
+
+// Mutation returns the PollVoteMutation object of the builder.
+func (pvuo *PollVoteUpdateOne) Mutation() *PollVoteMutation {
+	return pvuo.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (pvuo *PollVoteUpdateOne) ClearPoll() *PollVoteUpdateOne {
+	pvuo.mutation.ClearPoll()
+	return pvuo
+}
+
+// Where appends a list predicates to the PollVoteUpdate builder.
+func (pvuo *PollVoteUpdateOne) Where(ps ...predicate.PollVote) *PollVoteUpdateOne {
+	pvuo.mutation.Where(ps...)
+	return pvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pvuo *PollVoteUpdateOne) Select(field string, fields ...string) *PollVoteUpdateOne {
+	pvuo.fields = append([]string{field}, fields...)
+	return pvuo
+}
+
+// Save executes the query and returns the updated PollVote entity.
+func (pvuo *PollVoteUpdateOne) Save(ctx context.Context) (*PollVote, error) {
+	return withHooks(ctx, pvuo.sqlSave, pvuo.mutation, pvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pvuo *PollVoteUpdateOne) SaveX(ctx context.Context) *PollVote {
+	node, err := pvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pvuo *PollVoteUpdateOne) Exec(ctx context.Context) error {
+	_, err := pvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvuo *PollVoteUpdateOne) ExecX(ctx context.Context) {
+	if err := pvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pvuo *PollVoteUpdateOne) check() error {
+	if v, ok := pvuo.mutation.UserID(); ok {
+		if err := pollvote.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PollVote.user_id": %w`, err)}
+		}
+	}
+	if v, ok := pvuo.mutation.Username(); ok {
+		if err := pollvote.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "PollVote.username": %w`, err)}
+		}
+	}
+	if v, ok := pvuo.mutation.Option(); ok {
+		if err := pollvote.OptionValidator(v); err != nil {
+			return &ValidationError{Name: "option", err: fmt.Errorf(`ent: validator failed for field "PollVote.option": %w`, err)}
+		}
+	}
+	if pvuo.mutation.PollCleared() && len(pvuo.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PollVote.poll"`)
+	}
+	return nil
+}
+
+func (pvuo *PollVoteUpdateOne) sqlSave(ctx context.Context) (_node *PollVote, err error) {
+	if err := pvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pollvote.Table, pollvote.Columns, sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeInt))
+	id, ok := pvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PollVote.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pollvote.FieldID)
+		for _, f := range fields {
+			if !pollvote.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pollvote.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pvuo.mutation.UserID(); ok {
+		_spec.SetField(pollvote.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := pvuo.mutation.Username(); ok {
+		_spec.SetField(pollvote.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := pvuo.mutation.Option(); ok {
+		_spec.SetField(pollvote.FieldOption, field.TypeInt, value)
+	}
+	if value, ok := pvuo.mutation.AddedOption(); ok {
+		_spec.AddField(pollvote.FieldOption, field.TypeInt, value)
+	}
+	if pvuo.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pollvote.PollTable,
+			Columns: []string{pollvote.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pvuo.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pollvote.PollTable,
+			Columns: []string{pollvote.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PollVote{config: pvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pollvote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pvuo.mutation.done = true
+	return _node, nil
+}
@@ -6,8 +6,53 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// BotDelivery is the predicate function for botdelivery builders.
+type BotDelivery func(*sql.Selector)
+
+// BotSubscription is the predicate function for botsubscription builders.
+type BotSubscription func(*sql.Selector)
+
+// CallParticipant is the predicate function for callparticipant builders.
+type CallParticipant func(*sql.Selector)
+
+// CallSession is the predicate function for callsession builders.
+type CallSession func(*sql.Selector)
+
+// HistoryImport is the predicate function for historyimport builders.
+type HistoryImport func(*sql.Selector)
+
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
+// MessagePurge is the predicate function for messagepurge builders.
+type MessagePurge func(*sql.Selector)
+
+// PinnedMessage is the predicate function for pinnedmessage builders.
+type PinnedMessage func(*sql.Selector)
+
+// Poll is the predicate function for poll builders.
+type Poll func(*sql.Selector)
+
+// PollVote is the predicate function for pollvote builders.
+type PollVote func(*sql.Selector)
+
 // Room is the predicate function for room builders.
 type Room func(*sql.Selector)
+
+// RoomExport is the predicate function for roomexport builders.
+type RoomExport func(*sql.Selector)
+
+// RoomExportChunk is the predicate function for roomexportchunk builders.
+type RoomExportChunk func(*sql.Selector)
+
+// RoomMember is the predicate function for roommember builders.
+type RoomMember func(*sql.Selector)
+
+// SavedMessage is the predicate function for savedmessage builders.
+type SavedMessage func(*sql.Selector)
+
+// ScheduledMessage is the predicate function for scheduledmessage builders.
+type ScheduledMessage func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -17,7 +18,15 @@ type Room struct {
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
-	Name         string `json:"name,omitempty"`
+	Name string `json:"name,omitempty"`
+	// RetentionMaxAge holds the value of the "retention_max_age" field.
+	RetentionMaxAge *time.Duration `json:"retention_max_age,omitempty"`
+	// RetentionMaxMessages holds the value of the "retention_max_messages" field.
+	RetentionMaxMessages *int `json:"retention_max_messages,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID *string `json:"owner_id,omitempty"`
+	// ImportKey holds the value of the "import_key" field.
+	ImportKey    *string `json:"import_key,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -26,9 +35,9 @@ func (*Room) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case room.FieldID:
+		case room.FieldID, room.FieldRetentionMaxAge, room.FieldRetentionMaxMessages:
 			values[i] = new(sql.NullInt64)
-		case room.FieldName:
+		case room.FieldName, room.FieldOwnerID, room.FieldImportKey:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -57,6 +66,34 @@ func (r *Room) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				r.Name = value.String
 			}
+		case room.FieldRetentionMaxAge:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_max_age", values[i])
+			} else if value.Valid {
+				r.RetentionMaxAge = new(time.Duration)
+				*r.RetentionMaxAge = time.Duration(value.Int64)
+			}
+		case room.FieldRetentionMaxMessages:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_max_messages", values[i])
+			} else if value.Valid {
+				r.RetentionMaxMessages = new(int)
+				*r.RetentionMaxMessages = int(value.Int64)
+			}
+		case room.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				r.OwnerID = new(string)
+				*r.OwnerID = value.String
+			}
+		case room.FieldImportKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field import_key", values[i])
+			} else if value.Valid {
+				r.ImportKey = new(string)
+				*r.ImportKey = value.String
+			}
 		default:
 			r.selectValues.Set(columns[i], values[i])
 		}
@@ -95,6 +132,26 @@ func (r *Room) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
 	builder.WriteString("name=")
 	builder.WriteString(r.Name)
+	builder.WriteString(", ")
+	if v := r.RetentionMaxAge; v != nil {
+		builder.WriteString("retention_max_age=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := r.RetentionMaxMessages; v != nil {
+		builder.WriteString("retention_max_messages=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := r.OwnerID; v != nil {
+		builder.WriteString("owner_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := r.ImportKey; v != nil {
+		builder.WriteString("import_key=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
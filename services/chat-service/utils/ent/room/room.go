@@ -13,6 +13,14 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldRetentionMaxAge holds the string denoting the retention_max_age field in the database.
+	FieldRetentionMaxAge = "retention_max_age"
+	// FieldRetentionMaxMessages holds the string denoting the retention_max_messages field in the database.
+	FieldRetentionMaxMessages = "retention_max_messages"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldImportKey holds the string denoting the import_key field in the database.
+	FieldImportKey = "import_key"
 	// Table holds the table name of the room in the database.
 	Table = "rooms"
 )
@@ -21,6 +29,10 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldRetentionMaxAge,
+	FieldRetentionMaxMessages,
+	FieldOwnerID,
+	FieldImportKey,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -36,6 +48,8 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// RetentionMaxMessagesValidator is a validator for the "retention_max_messages" field. It is called by the builders before save.
+	RetentionMaxMessagesValidator func(int) error
 )
 
 // OrderOption defines the ordering options for the Room queries.
@@ -50,3 +64,23 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
+
+// ByRetentionMaxAge orders the results by the retention_max_age field.
+func ByRetentionMaxAge(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionMaxAge, opts...).ToFunc()
+}
+
+// ByRetentionMaxMessages orders the results by the retention_max_messages field.
+func ByRetentionMaxMessages(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionMaxMessages, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByImportKey orders the results by the import_key field.
+func ByImportKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImportKey, opts...).ToFunc()
+}
@@ -3,6 +3,8 @@
 package room
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
 )
@@ -57,6 +59,27 @@ func Name(v string) predicate.Room {
 	return predicate.Room(sql.FieldEQ(FieldName, v))
 }
 
+// RetentionMaxAge applies equality check predicate on the "retention_max_age" field. It's identical to RetentionMaxAgeEQ.
+func RetentionMaxAge(v time.Duration) predicate.Room {
+	vc := int64(v)
+	return predicate.Room(sql.FieldEQ(FieldRetentionMaxAge, vc))
+}
+
+// RetentionMaxMessages applies equality check predicate on the "retention_max_messages" field. It's identical to RetentionMaxMessagesEQ.
+func RetentionMaxMessages(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldRetentionMaxMessages, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// ImportKey applies equality check predicate on the "import_key" field. It's identical to ImportKeyEQ.
+func ImportKey(v string) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldImportKey, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Room {
 	return predicate.Room(sql.FieldEQ(FieldName, v))
@@ -122,6 +145,270 @@ func NameContainsFold(v string) predicate.Room {
 	return predicate.Room(sql.FieldContainsFold(FieldName, v))
 }
 
+// RetentionMaxAgeEQ applies the EQ predicate on the "retention_max_age" field.
+func RetentionMaxAgeEQ(v time.Duration) predicate.Room {
+	vc := int64(v)
+	return predicate.Room(sql.FieldEQ(FieldRetentionMaxAge, vc))
+}
+
+// RetentionMaxAgeNEQ applies the NEQ predicate on the "retention_max_age" field.
+func RetentionMaxAgeNEQ(v time.Duration) predicate.Room {
+	vc := int64(v)
+	return predicate.Room(sql.FieldNEQ(FieldRetentionMaxAge, vc))
+}
+
+// RetentionMaxAgeIn applies the In predicate on the "retention_max_age" field.
+func RetentionMaxAgeIn(vs ...time.Duration) predicate.Room {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Room(sql.FieldIn(FieldRetentionMaxAge, v...))
+}
+
+// RetentionMaxAgeNotIn applies the NotIn predicate on the "retention_max_age" field.
+func RetentionMaxAgeNotIn(vs ...time.Duration) predicate.Room {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Room(sql.FieldNotIn(FieldRetentionMaxAge, v...))
+}
+
+// RetentionMaxAgeGT applies the GT predicate on the "retention_max_age" field.
+func RetentionMaxAgeGT(v time.Duration) predicate.Room {
+	vc := int64(v)
+	return predicate.Room(sql.FieldGT(FieldRetentionMaxAge, vc))
+}
+
+// RetentionMaxAgeGTE applies the GTE predicate on the "retention_max_age" field.
+func RetentionMaxAgeGTE(v time.Duration) predicate.Room {
+	vc := int64(v)
+	return predicate.Room(sql.FieldGTE(FieldRetentionMaxAge, vc))
+}
+
+// RetentionMaxAgeLT applies the LT predicate on the "retention_max_age" field.
+func RetentionMaxAgeLT(v time.Duration) predicate.Room {
+	vc := int64(v)
+	return predicate.Room(sql.FieldLT(FieldRetentionMaxAge, vc))
+}
+
+// RetentionMaxAgeLTE applies the LTE predicate on the "retention_max_age" field.
+func RetentionMaxAgeLTE(v time.Duration) predicate.Room {
+	vc := int64(v)
+	return predicate.Room(sql.FieldLTE(FieldRetentionMaxAge, vc))
+}
+
+// RetentionMaxAgeIsNil applies the IsNil predicate on the "retention_max_age" field.
+func RetentionMaxAgeIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldRetentionMaxAge))
+}
+
+// RetentionMaxAgeNotNil applies the NotNil predicate on the "retention_max_age" field.
+func RetentionMaxAgeNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldRetentionMaxAge))
+}
+
+// RetentionMaxMessagesEQ applies the EQ predicate on the "retention_max_messages" field.
+func RetentionMaxMessagesEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldRetentionMaxMessages, v))
+}
+
+// RetentionMaxMessagesNEQ applies the NEQ predicate on the "retention_max_messages" field.
+func RetentionMaxMessagesNEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldRetentionMaxMessages, v))
+}
+
+// RetentionMaxMessagesIn applies the In predicate on the "retention_max_messages" field.
+func RetentionMaxMessagesIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldRetentionMaxMessages, vs...))
+}
+
+// RetentionMaxMessagesNotIn applies the NotIn predicate on the "retention_max_messages" field.
+func RetentionMaxMessagesNotIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldRetentionMaxMessages, vs...))
+}
+
+// RetentionMaxMessagesGT applies the GT predicate on the "retention_max_messages" field.
+func RetentionMaxMessagesGT(v int) predicate.Room {
+	return predicate.Room(sql.FieldGT(FieldRetentionMaxMessages, v))
+}
+
+// RetentionMaxMessagesGTE applies the GTE predicate on the "retention_max_messages" field.
+func RetentionMaxMessagesGTE(v int) predicate.Room {
+	return predicate.Room(sql.FieldGTE(FieldRetentionMaxMessages, v))
+}
+
+// RetentionMaxMessagesLT applies the LT predicate on the "retention_max_messages" field.
+func RetentionMaxMessagesLT(v int) predicate.Room {
+	return predicate.Room(sql.FieldLT(FieldRetentionMaxMessages, v))
+}
+
+// RetentionMaxMessagesLTE applies the LTE predicate on the "retention_max_messages" field.
+func RetentionMaxMessagesLTE(v int) predicate.Room {
+	return predicate.Room(sql.FieldLTE(FieldRetentionMaxMessages, v))
+}
+
+// RetentionMaxMessagesIsNil applies the IsNil predicate on the "retention_max_messages" field.
+func RetentionMaxMessagesIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldRetentionMaxMessages))
+}
+
+// RetentionMaxMessagesNotNil applies the NotNil predicate on the "retention_max_messages" field.
+func RetentionMaxMessagesNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldRetentionMaxMessages))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.Room {
+	return predicate.Room(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.Room {
+	return predicate.Room(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.Room {
+	return predicate.Room(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.Room {
+	return predicate.Room(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.Room {
+	return predicate.Room(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.Room {
+	return predicate.Room(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.Room {
+	return predicate.Room(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldOwnerID))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.Room {
+	return predicate.Room(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.Room {
+	return predicate.Room(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// ImportKeyEQ applies the EQ predicate on the "import_key" field.
+func ImportKeyEQ(v string) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldImportKey, v))
+}
+
+// ImportKeyNEQ applies the NEQ predicate on the "import_key" field.
+func ImportKeyNEQ(v string) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldImportKey, v))
+}
+
+// ImportKeyIn applies the In predicate on the "import_key" field.
+func ImportKeyIn(vs ...string) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldImportKey, vs...))
+}
+
+// ImportKeyNotIn applies the NotIn predicate on the "import_key" field.
+func ImportKeyNotIn(vs ...string) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldImportKey, vs...))
+}
+
+// ImportKeyGT applies the GT predicate on the "import_key" field.
+func ImportKeyGT(v string) predicate.Room {
+	return predicate.Room(sql.FieldGT(FieldImportKey, v))
+}
+
+// ImportKeyGTE applies the GTE predicate on the "import_key" field.
+func ImportKeyGTE(v string) predicate.Room {
+	return predicate.Room(sql.FieldGTE(FieldImportKey, v))
+}
+
+// ImportKeyLT applies the LT predicate on the "import_key" field.
+func ImportKeyLT(v string) predicate.Room {
+	return predicate.Room(sql.FieldLT(FieldImportKey, v))
+}
+
+// ImportKeyLTE applies the LTE predicate on the "import_key" field.
+func ImportKeyLTE(v string) predicate.Room {
+	return predicate.Room(sql.FieldLTE(FieldImportKey, v))
+}
+
+// ImportKeyContains applies the Contains predicate on the "import_key" field.
+func ImportKeyContains(v string) predicate.Room {
+	return predicate.Room(sql.FieldContains(FieldImportKey, v))
+}
+
+// ImportKeyHasPrefix applies the HasPrefix predicate on the "import_key" field.
+func ImportKeyHasPrefix(v string) predicate.Room {
+	return predicate.Room(sql.FieldHasPrefix(FieldImportKey, v))
+}
+
+// ImportKeyHasSuffix applies the HasSuffix predicate on the "import_key" field.
+func ImportKeyHasSuffix(v string) predicate.Room {
+	return predicate.Room(sql.FieldHasSuffix(FieldImportKey, v))
+}
+
+// ImportKeyIsNil applies the IsNil predicate on the "import_key" field.
+func ImportKeyIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldImportKey))
+}
+
+// ImportKeyNotNil applies the NotNil predicate on the "import_key" field.
+func ImportKeyNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldImportKey))
+}
+
+// ImportKeyEqualFold applies the EqualFold predicate on the "import_key" field.
+func ImportKeyEqualFold(v string) predicate.Room {
+	return predicate.Room(sql.FieldEqualFold(FieldImportKey, v))
+}
+
+// ImportKeyContainsFold applies the ContainsFold predicate on the "import_key" field.
+func ImportKeyContainsFold(v string) predicate.Room {
+	return predicate.Room(sql.FieldContainsFold(FieldImportKey, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Room) predicate.Room {
 	return predicate.Room(sql.AndPredicates(predicates...))
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -25,6 +26,62 @@ func (rc *RoomCreate) SetName(s string) *RoomCreate {
 	return rc
 }
 
+// SetRetentionMaxAge sets the "retention_max_age" field.
+func (rc *RoomCreate) SetRetentionMaxAge(t time.Duration) *RoomCreate {
+	rc.mutation.SetRetentionMaxAge(t)
+	return rc
+}
+
+// SetNillableRetentionMaxAge sets the "retention_max_age" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableRetentionMaxAge(t *time.Duration) *RoomCreate {
+	if t != nil {
+		rc.SetRetentionMaxAge(*t)
+	}
+	return rc
+}
+
+// SetRetentionMaxMessages sets the "retention_max_messages" field.
+func (rc *RoomCreate) SetRetentionMaxMessages(i int) *RoomCreate {
+	rc.mutation.SetRetentionMaxMessages(i)
+	return rc
+}
+
+// SetNillableRetentionMaxMessages sets the "retention_max_messages" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableRetentionMaxMessages(i *int) *RoomCreate {
+	if i != nil {
+		rc.SetRetentionMaxMessages(*i)
+	}
+	return rc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (rc *RoomCreate) SetOwnerID(s string) *RoomCreate {
+	rc.mutation.SetOwnerID(s)
+	return rc
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableOwnerID(s *string) *RoomCreate {
+	if s != nil {
+		rc.SetOwnerID(*s)
+	}
+	return rc
+}
+
+// SetImportKey sets the "import_key" field.
+func (rc *RoomCreate) SetImportKey(s string) *RoomCreate {
+	rc.mutation.SetImportKey(s)
+	return rc
+}
+
+// SetNillableImportKey sets the "import_key" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableImportKey(s *string) *RoomCreate {
+	if s != nil {
+		rc.SetImportKey(*s)
+	}
+	return rc
+}
+
 // Mutation returns the RoomMutation object of the builder.
 func (rc *RoomCreate) Mutation() *RoomMutation {
 	return rc.mutation
@@ -67,6 +124,11 @@ func (rc *RoomCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Room.name": %w`, err)}
 		}
 	}
+	if v, ok := rc.mutation.RetentionMaxMessages(); ok {
+		if err := room.RetentionMaxMessagesValidator(v); err != nil {
+			return &ValidationError{Name: "retention_max_messages", err: fmt.Errorf(`ent: validator failed for field "Room.retention_max_messages": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -97,6 +159,22 @@ func (rc *RoomCreate) createSpec() (*Room, *sqlgraph.CreateSpec) {
 		_spec.SetField(room.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := rc.mutation.RetentionMaxAge(); ok {
+		_spec.SetField(room.FieldRetentionMaxAge, field.TypeInt64, value)
+		_node.RetentionMaxAge = &value
+	}
+	if value, ok := rc.mutation.RetentionMaxMessages(); ok {
+		_spec.SetField(room.FieldRetentionMaxMessages, field.TypeInt, value)
+		_node.RetentionMaxMessages = &value
+	}
+	if value, ok := rc.mutation.OwnerID(); ok {
+		_spec.SetField(room.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = &value
+	}
+	if value, ok := rc.mutation.ImportKey(); ok {
+		_spec.SetField(room.FieldImportKey, field.TypeString, value)
+		_node.ImportKey = &value
+	}
 	return _node, _spec
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -41,6 +42,80 @@ func (ru *RoomUpdate) SetNillableName(s *string) *RoomUpdate {
 	return ru
 }
 
+// SetRetentionMaxAge sets the "retention_max_age" field.
+func (ru *RoomUpdate) SetRetentionMaxAge(t time.Duration) *RoomUpdate {
+	ru.mutation.ResetRetentionMaxAge()
+	ru.mutation.SetRetentionMaxAge(t)
+	return ru
+}
+
+// SetNillableRetentionMaxAge sets the "retention_max_age" field if the given value is not nil.
+func (ru *RoomUpdate) SetNillableRetentionMaxAge(t *time.Duration) *RoomUpdate {
+	if t != nil {
+		ru.SetRetentionMaxAge(*t)
+	}
+	return ru
+}
+
+// AddRetentionMaxAge adds t to the "retention_max_age" field.
+func (ru *RoomUpdate) AddRetentionMaxAge(t time.Duration) *RoomUpdate {
+	ru.mutation.AddRetentionMaxAge(t)
+	return ru
+}
+
+// ClearRetentionMaxAge clears the value of the "retention_max_age" field.
+func (ru *RoomUpdate) ClearRetentionMaxAge() *RoomUpdate {
+	ru.mutation.ClearRetentionMaxAge()
+	return ru
+}
+
+// SetRetentionMaxMessages sets the "retention_max_messages" field.
+func (ru *RoomUpdate) SetRetentionMaxMessages(i int) *RoomUpdate {
+	ru.mutation.ResetRetentionMaxMessages()
+	ru.mutation.SetRetentionMaxMessages(i)
+	return ru
+}
+
+// SetNillableRetentionMaxMessages sets the "retention_max_messages" field if the given value is not nil.
+func (ru *RoomUpdate) SetNillableRetentionMaxMessages(i *int) *RoomUpdate {
+	if i != nil {
+		ru.SetRetentionMaxMessages(*i)
+	}
+	return ru
+}
+
+// AddRetentionMaxMessages adds i to the "retention_max_messages" field.
+func (ru *RoomUpdate) AddRetentionMaxMessages(i int) *RoomUpdate {
+	ru.mutation.AddRetentionMaxMessages(i)
+	return ru
+}
+
+// ClearRetentionMaxMessages clears the value of the "retention_max_messages" field.
+func (ru *RoomUpdate) ClearRetentionMaxMessages() *RoomUpdate {
+	ru.mutation.ClearRetentionMaxMessages()
+	return ru
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (ru *RoomUpdate) SetOwnerID(s string) *RoomUpdate {
+	ru.mutation.SetOwnerID(s)
+	return ru
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (ru *RoomUpdate) SetNillableOwnerID(s *string) *RoomUpdate {
+	if s != nil {
+		ru.SetOwnerID(*s)
+	}
+	return ru
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (ru *RoomUpdate) ClearOwnerID() *RoomUpdate {
+	ru.mutation.ClearOwnerID()
+	return ru
+}
+
 // Mutation returns the RoomMutation object of the builder.
 func (ru *RoomUpdate) Mutation() *RoomMutation {
 	return ru.mutation
@@ -80,6 +155,11 @@ func (ru *RoomUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Room.name": %w`, err)}
 		}
 	}
+	if v, ok := ru.mutation.RetentionMaxMessages(); ok {
+		if err := room.RetentionMaxMessagesValidator(v); err != nil {
+			return &ValidationError{Name: "retention_max_messages", err: fmt.Errorf(`ent: validator failed for field "Room.retention_max_messages": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -98,6 +178,33 @@ func (ru *RoomUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ru.mutation.Name(); ok {
 		_spec.SetField(room.FieldName, field.TypeString, value)
 	}
+	if value, ok := ru.mutation.RetentionMaxAge(); ok {
+		_spec.SetField(room.FieldRetentionMaxAge, field.TypeInt64, value)
+	}
+	if value, ok := ru.mutation.AddedRetentionMaxAge(); ok {
+		_spec.AddField(room.FieldRetentionMaxAge, field.TypeInt64, value)
+	}
+	if ru.mutation.RetentionMaxAgeCleared() {
+		_spec.ClearField(room.FieldRetentionMaxAge, field.TypeInt64)
+	}
+	if value, ok := ru.mutation.RetentionMaxMessages(); ok {
+		_spec.SetField(room.FieldRetentionMaxMessages, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedRetentionMaxMessages(); ok {
+		_spec.AddField(room.FieldRetentionMaxMessages, field.TypeInt, value)
+	}
+	if ru.mutation.RetentionMaxMessagesCleared() {
+		_spec.ClearField(room.FieldRetentionMaxMessages, field.TypeInt)
+	}
+	if value, ok := ru.mutation.OwnerID(); ok {
+		_spec.SetField(room.FieldOwnerID, field.TypeString, value)
+	}
+	if ru.mutation.OwnerIDCleared() {
+		_spec.ClearField(room.FieldOwnerID, field.TypeString)
+	}
+	if ru.mutation.ImportKeyCleared() {
+		_spec.ClearField(room.FieldImportKey, field.TypeString)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{room.Label}
@@ -132,6 +239,80 @@ func (ruo *RoomUpdateOne) SetNillableName(s *string) *RoomUpdateOne {
 	return ruo
 }
 
+// SetRetentionMaxAge sets the "retention_max_age" field.
+func (ruo *RoomUpdateOne) SetRetentionMaxAge(t time.Duration) *RoomUpdateOne {
+	ruo.mutation.ResetRetentionMaxAge()
+	ruo.mutation.SetRetentionMaxAge(t)
+	return ruo
+}
+
+// SetNillableRetentionMaxAge sets the "retention_max_age" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableRetentionMaxAge(t *time.Duration) *RoomUpdateOne {
+	if t != nil {
+		ruo.SetRetentionMaxAge(*t)
+	}
+	return ruo
+}
+
+// AddRetentionMaxAge adds t to the "retention_max_age" field.
+func (ruo *RoomUpdateOne) AddRetentionMaxAge(t time.Duration) *RoomUpdateOne {
+	ruo.mutation.AddRetentionMaxAge(t)
+	return ruo
+}
+
+// ClearRetentionMaxAge clears the value of the "retention_max_age" field.
+func (ruo *RoomUpdateOne) ClearRetentionMaxAge() *RoomUpdateOne {
+	ruo.mutation.ClearRetentionMaxAge()
+	return ruo
+}
+
+// SetRetentionMaxMessages sets the "retention_max_messages" field.
+func (ruo *RoomUpdateOne) SetRetentionMaxMessages(i int) *RoomUpdateOne {
+	ruo.mutation.ResetRetentionMaxMessages()
+	ruo.mutation.SetRetentionMaxMessages(i)
+	return ruo
+}
+
+// SetNillableRetentionMaxMessages sets the "retention_max_messages" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableRetentionMaxMessages(i *int) *RoomUpdateOne {
+	if i != nil {
+		ruo.SetRetentionMaxMessages(*i)
+	}
+	return ruo
+}
+
+// AddRetentionMaxMessages adds i to the "retention_max_messages" field.
+func (ruo *RoomUpdateOne) AddRetentionMaxMessages(i int) *RoomUpdateOne {
+	ruo.mutation.AddRetentionMaxMessages(i)
+	return ruo
+}
+
+// ClearRetentionMaxMessages clears the value of the "retention_max_messages" field.
+func (ruo *RoomUpdateOne) ClearRetentionMaxMessages() *RoomUpdateOne {
+	ruo.mutation.ClearRetentionMaxMessages()
+	return ruo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (ruo *RoomUpdateOne) SetOwnerID(s string) *RoomUpdateOne {
+	ruo.mutation.SetOwnerID(s)
+	return ruo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableOwnerID(s *string) *RoomUpdateOne {
+	if s != nil {
+		ruo.SetOwnerID(*s)
+	}
+	return ruo
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (ruo *RoomUpdateOne) ClearOwnerID() *RoomUpdateOne {
+	ruo.mutation.ClearOwnerID()
+	return ruo
+}
+
 // Mutation returns the RoomMutation object of the builder.
 func (ruo *RoomUpdateOne) Mutation() *RoomMutation {
 	return ruo.mutation
@@ -184,6 +365,11 @@ func (ruo *RoomUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Room.name": %w`, err)}
 		}
 	}
+	if v, ok := ruo.mutation.RetentionMaxMessages(); ok {
+		if err := room.RetentionMaxMessagesValidator(v); err != nil {
+			return &ValidationError{Name: "retention_max_messages", err: fmt.Errorf(`ent: validator failed for field "Room.retention_max_messages": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -219,6 +405,33 @@ func (ruo *RoomUpdateOne) sqlSave(ctx context.Context) (_node *Room, err error)
 	if value, ok := ruo.mutation.Name(); ok {
 		_spec.SetField(room.FieldName, field.TypeString, value)
 	}
+	if value, ok := ruo.mutation.RetentionMaxAge(); ok {
+		_spec.SetField(room.FieldRetentionMaxAge, field.TypeInt64, value)
+	}
+	if value, ok := ruo.mutation.AddedRetentionMaxAge(); ok {
+		_spec.AddField(room.FieldRetentionMaxAge, field.TypeInt64, value)
+	}
+	if ruo.mutation.RetentionMaxAgeCleared() {
+		_spec.ClearField(room.FieldRetentionMaxAge, field.TypeInt64)
+	}
+	if value, ok := ruo.mutation.RetentionMaxMessages(); ok {
+		_spec.SetField(room.FieldRetentionMaxMessages, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedRetentionMaxMessages(); ok {
+		_spec.AddField(room.FieldRetentionMaxMessages, field.TypeInt, value)
+	}
+	if ruo.mutation.RetentionMaxMessagesCleared() {
+		_spec.ClearField(room.FieldRetentionMaxMessages, field.TypeInt)
+	}
+	if value, ok := ruo.mutation.OwnerID(); ok {
+		_spec.SetField(room.FieldOwnerID, field.TypeString, value)
+	}
+	if ruo.mutation.OwnerIDCleared() {
+		_spec.ClearField(room.FieldOwnerID, field.TypeString)
+	}
+	if ruo.mutation.ImportKeyCleared() {
+		_spec.ClearField(room.FieldImportKey, field.TypeString)
+	}
 	_node = &Room{config: ruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexport"
+)
+
+// RoomExport is the model entity for the RoomExport schema.
+type RoomExport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// RoomID holds the value of the "room_id" field.
+	RoomID string `json:"room_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Format holds the value of the "format" field.
+	Format roomexport.Format `json:"format,omitempty"`
+	// From holds the value of the "from" field.
+	From *time.Time `json:"from,omitempty"`
+	// To holds the value of the "to" field.
+	To *time.Time `json:"to,omitempty"`
+	// Status holds the value of the "status" field.
+	Status roomexport.Status `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// MessageCount holds the value of the "message_count" field.
+	MessageCount int `json:"message_count,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// LeaseUntil holds the value of the "lease_until" field.
+	LeaseUntil   *time.Time `json:"lease_until,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoomExport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case roomexport.FieldID, roomexport.FieldMessageCount:
+			values[i] = new(sql.NullInt64)
+		case roomexport.FieldRoomID, roomexport.FieldUserID, roomexport.FieldFormat, roomexport.FieldStatus, roomexport.FieldError:
+			values[i] = new(sql.NullString)
+		case roomexport.FieldFrom, roomexport.FieldTo, roomexport.FieldCreatedAt, roomexport.FieldCompletedAt, roomexport.FieldLeaseUntil:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoomExport fields.
+func (re *RoomExport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case roomexport.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			re.ID = int(value.Int64)
+		case roomexport.FieldRoomID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value.Valid {
+				re.RoomID = value.String
+			}
+		case roomexport.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				re.UserID = value.String
+			}
+		case roomexport.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				re.Format = roomexport.Format(value.String)
+			}
+		case roomexport.FieldFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field from", values[i])
+			} else if value.Valid {
+				re.From = new(time.Time)
+				*re.From = value.Time
+			}
+		case roomexport.FieldTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field to", values[i])
+			} else if value.Valid {
+				re.To = new(time.Time)
+				*re.To = value.Time
+			}
+		case roomexport.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				re.Status = roomexport.Status(value.String)
+			}
+		case roomexport.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				re.Error = value.String
+			}
+		case roomexport.FieldMessageCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_count", values[i])
+			} else if value.Valid {
+				re.MessageCount = int(value.Int64)
+			}
+		case roomexport.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				re.CreatedAt = value.Time
+			}
+		case roomexport.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				re.CompletedAt = new(time.Time)
+				*re.CompletedAt = value.Time
+			}
+		case roomexport.FieldLeaseUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_until", values[i])
+			} else if value.Valid {
+				re.LeaseUntil = new(time.Time)
+				*re.LeaseUntil = value.Time
+			}
+		default:
+			re.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoomExport.
+// This includes values selected through modifiers, order, etc.
+func (re *RoomExport) Value(name string) (ent.Value, error) {
+	return re.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RoomExport.
+// Note that you need to call RoomExport.Unwrap() before calling this method if this RoomExport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (re *RoomExport) Update() *RoomExportUpdateOne {
+	return NewRoomExportClient(re.config).UpdateOne(re)
+}
+
+// Unwrap unwraps the RoomExport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (re *RoomExport) Unwrap() *RoomExport {
+	_tx, ok := re.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoomExport is not a transactional entity")
+	}
+	re.config.driver = _tx.drv
+	return re
+}
+
+// String implements the fmt.Stringer.
+func (re *RoomExport) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoomExport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", re.ID))
+	builder.WriteString("room_id=")
+	builder.WriteString(re.RoomID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(re.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", re.Format))
+	builder.WriteString(", ")
+	if v := re.From; v != nil {
+		builder.WriteString("from=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := re.To; v != nil {
+		builder.WriteString("to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", re.Status))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(re.Error)
+	builder.WriteString(", ")
+	builder.WriteString("message_count=")
+	builder.WriteString(fmt.Sprintf("%v", re.MessageCount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(re.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := re.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := re.LeaseUntil; v != nil {
+		builder.WriteString("lease_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoomExports is a parsable slice of RoomExport.
+type RoomExports []*RoomExport
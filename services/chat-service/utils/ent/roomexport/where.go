@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roomexport
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLTE(FieldID, id))
+}
+
+// RoomID applies equality check predicate on the "room_id" field. It's identical to RoomIDEQ.
+func RoomID(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldRoomID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldUserID, v))
+}
+
+// From applies equality check predicate on the "from" field. It's identical to FromEQ.
+func From(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldFrom, v))
+}
+
+// To applies equality check predicate on the "to" field. It's identical to ToEQ.
+func To(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldTo, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldError, v))
+}
+
+// MessageCount applies equality check predicate on the "message_count" field. It's identical to MessageCountEQ.
+func MessageCount(v int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldMessageCount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// LeaseUntil applies equality check predicate on the "lease_until" field. It's identical to LeaseUntilEQ.
+func LeaseUntil(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldLeaseUntil, v))
+}
+
+// RoomIDEQ applies the EQ predicate on the "room_id" field.
+func RoomIDEQ(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldRoomID, v))
+}
+
+// RoomIDNEQ applies the NEQ predicate on the "room_id" field.
+func RoomIDNEQ(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNEQ(FieldRoomID, v))
+}
+
+// RoomIDIn applies the In predicate on the "room_id" field.
+func RoomIDIn(vs ...string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIn(FieldRoomID, vs...))
+}
+
+// RoomIDNotIn applies the NotIn predicate on the "room_id" field.
+func RoomIDNotIn(vs ...string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotIn(FieldRoomID, vs...))
+}
+
+// RoomIDGT applies the GT predicate on the "room_id" field.
+func RoomIDGT(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGT(FieldRoomID, v))
+}
+
+// RoomIDGTE applies the GTE predicate on the "room_id" field.
+func RoomIDGTE(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGTE(FieldRoomID, v))
+}
+
+// RoomIDLT applies the LT predicate on the "room_id" field.
+func RoomIDLT(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLT(FieldRoomID, v))
+}
+
+// RoomIDLTE applies the LTE predicate on the "room_id" field.
+func RoomIDLTE(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLTE(FieldRoomID, v))
+}
+
+// RoomIDContains applies the Contains predicate on the "room_id" field.
+func RoomIDContains(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldContains(FieldRoomID, v))
+}
+
+// RoomIDHasPrefix applies the HasPrefix predicate on the "room_id" field.
+func RoomIDHasPrefix(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldHasPrefix(FieldRoomID, v))
+}
+
+// RoomIDHasSuffix applies the HasSuffix predicate on the "room_id" field.
+func RoomIDHasSuffix(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldHasSuffix(FieldRoomID, v))
+}
+
+// RoomIDEqualFold applies the EqualFold predicate on the "room_id" field.
+func RoomIDEqualFold(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEqualFold(FieldRoomID, v))
+}
+
+// RoomIDContainsFold applies the ContainsFold predicate on the "room_id" field.
+func RoomIDContainsFold(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldContainsFold(FieldRoomID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// FromEQ applies the EQ predicate on the "from" field.
+func FromEQ(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldFrom, v))
+}
+
+// FromNEQ applies the NEQ predicate on the "from" field.
+func FromNEQ(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNEQ(FieldFrom, v))
+}
+
+// FromIn applies the In predicate on the "from" field.
+func FromIn(vs ...time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIn(FieldFrom, vs...))
+}
+
+// FromNotIn applies the NotIn predicate on the "from" field.
+func FromNotIn(vs ...time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotIn(FieldFrom, vs...))
+}
+
+// FromGT applies the GT predicate on the "from" field.
+func FromGT(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGT(FieldFrom, v))
+}
+
+// FromGTE applies the GTE predicate on the "from" field.
+func FromGTE(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGTE(FieldFrom, v))
+}
+
+// FromLT applies the LT predicate on the "from" field.
+func FromLT(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLT(FieldFrom, v))
+}
+
+// FromLTE applies the LTE predicate on the "from" field.
+func FromLTE(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLTE(FieldFrom, v))
+}
+
+// FromIsNil applies the IsNil predicate on the "from" field.
+func FromIsNil() predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIsNull(FieldFrom))
+}
+
+// FromNotNil applies the NotNil predicate on the "from" field.
+func FromNotNil() predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotNull(FieldFrom))
+}
+
+// ToEQ applies the EQ predicate on the "to" field.
+func ToEQ(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldTo, v))
+}
+
+// ToNEQ applies the NEQ predicate on the "to" field.
+func ToNEQ(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNEQ(FieldTo, v))
+}
+
+// ToIn applies the In predicate on the "to" field.
+func ToIn(vs ...time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIn(FieldTo, vs...))
+}
+
+// ToNotIn applies the NotIn predicate on the "to" field.
+func ToNotIn(vs ...time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotIn(FieldTo, vs...))
+}
+
+// ToGT applies the GT predicate on the "to" field.
+func ToGT(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGT(FieldTo, v))
+}
+
+// ToGTE applies the GTE predicate on the "to" field.
+func ToGTE(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGTE(FieldTo, v))
+}
+
+// ToLT applies the LT predicate on the "to" field.
+func ToLT(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLT(FieldTo, v))
+}
+
+// ToLTE applies the LTE predicate on the "to" field.
+func ToLTE(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLTE(FieldTo, v))
+}
+
+// ToIsNil applies the IsNil predicate on the "to" field.
+func ToIsNil() predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIsNull(FieldTo))
+}
+
+// ToNotNil applies the NotNil predicate on the "to" field.
+func ToNotNil() predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotNull(FieldTo))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldContainsFold(FieldError, v))
+}
+
+// MessageCountEQ applies the EQ predicate on the "message_count" field.
+func MessageCountEQ(v int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldMessageCount, v))
+}
+
+// MessageCountNEQ applies the NEQ predicate on the "message_count" field.
+func MessageCountNEQ(v int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNEQ(FieldMessageCount, v))
+}
+
+// MessageCountIn applies the In predicate on the "message_count" field.
+func MessageCountIn(vs ...int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIn(FieldMessageCount, vs...))
+}
+
+// MessageCountNotIn applies the NotIn predicate on the "message_count" field.
+func MessageCountNotIn(vs ...int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotIn(FieldMessageCount, vs...))
+}
+
+// MessageCountGT applies the GT predicate on the "message_count" field.
+func MessageCountGT(v int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGT(FieldMessageCount, v))
+}
+
+// MessageCountGTE applies the GTE predicate on the "message_count" field.
+func MessageCountGTE(v int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGTE(FieldMessageCount, v))
+}
+
+// MessageCountLT applies the LT predicate on the "message_count" field.
+func MessageCountLT(v int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLT(FieldMessageCount, v))
+}
+
+// MessageCountLTE applies the LTE predicate on the "message_count" field.
+func MessageCountLTE(v int) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLTE(FieldMessageCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// LeaseUntilEQ applies the EQ predicate on the "lease_until" field.
+func LeaseUntilEQ(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldEQ(FieldLeaseUntil, v))
+}
+
+// LeaseUntilNEQ applies the NEQ predicate on the "lease_until" field.
+func LeaseUntilNEQ(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNEQ(FieldLeaseUntil, v))
+}
+
+// LeaseUntilIn applies the In predicate on the "lease_until" field.
+func LeaseUntilIn(vs ...time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIn(FieldLeaseUntil, vs...))
+}
+
+// LeaseUntilNotIn applies the NotIn predicate on the "lease_until" field.
+func LeaseUntilNotIn(vs ...time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotIn(FieldLeaseUntil, vs...))
+}
+
+// LeaseUntilGT applies the GT predicate on the "lease_until" field.
+func LeaseUntilGT(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGT(FieldLeaseUntil, v))
+}
+
+// LeaseUntilGTE applies the GTE predicate on the "lease_until" field.
+func LeaseUntilGTE(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldGTE(FieldLeaseUntil, v))
+}
+
+// LeaseUntilLT applies the LT predicate on the "lease_until" field.
+func LeaseUntilLT(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLT(FieldLeaseUntil, v))
+}
+
+// LeaseUntilLTE applies the LTE predicate on the "lease_until" field.
+func LeaseUntilLTE(v time.Time) predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldLTE(FieldLeaseUntil, v))
+}
+
+// LeaseUntilIsNil applies the IsNil predicate on the "lease_until" field.
+func LeaseUntilIsNil() predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldIsNull(FieldLeaseUntil))
+}
+
+// LeaseUntilNotNil applies the NotNil predicate on the "lease_until" field.
+func LeaseUntilNotNil() predicate.RoomExport {
+	return predicate.RoomExport(sql.FieldNotNull(FieldLeaseUntil))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RoomExport) predicate.RoomExport {
+	return predicate.RoomExport(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RoomExport) predicate.RoomExport {
+	return predicate.RoomExport(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RoomExport) predicate.RoomExport {
+	return predicate.RoomExport(sql.NotPredicates(p))
+}
@@ -0,0 +1,401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexport"
+)
+
+// RoomExportCreate is the builder for creating a RoomExport entity.
+type RoomExportCreate struct {
+	config
+	mutation *RoomExportMutation
+	hooks    []Hook
+}
+
+// SetRoomID sets the "room_id" field.
+func (rec *RoomExportCreate) SetRoomID(s string) *RoomExportCreate {
+	rec.mutation.SetRoomID(s)
+	return rec
+}
+
+// SetUserID sets the "user_id" field.
+func (rec *RoomExportCreate) SetUserID(s string) *RoomExportCreate {
+	rec.mutation.SetUserID(s)
+	return rec
+}
+
+// SetFormat sets the "format" field.
+func (rec *RoomExportCreate) SetFormat(r roomexport.Format) *RoomExportCreate {
+	rec.mutation.SetFormat(r)
+	return rec
+}
+
+// SetFrom sets the "from" field.
+func (rec *RoomExportCreate) SetFrom(t time.Time) *RoomExportCreate {
+	rec.mutation.SetFrom(t)
+	return rec
+}
+
+// SetNillableFrom sets the "from" field if the given value is not nil.
+func (rec *RoomExportCreate) SetNillableFrom(t *time.Time) *RoomExportCreate {
+	if t != nil {
+		rec.SetFrom(*t)
+	}
+	return rec
+}
+
+// SetTo sets the "to" field.
+func (rec *RoomExportCreate) SetTo(t time.Time) *RoomExportCreate {
+	rec.mutation.SetTo(t)
+	return rec
+}
+
+// SetNillableTo sets the "to" field if the given value is not nil.
+func (rec *RoomExportCreate) SetNillableTo(t *time.Time) *RoomExportCreate {
+	if t != nil {
+		rec.SetTo(*t)
+	}
+	return rec
+}
+
+// SetStatus sets the "status" field.
+func (rec *RoomExportCreate) SetStatus(r roomexport.Status) *RoomExportCreate {
+	rec.mutation.SetStatus(r)
+	return rec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rec *RoomExportCreate) SetNillableStatus(r *roomexport.Status) *RoomExportCreate {
+	if r != nil {
+		rec.SetStatus(*r)
+	}
+	return rec
+}
+
+// SetError sets the "error" field.
+func (rec *RoomExportCreate) SetError(s string) *RoomExportCreate {
+	rec.mutation.SetError(s)
+	return rec
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (rec *RoomExportCreate) SetNillableError(s *string) *RoomExportCreate {
+	if s != nil {
+		rec.SetError(*s)
+	}
+	return rec
+}
+
+// SetMessageCount sets the "message_count" field.
+func (rec *RoomExportCreate) SetMessageCount(i int) *RoomExportCreate {
+	rec.mutation.SetMessageCount(i)
+	return rec
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (rec *RoomExportCreate) SetNillableMessageCount(i *int) *RoomExportCreate {
+	if i != nil {
+		rec.SetMessageCount(*i)
+	}
+	return rec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rec *RoomExportCreate) SetCreatedAt(t time.Time) *RoomExportCreate {
+	rec.mutation.SetCreatedAt(t)
+	return rec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rec *RoomExportCreate) SetNillableCreatedAt(t *time.Time) *RoomExportCreate {
+	if t != nil {
+		rec.SetCreatedAt(*t)
+	}
+	return rec
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (rec *RoomExportCreate) SetCompletedAt(t time.Time) *RoomExportCreate {
+	rec.mutation.SetCompletedAt(t)
+	return rec
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (rec *RoomExportCreate) SetNillableCompletedAt(t *time.Time) *RoomExportCreate {
+	if t != nil {
+		rec.SetCompletedAt(*t)
+	}
+	return rec
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (rec *RoomExportCreate) SetLeaseUntil(t time.Time) *RoomExportCreate {
+	rec.mutation.SetLeaseUntil(t)
+	return rec
+}
+
+// SetNillableLeaseUntil sets the "lease_until" field if the given value is not nil.
+func (rec *RoomExportCreate) SetNillableLeaseUntil(t *time.Time) *RoomExportCreate {
+	if t != nil {
+		rec.SetLeaseUntil(*t)
+	}
+	return rec
+}
+
+// Mutation returns the RoomExportMutation object of the builder.
+func (rec *RoomExportCreate) Mutation() *RoomExportMutation {
+	return rec.mutation
+}
+
+// Save creates the RoomExport in the database.
+func (rec *RoomExportCreate) Save(ctx context.Context) (*RoomExport, error) {
+	rec.defaults()
+	return withHooks(ctx, rec.sqlSave, rec.mutation, rec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rec *RoomExportCreate) SaveX(ctx context.Context) *RoomExport {
+	v, err := rec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rec *RoomExportCreate) Exec(ctx context.Context) error {
+	_, err := rec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rec *RoomExportCreate) ExecX(ctx context.Context) {
+	if err := rec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rec *RoomExportCreate) defaults() {
+	if _, ok := rec.mutation.Status(); !ok {
+		v := roomexport.DefaultStatus
+		rec.mutation.SetStatus(v)
+	}
+	if _, ok := rec.mutation.MessageCount(); !ok {
+		v := roomexport.DefaultMessageCount
+		rec.mutation.SetMessageCount(v)
+	}
+	if _, ok := rec.mutation.CreatedAt(); !ok {
+		v := roomexport.DefaultCreatedAt()
+		rec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rec *RoomExportCreate) check() error {
+	if _, ok := rec.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room_id", err: errors.New(`ent: missing required field "RoomExport.room_id"`)}
+	}
+	if v, ok := rec.mutation.RoomID(); ok {
+		if err := roomexport.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "RoomExport.room_id": %w`, err)}
+		}
+	}
+	if _, ok := rec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RoomExport.user_id"`)}
+	}
+	if v, ok := rec.mutation.UserID(); ok {
+		if err := roomexport.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "RoomExport.user_id": %w`, err)}
+		}
+	}
+	if _, ok := rec.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "RoomExport.format"`)}
+	}
+	if v, ok := rec.mutation.Format(); ok {
+		if err := roomexport.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "RoomExport.format": %w`, err)}
+		}
+	}
+	if _, ok := rec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RoomExport.status"`)}
+	}
+	if v, ok := rec.mutation.Status(); ok {
+		if err := roomexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RoomExport.status": %w`, err)}
+		}
+	}
+	if _, ok := rec.mutation.MessageCount(); !ok {
+		return &ValidationError{Name: "message_count", err: errors.New(`ent: missing required field "RoomExport.message_count"`)}
+	}
+	if _, ok := rec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoomExport.created_at"`)}
+	}
+	return nil
+}
+
+func (rec *RoomExportCreate) sqlSave(ctx context.Context) (*RoomExport, error) {
+	if err := rec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rec.mutation.id = &_node.ID
+	rec.mutation.done = true
+	return _node, nil
+}
+
+func (rec *RoomExportCreate) createSpec() (*RoomExport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoomExport{config: rec.config}
+		_spec = sqlgraph.NewCreateSpec(roomexport.Table, sqlgraph.NewFieldSpec(roomexport.FieldID, field.TypeInt))
+	)
+	if value, ok := rec.mutation.RoomID(); ok {
+		_spec.SetField(roomexport.FieldRoomID, field.TypeString, value)
+		_node.RoomID = value
+	}
+	if value, ok := rec.mutation.UserID(); ok {
+		_spec.SetField(roomexport.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := rec.mutation.Format(); ok {
+		_spec.SetField(roomexport.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := rec.mutation.From(); ok {
+		_spec.SetField(roomexport.FieldFrom, field.TypeTime, value)
+		_node.From = &value
+	}
+	if value, ok := rec.mutation.To(); ok {
+		_spec.SetField(roomexport.FieldTo, field.TypeTime, value)
+		_node.To = &value
+	}
+	if value, ok := rec.mutation.Status(); ok {
+		_spec.SetField(roomexport.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rec.mutation.Error(); ok {
+		_spec.SetField(roomexport.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := rec.mutation.MessageCount(); ok {
+		_spec.SetField(roomexport.FieldMessageCount, field.TypeInt, value)
+		_node.MessageCount = value
+	}
+	if value, ok := rec.mutation.CreatedAt(); ok {
+		_spec.SetField(roomexport.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rec.mutation.CompletedAt(); ok {
+		_spec.SetField(roomexport.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := rec.mutation.LeaseUntil(); ok {
+		_spec.SetField(roomexport.FieldLeaseUntil, field.TypeTime, value)
+		_node.LeaseUntil = &value
+	}
+	return _node, _spec
+}
+
+// RoomExportCreateBulk is the builder for creating many RoomExport entities in bulk.
+type RoomExportCreateBulk struct {
+	config
+	err      error
+	builders []*RoomExportCreate
+}
+
+// Save creates the RoomExport entities in the database.
+func (recb *RoomExportCreateBulk) Save(ctx context.Context) ([]*RoomExport, error) {
+	if recb.err != nil {
+		return nil, recb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(recb.builders))
+	nodes := make([]*RoomExport, len(recb.builders))
+	mutators := make([]Mutator, len(recb.builders))
+	for i := range recb.builders {
+		func(i int, root context.Context) {
+			builder := recb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoomExportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, recb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, recb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, recb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (recb *RoomExportCreateBulk) SaveX(ctx context.Context) []*RoomExport {
+	v, err := recb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (recb *RoomExportCreateBulk) Exec(ctx context.Context) error {
+	_, err := recb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (recb *RoomExportCreateBulk) ExecX(ctx context.Context) {
+	if err := recb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexport"
+)
+
+// RoomExportDelete is the builder for deleting a RoomExport entity.
+type RoomExportDelete struct {
+	config
+	hooks    []Hook
+	mutation *RoomExportMutation
+}
+
+// Where appends a list predicates to the RoomExportDelete builder.
+func (red *RoomExportDelete) Where(ps ...predicate.RoomExport) *RoomExportDelete {
+	red.mutation.Where(ps...)
+	return red
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (red *RoomExportDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, red.sqlExec, red.mutation, red.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (red *RoomExportDelete) ExecX(ctx context.Context) int {
+	n, err := red.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (red *RoomExportDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(roomexport.Table, sqlgraph.NewFieldSpec(roomexport.FieldID, field.TypeInt))
+	if ps := red.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, red.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	red.mutation.done = true
+	return affected, err
+}
+
+// RoomExportDeleteOne is the builder for deleting a single RoomExport entity.
+type RoomExportDeleteOne struct {
+	red *RoomExportDelete
+}
+
+// Where appends a list predicates to the RoomExportDelete builder.
+func (redo *RoomExportDeleteOne) Where(ps ...predicate.RoomExport) *RoomExportDeleteOne {
+	redo.red.mutation.Where(ps...)
+	return redo
+}
+
+// Exec executes the deletion query.
+func (redo *RoomExportDeleteOne) Exec(ctx context.Context) error {
+	n, err := redo.red.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{roomexport.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (redo *RoomExportDeleteOne) ExecX(ctx context.Context) {
+	if err := redo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexport"
+)
+
+// RoomExportQuery is the builder for querying RoomExport entities.
+type RoomExportQuery struct {
+	config
+	ctx        *QueryContext
+	order      []roomexport.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RoomExport
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RoomExportQuery builder.
+func (req *RoomExportQuery) Where(ps ...predicate.RoomExport) *RoomExportQuery {
+	req.predicates = append(req.predicates, ps...)
+	return req
+}
+
+// Limit the number of records to be returned by this query.
+func (req *RoomExportQuery) Limit(limit int) *RoomExportQuery {
+	req.ctx.Limit = &limit
+	return req
+}
+
+// Offset to start from.
+func (req *RoomExportQuery) Offset(offset int) *RoomExportQuery {
+	req.ctx.Offset = &offset
+	return req
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (req *RoomExportQuery) Unique(unique bool) *RoomExportQuery {
+	req.ctx.Unique = &unique
+	return req
+}
+
+// Order specifies how the records should be ordered.
+func (req *RoomExportQuery) Order(o ...roomexport.OrderOption) *RoomExportQuery {
+	req.order = append(req.order, o...)
+	return req
+}
+
+// First returns the first RoomExport entity from the query.
+// Returns a *NotFoundError when no RoomExport was found.
+func (req *RoomExportQuery) First(ctx context.Context) (*RoomExport, error) {
+	nodes, err := req.Limit(1).All(setContextOp(ctx, req.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{roomexport.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (req *RoomExportQuery) FirstX(ctx context.Context) *RoomExport {
+	node, err := req.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RoomExport ID from the query.
+// Returns a *NotFoundError when no RoomExport ID was found.
+func (req *RoomExportQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = req.Limit(1).IDs(setContextOp(ctx, req.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{roomexport.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (req *RoomExportQuery) FirstIDX(ctx context.Context) int {
+	id, err := req.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RoomExport entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RoomExport entity is found.
+// Returns a *NotFoundError when no RoomExport entities are found.
+func (req *RoomExportQuery) Only(ctx context.Context) (*RoomExport, error) {
+	nodes, err := req.Limit(2).All(setContextOp(ctx, req.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{roomexport.Label}
+	default:
+		return nil, &NotSingularError{roomexport.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (req *RoomExportQuery) OnlyX(ctx context.Context) *RoomExport {
+	node, err := req.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RoomExport ID in the query.
+// Returns a *NotSingularError when more than one RoomExport ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (req *RoomExportQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = req.Limit(2).IDs(setContextOp(ctx, req.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{roomexport.Label}
+	default:
+		err = &NotSingularError{roomexport.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (req *RoomExportQuery) OnlyIDX(ctx context.Context) int {
+	id, err := req.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RoomExports.
+func (req *RoomExportQuery) All(ctx context.Context) ([]*RoomExport, error) {
+	ctx = setContextOp(ctx, req.ctx, ent.OpQueryAll)
+	if err := req.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RoomExport, *RoomExportQuery]()
+	return withInterceptors[[]*RoomExport](ctx, req, qr, req.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (req *RoomExportQuery) AllX(ctx context.Context) []*RoomExport {
+	nodes, err := req.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RoomExport IDs.
+func (req *RoomExportQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if req.ctx.Unique == nil && req.path != nil {
+		req.Unique(true)
+	}
+	ctx = setContextOp(ctx, req.ctx, ent.OpQueryIDs)
+	if err = req.Select(roomexport.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (req *RoomExportQuery) IDsX(ctx context.Context) []int {
+	ids, err := req.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (req *RoomExportQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, req.ctx, ent.OpQueryCount)
+	if err := req.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, req, querierCount[*RoomExportQuery](), req.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (req *RoomExportQuery) CountX(ctx context.Context) int {
+	count, err := req.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (req *RoomExportQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, req.ctx, ent.OpQueryExist)
+	switch _, err := req.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (req *RoomExportQuery) ExistX(ctx context.Context) bool {
+	exist, err := req.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RoomExportQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (req *RoomExportQuery) Clone() *RoomExportQuery {
+	if req == nil {
+		return nil
+	}
+	return &RoomExportQuery{
+		config:     req.config,
+		ctx:        req.ctx.Clone(),
+		order:      append([]roomexport.OrderOption{}, req.order...),
+		inters:     append([]Interceptor{}, req.inters...),
+		predicates: append([]predicate.RoomExport{}, req.predicates...),
+		// clone intermediate query.
+		sql:  req.sql.Clone(),
+		path: req.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		RoomID string `json:"room_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RoomExport.Query().
+//		GroupBy(roomexport.FieldRoomID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (req *RoomExportQuery) GroupBy(field string, fields ...string) *RoomExportGroupBy {
+	req.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RoomExportGroupBy{build: req}
+	grbuild.flds = &req.ctx.Fields
+	grbuild.label = roomexport.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		RoomID string `json:"room_id,omitempty"`
+//	}
+//
+//	client.RoomExport.Query().
+//		Select(roomexport.FieldRoomID).
+//		Scan(ctx, &v)
+func (req *RoomExportQuery) Select(fields ...string) *RoomExportSelect {
+	req.ctx.Fields = append(req.ctx.Fields, fields...)
+	sbuild := &RoomExportSelect{RoomExportQuery: req}
+	sbuild.label = roomexport.Label
+	sbuild.flds, sbuild.scan = &req.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RoomExportSelect configured with the given aggregations.
+func (req *RoomExportQuery) Aggregate(fns ...AggregateFunc) *RoomExportSelect {
+	return req.Select().Aggregate(fns...)
+}
+
+func (req *RoomExportQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range req.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, req); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range req.ctx.Fields {
+		if !roomexport.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if req.path != nil {
+		prev, err := req.path(ctx)
+		if err != nil {
+			return err
+		}
+		req.sql = prev
+	}
+	return nil
+}
+
+func (req *RoomExportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RoomExport, error) {
+	var (
+		nodes = []*RoomExport{}
+		_spec = req.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RoomExport).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RoomExport{config: req.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, req.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (req *RoomExportQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := req.querySpec()
+	_spec.Node.Columns = req.ctx.Fields
+	if len(req.ctx.Fields) > 0 {
+		_spec.Unique = req.ctx.Unique != nil && *req.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, req.driver, _spec)
+}
+
+func (req *RoomExportQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(roomexport.Table, roomexport.Columns, sqlgraph.NewFieldSpec(roomexport.FieldID, field.TypeInt))
+	_spec.From = req.sql
+	if unique := req.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if req.path != nil {
+		_spec.Unique = true
+	}
+	if fields := req.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roomexport.FieldID)
+		for i := range fields {
+			if fields[i] != roomexport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := req.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := req.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := req.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := req.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (req *RoomExportQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(req.driver.Dialect())
+	t1 := builder.Table(roomexport.Table)
+	columns := req.ctx.Fields
+	if len(columns) == 0 {
+		columns = roomexport.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if req.sql != nil {
+		selector = req.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if req.ctx.Unique != nil && *req.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range req.predicates {
+		p(selector)
+	}
+	for _, p := range req.order {
+		p(selector)
+	}
+	if offset := req.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := req.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RoomExportGroupBy is the group-by builder for RoomExport entities.
+type RoomExportGroupBy struct {
+	selector
+	build *RoomExportQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (regb *RoomExportGroupBy) Aggregate(fns ...AggregateFunc) *RoomExportGroupBy {
+	regb.fns = append(regb.fns, fns...)
+	return regb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (regb *RoomExportGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, regb.build.ctx, ent.OpQueryGroupBy)
+	if err := regb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoomExportQuery, *RoomExportGroupBy](ctx, regb.build, regb, regb.build.inters, v)
+}
+
+func (regb *RoomExportGroupBy) sqlScan(ctx context.Context, root *RoomExportQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(regb.fns))
+	for _, fn := range regb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*regb.flds)+len(regb.fns))
+		for _, f := range *regb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*regb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := regb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RoomExportSelect is the builder for selecting fields of RoomExport entities.
+type RoomExportSelect struct {
+	*RoomExportQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (res *RoomExportSelect) Aggregate(fns ...AggregateFunc) *RoomExportSelect {
+	res.fns = append(res.fns, fns...)
+	return res
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (res *RoomExportSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, res.ctx, ent.OpQuerySelect)
+	if err := res.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoomExportQuery, *RoomExportSelect](ctx, res.RoomExportQuery, res, res.inters, v)
+}
+
+func (res *RoomExportSelect) sqlScan(ctx context.Context, root *RoomExportQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(res.fns))
+	for _, fn := range res.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*res.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := res.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
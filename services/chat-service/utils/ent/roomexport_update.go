@@ -0,0 +1,682 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexport"
+)
+
+// RoomExportUpdate is the builder for updating RoomExport entities.
+type RoomExportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoomExportMutation
+}
+
+// Where appends a list predicates to the RoomExportUpdate builder.
+func (reu *RoomExportUpdate) Where(ps ...predicate.RoomExport) *RoomExportUpdate {
+	reu.mutation.Where(ps...)
+	return reu
+}
+
+// SetRoomID sets the "room_id" field.
+func (reu *RoomExportUpdate) SetRoomID(s string) *RoomExportUpdate {
+	reu.mutation.SetRoomID(s)
+	return reu
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (reu *RoomExportUpdate) SetNillableRoomID(s *string) *RoomExportUpdate {
+	if s != nil {
+		reu.SetRoomID(*s)
+	}
+	return reu
+}
+
+// SetUserID sets the "user_id" field.
+func (reu *RoomExportUpdate) SetUserID(s string) *RoomExportUpdate {
+	reu.mutation.SetUserID(s)
+	return reu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (reu *RoomExportUpdate) SetNillableUserID(s *string) *RoomExportUpdate {
+	if s != nil {
+		reu.SetUserID(*s)
+	}
+	return reu
+}
+
+// SetFormat sets the "format" field.
+func (reu *RoomExportUpdate) SetFormat(r roomexport.Format) *RoomExportUpdate {
+	reu.mutation.SetFormat(r)
+	return reu
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (reu *RoomExportUpdate) SetNillableFormat(r *roomexport.Format) *RoomExportUpdate {
+	if r != nil {
+		reu.SetFormat(*r)
+	}
+	return reu
+}
+
+// SetFrom sets the "from" field.
+func (reu *RoomExportUpdate) SetFrom(t time.Time) *RoomExportUpdate {
+	reu.mutation.SetFrom(t)
+	return reu
+}
+
+// SetNillableFrom sets the "from" field if the given value is not nil.
+func (reu *RoomExportUpdate) SetNillableFrom(t *time.Time) *RoomExportUpdate {
+	if t != nil {
+		reu.SetFrom(*t)
+	}
+	return reu
+}
+
+// ClearFrom clears the value of the "from" field.
+func (reu *RoomExportUpdate) ClearFrom() *RoomExportUpdate {
+	reu.mutation.ClearFrom()
+	return reu
+}
+
+// SetTo sets the "to" field.
+func (reu *RoomExportUpdate) SetTo(t time.Time) *RoomExportUpdate {
+	reu.mutation.SetTo(t)
+	return reu
+}
+
+// SetNillableTo sets the "to" field if the given value is not nil.
+func (reu *RoomExportUpdate) SetNillableTo(t *time.Time) *RoomExportUpdate {
+	if t != nil {
+		reu.SetTo(*t)
+	}
+	return reu
+}
+
+// ClearTo clears the value of the "to" field.
+func (reu *RoomExportUpdate) ClearTo() *RoomExportUpdate {
+	reu.mutation.ClearTo()
+	return reu
+}
+
+// SetStatus sets the "status" field.
+func (reu *RoomExportUpdate) SetStatus(r roomexport.Status) *RoomExportUpdate {
+	reu.mutation.SetStatus(r)
+	return reu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (reu *RoomExportUpdate) SetNillableStatus(r *roomexport.Status) *RoomExportUpdate {
+	if r != nil {
+		reu.SetStatus(*r)
+	}
+	return reu
+}
+
+// SetError sets the "error" field.
+func (reu *RoomExportUpdate) SetError(s string) *RoomExportUpdate {
+	reu.mutation.SetError(s)
+	return reu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (reu *RoomExportUpdate) SetNillableError(s *string) *RoomExportUpdate {
+	if s != nil {
+		reu.SetError(*s)
+	}
+	return reu
+}
+
+// ClearError clears the value of the "error" field.
+func (reu *RoomExportUpdate) ClearError() *RoomExportUpdate {
+	reu.mutation.ClearError()
+	return reu
+}
+
+// SetMessageCount sets the "message_count" field.
+func (reu *RoomExportUpdate) SetMessageCount(i int) *RoomExportUpdate {
+	reu.mutation.ResetMessageCount()
+	reu.mutation.SetMessageCount(i)
+	return reu
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (reu *RoomExportUpdate) SetNillableMessageCount(i *int) *RoomExportUpdate {
+	if i != nil {
+		reu.SetMessageCount(*i)
+	}
+	return reu
+}
+
+// AddMessageCount adds i to the "message_count" field.
+func (reu *RoomExportUpdate) AddMessageCount(i int) *RoomExportUpdate {
+	reu.mutation.AddMessageCount(i)
+	return reu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (reu *RoomExportUpdate) SetCompletedAt(t time.Time) *RoomExportUpdate {
+	reu.mutation.SetCompletedAt(t)
+	return reu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (reu *RoomExportUpdate) SetNillableCompletedAt(t *time.Time) *RoomExportUpdate {
+	if t != nil {
+		reu.SetCompletedAt(*t)
+	}
+	return reu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (reu *RoomExportUpdate) ClearCompletedAt() *RoomExportUpdate {
+	reu.mutation.ClearCompletedAt()
+	return reu
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (reu *RoomExportUpdate) SetLeaseUntil(t time.Time) *RoomExportUpdate {
+	reu.mutation.SetLeaseUntil(t)
+	return reu
+}
+
+// SetNillableLeaseUntil sets the "lease_until" field if the given value is not nil.
+func (reu *RoomExportUpdate) SetNillableLeaseUntil(t *time.Time) *RoomExportUpdate {
+	if t != nil {
+		reu.SetLeaseUntil(*t)
+	}
+	return reu
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (reu *RoomExportUpdate) ClearLeaseUntil() *RoomExportUpdate {
+	reu.mutation.ClearLeaseUntil()
+	return reu
+}
+
+// Mutation returns the RoomExportMutation object of the builder.
+func (reu *RoomExportUpdate) Mutation() *RoomExportMutation {
+	return reu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (reu *RoomExportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, reu.sqlSave, reu.mutation, reu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (reu *RoomExportUpdate) SaveX(ctx context.Context) int {
+	affected, err := reu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (reu *RoomExportUpdate) Exec(ctx context.Context) error {
+	_, err := reu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (reu *RoomExportUpdate) ExecX(ctx context.Context) {
+	if err := reu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (reu *RoomExportUpdate) check() error {
+	if v, ok := reu.mutation.RoomID(); ok {
+		if err := roomexport.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "RoomExport.room_id": %w`, err)}
+		}
+	}
+	if v, ok := reu.mutation.UserID(); ok {
+		if err := roomexport.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "RoomExport.user_id": %w`, err)}
+		}
+	}
+	if v, ok := reu.mutation.Format(); ok {
+		if err := roomexport.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "RoomExport.format": %w`, err)}
+		}
+	}
+	if v, ok := reu.mutation.Status(); ok {
+		if err := roomexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RoomExport.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (reu *RoomExportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := reu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roomexport.Table, roomexport.Columns, sqlgraph.NewFieldSpec(roomexport.FieldID, field.TypeInt))
+	if ps := reu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := reu.mutation.RoomID(); ok {
+		_spec.SetField(roomexport.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := reu.mutation.UserID(); ok {
+		_spec.SetField(roomexport.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := reu.mutation.Format(); ok {
+		_spec.SetField(roomexport.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := reu.mutation.From(); ok {
+		_spec.SetField(roomexport.FieldFrom, field.TypeTime, value)
+	}
+	if reu.mutation.FromCleared() {
+		_spec.ClearField(roomexport.FieldFrom, field.TypeTime)
+	}
+	if value, ok := reu.mutation.To(); ok {
+		_spec.SetField(roomexport.FieldTo, field.TypeTime, value)
+	}
+	if reu.mutation.ToCleared() {
+		_spec.ClearField(roomexport.FieldTo, field.TypeTime)
+	}
+	if value, ok := reu.mutation.Status(); ok {
+		_spec.SetField(roomexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := reu.mutation.Error(); ok {
+		_spec.SetField(roomexport.FieldError, field.TypeString, value)
+	}
+	if reu.mutation.ErrorCleared() {
+		_spec.ClearField(roomexport.FieldError, field.TypeString)
+	}
+	if value, ok := reu.mutation.MessageCount(); ok {
+		_spec.SetField(roomexport.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := reu.mutation.AddedMessageCount(); ok {
+		_spec.AddField(roomexport.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := reu.mutation.CompletedAt(); ok {
+		_spec.SetField(roomexport.FieldCompletedAt, field.TypeTime, value)
+	}
+	if reu.mutation.CompletedAtCleared() {
+		_spec.ClearField(roomexport.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := reu.mutation.LeaseUntil(); ok {
+		_spec.SetField(roomexport.FieldLeaseUntil, field.TypeTime, value)
+	}
+	if reu.mutation.LeaseUntilCleared() {
+		_spec.ClearField(roomexport.FieldLeaseUntil, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, reu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roomexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	reu.mutation.done = true
+	return n, nil
+}
+
+// RoomExportUpdateOne is the builder for updating a single RoomExport entity.
+type RoomExportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoomExportMutation
+}
+
+// SetRoomID sets the "room_id" field.
+func (reuo *RoomExportUpdateOne) SetRoomID(s string) *RoomExportUpdateOne {
+	reuo.mutation.SetRoomID(s)
+	return reuo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (reuo *RoomExportUpdateOne) SetNillableRoomID(s *string) *RoomExportUpdateOne {
+	if s != nil {
+		reuo.SetRoomID(*s)
+	}
+	return reuo
+}
+
+// SetUserID sets the "user_id" field.
+func (reuo *RoomExportUpdateOne) SetUserID(s string) *RoomExportUpdateOne {
+	reuo.mutation.SetUserID(s)
+	return reuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (reuo *RoomExportUpdateOne) SetNillableUserID(s *string) *RoomExportUpdateOne {
+	if s != nil {
+		reuo.SetUserID(*s)
+	}
+	return reuo
+}
+
+// SetFormat sets the "format" field.
+func (reuo *RoomExportUpdateOne) SetFormat(r roomexport.Format) *RoomExportUpdateOne {
+	reuo.mutation.SetFormat(r)
+	return reuo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (reuo *RoomExportUpdateOne) SetNillableFormat(r *roomexport.Format) *RoomExportUpdateOne {
+	if r != nil {
+		reuo.SetFormat(*r)
+	}
+	return reuo
+}
+
+// SetFrom sets the "from" field.
+func (reuo *RoomExportUpdateOne) SetFrom(t time.Time) *RoomExportUpdateOne {
+	reuo.mutation.SetFrom(t)
+	return reuo
+}
+
+// SetNillableFrom sets the "from" field if the given value is not nil.
+func (reuo *RoomExportUpdateOne) SetNillableFrom(t *time.Time) *RoomExportUpdateOne {
+	if t != nil {
+		reuo.SetFrom(*t)
+	}
+	return reuo
+}
+
+// ClearFrom clears the value of the "from" field.
+func (reuo *RoomExportUpdateOne) ClearFrom() *RoomExportUpdateOne {
+	reuo.mutation.ClearFrom()
+	return reuo
+}
+
+// SetTo sets the "to" field.
+func (reuo *RoomExportUpdateOne) SetTo(t time.Time) *RoomExportUpdateOne {
+	reuo.mutation.SetTo(t)
+	return reuo
+}
+
+// SetNillableTo sets the "to" field if the given value is not nil.
+func (reuo *RoomExportUpdateOne) SetNillableTo(t *time.Time) *RoomExportUpdateOne {
+	if t != nil {
+		reuo.SetTo(*t)
+	}
+	return reuo
+}
+
+// ClearTo clears the value of the "to" field.
+func (reuo *RoomExportUpdateOne) ClearTo() *RoomExportUpdateOne {
+	reuo.mutation.ClearTo()
+	return reuo
+}
+
+// SetStatus sets the "status" field.
+func (reuo *RoomExportUpdateOne) SetStatus(r roomexport.Status) *RoomExportUpdateOne {
+	reuo.mutation.SetStatus(r)
+	return reuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (reuo *RoomExportUpdateOne) SetNillableStatus(r *roomexport.Status) *RoomExportUpdateOne {
+	if r != nil {
+		reuo.SetStatus(*r)
+	}
+	return reuo
+}
+
+// SetError sets the "error" field.
+func (reuo *RoomExportUpdateOne) SetError(s string) *RoomExportUpdateOne {
+	reuo.mutation.SetError(s)
+	return reuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (reuo *RoomExportUpdateOne) SetNillableError(s *string) *RoomExportUpdateOne {
+	if s != nil {
+		reuo.SetError(*s)
+	}
+	return reuo
+}
+
+// ClearError clears the value of the "error" field.
+func (reuo *RoomExportUpdateOne) ClearError() *RoomExportUpdateOne {
+	reuo.mutation.ClearError()
+	return reuo
+}
+
+// SetMessageCount sets the "message_count" field.
+func (reuo *RoomExportUpdateOne) SetMessageCount(i int) *RoomExportUpdateOne {
+	reuo.mutation.ResetMessageCount()
+	reuo.mutation.SetMessageCount(i)
+	return reuo
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (reuo *RoomExportUpdateOne) SetNillableMessageCount(i *int) *RoomExportUpdateOne {
+	if i != nil {
+		reuo.SetMessageCount(*i)
+	}
+	return reuo
+}
+
+// AddMessageCount adds i to the "message_count" field.
+func (reuo *RoomExportUpdateOne) AddMessageCount(i int) *RoomExportUpdateOne {
+	reuo.mutation.AddMessageCount(i)
+	return reuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (reuo *RoomExportUpdateOne) SetCompletedAt(t time.Time) *RoomExportUpdateOne {
+	reuo.mutation.SetCompletedAt(t)
+	return reuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (reuo *RoomExportUpdateOne) SetNillableCompletedAt(t *time.Time) *RoomExportUpdateOne {
+	if t != nil {
+		reuo.SetCompletedAt(*t)
+	}
+	return reuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (reuo *RoomExportUpdateOne) ClearCompletedAt() *RoomExportUpdateOne {
+	reuo.mutation.ClearCompletedAt()
+	return reuo
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (reuo *RoomExportUpdateOne) SetLeaseUntil(t time.Time) *RoomExportUpdateOne {
+	reuo.mutation.SetLeaseUntil(t)
+	return reuo
+}
+
+// SetNillableLeaseUntil sets the "lease_until" field if the given value is not nil.
+func (reuo *RoomExportUpdateOne) SetNillableLeaseUntil(t *time.Time) *RoomExportUpdateOne {
+	if t != nil {
+		reuo.SetLeaseUntil(*t)
+	}
+	return reuo
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (reuo *RoomExportUpdateOne) ClearLeaseUntil() *RoomExportUpdateOne {
+	reuo.mutation.ClearLeaseUntil()
+	return reuo
+}
+
+// Mutation returns the RoomExportMutation object of the builder.
+func (reuo *RoomExportUpdateOne) Mutation() *RoomExportMutation {
+	return reuo.mutation
+}
+
+// Where appends a list predicates to the RoomExportUpdate builder.
+func (reuo *RoomExportUpdateOne) Where(ps ...predicate.RoomExport) *RoomExportUpdateOne {
+	reuo.mutation.Where(ps...)
+	return reuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (reuo *RoomExportUpdateOne) Select(field string, fields ...string) *RoomExportUpdateOne {
+	reuo.fields = append([]string{field}, fields...)
+	return reuo
+}
+
+// Save executes the query and returns the updated RoomExport entity.
+func (reuo *RoomExportUpdateOne) Save(ctx context.Context) (*RoomExport, error) {
+	return withHooks(ctx, reuo.sqlSave, reuo.mutation, reuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (reuo *RoomExportUpdateOne) SaveX(ctx context.Context) *RoomExport {
+	node, err := reuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (reuo *RoomExportUpdateOne) Exec(ctx context.Context) error {
+	_, err := reuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (reuo *RoomExportUpdateOne) ExecX(ctx context.Context) {
+	if err := reuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (reuo *RoomExportUpdateOne) check() error {
+	if v, ok := reuo.mutation.RoomID(); ok {
+		if err := roomexport.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "RoomExport.room_id": %w`, err)}
+		}
+	}
+	if v, ok := reuo.mutation.UserID(); ok {
+		if err := roomexport.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "RoomExport.user_id": %w`, err)}
+		}
+	}
+	if v, ok := reuo.mutation.Format(); ok {
+		if err := roomexport.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "RoomExport.format": %w`, err)}
+		}
+	}
+	if v, ok := reuo.mutation.Status(); ok {
+		if err := roomexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RoomExport.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (reuo *RoomExportUpdateOne) sqlSave(ctx context.Context) (_node *RoomExport, err error) {
+	if err := reuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roomexport.Table, roomexport.Columns, sqlgraph.NewFieldSpec(roomexport.FieldID, field.TypeInt))
+	id, ok := reuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoomExport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := reuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roomexport.FieldID)
+		for _, f := range fields {
+			if !roomexport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != roomexport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := reuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := reuo.mutation.RoomID(); ok {
+		_spec.SetField(roomexport.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := reuo.mutation.UserID(); ok {
+		_spec.SetField(roomexport.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := reuo.mutation.Format(); ok {
+		_spec.SetField(roomexport.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := reuo.mutation.From(); ok {
+		_spec.SetField(roomexport.FieldFrom, field.TypeTime, value)
+	}
+	if reuo.mutation.FromCleared() {
+		_spec.ClearField(roomexport.FieldFrom, field.TypeTime)
+	}
+	if value, ok := reuo.mutation.To(); ok {
+		_spec.SetField(roomexport.FieldTo, field.TypeTime, value)
+	}
+	if reuo.mutation.ToCleared() {
+		_spec.ClearField(roomexport.FieldTo, field.TypeTime)
+	}
+	if value, ok := reuo.mutation.Status(); ok {
+		_spec.SetField(roomexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := reuo.mutation.Error(); ok {
+		_spec.SetField(roomexport.FieldError, field.TypeString, value)
+	}
+	if reuo.mutation.ErrorCleared() {
+		_spec.ClearField(roomexport.FieldError, field.TypeString)
+	}
+	if value, ok := reuo.mutation.MessageCount(); ok {
+		_spec.SetField(roomexport.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := reuo.mutation.AddedMessageCount(); ok {
+		_spec.AddField(roomexport.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := reuo.mutation.CompletedAt(); ok {
+		_spec.SetField(roomexport.FieldCompletedAt, field.TypeTime, value)
+	}
+	if reuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(roomexport.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := reuo.mutation.LeaseUntil(); ok {
+		_spec.SetField(roomexport.FieldLeaseUntil, field.TypeTime, value)
+	}
+	if reuo.mutation.LeaseUntilCleared() {
+		_spec.ClearField(roomexport.FieldLeaseUntil, field.TypeTime)
+	}
+	_node = &RoomExport{config: reuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, reuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roomexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	reuo.mutation.done = true
+	return _node, nil
+}
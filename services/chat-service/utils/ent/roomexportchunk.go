@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexportchunk"
+)
+
+// RoomExportChunk is the model entity for the RoomExportChunk schema.
+type RoomExportChunk struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ExportID holds the value of the "export_id" field.
+	ExportID int `json:"export_id,omitempty"`
+	// Seq holds the value of the "seq" field.
+	Seq int `json:"seq,omitempty"`
+	// Data holds the value of the "data" field.
+	Data         []byte `json:"data,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoomExportChunk) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case roomexportchunk.FieldData:
+			values[i] = new([]byte)
+		case roomexportchunk.FieldID, roomexportchunk.FieldExportID, roomexportchunk.FieldSeq:
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoomExportChunk fields.
+func (rec *RoomExportChunk) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case roomexportchunk.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rec.ID = int(value.Int64)
+		case roomexportchunk.FieldExportID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field export_id", values[i])
+			} else if value.Valid {
+				rec.ExportID = int(value.Int64)
+			}
+		case roomexportchunk.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				rec.Seq = int(value.Int64)
+			}
+		case roomexportchunk.FieldData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value != nil {
+				rec.Data = *value
+			}
+		default:
+			rec.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoomExportChunk.
+// This includes values selected through modifiers, order, etc.
+func (rec *RoomExportChunk) Value(name string) (ent.Value, error) {
+	return rec.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RoomExportChunk.
+// Note that you need to call RoomExportChunk.Unwrap() before calling this method if this RoomExportChunk
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rec *RoomExportChunk) Update() *RoomExportChunkUpdateOne {
+	return NewRoomExportChunkClient(rec.config).UpdateOne(rec)
+}
+
+// Unwrap unwraps the RoomExportChunk entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rec *RoomExportChunk) Unwrap() *RoomExportChunk {
+	_tx, ok := rec.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoomExportChunk is not a transactional entity")
+	}
+	rec.config.driver = _tx.drv
+	return rec
+}
+
+// String implements the fmt.Stringer.
+func (rec *RoomExportChunk) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoomExportChunk(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rec.ID))
+	builder.WriteString("export_id=")
+	builder.WriteString(fmt.Sprintf("%v", rec.ExportID))
+	builder.WriteString(", ")
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", rec.Seq))
+	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(fmt.Sprintf("%v", rec.Data))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoomExportChunks is a parsable slice of RoomExportChunk.
+type RoomExportChunks []*RoomExportChunk
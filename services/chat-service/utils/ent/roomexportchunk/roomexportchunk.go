@@ -0,0 +1,58 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roomexportchunk
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the roomexportchunk type in the database.
+	Label = "room_export_chunk"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldExportID holds the string denoting the export_id field in the database.
+	FieldExportID = "export_id"
+	// FieldSeq holds the string denoting the seq field in the database.
+	FieldSeq = "seq"
+	// FieldData holds the string denoting the data field in the database.
+	FieldData = "data"
+	// Table holds the table name of the roomexportchunk in the database.
+	Table = "room_export_chunks"
+)
+
+// Columns holds all SQL columns for roomexportchunk fields.
+var Columns = []string{
+	FieldID,
+	FieldExportID,
+	FieldSeq,
+	FieldData,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the RoomExportChunk queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByExportID orders the results by the export_id field.
+func ByExportID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExportID, opts...).ToFunc()
+}
+
+// BySeq orders the results by the seq field.
+func BySeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeq, opts...).ToFunc()
+}
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roomexportchunk
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldLTE(FieldID, id))
+}
+
+// ExportID applies equality check predicate on the "export_id" field. It's identical to ExportIDEQ.
+func ExportID(v int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldEQ(FieldExportID, v))
+}
+
+// Seq applies equality check predicate on the "seq" field. It's identical to SeqEQ.
+func Seq(v int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldEQ(FieldSeq, v))
+}
+
+// Data applies equality check predicate on the "data" field. It's identical to DataEQ.
+func Data(v []byte) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldEQ(FieldData, v))
+}
+
+// ExportIDEQ applies the EQ predicate on the "export_id" field.
+func ExportIDEQ(v int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldEQ(FieldExportID, v))
+}
+
+// ExportIDNEQ applies the NEQ predicate on the "export_id" field.
+func ExportIDNEQ(v int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldNEQ(FieldExportID, v))
+}
+
+// ExportIDIn applies the In predicate on the "export_id" field.
+func ExportIDIn(vs ...int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldIn(FieldExportID, vs...))
+}
+
+// ExportIDNotIn applies the NotIn predicate on the "export_id" field.
+func ExportIDNotIn(vs ...int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldNotIn(FieldExportID, vs...))
+}
+
+// ExportIDGT applies the GT predicate on the "export_id" field.
+func ExportIDGT(v int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldGT(FieldExportID, v))
+}
+
+// ExportIDGTE applies the GTE predicate on the "export_id" field.
+func ExportIDGTE(v int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldGTE(FieldExportID, v))
+}
+
+// ExportIDLT applies the LT predicate on the "export_id" field.
+func ExportIDLT(v int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldLT(FieldExportID, v))
+}
+
+// ExportIDLTE applies the LTE predicate on the "export_id" field.
+func ExportIDLTE(v int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldLTE(FieldExportID, v))
+}
+
+// SeqEQ applies the EQ predicate on the "seq" field.
+func SeqEQ(v int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldEQ(FieldSeq, v))
+}
+
+// SeqNEQ applies the NEQ predicate on the "seq" field.
+func SeqNEQ(v int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldNEQ(FieldSeq, v))
+}
+
+// SeqIn applies the In predicate on the "seq" field.
+func SeqIn(vs ...int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldIn(FieldSeq, vs...))
+}
+
+// SeqNotIn applies the NotIn predicate on the "seq" field.
+func SeqNotIn(vs ...int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldNotIn(FieldSeq, vs...))
+}
+
+// SeqGT applies the GT predicate on the "seq" field.
+func SeqGT(v int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldGT(FieldSeq, v))
+}
+
+// SeqGTE applies the GTE predicate on the "seq" field.
+func SeqGTE(v int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldGTE(FieldSeq, v))
+}
+
+// SeqLT applies the LT predicate on the "seq" field.
+func SeqLT(v int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldLT(FieldSeq, v))
+}
+
+// SeqLTE applies the LTE predicate on the "seq" field.
+func SeqLTE(v int) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldLTE(FieldSeq, v))
+}
+
+// DataEQ applies the EQ predicate on the "data" field.
+func DataEQ(v []byte) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldEQ(FieldData, v))
+}
+
+// DataNEQ applies the NEQ predicate on the "data" field.
+func DataNEQ(v []byte) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldNEQ(FieldData, v))
+}
+
+// DataIn applies the In predicate on the "data" field.
+func DataIn(vs ...[]byte) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldIn(FieldData, vs...))
+}
+
+// DataNotIn applies the NotIn predicate on the "data" field.
+func DataNotIn(vs ...[]byte) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldNotIn(FieldData, vs...))
+}
+
+// DataGT applies the GT predicate on the "data" field.
+func DataGT(v []byte) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldGT(FieldData, v))
+}
+
+// DataGTE applies the GTE predicate on the "data" field.
+func DataGTE(v []byte) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldGTE(FieldData, v))
+}
+
+// DataLT applies the LT predicate on the "data" field.
+func DataLT(v []byte) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldLT(FieldData, v))
+}
+
+// DataLTE applies the LTE predicate on the "data" field.
+func DataLTE(v []byte) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.FieldLTE(FieldData, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RoomExportChunk) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RoomExportChunk) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RoomExportChunk) predicate.RoomExportChunk {
+	return predicate.RoomExportChunk(sql.NotPredicates(p))
+}
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexportchunk"
+)
+
+// RoomExportChunkCreate is the builder for creating a RoomExportChunk entity.
+type RoomExportChunkCreate struct {
+	config
+	mutation *RoomExportChunkMutation
+	hooks    []Hook
+}
+
+// SetExportID sets the "export_id" field.
+func (recc *RoomExportChunkCreate) SetExportID(i int) *RoomExportChunkCreate {
+	recc.mutation.SetExportID(i)
+	return recc
+}
+
+// SetSeq sets the "seq" field.
+func (recc *RoomExportChunkCreate) SetSeq(i int) *RoomExportChunkCreate {
+	recc.mutation.SetSeq(i)
+	return recc
+}
+
+// SetData sets the "data" field.
+func (recc *RoomExportChunkCreate) SetData(b []byte) *RoomExportChunkCreate {
+	recc.mutation.SetData(b)
+	return recc
+}
+
+// Mutation returns the RoomExportChunkMutation object of the builder.
+func (recc *RoomExportChunkCreate) Mutation() *RoomExportChunkMutation {
+	return recc.mutation
+}
+
+// Save creates the RoomExportChunk in the database.
+func (recc *RoomExportChunkCreate) Save(ctx context.Context) (*RoomExportChunk, error) {
+	return withHooks(ctx, recc.sqlSave, recc.mutation, recc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (recc *RoomExportChunkCreate) SaveX(ctx context.Context) *RoomExportChunk {
+	v, err := recc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (recc *RoomExportChunkCreate) Exec(ctx context.Context) error {
+	_, err := recc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (recc *RoomExportChunkCreate) ExecX(ctx context.Context) {
+	if err := recc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (recc *RoomExportChunkCreate) check() error {
+	if _, ok := recc.mutation.ExportID(); !ok {
+		return &ValidationError{Name: "export_id", err: errors.New(`ent: missing required field "RoomExportChunk.export_id"`)}
+	}
+	if _, ok := recc.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "RoomExportChunk.seq"`)}
+	}
+	if _, ok := recc.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`ent: missing required field "RoomExportChunk.data"`)}
+	}
+	return nil
+}
+
+func (recc *RoomExportChunkCreate) sqlSave(ctx context.Context) (*RoomExportChunk, error) {
+	if err := recc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := recc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, recc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	recc.mutation.id = &_node.ID
+	recc.mutation.done = true
+	return _node, nil
+}
+
+func (recc *RoomExportChunkCreate) createSpec() (*RoomExportChunk, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoomExportChunk{config: recc.config}
+		_spec = sqlgraph.NewCreateSpec(roomexportchunk.Table, sqlgraph.NewFieldSpec(roomexportchunk.FieldID, field.TypeInt))
+	)
+	if value, ok := recc.mutation.ExportID(); ok {
+		_spec.SetField(roomexportchunk.FieldExportID, field.TypeInt, value)
+		_node.ExportID = value
+	}
+	if value, ok := recc.mutation.Seq(); ok {
+		_spec.SetField(roomexportchunk.FieldSeq, field.TypeInt, value)
+		_node.Seq = value
+	}
+	if value, ok := recc.mutation.Data(); ok {
+		_spec.SetField(roomexportchunk.FieldData, field.TypeBytes, value)
+		_node.Data = value
+	}
+	return _node, _spec
+}
+
+// RoomExportChunkCreateBulk is the builder for creating many RoomExportChunk entities in bulk.
+type RoomExportChunkCreateBulk struct {
+	config
+	err      error
+	builders []*RoomExportChunkCreate
+}
+
+// Save creates the RoomExportChunk entities in the database.
+func (reccb *RoomExportChunkCreateBulk) Save(ctx context.Context) ([]*RoomExportChunk, error) {
+	if reccb.err != nil {
+		return nil, reccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(reccb.builders))
+	nodes := make([]*RoomExportChunk, len(reccb.builders))
+	mutators := make([]Mutator, len(reccb.builders))
+	for i := range reccb.builders {
+		func(i int, root context.Context) {
+			builder := reccb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoomExportChunkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, reccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, reccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, reccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (reccb *RoomExportChunkCreateBulk) SaveX(ctx context.Context) []*RoomExportChunk {
+	v, err := reccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (reccb *RoomExportChunkCreateBulk) Exec(ctx context.Context) error {
+	_, err := reccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (reccb *RoomExportChunkCreateBulk) ExecX(ctx context.Context) {
+	if err := reccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexportchunk"
+)
+
+// RoomExportChunkDelete is the builder for deleting a RoomExportChunk entity.
+type RoomExportChunkDelete struct {
+	config
+	hooks    []Hook
+	mutation *RoomExportChunkMutation
+}
+
+// Where appends a list predicates to the RoomExportChunkDelete builder.
+func (recd *RoomExportChunkDelete) Where(ps ...predicate.RoomExportChunk) *RoomExportChunkDelete {
+	recd.mutation.Where(ps...)
+	return recd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (recd *RoomExportChunkDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, recd.sqlExec, recd.mutation, recd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (recd *RoomExportChunkDelete) ExecX(ctx context.Context) int {
+	n, err := recd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (recd *RoomExportChunkDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(roomexportchunk.Table, sqlgraph.NewFieldSpec(roomexportchunk.FieldID, field.TypeInt))
+	if ps := recd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, recd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	recd.mutation.done = true
+	return affected, err
+}
+
+// RoomExportChunkDeleteOne is the builder for deleting a single RoomExportChunk entity.
+type RoomExportChunkDeleteOne struct {
+	recd *RoomExportChunkDelete
+}
+
+// Where appends a list predicates to the RoomExportChunkDelete builder.
+func (recdo *RoomExportChunkDeleteOne) Where(ps ...predicate.RoomExportChunk) *RoomExportChunkDeleteOne {
+	recdo.recd.mutation.Where(ps...)
+	return recdo
+}
+
+// Exec executes the deletion query.
+func (recdo *RoomExportChunkDeleteOne) Exec(ctx context.Context) error {
+	n, err := recdo.recd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{roomexportchunk.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (recdo *RoomExportChunkDeleteOne) ExecX(ctx context.Context) {
+	if err := recdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexportchunk"
+)
+
+// RoomExportChunkQuery is the builder for querying RoomExportChunk entities.
+type RoomExportChunkQuery struct {
+	config
+	ctx        *QueryContext
+	order      []roomexportchunk.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RoomExportChunk
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RoomExportChunkQuery builder.
+func (recq *RoomExportChunkQuery) Where(ps ...predicate.RoomExportChunk) *RoomExportChunkQuery {
+	recq.predicates = append(recq.predicates, ps...)
+	return recq
+}
+
+// Limit the number of records to be returned by this query.
+func (recq *RoomExportChunkQuery) Limit(limit int) *RoomExportChunkQuery {
+	recq.ctx.Limit = &limit
+	return recq
+}
+
+// Offset to start from.
+func (recq *RoomExportChunkQuery) Offset(offset int) *RoomExportChunkQuery {
+	recq.ctx.Offset = &offset
+	return recq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (recq *RoomExportChunkQuery) Unique(unique bool) *RoomExportChunkQuery {
+	recq.ctx.Unique = &unique
+	return recq
+}
+
+// Order specifies how the records should be ordered.
+func (recq *RoomExportChunkQuery) Order(o ...roomexportchunk.OrderOption) *RoomExportChunkQuery {
+	recq.order = append(recq.order, o...)
+	return recq
+}
+
+// First returns the first RoomExportChunk entity from the query.
+// Returns a *NotFoundError when no RoomExportChunk was found.
+func (recq *RoomExportChunkQuery) First(ctx context.Context) (*RoomExportChunk, error) {
+	nodes, err := recq.Limit(1).All(setContextOp(ctx, recq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{roomexportchunk.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (recq *RoomExportChunkQuery) FirstX(ctx context.Context) *RoomExportChunk {
+	node, err := recq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RoomExportChunk ID from the query.
+// Returns a *NotFoundError when no RoomExportChunk ID was found.
+func (recq *RoomExportChunkQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = recq.Limit(1).IDs(setContextOp(ctx, recq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{roomexportchunk.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (recq *RoomExportChunkQuery) FirstIDX(ctx context.Context) int {
+	id, err := recq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RoomExportChunk entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RoomExportChunk entity is found.
+// Returns a *NotFoundError when no RoomExportChunk entities are found.
+func (recq *RoomExportChunkQuery) Only(ctx context.Context) (*RoomExportChunk, error) {
+	nodes, err := recq.Limit(2).All(setContextOp(ctx, recq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{roomexportchunk.Label}
+	default:
+		return nil, &NotSingularError{roomexportchunk.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (recq *RoomExportChunkQuery) OnlyX(ctx context.Context) *RoomExportChunk {
+	node, err := recq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RoomExportChunk ID in the query.
+// Returns a *NotSingularError when more than one RoomExportChunk ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (recq *RoomExportChunkQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = recq.Limit(2).IDs(setContextOp(ctx, recq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{roomexportchunk.Label}
+	default:
+		err = &NotSingularError{roomexportchunk.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (recq *RoomExportChunkQuery) OnlyIDX(ctx context.Context) int {
+	id, err := recq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RoomExportChunks.
+func (recq *RoomExportChunkQuery) All(ctx context.Context) ([]*RoomExportChunk, error) {
+	ctx = setContextOp(ctx, recq.ctx, ent.OpQueryAll)
+	if err := recq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RoomExportChunk, *RoomExportChunkQuery]()
+	return withInterceptors[[]*RoomExportChunk](ctx, recq, qr, recq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (recq *RoomExportChunkQuery) AllX(ctx context.Context) []*RoomExportChunk {
+	nodes, err := recq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RoomExportChunk IDs.
+func (recq *RoomExportChunkQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if recq.ctx.Unique == nil && recq.path != nil {
+		recq.Unique(true)
+	}
+	ctx = setContextOp(ctx, recq.ctx, ent.OpQueryIDs)
+	if err = recq.Select(roomexportchunk.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (recq *RoomExportChunkQuery) IDsX(ctx context.Context) []int {
+	ids, err := recq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (recq *RoomExportChunkQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, recq.ctx, ent.OpQueryCount)
+	if err := recq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, recq, querierCount[*RoomExportChunkQuery](), recq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (recq *RoomExportChunkQuery) CountX(ctx context.Context) int {
+	count, err := recq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (recq *RoomExportChunkQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, recq.ctx, ent.OpQueryExist)
+	switch _, err := recq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (recq *RoomExportChunkQuery) ExistX(ctx context.Context) bool {
+	exist, err := recq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RoomExportChunkQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (recq *RoomExportChunkQuery) Clone() *RoomExportChunkQuery {
+	if recq == nil {
+		return nil
+	}
+	return &RoomExportChunkQuery{
+		config:     recq.config,
+		ctx:        recq.ctx.Clone(),
+		order:      append([]roomexportchunk.OrderOption{}, recq.order...),
+		inters:     append([]Interceptor{}, recq.inters...),
+		predicates: append([]predicate.RoomExportChunk{}, recq.predicates...),
+		// clone intermediate query.
+		sql:  recq.sql.Clone(),
+		path: recq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ExportID int `json:"export_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RoomExportChunk.Query().
+//		GroupBy(roomexportchunk.FieldExportID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (recq *RoomExportChunkQuery) GroupBy(field string, fields ...string) *RoomExportChunkGroupBy {
+	recq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RoomExportChunkGroupBy{build: recq}
+	grbuild.flds = &recq.ctx.Fields
+	grbuild.label = roomexportchunk.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ExportID int `json:"export_id,omitempty"`
+//	}
+//
+//	client.RoomExportChunk.Query().
+//		Select(roomexportchunk.FieldExportID).
+//		Scan(ctx, &v)
+func (recq *RoomExportChunkQuery) Select(fields ...string) *RoomExportChunkSelect {
+	recq.ctx.Fields = append(recq.ctx.Fields, fields...)
+	sbuild := &RoomExportChunkSelect{RoomExportChunkQuery: recq}
+	sbuild.label = roomexportchunk.Label
+	sbuild.flds, sbuild.scan = &recq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RoomExportChunkSelect configured with the given aggregations.
+func (recq *RoomExportChunkQuery) Aggregate(fns ...AggregateFunc) *RoomExportChunkSelect {
+	return recq.Select().Aggregate(fns...)
+}
+
+func (recq *RoomExportChunkQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range recq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, recq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range recq.ctx.Fields {
+		if !roomexportchunk.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if recq.path != nil {
+		prev, err := recq.path(ctx)
+		if err != nil {
+			return err
+		}
+		recq.sql = prev
+	}
+	return nil
+}
+
+func (recq *RoomExportChunkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RoomExportChunk, error) {
+	var (
+		nodes = []*RoomExportChunk{}
+		_spec = recq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RoomExportChunk).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RoomExportChunk{config: recq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, recq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (recq *RoomExportChunkQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := recq.querySpec()
+	_spec.Node.Columns = recq.ctx.Fields
+	if len(recq.ctx.Fields) > 0 {
+		_spec.Unique = recq.ctx.Unique != nil && *recq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, recq.driver, _spec)
+}
+
+func (recq *RoomExportChunkQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(roomexportchunk.Table, roomexportchunk.Columns, sqlgraph.NewFieldSpec(roomexportchunk.FieldID, field.TypeInt))
+	_spec.From = recq.sql
+	if unique := recq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if recq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := recq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roomexportchunk.FieldID)
+		for i := range fields {
+			if fields[i] != roomexportchunk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := recq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := recq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := recq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := recq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (recq *RoomExportChunkQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(recq.driver.Dialect())
+	t1 := builder.Table(roomexportchunk.Table)
+	columns := recq.ctx.Fields
+	if len(columns) == 0 {
+		columns = roomexportchunk.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if recq.sql != nil {
+		selector = recq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if recq.ctx.Unique != nil && *recq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range recq.predicates {
+		p(selector)
+	}
+	for _, p := range recq.order {
+		p(selector)
+	}
+	if offset := recq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := recq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RoomExportChunkGroupBy is the group-by builder for RoomExportChunk entities.
+type RoomExportChunkGroupBy struct {
+	selector
+	build *RoomExportChunkQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (recgb *RoomExportChunkGroupBy) Aggregate(fns ...AggregateFunc) *RoomExportChunkGroupBy {
+	recgb.fns = append(recgb.fns, fns...)
+	return recgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (recgb *RoomExportChunkGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, recgb.build.ctx, ent.OpQueryGroupBy)
+	if err := recgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoomExportChunkQuery, *RoomExportChunkGroupBy](ctx, recgb.build, recgb, recgb.build.inters, v)
+}
+
+func (recgb *RoomExportChunkGroupBy) sqlScan(ctx context.Context, root *RoomExportChunkQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(recgb.fns))
+	for _, fn := range recgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*recgb.flds)+len(recgb.fns))
+		for _, f := range *recgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*recgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := recgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RoomExportChunkSelect is the builder for selecting fields of RoomExportChunk entities.
+type RoomExportChunkSelect struct {
+	*RoomExportChunkQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (recs *RoomExportChunkSelect) Aggregate(fns ...AggregateFunc) *RoomExportChunkSelect {
+	recs.fns = append(recs.fns, fns...)
+	return recs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (recs *RoomExportChunkSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, recs.ctx, ent.OpQuerySelect)
+	if err := recs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoomExportChunkQuery, *RoomExportChunkSelect](ctx, recs.RoomExportChunkQuery, recs, recs.inters, v)
+}
+
+func (recs *RoomExportChunkSelect) sqlScan(ctx context.Context, root *RoomExportChunkQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(recs.fns))
+	for _, fn := range recs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*recs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := recs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
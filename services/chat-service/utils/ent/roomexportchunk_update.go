@@ -0,0 +1,301 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexportchunk"
+)
+
+// RoomExportChunkUpdate is the builder for updating RoomExportChunk entities.
+type RoomExportChunkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoomExportChunkMutation
+}
+
+// Where appends a list predicates to the RoomExportChunkUpdate builder.
+func (recu *RoomExportChunkUpdate) Where(ps ...predicate.RoomExportChunk) *RoomExportChunkUpdate {
+	recu.mutation.Where(ps...)
+	return recu
+}
+
+// SetExportID sets the "export_id" field.
+func (recu *RoomExportChunkUpdate) SetExportID(i int) *RoomExportChunkUpdate {
+	recu.mutation.ResetExportID()
+	recu.mutation.SetExportID(i)
+	return recu
+}
+
+// SetNillableExportID sets the "export_id" field if the given value is not nil.
+func (recu *RoomExportChunkUpdate) SetNillableExportID(i *int) *RoomExportChunkUpdate {
+	if i != nil {
+		recu.SetExportID(*i)
+	}
+	return recu
+}
+
+// AddExportID adds i to the "export_id" field.
+func (recu *RoomExportChunkUpdate) AddExportID(i int) *RoomExportChunkUpdate {
+	recu.mutation.AddExportID(i)
+	return recu
+}
+
+// SetSeq sets the "seq" field.
+func (recu *RoomExportChunkUpdate) SetSeq(i int) *RoomExportChunkUpdate {
+	recu.mutation.ResetSeq()
+	recu.mutation.SetSeq(i)
+	return recu
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (recu *RoomExportChunkUpdate) SetNillableSeq(i *int) *RoomExportChunkUpdate {
+	if i != nil {
+		recu.SetSeq(*i)
+	}
+	return recu
+}
+
+// AddSeq adds i to the "seq" field.
+func (recu *RoomExportChunkUpdate) AddSeq(i int) *RoomExportChunkUpdate {
+	recu.mutation.AddSeq(i)
+	return recu
+}
+
+// SetData sets the "data" field.
+func (recu *RoomExportChunkUpdate) SetData(b []byte) *RoomExportChunkUpdate {
+	recu.mutation.SetData(b)
+	return recu
+}
+
+// Mutation returns the RoomExportChunkMutation object of the builder.
+func (recu *RoomExportChunkUpdate) Mutation() *RoomExportChunkMutation {
+	return recu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (recu *RoomExportChunkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, recu.sqlSave, recu.mutation, recu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (recu *RoomExportChunkUpdate) SaveX(ctx context.Context) int {
+	affected, err := recu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (recu *RoomExportChunkUpdate) Exec(ctx context.Context) error {
+	_, err := recu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (recu *RoomExportChunkUpdate) ExecX(ctx context.Context) {
+	if err := recu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (recu *RoomExportChunkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(roomexportchunk.Table, roomexportchunk.Columns, sqlgraph.NewFieldSpec(roomexportchunk.FieldID, field.TypeInt))
+	if ps := recu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := recu.mutation.ExportID(); ok {
+		_spec.SetField(roomexportchunk.FieldExportID, field.TypeInt, value)
+	}
+	if value, ok := recu.mutation.AddedExportID(); ok {
+		_spec.AddField(roomexportchunk.FieldExportID, field.TypeInt, value)
+	}
+	if value, ok := recu.mutation.Seq(); ok {
+		_spec.SetField(roomexportchunk.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := recu.mutation.AddedSeq(); ok {
+		_spec.AddField(roomexportchunk.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := recu.mutation.Data(); ok {
+		_spec.SetField(roomexportchunk.FieldData, field.TypeBytes, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, recu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roomexportchunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	recu.mutation.done = true
+	return n, nil
+}
+
+// RoomExportChunkUpdateOne is the builder for updating a single RoomExportChunk entity.
+type RoomExportChunkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoomExportChunkMutation
+}
+
+// SetExportID sets the "export_id" field.
+func (recuo *RoomExportChunkUpdateOne) SetExportID(i int) *RoomExportChunkUpdateOne {
+	recuo.mutation.ResetExportID()
+	recuo.mutation.SetExportID(i)
+	return recuo
+}
+
+// SetNillableExportID sets the "export_id" field if the given value is not nil.
+func (recuo *RoomExportChunkUpdateOne) SetNillableExportID(i *int) *RoomExportChunkUpdateOne {
+	if i != nil {
+		recuo.SetExportID(*i)
+	}
+	return recuo
+}
+
+// AddExportID adds i to the "export_id" field.
+func (recuo *RoomExportChunkUpdateOne) AddExportID(i int) *RoomExportChunkUpdateOne {
+	recuo.mutation.AddExportID(i)
+	return recuo
+}
+
+// SetSeq sets the "seq" field.
+func (recuo *RoomExportChunkUpdateOne) SetSeq(i int) *RoomExportChunkUpdateOne {
+	recuo.mutation.ResetSeq()
+	recuo.mutation.SetSeq(i)
+	return recuo
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (recuo *RoomExportChunkUpdateOne) SetNillableSeq(i *int) *RoomExportChunkUpdateOne {
+	if i != nil {
+		recuo.SetSeq(*i)
+	}
+	return recuo
+}
+
+// AddSeq adds i to the "seq" field.
+func (recuo *RoomExportChunkUpdateOne) AddSeq(i int) *RoomExportChunkUpdateOne {
+	recuo.mutation.AddSeq(i)
+	return recuo
+}
+
+// SetData sets the "data" field.
+func (recuo *RoomExportChunkUpdateOne) SetData(b []byte) *RoomExportChunkUpdateOne {
+	recuo.mutation.SetData(b)
+	return recuo
+}
+
+// Mutation returns the RoomExportChunkMutation object of the builder.
+func (recuo *RoomExportChunkUpdateOne) Mutation() *RoomExportChunkMutation {
+	return recuo.mutation
+}
+
+// Where appends a list predicates to the RoomExportChunkUpdate builder.
+func (recuo *RoomExportChunkUpdateOne) Where(ps ...predicate.RoomExportChunk) *RoomExportChunkUpdateOne {
+	recuo.mutation.Where(ps...)
+	return recuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (recuo *RoomExportChunkUpdateOne) Select(field string, fields ...string) *RoomExportChunkUpdateOne {
+	recuo.fields = append([]string{field}, fields...)
+	return recuo
+}
+
+// Save executes the query and returns the updated RoomExportChunk entity.
+func (recuo *RoomExportChunkUpdateOne) Save(ctx context.Context) (*RoomExportChunk, error) {
+	return withHooks(ctx, recuo.sqlSave, recuo.mutation, recuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (recuo *RoomExportChunkUpdateOne) SaveX(ctx context.Context) *RoomExportChunk {
+	node, err := recuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (recuo *RoomExportChunkUpdateOne) Exec(ctx context.Context) error {
+	_, err := recuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (recuo *RoomExportChunkUpdateOne) ExecX(ctx context.Context) {
+	if err := recuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (recuo *RoomExportChunkUpdateOne) sqlSave(ctx context.Context) (_node *RoomExportChunk, err error) {
+	_spec := sqlgraph.NewUpdateSpec(roomexportchunk.Table, roomexportchunk.Columns, sqlgraph.NewFieldSpec(roomexportchunk.FieldID, field.TypeInt))
+	id, ok := recuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoomExportChunk.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := recuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roomexportchunk.FieldID)
+		for _, f := range fields {
+			if !roomexportchunk.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != roomexportchunk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := recuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := recuo.mutation.ExportID(); ok {
+		_spec.SetField(roomexportchunk.FieldExportID, field.TypeInt, value)
+	}
+	if value, ok := recuo.mutation.AddedExportID(); ok {
+		_spec.AddField(roomexportchunk.FieldExportID, field.TypeInt, value)
+	}
+	if value, ok := recuo.mutation.Seq(); ok {
+		_spec.SetField(roomexportchunk.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := recuo.mutation.AddedSeq(); ok {
+		_spec.AddField(roomexportchunk.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := recuo.mutation.Data(); ok {
+		_spec.SetField(roomexportchunk.FieldData, field.TypeBytes, value)
+	}
+	_node = &RoomExportChunk{config: recuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, recuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roomexportchunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	recuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roommember"
+)
+
+// RoomMember is the model entity for the RoomMember schema.
+type RoomMember struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// RoomID holds the value of the "room_id" field.
+	RoomID string `json:"room_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// JoinedAt holds the value of the "joined_at" field.
+	JoinedAt     time.Time `json:"joined_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoomMember) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case roommember.FieldID:
+			values[i] = new(sql.NullInt64)
+		case roommember.FieldRoomID, roommember.FieldUserID, roommember.FieldUsername:
+			values[i] = new(sql.NullString)
+		case roommember.FieldJoinedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoomMember fields.
+func (rm *RoomMember) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case roommember.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rm.ID = int(value.Int64)
+		case roommember.FieldRoomID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value.Valid {
+				rm.RoomID = value.String
+			}
+		case roommember.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rm.UserID = value.String
+			}
+		case roommember.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				rm.Username = value.String
+			}
+		case roommember.FieldJoinedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field joined_at", values[i])
+			} else if value.Valid {
+				rm.JoinedAt = value.Time
+			}
+		default:
+			rm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoomMember.
+// This includes values selected through modifiers, order, etc.
+func (rm *RoomMember) Value(name string) (ent.Value, error) {
+	return rm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RoomMember.
+// Note that you need to call RoomMember.Unwrap() before calling this method if this RoomMember
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rm *RoomMember) Update() *RoomMemberUpdateOne {
+	return NewRoomMemberClient(rm.config).UpdateOne(rm)
+}
+
+// Unwrap unwraps the RoomMember entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rm *RoomMember) Unwrap() *RoomMember {
+	_tx, ok := rm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoomMember is not a transactional entity")
+	}
+	rm.config.driver = _tx.drv
+	return rm
+}
+
+// String implements the fmt.Stringer.
+func (rm *RoomMember) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoomMember(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rm.ID))
+	builder.WriteString("room_id=")
+	builder.WriteString(rm.RoomID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(rm.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(rm.Username)
+	builder.WriteString(", ")
+	builder.WriteString("joined_at=")
+	builder.WriteString(rm.JoinedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoomMembers is a parsable slice of RoomMember.
+type RoomMembers []*RoomMember
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roommember"
+)
+
+// RoomMemberDelete is the builder for deleting a RoomMember entity.
+type RoomMemberDelete struct {
+	config
+	hooks    []Hook
+	mutation *RoomMemberMutation
+}
+
+// Where appends a list predicates to the RoomMemberDelete builder.
+func (rmd *RoomMemberDelete) Where(ps ...predicate.RoomMember) *RoomMemberDelete {
+	rmd.mutation.Where(ps...)
+	return rmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rmd *RoomMemberDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rmd.sqlExec, rmd.mutation, rmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rmd *RoomMemberDelete) ExecX(ctx context.Context) int {
+	n, err := rmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rmd *RoomMemberDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(roommember.Table, sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeInt))
+	if ps := rmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rmd.mutation.done = true
+	return affected, err
+}
+
+// RoomMemberDeleteOne is the builder for deleting a single RoomMember entity.
+type RoomMemberDeleteOne struct {
+	rmd *RoomMemberDelete
+}
+
+// Where appends a list predicates to the RoomMemberDelete builder.
+func (rmdo *RoomMemberDeleteOne) Where(ps ...predicate.RoomMember) *RoomMemberDeleteOne {
+	rmdo.rmd.mutation.Where(ps...)
+	return rmdo
+}
+
+// Exec executes the deletion query.
+func (rmdo *RoomMemberDeleteOne) Exec(ctx context.Context) error {
+	n, err := rmdo.rmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{roommember.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rmdo *RoomMemberDeleteOne) ExecX(ctx context.Context) {
+	if err := rmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roommember"
+)
+
+// RoomMemberQuery is the builder for querying RoomMember entities.
+type RoomMemberQuery struct {
+	config
+	ctx        *QueryContext
+	order      []roommember.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RoomMember
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RoomMemberQuery builder.
+func (rmq *RoomMemberQuery) Where(ps ...predicate.RoomMember) *RoomMemberQuery {
+	rmq.predicates = append(rmq.predicates, ps...)
+	return rmq
+}
+
+// Limit the number of records to be returned by this query.
+func (rmq *RoomMemberQuery) Limit(limit int) *RoomMemberQuery {
+	rmq.ctx.Limit = &limit
+	return rmq
+}
+
+// Offset to start from.
+func (rmq *RoomMemberQuery) Offset(offset int) *RoomMemberQuery {
+	rmq.ctx.Offset = &offset
+	return rmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rmq *RoomMemberQuery) Unique(unique bool) *RoomMemberQuery {
+	rmq.ctx.Unique = &unique
+	return rmq
+}
+
+// Order specifies how the records should be ordered.
+func (rmq *RoomMemberQuery) Order(o ...roommember.OrderOption) *RoomMemberQuery {
+	rmq.order = append(rmq.order, o...)
+	return rmq
+}
+
+// First returns the first RoomMember entity from the query.
+// Returns a *NotFoundError when no RoomMember was found.
+func (rmq *RoomMemberQuery) First(ctx context.Context) (*RoomMember, error) {
+	nodes, err := rmq.Limit(1).All(setContextOp(ctx, rmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{roommember.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rmq *RoomMemberQuery) FirstX(ctx context.Context) *RoomMember {
+	node, err := rmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RoomMember ID from the query.
+// Returns a *NotFoundError when no RoomMember ID was found.
+func (rmq *RoomMemberQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rmq.Limit(1).IDs(setContextOp(ctx, rmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{roommember.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rmq *RoomMemberQuery) FirstIDX(ctx context.Context) int {
+	id, err := rmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RoomMember entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RoomMember entity is found.
+// Returns a *NotFoundError when no RoomMember entities are found.
+func (rmq *RoomMemberQuery) Only(ctx context.Context) (*RoomMember, error) {
+	nodes, err := rmq.Limit(2).All(setContextOp(ctx, rmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{roommember.Label}
+	default:
+		return nil, &NotSingularError{roommember.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rmq *RoomMemberQuery) OnlyX(ctx context.Context) *RoomMember {
+	node, err := rmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RoomMember ID in the query.
+// Returns a *NotSingularError when more than one RoomMember ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rmq *RoomMemberQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rmq.Limit(2).IDs(setContextOp(ctx, rmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{roommember.Label}
+	default:
+		err = &NotSingularError{roommember.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rmq *RoomMemberQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RoomMembers.
+func (rmq *RoomMemberQuery) All(ctx context.Context) ([]*RoomMember, error) {
+	ctx = setContextOp(ctx, rmq.ctx, ent.OpQueryAll)
+	if err := rmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RoomMember, *RoomMemberQuery]()
+	return withInterceptors[[]*RoomMember](ctx, rmq, qr, rmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rmq *RoomMemberQuery) AllX(ctx context.Context) []*RoomMember {
+	nodes, err := rmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RoomMember IDs.
+func (rmq *RoomMemberQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rmq.ctx.Unique == nil && rmq.path != nil {
+		rmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rmq.ctx, ent.OpQueryIDs)
+	if err = rmq.Select(roommember.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rmq *RoomMemberQuery) IDsX(ctx context.Context) []int {
+	ids, err := rmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rmq *RoomMemberQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rmq.ctx, ent.OpQueryCount)
+	if err := rmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rmq, querierCount[*RoomMemberQuery](), rmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rmq *RoomMemberQuery) CountX(ctx context.Context) int {
+	count, err := rmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rmq *RoomMemberQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rmq.ctx, ent.OpQueryExist)
+	switch _, err := rmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rmq *RoomMemberQuery) ExistX(ctx context.Context) bool {
+	exist, err := rmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RoomMemberQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rmq *RoomMemberQuery) Clone() *RoomMemberQuery {
+	if rmq == nil {
+		return nil
+	}
+	return &RoomMemberQuery{
+		config:     rmq.config,
+		ctx:        rmq.ctx.Clone(),
+		order:      append([]roommember.OrderOption{}, rmq.order...),
+		inters:     append([]Interceptor{}, rmq.inters...),
+		predicates: append([]predicate.RoomMember{}, rmq.predicates...),
+		// clone intermediate query.
+		sql:  rmq.sql.Clone(),
+		path: rmq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		RoomID string `json:"room_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RoomMember.Query().
+//		GroupBy(roommember.FieldRoomID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rmq *RoomMemberQuery) GroupBy(field string, fields ...string) *RoomMemberGroupBy {
+	rmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RoomMemberGroupBy{build: rmq}
+	grbuild.flds = &rmq.ctx.Fields
+	grbuild.label = roommember.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		RoomID string `json:"room_id,omitempty"`
+//	}
+//
+//	client.RoomMember.Query().
+//		Select(roommember.FieldRoomID).
+//		Scan(ctx, &v)
+func (rmq *RoomMemberQuery) Select(fields ...string) *RoomMemberSelect {
+	rmq.ctx.Fields = append(rmq.ctx.Fields, fields...)
+	sbuild := &RoomMemberSelect{RoomMemberQuery: rmq}
+	sbuild.label = roommember.Label
+	sbuild.flds, sbuild.scan = &rmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RoomMemberSelect configured with the given aggregations.
+func (rmq *RoomMemberQuery) Aggregate(fns ...AggregateFunc) *RoomMemberSelect {
+	return rmq.Select().Aggregate(fns...)
+}
+
+func (rmq *RoomMemberQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rmq.ctx.Fields {
+		if !roommember.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rmq.path != nil {
+		prev, err := rmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rmq.sql = prev
+	}
+	return nil
+}
+
+func (rmq *RoomMemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RoomMember, error) {
+	var (
+		nodes = []*RoomMember{}
+		_spec = rmq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RoomMember).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RoomMember{config: rmq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rmq *RoomMemberQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rmq.querySpec()
+	_spec.Node.Columns = rmq.ctx.Fields
+	if len(rmq.ctx.Fields) > 0 {
+		_spec.Unique = rmq.ctx.Unique != nil && *rmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rmq.driver, _spec)
+}
+
+func (rmq *RoomMemberQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(roommember.Table, roommember.Columns, sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeInt))
+	_spec.From = rmq.sql
+	if unique := rmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roommember.FieldID)
+		for i := range fields {
+			if fields[i] != roommember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rmq *RoomMemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rmq.driver.Dialect())
+	t1 := builder.Table(roommember.Table)
+	columns := rmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = roommember.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rmq.sql != nil {
+		selector = rmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rmq.ctx.Unique != nil && *rmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rmq.predicates {
+		p(selector)
+	}
+	for _, p := range rmq.order {
+		p(selector)
+	}
+	if offset := rmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RoomMemberGroupBy is the group-by builder for RoomMember entities.
+type RoomMemberGroupBy struct {
+	selector
+	build *RoomMemberQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rmgb *RoomMemberGroupBy) Aggregate(fns ...AggregateFunc) *RoomMemberGroupBy {
+	rmgb.fns = append(rmgb.fns, fns...)
+	return rmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rmgb *RoomMemberGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoomMemberQuery, *RoomMemberGroupBy](ctx, rmgb.build, rmgb, rmgb.build.inters, v)
+}
+
+func (rmgb *RoomMemberGroupBy) sqlScan(ctx context.Context, root *RoomMemberQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rmgb.fns))
+	for _, fn := range rmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rmgb.flds)+len(rmgb.fns))
+		for _, f := range *rmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RoomMemberSelect is the builder for selecting fields of RoomMember entities.
+type RoomMemberSelect struct {
+	*RoomMemberQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rms *RoomMemberSelect) Aggregate(fns ...AggregateFunc) *RoomMemberSelect {
+	rms.fns = append(rms.fns, fns...)
+	return rms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rms *RoomMemberSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rms.ctx, ent.OpQuerySelect)
+	if err := rms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoomMemberQuery, *RoomMemberSelect](ctx, rms.RoomMemberQuery, rms, rms.inters, v)
+}
+
+func (rms *RoomMemberSelect) sqlScan(ctx context.Context, root *RoomMemberQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rms.fns))
+	for _, fn := range rms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
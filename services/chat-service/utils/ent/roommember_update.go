@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roommember"
+)
+
+// RoomMemberUpdate is the builder for updating RoomMember entities.
+type RoomMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoomMemberMutation
+}
+
+// Where appends a list predicates to the RoomMemberUpdate builder.
+func (rmu *RoomMemberUpdate) Where(ps ...predicate.RoomMember) *RoomMemberUpdate {
+	rmu.mutation.Where(ps...)
+	return rmu
+}
+
+// SetRoomID sets the "room_id" field.
+func (rmu *RoomMemberUpdate) SetRoomID(s string) *RoomMemberUpdate {
+	rmu.mutation.SetRoomID(s)
+	return rmu
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (rmu *RoomMemberUpdate) SetNillableRoomID(s *string) *RoomMemberUpdate {
+	if s != nil {
+		rmu.SetRoomID(*s)
+	}
+	return rmu
+}
+
+// SetUserID sets the "user_id" field.
+func (rmu *RoomMemberUpdate) SetUserID(s string) *RoomMemberUpdate {
+	rmu.mutation.SetUserID(s)
+	return rmu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rmu *RoomMemberUpdate) SetNillableUserID(s *string) *RoomMemberUpdate {
+	if s != nil {
+		rmu.SetUserID(*s)
+	}
+	return rmu
+}
+
+// SetUsername sets the "username" field.
+func (rmu *RoomMemberUpdate) SetUsername(s string) *RoomMemberUpdate {
+	rmu.mutation.SetUsername(s)
+	return rmu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (rmu *RoomMemberUpdate) SetNillableUsername(s *string) *RoomMemberUpdate {
+	if s != nil {
+		rmu.SetUsername(*s)
+	}
+	return rmu
+}
+
+// Mutation returns the RoomMemberMutation object of the builder.
+func (rmu *RoomMemberUpdate) Mutation() *RoomMemberMutation {
+	return rmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rmu *RoomMemberUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rmu.sqlSave, rmu.mutation, rmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rmu *RoomMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := rmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rmu *RoomMemberUpdate) Exec(ctx context.Context) error {
+	_, err := rmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rmu *RoomMemberUpdate) ExecX(ctx context.Context) {
+	if err := rmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rmu *RoomMemberUpdate) check() error {
+	if v, ok := rmu.mutation.RoomID(); ok {
+		if err := roommember.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "RoomMember.room_id": %w`, err)}
+		}
+	}
+	if v, ok := rmu.mutation.UserID(); ok {
+		if err := roommember.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "RoomMember.user_id": %w`, err)}
+		}
+	}
+	if v, ok := rmu.mutation.Username(); ok {
+		if err := roommember.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "RoomMember.username": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rmu *RoomMemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roommember.Table, roommember.Columns, sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeInt))
+	if ps := rmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rmu.mutation.RoomID(); ok {
+		_spec.SetField(roommember.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := rmu.mutation.UserID(); ok {
+		_spec.SetField(roommember.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := rmu.mutation.Username(); ok {
+		_spec.SetField(roommember.FieldUsername, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roommember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rmu.mutation.done = true
+	return n, nil
+}
+
+// RoomMemberUpdateOne is the builder for updating a single RoomMember entity.
+type RoomMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoomMemberMutation
+}
+
+// SetRoomID sets the "room_id" field.
+func (rmuo *RoomMemberUpdateOne) SetRoomID(s string) *RoomMemberUpdateOne {
+	rmuo.mutation.SetRoomID(s)
+	return rmuo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (rmuo *RoomMemberUpdateOne) SetNillableRoomID(s *string) *RoomMemberUpdateOne {
+	if s != nil {
+		rmuo.SetRoomID(*s)
+	}
+	return rmuo
+}
+
+// SetUserID sets the "user_id" field.
+func (rmuo *RoomMemberUpdateOne) SetUserID(s string) *RoomMemberUpdateOne {
+	rmuo.mutation.SetUserID(s)
+	return rmuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rmuo *RoomMemberUpdateOne) SetNillableUserID(s *string) *RoomMemberUpdateOne {
+	if s != nil {
+		rmuo.SetUserID(*s)
+	}
+	return rmuo
+}
+
+// SetUsername sets the "username" field.
+func (rmuo *RoomMemberUpdateOne) SetUsername(s string) *RoomMemberUpdateOne {
+	rmuo.mutation.SetUsername(s)
+	return rmuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (rmuo *RoomMemberUpdateOne) SetNillableUsername(s *string) *RoomMemberUpdateOne {
+	if s != nil {
+		rmuo.SetUsername(*s)
+	}
+	return rmuo
+}
+
+// Mutation returns the RoomMemberMutation object of the builder.
+func (rmuo *RoomMemberUpdateOne) Mutation() *RoomMemberMutation {
+	return rmuo.mutation
+}
+
+// Where appends a list predicates to the RoomMemberUpdate builder.
+func (rmuo *RoomMemberUpdateOne) Where(ps ...predicate.RoomMember) *RoomMemberUpdateOne {
+	rmuo.mutation.Where(ps...)
+	return rmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rmuo *RoomMemberUpdateOne) Select(field string, fields ...string) *RoomMemberUpdateOne {
+	rmuo.fields = append([]string{field}, fields...)
+	return rmuo
+}
+
+// Save executes the query and returns the updated RoomMember entity.
+func (rmuo *RoomMemberUpdateOne) Save(ctx context.Context) (*RoomMember, error) {
+	return withHooks(ctx, rmuo.sqlSave, rmuo.mutation, rmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rmuo *RoomMemberUpdateOne) SaveX(ctx context.Context) *RoomMember {
+	node, err := rmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rmuo *RoomMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := rmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rmuo *RoomMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := rmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rmuo *RoomMemberUpdateOne) check() error {
+	if v, ok := rmuo.mutation.RoomID(); ok {
+		if err := roommember.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "RoomMember.room_id": %w`, err)}
+		}
+	}
+	if v, ok := rmuo.mutation.UserID(); ok {
+		if err := roommember.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "RoomMember.user_id": %w`, err)}
+		}
+	}
+	if v, ok := rmuo.mutation.Username(); ok {
+		if err := roommember.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "RoomMember.username": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rmuo *RoomMemberUpdateOne) sqlSave(ctx context.Context) (_node *RoomMember, err error) {
+	if err := rmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roommember.Table, roommember.Columns, sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeInt))
+	id, ok := rmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoomMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roommember.FieldID)
+		for _, f := range fields {
+			if !roommember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != roommember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rmuo.mutation.RoomID(); ok {
+		_spec.SetField(roommember.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := rmuo.mutation.UserID(); ok {
+		_spec.SetField(roommember.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := rmuo.mutation.Username(); ok {
+		_spec.SetField(roommember.FieldUsername, field.TypeString, value)
+	}
+	_node = &RoomMember{config: rmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roommember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rmuo.mutation.done = true
+	return _node, nil
+}
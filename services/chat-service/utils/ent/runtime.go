@@ -3,15 +3,135 @@
 package ent
 
 import (
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botdelivery"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/botsubscription"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callparticipant"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/callsession"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/historyimport"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/messagepurge"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pinnedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/poll"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/pollvote"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/room"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roomexport"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/roommember"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/savedmessage"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/scheduledmessage"
 	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/schema"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/webhook"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	botdeliveryFields := schema.BotDelivery{}.Fields()
+	_ = botdeliveryFields
+	// botdeliveryDescEvent is the schema descriptor for event field.
+	botdeliveryDescEvent := botdeliveryFields[1].Descriptor()
+	// botdelivery.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	botdelivery.EventValidator = botdeliveryDescEvent.Validators[0].(func(string) error)
+	// botdeliveryDescAttempts is the schema descriptor for attempts field.
+	botdeliveryDescAttempts := botdeliveryFields[4].Descriptor()
+	// botdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	botdelivery.DefaultAttempts = botdeliveryDescAttempts.Default.(int)
+	// botdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	botdeliveryDescNextAttemptAt := botdeliveryFields[5].Descriptor()
+	// botdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	botdelivery.DefaultNextAttemptAt = botdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// botdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	botdeliveryDescCreatedAt := botdeliveryFields[7].Descriptor()
+	// botdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	botdelivery.DefaultCreatedAt = botdeliveryDescCreatedAt.Default.(func() time.Time)
+	botsubscriptionFields := schema.BotSubscription{}.Fields()
+	_ = botsubscriptionFields
+	// botsubscriptionDescBotID is the schema descriptor for bot_id field.
+	botsubscriptionDescBotID := botsubscriptionFields[0].Descriptor()
+	// botsubscription.BotIDValidator is a validator for the "bot_id" field. It is called by the builders before save.
+	botsubscription.BotIDValidator = botsubscriptionDescBotID.Validators[0].(func(string) error)
+	// botsubscriptionDescBotName is the schema descriptor for bot_name field.
+	botsubscriptionDescBotName := botsubscriptionFields[1].Descriptor()
+	// botsubscription.BotNameValidator is a validator for the "bot_name" field. It is called by the builders before save.
+	botsubscription.BotNameValidator = botsubscriptionDescBotName.Validators[0].(func(string) error)
+	// botsubscriptionDescRoomID is the schema descriptor for room_id field.
+	botsubscriptionDescRoomID := botsubscriptionFields[2].Descriptor()
+	// botsubscription.RoomIDValidator is a validator for the "room_id" field. It is called by the builders before save.
+	botsubscription.RoomIDValidator = botsubscriptionDescRoomID.Validators[0].(func(string) error)
+	// botsubscriptionDescCallbackURL is the schema descriptor for callback_url field.
+	botsubscriptionDescCallbackURL := botsubscriptionFields[3].Descriptor()
+	// botsubscription.CallbackURLValidator is a validator for the "callback_url" field. It is called by the builders before save.
+	botsubscription.CallbackURLValidator = botsubscriptionDescCallbackURL.Validators[0].(func(string) error)
+	// botsubscriptionDescSecret is the schema descriptor for secret field.
+	botsubscriptionDescSecret := botsubscriptionFields[4].Descriptor()
+	// botsubscription.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	botsubscription.SecretValidator = botsubscriptionDescSecret.Validators[0].(func(string) error)
+	// botsubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	botsubscriptionDescCreatedAt := botsubscriptionFields[6].Descriptor()
+	// botsubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	botsubscription.DefaultCreatedAt = botsubscriptionDescCreatedAt.Default.(func() time.Time)
+	callparticipantFields := schema.CallParticipant{}.Fields()
+	_ = callparticipantFields
+	// callparticipantDescUserID is the schema descriptor for user_id field.
+	callparticipantDescUserID := callparticipantFields[1].Descriptor()
+	// callparticipant.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	callparticipant.UserIDValidator = callparticipantDescUserID.Validators[0].(func(string) error)
+	// callparticipantDescUsername is the schema descriptor for username field.
+	callparticipantDescUsername := callparticipantFields[2].Descriptor()
+	// callparticipant.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	callparticipant.UsernameValidator = callparticipantDescUsername.Validators[0].(func(string) error)
+	// callparticipantDescJoinedAt is the schema descriptor for joined_at field.
+	callparticipantDescJoinedAt := callparticipantFields[3].Descriptor()
+	// callparticipant.DefaultJoinedAt holds the default value on creation for the joined_at field.
+	callparticipant.DefaultJoinedAt = callparticipantDescJoinedAt.Default.(func() time.Time)
+	callsessionFields := schema.CallSession{}.Fields()
+	_ = callsessionFields
+	// callsessionDescRoomID is the schema descriptor for room_id field.
+	callsessionDescRoomID := callsessionFields[0].Descriptor()
+	// callsession.RoomIDValidator is a validator for the "room_id" field. It is called by the builders before save.
+	callsession.RoomIDValidator = callsessionDescRoomID.Validators[0].(func(string) error)
+	// callsessionDescStartedBy is the schema descriptor for started_by field.
+	callsessionDescStartedBy := callsessionFields[1].Descriptor()
+	// callsession.StartedByValidator is a validator for the "started_by" field. It is called by the builders before save.
+	callsession.StartedByValidator = callsessionDescStartedBy.Validators[0].(func(string) error)
+	// callsessionDescStartedByName is the schema descriptor for started_by_name field.
+	callsessionDescStartedByName := callsessionFields[2].Descriptor()
+	// callsession.StartedByNameValidator is a validator for the "started_by_name" field. It is called by the builders before save.
+	callsession.StartedByNameValidator = callsessionDescStartedByName.Validators[0].(func(string) error)
+	// callsessionDescStartedAt is the schema descriptor for started_at field.
+	callsessionDescStartedAt := callsessionFields[3].Descriptor()
+	// callsession.DefaultStartedAt holds the default value on creation for the started_at field.
+	callsession.DefaultStartedAt = callsessionDescStartedAt.Default.(func() time.Time)
+	// callsessionDescUpdatedAt is the schema descriptor for updated_at field.
+	callsessionDescUpdatedAt := callsessionFields[5].Descriptor()
+	// callsession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	callsession.DefaultUpdatedAt = callsessionDescUpdatedAt.Default.(func() time.Time)
+	// callsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	callsession.UpdateDefaultUpdatedAt = callsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	historyimportFields := schema.HistoryImport{}.Fields()
+	_ = historyimportFields
+	// historyimportDescFilePath is the schema descriptor for file_path field.
+	historyimportDescFilePath := historyimportFields[1].Descriptor()
+	// historyimport.FilePathValidator is a validator for the "file_path" field. It is called by the builders before save.
+	historyimport.FilePathValidator = historyimportDescFilePath.Validators[0].(func(string) error)
+	// historyimportDescRoomsCreated is the schema descriptor for rooms_created field.
+	historyimportDescRoomsCreated := historyimportFields[4].Descriptor()
+	// historyimport.DefaultRoomsCreated holds the default value on creation for the rooms_created field.
+	historyimport.DefaultRoomsCreated = historyimportDescRoomsCreated.Default.(int)
+	// historyimportDescMessagesImported is the schema descriptor for messages_imported field.
+	historyimportDescMessagesImported := historyimportFields[5].Descriptor()
+	// historyimport.DefaultMessagesImported holds the default value on creation for the messages_imported field.
+	historyimport.DefaultMessagesImported = historyimportDescMessagesImported.Default.(int)
+	// historyimportDescMessagesSkipped is the schema descriptor for messages_skipped field.
+	historyimportDescMessagesSkipped := historyimportFields[6].Descriptor()
+	// historyimport.DefaultMessagesSkipped holds the default value on creation for the messages_skipped field.
+	historyimport.DefaultMessagesSkipped = historyimportDescMessagesSkipped.Default.(int)
+	// historyimportDescCreatedAt is the schema descriptor for created_at field.
+	historyimportDescCreatedAt := historyimportFields[10].Descriptor()
+	// historyimport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	historyimport.DefaultCreatedAt = historyimportDescCreatedAt.Default.(func() time.Time)
 	messageFields := schema.Message{}.Fields()
 	_ = messageFields
 	// messageDescContent is the schema descriptor for content field.
@@ -26,10 +146,208 @@ func init() {
 	messageDescUsername := messageFields[2].Descriptor()
 	// message.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
 	message.UsernameValidator = messageDescUsername.Validators[0].(func(string) error)
+	// messageDescBot is the schema descriptor for bot field.
+	messageDescBot := messageFields[3].Descriptor()
+	// message.DefaultBot holds the default value on creation for the bot field.
+	message.DefaultBot = messageDescBot.Default.(bool)
+	// messageDescCreatedAt is the schema descriptor for created_at field.
+	messageDescCreatedAt := messageFields[5].Descriptor()
+	// message.DefaultCreatedAt holds the default value on creation for the created_at field.
+	message.DefaultCreatedAt = messageDescCreatedAt.Default.(func() time.Time)
+	messagepurgeFields := schema.MessagePurge{}.Fields()
+	_ = messagepurgeFields
+	// messagepurgeDescRoomID is the schema descriptor for room_id field.
+	messagepurgeDescRoomID := messagepurgeFields[0].Descriptor()
+	// messagepurge.RoomIDValidator is a validator for the "room_id" field. It is called by the builders before save.
+	messagepurge.RoomIDValidator = messagepurgeDescRoomID.Validators[0].(func(string) error)
+	// messagepurgeDescDryRun is the schema descriptor for dry_run field.
+	messagepurgeDescDryRun := messagepurgeFields[3].Descriptor()
+	// messagepurge.DefaultDryRun holds the default value on creation for the dry_run field.
+	messagepurge.DefaultDryRun = messagepurgeDescDryRun.Default.(bool)
+	// messagepurgeDescMessageCount is the schema descriptor for message_count field.
+	messagepurgeDescMessageCount := messagepurgeFields[4].Descriptor()
+	// messagepurge.MessageCountValidator is a validator for the "message_count" field. It is called by the builders before save.
+	messagepurge.MessageCountValidator = messagepurgeDescMessageCount.Validators[0].(func(int) error)
+	// messagepurgeDescCreatedAt is the schema descriptor for created_at field.
+	messagepurgeDescCreatedAt := messagepurgeFields[6].Descriptor()
+	// messagepurge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagepurge.DefaultCreatedAt = messagepurgeDescCreatedAt.Default.(func() time.Time)
+	pinnedmessageFields := schema.PinnedMessage{}.Fields()
+	_ = pinnedmessageFields
+	// pinnedmessageDescRoomID is the schema descriptor for room_id field.
+	pinnedmessageDescRoomID := pinnedmessageFields[0].Descriptor()
+	// pinnedmessage.RoomIDValidator is a validator for the "room_id" field. It is called by the builders before save.
+	pinnedmessage.RoomIDValidator = pinnedmessageDescRoomID.Validators[0].(func(string) error)
+	// pinnedmessageDescPinnedBy is the schema descriptor for pinned_by field.
+	pinnedmessageDescPinnedBy := pinnedmessageFields[2].Descriptor()
+	// pinnedmessage.PinnedByValidator is a validator for the "pinned_by" field. It is called by the builders before save.
+	pinnedmessage.PinnedByValidator = pinnedmessageDescPinnedBy.Validators[0].(func(string) error)
+	// pinnedmessageDescPinnedAt is the schema descriptor for pinned_at field.
+	pinnedmessageDescPinnedAt := pinnedmessageFields[3].Descriptor()
+	// pinnedmessage.DefaultPinnedAt holds the default value on creation for the pinned_at field.
+	pinnedmessage.DefaultPinnedAt = pinnedmessageDescPinnedAt.Default.(func() time.Time)
+	pollFields := schema.Poll{}.Fields()
+	_ = pollFields
+	// pollDescRoomID is the schema descriptor for room_id field.
+	pollDescRoomID := pollFields[0].Descriptor()
+	// poll.RoomIDValidator is a validator for the "room_id" field. It is called by the builders before save.
+	poll.RoomIDValidator = pollDescRoomID.Validators[0].(func(string) error)
+	// pollDescQuestion is the schema descriptor for question field.
+	pollDescQuestion := pollFields[2].Descriptor()
+	// poll.QuestionValidator is a validator for the "question" field. It is called by the builders before save.
+	poll.QuestionValidator = pollDescQuestion.Validators[0].(func(string) error)
+	// pollDescMultiple is the schema descriptor for multiple field.
+	pollDescMultiple := pollFields[4].Descriptor()
+	// poll.DefaultMultiple holds the default value on creation for the multiple field.
+	poll.DefaultMultiple = pollDescMultiple.Default.(bool)
+	// pollDescAnonymous is the schema descriptor for anonymous field.
+	pollDescAnonymous := pollFields[5].Descriptor()
+	// poll.DefaultAnonymous holds the default value on creation for the anonymous field.
+	poll.DefaultAnonymous = pollDescAnonymous.Default.(bool)
+	// pollDescCreatedBy is the schema descriptor for created_by field.
+	pollDescCreatedBy := pollFields[6].Descriptor()
+	// poll.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	poll.CreatedByValidator = pollDescCreatedBy.Validators[0].(func(string) error)
+	// pollDescCreatedAt is the schema descriptor for created_at field.
+	pollDescCreatedAt := pollFields[10].Descriptor()
+	// poll.DefaultCreatedAt holds the default value on creation for the created_at field.
+	poll.DefaultCreatedAt = pollDescCreatedAt.Default.(func() time.Time)
+	// pollDescUpdatedAt is the schema descriptor for updated_at field.
+	pollDescUpdatedAt := pollFields[11].Descriptor()
+	// poll.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	poll.DefaultUpdatedAt = pollDescUpdatedAt.Default.(func() time.Time)
+	// poll.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	poll.UpdateDefaultUpdatedAt = pollDescUpdatedAt.UpdateDefault.(func() time.Time)
+	pollvoteFields := schema.PollVote{}.Fields()
+	_ = pollvoteFields
+	// pollvoteDescUserID is the schema descriptor for user_id field.
+	pollvoteDescUserID := pollvoteFields[1].Descriptor()
+	// pollvote.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	pollvote.UserIDValidator = pollvoteDescUserID.Validators[0].(func(string) error)
+	// pollvoteDescUsername is the schema descriptor for username field.
+	pollvoteDescUsername := pollvoteFields[2].Descriptor()
+	// pollvote.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	pollvote.UsernameValidator = pollvoteDescUsername.Validators[0].(func(string) error)
+	// pollvoteDescOption is the schema descriptor for option field.
+	pollvoteDescOption := pollvoteFields[3].Descriptor()
+	// pollvote.OptionValidator is a validator for the "option" field. It is called by the builders before save.
+	pollvote.OptionValidator = pollvoteDescOption.Validators[0].(func(int) error)
+	// pollvoteDescCreatedAt is the schema descriptor for created_at field.
+	pollvoteDescCreatedAt := pollvoteFields[4].Descriptor()
+	// pollvote.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pollvote.DefaultCreatedAt = pollvoteDescCreatedAt.Default.(func() time.Time)
 	roomFields := schema.Room{}.Fields()
 	_ = roomFields
 	// roomDescName is the schema descriptor for name field.
 	roomDescName := roomFields[0].Descriptor()
 	// room.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	room.NameValidator = roomDescName.Validators[0].(func(string) error)
+	// roomDescRetentionMaxMessages is the schema descriptor for retention_max_messages field.
+	roomDescRetentionMaxMessages := roomFields[2].Descriptor()
+	// room.RetentionMaxMessagesValidator is a validator for the "retention_max_messages" field. It is called by the builders before save.
+	room.RetentionMaxMessagesValidator = roomDescRetentionMaxMessages.Validators[0].(func(int) error)
+	roomexportFields := schema.RoomExport{}.Fields()
+	_ = roomexportFields
+	// roomexportDescRoomID is the schema descriptor for room_id field.
+	roomexportDescRoomID := roomexportFields[0].Descriptor()
+	// roomexport.RoomIDValidator is a validator for the "room_id" field. It is called by the builders before save.
+	roomexport.RoomIDValidator = roomexportDescRoomID.Validators[0].(func(string) error)
+	// roomexportDescUserID is the schema descriptor for user_id field.
+	roomexportDescUserID := roomexportFields[1].Descriptor()
+	// roomexport.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	roomexport.UserIDValidator = roomexportDescUserID.Validators[0].(func(string) error)
+	// roomexportDescMessageCount is the schema descriptor for message_count field.
+	roomexportDescMessageCount := roomexportFields[7].Descriptor()
+	// roomexport.DefaultMessageCount holds the default value on creation for the message_count field.
+	roomexport.DefaultMessageCount = roomexportDescMessageCount.Default.(int)
+	// roomexportDescCreatedAt is the schema descriptor for created_at field.
+	roomexportDescCreatedAt := roomexportFields[8].Descriptor()
+	// roomexport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	roomexport.DefaultCreatedAt = roomexportDescCreatedAt.Default.(func() time.Time)
+	roommemberFields := schema.RoomMember{}.Fields()
+	_ = roommemberFields
+	// roommemberDescRoomID is the schema descriptor for room_id field.
+	roommemberDescRoomID := roommemberFields[0].Descriptor()
+	// roommember.RoomIDValidator is a validator for the "room_id" field. It is called by the builders before save.
+	roommember.RoomIDValidator = roommemberDescRoomID.Validators[0].(func(string) error)
+	// roommemberDescUserID is the schema descriptor for user_id field.
+	roommemberDescUserID := roommemberFields[1].Descriptor()
+	// roommember.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	roommember.UserIDValidator = roommemberDescUserID.Validators[0].(func(string) error)
+	// roommemberDescUsername is the schema descriptor for username field.
+	roommemberDescUsername := roommemberFields[2].Descriptor()
+	// roommember.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	roommember.UsernameValidator = roommemberDescUsername.Validators[0].(func(string) error)
+	// roommemberDescJoinedAt is the schema descriptor for joined_at field.
+	roommemberDescJoinedAt := roommemberFields[3].Descriptor()
+	// roommember.DefaultJoinedAt holds the default value on creation for the joined_at field.
+	roommember.DefaultJoinedAt = roommemberDescJoinedAt.Default.(func() time.Time)
+	savedmessageFields := schema.SavedMessage{}.Fields()
+	_ = savedmessageFields
+	// savedmessageDescUserID is the schema descriptor for user_id field.
+	savedmessageDescUserID := savedmessageFields[0].Descriptor()
+	// savedmessage.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	savedmessage.UserIDValidator = savedmessageDescUserID.Validators[0].(func(string) error)
+	// savedmessageDescSavedAt is the schema descriptor for saved_at field.
+	savedmessageDescSavedAt := savedmessageFields[2].Descriptor()
+	// savedmessage.DefaultSavedAt holds the default value on creation for the saved_at field.
+	savedmessage.DefaultSavedAt = savedmessageDescSavedAt.Default.(func() time.Time)
+	scheduledmessageFields := schema.ScheduledMessage{}.Fields()
+	_ = scheduledmessageFields
+	// scheduledmessageDescRoomID is the schema descriptor for room_id field.
+	scheduledmessageDescRoomID := scheduledmessageFields[0].Descriptor()
+	// scheduledmessage.RoomIDValidator is a validator for the "room_id" field. It is called by the builders before save.
+	scheduledmessage.RoomIDValidator = scheduledmessageDescRoomID.Validators[0].(func(string) error)
+	// scheduledmessageDescUserID is the schema descriptor for user_id field.
+	scheduledmessageDescUserID := scheduledmessageFields[1].Descriptor()
+	// scheduledmessage.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	scheduledmessage.UserIDValidator = scheduledmessageDescUserID.Validators[0].(func(string) error)
+	// scheduledmessageDescUsername is the schema descriptor for username field.
+	scheduledmessageDescUsername := scheduledmessageFields[2].Descriptor()
+	// scheduledmessage.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	scheduledmessage.UsernameValidator = scheduledmessageDescUsername.Validators[0].(func(string) error)
+	// scheduledmessageDescContent is the schema descriptor for content field.
+	scheduledmessageDescContent := scheduledmessageFields[3].Descriptor()
+	// scheduledmessage.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	scheduledmessage.ContentValidator = scheduledmessageDescContent.Validators[0].(func(string) error)
+	// scheduledmessageDescTimeZone is the schema descriptor for time_zone field.
+	scheduledmessageDescTimeZone := scheduledmessageFields[5].Descriptor()
+	// scheduledmessage.DefaultTimeZone holds the default value on creation for the time_zone field.
+	scheduledmessage.DefaultTimeZone = scheduledmessageDescTimeZone.Default.(string)
+	// scheduledmessageDescCreatedAt is the schema descriptor for created_at field.
+	scheduledmessageDescCreatedAt := scheduledmessageFields[8].Descriptor()
+	// scheduledmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scheduledmessage.DefaultCreatedAt = scheduledmessageDescCreatedAt.Default.(func() time.Time)
+	// scheduledmessageDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduledmessageDescUpdatedAt := scheduledmessageFields[9].Descriptor()
+	// scheduledmessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	scheduledmessage.DefaultUpdatedAt = scheduledmessageDescUpdatedAt.Default.(func() time.Time)
+	// scheduledmessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	scheduledmessage.UpdateDefaultUpdatedAt = scheduledmessageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescRoomID is the schema descriptor for room_id field.
+	webhookDescRoomID := webhookFields[0].Descriptor()
+	// webhook.RoomIDValidator is a validator for the "room_id" field. It is called by the builders before save.
+	webhook.RoomIDValidator = webhookDescRoomID.Validators[0].(func(string) error)
+	// webhookDescName is the schema descriptor for name field.
+	webhookDescName := webhookFields[1].Descriptor()
+	// webhook.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webhook.NameValidator = webhookDescName.Validators[0].(func(string) error)
+	// webhookDescCreatedBy is the schema descriptor for created_by field.
+	webhookDescCreatedBy := webhookFields[2].Descriptor()
+	// webhook.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	webhook.CreatedByValidator = webhookDescCreatedBy.Validators[0].(func(string) error)
+	// webhookDescTokenHash is the schema descriptor for token_hash field.
+	webhookDescTokenHash := webhookFields[3].Descriptor()
+	// webhook.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	webhook.TokenHashValidator = webhookDescTokenHash.Validators[0].(func(string) error)
+	// webhookDescIsRevoked is the schema descriptor for is_revoked field.
+	webhookDescIsRevoked := webhookFields[4].Descriptor()
+	// webhook.DefaultIsRevoked holds the default value on creation for the is_revoked field.
+	webhook.DefaultIsRevoked = webhookDescIsRevoked.Default.(bool)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[5].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
 }
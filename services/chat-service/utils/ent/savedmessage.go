@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/savedmessage"
+)
+
+// SavedMessage is the model entity for the SavedMessage schema.
+type SavedMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID int `json:"message_id,omitempty"`
+	// SavedAt holds the value of the "saved_at" field.
+	SavedAt time.Time `json:"saved_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SavedMessageQuery when eager-loading is set.
+	Edges        SavedMessageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SavedMessageEdges holds the relations/edges for other nodes in the graph.
+type SavedMessageEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedMessageEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SavedMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case savedmessage.FieldID, savedmessage.FieldMessageID:
+			values[i] = new(sql.NullInt64)
+		case savedmessage.FieldUserID:
+			values[i] = new(sql.NullString)
+		case savedmessage.FieldSavedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SavedMessage fields.
+func (sm *SavedMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case savedmessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sm.ID = int(value.Int64)
+		case savedmessage.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sm.UserID = value.String
+			}
+		case savedmessage.FieldMessageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				sm.MessageID = int(value.Int64)
+			}
+		case savedmessage.FieldSavedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field saved_at", values[i])
+			} else if value.Valid {
+				sm.SavedAt = value.Time
+			}
+		default:
+			sm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SavedMessage.
+// This includes values selected through modifiers, order, etc.
+func (sm *SavedMessage) Value(name string) (ent.Value, error) {
+	return sm.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the SavedMessage entity.
+func (sm *SavedMessage) QueryMessage() *MessageQuery {
+	return NewSavedMessageClient(sm.config).QueryMessage(sm)
+}
+
+// Update returns a builder for updating this SavedMessage.
+// Note that you need to call SavedMessage.Unwrap() before calling this method if this SavedMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sm *SavedMessage) Update() *SavedMessageUpdateOne {
+	return NewSavedMessageClient(sm.config).UpdateOne(sm)
+}
+
+// Unwrap unwraps the SavedMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sm *SavedMessage) Unwrap() *SavedMessage {
+	_tx, ok := sm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SavedMessage is not a transactional entity")
+	}
+	sm.config.driver = _tx.drv
+	return sm
+}
+
+// String implements the fmt.Stringer.
+func (sm *SavedMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("SavedMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sm.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(sm.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", sm.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("saved_at=")
+	builder.WriteString(sm.SavedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SavedMessages is a parsable slice of SavedMessage.
+type SavedMessages []*SavedMessage
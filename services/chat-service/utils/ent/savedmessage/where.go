@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedmessage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldEQ(FieldUserID, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v int) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// SavedAt applies equality check predicate on the "saved_at" field. It's identical to SavedAtEQ.
+func SavedAt(v time.Time) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldEQ(FieldSavedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v int) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v int) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...int) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...int) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// SavedAtEQ applies the EQ predicate on the "saved_at" field.
+func SavedAtEQ(v time.Time) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldEQ(FieldSavedAt, v))
+}
+
+// SavedAtNEQ applies the NEQ predicate on the "saved_at" field.
+func SavedAtNEQ(v time.Time) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldNEQ(FieldSavedAt, v))
+}
+
+// SavedAtIn applies the In predicate on the "saved_at" field.
+func SavedAtIn(vs ...time.Time) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldIn(FieldSavedAt, vs...))
+}
+
+// SavedAtNotIn applies the NotIn predicate on the "saved_at" field.
+func SavedAtNotIn(vs ...time.Time) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldNotIn(FieldSavedAt, vs...))
+}
+
+// SavedAtGT applies the GT predicate on the "saved_at" field.
+func SavedAtGT(v time.Time) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldGT(FieldSavedAt, v))
+}
+
+// SavedAtGTE applies the GTE predicate on the "saved_at" field.
+func SavedAtGTE(v time.Time) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldGTE(FieldSavedAt, v))
+}
+
+// SavedAtLT applies the LT predicate on the "saved_at" field.
+func SavedAtLT(v time.Time) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldLT(FieldSavedAt, v))
+}
+
+// SavedAtLTE applies the LTE predicate on the "saved_at" field.
+func SavedAtLTE(v time.Time) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.FieldLTE(FieldSavedAt, v))
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.SavedMessage {
+	return predicate.SavedMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.Message) predicate.SavedMessage {
+	return predicate.SavedMessage(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SavedMessage) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SavedMessage) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SavedMessage) predicate.SavedMessage {
+	return predicate.SavedMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/savedmessage"
+)
+
+// SavedMessageCreate is the builder for creating a SavedMessage entity.
+type SavedMessageCreate struct {
+	config
+	mutation *SavedMessageMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (smc *SavedMessageCreate) SetUserID(s string) *SavedMessageCreate {
+	smc.mutation.SetUserID(s)
+	return smc
+}
+
+// SetMessageID sets the "message_id" field.
+func (smc *SavedMessageCreate) SetMessageID(i int) *SavedMessageCreate {
+	smc.mutation.SetMessageID(i)
+	return smc
+}
+
+// SetSavedAt sets the "saved_at" field.
+func (smc *SavedMessageCreate) SetSavedAt(t time.Time) *SavedMessageCreate {
+	smc.mutation.SetSavedAt(t)
+	return smc
+}
+
+// SetNillableSavedAt sets the "saved_at" field if the given value is not nil.
+func (smc *SavedMessageCreate) SetNillableSavedAt(t *time.Time) *SavedMessageCreate {
+	if t != nil {
+		smc.SetSavedAt(*t)
+	}
+	return smc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (smc *SavedMessageCreate) SetMessage(m *Message) *SavedMessageCreate {
+	return smc.SetMessageID(m.ID)
+}
+
+// Mutation returns the SavedMessageMutation object of the builder.
+func (smc *SavedMessageCreate) Mutation() *SavedMessageMutation {
+	return smc.mutation
+}
+
+// Save creates the SavedMessage in the database.
+func (smc *SavedMessageCreate) Save(ctx context.Context) (*SavedMessage, error) {
+	smc.defaults()
+	return withHooks(ctx, smc.sqlSave, smc.mutation, smc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (smc *SavedMessageCreate) SaveX(ctx context.Context) *SavedMessage {
+	v, err := smc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smc *SavedMessageCreate) Exec(ctx context.Context) error {
+	_, err := smc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smc *SavedMessageCreate) ExecX(ctx context.Context) {
+	if err := smc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smc *SavedMessageCreate) defaults() {
+	if _, ok := smc.mutation.SavedAt(); !ok {
+		v := savedmessage.DefaultSavedAt()
+		smc.mutation.SetSavedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smc *SavedMessageCreate) check() error {
+	if _, ok := smc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SavedMessage.user_id"`)}
+	}
+	if v, ok := smc.mutation.UserID(); ok {
+		if err := savedmessage.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "SavedMessage.user_id": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "SavedMessage.message_id"`)}
+	}
+	if _, ok := smc.mutation.SavedAt(); !ok {
+		return &ValidationError{Name: "saved_at", err: errors.New(`ent: missing required field "SavedMessage.saved_at"`)}
+	}
+	if len(smc.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "SavedMessage.message"`)}
+	}
+	return nil
+}
+
+func (smc *SavedMessageCreate) sqlSave(ctx context.Context) (*SavedMessage, error) {
+	if err := smc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := smc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, smc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	smc.mutation.id = &_node.ID
+	smc.mutation.done = true
+	return _node, nil
+}
+
+func (smc *SavedMessageCreate) createSpec() (*SavedMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SavedMessage{config: smc.config}
+		_spec = sqlgraph.NewCreateSpec(savedmessage.Table, sqlgraph.NewFieldSpec(savedmessage.FieldID, field.TypeInt))
+	)
+	if value, ok := smc.mutation.UserID(); ok {
+		_spec.SetField(savedmessage.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := smc.mutation.SavedAt(); ok {
+		_spec.SetField(savedmessage.FieldSavedAt, field.TypeTime, value)
+		_node.SavedAt = value
+	}
+	if nodes := smc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedmessage.MessageTable,
+			Columns: []string{savedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SavedMessageCreateBulk is the builder for creating many SavedMessage entities in bulk.
+type SavedMessageCreateBulk struct {
+	config
+	err      error
+	builders []*SavedMessageCreate
+}
+
+// Save creates the SavedMessage entities in the database.
+func (smcb *SavedMessageCreateBulk) Save(ctx context.Context) ([]*SavedMessage, error) {
+	if smcb.err != nil {
+		return nil, smcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(smcb.builders))
+	nodes := make([]*SavedMessage, len(smcb.builders))
+	mutators := make([]Mutator, len(smcb.builders))
+	for i := range smcb.builders {
+		func(i int, root context.Context) {
+			builder := smcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SavedMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, smcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, smcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, smcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smcb *SavedMessageCreateBulk) SaveX(ctx context.Context) []*SavedMessage {
+	v, err := smcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smcb *SavedMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := smcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smcb *SavedMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := smcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/savedmessage"
+)
+
+// SavedMessageDelete is the builder for deleting a SavedMessage entity.
+type SavedMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *SavedMessageMutation
+}
+
+// Where appends a list predicates to the SavedMessageDelete builder.
+func (smd *SavedMessageDelete) Where(ps ...predicate.SavedMessage) *SavedMessageDelete {
+	smd.mutation.Where(ps...)
+	return smd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (smd *SavedMessageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, smd.sqlExec, smd.mutation, smd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smd *SavedMessageDelete) ExecX(ctx context.Context) int {
+	n, err := smd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (smd *SavedMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(savedmessage.Table, sqlgraph.NewFieldSpec(savedmessage.FieldID, field.TypeInt))
+	if ps := smd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, smd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	smd.mutation.done = true
+	return affected, err
+}
+
+// SavedMessageDeleteOne is the builder for deleting a single SavedMessage entity.
+type SavedMessageDeleteOne struct {
+	smd *SavedMessageDelete
+}
+
+// Where appends a list predicates to the SavedMessageDelete builder.
+func (smdo *SavedMessageDeleteOne) Where(ps ...predicate.SavedMessage) *SavedMessageDeleteOne {
+	smdo.smd.mutation.Where(ps...)
+	return smdo
+}
+
+// Exec executes the deletion query.
+func (smdo *SavedMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := smdo.smd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{savedmessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smdo *SavedMessageDeleteOne) ExecX(ctx context.Context) {
+	if err := smdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
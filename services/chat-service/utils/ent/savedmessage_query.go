@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/savedmessage"
+)
+
+// SavedMessageQuery is the builder for querying SavedMessage entities.
+type SavedMessageQuery struct {
+	config
+	ctx         *QueryContext
+	order       []savedmessage.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.SavedMessage
+	withMessage *MessageQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SavedMessageQuery builder.
+func (smq *SavedMessageQuery) Where(ps ...predicate.SavedMessage) *SavedMessageQuery {
+	smq.predicates = append(smq.predicates, ps...)
+	return smq
+}
+
+// Limit the number of records to be returned by this query.
+func (smq *SavedMessageQuery) Limit(limit int) *SavedMessageQuery {
+	smq.ctx.Limit = &limit
+	return smq
+}
+
+// Offset to start from.
+func (smq *SavedMessageQuery) Offset(offset int) *SavedMessageQuery {
+	smq.ctx.Offset = &offset
+	return smq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (smq *SavedMessageQuery) Unique(unique bool) *SavedMessageQuery {
+	smq.ctx.Unique = &unique
+	return smq
+}
+
+// Order specifies how the records should be ordered.
+func (smq *SavedMessageQuery) Order(o ...savedmessage.OrderOption) *SavedMessageQuery {
+	smq.order = append(smq.order, o...)
+	return smq
+}
+
+// QueryMessage chains the current query on the "message" edge.
+func (smq *SavedMessageQuery) QueryMessage() *MessageQuery {
+	query := (&MessageClient{config: smq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := smq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := smq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedmessage.Table, savedmessage.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedmessage.MessageTable, savedmessage.MessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(smq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SavedMessage entity from the query.
+// Returns a *NotFoundError when no SavedMessage was found.
+func (smq *SavedMessageQuery) First(ctx context.Context) (*SavedMessage, error) {
+	nodes, err := smq.Limit(1).All(setContextOp(ctx, smq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{savedmessage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (smq *SavedMessageQuery) FirstX(ctx context.Context) *SavedMessage {
+	node, err := smq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SavedMessage ID from the query.
+// Returns a *NotFoundError when no SavedMessage ID was found.
+func (smq *SavedMessageQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = smq.Limit(1).IDs(setContextOp(ctx, smq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{savedmessage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (smq *SavedMessageQuery) FirstIDX(ctx context.Context) int {
+	id, err := smq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SavedMessage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SavedMessage entity is found.
+// Returns a *NotFoundError when no SavedMessage entities are found.
+func (smq *SavedMessageQuery) Only(ctx context.Context) (*SavedMessage, error) {
+	nodes, err := smq.Limit(2).All(setContextOp(ctx, smq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{savedmessage.Label}
+	default:
+		return nil, &NotSingularError{savedmessage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (smq *SavedMessageQuery) OnlyX(ctx context.Context) *SavedMessage {
+	node, err := smq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SavedMessage ID in the query.
+// Returns a *NotSingularError when more than one SavedMessage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (smq *SavedMessageQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = smq.Limit(2).IDs(setContextOp(ctx, smq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{savedmessage.Label}
+	default:
+		err = &NotSingularError{savedmessage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (smq *SavedMessageQuery) OnlyIDX(ctx context.Context) int {
+	id, err := smq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SavedMessages.
+func (smq *SavedMessageQuery) All(ctx context.Context) ([]*SavedMessage, error) {
+	ctx = setContextOp(ctx, smq.ctx, ent.OpQueryAll)
+	if err := smq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SavedMessage, *SavedMessageQuery]()
+	return withInterceptors[[]*SavedMessage](ctx, smq, qr, smq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (smq *SavedMessageQuery) AllX(ctx context.Context) []*SavedMessage {
+	nodes, err := smq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SavedMessage IDs.
+func (smq *SavedMessageQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if smq.ctx.Unique == nil && smq.path != nil {
+		smq.Unique(true)
+	}
+	ctx = setContextOp(ctx, smq.ctx, ent.OpQueryIDs)
+	if err = smq.Select(savedmessage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (smq *SavedMessageQuery) IDsX(ctx context.Context) []int {
+	ids, err := smq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (smq *SavedMessageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, smq.ctx, ent.OpQueryCount)
+	if err := smq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, smq, querierCount[*SavedMessageQuery](), smq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (smq *SavedMessageQuery) CountX(ctx context.Context) int {
+	count, err := smq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (smq *SavedMessageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, smq.ctx, ent.OpQueryExist)
+	switch _, err := smq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (smq *SavedMessageQuery) ExistX(ctx context.Context) bool {
+	exist, err := smq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SavedMessageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (smq *SavedMessageQuery) Clone() *SavedMessageQuery {
+	if smq == nil {
+		return nil
+	}
+	return &SavedMessageQuery{
+		config:      smq.config,
+		ctx:         smq.ctx.Clone(),
+		order:       append([]savedmessage.OrderOption{}, smq.order...),
+		inters:      append([]Interceptor{}, smq.inters...),
+		predicates:  append([]predicate.SavedMessage{}, smq.predicates...),
+		withMessage: smq.withMessage.Clone(),
+		// clone intermediate query.
+		sql:  smq.sql.Clone(),
+		path: smq.path,
+	}
+}
+
+// WithMessage tells the query-builder to eager-load the nodes that are connected to
+// the "message" edge. The optional arguments are used to configure the query builder of the edge.
+func (smq *SavedMessageQuery) WithMessage(opts ...func(*MessageQuery)) *SavedMessageQuery {
+	query := (&MessageClient{config: smq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	smq.withMessage = query
+	return smq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SavedMessage.Query().
+//		GroupBy(savedmessage.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (smq *SavedMessageQuery) GroupBy(field string, fields ...string) *SavedMessageGroupBy {
+	smq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SavedMessageGroupBy{build: smq}
+	grbuild.flds = &smq.ctx.Fields
+	grbuild.label = savedmessage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//	}
+//
+//	client.SavedMessage.Query().
+//		Select(savedmessage.FieldUserID).
+//		Scan(ctx, &v)
+func (smq *SavedMessageQuery) Select(fields ...string) *SavedMessageSelect {
+	smq.ctx.Fields = append(smq.ctx.Fields, fields...)
+	sbuild := &SavedMessageSelect{SavedMessageQuery: smq}
+	sbuild.label = savedmessage.Label
+	sbuild.flds, sbuild.scan = &smq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SavedMessageSelect configured with the given aggregations.
+func (smq *SavedMessageQuery) Aggregate(fns ...AggregateFunc) *SavedMessageSelect {
+	return smq.Select().Aggregate(fns...)
+}
+
+func (smq *SavedMessageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range smq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, smq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range smq.ctx.Fields {
+		if !savedmessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if smq.path != nil {
+		prev, err := smq.path(ctx)
+		if err != nil {
+			return err
+		}
+		smq.sql = prev
+	}
+	return nil
+}
+
+func (smq *SavedMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SavedMessage, error) {
+	var (
+		nodes       = []*SavedMessage{}
+		_spec       = smq.querySpec()
+		loadedTypes = [1]bool{
+			smq.withMessage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SavedMessage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SavedMessage{config: smq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, smq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := smq.withMessage; query != nil {
+		if err := smq.loadMessage(ctx, query, nodes, nil,
+			func(n *SavedMessage, e *Message) { n.Edges.Message = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (smq *SavedMessageQuery) loadMessage(ctx context.Context, query *MessageQuery, nodes []*SavedMessage, init func(*SavedMessage), assign func(*SavedMessage, *Message)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SavedMessage)
+	for i := range nodes {
+		fk := nodes[i].MessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (smq *SavedMessageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := smq.querySpec()
+	_spec.Node.Columns = smq.ctx.Fields
+	if len(smq.ctx.Fields) > 0 {
+		_spec.Unique = smq.ctx.Unique != nil && *smq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, smq.driver, _spec)
+}
+
+func (smq *SavedMessageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(savedmessage.Table, savedmessage.Columns, sqlgraph.NewFieldSpec(savedmessage.FieldID, field.TypeInt))
+	_spec.From = smq.sql
+	if unique := smq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if smq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := smq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedmessage.FieldID)
+		for i := range fields {
+			if fields[i] != savedmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if smq.withMessage != nil {
+			_spec.Node.AddColumnOnce(savedmessage.FieldMessageID)
+		}
+	}
+	if ps := smq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := smq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := smq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := smq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (smq *SavedMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(smq.driver.Dialect())
+	t1 := builder.Table(savedmessage.Table)
+	columns := smq.ctx.Fields
+	if len(columns) == 0 {
+		columns = savedmessage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if smq.sql != nil {
+		selector = smq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if smq.ctx.Unique != nil && *smq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range smq.predicates {
+		p(selector)
+	}
+	for _, p := range smq.order {
+		p(selector)
+	}
+	if offset := smq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := smq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SavedMessageGroupBy is the group-by builder for SavedMessage entities.
+type SavedMessageGroupBy struct {
+	selector
+	build *SavedMessageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (smgb *SavedMessageGroupBy) Aggregate(fns ...AggregateFunc) *SavedMessageGroupBy {
+	smgb.fns = append(smgb.fns, fns...)
+	return smgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (smgb *SavedMessageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, smgb.build.ctx, ent.OpQueryGroupBy)
+	if err := smgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedMessageQuery, *SavedMessageGroupBy](ctx, smgb.build, smgb, smgb.build.inters, v)
+}
+
+func (smgb *SavedMessageGroupBy) sqlScan(ctx context.Context, root *SavedMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(smgb.fns))
+	for _, fn := range smgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*smgb.flds)+len(smgb.fns))
+		for _, f := range *smgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*smgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := smgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SavedMessageSelect is the builder for selecting fields of SavedMessage entities.
+type SavedMessageSelect struct {
+	*SavedMessageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sms *SavedMessageSelect) Aggregate(fns ...AggregateFunc) *SavedMessageSelect {
+	sms.fns = append(sms.fns, fns...)
+	return sms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sms *SavedMessageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sms.ctx, ent.OpQuerySelect)
+	if err := sms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedMessageQuery, *SavedMessageSelect](ctx, sms.SavedMessageQuery, sms, sms.inters, v)
+}
+
+func (sms *SavedMessageSelect) sqlScan(ctx context.Context, root *SavedMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sms.fns))
+	for _, fn := range sms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/message"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/savedmessage"
+)
+
+// SavedMessageUpdate is the builder for updating SavedMessage entities.
+type SavedMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SavedMessageMutation
+}
+
+// Where appends a list predicates to the SavedMessageUpdate builder.
+func (smu *SavedMessageUpdate) Where(ps ...predicate.SavedMessage) *SavedMessageUpdate {
+	smu.mutation.Where(ps...)
+	return smu
+}
+
+// SetUserID sets the "user_id" field.
+func (smu *SavedMessageUpdate) SetUserID(s string) *SavedMessageUpdate {
+	smu.mutation.SetUserID(s)
+	return smu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (smu *SavedMessageUpdate) SetNillableUserID(s *string) *SavedMessageUpdate {
+	if s != nil {
+		smu.SetUserID(*s)
+	}
+	return smu
+}
+
+// SetMessageID sets the "message_id" field.
+func (smu *SavedMessageUpdate) SetMessageID(i int) *SavedMessageUpdate {
+	smu.mutation.SetMessageID(i)
+	return smu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (smu *SavedMessageUpdate) SetNillableMessageID(i *int) *SavedMessageUpdate {
+	if i != nil {
+		smu.SetMessageID(*i)
+	}
+	return smu
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (smu *SavedMessageUpdate) SetMessage(m *Message) *SavedMessageUpdate {
+	return smu.SetMessageID(m.ID)
+}
+
+// Mutation returns the SavedMessageMutation object of the builder.
+func (smu *SavedMessageUpdate) Mutation() *SavedMessageMutation {
+	return smu.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (smu *SavedMessageUpdate) ClearMessage() *SavedMessageUpdate {
+	smu.mutation.ClearMessage()
+	return smu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (smu *SavedMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, smu.sqlSave, smu.mutation, smu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smu *SavedMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := smu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (smu *SavedMessageUpdate) Exec(ctx context.Context) error {
+	_, err := smu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smu *SavedMessageUpdate) ExecX(ctx context.Context) {
+	if err := smu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smu *SavedMessageUpdate) check() error {
+	if v, ok := smu.mutation.UserID(); ok {
+		if err := savedmessage.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "SavedMessage.user_id": %w`, err)}
+		}
+	}
+	if smu.mutation.MessageCleared() && len(smu.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedMessage.message"`)
+	}
+	return nil
+}
+
+func (smu *SavedMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := smu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedmessage.Table, savedmessage.Columns, sqlgraph.NewFieldSpec(savedmessage.FieldID, field.TypeInt))
+	if ps := smu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := smu.mutation.UserID(); ok {
+		_spec.SetField(savedmessage.FieldUserID, field.TypeString, value)
+	}
+	if smu.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedmessage.MessageTable,
+			Columns: []string{savedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smu.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedmessage.MessageTable,
+			Columns: []string{savedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, smu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	smu.mutation.done = true
+	return n, nil
+}
+
+// SavedMessageUpdateOne is the builder for updating a single SavedMessage entity.
+type SavedMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SavedMessageMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (smuo *SavedMessageUpdateOne) SetUserID(s string) *SavedMessageUpdateOne {
+	smuo.mutation.SetUserID(s)
+	return smuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (smuo *SavedMessageUpdateOne) SetNillableUserID(s *string) *SavedMessageUpdateOne {
+	if s != nil {
+		smuo.SetUserID(*s)
+	}
+	return smuo
+}
+
+// SetMessageID sets the "message_id" field.
+func (smuo *SavedMessageUpdateOne) SetMessageID(i int) *SavedMessageUpdateOne {
+	smuo.mutation.SetMessageID(i)
+	return smuo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (smuo *SavedMessageUpdateOne) SetNillableMessageID(i *int) *SavedMessageUpdateOne {
+	if i != nil {
+		smuo.SetMessageID(*i)
+	}
+	return smuo
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (smuo *SavedMessageUpdateOne) SetMessage(m *Message) *SavedMessageUpdateOne {
+	return smuo.SetMessageID(m.ID)
+}
+
+// Mutation returns the SavedMessageMutation object of the builder.
+func (smuo *SavedMessageUpdateOne) Mutation() *SavedMessageMutation {
+	return smuo.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (smuo *SavedMessageUpdateOne) ClearMessage() *SavedMessageUpdateOne {
+	smuo.mutation.ClearMessage()
+	return smuo
+}
+
+// Where appends a list predicates to the SavedMessageUpdate builder.
+func (smuo *SavedMessageUpdateOne) Where(ps ...predicate.SavedMessage) *SavedMessageUpdateOne {
+	smuo.mutation.Where(ps...)
+	return smuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (smuo *SavedMessageUpdateOne) Select(field string, fields ...string) *SavedMessageUpdateOne {
+	smuo.fields = append([]string{field}, fields...)
+	return smuo
+}
+
+// Save executes the query and returns the updated SavedMessage entity.
+func (smuo *SavedMessageUpdateOne) Save(ctx context.Context) (*SavedMessage, error) {
+	return withHooks(ctx, smuo.sqlSave, smuo.mutation, smuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smuo *SavedMessageUpdateOne) SaveX(ctx context.Context) *SavedMessage {
+	node, err := smuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (smuo *SavedMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := smuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smuo *SavedMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := smuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smuo *SavedMessageUpdateOne) check() error {
+	if v, ok := smuo.mutation.UserID(); ok {
+		if err := savedmessage.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "SavedMessage.user_id": %w`, err)}
+		}
+	}
+	if smuo.mutation.MessageCleared() && len(smuo.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedMessage.message"`)
+	}
+	return nil
+}
+
+func (smuo *SavedMessageUpdateOne) sqlSave(ctx context.Context) (_node *SavedMessage, err error) {
+	if err := smuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedmessage.Table, savedmessage.Columns, sqlgraph.NewFieldSpec(savedmessage.FieldID, field.TypeInt))
+	id, ok := smuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := smuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedmessage.FieldID)
+		for _, f := range fields {
+			if !savedmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := smuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := smuo.mutation.UserID(); ok {
+		_spec.SetField(savedmessage.FieldUserID, field.TypeString, value)
+	}
+	if smuo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedmessage.MessageTable,
+			Columns: []string{savedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smuo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedmessage.MessageTable,
+			Columns: []string{savedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SavedMessage{config: smuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, smuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	smuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/scheduledmessage"
+)
+
+// ScheduledMessage is the model entity for the ScheduledMessage schema.
+type ScheduledMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// RoomID holds the value of the "room_id" field.
+	RoomID string `json:"room_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// SendAt holds the value of the "send_at" field.
+	SendAt time.Time `json:"send_at,omitempty"`
+	// TimeZone holds the value of the "time_zone" field.
+	TimeZone string `json:"time_zone,omitempty"`
+	// Status holds the value of the "status" field.
+	Status scheduledmessage.Status `json:"status,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID *int `json:"message_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// SentAt holds the value of the "sent_at" field.
+	SentAt       *time.Time `json:"sent_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduledMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scheduledmessage.FieldID, scheduledmessage.FieldMessageID:
+			values[i] = new(sql.NullInt64)
+		case scheduledmessage.FieldRoomID, scheduledmessage.FieldUserID, scheduledmessage.FieldUsername, scheduledmessage.FieldContent, scheduledmessage.FieldTimeZone, scheduledmessage.FieldStatus:
+			values[i] = new(sql.NullString)
+		case scheduledmessage.FieldSendAt, scheduledmessage.FieldCreatedAt, scheduledmessage.FieldUpdatedAt, scheduledmessage.FieldSentAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduledMessage fields.
+func (sm *ScheduledMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scheduledmessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sm.ID = int(value.Int64)
+		case scheduledmessage.FieldRoomID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value.Valid {
+				sm.RoomID = value.String
+			}
+		case scheduledmessage.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sm.UserID = value.String
+			}
+		case scheduledmessage.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				sm.Username = value.String
+			}
+		case scheduledmessage.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				sm.Content = value.String
+			}
+		case scheduledmessage.FieldSendAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field send_at", values[i])
+			} else if value.Valid {
+				sm.SendAt = value.Time
+			}
+		case scheduledmessage.FieldTimeZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_zone", values[i])
+			} else if value.Valid {
+				sm.TimeZone = value.String
+			}
+		case scheduledmessage.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sm.Status = scheduledmessage.Status(value.String)
+			}
+		case scheduledmessage.FieldMessageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				sm.MessageID = new(int)
+				*sm.MessageID = int(value.Int64)
+			}
+		case scheduledmessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sm.CreatedAt = value.Time
+			}
+		case scheduledmessage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sm.UpdatedAt = value.Time
+			}
+		case scheduledmessage.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				sm.SentAt = new(time.Time)
+				*sm.SentAt = value.Time
+			}
+		default:
+			sm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduledMessage.
+// This includes values selected through modifiers, order, etc.
+func (sm *ScheduledMessage) Value(name string) (ent.Value, error) {
+	return sm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ScheduledMessage.
+// Note that you need to call ScheduledMessage.Unwrap() before calling this method if this ScheduledMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sm *ScheduledMessage) Update() *ScheduledMessageUpdateOne {
+	return NewScheduledMessageClient(sm.config).UpdateOne(sm)
+}
+
+// Unwrap unwraps the ScheduledMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sm *ScheduledMessage) Unwrap() *ScheduledMessage {
+	_tx, ok := sm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduledMessage is not a transactional entity")
+	}
+	sm.config.driver = _tx.drv
+	return sm
+}
+
+// String implements the fmt.Stringer.
+func (sm *ScheduledMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduledMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sm.ID))
+	builder.WriteString("room_id=")
+	builder.WriteString(sm.RoomID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(sm.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(sm.Username)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(sm.Content)
+	builder.WriteString(", ")
+	builder.WriteString("send_at=")
+	builder.WriteString(sm.SendAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("time_zone=")
+	builder.WriteString(sm.TimeZone)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", sm.Status))
+	builder.WriteString(", ")
+	if v := sm.MessageID; v != nil {
+		builder.WriteString("message_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sm.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := sm.SentAt; v != nil {
+		builder.WriteString("sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduledMessages is a parsable slice of ScheduledMessage.
+type ScheduledMessages []*ScheduledMessage
@@ -0,0 +1,685 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledmessage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldID, id))
+}
+
+// RoomID applies equality check predicate on the "room_id" field. It's identical to RoomIDEQ.
+func RoomID(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldRoomID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldUserID, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldUsername, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldContent, v))
+}
+
+// SendAt applies equality check predicate on the "send_at" field. It's identical to SendAtEQ.
+func SendAt(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSendAt, v))
+}
+
+// TimeZone applies equality check predicate on the "time_zone" field. It's identical to TimeZoneEQ.
+func TimeZone(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldTimeZone, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSentAt, v))
+}
+
+// RoomIDEQ applies the EQ predicate on the "room_id" field.
+func RoomIDEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldRoomID, v))
+}
+
+// RoomIDNEQ applies the NEQ predicate on the "room_id" field.
+func RoomIDNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldRoomID, v))
+}
+
+// RoomIDIn applies the In predicate on the "room_id" field.
+func RoomIDIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldRoomID, vs...))
+}
+
+// RoomIDNotIn applies the NotIn predicate on the "room_id" field.
+func RoomIDNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldRoomID, vs...))
+}
+
+// RoomIDGT applies the GT predicate on the "room_id" field.
+func RoomIDGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldRoomID, v))
+}
+
+// RoomIDGTE applies the GTE predicate on the "room_id" field.
+func RoomIDGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldRoomID, v))
+}
+
+// RoomIDLT applies the LT predicate on the "room_id" field.
+func RoomIDLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldRoomID, v))
+}
+
+// RoomIDLTE applies the LTE predicate on the "room_id" field.
+func RoomIDLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldRoomID, v))
+}
+
+// RoomIDContains applies the Contains predicate on the "room_id" field.
+func RoomIDContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldRoomID, v))
+}
+
+// RoomIDHasPrefix applies the HasPrefix predicate on the "room_id" field.
+func RoomIDHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldRoomID, v))
+}
+
+// RoomIDHasSuffix applies the HasSuffix predicate on the "room_id" field.
+func RoomIDHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldRoomID, v))
+}
+
+// RoomIDEqualFold applies the EqualFold predicate on the "room_id" field.
+func RoomIDEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldRoomID, v))
+}
+
+// RoomIDContainsFold applies the ContainsFold predicate on the "room_id" field.
+func RoomIDContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldRoomID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldContent, v))
+}
+
+// SendAtEQ applies the EQ predicate on the "send_at" field.
+func SendAtEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSendAt, v))
+}
+
+// SendAtNEQ applies the NEQ predicate on the "send_at" field.
+func SendAtNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldSendAt, v))
+}
+
+// SendAtIn applies the In predicate on the "send_at" field.
+func SendAtIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldSendAt, vs...))
+}
+
+// SendAtNotIn applies the NotIn predicate on the "send_at" field.
+func SendAtNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldSendAt, vs...))
+}
+
+// SendAtGT applies the GT predicate on the "send_at" field.
+func SendAtGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldSendAt, v))
+}
+
+// SendAtGTE applies the GTE predicate on the "send_at" field.
+func SendAtGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldSendAt, v))
+}
+
+// SendAtLT applies the LT predicate on the "send_at" field.
+func SendAtLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldSendAt, v))
+}
+
+// SendAtLTE applies the LTE predicate on the "send_at" field.
+func SendAtLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldSendAt, v))
+}
+
+// TimeZoneEQ applies the EQ predicate on the "time_zone" field.
+func TimeZoneEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldTimeZone, v))
+}
+
+// TimeZoneNEQ applies the NEQ predicate on the "time_zone" field.
+func TimeZoneNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldTimeZone, v))
+}
+
+// TimeZoneIn applies the In predicate on the "time_zone" field.
+func TimeZoneIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldTimeZone, vs...))
+}
+
+// TimeZoneNotIn applies the NotIn predicate on the "time_zone" field.
+func TimeZoneNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldTimeZone, vs...))
+}
+
+// TimeZoneGT applies the GT predicate on the "time_zone" field.
+func TimeZoneGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldTimeZone, v))
+}
+
+// TimeZoneGTE applies the GTE predicate on the "time_zone" field.
+func TimeZoneGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldTimeZone, v))
+}
+
+// TimeZoneLT applies the LT predicate on the "time_zone" field.
+func TimeZoneLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldTimeZone, v))
+}
+
+// TimeZoneLTE applies the LTE predicate on the "time_zone" field.
+func TimeZoneLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldTimeZone, v))
+}
+
+// TimeZoneContains applies the Contains predicate on the "time_zone" field.
+func TimeZoneContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldTimeZone, v))
+}
+
+// TimeZoneHasPrefix applies the HasPrefix predicate on the "time_zone" field.
+func TimeZoneHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldTimeZone, v))
+}
+
+// TimeZoneHasSuffix applies the HasSuffix predicate on the "time_zone" field.
+func TimeZoneHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldTimeZone, v))
+}
+
+// TimeZoneEqualFold applies the EqualFold predicate on the "time_zone" field.
+func TimeZoneEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldTimeZone, v))
+}
+
+// TimeZoneContainsFold applies the ContainsFold predicate on the "time_zone" field.
+func TimeZoneContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldTimeZone, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldMessageID, v))
+}
+
+// MessageIDIsNil applies the IsNil predicate on the "message_id" field.
+func MessageIDIsNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIsNull(FieldMessageID))
+}
+
+// MessageIDNotNil applies the NotNil predicate on the "message_id" field.
+func MessageIDNotNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotNull(FieldMessageID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldSentAt, v))
+}
+
+// SentAtIsNil applies the IsNil predicate on the "sent_at" field.
+func SentAtIsNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIsNull(FieldSentAt))
+}
+
+// SentAtNotNil applies the NotNil predicate on the "sent_at" field.
+func SentAtNotNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotNull(FieldSentAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.NotPredicates(p))
+}
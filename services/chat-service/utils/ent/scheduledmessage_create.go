@@ -0,0 +1,403 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/scheduledmessage"
+)
+
+// ScheduledMessageCreate is the builder for creating a ScheduledMessage entity.
+type ScheduledMessageCreate struct {
+	config
+	mutation *ScheduledMessageMutation
+	hooks    []Hook
+}
+
+// SetRoomID sets the "room_id" field.
+func (smc *ScheduledMessageCreate) SetRoomID(s string) *ScheduledMessageCreate {
+	smc.mutation.SetRoomID(s)
+	return smc
+}
+
+// SetUserID sets the "user_id" field.
+func (smc *ScheduledMessageCreate) SetUserID(s string) *ScheduledMessageCreate {
+	smc.mutation.SetUserID(s)
+	return smc
+}
+
+// SetUsername sets the "username" field.
+func (smc *ScheduledMessageCreate) SetUsername(s string) *ScheduledMessageCreate {
+	smc.mutation.SetUsername(s)
+	return smc
+}
+
+// SetContent sets the "content" field.
+func (smc *ScheduledMessageCreate) SetContent(s string) *ScheduledMessageCreate {
+	smc.mutation.SetContent(s)
+	return smc
+}
+
+// SetSendAt sets the "send_at" field.
+func (smc *ScheduledMessageCreate) SetSendAt(t time.Time) *ScheduledMessageCreate {
+	smc.mutation.SetSendAt(t)
+	return smc
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (smc *ScheduledMessageCreate) SetTimeZone(s string) *ScheduledMessageCreate {
+	smc.mutation.SetTimeZone(s)
+	return smc
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableTimeZone(s *string) *ScheduledMessageCreate {
+	if s != nil {
+		smc.SetTimeZone(*s)
+	}
+	return smc
+}
+
+// SetStatus sets the "status" field.
+func (smc *ScheduledMessageCreate) SetStatus(s scheduledmessage.Status) *ScheduledMessageCreate {
+	smc.mutation.SetStatus(s)
+	return smc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableStatus(s *scheduledmessage.Status) *ScheduledMessageCreate {
+	if s != nil {
+		smc.SetStatus(*s)
+	}
+	return smc
+}
+
+// SetMessageID sets the "message_id" field.
+func (smc *ScheduledMessageCreate) SetMessageID(i int) *ScheduledMessageCreate {
+	smc.mutation.SetMessageID(i)
+	return smc
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableMessageID(i *int) *ScheduledMessageCreate {
+	if i != nil {
+		smc.SetMessageID(*i)
+	}
+	return smc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (smc *ScheduledMessageCreate) SetCreatedAt(t time.Time) *ScheduledMessageCreate {
+	smc.mutation.SetCreatedAt(t)
+	return smc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableCreatedAt(t *time.Time) *ScheduledMessageCreate {
+	if t != nil {
+		smc.SetCreatedAt(*t)
+	}
+	return smc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (smc *ScheduledMessageCreate) SetUpdatedAt(t time.Time) *ScheduledMessageCreate {
+	smc.mutation.SetUpdatedAt(t)
+	return smc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableUpdatedAt(t *time.Time) *ScheduledMessageCreate {
+	if t != nil {
+		smc.SetUpdatedAt(*t)
+	}
+	return smc
+}
+
+// SetSentAt sets the "sent_at" field.
+func (smc *ScheduledMessageCreate) SetSentAt(t time.Time) *ScheduledMessageCreate {
+	smc.mutation.SetSentAt(t)
+	return smc
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableSentAt(t *time.Time) *ScheduledMessageCreate {
+	if t != nil {
+		smc.SetSentAt(*t)
+	}
+	return smc
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (smc *ScheduledMessageCreate) Mutation() *ScheduledMessageMutation {
+	return smc.mutation
+}
+
+// Save creates the ScheduledMessage in the database.
+func (smc *ScheduledMessageCreate) Save(ctx context.Context) (*ScheduledMessage, error) {
+	smc.defaults()
+	return withHooks(ctx, smc.sqlSave, smc.mutation, smc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (smc *ScheduledMessageCreate) SaveX(ctx context.Context) *ScheduledMessage {
+	v, err := smc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smc *ScheduledMessageCreate) Exec(ctx context.Context) error {
+	_, err := smc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smc *ScheduledMessageCreate) ExecX(ctx context.Context) {
+	if err := smc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smc *ScheduledMessageCreate) defaults() {
+	if _, ok := smc.mutation.TimeZone(); !ok {
+		v := scheduledmessage.DefaultTimeZone
+		smc.mutation.SetTimeZone(v)
+	}
+	if _, ok := smc.mutation.Status(); !ok {
+		v := scheduledmessage.DefaultStatus
+		smc.mutation.SetStatus(v)
+	}
+	if _, ok := smc.mutation.CreatedAt(); !ok {
+		v := scheduledmessage.DefaultCreatedAt()
+		smc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := smc.mutation.UpdatedAt(); !ok {
+		v := scheduledmessage.DefaultUpdatedAt()
+		smc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smc *ScheduledMessageCreate) check() error {
+	if _, ok := smc.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room_id", err: errors.New(`ent: missing required field "ScheduledMessage.room_id"`)}
+	}
+	if v, ok := smc.mutation.RoomID(); ok {
+		if err := scheduledmessage.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.room_id": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ScheduledMessage.user_id"`)}
+	}
+	if v, ok := smc.mutation.UserID(); ok {
+		if err := scheduledmessage.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.user_id": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "ScheduledMessage.username"`)}
+	}
+	if v, ok := smc.mutation.Username(); ok {
+		if err := scheduledmessage.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.username": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "ScheduledMessage.content"`)}
+	}
+	if v, ok := smc.mutation.Content(); ok {
+		if err := scheduledmessage.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.content": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.SendAt(); !ok {
+		return &ValidationError{Name: "send_at", err: errors.New(`ent: missing required field "ScheduledMessage.send_at"`)}
+	}
+	if _, ok := smc.mutation.TimeZone(); !ok {
+		return &ValidationError{Name: "time_zone", err: errors.New(`ent: missing required field "ScheduledMessage.time_zone"`)}
+	}
+	if _, ok := smc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScheduledMessage.status"`)}
+	}
+	if v, ok := smc.mutation.Status(); ok {
+		if err := scheduledmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.status": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScheduledMessage.created_at"`)}
+	}
+	if _, ok := smc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ScheduledMessage.updated_at"`)}
+	}
+	return nil
+}
+
+func (smc *ScheduledMessageCreate) sqlSave(ctx context.Context) (*ScheduledMessage, error) {
+	if err := smc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := smc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, smc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	smc.mutation.id = &_node.ID
+	smc.mutation.done = true
+	return _node, nil
+}
+
+func (smc *ScheduledMessageCreate) createSpec() (*ScheduledMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduledMessage{config: smc.config}
+		_spec = sqlgraph.NewCreateSpec(scheduledmessage.Table, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeInt))
+	)
+	if value, ok := smc.mutation.RoomID(); ok {
+		_spec.SetField(scheduledmessage.FieldRoomID, field.TypeString, value)
+		_node.RoomID = value
+	}
+	if value, ok := smc.mutation.UserID(); ok {
+		_spec.SetField(scheduledmessage.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := smc.mutation.Username(); ok {
+		_spec.SetField(scheduledmessage.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := smc.mutation.Content(); ok {
+		_spec.SetField(scheduledmessage.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := smc.mutation.SendAt(); ok {
+		_spec.SetField(scheduledmessage.FieldSendAt, field.TypeTime, value)
+		_node.SendAt = value
+	}
+	if value, ok := smc.mutation.TimeZone(); ok {
+		_spec.SetField(scheduledmessage.FieldTimeZone, field.TypeString, value)
+		_node.TimeZone = value
+	}
+	if value, ok := smc.mutation.Status(); ok {
+		_spec.SetField(scheduledmessage.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := smc.mutation.MessageID(); ok {
+		_spec.SetField(scheduledmessage.FieldMessageID, field.TypeInt, value)
+		_node.MessageID = &value
+	}
+	if value, ok := smc.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := smc.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := smc.mutation.SentAt(); ok {
+		_spec.SetField(scheduledmessage.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	return _node, _spec
+}
+
+// ScheduledMessageCreateBulk is the builder for creating many ScheduledMessage entities in bulk.
+type ScheduledMessageCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduledMessageCreate
+}
+
+// Save creates the ScheduledMessage entities in the database.
+func (smcb *ScheduledMessageCreateBulk) Save(ctx context.Context) ([]*ScheduledMessage, error) {
+	if smcb.err != nil {
+		return nil, smcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(smcb.builders))
+	nodes := make([]*ScheduledMessage, len(smcb.builders))
+	mutators := make([]Mutator, len(smcb.builders))
+	for i := range smcb.builders {
+		func(i int, root context.Context) {
+			builder := smcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduledMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, smcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, smcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, smcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smcb *ScheduledMessageCreateBulk) SaveX(ctx context.Context) []*ScheduledMessage {
+	v, err := smcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smcb *ScheduledMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := smcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smcb *ScheduledMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := smcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
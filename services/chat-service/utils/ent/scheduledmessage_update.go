@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/scheduledmessage"
+)
+
+// ScheduledMessageUpdate is the builder for updating ScheduledMessage entities.
+type ScheduledMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledMessageMutation
+}
+
+// Where appends a list predicates to the ScheduledMessageUpdate builder.
+func (smu *ScheduledMessageUpdate) Where(ps ...predicate.ScheduledMessage) *ScheduledMessageUpdate {
+	smu.mutation.Where(ps...)
+	return smu
+}
+
+// SetRoomID sets the "room_id" field.
+func (smu *ScheduledMessageUpdate) SetRoomID(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetRoomID(s)
+	return smu
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableRoomID(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetRoomID(*s)
+	}
+	return smu
+}
+
+// SetUserID sets the "user_id" field.
+func (smu *ScheduledMessageUpdate) SetUserID(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetUserID(s)
+	return smu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableUserID(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetUserID(*s)
+	}
+	return smu
+}
+
+// SetUsername sets the "username" field.
+func (smu *ScheduledMessageUpdate) SetUsername(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetUsername(s)
+	return smu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableUsername(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetUsername(*s)
+	}
+	return smu
+}
+
+// SetContent sets the "content" field.
+func (smu *ScheduledMessageUpdate) SetContent(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetContent(s)
+	return smu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableContent(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetContent(*s)
+	}
+	return smu
+}
+
+// SetSendAt sets the "send_at" field.
+func (smu *ScheduledMessageUpdate) SetSendAt(t time.Time) *ScheduledMessageUpdate {
+	smu.mutation.SetSendAt(t)
+	return smu
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableSendAt(t *time.Time) *ScheduledMessageUpdate {
+	if t != nil {
+		smu.SetSendAt(*t)
+	}
+	return smu
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (smu *ScheduledMessageUpdate) SetTimeZone(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetTimeZone(s)
+	return smu
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableTimeZone(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetTimeZone(*s)
+	}
+	return smu
+}
+
+// SetStatus sets the "status" field.
+func (smu *ScheduledMessageUpdate) SetStatus(s scheduledmessage.Status) *ScheduledMessageUpdate {
+	smu.mutation.SetStatus(s)
+	return smu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableStatus(s *scheduledmessage.Status) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetStatus(*s)
+	}
+	return smu
+}
+
+// SetMessageID sets the "message_id" field.
+func (smu *ScheduledMessageUpdate) SetMessageID(i int) *ScheduledMessageUpdate {
+	smu.mutation.ResetMessageID()
+	smu.mutation.SetMessageID(i)
+	return smu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableMessageID(i *int) *ScheduledMessageUpdate {
+	if i != nil {
+		smu.SetMessageID(*i)
+	}
+	return smu
+}
+
+// AddMessageID adds i to the "message_id" field.
+func (smu *ScheduledMessageUpdate) AddMessageID(i int) *ScheduledMessageUpdate {
+	smu.mutation.AddMessageID(i)
+	return smu
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (smu *ScheduledMessageUpdate) ClearMessageID() *ScheduledMessageUpdate {
+	smu.mutation.ClearMessageID()
+	return smu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (smu *ScheduledMessageUpdate) SetUpdatedAt(t time.Time) *ScheduledMessageUpdate {
+	smu.mutation.SetUpdatedAt(t)
+	return smu
+}
+
+// SetSentAt sets the "sent_at" field.
+func (smu *ScheduledMessageUpdate) SetSentAt(t time.Time) *ScheduledMessageUpdate {
+	smu.mutation.SetSentAt(t)
+	return smu
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableSentAt(t *time.Time) *ScheduledMessageUpdate {
+	if t != nil {
+		smu.SetSentAt(*t)
+	}
+	return smu
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (smu *ScheduledMessageUpdate) ClearSentAt() *ScheduledMessageUpdate {
+	smu.mutation.ClearSentAt()
+	return smu
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (smu *ScheduledMessageUpdate) Mutation() *ScheduledMessageMutation {
+	return smu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (smu *ScheduledMessageUpdate) Save(ctx context.Context) (int, error) {
+	smu.defaults()
+	return withHooks(ctx, smu.sqlSave, smu.mutation, smu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smu *ScheduledMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := smu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (smu *ScheduledMessageUpdate) Exec(ctx context.Context) error {
+	_, err := smu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smu *ScheduledMessageUpdate) ExecX(ctx context.Context) {
+	if err := smu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smu *ScheduledMessageUpdate) defaults() {
+	if _, ok := smu.mutation.UpdatedAt(); !ok {
+		v := scheduledmessage.UpdateDefaultUpdatedAt()
+		smu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smu *ScheduledMessageUpdate) check() error {
+	if v, ok := smu.mutation.RoomID(); ok {
+		if err := scheduledmessage.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.room_id": %w`, err)}
+		}
+	}
+	if v, ok := smu.mutation.UserID(); ok {
+		if err := scheduledmessage.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.user_id": %w`, err)}
+		}
+	}
+	if v, ok := smu.mutation.Username(); ok {
+		if err := scheduledmessage.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.username": %w`, err)}
+		}
+	}
+	if v, ok := smu.mutation.Content(); ok {
+		if err := scheduledmessage.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.content": %w`, err)}
+		}
+	}
+	if v, ok := smu.mutation.Status(); ok {
+		if err := scheduledmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (smu *ScheduledMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := smu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledmessage.Table, scheduledmessage.Columns, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeInt))
+	if ps := smu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := smu.mutation.RoomID(); ok {
+		_spec.SetField(scheduledmessage.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := smu.mutation.UserID(); ok {
+		_spec.SetField(scheduledmessage.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := smu.mutation.Username(); ok {
+		_spec.SetField(scheduledmessage.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := smu.mutation.Content(); ok {
+		_spec.SetField(scheduledmessage.FieldContent, field.TypeString, value)
+	}
+	if value, ok := smu.mutation.SendAt(); ok {
+		_spec.SetField(scheduledmessage.FieldSendAt, field.TypeTime, value)
+	}
+	if value, ok := smu.mutation.TimeZone(); ok {
+		_spec.SetField(scheduledmessage.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := smu.mutation.Status(); ok {
+		_spec.SetField(scheduledmessage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := smu.mutation.MessageID(); ok {
+		_spec.SetField(scheduledmessage.FieldMessageID, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.AddedMessageID(); ok {
+		_spec.AddField(scheduledmessage.FieldMessageID, field.TypeInt, value)
+	}
+	if smu.mutation.MessageIDCleared() {
+		_spec.ClearField(scheduledmessage.FieldMessageID, field.TypeInt)
+	}
+	if value, ok := smu.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := smu.mutation.SentAt(); ok {
+		_spec.SetField(scheduledmessage.FieldSentAt, field.TypeTime, value)
+	}
+	if smu.mutation.SentAtCleared() {
+		_spec.ClearField(scheduledmessage.FieldSentAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, smu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	smu.mutation.done = true
+	return n, nil
+}
+
+// ScheduledMessageUpdateOne is the builder for updating a single ScheduledMessage entity.
+type ScheduledMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduledMessageMutation
+}
+
+// SetRoomID sets the "room_id" field.
+func (smuo *ScheduledMessageUpdateOne) SetRoomID(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetRoomID(s)
+	return smuo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableRoomID(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetRoomID(*s)
+	}
+	return smuo
+}
+
+// SetUserID sets the "user_id" field.
+func (smuo *ScheduledMessageUpdateOne) SetUserID(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetUserID(s)
+	return smuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableUserID(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetUserID(*s)
+	}
+	return smuo
+}
+
+// SetUsername sets the "username" field.
+func (smuo *ScheduledMessageUpdateOne) SetUsername(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetUsername(s)
+	return smuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableUsername(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetUsername(*s)
+	}
+	return smuo
+}
+
+// SetContent sets the "content" field.
+func (smuo *ScheduledMessageUpdateOne) SetContent(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetContent(s)
+	return smuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableContent(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetContent(*s)
+	}
+	return smuo
+}
+
+// SetSendAt sets the "send_at" field.
+func (smuo *ScheduledMessageUpdateOne) SetSendAt(t time.Time) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetSendAt(t)
+	return smuo
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableSendAt(t *time.Time) *ScheduledMessageUpdateOne {
+	if t != nil {
+		smuo.SetSendAt(*t)
+	}
+	return smuo
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (smuo *ScheduledMessageUpdateOne) SetTimeZone(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetTimeZone(s)
+	return smuo
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableTimeZone(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetTimeZone(*s)
+	}
+	return smuo
+}
+
+// SetStatus sets the "status" field.
+func (smuo *ScheduledMessageUpdateOne) SetStatus(s scheduledmessage.Status) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetStatus(s)
+	return smuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableStatus(s *scheduledmessage.Status) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetStatus(*s)
+	}
+	return smuo
+}
+
+// SetMessageID sets the "message_id" field.
+func (smuo *ScheduledMessageUpdateOne) SetMessageID(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.ResetMessageID()
+	smuo.mutation.SetMessageID(i)
+	return smuo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableMessageID(i *int) *ScheduledMessageUpdateOne {
+	if i != nil {
+		smuo.SetMessageID(*i)
+	}
+	return smuo
+}
+
+// AddMessageID adds i to the "message_id" field.
+func (smuo *ScheduledMessageUpdateOne) AddMessageID(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.AddMessageID(i)
+	return smuo
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (smuo *ScheduledMessageUpdateOne) ClearMessageID() *ScheduledMessageUpdateOne {
+	smuo.mutation.ClearMessageID()
+	return smuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (smuo *ScheduledMessageUpdateOne) SetUpdatedAt(t time.Time) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetUpdatedAt(t)
+	return smuo
+}
+
+// SetSentAt sets the "sent_at" field.
+func (smuo *ScheduledMessageUpdateOne) SetSentAt(t time.Time) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetSentAt(t)
+	return smuo
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableSentAt(t *time.Time) *ScheduledMessageUpdateOne {
+	if t != nil {
+		smuo.SetSentAt(*t)
+	}
+	return smuo
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (smuo *ScheduledMessageUpdateOne) ClearSentAt() *ScheduledMessageUpdateOne {
+	smuo.mutation.ClearSentAt()
+	return smuo
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (smuo *ScheduledMessageUpdateOne) Mutation() *ScheduledMessageMutation {
+	return smuo.mutation
+}
+
+// Where appends a list predicates to the ScheduledMessageUpdate builder.
+func (smuo *ScheduledMessageUpdateOne) Where(ps ...predicate.ScheduledMessage) *ScheduledMessageUpdateOne {
+	smuo.mutation.Where(ps...)
+	return smuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (smuo *ScheduledMessageUpdateOne) Select(field string, fields ...string) *ScheduledMessageUpdateOne {
+	smuo.fields = append([]string{field}, fields...)
+	return smuo
+}
+
+// Save executes the query and returns the updated ScheduledMessage entity.
+func (smuo *ScheduledMessageUpdateOne) Save(ctx context.Context) (*ScheduledMessage, error) {
+	smuo.defaults()
+	return withHooks(ctx, smuo.sqlSave, smuo.mutation, smuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smuo *ScheduledMessageUpdateOne) SaveX(ctx context.Context) *ScheduledMessage {
+	node, err := smuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (smuo *ScheduledMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := smuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smuo *ScheduledMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := smuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smuo *ScheduledMessageUpdateOne) defaults() {
+	if _, ok := smuo.mutation.UpdatedAt(); !ok {
+		v := scheduledmessage.UpdateDefaultUpdatedAt()
+		smuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smuo *ScheduledMessageUpdateOne) check() error {
+	if v, ok := smuo.mutation.RoomID(); ok {
+		if err := scheduledmessage.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.room_id": %w`, err)}
+		}
+	}
+	if v, ok := smuo.mutation.UserID(); ok {
+		if err := scheduledmessage.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.user_id": %w`, err)}
+		}
+	}
+	if v, ok := smuo.mutation.Username(); ok {
+		if err := scheduledmessage.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.username": %w`, err)}
+		}
+	}
+	if v, ok := smuo.mutation.Content(); ok {
+		if err := scheduledmessage.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.content": %w`, err)}
+		}
+	}
+	if v, ok := smuo.mutation.Status(); ok {
+		if err := scheduledmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (smuo *ScheduledMessageUpdateOne) sqlSave(ctx context.Context) (_node *ScheduledMessage, err error) {
+	if err := smuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledmessage.Table, scheduledmessage.Columns, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeInt))
+	id, ok := smuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScheduledMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := smuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledmessage.FieldID)
+		for _, f := range fields {
+			if !scheduledmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scheduledmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := smuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := smuo.mutation.RoomID(); ok {
+		_spec.SetField(scheduledmessage.FieldRoomID, field.TypeString, value)
+	}
+	if value, ok := smuo.mutation.UserID(); ok {
+		_spec.SetField(scheduledmessage.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := smuo.mutation.Username(); ok {
+		_spec.SetField(scheduledmessage.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := smuo.mutation.Content(); ok {
+		_spec.SetField(scheduledmessage.FieldContent, field.TypeString, value)
+	}
+	if value, ok := smuo.mutation.SendAt(); ok {
+		_spec.SetField(scheduledmessage.FieldSendAt, field.TypeTime, value)
+	}
+	if value, ok := smuo.mutation.TimeZone(); ok {
+		_spec.SetField(scheduledmessage.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := smuo.mutation.Status(); ok {
+		_spec.SetField(scheduledmessage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := smuo.mutation.MessageID(); ok {
+		_spec.SetField(scheduledmessage.FieldMessageID, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.AddedMessageID(); ok {
+		_spec.AddField(scheduledmessage.FieldMessageID, field.TypeInt, value)
+	}
+	if smuo.mutation.MessageIDCleared() {
+		_spec.ClearField(scheduledmessage.FieldMessageID, field.TypeInt)
+	}
+	if value, ok := smuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := smuo.mutation.SentAt(); ok {
+		_spec.SetField(scheduledmessage.FieldSentAt, field.TypeTime, value)
+	}
+	if smuo.mutation.SentAtCleared() {
+		_spec.ClearField(scheduledmessage.FieldSentAt, field.TypeTime)
+	}
+	_node = &ScheduledMessage{config: smuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, smuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	smuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BotDelivery holds the schema definition for the BotDelivery entity.
+// It is the outbox of bot events: a delivery stays pending until its callback
+// accepts it or it runs out of attempts.
+type BotDelivery struct {
+	ent.Schema
+}
+
+// Fields of the BotDelivery.
+func (BotDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("subscription_id"),
+		field.String("event").
+			NotEmpty(),
+		field.Text("payload"),
+		field.Enum("status").
+			Values("pending", "delivered", "failed").
+			Default("pending"),
+		field.Int("attempts").
+			Default(0),
+		field.Time("next_attempt_at").
+			Default(time.Now),
+		field.String("last_error").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the BotDelivery.
+func (BotDelivery) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the BotDelivery.
+func (BotDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("subscription_id"),
+	}
+}
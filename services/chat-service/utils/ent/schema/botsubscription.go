@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BotSubscription holds the schema definition for the BotSubscription entity.
+// Room events matching the subscription are delivered to its callback URL,
+// signed with its secret.
+type BotSubscription struct {
+	ent.Schema
+}
+
+// Fields of the BotSubscription.
+func (BotSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("bot_id").
+			NotEmpty(),
+		field.String("bot_name").
+			NotEmpty(),
+		field.String("room_id").
+			NotEmpty(),
+		field.String("callback_url").
+			NotEmpty(),
+		field.String("secret").
+			NotEmpty().
+			Sensitive(),
+		field.JSON("events", []string{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the BotSubscription.
+func (BotSubscription) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the BotSubscription.
+func (BotSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("room_id"),
+		index.Fields("bot_id"),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CallParticipant holds the schema definition for the CallParticipant entity.
+// Every time a user joins a call they get a new row, so rejoining keeps the history.
+type CallParticipant struct {
+	ent.Schema
+}
+
+// Fields of the CallParticipant.
+func (CallParticipant) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("call_id"),
+		field.String("user_id").
+			NotEmpty(),
+		field.String("username").
+			NotEmpty(),
+		field.Time("joined_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("left_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the CallParticipant.
+func (CallParticipant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("call", CallSession.Type).
+			Ref("participants").
+			Field("call_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the CallParticipant.
+func (CallParticipant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("call_id", "user_id").
+			Unique().
+			Annotations(entsql.IndexWhere("left_at IS NULL")),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CallSession holds the schema definition for the CallSession entity.
+// A room has at most one call that has not ended.
+type CallSession struct {
+	ent.Schema
+}
+
+// Fields of the CallSession.
+func (CallSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("room_id").
+			NotEmpty(),
+		field.String("started_by").
+			NotEmpty(),
+		field.String("started_by_name").
+			NotEmpty(),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("ended_at").
+			Optional().
+			Nillable(),
+		// updated_at is touched by every join and leave, which serializes them with ending the call.
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the CallSession.
+func (CallSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("participants", CallParticipant.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the CallSession.
+func (CallSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("room_id").
+			Unique().
+			Annotations(entsql.IndexWhere("ended_at IS NULL")),
+		index.Fields("room_id", "started_at"),
+	}
+}
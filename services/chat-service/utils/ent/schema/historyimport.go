@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// HistoryImport holds the schema definition for the HistoryImport entity.
+// It records the progress of an import so that an interrupted one can be resumed.
+type HistoryImport struct {
+	ent.Schema
+}
+
+// UnmappedUser is an author of imported messages that has no user-management account.
+type UnmappedUser struct {
+	Key      string `json:"key"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Messages int    `json:"messages"`
+}
+
+// Fields of the HistoryImport.
+func (HistoryImport) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("format").
+			Values("slack", "json"),
+		field.String("file_path").
+			NotEmpty(),
+		field.String("user_id").
+			Optional(),
+		field.Enum("status").
+			Values("pending", "running", "completed", "failed").
+			Default("pending"),
+		field.Int("rooms_created").
+			Default(0),
+		field.Int("messages_imported").
+			Default(0),
+		field.Int("messages_skipped").
+			Default(0),
+		field.JSON("completed_rooms", []string{}).
+			Optional(),
+		field.JSON("unmapped_users", []UnmappedUser{}).
+			Optional(),
+		field.String("error").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the HistoryImport.
+func (HistoryImport) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the HistoryImport.
+func (HistoryImport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
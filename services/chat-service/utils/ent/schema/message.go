@@ -1,8 +1,13 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Message holds the schema definition for the Message entity.
@@ -10,6 +15,13 @@ type Message struct {
 	ent.Schema
 }
 
+// Attachment is a link attached to a message, such as a build or an alert posted by a webhook.
+type Attachment struct {
+	Title string `json:"title,omitempty"`
+	URL   string `json:"url,omitempty"`
+	Text  string `json:"text,omitempty"`
+}
+
 // Fields of the Message.
 func (Message) Fields() []ent.Field {
 	return []ent.Field{
@@ -19,10 +31,44 @@ func (Message) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("username").
 			NotEmpty(),
+		field.Bool("bot").
+			Default(false),
+		field.JSON("attachments", []Attachment{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("archived_at").
+			Optional().
+			Nillable(),
+		// import_key identifies messages brought in by a history import,
+		// so that running the same import twice does not duplicate them.
+		field.String("import_key").
+			Optional().
+			Nillable().
+			Unique().
+			Immutable(),
 	}
 }
 
 // Edges of the Message.
+// Pins, saves and polls are removed together with the message, whether it is
+// purged by retention or deleted with its room.
 func (Message) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("poll", Poll.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("pins", PinnedMessage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("saves", SavedMessage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Message.
+func (Message) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("room_id", "created_at"),
+	}
 }
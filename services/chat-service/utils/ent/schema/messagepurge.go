@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MessagePurge holds the schema definition for the MessagePurge entity.
+// Every retention run writes one record per processed batch so that
+// removed messages can be accounted for.
+type MessagePurge struct {
+	ent.Schema
+}
+
+// Fields of the MessagePurge.
+func (MessagePurge) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("room_id").
+			NotEmpty(),
+		field.Enum("reason").
+			Values("max_age", "max_messages"),
+		field.Enum("mode").
+			Values("delete", "archive"),
+		field.Bool("dry_run").
+			Default(false),
+		field.Int("message_count").
+			NonNegative(),
+		field.JSON("message_ids", []int{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the MessagePurge.
+func (MessagePurge) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the MessagePurge.
+func (MessagePurge) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("room_id", "created_at"),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PinnedMessage holds the schema definition for the PinnedMessage entity.
+// A message is pinned at most once; the pin goes away with the message.
+type PinnedMessage struct {
+	ent.Schema
+}
+
+// Fields of the PinnedMessage.
+func (PinnedMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("room_id").
+			NotEmpty(),
+		field.Int("message_id").
+			Unique(),
+		field.String("pinned_by").
+			NotEmpty(),
+		field.Time("pinned_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PinnedMessage.
+func (PinnedMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", Message.Type).
+			Ref("pins").
+			Field("message_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PinnedMessage.
+func (PinnedMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("room_id", "pinned_at"),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Poll holds the schema definition for the Poll entity.
+// A poll is posted as a message of its room and goes away with it.
+type Poll struct {
+	ent.Schema
+}
+
+// Fields of the Poll.
+func (Poll) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("room_id").
+			NotEmpty(),
+		field.Int("message_id").
+			Unique(),
+		field.String("question").
+			NotEmpty(),
+		field.JSON("options", []string{}),
+		field.Bool("multiple").
+			Default(false),
+		field.Bool("anonymous").
+			Default(false),
+		field.String("created_by").
+			NotEmpty(),
+		field.Time("closes_at").
+			Optional().
+			Nillable(),
+		field.Time("closed_at").
+			Optional().
+			Nillable(),
+		// summary_message_id is the results message posted when the poll closed.
+		field.Int("summary_message_id").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		// updated_at is touched by every vote, which also serializes votes with closing.
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Poll.
+func (Poll) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", Message.Type).
+			Ref("poll").
+			Field("message_id").
+			Unique().
+			Required(),
+		edge.To("votes", PollVote.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Poll.
+func (Poll) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("closed_at", "closes_at"),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PollVote holds the schema definition for the PollVote entity.
+// A user has one vote per option they chose.
+type PollVote struct {
+	ent.Schema
+}
+
+// Fields of the PollVote.
+func (PollVote) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("poll_id"),
+		field.String("user_id").
+			NotEmpty(),
+		field.String("username").
+			NotEmpty(),
+		field.Int("option").
+			NonNegative(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PollVote.
+func (PollVote) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("poll", Poll.Type).
+			Ref("votes").
+			Field("poll_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PollVote.
+func (PollVote) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("poll_id", "user_id", "option").
+			Unique(),
+	}
+}
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 )
@@ -15,6 +17,26 @@ func (Room) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").
 			NotEmpty(),
+		// A nil retention value falls back to the global default,
+		// zero keeps messages forever.
+		field.Int64("retention_max_age").
+			GoType(time.Duration(0)).
+			Optional().
+			Nillable(),
+		field.Int("retention_max_messages").
+			Optional().
+			Nillable().
+			NonNegative(),
+		// owner_id is the user that created the room, if it was created by an authenticated user.
+		field.String("owner_id").
+			Optional().
+			Nillable(),
+		// import_key identifies rooms created by a history import.
+		field.String("import_key").
+			Optional().
+			Nillable().
+			Unique().
+			Immutable(),
 	}
 }
 
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RoomExport holds the schema definition for the RoomExport entity.
+// It tracks asynchronous transcript exports, whose transcripts are stored as
+// RoomExportChunks. A running export is leased to a worker until lease_until and is
+// claimed again when the worker stops renewing the lease.
+type RoomExport struct {
+	ent.Schema
+}
+
+// Fields of the RoomExport.
+func (RoomExport) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("room_id").
+			NotEmpty(),
+		field.String("user_id").
+			NotEmpty(),
+		field.Enum("format").
+			Values("jsonl", "csv", "html"),
+		field.Time("from").
+			Optional().
+			Nillable(),
+		field.Time("to").
+			Optional().
+			Nillable(),
+		field.Enum("status").
+			Values("pending", "running", "completed", "failed").
+			Default("pending"),
+		field.String("error").
+			Optional(),
+		field.Int("message_count").
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+		field.Time("lease_until").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the RoomExport.
+func (RoomExport) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the RoomExport.
+func (RoomExport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RoomExportChunk holds the schema definition for the RoomExportChunk entity.
+// The transcript of an asynchronous export is stored in numbered chunks, so that
+// any replica can serve its download.
+type RoomExportChunk struct {
+	ent.Schema
+}
+
+// Fields of the RoomExportChunk.
+func (RoomExportChunk) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("export_id"),
+		field.Int("seq"),
+		field.Bytes("data"),
+	}
+}
+
+// Edges of the RoomExportChunk.
+func (RoomExportChunk) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the RoomExportChunk.
+func (RoomExportChunk) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("export_id", "seq").
+			Unique(),
+	}
+}
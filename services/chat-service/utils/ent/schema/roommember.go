@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RoomMember holds the schema definition for the RoomMember entity.
+// A user becomes a member of a room the first time they join it.
+type RoomMember struct {
+	ent.Schema
+}
+
+// Fields of the RoomMember.
+func (RoomMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("room_id").
+			NotEmpty(),
+		field.String("user_id").
+			NotEmpty(),
+		field.String("username").
+			NotEmpty(),
+		field.Time("joined_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the RoomMember.
+func (RoomMember) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the RoomMember.
+func (RoomMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("room_id", "user_id").
+			Unique(),
+	}
+}
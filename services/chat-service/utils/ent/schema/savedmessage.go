@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SavedMessage holds the schema definition for the SavedMessage entity.
+// It is a private bookmark of a user on a message of any room.
+type SavedMessage struct {
+	ent.Schema
+}
+
+// Fields of the SavedMessage.
+func (SavedMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").
+			NotEmpty(),
+		field.Int("message_id"),
+		field.Time("saved_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the SavedMessage.
+func (SavedMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", Message.Type).
+			Ref("saves").
+			Field("message_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the SavedMessage.
+func (SavedMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "message_id").
+			Unique(),
+	}
+}
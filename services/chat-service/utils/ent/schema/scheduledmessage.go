@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ScheduledMessage holds the schema definition for the ScheduledMessage entity.
+// A scheduled message stays pending until it is due; the replica that moves it to
+// sent in the same transaction that creates the message is the one that posts it.
+type ScheduledMessage struct {
+	ent.Schema
+}
+
+// Fields of the ScheduledMessage.
+func (ScheduledMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("room_id").
+			NotEmpty(),
+		field.String("user_id").
+			NotEmpty(),
+		field.String("username").
+			NotEmpty(),
+		field.String("content").
+			NotEmpty(),
+		field.Time("send_at"),
+		// time_zone is the IANA zone the author scheduled the message in, kept for display.
+		field.String("time_zone").
+			Default("UTC"),
+		field.Enum("status").
+			Values("pending", "sent", "canceled").
+			Default("pending"),
+		field.Int("message_id").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("sent_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the ScheduledMessage.
+func (ScheduledMessage) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ScheduledMessage.
+func (ScheduledMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "send_at"),
+		index.Fields("user_id"),
+	}
+}
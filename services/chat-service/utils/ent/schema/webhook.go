@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+// An incoming webhook posts messages into its room. Only the SHA-256
+// hash of its token is stored.
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("room_id").
+			NotEmpty().
+			Immutable(),
+		field.String("name").
+			NotEmpty(),
+		field.String("created_by").
+			NotEmpty().
+			Immutable(),
+		field.String("token_hash").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Sensitive(),
+		field.Bool("is_revoked").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Webhook.
+func (Webhook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("room_id"),
+	}
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,10 +14,40 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// BotDelivery is the client for interacting with the BotDelivery builders.
+	BotDelivery *BotDeliveryClient
+	// BotSubscription is the client for interacting with the BotSubscription builders.
+	BotSubscription *BotSubscriptionClient
+	// CallParticipant is the client for interacting with the CallParticipant builders.
+	CallParticipant *CallParticipantClient
+	// CallSession is the client for interacting with the CallSession builders.
+	CallSession *CallSessionClient
+	// HistoryImport is the client for interacting with the HistoryImport builders.
+	HistoryImport *HistoryImportClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// MessagePurge is the client for interacting with the MessagePurge builders.
+	MessagePurge *MessagePurgeClient
+	// PinnedMessage is the client for interacting with the PinnedMessage builders.
+	PinnedMessage *PinnedMessageClient
+	// Poll is the client for interacting with the Poll builders.
+	Poll *PollClient
+	// PollVote is the client for interacting with the PollVote builders.
+	PollVote *PollVoteClient
 	// Room is the client for interacting with the Room builders.
 	Room *RoomClient
+	// RoomExport is the client for interacting with the RoomExport builders.
+	RoomExport *RoomExportClient
+	// RoomExportChunk is the client for interacting with the RoomExportChunk builders.
+	RoomExportChunk *RoomExportChunkClient
+	// RoomMember is the client for interacting with the RoomMember builders.
+	RoomMember *RoomMemberClient
+	// SavedMessage is the client for interacting with the SavedMessage builders.
+	SavedMessage *SavedMessageClient
+	// ScheduledMessage is the client for interacting with the ScheduledMessage builders.
+	ScheduledMessage *ScheduledMessageClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
 
 	// lazily loaded.
 	client     *Client
@@ -147,8 +179,23 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.BotDelivery = NewBotDeliveryClient(tx.config)
+	tx.BotSubscription = NewBotSubscriptionClient(tx.config)
+	tx.CallParticipant = NewCallParticipantClient(tx.config)
+	tx.CallSession = NewCallSessionClient(tx.config)
+	tx.HistoryImport = NewHistoryImportClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
+	tx.MessagePurge = NewMessagePurgeClient(tx.config)
+	tx.PinnedMessage = NewPinnedMessageClient(tx.config)
+	tx.Poll = NewPollClient(tx.config)
+	tx.PollVote = NewPollVoteClient(tx.config)
 	tx.Room = NewRoomClient(tx.config)
+	tx.RoomExport = NewRoomExportClient(tx.config)
+	tx.RoomExportChunk = NewRoomExportChunkClient(tx.config)
+	tx.RoomMember = NewRoomMemberClient(tx.config)
+	tx.SavedMessage = NewSavedMessageClient(tx.config)
+	tx.ScheduledMessage = NewScheduledMessageClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -158,7 +205,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Message.QueryXXX(), the query will be executed
+// applies a query, for example: BotDelivery.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -211,3 +258,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/webhook"
+)
+
+// WebhookCreate is the builder for creating a Webhook entity.
+type WebhookCreate struct {
+	config
+	mutation *WebhookMutation
+	hooks    []Hook
+}
+
+// SetRoomID sets the "room_id" field.
+func (wc *WebhookCreate) SetRoomID(s string) *WebhookCreate {
+	wc.mutation.SetRoomID(s)
+	return wc
+}
+
+// SetName sets the "name" field.
+func (wc *WebhookCreate) SetName(s string) *WebhookCreate {
+	wc.mutation.SetName(s)
+	return wc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (wc *WebhookCreate) SetCreatedBy(s string) *WebhookCreate {
+	wc.mutation.SetCreatedBy(s)
+	return wc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (wc *WebhookCreate) SetTokenHash(s string) *WebhookCreate {
+	wc.mutation.SetTokenHash(s)
+	return wc
+}
+
+// SetIsRevoked sets the "is_revoked" field.
+func (wc *WebhookCreate) SetIsRevoked(b bool) *WebhookCreate {
+	wc.mutation.SetIsRevoked(b)
+	return wc
+}
+
+// SetNillableIsRevoked sets the "is_revoked" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableIsRevoked(b *bool) *WebhookCreate {
+	if b != nil {
+		wc.SetIsRevoked(*b)
+	}
+	return wc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wc *WebhookCreate) SetCreatedAt(t time.Time) *WebhookCreate {
+	wc.mutation.SetCreatedAt(t)
+	return wc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableCreatedAt(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetCreatedAt(*t)
+	}
+	return wc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (wc *WebhookCreate) SetLastUsedAt(t time.Time) *WebhookCreate {
+	wc.mutation.SetLastUsedAt(t)
+	return wc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableLastUsedAt(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetLastUsedAt(*t)
+	}
+	return wc
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wc *WebhookCreate) Mutation() *WebhookMutation {
+	return wc.mutation
+}
+
+// Save creates the Webhook in the database.
+func (wc *WebhookCreate) Save(ctx context.Context) (*Webhook, error) {
+	wc.defaults()
+	return withHooks(ctx, wc.sqlSave, wc.mutation, wc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WebhookCreate) SaveX(ctx context.Context) *Webhook {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wc *WebhookCreate) Exec(ctx context.Context) error {
+	_, err := wc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wc *WebhookCreate) ExecX(ctx context.Context) {
+	if err := wc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wc *WebhookCreate) defaults() {
+	if _, ok := wc.mutation.IsRevoked(); !ok {
+		v := webhook.DefaultIsRevoked
+		wc.mutation.SetIsRevoked(v)
+	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		v := webhook.DefaultCreatedAt()
+		wc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wc *WebhookCreate) check() error {
+	if _, ok := wc.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room_id", err: errors.New(`ent: missing required field "Webhook.room_id"`)}
+	}
+	if v, ok := wc.mutation.RoomID(); ok {
+		if err := webhook.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "Webhook.room_id": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Webhook.name"`)}
+	}
+	if v, ok := wc.mutation.Name(); ok {
+		if err := webhook.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Webhook.name": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Webhook.created_by"`)}
+	}
+	if v, ok := wc.mutation.CreatedBy(); ok {
+		if err := webhook.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Webhook.created_by": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "Webhook.token_hash"`)}
+	}
+	if v, ok := wc.mutation.TokenHash(); ok {
+		if err := webhook.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "Webhook.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.IsRevoked(); !ok {
+		return &ValidationError{Name: "is_revoked", err: errors.New(`ent: missing required field "Webhook.is_revoked"`)}
+	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Webhook.created_at"`)}
+	}
+	return nil
+}
+
+func (wc *WebhookCreate) sqlSave(ctx context.Context) (*Webhook, error) {
+	if err := wc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wc.mutation.id = &_node.ID
+	wc.mutation.done = true
+	return _node, nil
+}
+
+func (wc *WebhookCreate) createSpec() (*Webhook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Webhook{config: wc.config}
+		_spec = sqlgraph.NewCreateSpec(webhook.Table, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt))
+	)
+	if value, ok := wc.mutation.RoomID(); ok {
+		_spec.SetField(webhook.FieldRoomID, field.TypeString, value)
+		_node.RoomID = value
+	}
+	if value, ok := wc.mutation.Name(); ok {
+		_spec.SetField(webhook.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := wc.mutation.CreatedBy(); ok {
+		_spec.SetField(webhook.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := wc.mutation.TokenHash(); ok {
+		_spec.SetField(webhook.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := wc.mutation.IsRevoked(); ok {
+		_spec.SetField(webhook.FieldIsRevoked, field.TypeBool, value)
+		_node.IsRevoked = value
+	}
+	if value, ok := wc.mutation.CreatedAt(); ok {
+		_spec.SetField(webhook.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wc.mutation.LastUsedAt(); ok {
+		_spec.SetField(webhook.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	return _node, _spec
+}
+
+// WebhookCreateBulk is the builder for creating many Webhook entities in bulk.
+type WebhookCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookCreate
+}
+
+// Save creates the Webhook entities in the database.
+func (wcb *WebhookCreateBulk) Save(ctx context.Context) ([]*Webhook, error) {
+	if wcb.err != nil {
+		return nil, wcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wcb.builders))
+	nodes := make([]*Webhook, len(wcb.builders))
+	mutators := make([]Mutator, len(wcb.builders))
+	for i := range wcb.builders {
+		func(i int, root context.Context) {
+			builder := wcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcb *WebhookCreateBulk) SaveX(ctx context.Context) []*Webhook {
+	v, err := wcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcb *WebhookCreateBulk) Exec(ctx context.Context) error {
+	_, err := wcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcb *WebhookCreateBulk) ExecX(ctx context.Context) {
+	if err := wcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
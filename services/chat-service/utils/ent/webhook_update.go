@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/predicate"
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/utils/ent/webhook"
+)
+
+// WebhookUpdate is the builder for updating Webhook entities.
+type WebhookUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookMutation
+}
+
+// Where appends a list predicates to the WebhookUpdate builder.
+func (wu *WebhookUpdate) Where(ps ...predicate.Webhook) *WebhookUpdate {
+	wu.mutation.Where(ps...)
+	return wu
+}
+
+// SetName sets the "name" field.
+func (wu *WebhookUpdate) SetName(s string) *WebhookUpdate {
+	wu.mutation.SetName(s)
+	return wu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableName(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetName(*s)
+	}
+	return wu
+}
+
+// SetIsRevoked sets the "is_revoked" field.
+func (wu *WebhookUpdate) SetIsRevoked(b bool) *WebhookUpdate {
+	wu.mutation.SetIsRevoked(b)
+	return wu
+}
+
+// SetNillableIsRevoked sets the "is_revoked" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableIsRevoked(b *bool) *WebhookUpdate {
+	if b != nil {
+		wu.SetIsRevoked(*b)
+	}
+	return wu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (wu *WebhookUpdate) SetLastUsedAt(t time.Time) *WebhookUpdate {
+	wu.mutation.SetLastUsedAt(t)
+	return wu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableLastUsedAt(t *time.Time) *WebhookUpdate {
+	if t != nil {
+		wu.SetLastUsedAt(*t)
+	}
+	return wu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (wu *WebhookUpdate) ClearLastUsedAt() *WebhookUpdate {
+	wu.mutation.ClearLastUsedAt()
+	return wu
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wu *WebhookUpdate) Mutation() *WebhookMutation {
+	return wu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WebhookUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WebhookUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WebhookUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WebhookUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wu *WebhookUpdate) check() error {
+	if v, ok := wu.mutation.Name(); ok {
+		if err := webhook.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Webhook.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wu *WebhookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhook.Table, webhook.Columns, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt))
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.Name(); ok {
+		_spec.SetField(webhook.FieldName, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.IsRevoked(); ok {
+		_spec.SetField(webhook.FieldIsRevoked, field.TypeBool, value)
+	}
+	if value, ok := wu.mutation.LastUsedAt(); ok {
+		_spec.SetField(webhook.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if wu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(webhook.FieldLastUsedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wu.mutation.done = true
+	return n, nil
+}
+
+// WebhookUpdateOne is the builder for updating a single Webhook entity.
+type WebhookUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookMutation
+}
+
+// SetName sets the "name" field.
+func (wuo *WebhookUpdateOne) SetName(s string) *WebhookUpdateOne {
+	wuo.mutation.SetName(s)
+	return wuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableName(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetName(*s)
+	}
+	return wuo
+}
+
+// SetIsRevoked sets the "is_revoked" field.
+func (wuo *WebhookUpdateOne) SetIsRevoked(b bool) *WebhookUpdateOne {
+	wuo.mutation.SetIsRevoked(b)
+	return wuo
+}
+
+// SetNillableIsRevoked sets the "is_revoked" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableIsRevoked(b *bool) *WebhookUpdateOne {
+	if b != nil {
+		wuo.SetIsRevoked(*b)
+	}
+	return wuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (wuo *WebhookUpdateOne) SetLastUsedAt(t time.Time) *WebhookUpdateOne {
+	wuo.mutation.SetLastUsedAt(t)
+	return wuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableLastUsedAt(t *time.Time) *WebhookUpdateOne {
+	if t != nil {
+		wuo.SetLastUsedAt(*t)
+	}
+	return wuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (wuo *WebhookUpdateOne) ClearLastUsedAt() *WebhookUpdateOne {
+	wuo.mutation.ClearLastUsedAt()
+	return wuo
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wuo *WebhookUpdateOne) Mutation() *WebhookMutation {
+	return wuo.mutation
+}
+
+// Where appends a list predicates to the WebhookUpdate builder.
+func (wuo *WebhookUpdateOne) Where(ps ...predicate.Webhook) *WebhookUpdateOne {
+	wuo.mutation.Where(ps...)
+	return wuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuo *WebhookUpdateOne) Select(field string, fields ...string) *WebhookUpdateOne {
+	wuo.fields = append([]string{field}, fields...)
+	return wuo
+}
+
+// Save executes the query and returns the updated Webhook entity.
+func (wuo *WebhookUpdateOne) Save(ctx context.Context) (*Webhook, error) {
+	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) SaveX(ctx context.Context) *Webhook {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WebhookUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wuo *WebhookUpdateOne) check() error {
+	if v, ok := wuo.mutation.Name(); ok {
+		if err := webhook.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Webhook.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wuo *WebhookUpdateOne) sqlSave(ctx context.Context) (_node *Webhook, err error) {
+	if err := wuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhook.Table, webhook.Columns, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt))
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Webhook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhook.FieldID)
+		for _, f := range fields {
+			if !webhook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.Name(); ok {
+		_spec.SetField(webhook.FieldName, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.IsRevoked(); ok {
+		_spec.SetField(webhook.FieldIsRevoked, field.TypeBool, value)
+	}
+	if value, ok := wuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(webhook.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if wuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(webhook.FieldLastUsedAt, field.TypeTime)
+	}
+	_node = &Webhook{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wuo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,7 @@ var (
 	ErrorInternal        = New("Internal Server Error")
 	ErrorConflict        = New("Conflict")
 	ErrorForbidden       = New("Forbidden")
+	ErrorTooManyRequests = New("Too Many Requests")
 	ErrorUpgradeRequired = New("WebSocket upgrade required")
 )
 
@@ -39,3 +40,9 @@ func (e Error) Error() string {
 func New(err string) error {
 	return errors.New(err)
 }
+
+// IsSvcError reports whether err is an Error of the given service error kind.
+func IsSvcError(err error, svcError error) bool {
+	var e Error
+	return errors.As(err, &e) && e.svcError == svcError
+}
@@ -43,6 +43,9 @@ func GRPCFromError(err error) GRPCError {
 		case ErrorConflict:
 			grpcError.Code = codes.AlreadyExists
 			grpcError.Status = http.StatusConflict
+		case ErrorTooManyRequests:
+			grpcError.Code = codes.ResourceExhausted
+			grpcError.Status = http.StatusTooManyRequests
 		case ErrorInternal:
 			grpcError.Code = codes.Internal
 			grpcError.Status = http.StatusInternalServerError
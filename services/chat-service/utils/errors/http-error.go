@@ -28,6 +28,8 @@ func HTTPFromError(err error) APIError {
 			apiError.Status = http.StatusNotFound
 		case ErrorConflict:
 			apiError.Status = http.StatusConflict
+		case ErrorTooManyRequests:
+			apiError.Status = http.StatusTooManyRequests
 		case ErrorInternal:
 			apiError.Status = http.StatusInternalServerError
 		}
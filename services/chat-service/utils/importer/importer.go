@@ -0,0 +1,107 @@
+// Package importer reads chat history exported from other chat systems.
+//
+// Two formats are supported: Slack workspace export archives (zip) and a
+// generic JSON document shaped like
+//
+//	{
+//	  "rooms": [
+//	    {
+//	      "id": "general",
+//	      "name": "General",
+//	      "messages": [
+//	        {
+//	          "id": "1",
+//	          "author": {"username": "alice", "email": "alice@example.com"},
+//	          "text": "Hello",
+//	          "timestamp": "2024-01-02T15:04:05Z"
+//	        }
+//	      ]
+//	    }
+//	  ]
+//	}
+//
+// Room and message ids only need to be unique within the document; they are
+// used to recognize rooms and messages that were already imported.
+package importer
+
+import (
+	"fmt"
+	"time"
+)
+
+// Format is the layout of an export file.
+type Format string
+
+const (
+	FormatSlack Format = "slack"
+	FormatJSON  Format = "json"
+)
+
+// Author is the author of an exported message as known to the source system.
+type Author struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+}
+
+// Key identifies the author within the export.
+func (a Author) Key() string {
+	switch {
+	case a.ID != "":
+		return a.ID
+	case a.Email != "":
+		return a.Email
+	default:
+		return a.Username
+	}
+}
+
+// DisplayName returns the best human readable name of the author.
+func (a Author) DisplayName() string {
+	switch {
+	case a.Username != "":
+		return a.Username
+	case a.Name != "":
+		return a.Name
+	case a.Email != "":
+		return a.Email
+	default:
+		return a.ID
+	}
+}
+
+// Room is an exported room or channel.
+type Room struct {
+	Key  string
+	Name string
+}
+
+// Message is an exported message.
+type Message struct {
+	Key       string
+	Author    Author
+	Text      string
+	CreatedAt time.Time
+}
+
+// Archive gives access to the rooms and messages of an export.
+type Archive interface {
+	// Rooms returns the exported rooms in a stable order.
+	Rooms() []Room
+	// Messages returns the messages of a room ordered by time.
+	Messages(room Room) ([]Message, error)
+	Close() error
+}
+
+// Open opens the export file at path.
+func Open(format Format, path string) (Archive, error) {
+	switch format {
+	case FormatSlack:
+		return openSlack(path)
+	case FormatJSON:
+		return openJSON(path)
+	default:
+		return nil, fmt.Errorf("unsupported import format: %s", format)
+	}
+}
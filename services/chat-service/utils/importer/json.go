@@ -0,0 +1,93 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+)
+
+type jsonExport struct {
+	Rooms []jsonRoom `json:"rooms"`
+}
+
+type jsonRoom struct {
+	ID       string        `json:"id"`
+	Name     string        `json:"name"`
+	Messages []jsonMessage `json:"messages"`
+}
+
+type jsonMessage struct {
+	ID        string    `json:"id"`
+	Author    Author    `json:"author"`
+	Text      string    `json:"text"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type jsonArchive struct {
+	rooms    []Room
+	messages map[string][]Message
+}
+
+func openJSON(path string) (*jsonArchive, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var export jsonExport
+	if err := json.NewDecoder(file).Decode(&export); err != nil {
+		return nil, fmt.Errorf("invalid JSON export: %w", err)
+	}
+
+	archive := &jsonArchive{
+		messages: make(map[string][]Message),
+	}
+	for _, room := range export.Rooms {
+		if room.ID == "" {
+			return nil, fmt.Errorf("invalid JSON export: room without id")
+		}
+		if _, ok := archive.messages[room.ID]; ok {
+			return nil, fmt.Errorf("invalid JSON export: duplicate room id %q", room.ID)
+		}
+
+		name := room.Name
+		if name == "" {
+			name = room.ID
+		}
+		archive.rooms = append(archive.rooms, Room{Key: room.ID, Name: name})
+
+		messages := make([]Message, 0, len(room.Messages))
+		for _, message := range room.Messages {
+			if message.ID == "" {
+				return nil, fmt.Errorf("invalid JSON export: message without id in room %q", room.ID)
+			}
+			messages = append(messages, Message{
+				Key:       message.ID,
+				Author:    message.Author,
+				Text:      message.Text,
+				CreatedAt: message.Timestamp,
+			})
+		}
+		sort.SliceStable(messages, func(i, j int) bool {
+			return messages[i].CreatedAt.Before(messages[j].CreatedAt)
+		})
+		archive.messages[room.ID] = messages
+	}
+
+	return archive, nil
+}
+
+func (a *jsonArchive) Rooms() []Room {
+	return a.rooms
+}
+
+func (a *jsonArchive) Messages(room Room) ([]Message, error) {
+	return a.messages[room.Key], nil
+}
+
+func (a *jsonArchive) Close() error {
+	return nil
+}
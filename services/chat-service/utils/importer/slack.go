@@ -0,0 +1,216 @@
+package importer
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type slackUser struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Profile struct {
+		RealName    string `json:"real_name"`
+		DisplayName string `json:"display_name"`
+		Email       string `json:"email"`
+	} `json:"profile"`
+}
+
+type slackChannel struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type slackMessage struct {
+	Type     string `json:"type"`
+	Subtype  string `json:"subtype"`
+	User     string `json:"user"`
+	Username string `json:"username"`
+	BotID    string `json:"bot_id"`
+	Text     string `json:"text"`
+	Ts       string `json:"ts"`
+}
+
+// slackSkippedSubtypes are channel events that are not part of the conversation.
+var slackSkippedSubtypes = map[string]bool{
+	"channel_join":    true,
+	"channel_leave":   true,
+	"channel_topic":   true,
+	"channel_purpose": true,
+	"channel_name":    true,
+	"channel_archive": true,
+	"group_join":      true,
+	"group_leave":     true,
+}
+
+type slackArchive struct {
+	reader *zip.ReadCloser
+	users  map[string]Author
+	rooms  []Room
+	files  map[string][]*zip.File
+}
+
+func openSlack(filePath string) (*slackArchive, error) {
+	reader, err := zip.OpenReader(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Slack export: %w", err)
+	}
+
+	archive := &slackArchive{
+		reader: reader,
+		users:  make(map[string]Author),
+		files:  make(map[string][]*zip.File),
+	}
+	if err := archive.load(); err != nil {
+		reader.Close()
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+func (a *slackArchive) load() error {
+	var users []slackUser
+	if err := a.readJSON("users.json", &users); err != nil {
+		return err
+	}
+	for _, user := range users {
+		a.users[user.ID] = Author{
+			ID:       user.ID,
+			Username: user.Name,
+			Email:    user.Profile.Email,
+			Name:     user.Profile.RealName,
+		}
+	}
+
+	var channels []slackChannel
+	if err := a.readJSON("channels.json", &channels); err != nil {
+		return err
+	}
+
+	// Messages are stored per channel as one file per day, e.g. general/2024-01-02.json
+	byName := make(map[string][]*zip.File)
+	for _, file := range a.reader.File {
+		dir, name := path.Split(file.Name)
+		if dir == "" || path.Ext(name) != ".json" {
+			continue
+		}
+		channel := strings.TrimSuffix(dir, "/")
+		byName[channel] = append(byName[channel], file)
+	}
+
+	for _, channel := range channels {
+		files := byName[channel.Name]
+		sort.Slice(files, func(i, j int) bool {
+			return files[i].Name < files[j].Name
+		})
+		a.rooms = append(a.rooms, Room{Key: channel.ID, Name: channel.Name})
+		a.files[channel.ID] = files
+	}
+
+	return nil
+}
+
+func (a *slackArchive) Rooms() []Room {
+	return a.rooms
+}
+
+func (a *slackArchive) Messages(room Room) ([]Message, error) {
+	var messages []Message
+	for _, file := range a.files[room.Key] {
+		var day []slackMessage
+		if err := a.decode(file, &day); err != nil {
+			return nil, err
+		}
+
+		for _, message := range day {
+			if message.Type != "message" || slackSkippedSubtypes[message.Subtype] || message.Text == "" {
+				continue
+			}
+
+			createdAt, err := parseSlackTs(message.Ts)
+			if err != nil {
+				return nil, fmt.Errorf("invalid Slack export: %s: %w", file.Name, err)
+			}
+
+			messages = append(messages, Message{
+				Key:       message.Ts,
+				Author:    a.author(message),
+				Text:      message.Text,
+				CreatedAt: createdAt,
+			})
+		}
+	}
+
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].CreatedAt.Before(messages[j].CreatedAt)
+	})
+
+	return messages, nil
+}
+
+func (a *slackArchive) Close() error {
+	return a.reader.Close()
+}
+
+func (a *slackArchive) author(message slackMessage) Author {
+	if author, ok := a.users[message.User]; ok {
+		return author
+	}
+	if message.User != "" {
+		return Author{ID: message.User, Username: message.Username}
+	}
+	// Bot and integration messages only carry a display name
+	return Author{ID: message.BotID, Username: message.Username}
+}
+
+func (a *slackArchive) readJSON(name string, v any) error {
+	for _, file := range a.reader.File {
+		if file.Name == name {
+			return a.decode(file, v)
+		}
+	}
+	return fmt.Errorf("invalid Slack export: %s is missing", name)
+}
+
+func (a *slackArchive) decode(file *zip.File, v any) error {
+	rc, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("invalid Slack export: %s: %w", file.Name, err)
+	}
+	return nil
+}
+
+// parseSlackTs converts a Slack message timestamp such as "1700000000.000200" to a time.
+func parseSlackTs(ts string) (time.Time, error) {
+	seconds, fraction, _ := strings.Cut(ts, ".")
+	sec, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %q", ts)
+	}
+
+	var usec int64
+	if fraction != "" {
+		usec, err = strconv.ParseInt((fraction + "000000")[:6], 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid timestamp %q", ts)
+		}
+	}
+
+	return time.Unix(sec, usec*int64(time.Microsecond)).UTC(), nil
+}
@@ -0,0 +1,59 @@
+// Package ratelimit implements a fixed window rate limiter on top of Redis,
+// so that the limits hold across every replica of the service.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Result is the outcome of a rate limited request.
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+type Limiter struct {
+	client *redis.Client
+	prefix string
+}
+
+func NewLimiter(client *redis.Client, prefix string) *Limiter {
+	return &Limiter{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+// Allow counts a request for key and reports whether it stays within limit requests per window.
+func (l *Limiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (Result, error) {
+	now := time.Now()
+	windowStart := now.Truncate(window)
+	redisKey := fmt.Sprintf("%s:%s:%d", l.prefix, key, windowStart.Unix())
+
+	pipe := l.client.TxPipeline()
+	incr := pipe.Incr(ctx, redisKey)
+	pipe.Expire(ctx, redisKey, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return Result{}, err
+	}
+
+	count := int(incr.Val())
+	res := Result{
+		Allowed:   count <= limit,
+		Limit:     limit,
+		Remaining: limit - count,
+	}
+	if res.Remaining < 0 {
+		res.Remaining = 0
+	}
+	if !res.Allowed {
+		res.RetryAfter = windowStart.Add(window).Sub(now)
+	}
+	return res, nil
+}
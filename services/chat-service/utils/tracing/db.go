@@ -0,0 +1,137 @@
+package tracing
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentDriver starts a client span for every statement that goes through the
+// ent driver and one for every transaction, from its start until it is committed
+// or rolled back.
+func InstrumentDriver(drv dialect.Driver) dialect.Driver {
+	return &driver{Driver: drv}
+}
+
+type driver struct {
+	dialect.Driver
+}
+
+func (d *driver) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := startQuery(ctx, query)
+	err := d.Driver.Exec(ctx, query, args, v)
+	end(span, err)
+	return err
+}
+
+func (d *driver) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := startQuery(ctx, query)
+	err := d.Driver.Query(ctx, query, args, v)
+	end(span, err)
+	return err
+}
+
+// ExecContext is used by the ent client for raw statements, such as the health check.
+func (d *driver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	drv, ok := d.Driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, errors.New("tracing: driver does not support raw statements")
+	}
+
+	ctx, span := startQuery(ctx, query)
+	res, err := drv.ExecContext(ctx, query, args...)
+	end(span, err)
+	return res, err
+}
+
+func (d *driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+// BeginTx is used by ent for transactions with options.
+func (d *driver) BeginTx(ctx context.Context, opts *stdsql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *stdsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, errors.New("tracing: driver does not support transaction options")
+	}
+
+	ctx, span := tracer().Start(ctx, "transaction",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL),
+	)
+	t, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		end(span, err)
+		return nil, err
+	}
+	return &tx{Tx: t, span: span}, nil
+}
+
+type tx struct {
+	dialect.Tx
+	span trace.Span
+}
+
+func (t *tx) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := startQuery(ctx, query)
+	err := t.Tx.Exec(ctx, query, args, v)
+	end(span, err)
+	return err
+}
+
+func (t *tx) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := startQuery(ctx, query)
+	err := t.Tx.Query(ctx, query, args, v)
+	end(span, err)
+	return err
+}
+
+func (t *tx) Commit() error {
+	err := t.Tx.Commit()
+	t.span.AddEvent("commit")
+	end(t.span, err)
+	return err
+}
+
+func (t *tx) Rollback() error {
+	err := t.Tx.Rollback()
+	t.span.AddEvent("rollback")
+	end(t.span, err)
+	return err
+}
+
+func startQuery(ctx context.Context, query string) (context.Context, trace.Span) {
+	operation := operation(query)
+	return tracer().Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(query),
+		),
+	)
+}
+
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// operation returns the first keyword of a statement, e.g. SELECT.
+func operation(query string) string {
+	keyword, _, _ := strings.Cut(strings.TrimSpace(query), " ")
+	return strings.ToUpper(keyword)
+}
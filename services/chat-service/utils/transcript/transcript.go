@@ -0,0 +1,165 @@
+package transcript
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Format is the encoding of a transcript.
+type Format string
+
+const (
+	FormatJSONL Format = "jsonl"
+	FormatCSV   Format = "csv"
+	FormatHTML  Format = "html"
+)
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatHTML:
+		return "text/html; charset=utf-8"
+	default:
+		return "application/x-ndjson"
+	}
+}
+
+// Header describes the room a transcript is generated for.
+type Header struct {
+	RoomID      string
+	RoomName    string
+	From        *time.Time
+	To          *time.Time
+	GeneratedAt time.Time
+}
+
+// Message is a single transcript line.
+type Message struct {
+	ID        int       `json:"id"`
+	RoomID    string    `json:"roomId"`
+	Username  string    `json:"username"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Text returns the message content as displayed in the chat, which wraps it in a JSON object.
+func (m Message) Text() string {
+	var wrapped struct {
+		Content *string `json:"content"`
+	}
+	if err := json.Unmarshal([]byte(m.Content), &wrapped); err == nil && wrapped.Content != nil {
+		return *wrapped.Content
+	}
+	return m.Content
+}
+
+// Writer encodes messages to an underlying writer. Close must be called to flush the transcript.
+type Writer interface {
+	WriteMessage(msg Message) error
+	Close() error
+}
+
+// NewWriter creates a Writer for the given format. The views are only used for HTML transcripts.
+func NewWriter(format Format, w io.Writer, views fiber.Views, header Header) (Writer, error) {
+	switch format {
+	case FormatJSONL:
+		return &jsonlWriter{enc: json.NewEncoder(w)}, nil
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatHTML:
+		return newHTMLWriter(w, views, header), nil
+	default:
+		return nil, fmt.Errorf("unsupported transcript format: %s", format)
+	}
+}
+
+type jsonlWriter struct {
+	enc *json.Encoder
+}
+
+func (j *jsonlWriter) WriteMessage(msg Message) error {
+	return j.enc.Encode(msg)
+}
+
+func (j *jsonlWriter) Close() error {
+	return nil
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	c := &csvWriter{w: csv.NewWriter(w)}
+	if err := c.w.Write([]string{"id", "room_id", "username", "content", "created_at"}); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *csvWriter) WriteMessage(msg Message) error {
+	return c.w.Write([]string{
+		strconv.Itoa(msg.ID),
+		msg.RoomID,
+		msg.Username,
+		msg.Text(),
+		msg.CreatedAt.Format(time.RFC3339),
+	})
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// htmlWriter streams messages into the "transcript" view, which ranges over a channel
+// so that the whole transcript never has to be held in memory.
+type htmlWriter struct {
+	messages chan Message
+	done     chan struct{}
+	err      error
+}
+
+func newHTMLWriter(w io.Writer, views fiber.Views, header Header) *htmlWriter {
+	h := &htmlWriter{
+		messages: make(chan Message),
+		done:     make(chan struct{}),
+	}
+
+	go func() {
+		defer close(h.done)
+		h.err = views.Render(w, "transcript", fiber.Map{
+			"Title":    fmt.Sprintf("Transcript of %s", header.RoomName),
+			"Header":   header,
+			"Messages": h.messages,
+		})
+	}()
+
+	return h
+}
+
+func (h *htmlWriter) WriteMessage(msg Message) error {
+	select {
+	case h.messages <- msg:
+		return nil
+	case <-h.done:
+		if h.err != nil {
+			return h.err
+		}
+		return fmt.Errorf("transcript rendering stopped")
+	}
+}
+
+func (h *htmlWriter) Close() error {
+	close(h.messages)
+	<-h.done
+	return h.err
+}
@@ -0,0 +1,16 @@
+package views
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/template/html/v2"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewEngine),
+)
+
+// NewEngine creates the HTML engine that renders the pages and transcripts found in ./views.
+func NewEngine() fiber.Views {
+	return html.New("./views", ".html")
+}
@@ -2,24 +2,60 @@ package ws
 
 import (
 	"log"
-
-	"encoding/json"
+	"time"
 
 	"github.com/gofiber/websocket/v2"
 )
 
 type Client struct {
-	Conn     *websocket.Conn
-	Message  chan *Message
-	ID       string `json:"id"`
-	RoomID   string `json:"roomId"`
-	Username string `json:"username"`
+	Conn      *websocket.Conn
+	Message   chan *Message
+	ID        string   `json:"id"`
+	RoomID    string   `json:"roomId"`
+	Username  string   `json:"username"`
+	Transport string   `json:"transport"` // websocket, sse, longpoll or grpc
+	Encoding  Encoding `json:"-"`         // frame encoding of WebSocket clients
 }
 
 type Message struct {
-	Content  string `json:"content"`
-	RoomID   string `json:"roomId"`
-	Username string `json:"username"`
+	Content     string       `json:"content"`
+	RoomID      string       `json:"roomId"`
+	Username    string       `json:"username"`
+	Bot         bool         `json:"bot,omitempty"`         // set for messages posted by bots
+	Attachments []Attachment `json:"attachments,omitempty"` // links posted by webhooks
+	Type        string       `json:"type,omitempty"`        // empty for chat messages, otherwise one of the MessageType constants
+	Pin         *Pin         `json:"pin,omitempty"`         // the message a pin event is about
+	Poll        *Poll        `json:"poll,omitempty"`        // the tallies of a poll event
+	Vote        *Vote        `json:"vote,omitempty"`        // a vote sent by a client
+	Call        *Call        `json:"call,omitempty"`        // a call action sent by a client or a call event
+	Signal      *Signal      `json:"signal,omitempty"`      // WebRTC signaling between two participants of a call
+	To          string       `json:"-"`                     // when set, the ID of the only user the message is delivered to
+
+	frames frames
+}
+
+// Types of the room events that are not chat messages.
+const (
+	MessageTypePin    = "pin"
+	MessageTypeUnpin  = "unpin"
+	MessageTypePoll   = "poll"
+	MessageTypeVote   = "vote"
+	MessageTypeCall   = "call"
+	MessageTypeSignal = "signal"
+)
+
+// Pin identifies the message of a pin or unpin event. By is the moderator who pinned or unpinned it.
+type Pin struct {
+	MessageID int    `json:"messageId"`
+	Content   string `json:"content,omitempty"`
+	Author    string `json:"author,omitempty"`
+	By        string `json:"by"`
+}
+
+type Attachment struct {
+	Title string `json:"title,omitempty"`
+	URL   string `json:"url,omitempty"`
+	Text  string `json:"text,omitempty"`
 }
 
 func (c *Client) WriteMessage() {
@@ -33,11 +69,93 @@ func (c *Client) WriteMessage() {
 			return
 		}
 
-		c.Conn.WriteJSON(message)
+		prepared, err := message.Prepared(c.Encoding)
+		if err != nil {
+			log.Printf("error: %v", err)
+			continue
+		}
+		c.Conn.WritePreparedMessage(prepared)
 	}
 }
 
-func (c *Client) ReadMessage(hub *Hub) {
+// Poll is the state of a poll as broadcast to its room.
+type Poll struct {
+	ID        int          `json:"id"`
+	MessageID int          `json:"messageId"`
+	Question  string       `json:"question"`
+	Options   []PollOption `json:"options"`
+	Multiple  bool         `json:"multiple"`
+	Anonymous bool         `json:"anonymous"`
+	ClosesAt  *time.Time   `json:"closesAt,omitempty"`
+	Closed    bool         `json:"closed"`
+	Voters    int          `json:"voters"`
+}
+
+// PollOption is the tally of a poll option. Voters is empty for anonymous polls.
+type PollOption struct {
+	Text   string   `json:"text"`
+	Votes  int      `json:"votes"`
+	Voters []string `json:"voters,omitempty"`
+}
+
+// Vote replaces the vote of a client on a poll; no options retracts it.
+type Vote struct {
+	PollID  int   `json:"pollId"`
+	Options []int `json:"options"`
+}
+
+// Call actions a client can send.
+const (
+	CallActionStart = "start"
+	CallActionJoin  = "join"
+	CallActionLeave = "leave"
+	CallActionEnd   = "end"
+)
+
+// Call events broadcast to the room.
+const (
+	CallEventStarted = "started"
+	CallEventJoined  = "joined"
+	CallEventLeft    = "left"
+	CallEventEnded   = "ended"
+)
+
+// Call is a call action sent by a client or a call event broadcast to the room.
+// Clients set Action to one of the CallAction constants and ID to the call they mean;
+// events set it to one of the CallEvent constants and list who is in the call.
+type Call struct {
+	ID           int               `json:"id,omitempty"`
+	Action       string            `json:"action"`
+	By           string            `json:"by,omitempty"`
+	StartedBy    string            `json:"startedBy,omitempty"`
+	Participants []CallParticipant `json:"participants,omitempty"`
+	StartedAt    *time.Time        `json:"startedAt,omitempty"`
+	EndedAt      *time.Time        `json:"endedAt,omitempty"`
+}
+
+type CallParticipant struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+}
+
+// Signal is an SDP offer or answer or an ICE candidate relayed from one participant
+// of a call to another. From and FromName are filled in by the service.
+type Signal struct {
+	CallID    int    `json:"callId"`
+	Kind      string `json:"kind"` // offer, answer or candidate
+	From      string `json:"from,omitempty"`
+	FromName  string `json:"fromName,omitempty"`
+	To        string `json:"to"`
+	SDP       string `json:"sdp,omitempty"`
+	Candidate string `json:"candidate,omitempty"`
+}
+
+// MessageHandler is called for every chat message read from a client before it is broadcast,
+// and a message it fails is not broadcast. Votes, call actions and signals are only passed
+// to the handler, which sends the resulting events itself.
+type MessageHandler func(msg *Message) error
+
+func (c *Client) ReadMessage(hub *Hub, handle MessageHandler) {
 	defer func() {
 		hub.Unregister <- c
 		c.Conn.Close()
@@ -52,18 +170,23 @@ func (c *Client) ReadMessage(hub *Hub) {
 			break
 		}
 
-		var msgData Message
-		if err := json.Unmarshal(m, &msgData); err != nil {
+		msg, err := decodeClientMessage(c.Encoding, m)
+		if err != nil {
 			log.Printf("error: %v", err)
 			continue
 		}
+		msg.RoomID = c.RoomID
+		msg.Username = c.Username
 
-		msg := &Message{
-			Content:  msgData.Content,
-			RoomID:   c.RoomID,
-			Username: c.Username,
+		if handle != nil {
+			if err := handle(msg); err != nil {
+				log.Printf("error: %v", err)
+				continue
+			}
 		}
 
-		hub.Broadcast <- msg
+		if msg.Type == "" {
+			hub.Broadcast <- msg
+		}
 	}
 }
@@ -0,0 +1,241 @@
+package ws
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/chat-service/grpc/pkg/chat"
+	fasthttpws "github.com/fasthttp/websocket"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WebSocket subprotocols. Clients that do not ask for one get JSON frames.
+const (
+	SubprotocolJSON  = "chat.v1.json"
+	SubprotocolProto = "chat.v1.proto"
+)
+
+// Subprotocols lists the subprotocols the chat endpoint accepts.
+var Subprotocols = []string{SubprotocolProto, SubprotocolJSON}
+
+// Encoding is the frame encoding of a WebSocket client.
+type Encoding int
+
+const (
+	// EncodingJSON sends Message as JSON text frames and reads them back the same way.
+	EncodingJSON Encoding = iota
+	// EncodingProto sends chat.ServerEvent binary frames and reads chat.ClientEvent ones.
+	EncodingProto
+
+	encodingCount
+)
+
+// EncodingForSubprotocol returns the encoding of a negotiated subprotocol.
+func EncodingForSubprotocol(subprotocol string) Encoding {
+	if subprotocol == SubprotocolProto {
+		return EncodingProto
+	}
+	return EncodingJSON
+}
+
+// frames caches the encoded forms of a message, so that a message broadcast to
+// many clients is serialized once per encoding instead of once per client.
+type frames struct {
+	once     [encodingCount]sync.Once
+	prepared [encodingCount]*fasthttpws.PreparedMessage
+	err      [encodingCount]error
+}
+
+// Prepared returns the message encoded as a WebSocket frame. The frame is built on
+// first use and shared by every client with the same encoding; compressed variants
+// are cached by the prepared message itself.
+func (m *Message) Prepared(encoding Encoding) (*fasthttpws.PreparedMessage, error) {
+	m.frames.once[encoding].Do(func() {
+		var data []byte
+		messageType := fasthttpws.TextMessage
+		switch encoding {
+		case EncodingProto:
+			data, m.frames.err[encoding] = proto.Marshal(m.ProtoEvent())
+			messageType = fasthttpws.BinaryMessage
+		default:
+			data, m.frames.err[encoding] = json.Marshal(m)
+		}
+		if m.frames.err[encoding] != nil {
+			return
+		}
+		m.frames.prepared[encoding], m.frames.err[encoding] = fasthttpws.NewPreparedMessage(messageType, data)
+	})
+	return m.frames.prepared[encoding], m.frames.err[encoding]
+}
+
+// ProtoEvent returns the message as a chat.ServerEvent. Hub messages are not
+// persisted yet, so the event carries no id and the time it was encoded.
+func (m *Message) ProtoEvent() *chat.ServerEvent {
+	if m.Poll != nil {
+		options := make([]*chat.PollOption, 0, len(m.Poll.Options))
+		for _, option := range m.Poll.Options {
+			options = append(options, &chat.PollOption{
+				Text:   option.Text,
+				Votes:  int32(option.Votes),
+				Voters: option.Voters,
+			})
+		}
+
+		poll := &chat.Poll{
+			Id:        int32(m.Poll.ID),
+			MessageId: int32(m.Poll.MessageID),
+			RoomId:    m.RoomID,
+			Question:  m.Poll.Question,
+			Options:   options,
+			Multiple:  m.Poll.Multiple,
+			Anonymous: m.Poll.Anonymous,
+			Closed:    m.Poll.Closed,
+			Voters:    int32(m.Poll.Voters),
+		}
+		if m.Poll.ClosesAt != nil {
+			poll.ClosesAt = timestamppb.New(*m.Poll.ClosesAt)
+		}
+		return &chat.ServerEvent{Event: &chat.ServerEvent_Poll{Poll: poll}}
+	}
+
+	if m.Call != nil {
+		participants := make([]*chat.CallParticipant, 0, len(m.Call.Participants))
+		for _, participant := range m.Call.Participants {
+			participants = append(participants, &chat.CallParticipant{
+				UserId:   participant.ID,
+				Username: participant.Username,
+			})
+		}
+
+		call := &chat.Call{
+			Id:           int32(m.Call.ID),
+			RoomId:       m.RoomID,
+			Action:       m.Call.Action,
+			By:           m.Call.By,
+			StartedBy:    m.Call.StartedBy,
+			Participants: participants,
+		}
+		if m.Call.StartedAt != nil {
+			call.StartedAt = timestamppb.New(*m.Call.StartedAt)
+		}
+		if m.Call.EndedAt != nil {
+			call.EndedAt = timestamppb.New(*m.Call.EndedAt)
+		}
+		return &chat.ServerEvent{Event: &chat.ServerEvent_Call{Call: call}}
+	}
+
+	if m.Signal != nil {
+		return &chat.ServerEvent{Event: &chat.ServerEvent_Signal{Signal: &chat.Signal{
+			RoomId:       m.RoomID,
+			CallId:       int32(m.Signal.CallID),
+			Kind:         m.Signal.Kind,
+			From:         m.Signal.From,
+			FromUsername: m.Signal.FromName,
+			To:           m.Signal.To,
+			Sdp:          m.Signal.SDP,
+			Candidate:    m.Signal.Candidate,
+		}}}
+	}
+
+	if m.Pin != nil {
+		switch m.Type {
+		case MessageTypePin:
+			return &chat.ServerEvent{
+				Event: &chat.ServerEvent_Pinned{
+					Pinned: &chat.Pinned{
+						RoomId:    m.RoomID,
+						MessageId: int32(m.Pin.MessageID),
+						Content:   m.Pin.Content,
+						Author:    m.Pin.Author,
+						PinnedBy:  m.Pin.By,
+					},
+				},
+			}
+		case MessageTypeUnpin:
+			return &chat.ServerEvent{
+				Event: &chat.ServerEvent_Unpinned{
+					Unpinned: &chat.Unpinned{
+						RoomId:     m.RoomID,
+						MessageId:  int32(m.Pin.MessageID),
+						UnpinnedBy: m.Pin.By,
+					},
+				},
+			}
+		}
+	}
+
+	attachments := make([]*chat.Attachment, 0, len(m.Attachments))
+	for _, attachment := range m.Attachments {
+		attachments = append(attachments, &chat.Attachment{
+			Title: attachment.Title,
+			Url:   attachment.URL,
+			Text:  attachment.Text,
+		})
+	}
+
+	return &chat.ServerEvent{
+		Event: &chat.ServerEvent_Message{
+			Message: &chat.Message{
+				RoomId:      m.RoomID,
+				Username:    m.Username,
+				Content:     m.Content,
+				Bot:         m.Bot,
+				Attachments: attachments,
+				CreatedAt:   timestamppb.Now(),
+			},
+		},
+	}
+}
+
+// decodeClientMessage returns the chat message, the vote, the call action or the signal of a frame read from a client.
+func decodeClientMessage(encoding Encoding, data []byte) (*Message, error) {
+	if encoding == EncodingProto {
+		var event chat.ClientEvent
+		if err := proto.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+		if vote := event.GetVote(); vote != nil {
+			options := make([]int, 0, len(vote.GetOptions()))
+			for _, option := range vote.GetOptions() {
+				options = append(options, int(option))
+			}
+			return &Message{Type: MessageTypeVote, Vote: &Vote{PollID: int(vote.GetPollId()), Options: options}}, nil
+		}
+		if call := event.GetCall(); call != nil {
+			return &Message{Type: MessageTypeCall, Call: &Call{ID: int(call.GetCallId()), Action: call.GetAction()}}, nil
+		}
+		if signal := event.GetSignal(); signal != nil {
+			return &Message{Type: MessageTypeSignal, Signal: &Signal{
+				CallID:    int(signal.GetCallId()),
+				Kind:      signal.GetKind(),
+				To:        signal.GetTo(),
+				SDP:       signal.GetSdp(),
+				Candidate: signal.GetCandidate(),
+			}}, nil
+		}
+		return &Message{Content: event.GetSend().GetContent()}, nil
+	}
+
+	var msg struct {
+		Content string  `json:"content"`
+		Type    string  `json:"type"`
+		Vote    *Vote   `json:"vote"`
+		Call    *Call   `json:"call"`
+		Signal  *Signal `json:"signal"`
+	}
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, err
+	}
+	if msg.Type == MessageTypeVote && msg.Vote != nil {
+		return &Message{Type: MessageTypeVote, Vote: msg.Vote}, nil
+	}
+	if msg.Type == MessageTypeCall && msg.Call != nil {
+		return &Message{Type: MessageTypeCall, Call: &Call{ID: msg.Call.ID, Action: msg.Call.Action}}, nil
+	}
+	if msg.Type == MessageTypeSignal && msg.Signal != nil {
+		msg.Signal.From, msg.Signal.FromName = "", ""
+		return &Message{Type: MessageTypeSignal, Signal: msg.Signal}, nil
+	}
+	return &Message{Content: msg.Content}, nil
+}
@@ -25,6 +25,30 @@ func NewHub() *Hub {
 	}
 }
 
+// IsConnected reports whether the user has a connection to the room.
+func (h *Hub) IsConnected(roomID, userID string) bool {
+	h.RLock()
+	defer h.RUnlock()
+
+	room, ok := h.Rooms[roomID]
+	return ok && len(room.Clients[userID]) > 0
+}
+
+// OnlineCounts returns the number of users connected to each of the rooms.
+// Rooms nobody has joined yet are not in the hub and count as zero.
+func (h *Hub) OnlineCounts(roomIDs []string) map[string]int {
+	h.RLock()
+	defer h.RUnlock()
+
+	counts := make(map[string]int, len(roomIDs))
+	for _, roomID := range roomIDs {
+		if room, ok := h.Rooms[roomID]; ok {
+			counts[roomID] = len(room.Clients)
+		}
+	}
+	return counts
+}
+
 func (h *Hub) Run() {
 	for {
 		select {
@@ -87,11 +111,36 @@ func (h *Hub) Run() {
 			h.Unlock()
 
 		case m := <-h.Broadcast:
+			hubBroadcasts.WithLabelValues(messageType(m)).Inc()
+
 			h.RLock() // Use RLock for reading
-			if room, ok := h.Rooms[m.RoomID]; ok {
-				for _, clientList := range room.Clients {
+			room, ok := h.Rooms[m.RoomID]
+			if !ok {
+				hubDropped.WithLabelValues(dropNoRoom).Inc()
+			} else {
+				recipients := room.Clients
+				if m.To != "" {
+					if len(room.Clients[m.To]) == 0 {
+						hubDropped.WithLabelValues(dropNoRecipient).Inc()
+					}
+					recipients = map[string][]*Client{m.To: room.Clients[m.To]}
+				}
+
+				// Encode the message once for every encoding in use among the recipients
+				var prepared [encodingCount]bool
+				for _, clientList := range recipients {
+					for _, cl := range clientList {
+						if cl.Conn != nil && !prepared[cl.Encoding] {
+							prepared[cl.Encoding] = true
+							m.Prepared(cl.Encoding)
+						}
+					}
+				}
+
+				for _, clientList := range recipients {
 					for _, cl := range clientList {
 						cl.Message <- m
+						hubDeliveries.Inc()
 					}
 				}
 			}
@@ -0,0 +1,97 @@
+package ws
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Reasons a message is dropped instead of delivered.
+const (
+	dropNoRoom        = "no_room"        // nobody is connected to the room on this instance
+	dropNoRecipient   = "no_recipient"   // the user a message is addressed to is not connected
+	dropSessionBuffer = "session_buffer" // a session buffer overflowed before its client fetched the events
+)
+
+var (
+	hubBroadcasts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "chat_hub_broadcasts_total",
+		Help: "Messages broadcast by the hub, by type; chat messages have the type message.",
+	}, []string{"type"})
+
+	hubDeliveries = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "chat_hub_deliveries_total",
+		Help: "Messages queued to clients by the hub; a broadcast is delivered once per connection.",
+	})
+
+	hubDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "chat_hub_dropped_messages_total",
+		Help: "Messages the hub or a session dropped, by reason.",
+	}, []string{"reason"})
+)
+
+var (
+	hubClientsDesc = prometheus.NewDesc("chat_hub_clients",
+		"Connections attached to the hub, by transport.", []string{"transport"}, nil)
+	hubUsersDesc = prometheus.NewDesc("chat_hub_users",
+		"Users connected to at least one room, counted once per room.", nil, nil)
+	hubRoomsDesc = prometheus.NewDesc("chat_hub_rooms",
+		"Rooms with at least one connection.", nil, nil)
+	hubQueueDesc = prometheus.NewDesc("chat_hub_queue_depth",
+		"Messages waiting in the broadcast queue of the hub.", nil, nil)
+	hubClientQueueDesc = prometheus.NewDesc("chat_hub_client_queue_depth",
+		"Messages waiting to be written to clients, summed over all of them.", nil, nil)
+	hubClientQueueMaxDesc = prometheus.NewDesc("chat_hub_client_queue_depth_max",
+		"Messages waiting to be written to the most backed up client.", nil, nil)
+)
+
+// Describe implements prometheus.Collector.
+func (h *Hub) Describe(ch chan<- *prometheus.Desc) {
+	ch <- hubClientsDesc
+	ch <- hubUsersDesc
+	ch <- hubRoomsDesc
+	ch <- hubQueueDesc
+	ch <- hubClientQueueDesc
+	ch <- hubClientQueueMaxDesc
+}
+
+// Collect implements prometheus.Collector; the gauges are read from the hub at scrape time.
+func (h *Hub) Collect(ch chan<- prometheus.Metric) {
+	h.RLock()
+	clients := map[string]int{
+		TransportWebSocket: 0,
+		TransportSSE:       0,
+		TransportLongPoll:  0,
+		TransportGRPC:      0,
+	}
+	users, rooms, queued, queuedMax := 0, 0, 0, 0
+	for _, room := range h.Rooms {
+		if len(room.Clients) > 0 {
+			rooms++
+		}
+		users += len(room.Clients)
+		for _, clientList := range room.Clients {
+			for _, cl := range clientList {
+				clients[cl.Transport]++
+				queued += len(cl.Message)
+				queuedMax = max(queuedMax, len(cl.Message))
+			}
+		}
+	}
+	h.RUnlock()
+
+	for transport, count := range clients {
+		ch <- prometheus.MustNewConstMetric(hubClientsDesc, prometheus.GaugeValue, float64(count), transport)
+	}
+	ch <- prometheus.MustNewConstMetric(hubUsersDesc, prometheus.GaugeValue, float64(users))
+	ch <- prometheus.MustNewConstMetric(hubRoomsDesc, prometheus.GaugeValue, float64(rooms))
+	ch <- prometheus.MustNewConstMetric(hubQueueDesc, prometheus.GaugeValue, float64(len(h.Broadcast)))
+	ch <- prometheus.MustNewConstMetric(hubClientQueueDesc, prometheus.GaugeValue, float64(queued))
+	ch <- prometheus.MustNewConstMetric(hubClientQueueMaxDesc, prometheus.GaugeValue, float64(queuedMax))
+}
+
+func messageType(m *Message) string {
+	if m.Type == "" {
+		return "message"
+	}
+	return m.Type
+}
@@ -0,0 +1,216 @@
+package ws
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// Transports a client can be attached to the hub with.
+const (
+	TransportWebSocket = "websocket"
+	TransportSSE       = "sse"
+	TransportLongPoll  = "longpoll"
+	TransportGRPC      = "grpc"
+)
+
+// Event is a message delivered to a session, numbered in the order it was received.
+type Event struct {
+	Seq     int64    `json:"seq"`
+	Message *Message `json:"message"`
+}
+
+// Session attaches a client that is not a WebSocket (Server-Sent Events, long-polling or gRPC) to the hub.
+// It drains the messages of its hub client into a bounded buffer so that a slow
+// client never blocks the hub; when the buffer is full the oldest events are dropped.
+type Session struct {
+	ID     string
+	Client *Client
+
+	mu       sync.Mutex
+	events   []Event
+	seq      int64
+	closed   bool
+	lastSeen time.Time
+	notify   chan struct{}
+	done     chan struct{}
+	size     int
+}
+
+// Next returns the events after cursor, waiting until there is at least one,
+// the session is closed or ctx is done. It reports whether the session is closed.
+func (s *Session) Next(ctx context.Context, cursor int64) ([]Event, bool) {
+	for {
+		s.mu.Lock()
+		s.lastSeen = time.Now()
+		var events []Event
+		for _, event := range s.events {
+			if event.Seq > cursor {
+				events = append(events, event)
+			}
+		}
+		closed, notify := s.closed, s.notify
+		s.mu.Unlock()
+
+		if len(events) > 0 || closed {
+			return events, closed
+		}
+
+		select {
+		case <-notify:
+		case <-ctx.Done():
+			return nil, false
+		}
+	}
+}
+
+// Cursor returns the sequence number of the latest event.
+func (s *Session) Cursor() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.seq
+}
+
+// Done is closed once the session is closed.
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+// Touch marks the session as in use.
+func (s *Session) Touch() {
+	s.mu.Lock()
+	s.lastSeen = time.Now()
+	s.mu.Unlock()
+}
+
+func (s *Session) pump() {
+	for m := range s.Client.Message {
+		s.mu.Lock()
+		s.seq++
+		s.events = append(s.events, Event{Seq: s.seq, Message: m})
+		if len(s.events) > s.size {
+			hubDropped.WithLabelValues(dropSessionBuffer).Add(float64(len(s.events) - s.size))
+			s.events = s.events[len(s.events)-s.size:]
+		}
+		s.wake()
+		s.mu.Unlock()
+	}
+
+	// The hub closes the channel once the client is unregistered
+	s.mu.Lock()
+	s.closed = true
+	s.wake()
+	s.mu.Unlock()
+	close(s.done)
+}
+
+// wake releases the waiters of Next; s.mu must be held.
+func (s *Session) wake() {
+	close(s.notify)
+	s.notify = make(chan struct{})
+}
+
+func (s *Session) idleSince() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastSeen
+}
+
+// Sessions keeps the non-WebSocket sessions of this instance.
+// Sessions that are not used for longer than the TTL are closed.
+type Sessions struct {
+	hub        *Hub
+	ttl        time.Duration
+	bufferSize int
+
+	mu       sync.Mutex
+	sessions map[string]*Session
+	once     sync.Once
+}
+
+func NewSessions(hub *Hub, ttl time.Duration, bufferSize int) *Sessions {
+	return &Sessions{
+		hub:        hub,
+		ttl:        ttl,
+		bufferSize: bufferSize,
+		sessions:   make(map[string]*Session),
+	}
+}
+
+// Open registers the client with the hub and returns its session.
+func (s *Sessions) Open(client *Client) (*Session, error) {
+	id, err := newSessionID()
+	if err != nil {
+		return nil, err
+	}
+
+	session := &Session{
+		ID:       id,
+		Client:   client,
+		lastSeen: time.Now(),
+		notify:   make(chan struct{}),
+		done:     make(chan struct{}),
+		size:     s.bufferSize,
+	}
+
+	s.once.Do(func() { go s.expire() })
+
+	s.mu.Lock()
+	s.sessions[id] = session
+	s.mu.Unlock()
+
+	go session.pump()
+	s.hub.Register <- client
+
+	return session, nil
+}
+
+// Get returns an open session.
+func (s *Sessions) Get(id string) (*Session, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[id]
+	return session, ok
+}
+
+// Close unregisters the session from the hub. Closing a closed session is a no-op.
+func (s *Sessions) Close(id string) {
+	s.mu.Lock()
+	session, ok := s.sessions[id]
+	delete(s.sessions, id)
+	s.mu.Unlock()
+
+	if ok {
+		s.hub.Unregister <- session.Client
+	}
+}
+
+func (s *Sessions) expire() {
+	ticker := time.NewTicker(s.ttl / 2)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		var expired []string
+		s.mu.Lock()
+		for id, session := range s.sessions {
+			if time.Since(session.idleSince()) > s.ttl {
+				expired = append(expired, id)
+			}
+		}
+		s.mu.Unlock()
+
+		for _, id := range expired {
+			s.Close(id)
+		}
+	}
+}
+
+func newSessionID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
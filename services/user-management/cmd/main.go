@@ -14,7 +14,10 @@ import (
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/server"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/configs"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/db"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/health"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/jwks"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/tracing"
 	"github.com/gofiber/fiber/v2"
 	"go.uber.org/fx"
 )
@@ -34,6 +37,9 @@ func main() {
 		logger.Module,
 		db.Module,
 		configs.Module,
+		tracing.Module,
+		health.Module,
+		jwks.Module,
 		fx.Provide(
 			// http service
 			handler.NewUserHandler,
@@ -61,12 +67,16 @@ func main() {
 			config *configs.Config,
 			srv *server.Server, // Inject the Fiber server
 			grpcSrv *grpc.GRPCServer, // Inject the gRPC server
+			checker *health.Checker, // Inject the health checker
 		) {
 			// Set up the Fiber server
 			srv.SetupUserServer(lc)
 
 			// Set up the gRPC server
 			grpcSrv.SetupGRPCServer(lc)
+
+			// Set up the health checks
+			checker.SetupChecker(lc)
 		}),
 	)
 	app.Run()
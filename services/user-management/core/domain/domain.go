@@ -1,5 +1,7 @@
 package domain
 
+import "slices"
+
 type User struct {
 	ID       int
 	Username string
@@ -7,6 +9,28 @@ type User struct {
 	Email    string
 	Role     Role
 	Profile  Profile
+	// ClientID and Scopes are set when an OAuth client acts on behalf of the user
+	ClientID string
+	Scopes   []string
+}
+
+// The scopes OAuth clients need to read and to change users.
+const (
+	ScopeUsersRead  = "users:read"
+	ScopeUsersWrite = "users:write"
+)
+
+// RoleBot and RoleClient are the roles of the tokens of bots and of OAuth clients acting on
+// their own behalf. Their IDs number bots and clients, not users.
+const (
+	RoleBot    = "bot"
+	RoleClient = "client"
+)
+
+// HasScope reports whether the token of the user allows scope. Only the tokens of OAuth
+// clients are limited to their scopes.
+func (u User) HasScope(scope string) bool {
+	return u.ClientID == "" || slices.Contains(u.Scopes, scope)
 }
 
 type Role struct {
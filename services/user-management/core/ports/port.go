@@ -10,6 +10,7 @@ type IUserRepository interface {
 	CreateUserWithTransaction(ctx context.Context, user domain.User) (domain.User, error)
 	FindUserByIDWithTransaction(ctx context.Context, user domain.User) (domain.User, error)
 	FindUserByUsernameWithTransaction(ctx context.Context, user domain.User) (domain.User, error)
+	FindUserByEmailWithTransaction(ctx context.Context, user domain.User) (domain.User, error)
 	UpdateUserWithTransaction(ctx context.Context, user domain.User) (domain.User, error)
 	DeleteUserWithTransaction(ctx context.Context, user domain.User) error
 }
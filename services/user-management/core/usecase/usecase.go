@@ -3,10 +3,12 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/core/domain"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/core/ports"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/grpc/service/auth"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/authctx"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/errors"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/logger"
 )
@@ -29,14 +31,14 @@ func (u *UserUseCase) CreateUser(ctx context.Context, user domain.User) (domain.
 	// hash password with auth service
 	hashedPassword, err := u.authService.HashPassword(ctx, user)
 	if err != nil {
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
 		return domain.User{}, err
 	}
 	user.Password = hashedPassword.Password
 
 	createdUser, err := u.userRepository.CreateUserWithTransaction(ctx, user)
 	if err != nil {
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
 		return domain.User{}, err
 	}
 
@@ -45,23 +47,27 @@ func (u *UserUseCase) CreateUser(ctx context.Context, user domain.User) (domain.
 
 func (u *UserUseCase) FindUserByID(ctx context.Context, user domain.User) (domain.User, error) {
 	// get token from context
-	contextToken, ok := ctx.Value("token").(string)
+	contextToken, ok := authctx.Token(ctx)
 	if !ok {
 		err := fmt.Errorf("error in getting token from context")
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
 		return domain.User{}, errors.NewError(errors.ErrorBadRequest, err)
 	}
 
 	// verify token with auth service and get user claims
-	_, err := u.authService.VerifyToken(ctx, domain.Auth{AccessToken: contextToken})
+	claims, err := u.authService.VerifyToken(ctx, domain.Auth{AccessToken: contextToken})
 	if err != nil {
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
+		return domain.User{}, err
+	}
+	logger.SetUserID(ctx, strconv.Itoa(claims.ID))
+	if err := u.verifyScope(claims, domain.ScopeUsersRead); err != nil {
 		return domain.User{}, err
 	}
 
 	existingUser, err := u.userRepository.FindUserByIDWithTransaction(ctx, user)
 	if err != nil {
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
 		return domain.User{}, err
 	}
 
@@ -75,7 +81,29 @@ func (u *UserUseCase) FindUserByID(ctx context.Context, user domain.User) (domai
 func (u *UserUseCase) FindUserByUsername(ctx context.Context, user domain.User) (domain.User, error) {
 	existingUser, err := u.userRepository.FindUserByUsernameWithTransaction(ctx, user)
 	if err != nil {
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
+		return domain.User{}, err
+	}
+
+	return existingUser, nil
+}
+
+func (u *UserUseCase) FindUserByEmail(ctx context.Context, user domain.User) (domain.User, error) {
+	existingUser, err := u.userRepository.FindUserByEmailWithTransaction(ctx, user)
+	if err != nil {
+		u.logger.Ctx(ctx).Error(err.Error())
+		return domain.User{}, err
+	}
+
+	return existingUser, nil
+}
+
+// GetUserByID finds a user for the other services, such as auth-service refreshing the
+// claims of a session; unlike FindUserByID it takes no token.
+func (u *UserUseCase) GetUserByID(ctx context.Context, user domain.User) (domain.User, error) {
+	existingUser, err := u.userRepository.FindUserByIDWithTransaction(ctx, user)
+	if err != nil {
+		u.logger.Ctx(ctx).Error(err.Error())
 		return domain.User{}, err
 	}
 
@@ -84,17 +112,21 @@ func (u *UserUseCase) FindUserByUsername(ctx context.Context, user domain.User)
 
 func (u *UserUseCase) UpdateUser(ctx context.Context, user domain.User) (domain.User, error) {
 	// get token from context
-	contextToken, ok := ctx.Value("token").(string)
+	contextToken, ok := authctx.Token(ctx)
 	if !ok {
 		err := fmt.Errorf("error in getting token from context")
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
 		return domain.User{}, errors.NewError(errors.ErrorBadRequest, err)
 	}
 
 	// verify token with auth service and get user claims
 	userClaims, err := u.authService.VerifyToken(ctx, domain.Auth{AccessToken: contextToken})
 	if err != nil {
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
+		return domain.User{}, err
+	}
+	logger.SetUserID(ctx, strconv.Itoa(userClaims.ID))
+	if err := u.verifyScope(userClaims, domain.ScopeUsersWrite); err != nil {
 		return domain.User{}, err
 	}
 
@@ -104,7 +136,7 @@ func (u *UserUseCase) UpdateUser(ctx context.Context, user domain.User) (domain.
 
 	existingUser, err := u.userRepository.FindUserByIDWithTransaction(ctx, user)
 	if err != nil {
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
 		return domain.User{}, err
 	}
 
@@ -119,14 +151,14 @@ func (u *UserUseCase) UpdateUser(ctx context.Context, user domain.User) (domain.
 	// hash password with auth service
 	hashedPassword, err := u.authService.HashPassword(ctx, user)
 	if err != nil {
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
 		return domain.User{}, err
 	}
 	user.Password = hashedPassword.Password
 
 	updatedUser, err := u.userRepository.UpdateUserWithTransaction(ctx, user)
 	if err != nil {
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
 		return domain.User{}, err
 	}
 
@@ -135,29 +167,33 @@ func (u *UserUseCase) UpdateUser(ctx context.Context, user domain.User) (domain.
 
 func (u *UserUseCase) DeleteUser(ctx context.Context, user domain.User) error {
 	// get token from context
-	contextToken, ok := ctx.Value("token").(string)
+	contextToken, ok := authctx.Token(ctx)
 	if !ok {
 		err := fmt.Errorf("error in getting token from context")
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
 		return errors.NewError(errors.ErrorBadRequest, err)
 	}
 
 	// verify token with auth service and get user claims
 	claims, err := u.authService.VerifyToken(ctx, domain.Auth{AccessToken: contextToken})
 	if err != nil {
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
+		return err
+	}
+	logger.SetUserID(ctx, strconv.Itoa(claims.ID))
+	if err := u.verifyScope(claims, domain.ScopeUsersWrite); err != nil {
 		return err
 	}
 
 	userClaims, err := u.userRepository.FindUserByIDWithTransaction(ctx, claims)
 	if err != nil {
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
 		return err
 	}
 
 	existingUser, err := u.userRepository.FindUserByIDWithTransaction(ctx, user)
 	if err != nil {
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
 		return err
 	}
 
@@ -167,13 +203,21 @@ func (u *UserUseCase) DeleteUser(ctx context.Context, user domain.User) error {
 
 	err = u.userRepository.DeleteUserWithTransaction(ctx, existingUser)
 	if err != nil {
-		u.logger.Error(err.Error())
+		u.logger.Ctx(ctx).Error(err.Error())
 		return err
 	}
 
 	return nil
 }
 
+// verifyScope makes sure that an OAuth client acting on behalf of the user was granted scope.
+func (u *UserUseCase) verifyScope(user domain.User, scope string) error {
+	if !user.HasScope(scope) {
+		return errors.NewError(errors.ErrorForbidden, fmt.Errorf("token does not have the %s scope", scope))
+	}
+	return nil
+}
+
 func (u *UserUseCase) hasPermission(user domain.User, requiredPermission string) bool {
 	// Step 1: Check if the user has a role
 	if user.Role.Name == "" {
@@ -5,6 +5,8 @@ import (
 
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/core/usecase"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/grpc/pkg/user"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/errors"
+	"google.golang.org/grpc/status"
 )
 
 type UserHandler struct {
@@ -21,7 +23,26 @@ func NewUserHandler(userUseCase *usecase.UserUseCase) *UserHandler {
 func (h *UserHandler) GetUserByUsername(ctx context.Context, req *user.GetUserReq) (*user.UserRes, error) {
 	res, err := h.userUseCase.FindUserByUsername(ctx, MapProtoGetUserReqToDomainAuth(req))
 	if err != nil {
-		return &user.UserRes{}, err
+		grpcErr := errors.GRPCFromError(err)
+		return &user.UserRes{}, status.Error(grpcErr.Code, grpcErr.Message)
+	}
+	return MapDomainUserToProtoUserRes(res), nil
+}
+
+func (h *UserHandler) GetUserByEmail(ctx context.Context, req *user.GetUserByEmailReq) (*user.UserRes, error) {
+	res, err := h.userUseCase.FindUserByEmail(ctx, MapProtoGetUserByEmailReqToDomainUser(req))
+	if err != nil {
+		grpcErr := errors.GRPCFromError(err)
+		return &user.UserRes{}, status.Error(grpcErr.Code, grpcErr.Message)
+	}
+	return MapDomainUserToProtoUserRes(res), nil
+}
+
+func (h *UserHandler) GetUserByID(ctx context.Context, req *user.GetUserByIDReq) (*user.UserRes, error) {
+	res, err := h.userUseCase.GetUserByID(ctx, MapProtoGetUserByIDReqToDomainUser(req))
+	if err != nil {
+		grpcErr := errors.GRPCFromError(err)
+		return &user.UserRes{}, status.Error(grpcErr.Code, grpcErr.Message)
 	}
 	return MapDomainUserToProtoUserRes(res), nil
 }
@@ -11,6 +11,18 @@ func MapProtoGetUserReqToDomainAuth(req *user.GetUserReq) domain.User {
 	}
 }
 
+func MapProtoGetUserByEmailReqToDomainUser(req *user.GetUserByEmailReq) domain.User {
+	return domain.User{
+		Email: req.Email,
+	}
+}
+
+func MapProtoGetUserByIDReqToDomainUser(req *user.GetUserByIDReq) domain.User {
+	return domain.User{
+		ID: int(req.Id),
+	}
+}
+
 func MapDomainUserToProtoUserRes(res domain.User) *user.UserRes {
 	return &user.UserRes{
 		Id:       int32(res.ID),
@@ -7,10 +7,15 @@ import (
 
 	grpchandler "github.com/Ali-Gorgani/chat-room-project/services/user-management/grpc/grpc-handler"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/grpc/pkg/user"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/middleware"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/health"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/metrics"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type GRPCServer struct {
@@ -19,9 +24,14 @@ type GRPCServer struct {
 	config      *configs.Config
 }
 
-func NewGRPCServer(grpcHandler *grpchandler.UserHandler, logger *logger.Logger, config *configs.Config) *GRPCServer {
-	srv := grpc.NewServer()
+func NewGRPCServer(grpcHandler *grpchandler.UserHandler, checker *health.Checker, logger *logger.Logger, config *configs.Config) *GRPCServer {
+	srv := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor(), middleware.RequestIDUnaryInterceptor(logger)),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor(), middleware.RequestIDStreamInterceptor(logger)),
+	)
 	user.RegisterUsersServiceServer(srv, grpcHandler)
+	grpc_health_v1.RegisterHealthServer(srv, checker.Server())
 
 	return &GRPCServer{
 		server: srv,
@@ -7,21 +7,28 @@ import (
 	"time"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/grpc/pkg/auth"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/middleware"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/configs"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/metrics"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // Client interface for AuthService
 type IClient interface {
 	HashPassword(ctx context.Context, req HashPasswordReq) (HashPasswordRes, error)
 	VerifyToken(ctx context.Context, req VerifyTokenReq) (VerifyTokenRes, error)
+	CheckHealth(ctx context.Context) error
 }
 
 // Client struct for managing connection
 type Client struct {
 	c      auth.AuthServiceClient // gRPC client
+	health grpc_health_v1.HealthClient
 	logger *logger.Logger
 }
 
@@ -32,7 +39,11 @@ func NewClient(logger *logger.Logger, config *configs.Config) (IClient, error) {
 	defer cancel()
 
 	// AuthService connection
-	conn, err := grpc.DialContext(ctx, fmt.Sprintf("%s:%d", config.GRPC.AuthHost, config.GRPC.AuthPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.DialContext(ctx, fmt.Sprintf("%s:%d", config.GRPC.AuthHost, config.GRPC.AuthPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(metrics.UnaryClientInterceptor(), middleware.RequestIDClientInterceptor()),
+	)
 	if err != nil {
 		log.Fatalf("failed to connect to UserService: %v", err)
 	}
@@ -40,14 +51,27 @@ func NewClient(logger *logger.Logger, config *configs.Config) (IClient, error) {
 
 	return &Client{
 		c:      client,
+		health: grpc_health_v1.NewHealthClient(conn),
 		logger: logger,
 	}, nil
 }
 
+// CheckHealth reports an error unless AuthService is serving.
+func (c *Client) CheckHealth(ctx context.Context) error {
+	res, err := c.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if res.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("AuthService is %s", res.GetStatus())
+	}
+	return nil
+}
+
 func (c *Client) HashPassword(ctx context.Context, req HashPasswordReq) (HashPasswordRes, error) {
 	res, err := c.c.HashPassword(ctx, MapDtoHashPasswordReqToPbHashPasswordReq(req))
 	if err != nil {
-		c.logger.Error(fmt.Sprintf("failed to call HashPassword: %v", err))
+		c.logger.Ctx(ctx).Error("failed to call HashPassword", zap.Error(err))
 		return HashPasswordRes{}, err
 	}
 	return MapPbHashPasswordResToDtoHashPasswordRes(res), nil
@@ -56,7 +80,7 @@ func (c *Client) HashPassword(ctx context.Context, req HashPasswordReq) (HashPas
 func (c *Client) VerifyToken(ctx context.Context, req VerifyTokenReq) (VerifyTokenRes, error) {
 	res, err := c.c.VerifyToken(ctx, MapDtoVerifyTokenReqToPbVerifyTokenReq(req))
 	if err != nil {
-		c.logger.Error(fmt.Sprintf("failed to call VerifyToken: %v", err))
+		c.logger.Ctx(ctx).Error("failed to call VerifyToken", zap.Error(err))
 		return VerifyTokenRes{}, err
 	}
 	return MapPbVerifyTokenResToDtoVerifyTokenRes(res), nil
@@ -26,5 +26,7 @@ func MapPbVerifyTokenResToDtoVerifyTokenRes(pb *auth.VerifyTokenRes) VerifyToken
 		Username: pb.Username,
 		Email:    pb.Email,
 		Role:     pb.Role,
+		ClientID: pb.ClientId,
+		Scope:    pb.Scope,
 	}
 }
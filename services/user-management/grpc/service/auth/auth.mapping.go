@@ -1,8 +1,11 @@
 package auth
 
 import (
+	"strings"
+
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/core/domain"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/grpc/repository/auth"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/jwks"
 )
 
 func MapDomainHashPasswordReqToDtoHashPasswordReq(req domain.User) auth.HashPasswordReq {
@@ -31,5 +34,18 @@ func MapDtoVerifyTokenResToDomainVerifyTokenRes(res auth.VerifyTokenRes) domain.
 		Role: domain.Role{
 			Name: res.Role,
 		},
+		ClientID: res.ClientID,
+		Scopes:   strings.Fields(res.Scope),
 	}
 }
+
+func MapJwksClaimsToDomainVerifyTokenRes(claims jwks.Claims) domain.User {
+	return MapDtoVerifyTokenResToDomainVerifyTokenRes(auth.VerifyTokenRes{
+		ID:       int(claims.ID),
+		Username: claims.Username,
+		Email:    claims.Email,
+		Role:     claims.Role,
+		ClientID: claims.ClientID,
+		Scope:    claims.Scope,
+	})
+}
@@ -2,18 +2,30 @@ package auth
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/core/domain"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/grpc/repository/auth"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/errors"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/jwks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type AuthService struct {
-	c auth.IClient
+	c        auth.IClient
+	verifier *jwks.Verifier
+	sessions *sessionCache
+	config   *configs.Config
 }
 
-func NewAuthService(c auth.IClient) *AuthService {
+func NewAuthService(c auth.IClient, verifier *jwks.Verifier, config *configs.Config) *AuthService {
 	return &AuthService{
-		c: c,
+		c:        c,
+		verifier: verifier,
+		sessions: newSessionCache(config.Auth.SessionCacheTTL),
+		config:   config,
 	}
 }
 
@@ -26,11 +38,51 @@ func (s *AuthService) HashPassword(ctx context.Context, req domain.User) (domain
 	return MapDtoHashPasswordResToDomainHashPasswordRes(dtoRes), nil
 }
 
+// checkSession makes sure the session of a locally verified token was not ended, asking the
+// auth service once per session and cache TTL. Which tokens have a session to end, bots and
+// OAuth clients included, is up to the auth service, so every token is asked about.
+func (s *AuthService) checkSession(ctx context.Context, req domain.Auth, claims jwks.Claims) error {
+	active, ok := s.sessions.get(claims.SessionID)
+	if !ok {
+		_, err := s.c.VerifyToken(ctx, MapDomainVerifyTokenReqToDtoVerifyTokenReq(req))
+		if err != nil && status.Code(err) != codes.Unauthenticated {
+			return err
+		}
+		active = err == nil
+		s.sessions.set(claims.SessionID, active)
+	}
+
+	if !active {
+		return errors.NewError(errors.ErrorUnauthorized, fmt.Errorf("session has ended"))
+	}
+	return nil
+}
+
+// VerifyToken verifies the token locally with the published signing keys, or with the
+// auth service when the verification is configured as remote. Only the tokens of users
+// are accepted: the IDs in the tokens of bots and OAuth clients are not user IDs.
 func (s *AuthService) VerifyToken(ctx context.Context, req domain.Auth) (domain.User, error) {
-	dtoReq := MapDomainVerifyTokenReqToDtoVerifyTokenReq(req)
-	dtoRes, err := s.c.VerifyToken(ctx, dtoReq)
-	if err != nil {
-		return domain.User{}, err
+	var user domain.User
+	if s.config.Auth.Verification == "local" {
+		claims, err := s.verifier.Verify(ctx, req.AccessToken)
+		if err != nil {
+			return domain.User{}, errors.NewError(errors.ErrorUnauthorized, err)
+		}
+		if err := s.checkSession(ctx, req, claims); err != nil {
+			return domain.User{}, err
+		}
+		user = MapJwksClaimsToDomainVerifyTokenRes(claims)
+	} else {
+		dtoReq := MapDomainVerifyTokenReqToDtoVerifyTokenReq(req)
+		dtoRes, err := s.c.VerifyToken(ctx, dtoReq)
+		if err != nil {
+			return domain.User{}, err
+		}
+		user = MapDtoVerifyTokenResToDomainVerifyTokenRes(dtoRes)
+	}
+
+	if user.Role.Name == domain.RoleBot || user.Role.Name == domain.RoleClient {
+		return domain.User{}, errors.NewError(errors.ErrorForbidden, fmt.Errorf("tokens of bots and OAuth clients can't act on users"))
 	}
-	return MapDtoVerifyTokenResToDomainVerifyTokenRes(dtoRes), nil
+	return user, nil
 }
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// sessionCache remembers for a short time whether the auth service found sessions active,
+// so that locally verified tokens only reach it once per session and cache TTL.
+type sessionCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]sessionEntry
+}
+
+type sessionEntry struct {
+	active    bool
+	expiresAt time.Time
+}
+
+func newSessionCache(ttl time.Duration) *sessionCache {
+	return &sessionCache{
+		ttl:     ttl,
+		entries: make(map[string]sessionEntry),
+	}
+}
+
+func (c *sessionCache) get(sessionID string) (active bool, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[sessionID]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return false, false
+	}
+	return entry.active, true
+}
+
+func (c *sessionCache) set(sessionID string, active bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	c.entries[sessionID] = sessionEntry{active: active, expiresAt: now.Add(c.ttl)}
+
+	// Drop the expired entries once the cache grew, so it holds the recent sessions only
+	if len(c.entries) > 10000 {
+		for id, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, id)
+			}
+		}
+	}
+}
@@ -45,7 +45,7 @@ func (h *UserHandler) FindUserByID(ctx *fiber.Ctx) error {
 	user := domain.User{
 		ID: id,
 	}
-	foundUser, err := h.usecase.FindUserByID(ctx.Context(), user)
+	foundUser, err := h.usecase.FindUserByID(ctx.UserContext(), user)
 	if err != nil {
 		apiErr := errors.FromError(err)
 		return ctx.Status(apiErr.Status).JSON(apiErr)
@@ -74,7 +74,7 @@ func (h *UserHandler) CreateUser(ctx *fiber.Ctx) error {
 		return ctx.Status(apiErr.Status).JSON(apiErr)
 	}
 
-	createdUser, err := h.usecase.CreateUser(ctx.Context(), CreateUserRequestToDomainUser(user))
+	createdUser, err := h.usecase.CreateUser(ctx.UserContext(), CreateUserRequestToDomainUser(user))
 	if err != nil {
 		apiErr := errors.FromError(err)
 		return ctx.Status(apiErr.Status).JSON(apiErr)
@@ -115,7 +115,7 @@ func (h *UserHandler) UpdateUser(ctx *fiber.Ctx) error {
 		return ctx.Status(apiErr.Status).JSON(apiErr)
 	}
 
-	updatedUser, err := h.usecase.UpdateUser(ctx.Context(), UpdateUserRequestToDomainUser(user, id))
+	updatedUser, err := h.usecase.UpdateUser(ctx.UserContext(), UpdateUserRequestToDomainUser(user, id))
 	if err != nil {
 		apiErr := errors.FromError(err)
 		return ctx.Status(apiErr.Status).JSON(apiErr)
@@ -149,7 +149,7 @@ func (h *UserHandler) DeleteUser(ctx *fiber.Ctx) error {
 	user := domain.User{
 		ID: id,
 	}
-	err = h.usecase.DeleteUser(ctx.Context(), user)
+	err = h.usecase.DeleteUser(ctx.UserContext(), user)
 	if err != nil {
 		apiErr := errors.FromError(err)
 		return ctx.Status(apiErr.Status).JSON(apiErr)
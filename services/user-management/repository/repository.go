@@ -14,6 +14,7 @@ import (
 	entUser "github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/ent/user"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/errors"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/logger"
+	"go.uber.org/zap"
 )
 
 type UserRepository struct {
@@ -33,7 +34,7 @@ func (r *UserRepository) CreateUserWithTransaction(ctx context.Context, user dom
 	// Start a transaction
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to start transaction: %v", err))
+		r.logger.Ctx(ctx).Error("failed to start transaction", zap.Error(err))
 		return domain.User{}, errors.NewError(errors.ErrorInternal, err)
 	}
 	defer tx.Rollback()
@@ -148,7 +149,7 @@ func (r *UserRepository) CreateUserWithTransaction(ctx context.Context, user dom
 func (r *UserRepository) FindUserByIDWithTransaction(ctx context.Context, user domain.User) (domain.User, error) {
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to start transaction: %v", err))
+		r.logger.Ctx(ctx).Error("failed to start transaction", zap.Error(err))
 		return domain.User{}, errors.NewError(errors.ErrorInternal, err)
 	}
 	defer tx.Rollback()
@@ -200,7 +201,7 @@ func (r *UserRepository) FindUserByIDWithTransaction(ctx context.Context, user d
 func (r *UserRepository) FindUserByUsernameWithTransaction(ctx context.Context, user domain.User) (domain.User, error) {
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to start transaction: %v", err))
+		r.logger.Ctx(ctx).Error("failed to start transaction", zap.Error(err))
 		return domain.User{}, errors.NewError(errors.ErrorInternal, err)
 	}
 	defer tx.Rollback()
@@ -248,11 +249,63 @@ func (r *UserRepository) FindUserByUsernameWithTransaction(ctx context.Context,
 	}, nil
 }
 
+// FindUserByEmailWithTransaction retrieves a user with their profile and role within a transaction
+func (r *UserRepository) FindUserByEmailWithTransaction(ctx context.Context, user domain.User) (domain.User, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Ctx(ctx).Error("failed to start transaction", zap.Error(err))
+		return domain.User{}, errors.NewError(errors.ErrorInternal, err)
+	}
+	defer tx.Rollback()
+
+	// Query user with profile and role information
+	foundUser, err := tx.User.Query().
+		Where(entUser.EmailEQ(user.Email)).
+		WithProfile(). // Load the associated profile
+		WithRole().    // Load the associated role information
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.User{}, errors.NewError(errors.ErrorNotFound, err)
+		}
+		return domain.User{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return domain.User{}, errors.NewError(errors.ErrorInternal, err)
+	}
+
+	// Map the result to domain.User including profile and role information
+	return domain.User{
+		ID:       foundUser.ID,
+		Username: foundUser.Username,
+		Password: foundUser.Password,
+		Email:    foundUser.Email,
+		Role: domain.Role{
+			ID:          foundUser.Edges.Role.ID,
+			Name:        string(foundUser.Edges.Role.Name),
+			Premissions: foundUser.Edges.Role.Permissions,
+		},
+		Profile: func() domain.Profile {
+			if foundUser.Edges.Profile != nil {
+				return domain.Profile{
+					ID:             foundUser.Edges.Profile.ID,
+					FirstName:      foundUser.Edges.Profile.FirstName,
+					LastName:       foundUser.Edges.Profile.LastName,
+					ProfilePicture: foundUser.Edges.Profile.ProfilePicture,
+				}
+			}
+			return domain.Profile{} // Return an empty profile if no profile exists
+		}(),
+	}, nil
+}
+
 // UpdateUserWithTransaction updates a user with their profile and role within a transaction
 func (r *UserRepository) UpdateUserWithTransaction(ctx context.Context, user domain.User) (domain.User, error) {
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to start transaction: %v", err))
+		r.logger.Ctx(ctx).Error("failed to start transaction", zap.Error(err))
 		return domain.User{}, errors.NewError(errors.ErrorInternal, err)
 	}
 	defer tx.Rollback()
@@ -385,7 +438,7 @@ func (r *UserRepository) UpdateUserWithTransaction(ctx context.Context, user dom
 func (r *UserRepository) DeleteUserWithTransaction(ctx context.Context, user domain.User) error {
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
-		r.logger.Error(fmt.Sprintf("failed to start transaction: %v", err))
+		r.logger.Ctx(ctx).Error("failed to start transaction", zap.Error(err))
 		return errors.NewError(errors.ErrorInternal, err)
 	}
 	defer tx.Rollback()
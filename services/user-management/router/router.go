@@ -3,20 +3,38 @@ package router
 import (
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/handler"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/middleware"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/health"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/metrics"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/tracing"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/swagger"
 )
 
-func SetupUserRouter(handler *handler.UserHandler) *fiber.App {
+func SetupUserRouter(handler *handler.UserHandler, logger *logger.Logger, checker *health.Checker) *fiber.App {
 	app := fiber.New()
 
+	// Health checks come before the middlewares, so probes are not counted, traced or logged
+	app.Get("/healthz", checker.Liveness)
+	app.Get("/readyz", checker.Readiness)
+
+	// Count and time every request
+	app.Use(metrics.Middleware())
+
+	// Trace every request and pass the span on in the user context
+	app.Use(tracing.Middleware())
+
+	// Accept or assign a request ID and log the request with it
+	app.Use(middleware.RequestIDMiddleware(logger))
+
 	// Configure CORS
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:     "http://localhost:3000,http://localhost:3001,https://localhost:3002", // Comma-separated origins as a single string
 		AllowCredentials: true,                                                                 // Allow cookies and credentials
 		AllowMethods:     "GET,POST,PUT,DELETE",                                                // Specify allowed HTTP methods
-		AllowHeaders:     "Content-Type,Authorization",                                         // Specify allowed headers
+		AllowHeaders:     "Content-Type,Authorization,X-Request-ID",                            // Specify allowed headers
+		ExposeHeaders:    "X-Request-ID",                                                       // Let browsers read the request ID
 	}))
 
 	app.Post("/users", handler.CreateUser)
@@ -26,5 +44,8 @@ func SetupUserRouter(handler *handler.UserHandler) *fiber.App {
 
 	app.Get("/swagger/*", swagger.HandlerDefault)
 
+	// Prometheus metrics
+	app.Get("/metrics", metrics.Handler())
+
 	return app
 }
@@ -2,6 +2,7 @@ package configs
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/logger"
 	"github.com/spf13/viper"
@@ -16,9 +17,13 @@ var Module = fx.Options(
 // Config holds the application wide configurations.
 // The values are read by viper from the config file or environment variables.
 type Config struct {
-	Server ServerConfig `mapstructure:"server"`
-	GRPC   GRPCConfig   `mapstructure:"grpc"`
-	PSQL   PSQLConfig   `mapstructure:"postgres"`
+	Server  ServerConfig  `mapstructure:"server"`
+	GRPC    GRPCConfig    `mapstructure:"grpc"`
+	PSQL    PSQLConfig    `mapstructure:"postgres"`
+	Tracing TracingConfig `mapstructure:"tracing"`
+	Log     LogConfig     `mapstructure:"log"`
+	Health  HealthConfig  `mapstructure:"health"`
+	Auth    AuthConfig    `mapstructure:"auth"`
 }
 
 type ServerConfig struct {
@@ -42,6 +47,38 @@ type PSQLConfig struct {
 	SSLMode  string `mapstructure:"ssl_mode"`
 }
 
+// TracingConfig selects where spans are exported: none, stdout or an OTLP gRPC collector.
+type TracingConfig struct {
+	Exporter    string  `mapstructure:"exporter"`
+	Endpoint    string  `mapstructure:"endpoint"`
+	Insecure    bool    `mapstructure:"insecure"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+	ServiceName string  `mapstructure:"service_name"`
+}
+
+// LogConfig holds the minimum level of the JSON logs: debug, info, warn or error.
+type LogConfig struct {
+	Level string `mapstructure:"level"`
+}
+
+// HealthConfig sets how often the dependencies are checked to update the gRPC health
+// status and how long a single dependency check may take.
+type HealthConfig struct {
+	Interval time.Duration `mapstructure:"interval"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+}
+
+// AuthConfig sets how access tokens are verified: "local" checks their signature with the
+// keys published by the auth service at JWKSURL, cached for JWKSCacheTTL, and "remote"
+// calls VerifyToken on the auth service for every token. Locally verified tokens have
+// their session checked with the auth service, which is remembered for SessionCacheTTL.
+type AuthConfig struct {
+	Verification    string        `mapstructure:"verification"`
+	JWKSURL         string        `mapstructure:"jwks_url"`
+	JWKSCacheTTL    time.Duration `mapstructure:"jwks_cache_ttl"`
+	SessionCacheTTL time.Duration `mapstructure:"session_cache_ttl"`
+}
+
 // NewConfig creates a new Config instance.
 func NewConfig() *Config {
 	return &Config{}
@@ -87,6 +124,23 @@ func LoadConfig(path string, logger *logger.Logger) (*Config, error) {
 		return nil, err
 	}
 
+	if err := validateTracingConfig(config.Tracing); err != nil {
+		return nil, err
+	}
+
+	// Apply the log level, which also validates it
+	if err := logger.SetLevel(config.Log.Level); err != nil {
+		return nil, fmt.Errorf("invalid log level: %w", err)
+	}
+
+	if err := validateHealthConfig(config.Health); err != nil {
+		return nil, err
+	}
+
+	if err := validateAuthConfig(config.Auth); err != nil {
+		return nil, err
+	}
+
 	return &config, nil
 }
 
@@ -105,6 +159,22 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("postgres.password", "secret")
 	v.SetDefault("postgres.database", "user-db")
 	v.SetDefault("postgres.ssl_mode", "disable")
+
+	v.SetDefault("tracing.exporter", "none")
+	v.SetDefault("tracing.endpoint", "localhost:4317")
+	v.SetDefault("tracing.insecure", true)
+	v.SetDefault("tracing.sample_ratio", 1)
+	v.SetDefault("tracing.service_name", "user-management")
+
+	v.SetDefault("log.level", "info")
+
+	v.SetDefault("health.interval", "10s")
+	v.SetDefault("health.timeout", "2s")
+
+	v.SetDefault("auth.verification", "local")
+	v.SetDefault("auth.jwks_url", "http://localhost:3001/.well-known/jwks.json")
+	v.SetDefault("auth.jwks_cache_ttl", "5m")
+	v.SetDefault("auth.session_cache_ttl", "5s")
 }
 
 // validateServerConfig ensures that essential server config values are present.
@@ -152,6 +222,58 @@ func validatePSQLConfig(psqlConfig PSQLConfig) error {
 	return nil
 }
 
+// validateTracingConfig ensures that the exporter is known and the sample ratio is a fraction.
+func validateTracingConfig(tracingConfig TracingConfig) error {
+	switch tracingConfig.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if tracingConfig.Endpoint == "" {
+			return fmt.Errorf("tracing endpoint is required for the otlp exporter")
+		}
+	default:
+		return fmt.Errorf("tracing exporter must be none, stdout or otlp")
+	}
+	if tracingConfig.SampleRatio < 0 || tracingConfig.SampleRatio > 1 {
+		return fmt.Errorf("tracing sample ratio must be between 0 and 1")
+	}
+	if tracingConfig.ServiceName == "" {
+		return fmt.Errorf("tracing service name is required")
+	}
+	return nil
+}
+
+// validateHealthConfig ensures that the checks are repeated and time out.
+func validateHealthConfig(healthConfig HealthConfig) error {
+	if healthConfig.Interval <= 0 {
+		return fmt.Errorf("health check interval is required")
+	}
+	if healthConfig.Timeout <= 0 {
+		return fmt.Errorf("health check timeout is required")
+	}
+	return nil
+}
+
+// validateAuthConfig ensures that local verification knows where to fetch the keys.
+func validateAuthConfig(authConfig AuthConfig) error {
+	switch authConfig.Verification {
+	case "remote":
+		return nil
+	case "local":
+	default:
+		return fmt.Errorf("auth verification must be local or remote")
+	}
+	if authConfig.JWKSURL == "" {
+		return fmt.Errorf("auth jwks url is required")
+	}
+	if authConfig.JWKSCacheTTL <= 0 {
+		return fmt.Errorf("auth jwks cache ttl is required")
+	}
+	if authConfig.SessionCacheTTL <= 0 {
+		return fmt.Errorf("auth session cache ttl is required")
+	}
+	return nil
+}
+
 // ProvideConfig is an fx provider that loads the configuration.
 func ProvideConfig(logger *logger.Logger) (*Config, error) {
 	return LoadConfig(".", logger)
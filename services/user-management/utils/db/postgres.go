@@ -11,6 +11,8 @@ import (
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/configs"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/ent"
 	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/logger"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/metrics"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/tracing"
 	_ "github.com/lib/pq"
 	"go.uber.org/fx"
 )
@@ -45,7 +47,7 @@ func NewEntClient(lc fx.Lifecycle, config *configs.Config, logger *logger.Logger
 	if err != nil {
 		return nil, err
 	}
-	client := ent.NewClient(ent.Driver(driver))
+	client := ent.NewClient(ent.Driver(tracing.InstrumentDriver(metrics.InstrumentDriver(driver, cfg.DBName))))
 
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
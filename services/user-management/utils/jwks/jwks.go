@@ -0,0 +1,251 @@
+// Package jwks verifies access tokens locally with the public keys the auth service
+// publishes as a JSON Web Key Set, instead of asking the auth service about every token.
+//
+// chat-service and user-management each keep a copy of this package, as they do with
+// the other utils: every service is its own module and Docker build context. The copies
+// only differ in their import paths and must be changed together; make check_jwks
+// compares them.
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/logger"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewVerifier),
+)
+
+// refetchInterval limits how often a token with an unknown kid makes the verifier fetch
+// the keys, so tokens with made up kids cannot flood the auth service.
+const refetchInterval = 30 * time.Second
+
+// tokenTypeAccess is the typ claim of access tokens.
+const tokenTypeAccess = "access"
+
+// Claims are the claims of the access tokens issued by the auth service.
+type Claims struct {
+	// Type is "access"; refresh tokens are typed "refresh" and are not bearer tokens
+	Type      string `json:"typ"`
+	ID        uint
+	SessionID string
+	Username  string
+	Email     string
+	Role      string
+	Duration  time.Duration
+	// ClientID and Scope are only set on the tokens of OAuth clients
+	ClientID string `json:",omitempty"`
+	Scope    string `json:",omitempty"`
+	jwt.RegisteredClaims
+}
+
+type publicKey struct {
+	alg string
+	key crypto.PublicKey
+}
+
+// Verifier caches the keys of the set for the configured TTL. A token signed with a key
+// the cache does not know yet refreshes it, and when the auth service cannot be reached
+// the keys fetched last are used until it can. The keys are fetched outside the lock by
+// one request at a time: the cached keys keep verifying tokens meanwhile, and only the
+// tokens with an unknown kid wait for the fetch.
+type Verifier struct {
+	url    string
+	ttl    time.Duration
+	client *http.Client
+	logger *logger.Logger
+	group  singleflight.Group
+
+	mu        sync.Mutex
+	keys      map[string]publicKey
+	fetchedAt time.Time
+	triedAt   time.Time
+	fetching  bool
+}
+
+func NewVerifier(config *configs.Config, logger *logger.Logger) *Verifier {
+	return &Verifier{
+		url:    config.Auth.JWKSURL,
+		ttl:    config.Auth.JWKSCacheTTL,
+		client: &http.Client{Timeout: 5 * time.Second},
+		logger: logger,
+	}
+}
+
+// Verify checks the signature, the expiry and the type of the token and returns its claims.
+func (v *Verifier) Verify(ctx context.Context, tokenStr string) (Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenStr, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := v.key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if token.Method.Alg() != key.alg {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return key.key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}))
+	if err != nil {
+		return Claims{}, fmt.Errorf("error parsing token: %w", err)
+	}
+
+	claims, ok := token.Claims.(*Claims)
+	if !ok {
+		return Claims{}, fmt.Errorf("invalid token claims")
+	}
+	if claims.Type != tokenTypeAccess {
+		return Claims{}, fmt.Errorf("token is not an access token")
+	}
+
+	return *claims, nil
+}
+
+// key returns the key named kid, fetching the set when the cache expired or does not know kid.
+// A cached key is returned right away, also when the set is being fetched again.
+func (v *Verifier) key(ctx context.Context, kid string) (publicKey, error) {
+	v.mu.Lock()
+	now := time.Now()
+	key, ok := v.keys[kid]
+	expired := now.Sub(v.fetchedAt) >= v.ttl
+	start := (expired || !ok) && !v.fetching && now.Sub(v.triedAt) >= refetchInterval
+	if start {
+		v.triedAt = now
+		v.fetching = true
+	}
+	var fetched <-chan singleflight.Result
+	if start || (!ok && v.fetching) {
+		// The fetch is shared by the callers, so it must not end with the context of the first one
+		fetched = v.group.DoChan("keys", func() (interface{}, error) {
+			return nil, v.refresh(context.WithoutCancel(ctx))
+		})
+	}
+	v.mu.Unlock()
+
+	if ok {
+		return key, nil
+	}
+	if fetched != nil {
+		select {
+		case <-fetched:
+		case <-ctx.Done():
+			return publicKey{}, ctx.Err()
+		}
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.keys == nil {
+		return publicKey{}, fmt.Errorf("the signing keys have not been fetched yet")
+	}
+	key, ok = v.keys[kid]
+	if !ok {
+		return publicKey{}, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// refresh fetches the set and replaces the cached keys with it. When the fetch fails the
+// cached keys are kept.
+func (v *Verifier) refresh(ctx context.Context) error {
+	keys, err := v.fetch(ctx)
+	if err != nil {
+		v.logger.Ctx(ctx).Warn("failed to fetch the signing keys, using the cached ones", zap.Error(err))
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.fetching = false
+	if err != nil {
+		return err
+	}
+	v.keys = keys
+	v.fetchedAt = time.Now()
+	return nil
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+}
+
+// fetch returns the keys published at the JWKS URL.
+func (v *Verifier) fetch(ctx context.Context) (map[string]publicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", res.Status)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]publicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		key, err := parseKey(k)
+		if err != nil {
+			// Skip the keys this verifier does not understand, the others still verify tokens
+			v.logger.Ctx(ctx).Warn("skipping signing key", zap.String("kid", k.Kid), zap.Error(err))
+			continue
+		}
+		keys[k.Kid] = publicKey{alg: k.Alg, key: key}
+	}
+	return keys, nil
+}
+
+func parseKey(k jwk) (crypto.PublicKey, error) {
+	switch {
+	case k.Kty == "RSA" && k.Alg == jwt.SigningMethodRS256.Alg():
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case k.Kty == "OKP" && k.Crv == "Ed25519" && k.Alg == jwt.SigningMethodEdDSA.Alg():
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 key size %d", len(x))
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s %s", k.Kty, k.Alg)
+	}
+}
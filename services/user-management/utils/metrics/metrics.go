@@ -0,0 +1,64 @@
+// Package metrics exposes Prometheus metrics of the HTTP and gRPC servers, the gRPC
+// clients and the database on the /metrics endpoint of the Fiber app.
+package metrics
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "HTTP requests handled, by method, route and status code.",
+	}, []string{"method", "route", "code"})
+
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Time taken to handle HTTP requests, by method and route.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+)
+
+// Handler serves the metrics in the Prometheus text format.
+func Handler() fiber.Handler {
+	return adaptor.HTTPHandler(promhttp.Handler())
+}
+
+// Middleware records the count and latency of the requests per route. Routes are
+// labelled with their pattern, e.g. /ws/get-call/:callId, so that IDs do not end
+// up in the label values; requests that match no route are labelled "unmatched".
+func Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		middleware := c.Route()
+
+		err := c.Next()
+
+		code := c.Response().StatusCode()
+		if err != nil {
+			// The error handler sets the status after the middleware returns
+			code = fiber.StatusInternalServerError
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				code = fiberErr.Code
+			}
+		}
+
+		route := c.Route().Path
+		if c.Route() == middleware {
+			route = "unmatched"
+		}
+		method := c.Method()
+		httpRequests.WithLabelValues(method, route, strconv.Itoa(code)).Inc()
+		httpDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
@@ -0,0 +1,78 @@
+package tracing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware starts a server span for every request, continuing the trace of the
+// caller when the request carries a traceparent header. The span is put in the
+// user context of the request, which handlers pass on as ctx.UserContext(). Later
+// middlewares add to that context, as AuthMiddleware does with the token.
+func Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := otel.GetTextMapPropagator().Extract(c.Context(), headerCarrier{c})
+		ctx, span := tracer().Start(ctx, "HTTP "+c.Method(),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(c.Method()),
+				semconv.URLPath(c.Path()),
+				semconv.UserAgentOriginal(string(c.Request().Header.UserAgent())),
+				semconv.ClientAddress(c.IP()),
+			),
+		)
+		defer span.End()
+		c.SetUserContext(ctx)
+		middleware := c.Route()
+
+		err := c.Next()
+
+		code := c.Response().StatusCode()
+		if err != nil {
+			code = fiber.StatusInternalServerError
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				code = fiberErr.Code
+			}
+			span.RecordError(err)
+		}
+
+		route := c.Route().Path
+		if c.Route() == middleware {
+			route = "unmatched"
+		}
+		span.SetName(fmt.Sprintf("%s %s", c.Method(), route))
+		span.SetAttributes(semconv.HTTPRoute(route), semconv.HTTPResponseStatusCode(code))
+		if code >= fiber.StatusInternalServerError {
+			span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", code))
+		}
+		return err
+	}
+}
+
+// headerCarrier reads and writes the propagation headers of a request.
+type headerCarrier struct {
+	c *fiber.Ctx
+}
+
+func (h headerCarrier) Get(key string) string {
+	return h.c.Get(key)
+}
+
+func (h headerCarrier) Set(key, value string) {
+	h.c.Request().Header.Set(key, value)
+}
+
+func (h headerCarrier) Keys() []string {
+	var keys []string
+	h.c.Request().Header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+	return keys
+}
@@ -0,0 +1,81 @@
+// Package tracing sets up OpenTelemetry tracing and instruments the Fiber app and
+// the database. gRPC servers and clients are instrumented with the
+// otelgrpc stats handlers, which propagate the trace context in the metadata.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/configs"
+	"github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Invoke(SetupTracing),
+)
+
+const instrumentationName = "github.com/Ali-Gorgani/chat-room-project/services/user-management/utils/tracing"
+
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// SetupTracing installs the global tracer provider and the W3C trace context propagator.
+// With the none exporter the incoming trace context is still propagated to the calls
+// this service makes, but no spans are recorded.
+func SetupTracing(lc fx.Lifecycle, config *configs.Config, logger *logger.Logger) error {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	exporter, err := newExporter(config.Tracing)
+	if err != nil {
+		return fmt.Errorf("failed to create the %s trace exporter: %w", config.Tracing.Exporter, err)
+	}
+	if exporter == nil {
+		return nil
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(config.Tracing.ServiceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.Tracing.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info(fmt.Sprintf("Exporting traces to %s", config.Tracing.Exporter))
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			// Flush the spans that are still batched
+			return provider.Shutdown(ctx)
+		},
+	})
+	return nil
+}
+
+func newExporter(config configs.TracingConfig) (sdktrace.SpanExporter, error) {
+	switch config.Exporter {
+	case "stdout":
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case "otlp":
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.Endpoint)}
+		if config.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		// The client connects lazily, so a collector that is not up yet does not stop the service
+		return otlptracegrpc.New(context.Background(), opts...)
+	default:
+		return nil, nil
+	}
+}